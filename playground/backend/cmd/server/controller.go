@@ -17,79 +17,534 @@ package main
 import (
 	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/memo"
 	"beam.apache.org/playground/backend/internal/cloud_bucket"
 	"beam.apache.org/playground/backend/internal/code_processing"
+	"beam.apache.org/playground/backend/internal/compile_diagnostics"
+	"beam.apache.org/playground/backend/internal/dependencies"
+	"beam.apache.org/playground/backend/internal/env_vars"
 	"beam.apache.org/playground/backend/internal/environment"
 	"beam.apache.org/playground/backend/internal/errors"
+	"beam.apache.org/playground/backend/internal/fs_tool"
+	"beam.apache.org/playground/backend/internal/job_queue"
+	"beam.apache.org/playground/backend/internal/log_entries"
 	"beam.apache.org/playground/backend/internal/logger"
+	"beam.apache.org/playground/backend/internal/pipeline_options"
 	"beam.apache.org/playground/backend/internal/setup_tools/life_cycle"
+	"beam.apache.org/playground/backend/internal/snippets"
+	"beam.apache.org/playground/backend/internal/source_cache"
+	"beam.apache.org/playground/backend/internal/usage_metrics"
 	"beam.apache.org/playground/backend/internal/utils"
+	"beam.apache.org/playground/backend/internal/validators"
+	"bufio"
 	"context"
+	"encoding/json"
+	goerrors "errors"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"path/filepath"
+	"sync"
+	"time"
+	"unicode/utf8"
 )
 
+// statusCheckInterval is the interval of checking whether the pipeline is finished while its statuses are streamed.
+// It isn't needed if all statuses are received by the subscription, but makes sure that the stream is finished anyway.
+const statusCheckInterval = time.Second
+
+// outputCheckInterval is the interval of checking new run output of the pipeline while its output is streamed.
+const outputCheckInterval = 500 * time.Millisecond
+
+// workingDirChunkSize is the max size in bytes of the chunk of the working directory archive, so the chunk fits the message size limit of gRPC
+const workingDirChunkSize = 1024 * 1024
+
+// defaultUsageMetricsDays is the number of days of usage metrics which are returned if the first date isn't set
+const defaultUsageMetricsDays = 30
+
+// maxUsageMetricsDays is the max number of days of usage metrics which are returned by one request
+const maxUsageMetricsDays = 366
+
 // playgroundController processes `gRPC' requests from clients.
 // Contains methods to process receiving code, monitor current status of code processing and receive compile/run output.
 type playgroundController struct {
 	env          *environment.Environment
 	cacheService cache.Cache
+	// queue limits the number of code processings which are compiled and run at the same time
+	queue *job_queue.Queue
+	// examplesStorage keeps examples which are returned and searched
+	examplesStorage cloud_bucket.ExamplesStorage
+	// sdks contains availability of all known SDKs which is detected when the server is started
+	sdks []*pb.SdkInfo
+	// warmPool keeps working directories which are prepared in advance, nil if there is no warm pool
+	warmPool *fs_tool.WarmPool
+	// usageMetrics counts runs per SDK, example and date, nil if usage metrics aren't collected
+	usageMetrics *usage_metrics.Collector
+	// snippets keeps snippets which are shared by users
+	snippets snippets.Store
+
+	// drainMu guards draining, so code processings aren't added to runs after the server is drained
+	drainMu  sync.Mutex
+	draining bool
+	// runs contains code processings which are started by the controller and aren't finished yet
+	runs sync.WaitGroup
 
 	pb.UnimplementedPlaygroundServiceServer
 }
 
 // RunCode is running code from requests using a particular SDK
 // - In case of incorrect sdk returns codes.InvalidArgument
+// - In case of requirements which aren't supported or allowed returns codes.InvalidArgument
+// - In case of negative deadline returns codes.InvalidArgument
+// - In case of the server is shutting down returns codes.Unavailable
 // - In case of error during preparing files/folders returns codes.Internal
 // - In case of no errors saves playground.Status_STATUS_EXECUTING as cache.Status into cache and sets expiration time
 //   for all cache values which will be saved into cache during processing received code.
 //   Returns id of code processing (pipelineId)
+// - In case of the identical deterministic code is already processed successfully, saves results of the previous
+//   code processing into cache and returns id of code processing without processing the code again
 func (controller *playgroundController) RunCode(ctx context.Context, info *pb.RunCodeRequest) (*pb.RunCodeResponse, error) {
+	if !controller.startRun() {
+		return nil, errors.UnavailableError("Error during preparing", "The server is shutting down, please retry the request")
+	}
+	pipelineId, lc, source, pipelineOptions, err := controller.prepareRunCode(ctx, info)
+	if err != nil {
+		controller.runs.Done()
+		return nil, err
+	}
+	controller.usageMetrics.Record(info.Sdk, info.ExampleCloudPath, time.Now())
+	if lc != nil {
+		controller.processCode(lc, pipelineId, info, pipelineOptions, source)
+	} else {
+		controller.runs.Done()
+	}
+
+	pipelineInfo := pb.RunCodeResponse{PipelineUuid: pipelineId.String()}
+	return &pipelineInfo, nil
+}
+
+// RunProgram is running code from requests like RunCode and streams statuses of the code processing until it is finished.
+// The subscription to statuses is done before the code processing is started, so the client receives all changes of
+// the status (e.g. STATUS_COMPILING -> STATUS_EXECUTING -> STATUS_FINISHED) within one call.
+// The code processing isn't stopped if the client cancels the call, but it is terminated after the deadline of the request.
+// If the code processing is failed, the last status is followed by the error which contains playground.ErrorDetails
+// with the code of the failure (e.g. ERROR_CODE_COMPILE_ERROR for STATUS_COMPILE_ERROR).
+func (controller *playgroundController) RunProgram(info *pb.RunCodeRequest, stream pb.PlaygroundService_RunProgramServer) error {
+	ctx := stream.Context()
+	if !controller.startRun() {
+		return errors.UnavailableError("Error during preparing", "The server is shutting down, please retry the request")
+	}
+	pipelineId, lc, source, pipelineOptions, err := controller.prepareRunCode(ctx, info)
+	if err != nil {
+		controller.runs.Done()
+		return err
+	}
+	controller.usageMetrics.Record(info.Sdk, info.ExampleCloudPath, time.Now())
+	subscriptionCtx, cancelSubscription := context.WithCancel(ctx)
+	defer cancelSubscription()
+	statuses, err := controller.cacheService.SubscribeStatus(subscriptionCtx, pipelineId)
+	if err != nil {
+		logger.Errorf("%s: RunProgram(): cache.SubscribeStatus(): %s\n", pipelineId, err.Error())
+		if lc != nil {
+			code_processing.DeleteFolders(pipelineId, lc)
+		}
+		controller.runs.Done()
+		return errors.InternalError("Error during preparing", "Error during subscribing to status of the code processing")
+	}
+	// the initial status is received before the code processing is started, so it isn't newer than received statuses
+	initialStatus := getStatus(ctx, controller.cacheService, pipelineId)
+	if lc != nil {
+		controller.processCode(lc, pipelineId, info, pipelineOptions, source)
+	} else {
+		controller.runs.Done()
+	}
+	return streamStatuses(ctx, controller.cacheService, pipelineId, initialStatus, statuses, stream.Send)
+}
+
+// startRun adds the code processing to runs unless the server is drained.
+// Returns false if the server is drained, so the code shouldn't be processed.
+// Otherwise, runs.Done should be called when the code processing is finished or isn't started.
+func (controller *playgroundController) startRun() bool {
+	controller.drainMu.Lock()
+	defer controller.drainMu.Unlock()
+	if controller.draining {
+		logger.Errorf("RunCode(): the server is shutting down\n")
+		return false
+	}
+	controller.runs.Add(1)
+	return true
+}
+
+// processCode processes the code of the request in background and removes it from runs when it is finished.
+// The code processing doesn't depend on the context of the request, so it isn't stopped if the client is gone.
+func (controller *playgroundController) processCode(lc *fs_tool.LifeCycle, pipelineId uuid.UUID, info *pb.RunCodeRequest, pipelineOptions string, source *source_cache.Source) {
+	go func() {
+		defer controller.runs.Done()
+		code_processing.Process(context.Background(), controller.cacheService, lc, pipelineId, &controller.env.ApplicationEnvs, &controller.env.BeamSdkEnvs, pipelineOptions, info.Deadline.AsDuration(), source, controller.queue)
+	}()
+}
+
+// drain stops accepting new code processings and waits until the started ones are finished, but not longer than
+// gracePeriod. Code processings which aren't finished during gracePeriod are terminated with the status which explains
+// the shutdown (see code_processing.TerminateAll), then drain waits until they are stopped, but not longer than stopTimeout.
+// Returns the number of terminated code processings.
+func (controller *playgroundController) drain(gracePeriod, stopTimeout time.Duration) int {
+	controller.drainMu.Lock()
+	controller.draining = true
+	controller.drainMu.Unlock()
+
+	finished := make(chan struct{})
+	go func() {
+		controller.runs.Wait()
+		close(finished)
+	}()
+	select {
+	case <-finished:
+		return 0
+	case <-time.After(gracePeriod):
+	}
+	terminated := code_processing.TerminateAll(context.Background(), controller.cacheService)
+	select {
+	case <-finished:
+	case <-time.After(stopTimeout):
+		logger.Errorf("Server: code processings aren't stopped during %s after termination\n", stopTimeout)
+	}
+	return terminated
+}
+
+// prepareRunCode checks the request of RunCode and prepares the file system and cache to process the code.
+// Returns the id of the pipeline, the life cycle, the source and the pipeline options of the code which are passed
+// to code_processing.Process. The pipeline options are options of the request merged with default options of the example.
+// If results of the identical code are reused, the life cycle is nil and the code shouldn't be processed.
+func (controller *playgroundController) prepareRunCode(ctx context.Context, info *pb.RunCodeRequest) (uuid.UUID, *fs_tool.LifeCycle, *source_cache.Source, string, error) {
+	if err := controller.applySnippet(ctx, info); err != nil {
+		return uuid.Nil, nil, nil, "", err
+	}
 	// check for correct sdk
 	if info.Sdk != controller.env.BeamSdkEnvs.ApacheBeamSdk {
 		logger.Errorf("RunCode(): request contains incorrect sdk: %s\n", info.Sdk)
-		return nil, errors.InvalidArgumentError("Error during preparing", "Incorrect sdk. Want to receive %s, but the request contains %s", controller.env.BeamSdkEnvs.ApacheBeamSdk.String(), info.Sdk.String())
+		return uuid.Nil, nil, nil, "", errors.InvalidArgumentError("Error during preparing", "Incorrect sdk. Want to receive %s, but the request contains %s", controller.env.BeamSdkEnvs.ApacheBeamSdk.String(), info.Sdk.String())
 	}
 	switch info.Sdk {
-	case pb.Sdk_SDK_UNSPECIFIED, pb.Sdk_SDK_SCIO:
+	case pb.Sdk_SDK_UNSPECIFIED:
 		logger.Errorf("RunCode(): unimplemented sdk: %s\n", info.Sdk)
-		return nil, errors.InvalidArgumentError("Error during preparing", "Sdk is not implemented yet: %s", info.Sdk.String())
+		return uuid.Nil, nil, nil, "", errors.InvalidArgumentError("Error during preparing", "Sdk is not implemented yet: %s", info.Sdk.String())
+	}
+	if err := controller.checkCodeSize(info.Code); err != nil {
+		logger.Errorf("RunCode(): too large code: %s\n", err.Error())
+		return uuid.Nil, nil, nil, "", errors.LimitExceededError("Error during preparing", "Incorrect code: %s", err.Error())
+	}
+	if len(info.Requirements) > 0 {
+		if info.Sdk != pb.Sdk_SDK_PYTHON {
+			logger.Errorf("RunCode(): requirements of sdk: %s\n", info.Sdk)
+			return uuid.Nil, nil, nil, "", errors.InvalidArgumentError("Error during preparing", "Requirements are supported only for %s", pb.Sdk_SDK_PYTHON.String())
+		}
+		if err := dependencies.CheckPyRequirements(info.Requirements, controller.env.BeamSdkEnvs.ExecutorConfig.AllowedPackages); err != nil {
+			logger.Errorf("RunCode(): incorrect requirements: %s\n", err.Error())
+			return uuid.Nil, nil, nil, "", errors.InvalidArgumentError("Error during preparing", "Incorrect requirements: %s", err.Error())
+		}
+	}
+	if err := env_vars.Check(info.EnvVars); err != nil {
+		logger.Errorf("RunCode(): incorrect environment variables: %s\n", err.Error())
+		return uuid.Nil, nil, nil, "", errors.InvalidArgumentError("Error during preparing", "Incorrect environment variables: %s", err.Error())
+	}
+	if err := controller.env.BeamSdkEnvs.ExecutorConfig.CheckRunner(info.Runner); err != nil {
+		logger.Errorf("RunCode(): incorrect runner: %s\n", err.Error())
+		return uuid.Nil, nil, nil, "", errors.InvalidArgumentError("Error during preparing", "Incorrect runner: %s", err.Error())
+	}
+	if info.Deadline != nil && (!info.Deadline.IsValid() || info.Deadline.AsDuration() < 0) {
+		logger.Errorf("RunCode(): incorrect deadline: %s\n", info.Deadline.String())
+		return uuid.Nil, nil, nil, "", errors.InvalidArgumentError("Error during preparing", "Incorrect deadline: it should be a positive duration")
+	}
+	pipelineOptions, err := controller.getPipelineOptions(ctx, info)
+	if err != nil {
+		return uuid.Nil, nil, nil, "", err
+	}
+	// the direct runner is the default one, so the code which sets it explicitly is the same code
+	runner := info.Runner
+	if runner == environment.DirectRunner {
+		runner = ""
+	}
+	if info.KeepWorkingDir && controller.env.ApplicationEnvs.WorkingDirEnvs().Retention() == 0 {
+		logger.Errorf("RunCode(): working directories aren't kept\n")
+		return uuid.Nil, nil, nil, "", errors.InvalidArgumentError("Error during preparing", "Working directories of the code aren't kept by the server")
+	}
+	if len(info.Stdin) > fs_tool.MaxStdinSize {
+		logger.Errorf("RunCode(): too large input: %d bytes\n", len(info.Stdin))
+		return uuid.Nil, nil, nil, "", errors.InvalidArgumentError("Error during preparing", "Input of the code is %d bytes, but should be at most %d bytes", len(info.Stdin), fs_tool.MaxStdinSize)
 	}
 
 	cacheExpirationTime := controller.env.ApplicationEnvs.CacheEnvs().KeyExpirationTime()
 	pipelineId := uuid.New()
 
-	lc, err := life_cycle.Setup(info.Sdk, info.Code, pipelineId, controller.env.ApplicationEnvs.WorkingDir(), controller.env.ApplicationEnvs.PipelinesFolder(), controller.env.BeamSdkEnvs.PreparedModDir())
+	source := source_cache.New(info.Sdk, info.Code, pipelineOptions, info.Requirements, info.Stdin, info.EnvVars, runner, !info.Nondeterministic, cacheExpirationTime)
+	// the working directory is created only if the code is processed, so results aren't reused if it should be kept
+	if !info.KeepWorkingDir {
+		if reused, err := source.RestoreRunResult(ctx, controller.cacheService, pipelineId); err != nil {
+			logger.Errorf("%s: RunCode(): error during restoring results of the identical code: %s\n", pipelineId, err.Error())
+		} else if reused {
+			if err = controller.cacheService.SetExpTime(ctx, pipelineId, cacheExpirationTime); err != nil {
+				logger.Errorf("%s: RunCode(): cache.SetExpTime(): %s\n", pipelineId, err.Error())
+				return uuid.Nil, nil, nil, "", errors.InternalError("Error during preparing", "Internal error")
+			}
+			logger.Infof("%s: RunCode(): results of the identical code are reused\n", pipelineId)
+			return pipelineId, nil, source, pipelineOptions, nil
+		}
+	}
+
+	lc, err := life_cycle.SetupWithPool(controller.warmPool, info.Sdk, info.Code, pipelineId, controller.env.ApplicationEnvs.WorkingDir(), controller.env.ApplicationEnvs.PipelinesFolder(), controller.env.BeamSdkEnvs.PreparedModDir())
 	if err != nil {
 		logger.Errorf("RunCode(): error during setup file system: %s\n", err.Error())
-		return nil, errors.InternalError("Error during preparing", "Error during setup file system for the code processing: %s", err.Error())
+		return uuid.Nil, nil, nil, "", errors.InternalError("Error during preparing", "Error during setup file system for the code processing: %s", err.Error())
+	}
+	if err = dependencies.WriteRequirements(lc.Paths.AbsoluteBaseFolderPath, info.Requirements); err != nil {
+		logger.Errorf("%s: RunCode(): error during writing requirements: %s\n", pipelineId, err.Error())
+		code_processing.DeleteFolders(pipelineId, lc)
+		return uuid.Nil, nil, nil, "", errors.InternalError("Error during preparing", "Error during saving requirements of the code")
+	}
+	if err = lc.CreateStdinFile(info.Stdin); err != nil {
+		logger.Errorf("%s: RunCode(): error during writing input: %s\n", pipelineId, err.Error())
+		code_processing.DeleteFolders(pipelineId, lc)
+		return uuid.Nil, nil, nil, "", errors.InternalError("Error during preparing", "Error during saving input of the code")
+	}
+	if err = env_vars.Write(lc.Paths.AbsoluteBaseFolderPath, info.EnvVars); err != nil {
+		logger.Errorf("%s: RunCode(): error during writing environment variables: %s\n", pipelineId, err.Error())
+		code_processing.DeleteFolders(pipelineId, lc)
+		return uuid.Nil, nil, nil, "", errors.InternalError("Error during preparing", "Error during saving environment variables of the code")
+	}
+	if err = lc.CreateRunnerFile(runner); err != nil {
+		logger.Errorf("%s: RunCode(): error during writing runner: %s\n", pipelineId, err.Error())
+		code_processing.DeleteFolders(pipelineId, lc)
+		return uuid.Nil, nil, nil, "", errors.InternalError("Error during preparing", "Error during saving runner of the code")
+	}
+	if info.KeepWorkingDir {
+		if err = lc.CreateKeepFile(); err != nil {
+			logger.Errorf("%s: RunCode(): error during marking the working directory to be kept: %s\n", pipelineId, err.Error())
+			code_processing.DeleteFolders(pipelineId, lc)
+			return uuid.Nil, nil, nil, "", errors.InternalError("Error during preparing", "Error during marking the working directory of the code to be kept")
+		}
 	}
 
 	if err = utils.SetToCache(ctx, controller.cacheService, pipelineId, cache.Status, pb.Status_STATUS_VALIDATING); err != nil {
 		code_processing.DeleteFolders(pipelineId, lc)
-		return nil, errors.InternalError("Error during preparing", "Error during saving status of the code processing")
+		return uuid.Nil, nil, nil, "", errors.InternalError("Error during preparing", "Error during saving status of the code processing")
 	}
 	if err = utils.SetToCache(ctx, controller.cacheService, pipelineId, cache.RunOutputIndex, 0); err != nil {
 		code_processing.DeleteFolders(pipelineId, lc)
-		return nil, errors.InternalError("Error during preparing", "Error during saving initial run output")
+		return uuid.Nil, nil, nil, "", errors.InternalError("Error during preparing", "Error during saving initial run output")
 	}
 	if err = utils.SetToCache(ctx, controller.cacheService, pipelineId, cache.LogsIndex, 0); err != nil {
 		code_processing.DeleteFolders(pipelineId, lc)
-		return nil, errors.InternalError("Error during preparing", "Error during saving value for the logs output")
+		return uuid.Nil, nil, nil, "", errors.InternalError("Error during preparing", "Error during saving value for the logs output")
 	}
 	if err = utils.SetToCache(ctx, controller.cacheService, pipelineId, cache.Canceled, false); err != nil {
 		code_processing.DeleteFolders(pipelineId, lc)
-		return nil, errors.InternalError("Error during preparing", "Error during saving initial cancel flag")
+		return uuid.Nil, nil, nil, "", errors.InternalError("Error during preparing", "Error during saving initial cancel flag")
 	}
 	if err = controller.cacheService.SetExpTime(ctx, pipelineId, cacheExpirationTime); err != nil {
 		logger.Errorf("%s: RunCode(): cache.SetExpTime(): %s\n", pipelineId, err.Error())
 		code_processing.DeleteFolders(pipelineId, lc)
-		return nil, errors.InternalError("Error during preparing", "Internal error")
+		return uuid.Nil, nil, nil, "", errors.InternalError("Error during preparing", "Internal error")
 	}
 
-	go code_processing.Process(context.Background(), controller.cacheService, lc, pipelineId, &controller.env.ApplicationEnvs, &controller.env.BeamSdkEnvs, info.PipelineOptions)
+	return pipelineId, lc, source, pipelineOptions, nil
+}
 
-	pipelineInfo := pb.RunCodeResponse{PipelineUuid: pipelineId.String()}
-	return &pipelineInfo, nil
+// getPipelineOptions returns pipeline options of the request merged with default pipeline options of the example
+// which is set by ExampleCloudPath. Options of the request override default options with the same names.
+// - In case of the example isn't found returns codes.NotFound
+// - In case of incorrect pipeline options of the request or the example returns codes.InvalidArgument
+// - In case of an error of the examples storage returns codes.Internal
+func (controller *playgroundController) getPipelineOptions(ctx context.Context, info *pb.RunCodeRequest) (string, error) {
+	if info.ExampleCloudPath == "" {
+		return info.PipelineOptions, nil
+	}
+	data, err := controller.examplesStorage.GetPrecompiledObjectData(ctx, info.ExampleCloudPath)
+	if goerrors.Is(err, cloud_bucket.ErrPrecompiledObjectNotFound) {
+		logger.Errorf("RunCode(): example %s is not found\n", info.ExampleCloudPath)
+		return "", errors.NotFoundError("Error during preparing", "Example %s is not found", info.ExampleCloudPath)
+	}
+	if err != nil {
+		logger.Errorf("RunCode(): cloud storage error: %s\n", err.Error())
+		return "", errors.InternalError("Error during preparing", "Error during getting default pipeline options of the example")
+	}
+	pipelineOptions, err := utils.MergePipelineOptions(data.Info.PipelineOptions, info.PipelineOptions)
+	if err != nil {
+		logger.Errorf("RunCode(): incorrect pipeline options: %s\n", err.Error())
+		return "", errors.InvalidArgumentError("Error during preparing", "Incorrect pipeline options: %s", err.Error())
+	}
+	return pipelineOptions, nil
+}
+
+// applySnippet sets the code, the sdk and the pipeline options of the saved snippet to the request if the request
+// contains the id of the snippet. Pipeline options of the request override options of the snippet with the same names.
+// The request is processed as any other code, so each run of the snippet has its own pipeline id and working directory.
+func (controller *playgroundController) applySnippet(ctx context.Context, info *pb.RunCodeRequest) error {
+	if info.SnippetId == "" {
+		return nil
+	}
+	if info.Code != "" {
+		logger.Errorf("RunCode(): request contains both the code and the snippet %s\n", info.SnippetId)
+		return errors.InvalidArgumentError("Error during preparing", "The request should contain either the code or the id of the snippet")
+	}
+	snippet, err := snippets.Get(ctx, controller.snippets, info.SnippetId)
+	if err != nil {
+		logger.Errorf("%s: RunCode(): error during getting the snippet: %s\n", info.SnippetId, err.Error())
+		switch {
+		case goerrors.Is(err, snippets.ErrInvalidId):
+			return errors.InvalidArgumentError("Error during preparing", "id of the snippet has incorrect value: %s", info.SnippetId)
+		case goerrors.Is(err, snippets.ErrNotFound):
+			return errors.NotFoundError("Error during preparing", "Snippet %s isn't found", info.SnippetId)
+		default:
+			return errors.InternalError("Error during preparing", "Error during getting the snippet from the store")
+		}
+	}
+	if info.Sdk != pb.Sdk_SDK_UNSPECIFIED && info.Sdk != snippet.Sdk {
+		logger.Errorf("RunCode(): request contains sdk %s, but the snippet %s is saved for %s\n", info.Sdk, info.SnippetId, snippet.Sdk)
+		return errors.InvalidArgumentError("Error during preparing", "Incorrect sdk. The snippet is saved for %s, but the request contains %s", snippet.Sdk.String(), info.Sdk.String())
+	}
+	pipelineOptions, err := utils.MergePipelineOptions(snippet.PipelineOptions, info.PipelineOptions)
+	if err != nil {
+		logger.Errorf("RunCode(): incorrect pipeline options: %s\n", err.Error())
+		return errors.InvalidArgumentError("Error during preparing", "Incorrect pipeline options: %s", err.Error())
+	}
+	info.Code, info.Sdk, info.PipelineOptions = snippet.Code, snippet.Sdk, pipelineOptions
+	return nil
+}
+
+// checkCodeSize checks that the code fits size limits of the server before any file of the code is created
+func (controller *playgroundController) checkCodeSize(code string) error {
+	codeEnvs := controller.env.ApplicationEnvs.CodeEnvs()
+	return validators.CheckCodeSize([]fs_tool.SourceFile{{Code: code, IsMain: true}}, codeEnvs.MaxCodeSize(), codeEnvs.MaxFileSize())
+}
+
+// streamStatuses sends the initial status of the pipeline and its changes which are received from statuses.
+// Returns when the terminal status is sent (see cache.IsTerminalStatus) or ctx is done.
+// If the terminal status is a failure, returns the error which contains the code of the failure (see getPipelineError).
+func streamStatuses(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID, initialStatus pb.Status, statuses <-chan pb.Status, send func(*pb.RunProgramResponse) error) error {
+	lastStatus := pb.Status_STATUS_UNSPECIFIED
+	sendStatus := func(status pb.Status) error {
+		if status == lastStatus {
+			return nil
+		}
+		lastStatus = status
+		if err := send(&pb.RunProgramResponse{PipelineUuid: pipelineId.String(), Status: status}); err != nil {
+			logger.Errorf("%s: RunProgram(): error during sending status: %s\n", pipelineId, err.Error())
+			return err
+		}
+		return nil
+	}
+
+	if err := sendStatus(initialStatus); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(statusCheckInterval)
+	defer ticker.Stop()
+	for !cache.IsTerminalStatus(lastStatus) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case status, ok := <-statuses:
+			if !ok {
+				return ctx.Err()
+			}
+			if err := sendStatus(status); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			// only the terminal status is sent, since other statuses could be received by the subscription later
+			if status := getStatus(ctx, cacheService, pipelineId); cache.IsTerminalStatus(status) {
+				if err := sendStatus(status); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return getPipelineError(ctx, cacheService, pipelineId, lastStatus)
+}
+
+// getPipelineError returns the error of the code processing which is finished with the failed terminal status.
+// The error contains the code of the failure which is chosen by the status and the resource limit exceeded by
+// the run step (see errors.PipelineError). Returns nil if the code processing is finished successfully.
+func getPipelineError(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID, pipelineStatus pb.Status) error {
+	if pipelineStatus == pb.Status_STATUS_FINISHED {
+		return nil
+	}
+	limitExceeded := false
+	if value, err := cacheService.GetValue(ctx, pipelineId, cache.RunResult); err == nil {
+		if result, ok := value.(cache.ExecutionResult); ok {
+			limitExceeded = result.LimitExceeded != ""
+		}
+	}
+	return errors.PipelineError(pipelineStatus, limitExceeded, "Error during processing", "Code processing is finished with %s", pipelineStatus)
+}
+
+// getStatus returns the status of the pipeline from cache or STATUS_UNSPECIFIED if it couldn't be received
+func getStatus(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID) pb.Status {
+	status, err := cacheService.GetValue(ctx, pipelineId, cache.Status)
+	if err != nil {
+		logger.Errorf("%s: RunProgram(): cache.GetValue: error: %s\n", pipelineId, err.Error())
+		return pb.Status_STATUS_UNSPECIFIED
+	}
+	pipelineStatus, _ := status.(pb.Status)
+	return pipelineStatus
+}
+
+// ValidateCode is checking syntax of the code from requests using a particular SDK without compiling and running it
+// - In case of incorrect sdk returns codes.InvalidArgument
+// - In case of error during preparing files/folders or checking the code returns codes.Internal
+// - In case of no errors saves the status of the check as cache.Status and its diagnostics as cache.ValidationOutput
+//   into cache and sets expiration time for them.
+//   Returns id of code processing (pipelineId), the status and the diagnostics
+func (controller *playgroundController) ValidateCode(ctx context.Context, info *pb.ValidateCodeRequest) (*pb.ValidateCodeResponse, error) {
+	errorMessage := "Error during validating"
+	// check for correct sdk
+	if info.Sdk != controller.env.BeamSdkEnvs.ApacheBeamSdk {
+		logger.Errorf("ValidateCode(): request contains incorrect sdk: %s\n", info.Sdk)
+		return nil, errors.InvalidArgumentError(errorMessage, "Incorrect sdk. Want to receive %s, but the request contains %s", controller.env.BeamSdkEnvs.ApacheBeamSdk.String(), info.Sdk.String())
+	}
+	switch info.Sdk {
+	case pb.Sdk_SDK_UNSPECIFIED:
+		logger.Errorf("ValidateCode(): unimplemented sdk: %s\n", info.Sdk)
+		return nil, errors.InvalidArgumentError(errorMessage, "Sdk is not implemented yet: %s", info.Sdk.String())
+	}
+	if err := controller.checkCodeSize(info.Code); err != nil {
+		logger.Errorf("ValidateCode(): too large code: %s\n", err.Error())
+		return nil, errors.LimitExceededError(errorMessage, "Incorrect code: %s", err.Error())
+	}
+
+	pipelineId := uuid.New()
+	lc, err := life_cycle.SetupWithPool(controller.warmPool, info.Sdk, info.Code, pipelineId, controller.env.ApplicationEnvs.WorkingDir(), controller.env.ApplicationEnvs.PipelinesFolder(), controller.env.BeamSdkEnvs.PreparedModDir())
+	if err != nil {
+		logger.Errorf("ValidateCode(): error during setup file system: %s\n", err.Error())
+		return nil, errors.InternalError(errorMessage, "Error during setup file system for the code processing: %s", err.Error())
+	}
+
+	status, output, err := code_processing.CheckSyntax(ctx, controller.cacheService, lc, pipelineId, &controller.env.BeamSdkEnvs)
+	if err != nil {
+		return nil, errors.InternalError(errorMessage, "Error during checking syntax of the code")
+	}
+	if err = controller.cacheService.SetExpTime(ctx, pipelineId, controller.env.ApplicationEnvs.CacheEnvs().KeyExpirationTime()); err != nil {
+		logger.Errorf("%s: ValidateCode(): cache.SetExpTime(): %s\n", pipelineId, err.Error())
+		return nil, errors.InternalError(errorMessage, "Internal error")
+	}
+	return &pb.ValidateCodeResponse{PipelineUuid: pipelineId.String(), Status: status, Output: output}, nil
+}
+
+// ValidateOptions checks pipeline options against options which are known for the sdk without running the code
+func (controller *playgroundController) ValidateOptions(ctx context.Context, info *pb.ValidateOptionsRequest) (*pb.ValidateOptionsResponse, error) {
+	errorMessage := "Error during validating pipeline options"
+	// check for correct sdk
+	if info.Sdk != controller.env.BeamSdkEnvs.ApacheBeamSdk {
+		logger.Errorf("ValidateOptions(): request contains incorrect sdk: %s\n", info.Sdk)
+		return nil, errors.InvalidArgumentError(errorMessage, "Incorrect sdk. Want to receive %s, but the request contains %s", controller.env.BeamSdkEnvs.ApacheBeamSdk.String(), info.Sdk.String())
+	}
+	switch info.Sdk {
+	case pb.Sdk_SDK_UNSPECIFIED:
+		logger.Errorf("ValidateOptions(): unimplemented sdk: %s\n", info.Sdk)
+		return nil, errors.InvalidArgumentError(errorMessage, "Sdk is not implemented yet: %s", info.Sdk.String())
+	}
+	diagnostics := pipeline_options.Validate(info.Sdk, info.PipelineOptions)
+	return &pb.ValidateOptionsResponse{Valid: pipeline_options.IsValid(diagnostics), Diagnostics: diagnostics}, nil
 }
 
 // CheckStatus is checking status for the specific pipeline by PipelineUuid
@@ -166,6 +621,245 @@ func (controller *playgroundController) GetLogs(ctx context.Context, info *pb.Ge
 	return &pipelineResult, nil
 }
 
+// GetRunOutputPage is returning the page of output of execution for specific pipeline by PipelineUuid, Offset and Limit.
+// Unlike GetRunOutput, it doesn't keep the index of the returned output, so pages could be requested in any order.
+func (controller *playgroundController) GetRunOutputPage(ctx context.Context, info *pb.GetRunOutputPageRequest) (*pb.GetRunOutputPageResponse, error) {
+	pipelineId, err := uuid.Parse(info.PipelineUuid)
+	errorMessage := "Error during getting run output of the code processing"
+	if err != nil {
+		logger.Errorf("%s: GetRunOutputPage(): pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid, err.Error())
+		return nil, errors.InvalidArgumentError(errorMessage, "pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid)
+	}
+	output, nextOffset, totalSize, err := code_processing.GetProcessingOutputPage(ctx, controller.cacheService, pipelineId, cache.RunOutput, info.Offset, info.Limit, errorMessage)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetRunOutputPageResponse{Output: output, NextOffset: nextOffset, TotalSize: totalSize}, nil
+}
+
+// StreamOutput streams changes of the status and chunks of the run output of the code processing by PipelineUuid until
+// the code processing is finished. The run output which is produced before the status is changed is sent before the status,
+// so the client has the whole run output when it receives the terminal status.
+// - In case of incorrect uuid returns codes.InvalidArgument
+// - In case the code processing isn't found returns codes.NotFound
+// - In case of an error of the cache returns codes.Internal
+func (controller *playgroundController) StreamOutput(info *pb.StreamOutputRequest, stream pb.PlaygroundService_StreamOutputServer) error {
+	ctx := stream.Context()
+	errorMessage := "Error during streaming output of the code processing"
+	pipelineId, err := uuid.Parse(info.PipelineUuid)
+	if err != nil {
+		logger.Errorf("%s: StreamOutput(): pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid, err.Error())
+		return errors.InvalidArgumentError(errorMessage, "pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid)
+	}
+	subscriptionCtx, cancelSubscription := context.WithCancel(ctx)
+	defer cancelSubscription()
+	statuses, err := controller.cacheService.SubscribeStatus(subscriptionCtx, pipelineId)
+	if err != nil {
+		logger.Errorf("%s: StreamOutput(): cache.SubscribeStatus(): %s\n", pipelineId, err.Error())
+		return errors.InternalError(errorMessage, "Error during subscribing to status of the code processing")
+	}
+	// the initial status is received after the subscription, so changes of the status aren't missed
+	initialStatus, err := code_processing.GetProcessingStatus(ctx, controller.cacheService, pipelineId, errorMessage)
+	if err != nil {
+		return err
+	}
+	return streamOutput(ctx, controller.cacheService, pipelineId, initialStatus, statuses, stream.Send, errorMessage)
+}
+
+// streamOutput sends the new run output and the status of the pipeline at the initial status, on each change of the status
+// which is received from statuses and on each outputCheckInterval. The run output is sent in chunks of at most
+// code_processing.MaxOutputPageSize bytes which don't split UTF-8 characters.
+// Returns when the terminal status is sent (see cache.IsTerminalStatus) or ctx is done.
+func streamOutput(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID, initialStatus pb.Status, statuses <-chan pb.Status, send func(*pb.StreamOutputResponse) error, errorMessage string) error {
+	lastStatus := pb.Status_STATUS_UNSPECIFIED
+	offset := 0
+	sendOutput := func() error {
+		value, err := cacheService.GetValue(ctx, pipelineId, cache.RunOutput)
+		if goerrors.Is(err, cache.ErrNotFound) {
+			// the run output is set when the run step is started
+			return nil
+		}
+		if err != nil {
+			logger.Errorf("%s: StreamOutput(): cache.GetValue: error: %s\n", pipelineId, err.Error())
+			return errors.InternalError(errorMessage, "Error during getting output")
+		}
+		output, _ := value.(string)
+		if len(output) < offset {
+			// the run output is reset when the run step is started
+			offset = 0
+		}
+		for offset < len(output) {
+			end := offset + code_processing.MaxOutputPageSize
+			if end >= len(output) {
+				end = len(output)
+			} else {
+				// the chunk isn't empty even if the output isn't valid UTF-8
+				for end > offset+1 && !utf8.RuneStart(output[end]) {
+					end--
+				}
+			}
+			if err := send(&pb.StreamOutputResponse{RunOutput: output[offset:end]}); err != nil {
+				logger.Errorf("%s: StreamOutput(): error during sending output: %s\n", pipelineId, err.Error())
+				return err
+			}
+			offset = end
+		}
+		return nil
+	}
+	sendStatus := func(status pb.Status) error {
+		if err := sendOutput(); err != nil {
+			return err
+		}
+		if status == lastStatus {
+			return nil
+		}
+		lastStatus = status
+		if err := send(&pb.StreamOutputResponse{Status: status}); err != nil {
+			logger.Errorf("%s: StreamOutput(): error during sending status: %s\n", pipelineId, err.Error())
+			return err
+		}
+		return nil
+	}
+
+	if err := sendStatus(initialStatus); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(outputCheckInterval)
+	defer ticker.Stop()
+	for !cache.IsTerminalStatus(lastStatus) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case status, ok := <-statuses:
+			if !ok {
+				return ctx.Err()
+			}
+			if err := sendStatus(status); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			// only the terminal status is sent, since other statuses could be received by the subscription later
+			status := lastStatus
+			if current := getStatus(ctx, cacheService, pipelineId); cache.IsTerminalStatus(current) {
+				status = current
+			}
+			if err := sendStatus(status); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GetWorkingDir streams the zip archive of the working directory of the code processing by PipelineUuid in chunks.
+// The working directory is kept only if the request of the code processing has KeepWorkingDir, and it is available
+// since the code processing is finished until the retention of working directories is expired.
+// Files which match exclude patterns of working directories and environment variables of the code aren't archived.
+// - In case of incorrect uuid returns codes.InvalidArgument
+// - In case the working directory isn't kept returns codes.NotFound
+// - In case of an error during archiving returns codes.Internal
+func (controller *playgroundController) GetWorkingDir(info *pb.GetWorkingDirRequest, stream pb.PlaygroundService_GetWorkingDirServer) error {
+	errorMessage := "Error during getting working directory of the code processing"
+	pipelineId, err := uuid.Parse(info.PipelineUuid)
+	if err != nil {
+		logger.Errorf("%s: GetWorkingDir(): pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid, err.Error())
+		return errors.InvalidArgumentError(errorMessage, "pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid)
+	}
+	appEnv := controller.env.ApplicationEnvs
+	pipelinesFolder := filepath.Join(appEnv.WorkingDir(), appEnv.PipelinesFolder())
+	folder, err := fs_tool.GetRetainedFolder(pipelinesFolder, pipelineId, appEnv.WorkingDirEnvs().Retention())
+	if goerrors.Is(err, fs_tool.ErrNotRetained) {
+		logger.Errorf("%s: GetWorkingDir(): %s\n", pipelineId, err.Error())
+		return errors.NotFoundError(errorMessage, "Working directory of the code isn't kept")
+	}
+	if err != nil {
+		logger.Errorf("%s: GetWorkingDir(): %s\n", pipelineId, err.Error())
+		return errors.InternalError(errorMessage, "Error during getting working directory")
+	}
+	// environment variables of the code could contain credentials, so they are never downloaded
+	excludePatterns := append([]string{env_vars.FileName}, appEnv.WorkingDirEnvs().ExcludePatterns()...)
+	writer := bufio.NewWriterSize(&chunkWriter{send: stream.Send}, workingDirChunkSize)
+	if err = fs_tool.ZipFolder(writer, folder, excludePatterns); err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		logger.Errorf("%s: GetWorkingDir(): error during archiving working directory: %s\n", pipelineId, err.Error())
+		return errors.InternalError(errorMessage, "Error during archiving working directory")
+	}
+	return nil
+}
+
+// chunkWriter sends written data as chunks of the working directory archive of at most workingDirChunkSize bytes
+type chunkWriter struct {
+	send func(*pb.GetWorkingDirResponse) error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for written < len(p) {
+		end := written + workingDirChunkSize
+		if end > len(p) {
+			end = len(p)
+		}
+		// the chunk is copied since p could be reused by the caller after Write returns
+		if err := w.send(&pb.GetWorkingDirResponse{Chunk: append([]byte(nil), p[written:end]...)}); err != nil {
+			return written, err
+		}
+		written = end
+	}
+	return written, nil
+}
+
+// GetLogEntries is returning structured entries of logs of the code processing for specific pipeline by PipelineUuid.
+// Entries are filtered by the min severity and the time range of the request.
+func (controller *playgroundController) GetLogEntries(ctx context.Context, info *pb.GetLogEntriesRequest) (*pb.GetLogEntriesResponse, error) {
+	pipelineId, err := uuid.Parse(info.PipelineUuid)
+	errorMessage := "Error during getting log entries of the code processing"
+	if err != nil {
+		logger.Errorf("%s: GetLogEntries(): pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid, err.Error())
+		return nil, errors.InvalidArgumentError(errorMessage, "pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid)
+	}
+	var start, end time.Time
+	if info.StartTime != nil {
+		start = info.StartTime.AsTime()
+	}
+	if info.EndTime != nil {
+		end = info.EndTime.AsTime()
+	}
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return nil, errors.InvalidArgumentError(errorMessage, "end time %s is before start time %s", end, start)
+	}
+	entries, err := code_processing.GetLogEntries(ctx, controller.cacheService, pipelineId, errorMessage)
+	if err != nil {
+		return nil, err
+	}
+	filtered := log_entries.Filter(entries, info.MinSeverity, start, end)
+	response := &pb.GetLogEntriesResponse{Entries: make([]*pb.LogEntry, 0, len(filtered))}
+	for _, entry := range filtered {
+		logEntry := &pb.LogEntry{Severity: entry.Severity, Message: entry.Message}
+		if entry.Timestamp != 0 {
+			logEntry.Timestamp = timestamppb.New(time.Unix(0, entry.Timestamp))
+		}
+		response.Entries = append(response.Entries, logEntry)
+	}
+	return response, nil
+}
+
+// GetLogsPage is returning the page of logs of execution for specific pipeline by PipelineUuid, Offset and Limit.
+func (controller *playgroundController) GetLogsPage(ctx context.Context, info *pb.GetLogsPageRequest) (*pb.GetLogsPageResponse, error) {
+	pipelineId, err := uuid.Parse(info.PipelineUuid)
+	errorMessage := "Error during getting logs of the code processing"
+	if err != nil {
+		logger.Errorf("%s: GetLogsPage(): pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid, err.Error())
+		return nil, errors.InvalidArgumentError(errorMessage, "pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid)
+	}
+	output, nextOffset, totalSize, err := code_processing.GetProcessingOutputPage(ctx, controller.cacheService, pipelineId, cache.Logs, info.Offset, info.Limit, errorMessage)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetLogsPageResponse{Output: output, NextOffset: nextOffset, TotalSize: totalSize}, nil
+}
+
 // GetRunError is returning error output of execution for specific pipeline by PipelineUuid
 func (controller *playgroundController) GetRunError(ctx context.Context, info *pb.GetRunErrorRequest) (*pb.GetRunErrorResponse, error) {
 	pipelineId, err := uuid.Parse(info.PipelineUuid)
@@ -211,7 +905,8 @@ func (controller *playgroundController) GetPreparationOutput(ctx context.Context
 	return &pb.GetPreparationOutputResponse{Output: preparationOutput}, nil
 }
 
-//GetCompileOutput is returning output of compilation for specific pipeline by PipelineUuid
+//GetCompileOutput is returning output of compilation for specific pipeline by PipelineUuid.
+//The output is also parsed to diagnostics with positions in the code by the compiler of the SDK.
 func (controller *playgroundController) GetCompileOutput(ctx context.Context, info *pb.GetCompileOutputRequest) (*pb.GetCompileOutputResponse, error) {
 	pipelineId, err := uuid.Parse(info.PipelineUuid)
 	errorMessage := "Error during getting compilation output"
@@ -223,25 +918,62 @@ func (controller *playgroundController) GetCompileOutput(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	return &pb.GetCompileOutputResponse{Output: compileOutput}, nil
+	diagnostics := compile_diagnostics.Parse(controller.env.BeamSdkEnvs.ApacheBeamSdk, compileOutput)
+	return &pb.GetCompileOutputResponse{Output: compileOutput, Diagnostics: diagnostics}, nil
 }
 
-//GetGraph is returning graph of execution for specific pipeline by PipelineUuid
+//GetExecutionCommand is returning command lines which are run to compile and run the code for specific pipeline by PipelineUuid.
+//Values of credentials are redacted before command lines are saved to the cache.
+func (controller *playgroundController) GetExecutionCommand(ctx context.Context, info *pb.GetExecutionCommandRequest) (*pb.GetExecutionCommandResponse, error) {
+	pipelineId, err := uuid.Parse(info.PipelineUuid)
+	errorMessage := "Error during getting execution command"
+	if err != nil {
+		logger.Errorf("%s: GetExecutionCommand(): pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid, err.Error())
+		return nil, errors.InvalidArgumentError(errorMessage, "pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid)
+	}
+	command, err := code_processing.GetProcessingOutput(ctx, controller.cacheService, pipelineId, cache.ExecutionCommand, errorMessage)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetExecutionCommandResponse{Command: command}, nil
+}
+
+//GetGraph is returning graph of execution for specific pipeline by PipelineUuid in DOT format and as JSON.
+//If the graph isn't generated yet, the response isn't ready.
+//The graph is read from the cache once per request since it is needed both in DOT format and as it is kept.
 func (controller *playgroundController) GetGraph(ctx context.Context, info *pb.GetGraphRequest) (*pb.GetGraphResponse, error) {
+	ctx, clearMemo := memo.NewContext(ctx)
+	defer clearMemo()
 	pipelineId, err := uuid.Parse(info.PipelineUuid)
 	errorMessage := "Error during getting graph output"
 	if err != nil {
 		logger.Errorf("%s: GetGraph(): pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid, err.Error())
 		return nil, errors.InvalidArgumentError(errorMessage, "pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid)
 	}
+	dot, structuredGraph, ready, err := code_processing.GetPipelineGraph(ctx, controller.cacheService, pipelineId, errorMessage)
+	if err != nil {
+		return nil, err
+	}
+	if !ready {
+		return &pb.GetGraphResponse{Ready: false}, nil
+	}
 	graph, err := code_processing.GetGraph(ctx, controller.cacheService, pipelineId, errorMessage)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.GetGraphResponse{Graph: graph}, nil
+	response := pb.GetGraphResponse{Graph: graph, Ready: true, Dot: dot}
+	if structuredGraph != nil {
+		graphJson, err := json.Marshal(structuredGraph)
+		if err != nil {
+			logger.Errorf("%s: GetGraph(): json.Marshal: error: %s", pipelineId, err.Error())
+			return nil, errors.InternalError(errorMessage, "Error during getting graph")
+		}
+		response.Json = string(graphJson)
+	}
+	return &response, nil
 }
 
-// Cancel is setting cancel flag to stop code processing
+// Cancel is setting cancel flag and status to stop code processing and kills the process of the code processing
 func (controller *playgroundController) Cancel(ctx context.Context, info *pb.CancelRequest) (*pb.CancelResponse, error) {
 	pipelineId, err := uuid.Parse(info.PipelineUuid)
 	errorMessage := "Error during canceling the code processing"
@@ -249,16 +981,31 @@ func (controller *playgroundController) Cancel(ctx context.Context, info *pb.Can
 		logger.Errorf("%s: Cancel(): pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid, err.Error())
 		return nil, errors.InvalidArgumentError(errorMessage, "pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid)
 	}
-	if err := utils.SetToCache(ctx, controller.cacheService, pipelineId, cache.Canceled, true); err != nil {
+	if err := code_processing.Cancel(ctx, controller.cacheService, pipelineId); err != nil {
 		return nil, errors.InternalError(errorMessage, "Error during saving cancel flag value")
 	}
 	return &pb.CancelResponse{}, nil
 }
 
+// GetMetadata is returning names, sizes and time to live of all currently available values of the pipeline by PipelineUuid,
+// so the client could request only the values which are ready
+func (controller *playgroundController) GetMetadata(ctx context.Context, info *pb.GetMetadataRequest) (*pb.GetMetadataResponse, error) {
+	pipelineId, err := uuid.Parse(info.PipelineUuid)
+	errorMessage := "Error during getting metadata of the code processing"
+	if err != nil {
+		logger.Errorf("%s: GetMetadata(): pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid, err.Error())
+		return nil, errors.InvalidArgumentError(errorMessage, "pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid)
+	}
+	subKeys, err := code_processing.GetMetadata(ctx, controller.cacheService, pipelineId, errorMessage)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMetadataResponse{SubKeys: subKeys}, nil
+}
+
 // GetPrecompiledObjects returns the list of examples
 func (controller *playgroundController) GetPrecompiledObjects(ctx context.Context, info *pb.GetPrecompiledObjectsRequest) (*pb.GetPrecompiledObjectsResponse, error) {
-	bucket := cloud_bucket.New()
-	sdkToCategories, err := bucket.GetPrecompiledObjects(ctx, info.Sdk, info.Category)
+	sdkToCategories, err := controller.examplesStorage.GetPrecompiledObjects(ctx, info.Sdk, info.Category)
 	if err != nil {
 		logger.Errorf("GetPrecompiledObjects(): cloud storage error: %s", err.Error())
 		return nil, errors.InternalError("Error during getting Precompiled Objects", "Error with cloud connection")
@@ -274,6 +1021,41 @@ func (controller *playgroundController) GetPrecompiledObjects(ctx context.Contex
 	return &response, nil
 }
 
+// GetPrecompiledObject returns the example with its code and precompiled outputs without running it
+// - In case of the empty cloud path returns codes.InvalidArgument
+// - In case of the example isn't found returns codes.NotFound
+// - In case of an error of the examples storage returns codes.Internal
+func (controller *playgroundController) GetPrecompiledObject(ctx context.Context, info *pb.GetPrecompiledObjectRequest) (*pb.GetPrecompiledObjectResponse, error) {
+	errorMessage := "Error during getting Precompiled Object"
+	if info.CloudPath == "" {
+		return nil, errors.InvalidArgumentError(errorMessage, "Cloud path of the example is empty")
+	}
+	data, err := controller.examplesStorage.GetPrecompiledObjectData(ctx, info.CloudPath)
+	if goerrors.Is(err, cloud_bucket.ErrPrecompiledObjectNotFound) {
+		return nil, errors.NotFoundError(errorMessage, "Example %s is not found", info.CloudPath)
+	}
+	if err != nil {
+		logger.Errorf("GetPrecompiledObject(): cloud storage error: %s", err.Error())
+		return nil, errors.InternalError(errorMessage, "Error with cloud connection")
+	}
+	return &pb.GetPrecompiledObjectResponse{
+		PrecompiledObject: &pb.PrecompiledObject{
+			CloudPath:       data.Info.CloudPath,
+			Name:            data.Info.Name,
+			Description:     data.Info.Description,
+			Type:            data.Info.Type,
+			PipelineOptions: data.Info.PipelineOptions,
+			Link:            data.Info.Link,
+		},
+		Sdk:              data.Sdk,
+		Code:             data.Code,
+		CompileOutput:    data.CompileOutput,
+		RunOutput:        data.RunOutput,
+		HasCompileOutput: data.HasCompileOutput,
+		HasRunOutput:     data.HasRunOutput,
+	}, nil
+}
+
 // GetPrecompiledObjectCode returns the code of the specific example
 func (controller *playgroundController) GetPrecompiledObjectCode(ctx context.Context, info *pb.GetPrecompiledObjectCodeRequest) (*pb.GetPrecompiledObjectCodeResponse, error) {
 	cd := cloud_bucket.New()
@@ -309,3 +1091,127 @@ func (controller *playgroundController) GetPrecompiledObjectLogs(ctx context.Con
 	response := pb.GetPrecompiledObjectLogsResponse{Output: logs}
 	return &response, nil
 }
+
+// SearchExamples returns the page of examples of the SDKs and the category which names or descriptions contain the term
+// - In case of the offset is out of found examples returns codes.InvalidArgument
+// - In case of an error of the examples storage returns codes.Internal
+func (controller *playgroundController) SearchExamples(ctx context.Context, info *pb.SearchExamplesRequest) (*pb.SearchExamplesResponse, error) {
+	errorMessage := "Error during searching examples"
+	targetSdk := pb.Sdk_SDK_UNSPECIFIED
+	if len(info.Sdks) == 1 {
+		// the only SDK is filtered by the storage, otherwise examples of all SDKs are filtered after they are received
+		targetSdk = info.Sdks[0]
+	}
+	sdkToCategories, err := controller.examplesStorage.GetPrecompiledObjects(ctx, targetSdk, info.Category)
+	if err != nil {
+		logger.Errorf("SearchExamples(): cloud storage error: %s", err.Error())
+		return nil, errors.InternalError(errorMessage, "Error with cloud connection")
+	}
+	examples := utils.SearchPrecompiledObjects(sdkToCategories, info.Sdks, info.Term)
+	page, nextOffset, err := utils.GetPrecompiledObjectsPage(examples, info.Offset, info.Limit)
+	if err != nil {
+		logger.Errorf("SearchExamples(): %s", err.Error())
+		return nil, errors.InvalidArgumentError(errorMessage, "%s", err.Error())
+	}
+	return &pb.SearchExamplesResponse{PrecompiledObjects: page, NextOffset: nextOffset, TotalCount: int32(len(examples))}, nil
+}
+
+// SaveSnippet saves the code which is shared by the user and returns the id of the snippet
+// - In case of the empty code, unspecified sdk or the snippet which exceeds the max size returns codes.InvalidArgument
+// - In case of an error of the store of snippets returns codes.Internal
+func (controller *playgroundController) SaveSnippet(ctx context.Context, info *pb.SaveSnippetRequest) (*pb.SaveSnippetResponse, error) {
+	errorMessage := "Error during saving the snippet"
+	if info.Sdk == pb.Sdk_SDK_UNSPECIFIED {
+		logger.Errorf("SaveSnippet(): unspecified sdk\n")
+		return nil, errors.InvalidArgumentError(errorMessage, "Sdk of the snippet isn't specified")
+	}
+	if info.Code == "" {
+		logger.Errorf("SaveSnippet(): empty code\n")
+		return nil, errors.InvalidArgumentError(errorMessage, "Code of the snippet is empty")
+	}
+	snippetEnvs := controller.env.ApplicationEnvs.SnippetEnvs()
+	snippet := &snippets.Snippet{Sdk: info.Sdk, Code: info.Code, PipelineOptions: info.PipelineOptions}
+	if snippet.Size() > snippetEnvs.MaxSize() {
+		logger.Errorf("SaveSnippet(): snippet of %d bytes exceeds the max size\n", snippet.Size())
+		return nil, errors.InvalidArgumentError(errorMessage, "Size of the snippet is %d bytes, but the max size is %d bytes", snippet.Size(), snippetEnvs.MaxSize())
+	}
+	id, err := snippets.Save(ctx, controller.snippets, snippet, snippetEnvs.Retention())
+	if err != nil {
+		logger.Errorf("SaveSnippet(): store error: %s\n", err.Error())
+		return nil, errors.InternalError(errorMessage, "Error during saving the snippet to the store")
+	}
+	return &pb.SaveSnippetResponse{Id: id}, nil
+}
+
+// GetSnippet returns the code of the shared snippet by its id
+// - In case of the id which couldn't be parsed returns codes.InvalidArgument
+// - In case of the snippet doesn't exist or is expired returns codes.NotFound
+// - In case of other errors of the store of snippets returns codes.Internal
+func (controller *playgroundController) GetSnippet(ctx context.Context, info *pb.GetSnippetRequest) (*pb.GetSnippetResponse, error) {
+	errorMessage := "Error during getting the snippet"
+	snippet, err := snippets.Get(ctx, controller.snippets, info.Id)
+	if err != nil {
+		logger.Errorf("%s: GetSnippet(): error during getting the snippet: %s\n", info.Id, err.Error())
+		switch {
+		case goerrors.Is(err, snippets.ErrInvalidId):
+			return nil, errors.InvalidArgumentError(errorMessage, "id has incorrect value: %s", info.Id)
+		case goerrors.Is(err, snippets.ErrNotFound):
+			return nil, errors.NotFoundError(errorMessage, "Snippet %s isn't found", info.Id)
+		default:
+			return nil, errors.InternalError(errorMessage, "Error during getting the snippet from the store")
+		}
+	}
+	return &pb.GetSnippetResponse{Code: snippet.Code, Sdk: snippet.Sdk, PipelineOptions: snippet.PipelineOptions}, nil
+}
+
+// GetUsageMetrics returns numbers of runs per SDK and example during the range of dates of the request
+// - In case of usage metrics aren't collected by the server returns codes.Unavailable
+// - In case of incorrect dates or the range which is longer than maxUsageMetricsDays returns codes.InvalidArgument
+// - In case of errors of the store of usage metrics returns codes.Internal
+func (controller *playgroundController) GetUsageMetrics(ctx context.Context, info *pb.GetUsageMetricsRequest) (*pb.GetUsageMetricsResponse, error) {
+	errorMessage := "Error during getting usage metrics"
+	if controller.usageMetrics == nil {
+		logger.Errorf("GetUsageMetrics(): usage metrics aren't collected\n")
+		return nil, errors.UnavailableError(errorMessage, "Usage metrics aren't collected by the server")
+	}
+	to := time.Now().UTC()
+	if info.ToDate != "" {
+		date, err := time.Parse(usage_metrics.DateLayout, info.ToDate)
+		if err != nil {
+			logger.Errorf("GetUsageMetrics(): incorrect to date: %s\n", info.ToDate)
+			return nil, errors.InvalidArgumentError(errorMessage, "to_date has incorrect value: %s, want the date like %s", info.ToDate, usage_metrics.DateLayout)
+		}
+		to = date
+	}
+	from := to.AddDate(0, 0, 1-defaultUsageMetricsDays)
+	if info.FromDate != "" {
+		date, err := time.Parse(usage_metrics.DateLayout, info.FromDate)
+		if err != nil {
+			logger.Errorf("GetUsageMetrics(): incorrect from date: %s\n", info.FromDate)
+			return nil, errors.InvalidArgumentError(errorMessage, "from_date has incorrect value: %s, want the date like %s", info.FromDate, usage_metrics.DateLayout)
+		}
+		from = date
+	}
+	fromDate, toDate := from.Format(usage_metrics.DateLayout), to.Format(usage_metrics.DateLayout)
+	if fromDate > toDate {
+		logger.Errorf("GetUsageMetrics(): from date %s is after to date %s\n", fromDate, toDate)
+		return nil, errors.InvalidArgumentError(errorMessage, "from_date %s is after to_date %s", fromDate, toDate)
+	}
+	if fromDate < to.AddDate(0, 0, 1-maxUsageMetricsDays).Format(usage_metrics.DateLayout) {
+		logger.Errorf("GetUsageMetrics(): too long range from %s to %s\n", fromDate, toDate)
+		return nil, errors.InvalidArgumentError(errorMessage, "The range of dates should contain at most %d days", maxUsageMetricsDays)
+	}
+	counters, err := controller.usageMetrics.Query(ctx, fromDate, toDate)
+	if err != nil {
+		logger.Errorf("GetUsageMetrics(): error during querying counters: %s\n", err.Error())
+		return nil, errors.InternalError(errorMessage, "Error during reading usage metrics")
+	}
+	response := usage_metrics.Aggregate(counters)
+	response.FromDate, response.ToDate = fromDate, toDate
+	return response, nil
+}
+
+// ListSDKs returns all known SDKs, versions of their toolchains and whether they are available on the server
+func (controller *playgroundController) ListSDKs(ctx context.Context, info *pb.ListSdksRequest) (*pb.ListSdksResponse, error) {
+	return &pb.ListSdksResponse{Sdks: controller.sdks}, nil
+}
@@ -15,16 +15,32 @@
 package main
 
 import (
+	"archive/zip"
 	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/cache"
 	"beam.apache.org/playground/backend/internal/cache/local"
+	"beam.apache.org/playground/backend/internal/cache/memo"
+	"beam.apache.org/playground/backend/internal/cloud_bucket"
+	"beam.apache.org/playground/backend/internal/code_processing"
 	"beam.apache.org/playground/backend/internal/environment"
+	"beam.apache.org/playground/backend/internal/errors"
+	"beam.apache.org/playground/backend/internal/fs_tool"
+	"beam.apache.org/playground/backend/internal/snippets"
+	"beam.apache.org/playground/backend/internal/source_cache"
+	"beam.apache.org/playground/backend/internal/usage_metrics"
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/google/uuid"
 	"go.uber.org/goleak"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
 	"io/fs"
 	"log"
 	"net"
@@ -32,8 +48,10 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"time"
+	"unicode/utf8"
 )
 
 const (
@@ -46,6 +64,7 @@ const (
 
 var lis *bufconn.Listener
 var cacheService cache.Cache
+var snippetsStore snippets.Store
 var opt goleak.Option
 
 func TestMain(m *testing.M) {
@@ -79,6 +98,7 @@ func setup() *grpc.Server {
 
 	// setup cache
 	cacheService = local.New(context.Background())
+	snippetsStore = snippets.NewCacheStore(cacheService)
 
 	path, err := os.Getwd()
 	if err != nil {
@@ -104,8 +124,10 @@ func setup() *grpc.Server {
 		panic(err)
 	}
 	pb.RegisterPlaygroundServiceServer(s, &playgroundController{
-		env:          environment.NewEnvironment(*networkEnv, *sdkEnv, *appEnv),
-		cacheService: cacheService,
+		env:             environment.NewEnvironment(*networkEnv, *sdkEnv, *appEnv),
+		cacheService:    cacheService,
+		examplesStorage: cloud_bucket.New(),
+		snippets:        snippetsStore,
 	})
 	go func() {
 		if err := s.Serve(lis); err != nil {
@@ -157,6 +179,89 @@ func TestPlaygroundController_RunCode(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			// Test case with calling RunCode method with requirements of the code which sdk isn't SDK_PYTHON.
+			// As a result, want to receive an error.
+			name: "RunCode with requirements of not python code",
+			args: args{
+				ctx: context.Background(),
+				request: &pb.RunCodeRequest{
+					Code:         "MOCK_CODE",
+					Sdk:          pb.Sdk_SDK_JAVA,
+					Requirements: []string{"numpy"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			// Test case with calling RunCode method with the environment variable which can't be overridden.
+			// As a result, want to receive an error.
+			name: "RunCode with denied environment variable",
+			args: args{
+				ctx: context.Background(),
+				request: &pb.RunCodeRequest{
+					Code:    "MOCK_CODE",
+					Sdk:     pb.Sdk_SDK_JAVA,
+					EnvVars: map[string]string{"PATH": "/tmp"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			// Test case with calling RunCode method with the runner which isn't supported by the SDK.
+			// As a result, want to receive an error.
+			name: "RunCode with unsupported runner",
+			args: args{
+				ctx: context.Background(),
+				request: &pb.RunCodeRequest{
+					Code:   "MOCK_CODE",
+					Sdk:    pb.Sdk_SDK_JAVA,
+					Runner: "flink",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			// Test case with calling RunCode method with the negative deadline.
+			// As a result, want to receive an error.
+			name: "RunCode with negative deadline",
+			args: args{
+				ctx: context.Background(),
+				request: &pb.RunCodeRequest{
+					Code:     "MOCK_CODE",
+					Sdk:      pb.Sdk_SDK_JAVA,
+					Deadline: durationpb.New(-time.Second),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			// Test case with calling RunCode method with the code which is larger than the max size of the file of the code.
+			// As a result, want to receive an error.
+			name: "RunCode with too large code",
+			args: args{
+				ctx: context.Background(),
+				request: &pb.RunCodeRequest{
+					Code: strings.Repeat("a", 512*1024+1),
+					Sdk:  pb.Sdk_SDK_JAVA,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			// Test case with calling RunCode method with the input which is larger than fs_tool.MaxStdinSize.
+			// As a result, want to receive an error.
+			name: "RunCode with too large input",
+			args: args{
+				ctx: context.Background(),
+				request: &pb.RunCodeRequest{
+					Code:  "MOCK_CODE",
+					Sdk:   pb.Sdk_SDK_JAVA,
+					Stdin: strings.Repeat("a", fs_tool.MaxStdinSize+1),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			// Test case with calling RunCode method with correct SDK.
 			// As a result, want to receive response with pipelineId and status into cache should be set as Status_STATUS_COMPILING.
@@ -202,11 +307,16 @@ func TestPlaygroundController_RunCode(t *testing.T) {
 	}
 }
 
-func TestPlaygroundController_CheckStatus(t *testing.T) {
-	defer goleak.VerifyNone(t, opt)
+func TestPlaygroundController_RunCodeSnippet(t *testing.T) {
 	ctx := context.Background()
-	pipelineId := uuid.New()
-	wantStatus := pb.Status_STATUS_FINISHED
+	javaSnippet, err := snippets.Save(ctx, snippetsStore, &snippets.Snippet{Sdk: pb.Sdk_SDK_JAVA, Code: "MOCK_CODE"}, time.Minute)
+	if err != nil {
+		t.Fatalf("error during saving the snippet: %s", err.Error())
+	}
+	goSnippet, err := snippets.Save(ctx, snippetsStore, &snippets.Snippet{Sdk: pb.Sdk_SDK_GO, Code: "MOCK_CODE"}, time.Minute)
+	if err != nil {
+		t.Fatalf("error during saving the snippet: %s", err.Error())
+	}
 	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
 	if err != nil {
 		t.Fatalf("Failed to dial bufnet: %v", err)
@@ -214,78 +324,135 @@ func TestPlaygroundController_CheckStatus(t *testing.T) {
 	defer conn.Close()
 	client := pb.NewPlaygroundServiceClient(conn)
 
-	type args struct {
-		ctx     context.Context
-		request *pb.CheckStatusRequest
-	}
 	tests := []struct {
-		name       string
-		prepare    func()
-		args       args
-		wantStatus *pb.Status
-		wantErr    bool
+		name     string
+		request  *pb.RunCodeRequest
+		wantCode codes.Code
 	}{
 		{
-			// Test case with calling CheckStatus method with incorrect pipelineId.
-			// As a result, want to receive an error
-			name:    "incorrect pipelineId",
-			prepare: func() {},
-			args: args{
-				ctx:     ctx,
-				request: &pb.CheckStatusRequest{PipelineUuid: "NO_UUID_STRING"},
-			},
-			wantStatus: nil,
-			wantErr:    true,
+			// Test case with calling RunCode method with both the code and the id of the snippet.
+			// As a result, want to receive the InvalidArgument error.
+			name:     "code and snippet",
+			request:  &pb.RunCodeRequest{Code: "MOCK_CODE", Sdk: pb.Sdk_SDK_JAVA, SnippetId: javaSnippet},
+			wantCode: codes.InvalidArgument,
 		},
 		{
-			// Test case with calling CheckStatus method with pipelineId which doesn't exist.
-			// As a result, want to receive an error.
-			name:    "status is not set",
-			prepare: func() {},
-			args: args{
-				ctx:     ctx,
-				request: &pb.CheckStatusRequest{PipelineUuid: pipelineId.String()},
-			},
-			wantStatus: nil,
-			wantErr:    true,
+			// Test case with calling RunCode method with the snippet which isn't saved.
+			// As a result, want to receive the NotFound error.
+			name:     "snippet doesn't exist",
+			request:  &pb.RunCodeRequest{SnippetId: "AAAAAAAAAAAAAAAAAAAAAA"},
+			wantCode: codes.NotFound,
 		},
 		{
-			// Test case with calling CheckStatus method with pipelineId which contains status.
-			// As a result, want to receive an expected status.
-			name: "status exists",
-			prepare: func() {
-				_ = cacheService.SetValue(ctx, pipelineId, cache.Status, wantStatus)
-			},
-			args: args{
-				ctx:     ctx,
-				request: &pb.CheckStatusRequest{PipelineUuid: pipelineId.String()},
-			},
-			wantStatus: &wantStatus,
-			wantErr:    false,
+			// Test case with calling RunCode method with the id of the snippet which couldn't be parsed.
+			// As a result, want to receive the InvalidArgument error.
+			name:     "incorrect id",
+			request:  &pb.RunCodeRequest{SnippetId: "MOCK_ID"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			// Test case with calling RunCode method with the sdk which differs from the sdk of the snippet.
+			// As a result, want to receive the InvalidArgument error.
+			name:     "sdk of another snippet",
+			request:  &pb.RunCodeRequest{Sdk: pb.Sdk_SDK_GO, SnippetId: javaSnippet},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			// Test case with calling RunCode method with the snippet of the sdk which the server doesn't run.
+			// As a result, want to receive the InvalidArgument error.
+			name:     "snippet of another sdk",
+			request:  &pb.RunCodeRequest{SnippetId: goSnippet},
+			wantCode: codes.InvalidArgument,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.prepare()
-			got, err := client.CheckStatus(ctx, tt.args.request)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("PlaygroundController_CheckStatus() error = %v, wantErr %v", err, tt.wantErr)
-			}
-			if got == nil && tt.wantStatus != nil {
-				t.Errorf("PlaygroundController_CheckStatus() return = %v, want response with status %v", got, tt.wantStatus)
-			}
-			if got != nil && !reflect.DeepEqual(got.Status, *tt.wantStatus) {
-				t.Errorf("PlaygroundController_CheckStatus() return status = %v, want status %v", got.Status, tt.wantStatus)
+			_, err := client.RunCode(ctx, tt.request)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("PlaygroundController_RunCode() error = %v, want code %v", err, tt.wantCode)
 			}
 		})
 	}
 }
 
-func TestPlaygroundController_GetCompileOutput(t *testing.T) {
+func TestPlaygroundController_RunProgramSnippetConcurrently(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("error during get working directory: %s", err.Error())
+	}
+	executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+	appEnv := environment.NewApplicationEnvs(workingDir, "", "", baseFileFolder, environment.NewCacheEnvs("local", "", time.Minute, 0, 0, 0, "", false), time.Minute, 0, environment.NewQueueEnvs(0, 0), environment.NewSnippetEnvs(0, 0), environment.NewRateLimitEnvs(0, map[string]int{}, nil), environment.NewWorkingDirEnvs(0, nil), environment.NewCodeEnvs(0, 0))
+	controller := &playgroundController{
+		env:          environment.NewEnvironment(environment.NetworkEnvs{}, *sdkEnv, *appEnv),
+		cacheService: cacheService,
+		snippets:     snippetsStore,
+	}
+	// the code keeps the input in the file of its working directory for a while, so runs which share the working
+	// directory would print the input of each other
+	code := "import sys\nimport time\n\nif __name__ == \"__main__\":\n    open('input.txt', 'w').write(sys.stdin.read())\n    time.sleep(0.5)\n    print(open('input.txt').read(), *sys.argv[1:])\n"
+	snippetId, err := snippets.Save(ctx, snippetsStore, &snippets.Snippet{Sdk: pb.Sdk_SDK_PYTHON, Code: code, PipelineOptions: "--mode=snippet"}, time.Minute)
+	if err != nil {
+		t.Fatalf("error during saving the snippet: %s", err.Error())
+	}
+
+	const runs = 5
+	streams := make([]*mockRunProgramServer, runs)
+	errs := make([]error, runs)
+	var wg sync.WaitGroup
+	for i := 0; i < runs; i++ {
+		streams[i] = &mockRunProgramServer{ctx: ctx}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = controller.RunProgram(&pb.RunCodeRequest{SnippetId: snippetId, Stdin: fmt.Sprintf("run %d", i)}, streams[i])
+		}(i)
+	}
+	wg.Wait()
+	controller.runs.Wait()
+
+	pipelineIds := make(map[string]bool, runs)
+	for i := 0; i < runs; i++ {
+		if errs[i] != nil {
+			t.Fatalf("PlaygroundController_RunProgram() run %d error = %v", i, errs[i])
+		}
+		responses := streams[i].responses
+		if len(responses) == 0 || responses[len(responses)-1].Status != pb.Status_STATUS_FINISHED {
+			t.Fatalf("PlaygroundController_RunProgram() run %d responses = %v, want STATUS_FINISHED at the end", i, responses)
+		}
+		pipelineId := responses[0].PipelineUuid
+		if pipelineIds[pipelineId] {
+			t.Errorf("PlaygroundController_RunProgram() run %d has the pipeline uuid %s of another run", i, pipelineId)
+		}
+		pipelineIds[pipelineId] = true
+		runOutput, err := cacheService.GetValue(ctx, uuid.MustParse(pipelineId), cache.RunOutput)
+		if err != nil {
+			t.Fatalf("error during get run output: %s", err.Error())
+		}
+		if want := fmt.Sprintf("run %d --mode=snippet\n", i); runOutput != want {
+			t.Errorf("PlaygroundController_RunProgram() run %d output = %q, want %q", i, runOutput, want)
+		}
+	}
+}
+
+func TestPlaygroundController_RunCodeReusesResults(t *testing.T) {
 	defer goleak.VerifyNone(t, opt)
 	ctx := context.Background()
-	pipelineId := uuid.New()
-	compileOutput := "MOCK_COMPILE_OUTPUT"
+	code := "class Cached {\n}\n"
+	// Results of the identical code which was processed before
+	sourceId := source_cache.Key(pb.Sdk_SDK_JAVA, code, "", nil, "", nil, "")
+	err := cacheService.SetValues(ctx, sourceId, map[cache.SubKey]interface{}{
+		cache.RunResultKept: true,
+		cache.CompileOutput: "",
+		cache.RunOutput:     "MOCK_CACHED_RUN_OUTPUT",
+		cache.RunError:      "",
+		cache.Logs:          "",
+	})
+	if err != nil {
+		panic(err)
+	}
 	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
 	if err != nil {
 		t.Fatalf("Failed to dial bufnet: %v", err)
@@ -293,296 +460,2386 @@ func TestPlaygroundController_GetCompileOutput(t *testing.T) {
 	defer conn.Close()
 	client := pb.NewPlaygroundServiceClient(conn)
 
-	type args struct {
-		ctx  context.Context
-		info *pb.GetCompileOutputRequest
+	// The code differs only by line endings, so it is identical to the processed one
+	response, err := client.RunCode(ctx, &pb.RunCodeRequest{Code: "class Cached {\r\n}\r\n", Sdk: pb.Sdk_SDK_JAVA})
+	if err != nil {
+		t.Fatalf("PlaygroundController_RunCode() error = %v", err)
+	}
+	pipelineId := uuid.MustParse(response.PipelineUuid)
+	// The results are reused without processing the code, so they are available right after RunCode
+	if status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status); status != pb.Status_STATUS_FINISHED {
+		t.Errorf("PlaygroundController_RunCode() status = %v, want %v", status, pb.Status_STATUS_FINISHED)
+	}
+	runOutput, err := client.GetRunOutput(ctx, &pb.GetRunOutputRequest{PipelineUuid: response.PipelineUuid})
+	if err != nil {
+		t.Fatalf("PlaygroundController_GetRunOutput() error = %v", err)
+	}
+	if runOutput.Output != "MOCK_CACHED_RUN_OUTPUT" {
+		t.Errorf("PlaygroundController_GetRunOutput() output = %q, want %q", runOutput.Output, "MOCK_CACHED_RUN_OUTPUT")
+	}
+}
+
+func TestPlaygroundController_RunProgram(t *testing.T) {
+	ctx := context.Background()
+	reusedCode := "class CachedProgram {\n}\n"
+	if err := cacheService.SetValues(ctx, source_cache.Key(pb.Sdk_SDK_JAVA, reusedCode, "", nil, "", nil, ""), map[cache.SubKey]interface{}{
+		cache.RunResultKept: true,
+		cache.CompileOutput: "",
+		cache.RunOutput:     "MOCK_CACHED_RUN_OUTPUT",
+		cache.RunError:      "",
+		cache.Logs:          "",
+	}); err != nil {
+		panic(err)
+	}
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
 	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
 	tests := []struct {
-		name    string
-		prepare func()
-		args    args
-		want    *pb.GetCompileOutputResponse
-		wantErr bool
+		name         string
+		request      *pb.RunCodeRequest
+		wantStatuses []pb.Status
+		wantCode     codes.Code
+		// wantErrorCode is the code of playground.ErrorDetails of the error
+		wantErrorCode pb.ErrorCode
 	}{
 		{
-			// Test case with calling GetCompileOutput method with incorrect pipelineId.
-			// As a result, want to receive an error
-			name:    "incorrect pipelineId",
-			prepare: func() {},
-			args: args{
-				ctx:  ctx,
-				info: &pb.GetCompileOutputRequest{PipelineUuid: "NO_UUID_STRING"},
-			},
-			want:    nil,
-			wantErr: true,
+			// Test case with calling RunProgram method with incorrect SDK.
+			// As a result, want to receive an error without statuses.
+			name:     "incorrect sdk",
+			request:  &pb.RunCodeRequest{Code: "MOCK_CODE", Sdk: pb.Sdk_SDK_GO},
+			wantCode: codes.InvalidArgument,
 		},
 		{
-			// Test case with calling GetCompileOutput method with pipelineId which doesn't exist.
-			// As a result, want to receive an error.
-			name:    "pipelineId doesn't exist",
-			prepare: func() {},
-			args: args{
-				ctx:  ctx,
-				info: &pb.GetCompileOutputRequest{PipelineUuid: pipelineId.String()},
-			},
-			want:    nil,
-			wantErr: true,
+			// Test case with calling RunProgram method with the code which isn't compiled.
+			// As a result, want to receive statuses up to STATUS_COMPILE_ERROR and the error with ERROR_CODE_COMPILE_ERROR,
+			// the code isn't run.
+			name:          "compile error",
+			request:       &pb.RunCodeRequest{Code: "public class Main {\n    public static void main(String[] args) {\n        broken\n    }\n}\n", Sdk: pb.Sdk_SDK_JAVA},
+			wantStatuses:  []pb.Status{pb.Status_STATUS_VALIDATING, pb.Status_STATUS_PREPARING, pb.Status_STATUS_COMPILING, pb.Status_STATUS_COMPILE_ERROR},
+			wantCode:      codes.Aborted,
+			wantErrorCode: pb.ErrorCode_ERROR_CODE_COMPILE_ERROR,
 		},
 		{
-			// Test case with calling GetCompileOutput method with pipelineId which contains compile output.
-			// As a result, want to receive an expected compile output.
-			name: "compile output exist",
-			prepare: func() {
-				_ = cacheService.SetValue(ctx, pipelineId, cache.CompileOutput, compileOutput)
-			},
-			args: args{
-				ctx:  ctx,
-				info: &pb.GetCompileOutputRequest{PipelineUuid: pipelineId.String()},
-			},
-			want:    &pb.GetCompileOutputResponse{Output: compileOutput},
-			wantErr: false,
+			// Test case with calling RunProgram method with the code whose results are reused.
+			// As a result, want to receive only the status of reused results.
+			name:         "reused results",
+			request:      &pb.RunCodeRequest{Code: reusedCode, Sdk: pb.Sdk_SDK_JAVA},
+			wantStatuses: []pb.Status{pb.Status_STATUS_FINISHED},
+			wantCode:     codes.OK,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.prepare()
-			got, err := client.GetCompileOutput(tt.args.ctx, tt.args.info)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("GetCompileOutput() error = %v, wantErr %v", err, tt.wantErr)
-				return
+			stream, err := client.RunProgram(ctx, tt.request)
+			if err != nil {
+				t.Fatalf("PlaygroundController_RunProgram() error = %v", err)
 			}
-			if !tt.wantErr {
-				if !strings.EqualFold(got.Output, tt.want.Output) {
-					t.Errorf("GetCompileOutput() got = %v, want %v", got.Output, tt.want.Output)
+			var statuses []pb.Status
+			for {
+				response, err := stream.Recv()
+				if err == io.EOF {
+					break
 				}
+				if err != nil {
+					if code := status.Code(err); code != tt.wantCode {
+						t.Fatalf("PlaygroundController_RunProgram() error = %v, want code %v", err, tt.wantCode)
+					}
+					if errorCode := errors.GetErrorCode(err); errorCode != tt.wantErrorCode {
+						t.Errorf("PlaygroundController_RunProgram() error code = %v, want %v", errorCode, tt.wantErrorCode)
+					}
+					break
+				}
+				statuses = append(statuses, response.Status)
+			}
+			if !reflect.DeepEqual(statuses, tt.wantStatuses) {
+				t.Errorf("PlaygroundController_RunProgram() statuses = %v, want %v", statuses, tt.wantStatuses)
 			}
 		})
 	}
 }
 
-func TestPlaygroundController_GetRunOutput(t *testing.T) {
-	defer goleak.VerifyNone(t, opt)
-	ctx := context.Background()
-	pipelineId := uuid.New()
-	runOutput := "MOCK_RUN_OUTPUT"
-	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+// mockRunProgramServer is pb.PlaygroundService_RunProgramServer which keeps sent responses
+type mockRunProgramServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*pb.RunProgramResponse
+	// sendErr is returned by Send after the response is kept if it isn't nil
+	sendErr error
+	// onSend is called after the response is kept if it isn't nil
+	onSend func()
+}
+
+func (s *mockRunProgramServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockRunProgramServer) Send(response *pb.RunProgramResponse) error {
+	s.responses = append(s.responses, response)
+	if s.onSend != nil {
+		s.onSend()
+	}
+	return s.sendErr
+}
+
+func TestPlaygroundController_RunProgramSuccess(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	workingDir, err := os.Getwd()
 	if err != nil {
-		t.Fatalf("Failed to dial bufnet: %v", err)
+		t.Fatalf("error during get working directory: %s", err.Error())
 	}
-	defer conn.Close()
-	client := pb.NewPlaygroundServiceClient(conn)
+	executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+	appEnv := environment.NewApplicationEnvs(workingDir, "", "", baseFileFolder, environment.NewCacheEnvs("local", "", time.Minute, 0, 0, 0, "", false), time.Minute, 0, environment.NewQueueEnvs(0, 0), environment.NewSnippetEnvs(0, 0), environment.NewRateLimitEnvs(0, map[string]int{}, nil), environment.NewWorkingDirEnvs(0, nil), environment.NewCodeEnvs(0, 0))
+	controller := &playgroundController{
+		env:          environment.NewEnvironment(environment.NetworkEnvs{}, *sdkEnv, *appEnv),
+		cacheService: cacheService,
+	}
+	stream := &mockRunProgramServer{ctx: ctx}
 
-	type args struct {
-		ctx  context.Context
-		info *pb.GetRunOutputRequest
+	err = controller.RunProgram(&pb.RunCodeRequest{Code: "print('MOCK_OUTPUT')\n", Sdk: pb.Sdk_SDK_PYTHON, Nondeterministic: true}, stream)
+	if err != nil {
+		t.Fatalf("PlaygroundController_RunProgram() error = %v", err)
 	}
-	tests := []struct {
-		name    string
-		prepare func()
-		args    args
-		want    *pb.GetRunOutputResponse
-		wantErr bool
-	}{
-		{
-			// Test case with calling GetRunOutput method with incorrect pipelineId.
-			// As a result, want to receive an error
-			name:    "incorrect pipelineId",
-			prepare: func() {},
-			args: args{
-				ctx:  ctx,
-				info: &pb.GetRunOutputRequest{PipelineUuid: "NO_UUID_STRING"},
-			},
-			want:    nil,
-			wantErr: true,
-		},
-		{
-			// Test case with calling GetRunOutput method with pipelineId which doesn't exist.
+	statuses := make([]pb.Status, 0, len(stream.responses))
+	for _, response := range stream.responses {
+		statuses = append(statuses, response.Status)
+		if response.PipelineUuid != stream.responses[0].PipelineUuid {
+			t.Errorf("PlaygroundController_RunProgram() pipeline uuid = %s, want %s", response.PipelineUuid, stream.responses[0].PipelineUuid)
+		}
+	}
+	wantStatuses := []pb.Status{pb.Status_STATUS_VALIDATING, pb.Status_STATUS_PREPARING, pb.Status_STATUS_COMPILING, pb.Status_STATUS_EXECUTING, pb.Status_STATUS_FINISHED}
+	if !reflect.DeepEqual(statuses, wantStatuses) {
+		t.Errorf("PlaygroundController_RunProgram() statuses = %v, want %v", statuses, wantStatuses)
+	}
+	runOutput, err := cacheService.GetValue(ctx, uuid.MustParse(stream.responses[0].PipelineUuid), cache.RunOutput)
+	if err != nil {
+		t.Fatalf("error during get run output: %s", err.Error())
+	}
+	if runOutput != "MOCK_OUTPUT\n" {
+		t.Errorf("PlaygroundController_RunProgram() run output = %q, want %q", runOutput, "MOCK_OUTPUT\n")
+	}
+}
+
+func TestPlaygroundController_RunProgramRunError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("error during get working directory: %s", err.Error())
+	}
+	executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+	appEnv := environment.NewApplicationEnvs(workingDir, "", "", baseFileFolder, environment.NewCacheEnvs("local", "", time.Minute, 0, 0, 0, "", false), time.Minute, 0, environment.NewQueueEnvs(0, 0), environment.NewSnippetEnvs(0, 0), environment.NewRateLimitEnvs(0, map[string]int{}, nil), environment.NewWorkingDirEnvs(0, nil), environment.NewCodeEnvs(0, 0))
+	controller := &playgroundController{
+		env:          environment.NewEnvironment(environment.NetworkEnvs{}, *sdkEnv, *appEnv),
+		cacheService: cacheService,
+	}
+	stream := &mockRunProgramServer{ctx: ctx}
+
+	err = controller.RunProgram(&pb.RunCodeRequest{Code: "raise ValueError('MOCK_ERROR')\n", Sdk: pb.Sdk_SDK_PYTHON, Nondeterministic: true}, stream)
+	if code := status.Code(err); code != codes.Aborted {
+		t.Fatalf("PlaygroundController_RunProgram() error = %v, want code %v", err, codes.Aborted)
+	}
+	if errorCode := errors.GetErrorCode(err); errorCode != pb.ErrorCode_ERROR_CODE_RUN_ERROR {
+		t.Errorf("PlaygroundController_RunProgram() error code = %v, want %v", errorCode, pb.ErrorCode_ERROR_CODE_RUN_ERROR)
+	}
+	if lastStatus := stream.responses[len(stream.responses)-1].Status; lastStatus != pb.Status_STATUS_RUN_ERROR {
+		t.Errorf("PlaygroundController_RunProgram() last status = %v, want %v", lastStatus, pb.Status_STATUS_RUN_ERROR)
+	}
+}
+
+func TestPlaygroundController_RunProgramWarmPool(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("error during get working directory: %s", err.Error())
+	}
+	executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+	appEnv := environment.NewApplicationEnvs(workingDir, "", "", baseFileFolder, environment.NewCacheEnvs("local", "", time.Minute, 0, 0, 0, "", false), time.Minute, 0, environment.NewQueueEnvs(0, 0), environment.NewSnippetEnvs(0, 0), environment.NewRateLimitEnvs(0, map[string]int{}, nil), environment.NewWorkingDirEnvs(0, nil), environment.NewCodeEnvs(0, 0))
+	pipelinesFolder := filepath.Join(workingDir, baseFileFolder)
+	// the prepared file is read by the code, so the code processing fails unless the working directory is taken from the pool
+	prepare := func(lc *fs_tool.LifeCycle, id uuid.UUID) error {
+		return os.WriteFile(filepath.Join(lc.Paths.AbsoluteBaseFolderPath, "prepared.txt"), []byte("MOCK_PREPARED"), 0600)
+	}
+	pool, err := fs_tool.NewWarmPool(pb.Sdk_SDK_PYTHON, pipelinesFolder, 1, prepare)
+	if err != nil {
+		t.Fatalf("error during preparing the warm pool: %s", err.Error())
+	}
+	controller := &playgroundController{
+		env:          environment.NewEnvironment(environment.NetworkEnvs{}, *sdkEnv, *appEnv),
+		cacheService: cacheService,
+		warmPool:     pool,
+	}
+	stream := &mockRunProgramServer{ctx: ctx}
+
+	code := "if __name__ == \"__main__\":\n    open('result.txt', 'w').write('MOCK_RESULT')\n    print(open('prepared.txt').read())\n"
+	if err = controller.RunProgram(&pb.RunCodeRequest{Code: code, Sdk: pb.Sdk_SDK_PYTHON, Nondeterministic: true}, stream); err != nil {
+		t.Fatalf("PlaygroundController_RunProgram() error = %v", err)
+	}
+	controller.runs.Wait()
+	runOutput, err := cacheService.GetValue(ctx, uuid.MustParse(stream.responses[0].PipelineUuid), cache.RunOutput)
+	if err != nil {
+		t.Fatalf("error during get run output: %s", err.Error())
+	}
+	if runOutput != "MOCK_PREPARED\n" {
+		t.Errorf("PlaygroundController_RunProgram() run output = %q, want %q", runOutput, "MOCK_PREPARED\n")
+	}
+	// the working directory is returned to the pool after the code processing without files of the code
+	if pool.Size() != 1 {
+		t.Fatalf("PlaygroundController_RunProgram() size of the warm pool = %d, want 1", pool.Size())
+	}
+	lc, err := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, uuid.New(), pipelinesFolder)
+	if err != nil {
+		t.Fatalf("error during creating the life cycle: %s", err.Error())
+	}
+	if !pool.Take(lc) {
+		t.Fatalf("the working directory isn't returned to the warm pool")
+	}
+	defer lc.DeleteFolders()
+	entries, err := os.ReadDir(lc.Paths.AbsoluteBaseFolderPath)
+	if err != nil {
+		t.Fatalf("error during reading the working directory: %s", err.Error())
+	}
+	if len(entries) != 1 || entries[0].Name() != "prepared.txt" {
+		t.Errorf("PlaygroundController_RunProgram() the returned working directory contains %v, want only the prepared file", entries)
+	}
+}
+
+func Test_getPipelineError(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name   string
+		status pb.Status
+		// runResult is the result of the run step which is saved into cache if it isn't nil
+		runResult     *cache.ExecutionResult
+		wantCode      codes.Code
+		wantErrorCode pb.ErrorCode
+	}{
+		{
+			// Test case with the code processing which is finished successfully.
+			// As a result, want to receive no error.
+			name:     "finished",
+			status:   pb.Status_STATUS_FINISHED,
+			wantCode: codes.OK,
+		},
+		{
+			// Test case with the code which isn't validated.
+			// As a result, want to receive ERROR_CODE_COMPILE_ERROR.
+			name:          "validation error",
+			status:        pb.Status_STATUS_VALIDATION_ERROR,
+			wantCode:      codes.Aborted,
+			wantErrorCode: pb.ErrorCode_ERROR_CODE_COMPILE_ERROR,
+		},
+		{
+			// Test case with the code which isn't compiled.
+			// As a result, want to receive ERROR_CODE_COMPILE_ERROR.
+			name:          "compile error",
+			status:        pb.Status_STATUS_COMPILE_ERROR,
+			wantCode:      codes.Aborted,
+			wantErrorCode: pb.ErrorCode_ERROR_CODE_COMPILE_ERROR,
+		},
+		{
+			// Test case with the code which is failed during the run step.
+			// As a result, want to receive ERROR_CODE_RUN_ERROR.
+			name:          "run error",
+			status:        pb.Status_STATUS_RUN_ERROR,
+			runResult:     &cache.ExecutionResult{ExitCode: 1},
+			wantCode:      codes.Aborted,
+			wantErrorCode: pb.ErrorCode_ERROR_CODE_RUN_ERROR,
+		},
+		{
+			// Test case with the code which exceeded the memory limit during the run step.
+			// As a result, want to receive ERROR_CODE_RESOURCE_LIMIT.
+			name:          "resource limit",
+			status:        pb.Status_STATUS_RUN_ERROR,
+			runResult:     &cache.ExecutionResult{ExitCode: -1, LimitExceeded: cache.MemoryLimit},
+			wantCode:      codes.ResourceExhausted,
+			wantErrorCode: pb.ErrorCode_ERROR_CODE_RESOURCE_LIMIT,
+		},
+		{
+			// Test case with the code processing which exceeded the timeout.
+			// As a result, want to receive ERROR_CODE_TIMEOUT.
+			name:          "timeout",
+			status:        pb.Status_STATUS_RUN_TIMEOUT,
+			wantCode:      codes.DeadlineExceeded,
+			wantErrorCode: pb.ErrorCode_ERROR_CODE_TIMEOUT,
+		},
+		{
+			// Test case with the code processing which is canceled.
+			// As a result, want to receive ERROR_CODE_CANCELED.
+			name:          "canceled",
+			status:        pb.Status_STATUS_CANCELED,
+			wantCode:      codes.Canceled,
+			wantErrorCode: pb.ErrorCode_ERROR_CODE_CANCELED,
+		},
+		{
+			// Test case with the code processing which is failed by the error of the server.
+			// As a result, want to receive ERROR_CODE_INTERNAL.
+			name:          "internal error",
+			status:        pb.Status_STATUS_ERROR,
+			wantCode:      codes.Internal,
+			wantErrorCode: pb.ErrorCode_ERROR_CODE_INTERNAL,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			if tt.runResult != nil {
+				if err := cacheService.SetValue(ctx, pipelineId, cache.RunResult, *tt.runResult); err != nil {
+					t.Fatalf("error during set run result: %s", err.Error())
+				}
+			}
+			err := getPipelineError(ctx, cacheService, pipelineId, tt.status)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("getPipelineError() error = %v, want code %v", err, tt.wantCode)
+			}
+			if errorCode := errors.GetErrorCode(err); errorCode != tt.wantErrorCode {
+				t.Errorf("getPipelineError() error code = %v, want %v", errorCode, tt.wantErrorCode)
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_RunProgramDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("error during get working directory: %s", err.Error())
+	}
+	executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+	appEnv := environment.NewApplicationEnvs(workingDir, "", "", baseFileFolder, environment.NewCacheEnvs("local", "", time.Minute, 0, 0, 0, "", false), time.Minute, 0, environment.NewQueueEnvs(0, 0), environment.NewSnippetEnvs(0, 0), environment.NewRateLimitEnvs(0, map[string]int{}, nil), environment.NewWorkingDirEnvs(0, nil), environment.NewCodeEnvs(0, 0))
+	controller := &playgroundController{
+		env:          environment.NewEnvironment(environment.NetworkEnvs{}, *sdkEnv, *appEnv),
+		cacheService: cacheService,
+	}
+	// the client is gone after the first status, so the stream can't send statuses anymore
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	stream := &mockRunProgramServer{ctx: streamCtx, sendErr: fmt.Errorf("MOCK_ERROR"), onSend: cancelStream}
+
+	request := &pb.RunCodeRequest{
+		Code:             "import time\n\nif __name__ == \"__main__\":\n    time.sleep(30)\n",
+		Sdk:              pb.Sdk_SDK_PYTHON,
+		Nondeterministic: true,
+		Deadline:         durationpb.New(time.Second),
+	}
+	start := time.Now()
+	if err = controller.RunProgram(request, stream); err == nil {
+		t.Fatalf("PlaygroundController_RunProgram() error = nil, want the error of the stream")
+	}
+	if len(stream.responses) == 0 {
+		t.Fatalf("PlaygroundController_RunProgram() doesn't send statuses")
+	}
+	pipelineId := uuid.MustParse(stream.responses[0].PipelineUuid)
+
+	var gotStatus interface{}
+	for time.Since(start) < 20*time.Second {
+		if gotStatus, err = cacheService.GetValue(ctx, pipelineId, cache.Status); err == nil && cache.IsTerminalStatus(gotStatus.(pb.Status)) {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if gotStatus != pb.Status_STATUS_RUN_TIMEOUT {
+		t.Fatalf("PlaygroundController_RunProgram() status = %v, want %v", gotStatus, pb.Status_STATUS_RUN_TIMEOUT)
+	}
+	runError, err := cacheService.GetValue(ctx, pipelineId, cache.RunError)
+	if err != nil {
+		t.Fatalf("error during get run error: %s", err.Error())
+	}
+	wantRunError := "Code processing exceeded the deadline of the request: 1s"
+	if runError != wantRunError {
+		t.Errorf("PlaygroundController_RunProgram() run error = %q, want %q", runError, wantRunError)
+	}
+}
+
+func TestPlaygroundController_RunProgramExampleOptions(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("error during get working directory: %s", err.Error())
+	}
+	executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+	appEnv := environment.NewApplicationEnvs(workingDir, "", "", baseFileFolder, environment.NewCacheEnvs("local", "", time.Minute, 0, 0, 0, "", false), time.Minute, 0, environment.NewQueueEnvs(0, 0), environment.NewSnippetEnvs(0, 0), environment.NewRateLimitEnvs(0, map[string]int{}, nil), environment.NewWorkingDirEnvs(0, nil), environment.NewCodeEnvs(0, 0))
+	storage := &fakeExamplesStorage{objects: map[string]cloud_bucket.PrecompiledObjectData{
+		"SDK_PYTHON/WordCount": {
+			Info: cloud_bucket.ObjectInfo{Name: "WordCount", CloudPath: "SDK_PYTHON/WordCount", PipelineOptions: "--input_text=MOCK_INPUT --output default.txt"},
+			Sdk:  pb.Sdk_SDK_PYTHON,
+		},
+		"SDK_PYTHON/Broken": {
+			Info: cloud_bucket.ObjectInfo{Name: "Broken", CloudPath: "SDK_PYTHON/Broken", PipelineOptions: "default.txt --output"},
+			Sdk:  pb.Sdk_SDK_PYTHON,
+		},
+	}}
+	code := "import sys\n\nif __name__ == \"__main__\":\n    print(' '.join(sys.argv[1:]))\n"
+
+	tests := []struct {
+		name             string
+		exampleCloudPath string
+		pipelineOptions  string
+		wantCode         codes.Code
+		wantRunOutput    string
+	}{
+		{
+			// Test case with running the code of the example with options of the request which override one of default options.
+			// As a result, want the code to receive default options of the example and options of the request.
+			name:             "options of the request override defaults",
+			exampleCloudPath: "SDK_PYTHON/WordCount",
+			pipelineOptions:  "--output=request.txt",
+			wantCode:         codes.OK,
+			wantRunOutput:    "--input_text=MOCK_INPUT --output=request.txt\n",
+		},
+		{
+			// Test case with running the code without the example.
+			// As a result, want the code to receive only options of the request.
+			name:            "without example",
+			pipelineOptions: "--output=request.txt",
+			wantCode:        codes.OK,
+			wantRunOutput:   "--output=request.txt\n",
+		},
+		{
+			// Test case with running the code of the example which doesn't exist.
+			// As a result, want to receive codes.NotFound.
+			name:             "example not found",
+			exampleCloudPath: "SDK_PYTHON/Unknown",
+			wantCode:         codes.NotFound,
+		},
+		{
+			// Test case with running the code of the example with incorrect default options.
+			// As a result, want to receive codes.InvalidArgument.
+			name:             "incorrect default options",
+			exampleCloudPath: "SDK_PYTHON/Broken",
+			wantCode:         codes.InvalidArgument,
+		},
+		{
+			// Test case with running the code of the example with incorrect options of the request.
+			// As a result, want to receive codes.InvalidArgument.
+			name:             "incorrect options of the request",
+			exampleCloudPath: "SDK_PYTHON/WordCount",
+			pipelineOptions:  "request.txt",
+			wantCode:         codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := &playgroundController{
+				env:             environment.NewEnvironment(environment.NetworkEnvs{}, *sdkEnv, *appEnv),
+				cacheService:    cacheService,
+				examplesStorage: storage,
+			}
+			stream := &mockRunProgramServer{ctx: ctx}
+			request := &pb.RunCodeRequest{
+				Code:             code,
+				Sdk:              pb.Sdk_SDK_PYTHON,
+				PipelineOptions:  tt.pipelineOptions,
+				Nondeterministic: true,
+				ExampleCloudPath: tt.exampleCloudPath,
+			}
+			err := controller.RunProgram(request, stream)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("PlaygroundController_RunProgram() error = %v, want code %v", err, tt.wantCode)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			runOutput, err := cacheService.GetValue(ctx, uuid.MustParse(stream.responses[0].PipelineUuid), cache.RunOutput)
+			if err != nil {
+				t.Fatalf("error during get run output: %s", err.Error())
+			}
+			if runOutput != tt.wantRunOutput {
+				t.Errorf("PlaygroundController_RunProgram() run output = %q, want %q", runOutput, tt.wantRunOutput)
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_drain(t *testing.T) {
+	ctx := context.Background()
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("error during get working directory: %s", err.Error())
+	}
+	executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+	appEnv := environment.NewApplicationEnvs(workingDir, "", "", baseFileFolder, environment.NewCacheEnvs("local", "", time.Minute, 0, 0, 0, "", false), time.Minute, 0, environment.NewQueueEnvs(0, 0), environment.NewSnippetEnvs(0, 0), environment.NewRateLimitEnvs(0, map[string]int{}, nil), environment.NewWorkingDirEnvs(0, nil), environment.NewCodeEnvs(0, 0))
+
+	tests := []struct {
+		name        string
+		code        string
+		gracePeriod time.Duration
+		// maxDuration is the max duration of the drain
+		maxDuration    time.Duration
+		wantTerminated int
+		wantStatus     pb.Status
+		wantRunError   string
+	}{
+		{
+			// Test case with shutting down the server while the code which finishes within the grace period is running.
+			// As a result, want the drain to wait for the code and the code to be finished successfully.
+			name:           "run finishes within grace period",
+			code:           "import time\n\nif __name__ == \"__main__\":\n    time.sleep(1)\n    print('MOCK_OUTPUT')\n",
+			gracePeriod:    30 * time.Second,
+			maxDuration:    20 * time.Second,
+			wantTerminated: 0,
+			wantStatus:     pb.Status_STATUS_FINISHED,
+			wantRunError:   "",
+		},
+		{
+			// Test case with shutting down the server while the code which doesn't finish within the grace period is running.
+			// As a result, want the code to be killed after the grace period with the status which explains the shutdown.
+			name:           "run exceeds grace period",
+			code:           "import time\n\nif __name__ == \"__main__\":\n    time.sleep(30)\n",
+			gracePeriod:    2 * time.Second,
+			maxDuration:    15 * time.Second,
+			wantTerminated: 1,
+			wantStatus:     pb.Status_STATUS_ERROR,
+			wantRunError:   code_processing.ShutdownMessage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := &playgroundController{
+				env:          environment.NewEnvironment(environment.NetworkEnvs{}, *sdkEnv, *appEnv),
+				cacheService: cacheService,
+			}
+			response, err := controller.RunCode(ctx, &pb.RunCodeRequest{Code: tt.code, Sdk: pb.Sdk_SDK_PYTHON, Nondeterministic: true})
+			if err != nil {
+				t.Fatalf("RunCode() error = %v", err)
+			}
+			pipelineId := uuid.MustParse(response.PipelineUuid)
+
+			start := time.Now()
+			if got := controller.drain(tt.gracePeriod, 10*time.Second); got != tt.wantTerminated {
+				t.Errorf("drain() terminated = %d, want %d", got, tt.wantTerminated)
+			}
+			if duration := time.Since(start); duration > tt.maxDuration {
+				t.Errorf("drain() finished after %s, want at most %s", duration, tt.maxDuration)
+			}
+			if status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status); status != tt.wantStatus {
+				t.Errorf("drain() status = %v, want %v", status, tt.wantStatus)
+			}
+			if runError, _ := cacheService.GetValue(ctx, pipelineId, cache.RunError); runError != tt.wantRunError {
+				t.Errorf("drain() run error = %q, want %q", runError, tt.wantRunError)
+			}
+
+			// new code processings aren't accepted after the drain
+			_, err = controller.RunCode(ctx, &pb.RunCodeRequest{Code: tt.code, Sdk: pb.Sdk_SDK_PYTHON, Nondeterministic: true})
+			if status.Code(err) != codes.Unavailable {
+				t.Errorf("RunCode() after drain error = %v, want code %v", err, codes.Unavailable)
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_ValidateCode(t *testing.T) {
+	defer goleak.VerifyNone(t, opt)
+	ctx := context.Background()
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
+	tests := []struct {
+		name    string
+		request *pb.ValidateCodeRequest
+		wantErr bool
+	}{
+		{
+			// Test case with calling ValidateCode method with incorrect SDK.
+			// As a result, want to receive an error.
+			name:    "incorrect sdk",
+			request: &pb.ValidateCodeRequest{Code: "MOCK_CODE", Sdk: pb.Sdk_SDK_GO},
+			wantErr: true,
+		},
+		{
+			// Test case with calling ValidateCode method with unspecified SDK.
+			// As a result, want to receive an error.
+			name:    "unspecified sdk",
+			request: &pb.ValidateCodeRequest{Code: "MOCK_CODE", Sdk: pb.Sdk_SDK_UNSPECIFIED},
+			wantErr: true,
+		},
+		{
+			// Test case with calling ValidateCode method with the code which is larger than the max size of the file of the code.
+			// As a result, want to receive an error.
+			name:    "too large code",
+			request: &pb.ValidateCodeRequest{Code: strings.Repeat("a", 512*1024+1), Sdk: pb.Sdk_SDK_JAVA},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ValidateCode(ctx, tt.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PlaygroundController_ValidateCode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_ValidateOptions(t *testing.T) {
+	defer goleak.VerifyNone(t, opt)
+	ctx := context.Background()
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
+	tests := []struct {
+		name            string
+		request         *pb.ValidateOptionsRequest
+		wantValid       bool
+		wantDiagnostics int
+		wantErr         bool
+	}{
+		{
+			// Test case with calling ValidateOptions method with incorrect SDK.
+			// As a result, want to receive an error.
+			name:    "incorrect sdk",
+			request: &pb.ValidateOptionsRequest{Sdk: pb.Sdk_SDK_PYTHON, PipelineOptions: "--streaming"},
+			wantErr: true,
+		},
+		{
+			// Test case with calling ValidateOptions method with known options which have correct values.
+			// As a result, want to receive valid options without diagnostics.
+			name:      "valid options",
+			request:   &pb.ValidateOptionsRequest{Sdk: pb.Sdk_SDK_JAVA, PipelineOptions: "--numWorkers 2 --streaming"},
+			wantValid: true,
+		},
+		{
+			// Test case with calling ValidateOptions method with the unknown option.
+			// As a result, want to receive valid options with the warning.
+			name:            "unknown option",
+			request:         &pb.ValidateOptionsRequest{Sdk: pb.Sdk_SDK_JAVA, PipelineOptions: "--inputFile=in.txt"},
+			wantValid:       true,
+			wantDiagnostics: 1,
+		},
+		{
+			// Test case with calling ValidateOptions method with the bad value of the known option.
+			// As a result, want to receive invalid options with the error.
+			name:            "bad value",
+			request:         &pb.ValidateOptionsRequest{Sdk: pb.Sdk_SDK_JAVA, PipelineOptions: "--numWorkers=two --inputFile=in.txt"},
+			wantValid:       false,
+			wantDiagnostics: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.ValidateOptions(ctx, tt.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PlaygroundController_ValidateOptions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.Valid != tt.wantValid {
+				t.Errorf("PlaygroundController_ValidateOptions() valid = %v, want %v", got.Valid, tt.wantValid)
+			}
+			if len(got.Diagnostics) != tt.wantDiagnostics {
+				t.Errorf("PlaygroundController_ValidateOptions() diagnostics = %v, want %d diagnostics", got.Diagnostics, tt.wantDiagnostics)
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_checkCodeSize(t *testing.T) {
+	appEnv := environment.NewApplicationEnvs("", "", "", baseFileFolder, environment.NewCacheEnvs("local", "", time.Minute, 0, 0, 0, "", false), time.Minute, 0, environment.NewQueueEnvs(0, 0), environment.NewSnippetEnvs(0, 0), environment.NewRateLimitEnvs(0, map[string]int{}, nil), environment.NewWorkingDirEnvs(0, nil), environment.NewCodeEnvs(100, 80))
+	controller := &playgroundController{env: environment.NewEnvironment(environment.NetworkEnvs{}, environment.BeamEnvs{}, *appEnv)}
+	tests := []struct {
+		name    string
+		code    string
+		wantErr bool
+	}{
+		{
+			// Test case with the code which size is equal to the max size of the file of the code.
+			// As a result, want to receive no error.
+			name:    "code size is equal to the limit",
+			code:    strings.Repeat("a", 80),
+			wantErr: false,
+		},
+		{
+			// Test case with the code which is larger than the max size of the file of the code.
+			// As a result, want to receive an error.
+			name:    "code is larger than the limit",
+			code:    strings.Repeat("a", 81),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := controller.checkCodeSize(tt.code); (err != nil) != tt.wantErr {
+				t.Errorf("PlaygroundController_checkCodeSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_CheckStatus(t *testing.T) {
+	defer goleak.VerifyNone(t, opt)
+	ctx := context.Background()
+	pipelineId := uuid.New()
+	wantStatus := pb.Status_STATUS_FINISHED
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
+	type args struct {
+		ctx     context.Context
+		request *pb.CheckStatusRequest
+	}
+	tests := []struct {
+		name       string
+		prepare    func()
+		args       args
+		wantStatus *pb.Status
+		wantErr    bool
+	}{
+		{
+			// Test case with calling CheckStatus method with incorrect pipelineId.
+			// As a result, want to receive an error
+			name:    "incorrect pipelineId",
+			prepare: func() {},
+			args: args{
+				ctx:     ctx,
+				request: &pb.CheckStatusRequest{PipelineUuid: "NO_UUID_STRING"},
+			},
+			wantStatus: nil,
+			wantErr:    true,
+		},
+		{
+			// Test case with calling CheckStatus method with pipelineId which doesn't exist.
+			// As a result, want to receive an error.
+			name:    "status is not set",
+			prepare: func() {},
+			args: args{
+				ctx:     ctx,
+				request: &pb.CheckStatusRequest{PipelineUuid: pipelineId.String()},
+			},
+			wantStatus: nil,
+			wantErr:    true,
+		},
+		{
+			// Test case with calling CheckStatus method with pipelineId which contains status.
+			// As a result, want to receive an expected status.
+			name: "status exists",
+			prepare: func() {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Status, wantStatus)
+			},
+			args: args{
+				ctx:     ctx,
+				request: &pb.CheckStatusRequest{PipelineUuid: pipelineId.String()},
+			},
+			wantStatus: &wantStatus,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			got, err := client.CheckStatus(ctx, tt.args.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PlaygroundController_CheckStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got == nil && tt.wantStatus != nil {
+				t.Errorf("PlaygroundController_CheckStatus() return = %v, want response with status %v", got, tt.wantStatus)
+			}
+			if got != nil && !reflect.DeepEqual(got.Status, *tt.wantStatus) {
+				t.Errorf("PlaygroundController_CheckStatus() return status = %v, want status %v", got.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_GetCompileOutput(t *testing.T) {
+	defer goleak.VerifyNone(t, opt)
+	ctx := context.Background()
+	pipelineId := uuid.New()
+	compileOutput := "MOCK_COMPILE_OUTPUT"
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
+	type args struct {
+		ctx  context.Context
+		info *pb.GetCompileOutputRequest
+	}
+	tests := []struct {
+		name    string
+		prepare func()
+		args    args
+		want    *pb.GetCompileOutputResponse
+		wantErr bool
+	}{
+		{
+			// Test case with calling GetCompileOutput method with incorrect pipelineId.
+			// As a result, want to receive an error
+			name:    "incorrect pipelineId",
+			prepare: func() {},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetCompileOutputRequest{PipelineUuid: "NO_UUID_STRING"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetCompileOutput method with pipelineId which doesn't exist.
+			// As a result, want to receive an error.
+			name:    "pipelineId doesn't exist",
+			prepare: func() {},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetCompileOutputRequest{PipelineUuid: pipelineId.String()},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetCompileOutput method with pipelineId which contains compile output.
+			// As a result, want to receive an expected compile output.
+			name: "compile output exist",
+			prepare: func() {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.CompileOutput, compileOutput)
+			},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetCompileOutputRequest{PipelineUuid: pipelineId.String()},
+			},
+			want:    &pb.GetCompileOutputResponse{Output: compileOutput, Diagnostics: []*pb.CompileDiagnostic{{Raw: compileOutput}}},
+			wantErr: false,
+		},
+		{
+			// Test case with calling GetCompileOutput method with pipelineId which contains the compile output of javac.
+			// As a result, want to receive the compile output and its diagnostics.
+			name: "compile output with diagnostics",
+			prepare: func() {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.CompileOutput, "Main.java:3: error: not a statement\n        broken\n        ^\n1 error\n")
+			},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetCompileOutputRequest{PipelineUuid: pipelineId.String()},
+			},
+			want: &pb.GetCompileOutputResponse{
+				Output: "Main.java:3: error: not a statement\n        broken\n        ^\n1 error\n",
+				Diagnostics: []*pb.CompileDiagnostic{
+					{
+						File: "Main.java", Line: 3, Column: 9, Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "not a statement",
+						Raw: "Main.java:3: error: not a statement\n        broken\n        ^",
+					},
+					{Raw: "1 error"},
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			got, err := client.GetCompileOutput(tt.args.ctx, tt.args.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetCompileOutput() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if !strings.EqualFold(got.Output, tt.want.Output) {
+					t.Errorf("GetCompileOutput() got = %v, want %v", got.Output, tt.want.Output)
+				}
+				if !proto.Equal(got, tt.want) {
+					t.Errorf("GetCompileOutput() diagnostics = %v, want %v", got.Diagnostics, tt.want.Diagnostics)
+				}
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_GetExecutionCommand(t *testing.T) {
+	ctx := context.Background()
+	command := "javac -d bin Main.java\njava -cp bin: Main --password=<redacted>"
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
+	tests := []struct {
+		name string
+		// prepare sets values of the pipeline to cache
+		prepare  func(pipelineId uuid.UUID)
+		info     *pb.GetExecutionCommandRequest
+		want     string
+		wantCode codes.Code
+	}{
+		{
+			// Test case with getting command lines of the pipeline which are kept in the cache.
+			// As a result, want to receive command lines as they are kept.
+			name: "command exists",
+			prepare: func(pipelineId uuid.UUID) {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.ExecutionCommand, command)
+			},
+			info:     &pb.GetExecutionCommandRequest{},
+			want:     command,
+			wantCode: codes.OK,
+		},
+		{
+			// Test case with getting command lines of the pipeline which hasn't started commands.
+			// As a result, want to receive the NotFound error.
+			name:     "command doesn't exist",
+			prepare:  func(pipelineId uuid.UUID) {},
+			info:     &pb.GetExecutionCommandRequest{},
+			wantCode: codes.NotFound,
+		},
+		{
+			// Test case with getting command lines by the pipelineId which couldn't be parsed.
+			// As a result, want to receive the InvalidArgument error.
+			name:     "incorrect pipelineId",
+			prepare:  func(pipelineId uuid.UUID) {},
+			info:     &pb.GetExecutionCommandRequest{PipelineUuid: "NO_UUID_STRING"},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			tt.prepare(pipelineId)
+			if tt.info.PipelineUuid == "" {
+				tt.info.PipelineUuid = pipelineId.String()
+			}
+			got, err := client.GetExecutionCommand(ctx, tt.info)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("GetExecutionCommand() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			if got.Command != tt.want {
+				t.Errorf("GetExecutionCommand() got = %q, want %q", got.Command, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_GetRunOutput(t *testing.T) {
+	defer goleak.VerifyNone(t, opt)
+	ctx := context.Background()
+	pipelineId := uuid.New()
+	runOutput := "MOCK_RUN_OUTPUT"
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
+	type args struct {
+		ctx  context.Context
+		info *pb.GetRunOutputRequest
+	}
+	tests := []struct {
+		name    string
+		prepare func()
+		args    args
+		want    *pb.GetRunOutputResponse
+		wantErr bool
+	}{
+		{
+			// Test case with calling GetRunOutput method with incorrect pipelineId.
+			// As a result, want to receive an error
+			name:    "incorrect pipelineId",
+			prepare: func() {},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetRunOutputRequest{PipelineUuid: "NO_UUID_STRING"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetRunOutput method with pipelineId which doesn't exist.
+			// As a result, want to receive an error.
+			name:    "pipelineId doesn't exist",
+			prepare: func() {},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetRunOutputRequest{PipelineUuid: pipelineId.String()},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetRunOutput method with pipelineId which doesn't contain run output.
+			// As a result, want to receive an error.
+			name: "run output doesn't exist",
+			prepare: func() {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_EXECUTING)
+			},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetRunOutputRequest{PipelineUuid: pipelineId.String()},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetRunOutput method with pipelineId which contains run output.
+			// As a result want to receive response with an expected run output.
+			name: "run output exist",
+			prepare: func() {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.RunOutputIndex, 0)
+				_ = cacheService.SetValue(ctx, pipelineId, cache.RunOutput, runOutput)
+			},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetRunOutputRequest{PipelineUuid: pipelineId.String()},
+			},
+			want:    &pb.GetRunOutputResponse{Output: runOutput},
+			wantErr: false,
+		},
+		{
+			// Test case with calling RunOutput method with pipelineId which contain run output and index of run output is 1.
+			// As a result want to receive response with correct output (output[1:]).
+			name: "get the second part",
+			prepare: func() {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.RunOutputIndex, 1)
+				_ = cacheService.SetValue(ctx, pipelineId, cache.RunOutput, runOutput)
+			},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetRunOutputRequest{PipelineUuid: pipelineId.String()},
+			},
+			want:    &pb.GetRunOutputResponse{Output: runOutput[1:]},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			got, err := client.GetRunOutput(tt.args.ctx, tt.args.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetRunOutput() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if !strings.EqualFold(got.Output, tt.want.Output) {
+					t.Errorf("GetRunOutput() got = %v, want %v", got.Output, tt.want.Output)
+				}
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_GetLogEntries(t *testing.T) {
+	ctx := context.Background()
+	logs := "WARNING:root:MOCK_WARNING\n2022-01-02T15:04:05Z ERROR MOCK_ERROR\n\tat MOCK_STACK_TRACE\nMOCK_OUTPUT\n"
+	errorTime := time.Date(2022, 1, 2, 15, 4, 5, 0, time.UTC)
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
+	tests := []struct {
+		name string
+		// prepare sets values of the pipeline to cache
+		prepare func(pipelineId uuid.UUID)
+		info    *pb.GetLogEntriesRequest
+		want    []*pb.LogEntry
+		// wantCode is the code of the error, codes.OK if the error isn't expected
+		wantCode codes.Code
+	}{
+		{
+			// Test case with getting entries of logs of the running code without filters.
+			// As a result, want to receive all entries and the unstructured line with the default severity.
+			name: "all entries",
+			prepare: func(pipelineId uuid.UUID) {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Logs, logs)
+			},
+			info: &pb.GetLogEntriesRequest{},
+			want: []*pb.LogEntry{
+				{Severity: pb.LogSeverity_LOG_SEVERITY_WARNING, Message: "MOCK_WARNING"},
+				{Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Timestamp: timestamppb.New(errorTime), Message: "MOCK_ERROR\n\tat MOCK_STACK_TRACE"},
+				{Severity: pb.LogSeverity_LOG_SEVERITY_INFO, Message: "MOCK_OUTPUT"},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			// Test case with getting entries of logs with the min severity.
+			// As a result, want to receive entries which severity isn't lower than WARNING.
+			name: "min severity",
+			prepare: func(pipelineId uuid.UUID) {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Logs, logs)
+			},
+			info: &pb.GetLogEntriesRequest{MinSeverity: pb.LogSeverity_LOG_SEVERITY_WARNING},
+			want: []*pb.LogEntry{
+				{Severity: pb.LogSeverity_LOG_SEVERITY_WARNING, Message: "MOCK_WARNING"},
+				{Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Timestamp: timestamppb.New(errorTime), Message: "MOCK_ERROR\n\tat MOCK_STACK_TRACE"},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			// Test case with getting saved entries of logs of the finished code by the time range.
+			// As a result, want to receive saved entries with times in the range.
+			name: "saved entries in time range",
+			prepare: func(pipelineId uuid.UUID) {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Logs, logs)
+				_ = cacheService.SetValue(ctx, pipelineId, cache.LogEntries, []cache.LogEntry{
+					{Severity: pb.LogSeverity_LOG_SEVERITY_INFO, Timestamp: errorTime.UnixNano(), Message: "MOCK_SAVED"},
+					{Severity: pb.LogSeverity_LOG_SEVERITY_INFO, Timestamp: errorTime.Add(time.Hour).UnixNano(), Message: "MOCK_LATE"},
+				})
+			},
+			info: &pb.GetLogEntriesRequest{StartTime: timestamppb.New(errorTime.Add(-time.Minute)), EndTime: timestamppb.New(errorTime.Add(time.Minute))},
+			want: []*pb.LogEntry{
+				{Severity: pb.LogSeverity_LOG_SEVERITY_INFO, Timestamp: timestamppb.New(errorTime), Message: "MOCK_SAVED"},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			// Test case with getting entries by the end time which is before the start time.
+			// As a result, want to receive the InvalidArgument error.
+			name: "incorrect time range",
+			prepare: func(pipelineId uuid.UUID) {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Logs, logs)
+			},
+			info:     &pb.GetLogEntriesRequest{StartTime: timestamppb.New(errorTime), EndTime: timestamppb.New(errorTime.Add(-time.Minute))},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			// Test case with getting entries of the pipeline which doesn't have logs.
+			// As a result, want to receive the NotFound error.
+			name:     "logs don't exist",
+			prepare:  func(pipelineId uuid.UUID) {},
+			info:     &pb.GetLogEntriesRequest{},
+			wantCode: codes.NotFound,
+		},
+		{
+			// Test case with getting entries by the pipelineId which couldn't be parsed.
+			// As a result, want to receive the InvalidArgument error.
+			name:     "incorrect pipelineId",
+			prepare:  func(pipelineId uuid.UUID) {},
+			info:     &pb.GetLogEntriesRequest{PipelineUuid: "NO_UUID_STRING"},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			tt.prepare(pipelineId)
+			if tt.info.PipelineUuid == "" {
+				tt.info.PipelineUuid = pipelineId.String()
+			}
+			got, err := client.GetLogEntries(ctx, tt.info)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("GetLogEntries() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			if len(got.Entries) != len(tt.want) {
+				t.Fatalf("GetLogEntries() got = %v, want %v", got.Entries, tt.want)
+			}
+			for i := range got.Entries {
+				if !proto.Equal(got.Entries[i], tt.want[i]) {
+					t.Errorf("GetLogEntries() got = %v, want %v", got.Entries[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_GetLogs(t *testing.T) {
+	defer goleak.VerifyNone(t, opt)
+	ctx := context.Background()
+	pipelineId := uuid.New()
+	logs := "MOCK_LOGS"
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
+	type args struct {
+		ctx  context.Context
+		info *pb.GetLogsRequest
+	}
+	tests := []struct {
+		name    string
+		prepare func()
+		args    args
+		want    *pb.GetLogsResponse
+		wantErr bool
+	}{
+		{
+			// Test case with calling GetLogs method with incorrect pipelineId.
+			// As a result, want to receive an error
+			name:    "incorrect pipelineId",
+			prepare: func() {},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetLogsRequest{PipelineUuid: "NO_UUID_STRING"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetRLogs method with pipelineId which doesn't exist.
+			// As a result, want to receive an error.
+			name:    "pipelineId doesn't exist",
+			prepare: func() {},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetLogsRequest{PipelineUuid: pipelineId.String()},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetLogs method with pipelineId which doesn't contain logs.
+			// As a result, want to receive an error.
+			name: "logs don't exist",
+			prepare: func() {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_EXECUTING)
+			},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetLogsRequest{PipelineUuid: pipelineId.String()},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetLogs method with pipelineId which contains logs.
+			// As a result want to receive response with an expected logs.
+			name: "logs exist",
+			prepare: func() {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.LogsIndex, 0)
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Logs, logs)
+			},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetLogsRequest{PipelineUuid: pipelineId.String()},
+			},
+			want:    &pb.GetLogsResponse{Output: logs},
+			wantErr: false,
+		},
+		{
+			// Test case with calling GetLogs method with pipelineId which contain logs and index of logs is 1.
+			// As a result want to receive response with correct logs (logs[1:]).
+			name: "get the second part",
+			prepare: func() {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.LogsIndex, 1)
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Logs, logs)
+			},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetLogsRequest{PipelineUuid: pipelineId.String()},
+			},
+			want:    &pb.GetLogsResponse{Output: logs[1:]},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			got, err := client.GetLogs(tt.args.ctx, tt.args.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetLogs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if !strings.EqualFold(got.Output, tt.want.Output) {
+					t.Errorf("GetLogs() got = %v, want %v", got.Output, tt.want.Output)
+				}
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_GetRunOutputPage(t *testing.T) {
+	defer goleak.VerifyNone(t, opt)
+	ctx := context.Background()
+	pipelineId := uuid.New()
+	runOutput := strings.Repeat("line with € character\n", 100)
+	if err := cacheService.SetValue(ctx, pipelineId, cache.RunOutput, runOutput); err != nil {
+		panic(err)
+	}
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
+	tests := []struct {
+		name    string
+		info    *pb.GetRunOutputPageRequest
+		wantErr bool
+	}{
+		{
+			// Test case with calling GetRunOutputPage method with incorrect pipelineId.
+			// As a result, want to receive an error.
+			name:    "incorrect pipelineId",
+			info:    &pb.GetRunOutputPageRequest{PipelineUuid: "NO_UUID_STRING"},
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetRunOutputPage method with pipelineId which doesn't exist.
+			// As a result, want to receive an error.
+			name:    "pipelineId doesn't exist",
+			info:    &pb.GetRunOutputPageRequest{PipelineUuid: uuid.New().String()},
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetRunOutputPage method with offset after the end of the output.
+			// As a result, want to receive an error.
+			name:    "offset after the end",
+			info:    &pb.GetRunOutputPageRequest{PipelineUuid: pipelineId.String(), Offset: int64(len(runOutput) + 1)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.GetRunOutputPage(ctx, tt.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PlaygroundController_GetRunOutputPage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got == nil {
+				t.Errorf("PlaygroundController_GetRunOutputPage() got nil response")
+			}
+		})
+	}
+
+	t.Run("read all pages", func(t *testing.T) {
+		// Page size is not a multiple of the line size, so pages end inside multibyte characters
+		var limit int64 = 100
+		var offset int64
+		var pages []string
+		for {
+			got, err := client.GetRunOutputPage(ctx, &pb.GetRunOutputPageRequest{PipelineUuid: pipelineId.String(), Offset: offset, Limit: limit})
+			if err != nil {
+				t.Fatalf("PlaygroundController_GetRunOutputPage() error = %v", err)
+			}
+			if got.TotalSize != int64(len(runOutput)) {
+				t.Fatalf("PlaygroundController_GetRunOutputPage() total size = %d, want %d", got.TotalSize, len(runOutput))
+			}
+			if int64(len(got.Output)) > limit || !utf8.ValidString(got.Output) {
+				t.Fatalf("PlaygroundController_GetRunOutputPage() incorrect page %q", got.Output)
+			}
+			if got.NextOffset != offset+int64(len(got.Output)) {
+				t.Fatalf("PlaygroundController_GetRunOutputPage() next offset = %d, want %d", got.NextOffset, offset+int64(len(got.Output)))
+			}
+			pages = append(pages, got.Output)
+			offset = got.NextOffset
+			if offset == got.TotalSize {
+				break
+			}
+		}
+		if strings.Join(pages, "") != runOutput {
+			t.Errorf("PlaygroundController_GetRunOutputPage() pages don't match the run output")
+		}
+	})
+}
+
+// mockStreamOutputServer is pb.PlaygroundService_StreamOutputServer which passes sent responses to the channel
+type mockStreamOutputServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *pb.StreamOutputResponse
+}
+
+func (s *mockStreamOutputServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockStreamOutputServer) Send(response *pb.StreamOutputResponse) error {
+	s.responses <- response
+	return nil
+}
+
+func TestPlaygroundController_StreamOutput(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	controller := &playgroundController{cacheService: cacheService}
+	pipelineId := uuid.New()
+	if err := cacheService.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_VALIDATING); err != nil {
+		t.Fatalf("error during set status: %s", err.Error())
+	}
+	stream := &mockStreamOutputServer{ctx: ctx, responses: make(chan *pb.StreamOutputResponse, 100)}
+	streamErr := make(chan error, 1)
+	go func() {
+		streamErr <- controller.StreamOutput(&pb.StreamOutputRequest{PipelineUuid: pipelineId.String()}, stream)
+	}()
+
+	// each stage of the fake run changes values of the pipeline when the responses of the previous stage are received
+	stages := []struct {
+		name          string
+		values        map[cache.SubKey]interface{}
+		wantResponses []*pb.StreamOutputResponse
+	}{
+		{
+			name:          "initial status",
+			wantResponses: []*pb.StreamOutputResponse{{Status: pb.Status_STATUS_VALIDATING}},
+		},
+		{
+			name:          "run step is started",
+			values:        map[cache.SubKey]interface{}{cache.RunOutput: "", cache.Status: pb.Status_STATUS_EXECUTING},
+			wantResponses: []*pb.StreamOutputResponse{{Status: pb.Status_STATUS_EXECUTING}},
+		},
+		{
+			name:          "first output",
+			values:        map[cache.SubKey]interface{}{cache.RunOutput: "MOCK_OUTPUT_1\n"},
+			wantResponses: []*pb.StreamOutputResponse{{RunOutput: "MOCK_OUTPUT_1\n"}},
+		},
+		{
+			name:   "last output with the terminal status",
+			values: map[cache.SubKey]interface{}{cache.RunOutput: "MOCK_OUTPUT_1\nMOCK_OUTPUT_2\n", cache.Status: pb.Status_STATUS_FINISHED},
+			wantResponses: []*pb.StreamOutputResponse{
+				{RunOutput: "MOCK_OUTPUT_2\n"},
+				{Status: pb.Status_STATUS_FINISHED},
+			},
+		},
+	}
+	for _, stage := range stages {
+		if stage.values != nil {
+			if err := cacheService.SetValues(ctx, pipelineId, stage.values); err != nil {
+				t.Fatalf("%s: error during set values: %s", stage.name, err.Error())
+			}
+		}
+		for _, want := range stage.wantResponses {
+			select {
+			case got := <-stream.responses:
+				if got.Status != want.Status || got.RunOutput != want.RunOutput {
+					t.Fatalf("%s: PlaygroundController_StreamOutput() response = %v, want %v", stage.name, got, want)
+				}
+			case <-ctx.Done():
+				t.Fatalf("%s: PlaygroundController_StreamOutput() doesn't send %v", stage.name, want)
+			}
+		}
+	}
+	select {
+	case err := <-streamErr:
+		if err != nil {
+			t.Errorf("PlaygroundController_StreamOutput() error = %v", err)
+		}
+	case <-ctx.Done():
+		t.Fatalf("PlaygroundController_StreamOutput() isn't finished after the terminal status")
+	}
+	select {
+	case got := <-stream.responses:
+		t.Errorf("PlaygroundController_StreamOutput() sends %v after the terminal status", got)
+	default:
+	}
+}
+
+func TestPlaygroundController_StreamOutputErrors(t *testing.T) {
+	ctx := context.Background()
+	controller := &playgroundController{cacheService: cacheService}
+	tests := []struct {
+		name         string
+		pipelineUuid string
+		wantCode     codes.Code
+	}{
+		{
+			// Test case with streaming output of the pipeline with incorrect uuid.
+			// As a result, want to receive codes.InvalidArgument.
+			name:         "incorrect uuid",
+			pipelineUuid: "MOCK_UUID",
+			wantCode:     codes.InvalidArgument,
+		},
+		{
+			// Test case with streaming output of the pipeline which doesn't exist.
+			// As a result, want to receive codes.NotFound.
+			name:         "pipeline not found",
+			pipelineUuid: uuid.NewString(),
+			wantCode:     codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &mockStreamOutputServer{ctx: ctx, responses: make(chan *pb.StreamOutputResponse, 1)}
+			err := controller.StreamOutput(&pb.StreamOutputRequest{PipelineUuid: tt.pipelineUuid}, stream)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("PlaygroundController_StreamOutput() error = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+// mockGetWorkingDirServer is pb.PlaygroundService_GetWorkingDirServer which keeps sent chunks
+type mockGetWorkingDirServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks [][]byte
+}
+
+func (s *mockGetWorkingDirServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockGetWorkingDirServer) Send(response *pb.GetWorkingDirResponse) error {
+	s.chunks = append(s.chunks, response.Chunk)
+	return nil
+}
+
+func TestPlaygroundController_GetWorkingDir(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("error during get working directory: %s", err.Error())
+	}
+	executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+	newController := func(retention time.Duration) *playgroundController {
+		appEnv := environment.NewApplicationEnvs(workingDir, "", "", baseFileFolder, environment.NewCacheEnvs("local", "", time.Minute, 0, 0, 0, "", false), time.Minute, 0, environment.NewQueueEnvs(0, 0), environment.NewSnippetEnvs(0, 0), environment.NewRateLimitEnvs(0, map[string]int{}, nil), environment.NewWorkingDirEnvs(retention, []string{"*.secret"}), environment.NewCodeEnvs(0, 0))
+		return &playgroundController{
+			env:          environment.NewEnvironment(environment.NetworkEnvs{}, *sdkEnv, *appEnv),
+			cacheService: cacheService,
+		}
+	}
+	controller := newController(time.Hour)
+	code := "if __name__ == \"__main__\":\n    open('result.txt', 'w').write('MOCK_RESULT')\n    open('token.secret', 'w').write('MOCK_SECRET')\n"
+	runCode := func(keepWorkingDir bool) uuid.UUID {
+		stream := &mockRunProgramServer{ctx: ctx}
+		request := &pb.RunCodeRequest{Code: code, Sdk: pb.Sdk_SDK_PYTHON, EnvVars: map[string]string{"MOCK_KEY": "MOCK_VALUE"}, KeepWorkingDir: keepWorkingDir}
+		if err := controller.RunProgram(request, stream); err != nil {
+			t.Fatalf("PlaygroundController_RunProgram() error = %v", err)
+		}
+		// the working directory is kept when the code processing is finished, which happens after the last status
+		controller.runs.Wait()
+		return uuid.MustParse(stream.responses[0].PipelineUuid)
+	}
+	keptPipelineId := runCode(true)
+	defer os.RemoveAll(filepath.Join(workingDir, baseFileFolder, keptPipelineId.String()))
+	notKeptPipelineId := runCode(false)
+
+	tests := []struct {
+		name         string
+		pipelineUuid string
+		wantCode     codes.Code
+		// wantFiles are contents of files which should be in the archive, other files of the code could be there too
+		wantFiles map[string]string
+		// excludedFiles are names of files which shouldn't be in the archive
+		excludedFiles []string
+	}{
+		{
+			// Test case with getting the working directory of the code which is requested to be kept.
+			// As a result, want to receive the archive with files of the code except excluded files and environment variables.
+			name:          "working directory is kept",
+			pipelineUuid:  keptPipelineId.String(),
+			wantCode:      codes.OK,
+			wantFiles:     map[string]string{"result.txt": "MOCK_RESULT"},
+			excludedFiles: []string{"token.secret", "env_vars.json"},
+		},
+		{
+			// Test case with getting the working directory of the code which isn't requested to be kept.
+			// As a result, want to receive codes.NotFound.
+			name:         "working directory isn't kept",
+			pipelineUuid: notKeptPipelineId.String(),
+			wantCode:     codes.NotFound,
+		},
+		{
+			// Test case with getting the working directory with incorrect uuid.
+			// As a result, want to receive codes.InvalidArgument.
+			name:         "incorrect uuid",
+			pipelineUuid: "MOCK_UUID",
+			wantCode:     codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &mockGetWorkingDirServer{ctx: ctx}
+			err := controller.GetWorkingDir(&pb.GetWorkingDirRequest{PipelineUuid: tt.pipelineUuid}, stream)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("PlaygroundController_GetWorkingDir() error = %v, want code %v", err, tt.wantCode)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			archive := bytes.Join(stream.chunks, nil)
+			reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+			if err != nil {
+				t.Fatalf("error during read zip archive: %s", err.Error())
+			}
+			files := make(map[string]string, len(reader.File))
+			for _, file := range reader.File {
+				fileReader, err := file.Open()
+				if err != nil {
+					t.Fatalf("error during open %s: %s", file.Name, err.Error())
+				}
+				content, err := io.ReadAll(fileReader)
+				fileReader.Close()
+				if err != nil {
+					t.Fatalf("error during read %s: %s", file.Name, err.Error())
+				}
+				files[file.Name] = string(content)
+			}
+			for name, want := range tt.wantFiles {
+				if got, ok := files[name]; !ok || got != want {
+					t.Errorf("PlaygroundController_GetWorkingDir() file %s = %q, want %q", name, got, want)
+				}
+			}
+			for _, name := range tt.excludedFiles {
+				if _, ok := files[name]; ok {
+					t.Errorf("PlaygroundController_GetWorkingDir() archive contains excluded file %s", name)
+				}
+			}
+		})
+	}
+
+	// the server which doesn't keep working directories rejects requests to keep them
+	_, err = newController(0).RunCode(ctx, &pb.RunCodeRequest{Code: code, Sdk: pb.Sdk_SDK_PYTHON, KeepWorkingDir: true})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("PlaygroundController_RunCode() error = %v, want code %v", err, codes.InvalidArgument)
+	}
+}
+
+func TestPlaygroundController_GetLogsPage(t *testing.T) {
+	defer goleak.VerifyNone(t, opt)
+	ctx := context.Background()
+	pipelineId := uuid.New()
+	logs := "first log line\nsecond log line\n"
+	if err := cacheService.SetValue(ctx, pipelineId, cache.Logs, logs); err != nil {
+		panic(err)
+	}
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
+	tests := []struct {
+		name    string
+		info    *pb.GetLogsPageRequest
+		want    *pb.GetLogsPageResponse
+		wantErr bool
+	}{
+		{
+			// Test case with calling GetLogsPage method with incorrect pipelineId.
+			// As a result, want to receive an error.
+			name:    "incorrect pipelineId",
+			info:    &pb.GetLogsPageRequest{PipelineUuid: "NO_UUID_STRING"},
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetLogsPage method with offset and limit.
+			// As a result, want to receive the requested part of logs.
+			name:    "second line",
+			info:    &pb.GetLogsPageRequest{PipelineUuid: pipelineId.String(), Offset: 15, Limit: 16},
+			want:    &pb.GetLogsPageResponse{Output: "second log line\n", NextOffset: 31, TotalSize: 31},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.GetLogsPage(ctx, tt.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PlaygroundController_GetLogsPage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.Output != tt.want.Output || got.NextOffset != tt.want.NextOffset || got.TotalSize != tt.want.TotalSize {
+				t.Errorf("PlaygroundController_GetLogsPage() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_GetRunError(t *testing.T) {
+	defer goleak.VerifyNone(t, opt)
+	ctx := context.Background()
+	pipelineId := uuid.New()
+	runError := "MOCK_RUN_ERROR"
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
+	type args struct {
+		ctx  context.Context
+		info *pb.GetRunErrorRequest
+	}
+	tests := []struct {
+		name    string
+		prepare func()
+		args    args
+		want    *pb.GetRunErrorResponse
+		wantErr bool
+	}{
+		{
+			// Test case with calling GetRunError method with incorrect pipelineId.
+			// As a result, want to receive an error
+			name:    "incorrect pipelineId",
+			prepare: func() {},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetRunErrorRequest{PipelineUuid: "NO_UUID_STRING"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetRunError method with pipelineId which doesn't exist.
+			// As a result, want to receive an error.
+			name:    "pipelineId doesn't exist",
+			prepare: func() {},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetRunErrorRequest{PipelineUuid: pipelineId.String()},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetRunError method with pipelineId which doesn't contain run error.
+			// As a result, want to receive an error.
+			name: "run error output doesn't exist",
+			prepare: func() {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_VALIDATING)
+			},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetRunErrorRequest{PipelineUuid: pipelineId.String()},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetRunError method with pipelineId which contains run error.
+			// As a result want to receive response with an expected run error .
+			name: "run error output exist",
+			prepare: func() {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.RunError, runError)
+			},
+			args: args{
+				ctx:  ctx,
+				info: &pb.GetRunErrorRequest{PipelineUuid: pipelineId.String()},
+			},
+			want:    &pb.GetRunErrorResponse{Output: runError},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			got, err := client.GetRunError(tt.args.ctx, tt.args.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetRunError() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if !strings.EqualFold(got.Output, tt.want.Output) {
+					t.Errorf("GetRunError() got = %v, want %v", got.Output, tt.want.Output)
+				}
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_GetGraph(t *testing.T) {
+	ctx := context.Background()
+	dot := "digraph G {\n  \"n1\" [label=\"ReadLines\"];\n  \"n2\" [label=\"WriteCounts\"];\n  \"n1\" -> \"n2\";\n}\n"
+	graph := cache.PipelineGraph{
+		Nodes: []cache.GraphNode{{Id: "n1", Label: "ReadLines"}, {Id: "n2", Label: "WriteCounts"}},
+		Edges: []cache.GraphEdge{{From: "n1", To: "n2"}},
+	}
+	graphJson := `{"nodes":[{"id":"n1","label":"ReadLines"},{"id":"n2","label":"WriteCounts"}],"edges":[{"from":"n1","to":"n2","label":""}]}`
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
+	tests := []struct {
+		name string
+		// prepare sets values of the pipeline to cache
+		prepare  func(pipelineId uuid.UUID)
+		info     *pb.GetGraphRequest
+		want     *pb.GetGraphResponse
+		wantCode codes.Code
+	}{
+		{
+			// Test case with getting the graph which is kept as a string in DOT format.
+			// As a result, want to receive the graph in DOT format and as JSON.
+			name: "graph in DOT format",
+			prepare: func(pipelineId uuid.UUID) {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Graph, dot)
+			},
+			info:     &pb.GetGraphRequest{},
+			want:     &pb.GetGraphResponse{Graph: dot, Ready: true, Dot: dot, Json: graphJson},
+			wantCode: codes.OK,
+		},
+		{
+			// Test case with getting the graph which is kept as the structured graph.
+			// As a result, want to receive the graph in DOT format and as JSON.
+			name: "structured graph",
+			prepare: func(pipelineId uuid.UUID) {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Graph, graph)
+			},
+			info:     &pb.GetGraphRequest{},
+			want:     &pb.GetGraphResponse{Graph: graphJson, Ready: true, Dot: dot, Json: graphJson},
+			wantCode: codes.OK,
+		},
+		{
+			// Test case with getting the graph of the pipeline which hasn't prepared it yet.
+			// As a result, want to receive the response which isn't ready.
+			name:     "graph isn't ready",
+			prepare:  func(pipelineId uuid.UUID) {},
+			info:     &pb.GetGraphRequest{},
+			want:     &pb.GetGraphResponse{Ready: false},
+			wantCode: codes.OK,
+		},
+		{
+			// Test case with getting the graph by the pipelineId which couldn't be parsed.
+			// As a result, want to receive the InvalidArgument error.
+			name:     "incorrect pipelineId",
+			prepare:  func(pipelineId uuid.UUID) {},
+			info:     &pb.GetGraphRequest{PipelineUuid: "NO_UUID_STRING"},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			tt.prepare(pipelineId)
+			if tt.info.PipelineUuid == "" {
+				tt.info.PipelineUuid = pipelineId.String()
+			}
+			got, err := client.GetGraph(ctx, tt.info)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("GetGraph() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("GetGraph() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// countingCache counts reads of each subKey from the wrapped cache
+type countingCache struct {
+	cache.Cache
+	mu    sync.Mutex
+	reads map[cache.SubKey]int
+}
+
+func (c *countingCache) GetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
+	c.mu.Lock()
+	c.reads[subKey]++
+	c.mu.Unlock()
+	return c.Cache.GetValue(ctx, pipelineId, subKey)
+}
+
+func TestPlaygroundController_GetGraph_ReadsGraphOnce(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	counting := &countingCache{Cache: local.New(ctx), reads: make(map[cache.SubKey]int)}
+	controller := &playgroundController{cacheService: memo.New(counting)}
+	pipelineId := uuid.New()
+	if err := counting.SetValue(ctx, pipelineId, cache.Graph, "digraph G {}"); err != nil {
+		t.Fatalf("error during set graph: %s", err.Error())
+	}
+
+	got, err := controller.GetGraph(ctx, &pb.GetGraphRequest{PipelineUuid: pipelineId.String()})
+	if err != nil {
+		t.Fatalf("GetGraph() error = %v", err)
+	}
+	if !got.Ready {
+		t.Errorf("GetGraph() got = %v, want the ready graph", got)
+	}
+	if reads := counting.reads[cache.Graph]; reads != 1 {
+		t.Errorf("GetGraph() reads the graph %d times, want 1", reads)
+	}
+}
+
+func TestPlaygroundController_Cancel(t *testing.T) {
+	defer goleak.VerifyNone(t, opt)
+	ctx := context.Background()
+	pipelineId := uuid.New()
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
+	type args struct {
+		ctx  context.Context
+		info *pb.CancelRequest
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *pb.CancelResponse
+		checkFunc func() bool
+		wantErr   bool
+	}{
+		{
+			// Test case with calling Cancel method with incorrect pipelineId.
+			// As a result, want to receive an error
+			name: "incorrect pipelineId",
+			args: args{
+				ctx:  ctx,
+				info: &pb.CancelRequest{PipelineUuid: "NO_UUID_STRING"},
+			},
+			checkFunc: func() bool {
+				return true
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// Test case with calling Cancel method.
+			// As a result, want to find value in cache for cache.Canceled subKey.
+			name: "set cancel without error",
+			args: args{
+				ctx:  ctx,
+				info: &pb.CancelRequest{PipelineUuid: pipelineId.String()},
+			},
+			checkFunc: func() bool {
+				value, err := cacheService.GetValue(context.Background(), pipelineId, cache.Canceled)
+				if err != nil {
+					return false
+				}
+				return value.(bool)
+			},
+			want:    &pb.CancelResponse{},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.Cancel(tt.args.ctx, tt.args.info); (err != nil) != tt.wantErr {
+				t.Errorf("Cancel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.checkFunc() {
+				t.Error("Cancel() doesn't set canceled flag")
+			}
+		})
+	}
+}
+
+func TestPlaygroundController_GetMetadata(t *testing.T) {
+	defer goleak.VerifyNone(t, opt)
+	ctx := context.Background()
+	partialPipelineId := uuid.New()
+	completePipelineId := uuid.New()
+	expTime := 15 * time.Minute
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewPlaygroundServiceClient(conn)
+
+	type args struct {
+		ctx  context.Context
+		info *pb.GetMetadataRequest
+	}
+	tests := []struct {
+		name    string
+		prepare func()
+		args    args
+		want    []*pb.SubKeyMetadata
+		wantErr bool
+	}{
+		{
+			// Test case with calling GetMetadata method with incorrect pipelineId.
 			// As a result, want to receive an error.
-			name:    "pipelineId doesn't exist",
+			name:    "incorrect pipelineId",
 			prepare: func() {},
 			args: args{
 				ctx:  ctx,
-				info: &pb.GetRunOutputRequest{PipelineUuid: pipelineId.String()},
+				info: &pb.GetMetadataRequest{PipelineUuid: "NO_UUID_STRING"},
 			},
 			want:    nil,
 			wantErr: true,
 		},
 		{
-			// Test case with calling GetRunOutput method with pipelineId which doesn't contain run output.
+			// Test case with calling GetMetadata method with pipelineId which doesn't exist.
 			// As a result, want to receive an error.
-			name: "run output doesn't exist",
-			prepare: func() {
-				_ = cacheService.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_EXECUTING)
-			},
+			name:    "pipelineId doesn't exist",
+			prepare: func() {},
 			args: args{
 				ctx:  ctx,
-				info: &pb.GetRunOutputRequest{PipelineUuid: pipelineId.String()},
+				info: &pb.GetMetadataRequest{PipelineUuid: uuid.New().String()},
 			},
 			want:    nil,
 			wantErr: true,
 		},
 		{
-			// Test case with calling GetRunOutput method with pipelineId which contains run output.
-			// As a result want to receive response with an expected run output.
-			name: "run output exist",
+			// Test case with calling GetMetadata method with pipelineId of the running code processing.
+			// As a result, want to receive only values which are already available.
+			name: "partial pipeline",
 			prepare: func() {
-				_ = cacheService.SetValue(ctx, pipelineId, cache.RunOutputIndex, 0)
-				_ = cacheService.SetValue(ctx, pipelineId, cache.RunOutput, runOutput)
+				_ = cacheService.SetValue(ctx, partialPipelineId, cache.Status, pb.Status_STATUS_EXECUTING)
+				_ = cacheService.SetValue(ctx, partialPipelineId, cache.RunOutput, "line 1\n")
+				_ = cacheService.SetExpTime(ctx, partialPipelineId, expTime)
 			},
 			args: args{
 				ctx:  ctx,
-				info: &pb.GetRunOutputRequest{PipelineUuid: pipelineId.String()},
+				info: &pb.GetMetadataRequest{PipelineUuid: partialPipelineId.String()},
+			},
+			want: []*pb.SubKeyMetadata{
+				{SubKey: string(cache.RunOutput), Size: 7, TtlSeconds: int64(expTime / time.Second)},
+				{SubKey: string(cache.Status), Size: 1, TtlSeconds: int64(expTime / time.Second)},
 			},
-			want:    &pb.GetRunOutputResponse{Output: runOutput},
 			wantErr: false,
 		},
 		{
-			// Test case with calling RunOutput method with pipelineId which contain run output and index of run output is 1.
-			// As a result want to receive response with correct output (output[1:]).
-			name: "get the second part",
+			// Test case with calling GetMetadata method with pipelineId of the finished code processing.
+			// As a result, want to receive all values of the pipeline with their time to live.
+			name: "complete pipeline",
 			prepare: func() {
-				_ = cacheService.SetValue(ctx, pipelineId, cache.RunOutputIndex, 1)
-				_ = cacheService.SetValue(ctx, pipelineId, cache.RunOutput, runOutput)
+				_ = cacheService.SetValues(ctx, completePipelineId, map[cache.SubKey]interface{}{
+					cache.Status:        pb.Status_STATUS_FINISHED,
+					cache.CompileOutput: "",
+					cache.RunOutput:     "done",
+					cache.Logs:          "log",
+					cache.Graph:         "digraph {}",
+				})
+				_ = cacheService.SetExpTime(ctx, completePipelineId, expTime)
 			},
 			args: args{
 				ctx:  ctx,
-				info: &pb.GetRunOutputRequest{PipelineUuid: pipelineId.String()},
+				info: &pb.GetMetadataRequest{PipelineUuid: completePipelineId.String()},
+			},
+			want: []*pb.SubKeyMetadata{
+				{SubKey: string(cache.CompileOutput), Size: 0, TtlSeconds: int64(expTime / time.Second)},
+				{SubKey: string(cache.Graph), Size: 10, TtlSeconds: int64(expTime / time.Second)},
+				{SubKey: string(cache.Logs), Size: 3, TtlSeconds: int64(expTime / time.Second)},
+				{SubKey: string(cache.RunOutput), Size: 4, TtlSeconds: int64(expTime / time.Second)},
+				{SubKey: string(cache.Status), Size: 1, TtlSeconds: int64(expTime / time.Second)},
 			},
-			want:    &pb.GetRunOutputResponse{Output: runOutput[1:]},
 			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.prepare()
-			got, err := client.GetRunOutput(tt.args.ctx, tt.args.info)
+			tt.prepare()
+			got, err := client.GetMetadata(tt.args.ctx, tt.args.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetMetadata() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got.SubKeys) != len(tt.want) {
+				t.Fatalf("GetMetadata() got = %v, want %v", got.SubKeys, tt.want)
+			}
+			for i, subKey := range got.SubKeys {
+				want := tt.want[i]
+				// the time to live is decreased while the test is running
+				ttlMatches := subKey.TtlSeconds > want.TtlSeconds-5 && subKey.TtlSeconds <= want.TtlSeconds
+				if subKey.SubKey != want.SubKey || subKey.Size != want.Size || !ttlMatches {
+					t.Errorf("GetMetadata() got %d = %v, want %v", i, subKey, want)
+				}
+			}
+		})
+	}
+}
+
+// fakeExamplesStorage is the examples storage which returns seeded examples filtered by sdk and category
+type fakeExamplesStorage struct {
+	examples cloud_bucket.SdkToCategories
+	objects  map[string]cloud_bucket.PrecompiledObjectData
+	err      error
+}
+
+func (storage *fakeExamplesStorage) GetPrecompiledObjectData(ctx context.Context, precompiledObjectPath string) (*cloud_bucket.PrecompiledObjectData, error) {
+	if storage.err != nil {
+		return nil, storage.err
+	}
+	data, ok := storage.objects[precompiledObjectPath]
+	if !ok {
+		return nil, cloud_bucket.ErrPrecompiledObjectNotFound
+	}
+	return &data, nil
+}
+
+func (storage *fakeExamplesStorage) GetPrecompiledObjects(ctx context.Context, targetSdk pb.Sdk, targetCategory string) (*cloud_bucket.SdkToCategories, error) {
+	if storage.err != nil {
+		return nil, storage.err
+	}
+	result := make(cloud_bucket.SdkToCategories, 0)
+	for sdkName, categories := range storage.examples {
+		if targetSdk != pb.Sdk_SDK_UNSPECIFIED && targetSdk.String() != sdkName {
+			continue
+		}
+		result[sdkName] = make(cloud_bucket.CategoryToPrecompiledObjects, 0)
+		for categoryName, examples := range categories {
+			if targetCategory == "" || targetCategory == categoryName {
+				result[sdkName][categoryName] = examples
+			}
+		}
+	}
+	return &result, nil
+}
+
+func TestPlaygroundController_SearchExamples(t *testing.T) {
+	ctx := context.Background()
+	examples := cloud_bucket.SdkToCategories{
+		"SDK_JAVA": cloud_bucket.CategoryToPrecompiledObjects{
+			"Common": cloud_bucket.PrecompiledObjects{
+				{Name: "WordCount", CloudPath: "SDK_JAVA/Common/WordCount", Description: "Counts words in the text"},
+				{Name: "Filter", CloudPath: "SDK_JAVA/Common/Filter", Description: "Filters elements"},
+			},
+			"IO": cloud_bucket.PrecompiledObjects{
+				{Name: "TextIO", CloudPath: "SDK_JAVA/IO/TextIO", Description: "Reads lines of the text"},
+			},
+		},
+		"SDK_GO": cloud_bucket.CategoryToPrecompiledObjects{
+			"Common": cloud_bucket.PrecompiledObjects{
+				{Name: "MinimalWordCount", CloudPath: "SDK_GO/Common/MinimalWordCount", Description: "Minimal example"},
+			},
+		},
+		"SDK_PYTHON": cloud_bucket.CategoryToPrecompiledObjects{
+			"Common": cloud_bucket.PrecompiledObjects{
+				{Name: "WordCount", CloudPath: "SDK_PYTHON/Common/WordCount", Description: "Counts words in the TEXT"},
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		storage *fakeExamplesStorage
+		info    *pb.SearchExamplesRequest
+		// want is cloud paths of the returned examples
+		want           []string
+		wantNextOffset int32
+		wantTotalCount int32
+		wantErr        bool
+	}{
+		{
+			// Test case with searching the term in names and descriptions of examples of all SDKs.
+			// As a result, want to receive examples which names or descriptions contain the term case-insensitively.
+			name:           "term in all sdks",
+			storage:        &fakeExamplesStorage{examples: examples},
+			info:           &pb.SearchExamplesRequest{Term: "text"},
+			want:           []string{"SDK_JAVA/Common/WordCount", "SDK_JAVA/IO/TextIO", "SDK_PYTHON/Common/WordCount"},
+			wantNextOffset: 3,
+			wantTotalCount: 3,
+			wantErr:        false,
+		},
+		{
+			// Test case with searching the term in examples of several SDKs.
+			// As a result, want to receive examples of these SDKs only.
+			name:           "several sdks",
+			storage:        &fakeExamplesStorage{examples: examples},
+			info:           &pb.SearchExamplesRequest{Sdks: []pb.Sdk{pb.Sdk_SDK_GO, pb.Sdk_SDK_PYTHON}, Term: "WORDCOUNT"},
+			want:           []string{"SDK_GO/Common/MinimalWordCount", "SDK_PYTHON/Common/WordCount"},
+			wantNextOffset: 2,
+			wantTotalCount: 2,
+			wantErr:        false,
+		},
+		{
+			// Test case with searching examples of the SDK and the category.
+			// As a result, want to receive examples of the category of this SDK.
+			name:           "sdk and category",
+			storage:        &fakeExamplesStorage{examples: examples},
+			info:           &pb.SearchExamplesRequest{Sdks: []pb.Sdk{pb.Sdk_SDK_JAVA}, Category: "Common"},
+			want:           []string{"SDK_JAVA/Common/Filter", "SDK_JAVA/Common/WordCount"},
+			wantNextOffset: 2,
+			wantTotalCount: 2,
+			wantErr:        false,
+		},
+		{
+			// Test case with getting the second page of found examples.
+			// As a result, want to receive the page which starts at the offset and the offset of the next page.
+			name:           "second page",
+			storage:        &fakeExamplesStorage{examples: examples},
+			info:           &pb.SearchExamplesRequest{Offset: 1, Limit: 2},
+			want:           []string{"SDK_JAVA/Common/Filter", "SDK_JAVA/Common/WordCount"},
+			wantNextOffset: 3,
+			wantTotalCount: 5,
+			wantErr:        false,
+		},
+		{
+			// Test case with getting the page which starts out of found examples.
+			// As a result, want to receive an error.
+			name:    "offset is out of examples",
+			storage: &fakeExamplesStorage{examples: examples},
+			info:    &pb.SearchExamplesRequest{Offset: 6},
+			wantErr: true,
+		},
+		{
+			// Test case with the error of the examples storage.
+			// As a result, want to receive an error.
+			name:    "error of storage",
+			storage: &fakeExamplesStorage{err: fmt.Errorf("MOCK_ERROR")},
+			info:    &pb.SearchExamplesRequest{Term: "text"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := &playgroundController{examplesStorage: tt.storage}
+			got, err := controller.SearchExamples(ctx, tt.info)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GetRunOutput() error = %v, wantErr %v", err, tt.wantErr)
+				t.Fatalf("PlaygroundController_SearchExamples() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
 				return
 			}
-			if !tt.wantErr {
-				if !strings.EqualFold(got.Output, tt.want.Output) {
-					t.Errorf("GetRunOutput() got = %v, want %v", got.Output, tt.want.Output)
-				}
+			cloudPaths := make([]string, 0)
+			for _, example := range got.PrecompiledObjects {
+				cloudPaths = append(cloudPaths, example.CloudPath)
+			}
+			if !reflect.DeepEqual(cloudPaths, tt.want) {
+				t.Errorf("PlaygroundController_SearchExamples() got = %v, want %v", cloudPaths, tt.want)
+			}
+			if got.NextOffset != tt.wantNextOffset || got.TotalCount != tt.wantTotalCount {
+				t.Errorf("PlaygroundController_SearchExamples() nextOffset = %d, totalCount = %d, want %d, %d", got.NextOffset, got.TotalCount, tt.wantNextOffset, tt.wantTotalCount)
 			}
 		})
 	}
 }
 
-func TestPlaygroundController_GetLogs(t *testing.T) {
-	defer goleak.VerifyNone(t, opt)
+func TestPlaygroundController_GetPrecompiledObject(t *testing.T) {
 	ctx := context.Background()
-	pipelineId := uuid.New()
-	logs := "MOCK_LOGS"
-	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
-	if err != nil {
-		t.Fatalf("Failed to dial bufnet: %v", err)
+	precompiledObject := cloud_bucket.PrecompiledObjectData{
+		Info: cloud_bucket.ObjectInfo{
+			Name:            "WordCount",
+			CloudPath:       "SDK_JAVA/WordCount",
+			Description:     "Counts words in the text",
+			Type:            pb.PrecompiledObjectType_PRECOMPILED_OBJECT_TYPE_EXAMPLE,
+			PipelineOptions: "--output output.txt",
+		},
+		Sdk:              pb.Sdk_SDK_JAVA,
+		Code:             "MOCK_CODE",
+		CompileOutput:    "MOCK_COMPILE_OUTPUT",
+		RunOutput:        "MOCK_RUN_OUTPUT",
+		HasCompileOutput: true,
+		HasRunOutput:     true,
 	}
-	defer conn.Close()
-	client := pb.NewPlaygroundServiceClient(conn)
-
-	type args struct {
-		ctx  context.Context
-		info *pb.GetLogsRequest
+	withoutOutputs := cloud_bucket.PrecompiledObjectData{
+		Info: cloud_bucket.ObjectInfo{Name: "Filter", CloudPath: "SDK_GO/Filter"},
+		Sdk:  pb.Sdk_SDK_GO,
+		Code: "MOCK_CODE",
 	}
+	storage := &fakeExamplesStorage{objects: map[string]cloud_bucket.PrecompiledObjectData{
+		precompiledObject.Info.CloudPath: precompiledObject,
+		withoutOutputs.Info.CloudPath:    withoutOutputs,
+	}}
 	tests := []struct {
-		name    string
-		prepare func()
-		args    args
-		want    *pb.GetLogsResponse
-		wantErr bool
+		name     string
+		storage  *fakeExamplesStorage
+		info     *pb.GetPrecompiledObjectRequest
+		want     *pb.GetPrecompiledObjectResponse
+		wantCode codes.Code
 	}{
 		{
-			// Test case with calling GetLogs method with incorrect pipelineId.
-			// As a result, want to receive an error
-			name:    "incorrect pipelineId",
-			prepare: func() {},
-			args: args{
-				ctx:  ctx,
-				info: &pb.GetLogsRequest{PipelineUuid: "NO_UUID_STRING"},
+			// Test case with getting the example which outputs are precompiled.
+			// As a result, want to receive the code, the information and outputs of the example.
+			name:    "precompiled example",
+			storage: storage,
+			info:    &pb.GetPrecompiledObjectRequest{CloudPath: "SDK_JAVA/WordCount"},
+			want: &pb.GetPrecompiledObjectResponse{
+				PrecompiledObject: &pb.PrecompiledObject{
+					CloudPath:       "SDK_JAVA/WordCount",
+					Name:            "WordCount",
+					Description:     "Counts words in the text",
+					Type:            pb.PrecompiledObjectType_PRECOMPILED_OBJECT_TYPE_EXAMPLE,
+					PipelineOptions: "--output output.txt",
+				},
+				Sdk:              pb.Sdk_SDK_JAVA,
+				Code:             "MOCK_CODE",
+				CompileOutput:    "MOCK_COMPILE_OUTPUT",
+				RunOutput:        "MOCK_RUN_OUTPUT",
+				HasCompileOutput: true,
+				HasRunOutput:     true,
 			},
-			want:    nil,
-			wantErr: true,
+			wantCode: codes.OK,
 		},
 		{
-			// Test case with calling GetRLogs method with pipelineId which doesn't exist.
-			// As a result, want to receive an error.
-			name:    "pipelineId doesn't exist",
-			prepare: func() {},
-			args: args{
-				ctx:  ctx,
-				info: &pb.GetLogsRequest{PipelineUuid: pipelineId.String()},
+			// Test case with getting the example which outputs aren't precompiled.
+			// As a result, want to receive the code of the example and empty outputs which are marked as missing.
+			name:    "example without precompiled outputs",
+			storage: storage,
+			info:    &pb.GetPrecompiledObjectRequest{CloudPath: "SDK_GO/Filter"},
+			want: &pb.GetPrecompiledObjectResponse{
+				PrecompiledObject: &pb.PrecompiledObject{CloudPath: "SDK_GO/Filter", Name: "Filter"},
+				Sdk:               pb.Sdk_SDK_GO,
+				Code:              "MOCK_CODE",
+				HasCompileOutput:  false,
+				HasRunOutput:      false,
 			},
-			want:    nil,
-			wantErr: true,
+			wantCode: codes.OK,
 		},
 		{
-			// Test case with calling GetLogs method with pipelineId which doesn't contain logs.
-			// As a result, want to receive an error.
-			name: "logs don't exist",
-			prepare: func() {
-				_ = cacheService.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_EXECUTING)
-			},
-			args: args{
-				ctx:  ctx,
-				info: &pb.GetLogsRequest{PipelineUuid: pipelineId.String()},
-			},
-			want:    nil,
-			wantErr: true,
+			// Test case with getting the example which doesn't exist.
+			// As a result, want to receive codes.NotFound.
+			name:     "unknown example",
+			storage:  storage,
+			info:     &pb.GetPrecompiledObjectRequest{CloudPath: "SDK_GO/Unknown"},
+			wantCode: codes.NotFound,
 		},
 		{
-			// Test case with calling GetLogs method with pipelineId which contains logs.
-			// As a result want to receive response with an expected logs.
-			name: "logs exist",
-			prepare: func() {
-				_ = cacheService.SetValue(ctx, pipelineId, cache.LogsIndex, 0)
-				_ = cacheService.SetValue(ctx, pipelineId, cache.Logs, logs)
-			},
-			args: args{
-				ctx:  ctx,
-				info: &pb.GetLogsRequest{PipelineUuid: pipelineId.String()},
-			},
-			want:    &pb.GetLogsResponse{Output: logs},
-			wantErr: false,
+			// Test case with the empty cloud path.
+			// As a result, want to receive codes.InvalidArgument.
+			name:     "empty cloud path",
+			storage:  storage,
+			info:     &pb.GetPrecompiledObjectRequest{},
+			wantCode: codes.InvalidArgument,
 		},
 		{
-			// Test case with calling GetLogs method with pipelineId which contain logs and index of logs is 1.
-			// As a result want to receive response with correct logs (logs[1:]).
-			name: "get the second part",
-			prepare: func() {
-				_ = cacheService.SetValue(ctx, pipelineId, cache.LogsIndex, 1)
-				_ = cacheService.SetValue(ctx, pipelineId, cache.Logs, logs)
-			},
-			args: args{
-				ctx:  ctx,
-				info: &pb.GetLogsRequest{PipelineUuid: pipelineId.String()},
-			},
-			want:    &pb.GetLogsResponse{Output: logs[1:]},
-			wantErr: false,
+			// Test case with the error of the examples storage.
+			// As a result, want to receive codes.Internal.
+			name:     "error of storage",
+			storage:  &fakeExamplesStorage{err: fmt.Errorf("MOCK_ERROR")},
+			info:     &pb.GetPrecompiledObjectRequest{CloudPath: "SDK_JAVA/WordCount"},
+			wantCode: codes.Internal,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.prepare()
-			got, err := client.GetLogs(tt.args.ctx, tt.args.info)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("GetLogs() error = %v, wantErr %v", err, tt.wantErr)
+			controller := &playgroundController{examplesStorage: tt.storage}
+			got, err := controller.GetPrecompiledObject(ctx, tt.info)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("PlaygroundController_GetPrecompiledObject() error = %v, wantCode %v", err, tt.wantCode)
+			}
+			if err != nil {
 				return
 			}
-			if !tt.wantErr {
-				if !strings.EqualFold(got.Output, tt.want.Output) {
-					t.Errorf("GetLogs() got = %v, want %v", got.Output, tt.want.Output)
-				}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("PlaygroundController_GetPrecompiledObject() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestPlaygroundController_GetRunError(t *testing.T) {
-	defer goleak.VerifyNone(t, opt)
+func TestPlaygroundController_SaveAndGetSnippet(t *testing.T) {
 	ctx := context.Background()
-	pipelineId := uuid.New()
-	runError := "MOCK_RUN_ERROR"
 	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
 	if err != nil {
 		t.Fatalf("Failed to dial bufnet: %v", err)
@@ -590,91 +2847,55 @@ func TestPlaygroundController_GetRunError(t *testing.T) {
 	defer conn.Close()
 	client := pb.NewPlaygroundServiceClient(conn)
 
-	type args struct {
-		ctx  context.Context
-		info *pb.GetRunErrorRequest
-	}
 	tests := []struct {
 		name    string
-		prepare func()
-		args    args
-		want    *pb.GetRunErrorResponse
+		request *pb.SaveSnippetRequest
 		wantErr bool
 	}{
 		{
-			// Test case with calling GetRunError method with incorrect pipelineId.
-			// As a result, want to receive an error
-			name:    "incorrect pipelineId",
-			prepare: func() {},
-			args: args{
-				ctx:  ctx,
-				info: &pb.GetRunErrorRequest{PipelineUuid: "NO_UUID_STRING"},
-			},
-			want:    nil,
-			wantErr: true,
+			// Test case with saving the snippet and getting it by the returned id.
+			// As a result, want to receive the saved code, sdk and pipeline options.
+			name:    "round trip of the snippet",
+			request: &pb.SaveSnippetRequest{Code: "class Snippet {\n}\n", Sdk: pb.Sdk_SDK_JAVA, PipelineOptions: "--output out.txt"},
+			wantErr: false,
 		},
 		{
-			// Test case with calling GetRunError method with pipelineId which doesn't exist.
+			// Test case with saving the snippet without sdk.
 			// As a result, want to receive an error.
-			name:    "pipelineId doesn't exist",
-			prepare: func() {},
-			args: args{
-				ctx:  ctx,
-				info: &pb.GetRunErrorRequest{PipelineUuid: pipelineId.String()},
-			},
-			want:    nil,
+			name:    "unspecified sdk",
+			request: &pb.SaveSnippetRequest{Code: "MOCK_CODE", Sdk: pb.Sdk_SDK_UNSPECIFIED},
 			wantErr: true,
 		},
 		{
-			// Test case with calling GetRunError method with pipelineId which doesn't contain run error.
+			// Test case with saving the snippet which exceeds the max size of snippets.
 			// As a result, want to receive an error.
-			name: "run error output doesn't exist",
-			prepare: func() {
-				_ = cacheService.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_VALIDATING)
-			},
-			args: args{
-				ctx:  ctx,
-				info: &pb.GetRunErrorRequest{PipelineUuid: pipelineId.String()},
-			},
-			want:    nil,
+			name:    "snippet is too large",
+			request: &pb.SaveSnippetRequest{Code: strings.Repeat("a", 1024*1024), Sdk: pb.Sdk_SDK_JAVA},
 			wantErr: true,
 		},
-		{
-			// Test case with calling GetRunError method with pipelineId which contains run error.
-			// As a result want to receive response with an expected run error .
-			name: "run error output exist",
-			prepare: func() {
-				_ = cacheService.SetValue(ctx, pipelineId, cache.RunError, runError)
-			},
-			args: args{
-				ctx:  ctx,
-				info: &pb.GetRunErrorRequest{PipelineUuid: pipelineId.String()},
-			},
-			want:    &pb.GetRunErrorResponse{Output: runError},
-			wantErr: false,
-		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.prepare()
-			got, err := client.GetRunError(tt.args.ctx, tt.args.info)
+			saved, err := client.SaveSnippet(ctx, tt.request)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GetRunError() error = %v, wantErr %v", err, tt.wantErr)
+				t.Fatalf("PlaygroundController_SaveSnippet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
 				return
 			}
-			if !tt.wantErr {
-				if !strings.EqualFold(got.Output, tt.want.Output) {
-					t.Errorf("GetRunError() got = %v, want %v", got.Output, tt.want.Output)
-				}
+			got, err := client.GetSnippet(ctx, &pb.GetSnippetRequest{Id: saved.Id})
+			if err != nil {
+				t.Fatalf("PlaygroundController_GetSnippet() error = %v", err)
+			}
+			if got.Code != tt.request.Code || got.Sdk != tt.request.Sdk || got.PipelineOptions != tt.request.PipelineOptions {
+				t.Errorf("PlaygroundController_GetSnippet() got = %v, want %v", got, tt.request)
 			}
 		})
 	}
 }
 
-func TestPlaygroundController_Cancel(t *testing.T) {
-	defer goleak.VerifyNone(t, opt)
+func TestPlaygroundController_GetSnippet(t *testing.T) {
 	ctx := context.Background()
-	pipelineId := uuid.New()
 	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithInsecure())
 	if err != nil {
 		t.Fatalf("Failed to dial bufnet: %v", err)
@@ -682,58 +2903,214 @@ func TestPlaygroundController_Cancel(t *testing.T) {
 	defer conn.Close()
 	client := pb.NewPlaygroundServiceClient(conn)
 
-	type args struct {
-		ctx  context.Context
-		info *pb.CancelRequest
+	tests := []struct {
+		name     string
+		id       string
+		wantCode codes.Code
+	}{
+		{
+			// Test case with getting the snippet which isn't saved.
+			// As a result, want to receive the NotFound error.
+			name:     "snippet doesn't exist",
+			id:       "AAAAAAAAAAAAAAAAAAAAAA",
+			wantCode: codes.NotFound,
+		},
+		{
+			// Test case with getting the snippet by the id which couldn't be parsed.
+			// As a result, want to receive the InvalidArgument error.
+			name:     "incorrect id",
+			id:       "MOCK_ID",
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetSnippet(ctx, &pb.GetSnippetRequest{Id: tt.id})
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("PlaygroundController_GetSnippet() error = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+// fakeUsageStore keeps counters of usage metrics in memory
+type fakeUsageStore struct {
+	mu       sync.Mutex
+	counters map[usage_metrics.Key]int64
+}
+
+func (s *fakeUsageStore) Increment(ctx context.Context, counts map[usage_metrics.Key]int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, count := range counts {
+		s.counters[key] += count
+	}
+	return nil
+}
+
+func (s *fakeUsageStore) Query(ctx context.Context, from, to string) ([]usage_metrics.Counter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	counters := make([]usage_metrics.Counter, 0)
+	for key, runs := range s.counters {
+		if key.Date >= from && key.Date <= to {
+			counters = append(counters, usage_metrics.Counter{Key: key, Runs: runs})
+		}
 	}
+	return counters, nil
+}
+
+func TestPlaygroundController_GetUsageMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	today := time.Now().UTC()
+	store := &fakeUsageStore{counters: map[usage_metrics.Key]int64{
+		{Sdk: pb.Sdk_SDK_JAVA, Example: "SDK_JAVA/EXAMPLE/WordCount", Date: today.Format(usage_metrics.DateLayout)}:                   3,
+		{Sdk: pb.Sdk_SDK_JAVA, Example: "SDK_JAVA/EXAMPLE/WordCount", Date: today.AddDate(0, 0, -1).Format(usage_metrics.DateLayout)}: 2,
+		{Sdk: pb.Sdk_SDK_GO, Date: today.AddDate(0, 0, -40).Format(usage_metrics.DateLayout)}:                                         7,
+	}}
+	collector := usage_metrics.NewCollector(ctx, store, time.Hour, 0)
+	defer collector.Close(ctx)
+
 	tests := []struct {
-		name      string
-		args      args
-		want      *pb.CancelResponse
-		checkFunc func() bool
-		wantErr   bool
+		name         string
+		usageMetrics *usage_metrics.Collector
+		request      *pb.GetUsageMetricsRequest
+		want         *pb.GetUsageMetricsResponse
+		wantCode     codes.Code
 	}{
 		{
-			// Test case with calling Cancel method with incorrect pipelineId.
-			// As a result, want to receive an error
-			name: "incorrect pipelineId",
-			args: args{
-				ctx:  ctx,
-				info: &pb.CancelRequest{PipelineUuid: "NO_UUID_STRING"},
-			},
-			checkFunc: func() bool {
-				return true
+			// Test case with the server which doesn't collect usage metrics.
+			// As a result, want to receive the Unavailable error.
+			name:     "usage metrics aren't collected",
+			request:  &pb.GetUsageMetricsRequest{},
+			wantCode: codes.Unavailable,
+		},
+		{
+			// Test case with the request without dates.
+			// As a result, want to receive runs of the last 30 days.
+			name:         "default range",
+			usageMetrics: collector,
+			request:      &pb.GetUsageMetricsRequest{},
+			want: &pb.GetUsageMetricsResponse{
+				FromDate:  today.AddDate(0, 0, -29).Format(usage_metrics.DateLayout),
+				ToDate:    today.Format(usage_metrics.DateLayout),
+				TotalRuns: 5,
+				Sdks:      []*pb.UsageMetric{{Sdk: pb.Sdk_SDK_JAVA, Runs: 5}},
+				Examples:  []*pb.UsageMetric{{Sdk: pb.Sdk_SDK_JAVA, Example: "SDK_JAVA/EXAMPLE/WordCount", Runs: 5}},
 			},
-			want:    nil,
-			wantErr: true,
+			wantCode: codes.OK,
 		},
 		{
-			// Test case with calling Cancel method.
-			// As a result, want to find value in cache for cache.Canceled subKey.
-			name: "set cancel without error",
-			args: args{
-				ctx:  ctx,
-				info: &pb.CancelRequest{PipelineUuid: pipelineId.String()},
+			// Test case with the range of dates which contains only the older run.
+			// As a result, want to receive runs of the range.
+			name:         "range of dates",
+			usageMetrics: collector,
+			request: &pb.GetUsageMetricsRequest{
+				FromDate: today.AddDate(0, 0, -50).Format(usage_metrics.DateLayout),
+				ToDate:   today.AddDate(0, 0, -2).Format(usage_metrics.DateLayout),
 			},
-			checkFunc: func() bool {
-				value, err := cacheService.GetValue(context.Background(), pipelineId, cache.Canceled)
-				if err != nil {
-					return false
-				}
-				return value.(bool)
+			want: &pb.GetUsageMetricsResponse{
+				FromDate:  today.AddDate(0, 0, -50).Format(usage_metrics.DateLayout),
+				ToDate:    today.AddDate(0, 0, -2).Format(usage_metrics.DateLayout),
+				TotalRuns: 7,
+				Sdks:      []*pb.UsageMetric{{Sdk: pb.Sdk_SDK_GO, Runs: 7}},
+				Examples:  []*pb.UsageMetric{{Sdk: pb.Sdk_SDK_GO, Runs: 7}},
 			},
-			want:    &pb.CancelResponse{},
-			wantErr: false,
+			wantCode: codes.OK,
+		},
+		{
+			// Test case with the date which couldn't be parsed.
+			// As a result, want to receive the InvalidArgument error.
+			name:         "incorrect date",
+			usageMetrics: collector,
+			request:      &pb.GetUsageMetricsRequest{FromDate: "31.01.2022"},
+			wantCode:     codes.InvalidArgument,
+		},
+		{
+			// Test case with the first date which is after the last date.
+			// As a result, want to receive the InvalidArgument error.
+			name:         "from date after to date",
+			usageMetrics: collector,
+			request:      &pb.GetUsageMetricsRequest{FromDate: "2022-02-01", ToDate: "2022-01-31"},
+			wantCode:     codes.InvalidArgument,
+		},
+		{
+			// Test case with the range which is longer than maxUsageMetricsDays.
+			// As a result, want to receive the InvalidArgument error.
+			name:         "too long range",
+			usageMetrics: collector,
+			request:      &pb.GetUsageMetricsRequest{FromDate: "2020-01-01", ToDate: "2022-01-31"},
+			wantCode:     codes.InvalidArgument,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if _, err := client.Cancel(tt.args.ctx, tt.args.info); (err != nil) != tt.wantErr {
-				t.Errorf("Cancel() error = %v, wantErr %v", err, tt.wantErr)
+			controller := &playgroundController{usageMetrics: tt.usageMetrics}
+			got, err := controller.GetUsageMetrics(ctx, tt.request)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("PlaygroundController_GetUsageMetrics() error = %v, want code %v", err, tt.wantCode)
 			}
-			if !tt.checkFunc() {
-				t.Error("Cancel() doesn't set canceled flag")
+			if err == nil && !proto.Equal(got, tt.want) {
+				t.Errorf("PlaygroundController_GetUsageMetrics() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func TestPlaygroundController_RunProgramUsageMetrics(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("error during get working directory: %s", err.Error())
+	}
+	executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+	appEnv := environment.NewApplicationEnvs(workingDir, "", "", baseFileFolder, environment.NewCacheEnvs("local", "", time.Minute, 0, 0, 0, "", false), time.Minute, 0, environment.NewQueueEnvs(0, 0), environment.NewSnippetEnvs(0, 0), environment.NewRateLimitEnvs(0, map[string]int{}, nil), environment.NewWorkingDirEnvs(0, nil), environment.NewCodeEnvs(0, 0))
+	store := &fakeUsageStore{counters: map[usage_metrics.Key]int64{}}
+	collector := usage_metrics.NewCollector(ctx, store, time.Hour, 0)
+	controller := &playgroundController{
+		env:          environment.NewEnvironment(environment.NetworkEnvs{}, *sdkEnv, *appEnv),
+		cacheService: cacheService,
+		usageMetrics: collector,
+	}
+
+	// Test case with two runs of the code and the run of the incorrect sdk.
+	// As a result, want to receive the counter of two runs after runs are added to the store.
+	code := "if __name__ == \"__main__\":\n    print('MOCK_OUTPUT')\n"
+	for i := 0; i < 2; i++ {
+		if err = controller.RunProgram(&pb.RunCodeRequest{Code: code, Sdk: pb.Sdk_SDK_PYTHON}, &mockRunProgramServer{ctx: ctx}); err != nil {
+			t.Fatalf("PlaygroundController_RunProgram() error = %v", err)
+		}
+	}
+	if err = controller.RunProgram(&pb.RunCodeRequest{Code: code, Sdk: pb.Sdk_SDK_GO}, &mockRunProgramServer{ctx: ctx}); err == nil {
+		t.Fatalf("PlaygroundController_RunProgram() error = nil, want an error")
+	}
+	controller.runs.Wait()
+	if err = collector.Close(ctx); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	want := map[usage_metrics.Key]int64{{Sdk: pb.Sdk_SDK_PYTHON, Date: time.Now().UTC().Format(usage_metrics.DateLayout)}: 2}
+	if !reflect.DeepEqual(store.counters, want) {
+		t.Errorf("PlaygroundController_RunProgram() usage counters = %v, want %v", store.counters, want)
+	}
+}
+
+func TestPlaygroundController_ListSDKs(t *testing.T) {
+	sdks := []*pb.SdkInfo{
+		{Sdk: pb.Sdk_SDK_JAVA, Enabled: true, Version: "javac 11.0.13"},
+		{Sdk: pb.Sdk_SDK_GO, DisabledReason: "go isn't found"},
+	}
+	controller := &playgroundController{sdks: sdks}
+
+	// Test case with the controller which has detected SDKs.
+	// As a result, want to receive all detected SDKs.
+	got, err := controller.ListSDKs(context.Background(), &pb.ListSdksRequest{})
+	if err != nil {
+		t.Fatalf("PlaygroundController_ListSDKs() error = %v", err)
+	}
+	if !reflect.DeepEqual(got.Sdks, sdks) {
+		t.Errorf("PlaygroundController_ListSDKs() got = %v, want %v", got.Sdks, sdks)
+	}
+}
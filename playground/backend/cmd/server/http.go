@@ -15,6 +15,7 @@
 package main
 
 import (
+	"beam.apache.org/playground/backend/internal/cache"
 	"beam.apache.org/playground/backend/internal/environment"
 	"beam.apache.org/playground/backend/internal/logger"
 	"beam.apache.org/playground/backend/internal/utils"
@@ -23,7 +24,7 @@ import (
 )
 
 // listenHttp binds the http.Handler on the TCP network address
-func listenHttp(ctx context.Context, errChan chan error, envs *environment.Environment, handler http.Handler) {
+func listenHttp(ctx context.Context, errChan chan error, envs *environment.Environment, cacheService cache.Cache, metricsHandler http.Handler, handler http.Handler) {
 	address := envs.NetworkEnvs.Address()
 	logger.Infof("listening HTTP at %s\n", address)
 
@@ -31,6 +32,8 @@ func listenHttp(ctx context.Context, errChan chan error, envs *environment.Envir
 	mux.Handle("/", handler)
 	mux.HandleFunc("/liveness", utils.GetLivenessFunction())
 	mux.HandleFunc("/readiness", utils.GetReadinessFunction(envs))
+	mux.HandleFunc("/health", utils.GetHealthFunction(cacheService))
+	mux.Handle("/metrics", metricsHandler)
 
 	if err := http.ListenAndServe(address, mux); err != nil {
 		errChan <- err
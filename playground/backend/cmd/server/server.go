@@ -18,13 +18,62 @@ package main
 import (
 	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/fallback"
 	"beam.apache.org/playground/backend/internal/cache/local"
+	"beam.apache.org/playground/backend/internal/cache/lru"
+	"beam.apache.org/playground/backend/internal/cache/memo"
+	"beam.apache.org/playground/backend/internal/cache/noop"
+	"beam.apache.org/playground/backend/internal/cache/reaper"
 	"beam.apache.org/playground/backend/internal/cache/redis"
+	"beam.apache.org/playground/backend/internal/cloud_bucket"
 	"beam.apache.org/playground/backend/internal/environment"
+	"beam.apache.org/playground/backend/internal/fs_tool"
+	"beam.apache.org/playground/backend/internal/job_queue"
 	"beam.apache.org/playground/backend/internal/logger"
+	"beam.apache.org/playground/backend/internal/metrics"
+	"beam.apache.org/playground/backend/internal/rate_limiter"
+	"beam.apache.org/playground/backend/internal/setup_tools/life_cycle"
+	"beam.apache.org/playground/backend/internal/snippets"
+	"beam.apache.org/playground/backend/internal/toolchains"
+	"beam.apache.org/playground/backend/internal/usage_metrics"
 	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"go.opentelemetry.io/otel"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	cachePingAttempts   = 6
+	cachePingRetryDelay = time.Second
+	// credentials of remote cache are read only from os environment, so they aren't part of logged configs
+	cacheUsernameKey = "CACHE_USERNAME"
+	cachePasswordKey = "CACHE_PASSWORD"
+	// cacheFallbackCheckInterval is the interval of checking the remote cache while operations are served by the local cache
+	cacheFallbackCheckInterval = 5 * time.Second
+	// localCacheSnapshotInterval is the interval of saving the local cache to CACHE_SNAPSHOT_PATH
+	localCacheSnapshotInterval = time.Minute
+	// drainStopTimeout is the max duration of waiting for code processings to stop after they are terminated by the shutdown
+	drainStopTimeout = 10 * time.Second
+	// retainedFoldersCheckInterval is the interval of deleting kept working directories which retention is expired
+	retainedFoldersCheckInterval = time.Minute
+	// usageMetricsKey is the store of usage metrics, usage metrics are collected only if it is "datastore"
+	usageMetricsKey = "USAGE_METRICS"
+	// usageMetricsFlushInterval is the interval of adding counted runs to the store of usage metrics
+	usageMetricsFlushInterval = time.Minute
+	// usageMetricsMaxBatchSize is the number of counters which are added to the store before usageMetricsFlushInterval
+	usageMetricsMaxBatchSize = 100
+	// snippetsStoreKey is the store of snippets, snippets are kept in Datastore if it is "datastore" and in cache otherwise
+	snippetsStoreKey = "SNIPPETS_STORE"
 )
 
 // runServer is starting http server wrapped on grpc
@@ -39,33 +88,72 @@ func runServer() error {
 
 	logger.SetupLogger(ctx, envService.ApplicationEnvs.LaunchSite(), envService.ApplicationEnvs.GoogleProjectId())
 
-	grpcServer := grpc.NewServer()
+	rateLimitEnvs := envService.ApplicationEnvs.RateLimitEnvs()
+	limiter := rate_limiter.New(rateLimitEnvs.DefaultLimit(), rateLimitEnvs.MethodLimits(), rateLimitEnvs.ApiKeys())
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(limiter.UnaryServerInterceptor()), grpc.StreamInterceptor(limiter.StreamServerInterceptor()))
 
-	cacheService, err := setupCache(ctx, envService.ApplicationEnvs)
+	cacheMetrics := metrics.NewCacheMetrics()
+	cacheService, err := setupCache(ctx, envService.ApplicationEnvs, cacheMetrics)
+	if err != nil {
+		return err
+	}
+	if idleTimeout := envService.ApplicationEnvs.CacheEnvs().IdleTimeout(); idleTimeout > 0 {
+		cacheService = reaper.New(ctx, cacheService, idleTimeout)
+	}
+	defer func() {
+		if err := cacheService.Close(); err != nil {
+			logger.Errorf("Server: error during closing of cache, err: %s\n", err.Error())
+		}
+	}()
+	usageMetrics, closeUsageMetrics := setupUsageMetrics(ctx, envService.ApplicationEnvs)
+	defer closeUsageMetrics()
+	snippetsStore, closeSnippetsStore, err := setupSnippets(ctx, envService.ApplicationEnvs, cacheService)
 	if err != nil {
 		return err
 	}
-	pb.RegisterPlaygroundServiceServer(grpcServer, &playgroundController{
-		env:          envService,
-		cacheService: cacheService,
-	})
+	defer closeSnippetsStore()
+	deleteOrphanedFolders(ctx, envService.ApplicationEnvs, cacheService)
+	if envService.ApplicationEnvs.WorkingDirEnvs().Retention() > 0 {
+		go deleteExpiredRetainedFolders(ctx, envService.ApplicationEnvs)
+	}
+	controller := &playgroundController{
+		env:             envService,
+		cacheService:    cacheService,
+		queue:           job_queue.New(envService.ApplicationEnvs.QueueEnvs().MaxJobs(), envService.ApplicationEnvs.QueueEnvs().Timeout()),
+		examplesStorage: cloud_bucket.New(),
+		sdks:            toolchains.Detect(ctx, envService.BeamSdkEnvs.ApacheBeamSdk, toolchains.ExecProber),
+		warmPool:        setupWarmPool(envService),
+		usageMetrics:    usageMetrics,
+		snippets:        snippetsStore,
+	}
+	pb.RegisterPlaygroundServiceServer(grpcServer, controller)
+	// server reflection allows tools (e.g. grpcurl) to discover services of the server
+	reflection.Register(grpcServer)
 
 	errChan := make(chan error)
+	// the cache isn't closed by the signal, so code processings which are drained keep saving their results
+	signalCtx, stopSignals := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
 
 	switch envService.NetworkEnvs.Protocol() {
 	case "TCP":
 		go listenTcp(ctx, errChan, envService.NetworkEnvs, grpcServer)
 	case "HTTP":
 		handler := Wrap(grpcServer, getGrpcWebOptions())
-		go listenHttp(ctx, errChan, envService, handler)
+		go listenHttp(ctx, errChan, envService, cacheService, cacheMetrics, handler)
 	}
 
 	for {
 		select {
 		case err := <-errChan:
 			return err
-		case <-ctx.Done():
-			logger.Info("interrupt signal received; stopping...")
+		case <-signalCtx.Done():
+			gracePeriod := envService.ApplicationEnvs.ShutdownGracePeriod()
+			logger.Infof("interrupt signal received; draining code processings during %s...\n", gracePeriod)
+			if terminated := controller.drain(gracePeriod, drainStopTimeout); terminated > 0 {
+				logger.Warnf("Server: %d code processings are terminated by the shutdown\n", terminated)
+			}
+			logger.Info("code processings are drained; stopping...")
 			return nil
 		}
 	}
@@ -99,16 +187,178 @@ func getGrpcWebOptions() []grpcweb.Option {
 
 }
 
-// setupCache constructs required cache by application environment
-func setupCache(ctx context.Context, appEnv environment.ApplicationEnvs) (cache.Cache, error) {
+// setupCache constructs required cache by application environment.
+// If CACHE_FALLBACK is true, operations of the remote cache are served by the local cache during an outage of the remote one.
+// If CACHE_LRU_SIZE is positive, values of finished pipelines are read from memory of the server in front of the cache.
+// Handlers which opt in by memo.NewContext read the same value only once per request.
+func setupCache(ctx context.Context, appEnv environment.ApplicationEnvs, cacheMetrics cache.Metrics) (cache.Cache, error) {
+	cacheService, err := newCache(ctx, appEnv, cacheMetrics)
+	if err != nil {
+		return nil, err
+	}
+	if appEnv.CacheEnvs().CacheType() == "remote" && appEnv.CacheEnvs().Fallback() {
+		cacheService = fallback.New(ctx, cacheService, local.New(ctx), cacheFallbackCheckInterval)
+	}
+	if size := appEnv.CacheEnvs().LruSize(); size > 0 {
+		cacheService = lru.New(cacheService, size, appEnv.CacheEnvs().LruTtl())
+	}
+	return memo.New(cacheService), nil
+}
+
+// newCache constructs the cache of CACHE_TYPE
+func newCache(ctx context.Context, appEnv environment.ApplicationEnvs, cacheMetrics cache.Metrics) (cache.Cache, error) {
 	switch appEnv.CacheEnvs().CacheType() {
 	case "remote":
-		return redis.New(ctx, appEnv.CacheEnvs().Address())
+		options := &redis.Options{
+			KeyExpirationTime: appEnv.CacheEnvs().KeyExpirationTime(),
+			Username:          takeEnv(cacheUsernameKey),
+			Password:          takeEnv(cachePasswordKey),
+			Metrics:           cacheMetrics,
+			Tracer:            otel.Tracer(cache.TracerName),
+			// Redis may start later than the server, so wait for it with the retry
+			PingAttempts:   cachePingAttempts,
+			PingRetryDelay: cachePingRetryDelay,
+		}
+		// several comma-separated addresses mean that Redis is deployed as a cluster
+		if addrs := strings.Split(appEnv.CacheEnvs().Address(), ","); len(addrs) > 1 {
+			return redis.NewCluster(ctx, addrs, options)
+		}
+		return redis.NewWithOptions(ctx, appEnv.CacheEnvs().Address(), options)
+	case "noop":
+		return noop.New(), nil
 	default:
+		// pipelines of the local cache are kept after restart of the server only if the snapshot path is set
+		if path := appEnv.CacheEnvs().SnapshotPath(); path != "" {
+			return local.NewWithSnapshot(ctx, path, localCacheSnapshotInterval)
+		}
 		return local.New(ctx), nil
 	}
 }
 
+// takeEnv returns the value of the environment variable and removes the variable from the environment of the server,
+// so processes which are started by the server (e.g. the compiler or the code) don't inherit it
+func takeEnv(key string) string {
+	value := os.Getenv(key)
+	_ = os.Unsetenv(key)
+	return value
+}
+
+// setupWarmPool returns the warm pool of working directories of the SDK with WarmPoolSize of the SDK's config file.
+// Returns nil if the size is 0 or the pool couldn't be prepared, so working directories are prepared for each request.
+func setupWarmPool(envService *environment.Environment) *fs_tool.WarmPool {
+	size := envService.BeamSdkEnvs.ExecutorConfig.WarmPoolSize
+	if size <= 0 {
+		return nil
+	}
+	appEnv := envService.ApplicationEnvs
+	sdk := envService.BeamSdkEnvs.ApacheBeamSdk
+	prepare := func(lc *fs_tool.LifeCycle, id uuid.UUID) error {
+		return life_cycle.PrepareFiles(sdk, lc, appEnv.WorkingDir(), envService.BeamSdkEnvs.PreparedModDir(), id)
+	}
+	pool, err := fs_tool.NewWarmPool(sdk, filepath.Join(appEnv.WorkingDir(), appEnv.PipelinesFolder()), size, prepare)
+	if err != nil {
+		logger.Errorf("Server: error during preparing the warm pool, err: %s\n", err.Error())
+		return nil
+	}
+	logger.Infof("Server: %d working directories are prepared in the warm pool\n", size)
+	return pool
+}
+
+// setupUsageMetrics returns the collector of usage metrics which keeps counters in Datastore of the Google Cloud project
+// if USAGE_METRICS is "datastore" and the function which adds remaining counts and closes the collector.
+// Returns nil collector if usage metrics aren't collected or Datastore isn't available, so runs aren't counted.
+func setupUsageMetrics(ctx context.Context, appEnv environment.ApplicationEnvs) (*usage_metrics.Collector, func()) {
+	if os.Getenv(usageMetricsKey) != "datastore" {
+		return nil, func() {}
+	}
+	store, err := usage_metrics.NewDatastoreStore(ctx, appEnv.GoogleProjectId())
+	if err != nil {
+		logger.Errorf("Server: error during connecting to the store of usage metrics, err: %s\n", err.Error())
+		return nil, func() {}
+	}
+	collector := usage_metrics.NewCollector(ctx, store, usageMetricsFlushInterval, usageMetricsMaxBatchSize)
+	return collector, func() {
+		if err := collector.Close(context.Background()); err != nil {
+			logger.Errorf("Server: error during adding remaining usage metrics, err: %s\n", err.Error())
+		}
+		if err := store.Close(); err != nil {
+			logger.Errorf("Server: error during closing the store of usage metrics, err: %s\n", err.Error())
+		}
+	}
+}
+
+// setupSnippets returns the store of snippets and the function which closes it.
+// Snippets are kept in Datastore of the Google Cloud project if SNIPPETS_STORE is "datastore", so they aren't lost
+// when the server or the cache is restarted. Otherwise, snippets are kept in the cache only as long as it keeps values.
+func setupSnippets(ctx context.Context, appEnv environment.ApplicationEnvs, cacheService cache.Cache) (snippets.Store, func(), error) {
+	if os.Getenv(snippetsStoreKey) != "datastore" {
+		logger.Warnf("Server: snippets are kept in the cache, they are lost when the cache is restarted or flushed\n")
+		return snippets.NewCacheStore(cacheService), func() {}, nil
+	}
+	store, err := snippets.NewDatastoreStore(ctx, appEnv.GoogleProjectId())
+	if err != nil {
+		return nil, nil, fmt.Errorf("error during connecting to the store of snippets: %w", err)
+	}
+	return store, func() {
+		if err := store.Close(); err != nil {
+			logger.Errorf("Server: error during closing the store of snippets, err: %s\n", err.Error())
+		}
+	}, nil
+}
+
+// deleteOrphanedFolders deletes folders of pipelines which are left on the disk by the previous run of the server.
+// The code isn't processed longer than the pipeline execute timeout, so folders which aren't modified during it
+// are deleted unless the status of the pipeline in cache shows that its code is still processed.
+func deleteOrphanedFolders(ctx context.Context, appEnv environment.ApplicationEnvs, cacheService cache.Cache) {
+	isActive := func(pipelineId uuid.UUID) bool {
+		status, err := cacheService.GetValue(ctx, pipelineId, cache.Status)
+		if errors.Is(err, cache.ErrNotFound) {
+			return false
+		}
+		if err != nil {
+			// the folder is kept if it isn't known whether the code is processed
+			return true
+		}
+		pipelineStatus, ok := status.(pb.Status)
+		return !ok || !cache.IsTerminalStatus(pipelineStatus)
+	}
+	pipelinesFolder := filepath.Join(appEnv.WorkingDir(), appEnv.PipelinesFolder())
+	// kept working directories aren't modified during their retention, so they aren't deleted before it is expired
+	maxAge := appEnv.PipelineExecuteTimeout()
+	if retention := appEnv.WorkingDirEnvs().Retention(); retention > maxAge {
+		maxAge = retention
+	}
+	deleted, err := fs_tool.DeleteOrphanedFolders(pipelinesFolder, maxAge, isActive)
+	if err != nil {
+		logger.Errorf("Server: error during deleting orphaned folders of pipelines, err: %s\n", err.Error())
+	}
+	if len(deleted) > 0 {
+		logger.Infof("Server: %d orphaned folders of pipelines are deleted\n", len(deleted))
+	}
+}
+
+// deleteExpiredRetainedFolders deletes kept working directories of pipelines which retention is expired
+// every retainedFoldersCheckInterval until ctx is done.
+func deleteExpiredRetainedFolders(ctx context.Context, appEnv environment.ApplicationEnvs) {
+	pipelinesFolder := filepath.Join(appEnv.WorkingDir(), appEnv.PipelinesFolder())
+	ticker := time.NewTicker(retainedFoldersCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := fs_tool.DeleteExpiredRetainedFolders(pipelinesFolder, appEnv.WorkingDirEnvs().Retention())
+			if err != nil {
+				logger.Errorf("Server: error during deleting kept working directories, err: %s\n", err.Error())
+			}
+			if len(deleted) > 0 {
+				logger.Infof("Server: %d kept working directories are deleted after the retention\n", len(deleted))
+			}
+		}
+	}
+}
+
 func main() {
 	err := runServer()
 	if err != nil {
@@ -1,20 +1,3 @@
-//
-// Licensed to the Apache Software Foundation (ASF) under one
-// or more contributor license agreements.  See the NOTICE file
-// distributed with this work for additional information
-// regarding copyright ownership.  The ASF licenses this file
-// to you under the Apache License, Version 2.0 (the
-// "License"); you may not use this file except in compliance
-// with the License.  You may obtain a copy of the License at
-//
-//     http://www.apache.org/licenses/LICENSE-2.0
-//
-// Unless required by applicable law or agreed to in writing, software
-// distributed under the License is distributed on an "AS IS" BASIS,
-// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-// See the License for the specific language governing permissions and
-// limitations under the License.
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 
 package playground
@@ -37,13 +20,36 @@ const _ = grpc.SupportPackageIsVersion7
 type PlaygroundServiceClient interface {
 	// Submit the job for an execution and get the pipeline uuid.
 	RunCode(ctx context.Context, in *RunCodeRequest, opts ...grpc.CallOption) (*RunCodeResponse, error)
+	// Submit the job for an execution and receive statuses of the pipeline (e.g. STATUS_COMPILING -> STATUS_EXECUTING ->
+	// STATUS_FINISHED) until it is finished. The code isn't run if it isn't compiled.
+	// If the code processing is failed, the call ends with the error which contains ErrorDetails after the last status.
+	RunProgram(ctx context.Context, in *RunCodeRequest, opts ...grpc.CallOption) (PlaygroundService_RunProgramClient, error)
+	// Check the syntax of the code without compiling and running it.
+	// Diagnostics are also available as the validation output of the returned pipeline uuid.
+	ValidateCode(ctx context.Context, in *ValidateCodeRequest, opts ...grpc.CallOption) (*ValidateCodeResponse, error)
+	// Check pipeline options against options known for the SDK without running the code.
+	ValidateOptions(ctx context.Context, in *ValidateOptionsRequest, opts ...grpc.CallOption) (*ValidateOptionsResponse, error)
 	// Get the status of pipeline execution.
 	CheckStatus(ctx context.Context, in *CheckStatusRequest, opts ...grpc.CallOption) (*CheckStatusResponse, error)
 	// Get the result of pipeline execution.
 	GetRunOutput(ctx context.Context, in *GetRunOutputRequest, opts ...grpc.CallOption) (*GetRunOutputResponse, error)
 	// Get the logs of pipeline execution.
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
-	// Get the string representation of the pipeline execution graph in DOT format.
+	// Get structured entries of the logs of pipeline execution filtered by the min severity and the time range.
+	GetLogEntries(ctx context.Context, in *GetLogEntriesRequest, opts ...grpc.CallOption) (*GetLogEntriesResponse, error)
+	// Get the page of the result of pipeline execution by the offset and the limit in bytes.
+	// Pages of the finished pipeline execution are stable, so the whole result could be read page by page.
+	GetRunOutputPage(ctx context.Context, in *GetRunOutputPageRequest, opts ...grpc.CallOption) (*GetRunOutputPageResponse, error)
+	// Get the page of the logs of pipeline execution by the offset and the limit in bytes.
+	GetLogsPage(ctx context.Context, in *GetLogsPageRequest, opts ...grpc.CallOption) (*GetLogsPageResponse, error)
+	// Stream changes of the status and chunks of the run output as they are produced until the code processing is finished.
+	// The run output which is produced before the status is changed is sent before the status.
+	StreamOutput(ctx context.Context, in *StreamOutputRequest, opts ...grpc.CallOption) (PlaygroundService_StreamOutputClient, error)
+	// Download the zip archive of the working directory of the code which is kept by keep_working_dir of RunCodeRequest.
+	// The archive is streamed in chunks which should be concatenated.
+	GetWorkingDir(ctx context.Context, in *GetWorkingDirRequest, opts ...grpc.CallOption) (PlaygroundService_GetWorkingDirClient, error)
+	// Get the pipeline execution graph in DOT format and as JSON of nodes and edges.
+	// If the graph isn't generated yet, the response isn't ready instead of an error.
 	GetGraph(ctx context.Context, in *GetGraphRequest, opts ...grpc.CallOption) (*GetGraphResponse, error)
 	// Get the error of pipeline execution.
 	GetRunError(ctx context.Context, in *GetRunErrorRequest, opts ...grpc.CallOption) (*GetRunErrorResponse, error)
@@ -51,18 +57,39 @@ type PlaygroundServiceClient interface {
 	GetValidationOutput(ctx context.Context, in *GetValidationOutputRequest, opts ...grpc.CallOption) (*GetValidationOutputResponse, error)
 	// Get the result of pipeline preparation.
 	GetPreparationOutput(ctx context.Context, in *GetPreparationOutputRequest, opts ...grpc.CallOption) (*GetPreparationOutputResponse, error)
-	// Get the result of pipeline compilation.
+	// Get the result of pipeline compilation with diagnostics which are parsed from it by the SDK.
 	GetCompileOutput(ctx context.Context, in *GetCompileOutputRequest, opts ...grpc.CallOption) (*GetCompileOutputResponse, error)
+	// Get command lines which are run to compile and run the code
+	GetExecutionCommand(ctx context.Context, in *GetExecutionCommandRequest, opts ...grpc.CallOption) (*GetExecutionCommandResponse, error)
 	// Cancel code processing
 	Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error)
+	// Get the metadata of all currently available values of the pipeline execution.
+	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
 	// Get all precompiled objects from the cloud storage.
 	GetPrecompiledObjects(ctx context.Context, in *GetPrecompiledObjectsRequest, opts ...grpc.CallOption) (*GetPrecompiledObjectsResponse, error)
+	// Get the PrecompiledObject with its code and precompiled outputs without running the code.
+	GetPrecompiledObject(ctx context.Context, in *GetPrecompiledObjectRequest, opts ...grpc.CallOption) (*GetPrecompiledObjectResponse, error)
 	// Get the code of an PrecompiledObject.
 	GetPrecompiledObjectCode(ctx context.Context, in *GetPrecompiledObjectCodeRequest, opts ...grpc.CallOption) (*GetPrecompiledObjectCodeResponse, error)
 	// Get the precompiled details of an PrecompiledObject.
 	GetPrecompiledObjectOutput(ctx context.Context, in *GetPrecompiledObjectOutputRequest, opts ...grpc.CallOption) (*GetPrecompiledObjectOutputResponse, error)
 	// Get the logs of an PrecompiledObject.
 	GetPrecompiledObjectLogs(ctx context.Context, in *GetPrecompiledObjectLogsRequest, opts ...grpc.CallOption) (*GetPrecompiledObjectLogsResponse, error)
+	// Get the default precompile object for the sdk.
+	GetDefaultPrecompiledObject(ctx context.Context, in *GetDefaultPrecompiledObjectRequest, opts ...grpc.CallOption) (*GetDefaultPrecompiledObjectResponse, error)
+	// Search examples by SDKs, the category and the term which is found in names or descriptions of examples.
+	SearchExamples(ctx context.Context, in *SearchExamplesRequest, opts ...grpc.CallOption) (*SearchExamplesResponse, error)
+	// Save the code which is shared by the user and get the id of the snippet.
+	// Snippets are kept during the retention of the server.
+	SaveSnippet(ctx context.Context, in *SaveSnippetRequest, opts ...grpc.CallOption) (*SaveSnippetResponse, error)
+	// Get the code of the shared snippet by its id.
+	GetSnippet(ctx context.Context, in *GetSnippetRequest, opts ...grpc.CallOption) (*GetSnippetResponse, error)
+	// Get all known SDKs, their versions and whether they are available on the server.
+	// Toolchains of SDKs are detected when the server is started.
+	ListSDKs(ctx context.Context, in *ListSdksRequest, opts ...grpc.CallOption) (*ListSdksResponse, error)
+	// Get numbers of runs per SDK and example during the range of dates.
+	// Runs are counted in batches, so the latest runs could be missed until they are saved.
+	GetUsageMetrics(ctx context.Context, in *GetUsageMetricsRequest, opts ...grpc.CallOption) (*GetUsageMetricsResponse, error)
 }
 
 type playgroundServiceClient struct {
@@ -82,6 +109,56 @@ func (c *playgroundServiceClient) RunCode(ctx context.Context, in *RunCodeReques
 	return out, nil
 }
 
+func (c *playgroundServiceClient) RunProgram(ctx context.Context, in *RunCodeRequest, opts ...grpc.CallOption) (PlaygroundService_RunProgramClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PlaygroundService_ServiceDesc.Streams[0], "/api.v1.PlaygroundService/RunProgram", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &playgroundServiceRunProgramClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PlaygroundService_RunProgramClient interface {
+	Recv() (*RunProgramResponse, error)
+	grpc.ClientStream
+}
+
+type playgroundServiceRunProgramClient struct {
+	grpc.ClientStream
+}
+
+func (x *playgroundServiceRunProgramClient) Recv() (*RunProgramResponse, error) {
+	m := new(RunProgramResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *playgroundServiceClient) ValidateCode(ctx context.Context, in *ValidateCodeRequest, opts ...grpc.CallOption) (*ValidateCodeResponse, error) {
+	out := new(ValidateCodeResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/ValidateCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playgroundServiceClient) ValidateOptions(ctx context.Context, in *ValidateOptionsRequest, opts ...grpc.CallOption) (*ValidateOptionsResponse, error) {
+	out := new(ValidateOptionsResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/ValidateOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *playgroundServiceClient) CheckStatus(ctx context.Context, in *CheckStatusRequest, opts ...grpc.CallOption) (*CheckStatusResponse, error) {
 	out := new(CheckStatusResponse)
 	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/CheckStatus", in, out, opts...)
@@ -109,6 +186,97 @@ func (c *playgroundServiceClient) GetLogs(ctx context.Context, in *GetLogsReques
 	return out, nil
 }
 
+func (c *playgroundServiceClient) GetLogEntries(ctx context.Context, in *GetLogEntriesRequest, opts ...grpc.CallOption) (*GetLogEntriesResponse, error) {
+	out := new(GetLogEntriesResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/GetLogEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playgroundServiceClient) GetRunOutputPage(ctx context.Context, in *GetRunOutputPageRequest, opts ...grpc.CallOption) (*GetRunOutputPageResponse, error) {
+	out := new(GetRunOutputPageResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/GetRunOutputPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playgroundServiceClient) GetLogsPage(ctx context.Context, in *GetLogsPageRequest, opts ...grpc.CallOption) (*GetLogsPageResponse, error) {
+	out := new(GetLogsPageResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/GetLogsPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playgroundServiceClient) StreamOutput(ctx context.Context, in *StreamOutputRequest, opts ...grpc.CallOption) (PlaygroundService_StreamOutputClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PlaygroundService_ServiceDesc.Streams[1], "/api.v1.PlaygroundService/StreamOutput", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &playgroundServiceStreamOutputClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PlaygroundService_StreamOutputClient interface {
+	Recv() (*StreamOutputResponse, error)
+	grpc.ClientStream
+}
+
+type playgroundServiceStreamOutputClient struct {
+	grpc.ClientStream
+}
+
+func (x *playgroundServiceStreamOutputClient) Recv() (*StreamOutputResponse, error) {
+	m := new(StreamOutputResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *playgroundServiceClient) GetWorkingDir(ctx context.Context, in *GetWorkingDirRequest, opts ...grpc.CallOption) (PlaygroundService_GetWorkingDirClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PlaygroundService_ServiceDesc.Streams[2], "/api.v1.PlaygroundService/GetWorkingDir", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &playgroundServiceGetWorkingDirClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PlaygroundService_GetWorkingDirClient interface {
+	Recv() (*GetWorkingDirResponse, error)
+	grpc.ClientStream
+}
+
+type playgroundServiceGetWorkingDirClient struct {
+	grpc.ClientStream
+}
+
+func (x *playgroundServiceGetWorkingDirClient) Recv() (*GetWorkingDirResponse, error) {
+	m := new(GetWorkingDirResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *playgroundServiceClient) GetGraph(ctx context.Context, in *GetGraphRequest, opts ...grpc.CallOption) (*GetGraphResponse, error) {
 	out := new(GetGraphResponse)
 	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/GetGraph", in, out, opts...)
@@ -154,6 +322,15 @@ func (c *playgroundServiceClient) GetCompileOutput(ctx context.Context, in *GetC
 	return out, nil
 }
 
+func (c *playgroundServiceClient) GetExecutionCommand(ctx context.Context, in *GetExecutionCommandRequest, opts ...grpc.CallOption) (*GetExecutionCommandResponse, error) {
+	out := new(GetExecutionCommandResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/GetExecutionCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *playgroundServiceClient) Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error) {
 	out := new(CancelResponse)
 	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/Cancel", in, out, opts...)
@@ -163,6 +340,15 @@ func (c *playgroundServiceClient) Cancel(ctx context.Context, in *CancelRequest,
 	return out, nil
 }
 
+func (c *playgroundServiceClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
+	out := new(GetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/GetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *playgroundServiceClient) GetPrecompiledObjects(ctx context.Context, in *GetPrecompiledObjectsRequest, opts ...grpc.CallOption) (*GetPrecompiledObjectsResponse, error) {
 	out := new(GetPrecompiledObjectsResponse)
 	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/GetPrecompiledObjects", in, out, opts...)
@@ -172,6 +358,15 @@ func (c *playgroundServiceClient) GetPrecompiledObjects(ctx context.Context, in
 	return out, nil
 }
 
+func (c *playgroundServiceClient) GetPrecompiledObject(ctx context.Context, in *GetPrecompiledObjectRequest, opts ...grpc.CallOption) (*GetPrecompiledObjectResponse, error) {
+	out := new(GetPrecompiledObjectResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/GetPrecompiledObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *playgroundServiceClient) GetPrecompiledObjectCode(ctx context.Context, in *GetPrecompiledObjectCodeRequest, opts ...grpc.CallOption) (*GetPrecompiledObjectCodeResponse, error) {
 	out := new(GetPrecompiledObjectCodeResponse)
 	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/GetPrecompiledObjectCode", in, out, opts...)
@@ -199,19 +394,96 @@ func (c *playgroundServiceClient) GetPrecompiledObjectLogs(ctx context.Context,
 	return out, nil
 }
 
+func (c *playgroundServiceClient) GetDefaultPrecompiledObject(ctx context.Context, in *GetDefaultPrecompiledObjectRequest, opts ...grpc.CallOption) (*GetDefaultPrecompiledObjectResponse, error) {
+	out := new(GetDefaultPrecompiledObjectResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/GetDefaultPrecompiledObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playgroundServiceClient) SearchExamples(ctx context.Context, in *SearchExamplesRequest, opts ...grpc.CallOption) (*SearchExamplesResponse, error) {
+	out := new(SearchExamplesResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/SearchExamples", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playgroundServiceClient) SaveSnippet(ctx context.Context, in *SaveSnippetRequest, opts ...grpc.CallOption) (*SaveSnippetResponse, error) {
+	out := new(SaveSnippetResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/SaveSnippet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playgroundServiceClient) GetSnippet(ctx context.Context, in *GetSnippetRequest, opts ...grpc.CallOption) (*GetSnippetResponse, error) {
+	out := new(GetSnippetResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/GetSnippet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playgroundServiceClient) ListSDKs(ctx context.Context, in *ListSdksRequest, opts ...grpc.CallOption) (*ListSdksResponse, error) {
+	out := new(ListSdksResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/ListSDKs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playgroundServiceClient) GetUsageMetrics(ctx context.Context, in *GetUsageMetricsRequest, opts ...grpc.CallOption) (*GetUsageMetricsResponse, error) {
+	out := new(GetUsageMetricsResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.PlaygroundService/GetUsageMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PlaygroundServiceServer is the server API for PlaygroundService service.
 // All implementations should embed UnimplementedPlaygroundServiceServer
 // for forward compatibility
 type PlaygroundServiceServer interface {
 	// Submit the job for an execution and get the pipeline uuid.
 	RunCode(context.Context, *RunCodeRequest) (*RunCodeResponse, error)
+	// Submit the job for an execution and receive statuses of the pipeline (e.g. STATUS_COMPILING -> STATUS_EXECUTING ->
+	// STATUS_FINISHED) until it is finished. The code isn't run if it isn't compiled.
+	// If the code processing is failed, the call ends with the error which contains ErrorDetails after the last status.
+	RunProgram(*RunCodeRequest, PlaygroundService_RunProgramServer) error
+	// Check the syntax of the code without compiling and running it.
+	// Diagnostics are also available as the validation output of the returned pipeline uuid.
+	ValidateCode(context.Context, *ValidateCodeRequest) (*ValidateCodeResponse, error)
+	// Check pipeline options against options known for the SDK without running the code.
+	ValidateOptions(context.Context, *ValidateOptionsRequest) (*ValidateOptionsResponse, error)
 	// Get the status of pipeline execution.
 	CheckStatus(context.Context, *CheckStatusRequest) (*CheckStatusResponse, error)
 	// Get the result of pipeline execution.
 	GetRunOutput(context.Context, *GetRunOutputRequest) (*GetRunOutputResponse, error)
 	// Get the logs of pipeline execution.
 	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
-	// Get the string representation of the pipeline execution graph in DOT format.
+	// Get structured entries of the logs of pipeline execution filtered by the min severity and the time range.
+	GetLogEntries(context.Context, *GetLogEntriesRequest) (*GetLogEntriesResponse, error)
+	// Get the page of the result of pipeline execution by the offset and the limit in bytes.
+	// Pages of the finished pipeline execution are stable, so the whole result could be read page by page.
+	GetRunOutputPage(context.Context, *GetRunOutputPageRequest) (*GetRunOutputPageResponse, error)
+	// Get the page of the logs of pipeline execution by the offset and the limit in bytes.
+	GetLogsPage(context.Context, *GetLogsPageRequest) (*GetLogsPageResponse, error)
+	// Stream changes of the status and chunks of the run output as they are produced until the code processing is finished.
+	// The run output which is produced before the status is changed is sent before the status.
+	StreamOutput(*StreamOutputRequest, PlaygroundService_StreamOutputServer) error
+	// Download the zip archive of the working directory of the code which is kept by keep_working_dir of RunCodeRequest.
+	// The archive is streamed in chunks which should be concatenated.
+	GetWorkingDir(*GetWorkingDirRequest, PlaygroundService_GetWorkingDirServer) error
+	// Get the pipeline execution graph in DOT format and as JSON of nodes and edges.
+	// If the graph isn't generated yet, the response isn't ready instead of an error.
 	GetGraph(context.Context, *GetGraphRequest) (*GetGraphResponse, error)
 	// Get the error of pipeline execution.
 	GetRunError(context.Context, *GetRunErrorRequest) (*GetRunErrorResponse, error)
@@ -219,18 +491,39 @@ type PlaygroundServiceServer interface {
 	GetValidationOutput(context.Context, *GetValidationOutputRequest) (*GetValidationOutputResponse, error)
 	// Get the result of pipeline preparation.
 	GetPreparationOutput(context.Context, *GetPreparationOutputRequest) (*GetPreparationOutputResponse, error)
-	// Get the result of pipeline compilation.
+	// Get the result of pipeline compilation with diagnostics which are parsed from it by the SDK.
 	GetCompileOutput(context.Context, *GetCompileOutputRequest) (*GetCompileOutputResponse, error)
+	// Get command lines which are run to compile and run the code
+	GetExecutionCommand(context.Context, *GetExecutionCommandRequest) (*GetExecutionCommandResponse, error)
 	// Cancel code processing
 	Cancel(context.Context, *CancelRequest) (*CancelResponse, error)
+	// Get the metadata of all currently available values of the pipeline execution.
+	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
 	// Get all precompiled objects from the cloud storage.
 	GetPrecompiledObjects(context.Context, *GetPrecompiledObjectsRequest) (*GetPrecompiledObjectsResponse, error)
+	// Get the PrecompiledObject with its code and precompiled outputs without running the code.
+	GetPrecompiledObject(context.Context, *GetPrecompiledObjectRequest) (*GetPrecompiledObjectResponse, error)
 	// Get the code of an PrecompiledObject.
 	GetPrecompiledObjectCode(context.Context, *GetPrecompiledObjectCodeRequest) (*GetPrecompiledObjectCodeResponse, error)
 	// Get the precompiled details of an PrecompiledObject.
 	GetPrecompiledObjectOutput(context.Context, *GetPrecompiledObjectOutputRequest) (*GetPrecompiledObjectOutputResponse, error)
 	// Get the logs of an PrecompiledObject.
 	GetPrecompiledObjectLogs(context.Context, *GetPrecompiledObjectLogsRequest) (*GetPrecompiledObjectLogsResponse, error)
+	// Get the default precompile object for the sdk.
+	GetDefaultPrecompiledObject(context.Context, *GetDefaultPrecompiledObjectRequest) (*GetDefaultPrecompiledObjectResponse, error)
+	// Search examples by SDKs, the category and the term which is found in names or descriptions of examples.
+	SearchExamples(context.Context, *SearchExamplesRequest) (*SearchExamplesResponse, error)
+	// Save the code which is shared by the user and get the id of the snippet.
+	// Snippets are kept during the retention of the server.
+	SaveSnippet(context.Context, *SaveSnippetRequest) (*SaveSnippetResponse, error)
+	// Get the code of the shared snippet by its id.
+	GetSnippet(context.Context, *GetSnippetRequest) (*GetSnippetResponse, error)
+	// Get all known SDKs, their versions and whether they are available on the server.
+	// Toolchains of SDKs are detected when the server is started.
+	ListSDKs(context.Context, *ListSdksRequest) (*ListSdksResponse, error)
+	// Get numbers of runs per SDK and example during the range of dates.
+	// Runs are counted in batches, so the latest runs could be missed until they are saved.
+	GetUsageMetrics(context.Context, *GetUsageMetricsRequest) (*GetUsageMetricsResponse, error)
 }
 
 // UnimplementedPlaygroundServiceServer should be embedded to have forward compatible implementations.
@@ -240,6 +533,15 @@ type UnimplementedPlaygroundServiceServer struct {
 func (UnimplementedPlaygroundServiceServer) RunCode(context.Context, *RunCodeRequest) (*RunCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunCode not implemented")
 }
+func (UnimplementedPlaygroundServiceServer) RunProgram(*RunCodeRequest, PlaygroundService_RunProgramServer) error {
+	return status.Errorf(codes.Unimplemented, "method RunProgram not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) ValidateCode(context.Context, *ValidateCodeRequest) (*ValidateCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCode not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) ValidateOptions(context.Context, *ValidateOptionsRequest) (*ValidateOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateOptions not implemented")
+}
 func (UnimplementedPlaygroundServiceServer) CheckStatus(context.Context, *CheckStatusRequest) (*CheckStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckStatus not implemented")
 }
@@ -249,6 +551,21 @@ func (UnimplementedPlaygroundServiceServer) GetRunOutput(context.Context, *GetRu
 func (UnimplementedPlaygroundServiceServer) GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
 }
+func (UnimplementedPlaygroundServiceServer) GetLogEntries(context.Context, *GetLogEntriesRequest) (*GetLogEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogEntries not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) GetRunOutputPage(context.Context, *GetRunOutputPageRequest) (*GetRunOutputPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunOutputPage not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) GetLogsPage(context.Context, *GetLogsPageRequest) (*GetLogsPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogsPage not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) StreamOutput(*StreamOutputRequest, PlaygroundService_StreamOutputServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOutput not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) GetWorkingDir(*GetWorkingDirRequest, PlaygroundService_GetWorkingDirServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetWorkingDir not implemented")
+}
 func (UnimplementedPlaygroundServiceServer) GetGraph(context.Context, *GetGraphRequest) (*GetGraphResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGraph not implemented")
 }
@@ -264,12 +581,21 @@ func (UnimplementedPlaygroundServiceServer) GetPreparationOutput(context.Context
 func (UnimplementedPlaygroundServiceServer) GetCompileOutput(context.Context, *GetCompileOutputRequest) (*GetCompileOutputResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCompileOutput not implemented")
 }
+func (UnimplementedPlaygroundServiceServer) GetExecutionCommand(context.Context, *GetExecutionCommandRequest) (*GetExecutionCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionCommand not implemented")
+}
 func (UnimplementedPlaygroundServiceServer) Cancel(context.Context, *CancelRequest) (*CancelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
 }
+func (UnimplementedPlaygroundServiceServer) GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+}
 func (UnimplementedPlaygroundServiceServer) GetPrecompiledObjects(context.Context, *GetPrecompiledObjectsRequest) (*GetPrecompiledObjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPrecompiledObjects not implemented")
 }
+func (UnimplementedPlaygroundServiceServer) GetPrecompiledObject(context.Context, *GetPrecompiledObjectRequest) (*GetPrecompiledObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrecompiledObject not implemented")
+}
 func (UnimplementedPlaygroundServiceServer) GetPrecompiledObjectCode(context.Context, *GetPrecompiledObjectCodeRequest) (*GetPrecompiledObjectCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPrecompiledObjectCode not implemented")
 }
@@ -279,6 +605,24 @@ func (UnimplementedPlaygroundServiceServer) GetPrecompiledObjectOutput(context.C
 func (UnimplementedPlaygroundServiceServer) GetPrecompiledObjectLogs(context.Context, *GetPrecompiledObjectLogsRequest) (*GetPrecompiledObjectLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPrecompiledObjectLogs not implemented")
 }
+func (UnimplementedPlaygroundServiceServer) GetDefaultPrecompiledObject(context.Context, *GetDefaultPrecompiledObjectRequest) (*GetDefaultPrecompiledObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDefaultPrecompiledObject not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) SearchExamples(context.Context, *SearchExamplesRequest) (*SearchExamplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchExamples not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) SaveSnippet(context.Context, *SaveSnippetRequest) (*SaveSnippetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSnippet not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) GetSnippet(context.Context, *GetSnippetRequest) (*GetSnippetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnippet not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) ListSDKs(context.Context, *ListSdksRequest) (*ListSdksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSDKs not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) GetUsageMetrics(context.Context, *GetUsageMetricsRequest) (*GetUsageMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageMetrics not implemented")
+}
 
 // UnsafePlaygroundServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PlaygroundServiceServer will
@@ -309,6 +653,63 @@ func _PlaygroundService_RunCode_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlaygroundService_RunProgram_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RunCodeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlaygroundServiceServer).RunProgram(m, &playgroundServiceRunProgramServer{stream})
+}
+
+type PlaygroundService_RunProgramServer interface {
+	Send(*RunProgramResponse) error
+	grpc.ServerStream
+}
+
+type playgroundServiceRunProgramServer struct {
+	grpc.ServerStream
+}
+
+func (x *playgroundServiceRunProgramServer) Send(m *RunProgramResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PlaygroundService_ValidateCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).ValidateCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.PlaygroundService/ValidateCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).ValidateCode(ctx, req.(*ValidateCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaygroundService_ValidateOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).ValidateOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.PlaygroundService/ValidateOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).ValidateOptions(ctx, req.(*ValidateOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PlaygroundService_CheckStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckStatusRequest)
 	if err := dec(in); err != nil {
@@ -363,6 +764,102 @@ func _PlaygroundService_GetLogs_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlaygroundService_GetLogEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).GetLogEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.PlaygroundService/GetLogEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).GetLogEntries(ctx, req.(*GetLogEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaygroundService_GetRunOutputPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunOutputPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).GetRunOutputPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.PlaygroundService/GetRunOutputPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).GetRunOutputPage(ctx, req.(*GetRunOutputPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaygroundService_GetLogsPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogsPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).GetLogsPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.PlaygroundService/GetLogsPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).GetLogsPage(ctx, req.(*GetLogsPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaygroundService_StreamOutput_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamOutputRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlaygroundServiceServer).StreamOutput(m, &playgroundServiceStreamOutputServer{stream})
+}
+
+type PlaygroundService_StreamOutputServer interface {
+	Send(*StreamOutputResponse) error
+	grpc.ServerStream
+}
+
+type playgroundServiceStreamOutputServer struct {
+	grpc.ServerStream
+}
+
+func (x *playgroundServiceStreamOutputServer) Send(m *StreamOutputResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PlaygroundService_GetWorkingDir_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetWorkingDirRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlaygroundServiceServer).GetWorkingDir(m, &playgroundServiceGetWorkingDirServer{stream})
+}
+
+type PlaygroundService_GetWorkingDirServer interface {
+	Send(*GetWorkingDirResponse) error
+	grpc.ServerStream
+}
+
+type playgroundServiceGetWorkingDirServer struct {
+	grpc.ServerStream
+}
+
+func (x *playgroundServiceGetWorkingDirServer) Send(m *GetWorkingDirResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _PlaygroundService_GetGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetGraphRequest)
 	if err := dec(in); err != nil {
@@ -453,6 +950,24 @@ func _PlaygroundService_GetCompileOutput_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlaygroundService_GetExecutionCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutionCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).GetExecutionCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.PlaygroundService/GetExecutionCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).GetExecutionCommand(ctx, req.(*GetExecutionCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PlaygroundService_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelRequest)
 	if err := dec(in); err != nil {
@@ -471,6 +986,24 @@ func _PlaygroundService_Cancel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlaygroundService_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).GetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.PlaygroundService/GetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PlaygroundService_GetPrecompiledObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPrecompiledObjectsRequest)
 	if err := dec(in); err != nil {
@@ -489,6 +1022,24 @@ func _PlaygroundService_GetPrecompiledObjects_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlaygroundService_GetPrecompiledObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrecompiledObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).GetPrecompiledObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.PlaygroundService/GetPrecompiledObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).GetPrecompiledObject(ctx, req.(*GetPrecompiledObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PlaygroundService_GetPrecompiledObjectCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPrecompiledObjectCodeRequest)
 	if err := dec(in); err != nil {
@@ -543,6 +1094,114 @@ func _PlaygroundService_GetPrecompiledObjectLogs_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlaygroundService_GetDefaultPrecompiledObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDefaultPrecompiledObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).GetDefaultPrecompiledObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.PlaygroundService/GetDefaultPrecompiledObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).GetDefaultPrecompiledObject(ctx, req.(*GetDefaultPrecompiledObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaygroundService_SearchExamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchExamplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).SearchExamples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.PlaygroundService/SearchExamples",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).SearchExamples(ctx, req.(*SearchExamplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaygroundService_SaveSnippet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSnippetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).SaveSnippet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.PlaygroundService/SaveSnippet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).SaveSnippet(ctx, req.(*SaveSnippetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaygroundService_GetSnippet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSnippetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).GetSnippet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.PlaygroundService/GetSnippet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).GetSnippet(ctx, req.(*GetSnippetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaygroundService_ListSDKs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSdksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).ListSDKs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.PlaygroundService/ListSDKs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).ListSDKs(ctx, req.(*ListSdksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaygroundService_GetUsageMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).GetUsageMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.PlaygroundService/GetUsageMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).GetUsageMetrics(ctx, req.(*GetUsageMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PlaygroundService_ServiceDesc is the grpc.ServiceDesc for PlaygroundService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -554,6 +1213,14 @@ var PlaygroundService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunCode",
 			Handler:    _PlaygroundService_RunCode_Handler,
 		},
+		{
+			MethodName: "ValidateCode",
+			Handler:    _PlaygroundService_ValidateCode_Handler,
+		},
+		{
+			MethodName: "ValidateOptions",
+			Handler:    _PlaygroundService_ValidateOptions_Handler,
+		},
 		{
 			MethodName: "CheckStatus",
 			Handler:    _PlaygroundService_CheckStatus_Handler,
@@ -566,6 +1233,18 @@ var PlaygroundService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLogs",
 			Handler:    _PlaygroundService_GetLogs_Handler,
 		},
+		{
+			MethodName: "GetLogEntries",
+			Handler:    _PlaygroundService_GetLogEntries_Handler,
+		},
+		{
+			MethodName: "GetRunOutputPage",
+			Handler:    _PlaygroundService_GetRunOutputPage_Handler,
+		},
+		{
+			MethodName: "GetLogsPage",
+			Handler:    _PlaygroundService_GetLogsPage_Handler,
+		},
 		{
 			MethodName: "GetGraph",
 			Handler:    _PlaygroundService_GetGraph_Handler,
@@ -586,14 +1265,26 @@ var PlaygroundService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCompileOutput",
 			Handler:    _PlaygroundService_GetCompileOutput_Handler,
 		},
+		{
+			MethodName: "GetExecutionCommand",
+			Handler:    _PlaygroundService_GetExecutionCommand_Handler,
+		},
 		{
 			MethodName: "Cancel",
 			Handler:    _PlaygroundService_Cancel_Handler,
 		},
+		{
+			MethodName: "GetMetadata",
+			Handler:    _PlaygroundService_GetMetadata_Handler,
+		},
 		{
 			MethodName: "GetPrecompiledObjects",
 			Handler:    _PlaygroundService_GetPrecompiledObjects_Handler,
 		},
+		{
+			MethodName: "GetPrecompiledObject",
+			Handler:    _PlaygroundService_GetPrecompiledObject_Handler,
+		},
 		{
 			MethodName: "GetPrecompiledObjectCode",
 			Handler:    _PlaygroundService_GetPrecompiledObjectCode_Handler,
@@ -606,7 +1297,47 @@ var PlaygroundService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPrecompiledObjectLogs",
 			Handler:    _PlaygroundService_GetPrecompiledObjectLogs_Handler,
 		},
+		{
+			MethodName: "GetDefaultPrecompiledObject",
+			Handler:    _PlaygroundService_GetDefaultPrecompiledObject_Handler,
+		},
+		{
+			MethodName: "SearchExamples",
+			Handler:    _PlaygroundService_SearchExamples_Handler,
+		},
+		{
+			MethodName: "SaveSnippet",
+			Handler:    _PlaygroundService_SaveSnippet_Handler,
+		},
+		{
+			MethodName: "GetSnippet",
+			Handler:    _PlaygroundService_GetSnippet_Handler,
+		},
+		{
+			MethodName: "ListSDKs",
+			Handler:    _PlaygroundService_ListSDKs_Handler,
+		},
+		{
+			MethodName: "GetUsageMetrics",
+			Handler:    _PlaygroundService_GetUsageMetrics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RunProgram",
+			Handler:       _PlaygroundService_RunProgram_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamOutput",
+			Handler:       _PlaygroundService_StreamOutput_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetWorkingDir",
+			Handler:       _PlaygroundService_GetWorkingDir_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/api.proto",
 }
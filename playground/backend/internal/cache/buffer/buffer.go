@@ -0,0 +1,136 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buffer
+
+import (
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/logger"
+	"context"
+	"github.com/google/uuid"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Buffer batches appends to the string value of the pipeline's subKey (e.g. cache.Logs) in memory.
+// The accumulated value is written to the cache on every flush interval and when the size
+// of not yet written data reaches the size threshold.
+// Buffer owns the value of the subKey, so the value shouldn't be set to the cache by anyone else.
+type Buffer struct {
+	cacheService  cache.Cache
+	pipelineId    uuid.UUID
+	subKey        cache.SubKey
+	sizeThreshold int
+
+	mu      sync.Mutex
+	value   strings.Builder
+	pending int
+
+	// flushMu keeps flushes in order so older value never overwrites newer one
+	flushMu sync.Mutex
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// New returns Buffer for the pipeline's subKey which flushes to cacheService every flushInterval
+// and when sizeThreshold bytes are appended since the last flush.
+// If sizeThreshold is 0, data is flushed only by interval.
+// When ctx is done, remaining buffered data is flushed.
+func New(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID, subKey cache.SubKey, flushInterval time.Duration, sizeThreshold int) *Buffer {
+	b := &Buffer{
+		cacheService:  cacheService,
+		pipelineId:    pipelineId,
+		subKey:        subKey,
+		sizeThreshold: sizeThreshold,
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+	go b.run(ctx, flushInterval)
+	return b
+}
+
+// Append adds data to the end of the buffered value.
+// If the size threshold is reached, the value is flushed to the cache.
+func (b *Buffer) Append(ctx context.Context, data string) error {
+	b.mu.Lock()
+	b.value.WriteString(data)
+	b.pending += len(data)
+	reached := b.sizeThreshold > 0 && b.pending >= b.sizeThreshold
+	b.mu.Unlock()
+
+	if reached {
+		return b.Flush(ctx)
+	}
+	return nil
+}
+
+// Flush writes the accumulated value to the cache if there is not yet written data.
+func (b *Buffer) Flush(ctx context.Context) error {
+	b.flushMu.Lock()
+	defer b.flushMu.Unlock()
+
+	b.mu.Lock()
+	if b.pending == 0 {
+		b.mu.Unlock()
+		return nil
+	}
+	value := b.value.String()
+	pending := b.pending
+	b.pending = 0
+	b.mu.Unlock()
+
+	if err := b.cacheService.SetValue(ctx, b.pipelineId, b.subKey, value); err != nil {
+		b.mu.Lock()
+		b.pending += pending
+		b.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Close stops flushing by interval and flushes remaining buffered data.
+func (b *Buffer) Close(ctx context.Context) error {
+	b.closeOnce.Do(func() {
+		close(b.stop)
+	})
+	<-b.done
+	return b.Flush(ctx)
+}
+
+// run flushes the buffer every flushInterval until ctx is done or the buffer is closed
+func (b *Buffer) run(ctx context.Context, flushInterval time.Duration) {
+	defer close(b.done)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// ctx is already done, so the final flush can't use it
+			if err := b.Flush(context.Background()); err != nil {
+				logger.Errorf("%s: Buffer: error during final flush of %s: %s\n", b.pipelineId, b.subKey, err.Error())
+			}
+			return
+		case <-b.stop:
+			return
+		case <-ticker.C:
+			if err := b.Flush(ctx); err != nil {
+				logger.Errorf("%s: Buffer: error during flush of %s: %s\n", b.pipelineId, b.subKey, err.Error())
+			}
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buffer
+
+import (
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/local"
+	"context"
+	"github.com/google/uuid"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingCache records values which are set to the wrapped Cache
+type recordingCache struct {
+	*local.Cache
+	mu     sync.Mutex
+	values []interface{}
+}
+
+func (c *recordingCache) SetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) error {
+	c.mu.Lock()
+	c.values = append(c.values, value)
+	c.mu.Unlock()
+	return c.Cache.SetValue(ctx, pipelineId, subKey, value)
+}
+
+func (c *recordingCache) setValues() []interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]interface{}(nil), c.values...)
+}
+
+// waitForValues waits until want values are set to the cache or timeout is reached
+func waitForValues(c *recordingCache, want int) []interface{} {
+	deadline := time.Now().Add(time.Second)
+	for len(c.setValues()) < want && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	return c.setValues()
+}
+
+func TestBuffer_Append(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	type args struct {
+		flushInterval time.Duration
+		sizeThreshold int
+		appends       []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []interface{}
+	}{
+		{
+			// Test case with appending less data than the size threshold.
+			// As a result, want nothing to be written to the cache.
+			name: "below size threshold",
+			args: args{
+				flushInterval: time.Hour,
+				sizeThreshold: 10,
+				appends:       []string{"ab", "cd"},
+			},
+			want: nil,
+		},
+		{
+			// Test case with appending more data than the size threshold.
+			// As a result, want the value to be written once the threshold is reached.
+			name: "size threshold flush",
+			args: args{
+				flushInterval: time.Hour,
+				sizeThreshold: 4,
+				appends:       []string{"ab", "cd", "e", "fgh"},
+			},
+			want: []interface{}{"abcd", "abcdefgh"},
+		},
+		{
+			// Test case with size threshold disabled.
+			// As a result, want nothing to be written to the cache.
+			name: "disabled size threshold",
+			args: args{
+				flushInterval: time.Hour,
+				sizeThreshold: 0,
+				appends:       []string{"abcdefgh"},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cacheService := &recordingCache{Cache: local.New(ctx)}
+			b := New(ctx, cacheService, uuid.New(), cache.Logs, tt.args.flushInterval, tt.args.sizeThreshold)
+			for _, data := range tt.args.appends {
+				if err := b.Append(ctx, data); err != nil {
+					t.Fatalf("Append() error = %v", err)
+				}
+			}
+			if got := cacheService.setValues(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Append() set values = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuffer_IntervalFlush(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pipelineId := uuid.New()
+	cacheService := &recordingCache{Cache: local.New(ctx)}
+	b := New(ctx, cacheService, pipelineId, cache.Logs, time.Millisecond*10, 0)
+	defer b.Close(ctx)
+
+	if err := b.Append(ctx, "MOCK_"); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	if err := b.Append(ctx, "LOGS"); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	got := waitForValues(cacheService, 1)
+	if !reflect.DeepEqual(got, []interface{}{"MOCK_LOGS"}) {
+		t.Errorf("interval flush set values = %v, want %v", got, []interface{}{"MOCK_LOGS"})
+	}
+
+	// wait a few more intervals to check that unchanged value isn't written again
+	time.Sleep(time.Millisecond * 50)
+	if got = cacheService.setValues(); len(got) != 1 {
+		t.Errorf("interval flush set values = %v, want only one write", got)
+	}
+	value, err := cacheService.GetValue(ctx, pipelineId, cache.Logs)
+	if err != nil || value != "MOCK_LOGS" {
+		t.Errorf("GetValue() = %v, %v, want %v", value, err, "MOCK_LOGS")
+	}
+}
+
+func TestBuffer_Close(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pipelineId := uuid.New()
+	cacheService := &recordingCache{Cache: local.New(ctx)}
+	b := New(ctx, cacheService, pipelineId, cache.Logs, time.Hour, 0)
+
+	if err := b.Append(ctx, "MOCK_LOGS"); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	if err := b.Close(ctx); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if err := b.Close(ctx); err != nil {
+		t.Fatalf("second Close() error = %v", err)
+	}
+	got := cacheService.setValues()
+	if !reflect.DeepEqual(got, []interface{}{"MOCK_LOGS"}) {
+		t.Errorf("Close() set values = %v, want %v", got, []interface{}{"MOCK_LOGS"})
+	}
+}
+
+func TestBuffer_ContextDone(t *testing.T) {
+	cacheCtx, cacheCancel := context.WithCancel(context.Background())
+	defer cacheCancel()
+	ctx, cancel := context.WithCancel(context.Background())
+	cacheService := &recordingCache{Cache: local.New(cacheCtx)}
+	b := New(ctx, cacheService, uuid.New(), cache.Logs, time.Hour, 0)
+
+	if err := b.Append(ctx, "MOCK_LOGS"); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	cancel()
+	got := waitForValues(cacheService, 1)
+	if !reflect.DeepEqual(got, []interface{}{"MOCK_LOGS"}) {
+		t.Errorf("final flush set values = %v, want %v", got, []interface{}{"MOCK_LOGS"})
+	}
+}
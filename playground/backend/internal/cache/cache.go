@@ -16,11 +16,19 @@
 package cache
 
 import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"context"
+	"errors"
 	"github.com/google/uuid"
 	"time"
 )
 
+// ErrNotFound is returned by Cache when value by pipelineId and subKey doesn't exist
+var ErrNotFound = errors.New("not found in cache")
+
+// NoExpiration is returned by Cache as time to live of the value which doesn't expire
+const NoExpiration time.Duration = -1
+
 // SubKey is used to keep value with Cache using nested structure like pipelineId:subKey:value
 type SubKey string
 
@@ -44,7 +52,7 @@ const (
 	// CompileOutput is used to keep compilation output value
 	CompileOutput SubKey = "COMPILE_OUTPUT"
 
-	// Canceled is used to keep the canceled status
+	// Canceled is used to keep the bool flag which is true if the pipeline is canceled
 	Canceled SubKey = "CANCELED"
 
 	// RunOutputIndex is the index of the start of the run step's output
@@ -56,10 +64,125 @@ const (
 	// LogsIndex is the index of the start of the log
 	LogsIndex SubKey = "LOGS_INDEX"
 
-	// Graph is used to keep graph of the execution
+	// LogEntries is used to keep []LogEntry which are parsed from Logs when the run step is finished
+	LogEntries SubKey = "LOG_ENTRIES"
+
+	// Graph is used to keep graph of the execution, PipelineGraph value or string value of the graph in the old form
 	Graph SubKey = "GRAPH"
+
+	// RunResult is used to keep ExecutionResult value of the run step
+	RunResult SubKey = "RUN_RESULT"
+
+	// RunResultKept is used to keep the bool flag which is true if outputs of the finished pipeline are kept
+	// under the id of its source, see source_cache.Source
+	RunResultKept SubKey = "RUN_RESULT_KEPT"
+
+	// CompiledArtifacts is used to keep gzipped tar archive of compiled files of the code as []byte value
+	CompiledArtifacts SubKey = "COMPILED_ARTIFACTS"
+
+	// ExecutionCommand is used to keep command lines of the compile and run steps with redacted credentials, one per line
+	ExecutionCommand SubKey = "EXECUTION_COMMAND"
+
+	// Timings is used to keep StageTimings value of the stages of the code processing which are already passed
+	Timings SubKey = "TIMINGS"
+)
+
+// StageTimings is the wall-clock duration of each passed stage of the code processing by the stage name
+// (ValidateStage, PrepareStage, WaitStage, CompileStage, GraphStage or RunStage)
+type StageTimings map[string]time.Duration
+
+const (
+	// ValidateStage is the key of StageTimings of the validation step
+	ValidateStage = "validate"
+
+	// PrepareStage is the key of StageTimings of the preparation step
+	PrepareStage = "prepare"
+
+	// WaitStage is the key of StageTimings of waiting for a free slot of the queue
+	WaitStage = "wait"
+
+	// CompileStage is the key of StageTimings of the compile step
+	CompileStage = "compile"
+
+	// GraphStage is the key of StageTimings of the generation of the graph
+	GraphStage = "graph"
+
+	// RunStage is the key of StageTimings of the run step
+	RunStage = "run"
 )
 
+// ExecutionResult is structured metadata of the finished run step
+type ExecutionResult struct {
+	// ExitCode is the exit code of the run process, -1 if the process was terminated by a signal
+	ExitCode int
+
+	// Duration is the wall-clock duration of the run step
+	Duration time.Duration
+
+	// PeakMemory is the maximum resident set size of the run process in bytes, 0 if it is unknown
+	PeakMemory int64
+
+	// LimitExceeded is the resource limit which was hit by the run process (MemoryLimit, CpuLimit, DiskLimit or OutputLimit), empty if none
+	LimitExceeded string
+
+	// OutputTruncated is true if the run output or the run error exceeded the output limits and was truncated
+	OutputTruncated bool
+}
+
+const (
+	// MemoryLimit is the value of ExecutionResult.LimitExceeded if the run process exceeded the memory limit
+	MemoryLimit = "MEMORY"
+
+	// CpuLimit is the value of ExecutionResult.LimitExceeded if the run process exceeded the CPU time limit
+	CpuLimit = "CPU"
+
+	// DiskLimit is the value of ExecutionResult.LimitExceeded if the run process exceeded the disk quota
+	DiskLimit = "DISK"
+
+	// OutputLimit is the value of ExecutionResult.LimitExceeded if the run process was killed because its output exceeded the output limits
+	OutputLimit = "OUTPUT"
+)
+
+// LogEntry is a structured line of logs of the run step
+type LogEntry struct {
+	// Severity is the level of the line, the default level if the line doesn't contain it
+	Severity pb.LogSeverity
+
+	// Timestamp is the time of the line in Unix nanoseconds, 0 if the line doesn't contain it
+	Timestamp int64
+
+	// Message is the text of the line with its continuation lines (e.g. stack traces)
+	Message string
+}
+
+// PipelineGraph is structured graph of the execution which is kept by Graph subKey.
+// It is returned to the frontend as JSON, so it doesn't need to parse the graph again.
+type PipelineGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+// GraphNode is a transform of the pipeline
+type GraphNode struct {
+	// Id is unique id of the node in the graph
+	Id string `json:"id"`
+
+	// Label is the name of the transform
+	Label string `json:"label"`
+}
+
+// GraphEdge is a collection which is passed from one transform of the pipeline to another
+type GraphEdge struct {
+	// From is id of the node which produces the collection
+	From string `json:"from"`
+
+	// To is id of the node which consumes the collection
+	To string `json:"to"`
+
+	// Label is the name of the collection
+	Label string `json:"label"`
+}
+
 // Cache is used to store states and outputs for Apache Beam pipelines that running in Playground
 // Cache allows keep and read any value by pipelineId and subKey:
 // pipelineId_1:
@@ -71,11 +194,115 @@ const (
 // pipelineId is uuid that calculates in the controller when the server takes new request to run code
 type Cache interface {
 	// GetValue returns value from cache by pipelineId and subKey.
+	// If value doesn't exist in cache, GetValue returns an error which wraps ErrNotFound.
+	// If the storage of the cache is unavailable or the value can't be decoded, the error is categorized
+	// as ErrConnection or ErrDecode accordingly, so errors.Is can be used to classify failures.
 	GetValue(ctx context.Context, pipelineId uuid.UUID, subKey SubKey) (interface{}, error)
 
+	// GetValueWithTTL returns value from cache by pipelineId and subKey together with its remaining time to live.
+	// If value doesn't expire, the time to live is NoExpiration.
+	// If value doesn't exist in cache, GetValueWithTTL returns an error which wraps ErrNotFound.
+	GetValueWithTTL(ctx context.Context, pipelineId uuid.UUID, subKey SubKey) (interface{}, time.Duration, error)
+
+	// GetValues returns values from cache by pipelineId and list of subKeys.
+	// SubKeys which don't exist in cache are absent from the result map.
+	GetValues(ctx context.Context, pipelineId uuid.UUID, subKeys []SubKey) (map[SubKey]interface{}, error)
+
+	// ScanValues returns all values of the pipeline by their subKeys without knowing the subKeys in advance.
+	// If pipeline doesn't exist in cache, ScanValues returns an empty map.
+	ScanValues(ctx context.Context, pipelineId uuid.UUID) (map[SubKey]interface{}, error)
+
+	// GetPipelines returns ids of all pipelines which are kept in cache.
+	GetPipelines(ctx context.Context) ([]uuid.UUID, error)
+
+	// GetAge returns the time passed since the pipeline was first set to cache.
+	// The creation time is recorded once by the first SetValue, SetValues or Preload of the pipeline.
+	// If the creation time of the pipeline isn't recorded, GetAge returns an error which wraps ErrNotFound.
+	GetAge(ctx context.Context, pipelineId uuid.UUID) (time.Duration, error)
+
+	// GetSubKeyCount returns the number of subKeys which are kept in cache for the pipeline.
+	// If pipeline doesn't exist in cache, GetSubKeyCount returns 0.
+	GetSubKeyCount(ctx context.Context, pipelineId uuid.UUID) (int, error)
+
 	// SetValue adds value to cache by pipelineId and subKey.
+	// Values of []byte type (e.g. binary artifacts) are kept as is and returned by GetValue as []byte.
 	SetValue(ctx context.Context, pipelineId uuid.UUID, subKey SubKey, value interface{}) error
 
+	// SetValues adds all values to cache by pipelineId in one operation.
+	// Readers see either none or all of the values.
+	SetValues(ctx context.Context, pipelineId uuid.UUID, values map[SubKey]interface{}) error
+
+	// Preload sets values of all received pipelines in one batch, e.g. outputs of catalog examples at startup.
+	// Preloaded pipelines expire after the preload expiration time of the cache, which is longer than the usual one.
+	Preload(ctx context.Context, pipelines map[uuid.UUID]map[SubKey]interface{}) error
+
+	// SetStatusIfNotTerminal sets status of the pipeline unless the current status is terminal (see IsTerminalStatus).
+	// The check and the update are done atomically. SetStatusIfNotTerminal returns true if the status is set.
+	SetStatusIfNotTerminal(ctx context.Context, pipelineId uuid.UUID, status pb.Status) (bool, error)
+
+	// SubscribeStatus returns channel which receives Status values of the pipeline which are set after the subscription.
+	// The channel is closed when ctx is done.
+	SubscribeStatus(ctx context.Context, pipelineId uuid.UUID) (<-chan pb.Status, error)
+
+	// DeleteValue removes value from cache by pipelineId and subKey.
+	// If value doesn't exist in cache, DeleteValue doesn't return an error.
+	DeleteValue(ctx context.Context, pipelineId uuid.UUID, subKey SubKey) error
+
+	// DeletePipeline removes all values of the pipeline from cache by pipelineId.
+	// If pipeline doesn't exist in cache, DeletePipeline doesn't return an error.
+	DeletePipeline(ctx context.Context, pipelineId uuid.UUID) error
+
+	// FlushAll removes all pipelines from cache.
+	// Only values of the playground are removed, other data of the storage is kept.
+	FlushAll(ctx context.Context) error
+
 	// SetExpTime adds expiration time of the pipeline to cache by pipelineId.
 	SetExpTime(ctx context.Context, pipelineId uuid.UUID, expTime time.Duration) error
+
+	// TouchPipeline resets expiration time of the pipeline to the default one of the cache without changing its values,
+	// e.g. to keep the pipeline of the long run in cache while the run is active.
+	// If pipeline doesn't exist in cache, TouchPipeline returns an error which wraps ErrNotFound.
+	TouchPipeline(ctx context.Context, pipelineId uuid.UUID) error
+
+	// HealthCheck checks the connection to the cache.
+	HealthCheck(ctx context.Context) error
+
+	// Close writes pending changes to the cache and releases its connections.
+	// Cache must not be used after Close.
+	Close() error
+}
+
+// SubKeyExpirer is implemented by Cache which keeps expiration times of some subKeys independently of the pipeline
+// (e.g. Redis cache with SubKeyExpirationTimes), so they can be restored when values are copied between caches.
+type SubKeyExpirer interface {
+	// SetSubKeyExpTime sets expiration time of the pipeline's subKey.
+	// If the subKey expires together with the pipeline, SetSubKeyExpTime does nothing and returns false.
+	SetSubKeyExpTime(ctx context.Context, pipelineId uuid.UUID, subKey SubKey, expTime time.Duration) (bool, error)
+}
+
+// terminalStatuses are statuses after which the status of the pipeline doesn't change anymore
+var terminalStatuses = []pb.Status{
+	pb.Status_STATUS_VALIDATION_ERROR,
+	pb.Status_STATUS_PREPARATION_ERROR,
+	pb.Status_STATUS_COMPILE_ERROR,
+	pb.Status_STATUS_FINISHED,
+	pb.Status_STATUS_RUN_ERROR,
+	pb.Status_STATUS_ERROR,
+	pb.Status_STATUS_RUN_TIMEOUT,
+	pb.Status_STATUS_CANCELED,
+}
+
+// TerminalStatuses returns statuses after which the status of the pipeline doesn't change anymore
+func TerminalStatuses() []pb.Status {
+	return append([]pb.Status(nil), terminalStatuses...)
+}
+
+// IsTerminalStatus checks that the pipeline with received status is finished (successfully, with an error or canceled)
+func IsTerminalStatus(status pb.Status) bool {
+	for _, terminalStatus := range terminalStatuses {
+		if status == terminalStatus {
+			return true
+		}
+	}
+	return false
 }
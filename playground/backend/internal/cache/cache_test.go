@@ -0,0 +1,44 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"testing"
+)
+
+func TestIsTerminalStatus(t *testing.T) {
+	tests := []struct {
+		status pb.Status
+		want   bool
+	}{
+		{status: pb.Status_STATUS_UNSPECIFIED, want: false},
+		{status: pb.Status_STATUS_VALIDATING, want: false},
+		{status: pb.Status_STATUS_EXECUTING, want: false},
+		{status: pb.Status_STATUS_COMPILE_ERROR, want: true},
+		{status: pb.Status_STATUS_FINISHED, want: true},
+		{status: pb.Status_STATUS_ERROR, want: true},
+		{status: pb.Status_STATUS_RUN_TIMEOUT, want: true},
+		{status: pb.Status_STATUS_CANCELED, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			if got := IsTerminalStatus(tt.status); got != tt.want {
+				t.Errorf("IsTerminalStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
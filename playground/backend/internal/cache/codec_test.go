@@ -0,0 +1,52 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestJSONCodec_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		subKey SubKey
+		value  interface{}
+	}{
+		{name: "status", subKey: Status, value: pb.Status_STATUS_FINISHED},
+		{name: "output", subKey: RunOutput, value: "MOCK_OUTPUT"},
+		{name: "index", subKey: RunOutputIndex, value: float64(42)},
+		{name: "canceled", subKey: Canceled, value: true},
+		{name: "runResult", subKey: RunResult, value: ExecutionResult{ExitCode: 1, Duration: time.Second, PeakMemory: 1024}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := JSONCodec.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			got, err := DecodeWith(JSONCodec, tt.subKey, string(data))
+			if err != nil {
+				t.Fatalf("DecodeWith() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("DecodeWith() got = %v, want %v", got, tt.value)
+			}
+		})
+	}
+}
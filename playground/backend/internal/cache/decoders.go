@@ -0,0 +1,127 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"sync"
+)
+
+// Decoder decodes value which is kept in cache by subKey and is encoded by codec
+type Decoder func(codec Codec, value string) (interface{}, error)
+
+var (
+	decodersMu sync.RWMutex
+	decoders   = map[SubKey]Decoder{}
+)
+
+func init() {
+	RegisterDecoder(Status, decodeStatus)
+	RegisterDecoder(RunResult, decodeRunResult)
+	RegisterDecoder(RunOutputIndex, decodeIndex)
+	RegisterDecoder(LogsIndex, decodeIndex)
+	RegisterDecoder(Canceled, decodeFlag)
+	RegisterDecoder(RunResultKept, decodeFlag)
+	RegisterDecoder(Graph, decodeGraph)
+	RegisterDecoder(LogEntries, decodeLogEntries)
+	RegisterDecoder(Timings, decodeTimings)
+}
+
+// RegisterDecoder sets decoder which is used to decode values of the subKey.
+// It is expected to be called from init functions of packages which define new subKeys.
+// Registering decoder for the same subKey again replaces the previous decoder.
+func RegisterDecoder(subKey SubKey, decoder Decoder) {
+	decodersMu.Lock()
+	defer decodersMu.Unlock()
+	decoders[subKey] = decoder
+}
+
+// Decode decodes JSON encoded value of the subKey using registered decoder.
+// If there is no decoder for the subKey, value is decoded to the default JSON type
+// (string for outputs).
+func Decode(subKey SubKey, value string) (interface{}, error) {
+	return DecodeWith(JSONCodec, subKey, value)
+}
+
+// DecodeWith decodes value of the subKey which is encoded by codec using registered decoder.
+// If there is no decoder for the subKey, value is decoded to the default type of the codec.
+func DecodeWith(codec Codec, subKey SubKey, value string) (interface{}, error) {
+	decodersMu.RLock()
+	decoder, ok := decoders[subKey]
+	decodersMu.RUnlock()
+	if ok {
+		return decoder(codec, value)
+	}
+	var result interface{}
+	err := codec.Unmarshal([]byte(value), &result)
+	return result, err
+}
+
+// decodeStatus decodes value to pb.Status
+func decodeStatus(codec Codec, value string) (interface{}, error) {
+	var status pb.Status
+	err := codec.Unmarshal([]byte(value), &status)
+	return status, err
+}
+
+// decodeRunResult decodes value to ExecutionResult
+func decodeRunResult(codec Codec, value string) (interface{}, error) {
+	var result ExecutionResult
+	err := codec.Unmarshal([]byte(value), &result)
+	return result, err
+}
+
+// decodeIndex decodes value to float64 which is the type of indexes regardless of codec
+func decodeIndex(codec Codec, value string) (interface{}, error) {
+	var index float64
+	err := codec.Unmarshal([]byte(value), &index)
+	return index, err
+}
+
+// decodeFlag decodes value to bool
+func decodeFlag(codec Codec, value string) (interface{}, error) {
+	var canceled bool
+	err := codec.Unmarshal([]byte(value), &canceled)
+	return canceled, err
+}
+
+// decodeGraph decodes value to PipelineGraph.
+// Graphs which were kept as strings before PipelineGraph was introduced are decoded to string.
+func decodeGraph(codec Codec, value string) (interface{}, error) {
+	var graph PipelineGraph
+	if err := codec.Unmarshal([]byte(value), &graph); err == nil {
+		return graph, nil
+	}
+	var graphString string
+	if err := codec.Unmarshal([]byte(value), &graphString); err != nil {
+		return nil, err
+	}
+	return graphString, nil
+}
+
+// decodeLogEntries decodes value to []LogEntry
+func decodeLogEntries(codec Codec, value string) (interface{}, error) {
+	var entries []LogEntry
+	err := codec.Unmarshal([]byte(value), &entries)
+	return entries, err
+}
+
+// decodeTimings decodes value to StageTimings
+func decodeTimings(codec Codec, value string) (interface{}, error) {
+	var timings StageTimings
+	err := codec.Unmarshal([]byte(value), &timings)
+	return timings, err
+}
@@ -0,0 +1,149 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		subKey  SubKey
+		value   string
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:   "status subKey",
+			subKey: Status,
+			value:  "8",
+			want:   pb.Status_STATUS_FINISHED,
+		},
+		{
+			name:   "runResult subKey",
+			subKey: RunResult,
+			value:  `{"ExitCode":1,"Duration":1000000000,"PeakMemory":1024}`,
+			want:   ExecutionResult{ExitCode: 1, Duration: time.Second, PeakMemory: 1024},
+		},
+		{
+			name:   "runResult subKey with truncated output",
+			subKey: RunResult,
+			value:  `{"ExitCode":-1,"Duration":1000000000,"LimitExceeded":"OUTPUT","OutputTruncated":true}`,
+			want:   ExecutionResult{ExitCode: -1, Duration: time.Second, LimitExceeded: OutputLimit, OutputTruncated: true},
+		},
+		{
+			name:   "output subKey",
+			subKey: RunOutput,
+			value:  `"MOCK_OUTPUT"`,
+			want:   "MOCK_OUTPUT",
+		},
+		{
+			name:   "index subKey",
+			subKey: LogsIndex,
+			value:  "2",
+			want:   float64(2),
+		},
+		{
+			name:   "canceled subKey",
+			subKey: Canceled,
+			value:  "true",
+			want:   true,
+		},
+		{
+			name:    "canceled subKey with non-bool value",
+			subKey:  Canceled,
+			value:   "1",
+			want:    false,
+			wantErr: true,
+		},
+		{
+			name:   "graph subKey",
+			subKey: Graph,
+			value:  `{"nodes":[{"id":"1","label":"Read"},{"id":"2","label":"Write"}],"edges":[{"from":"1","to":"2","label":"Lines"}]}`,
+			want: PipelineGraph{
+				Nodes: []GraphNode{{Id: "1", Label: "Read"}, {Id: "2", Label: "Write"}},
+				Edges: []GraphEdge{{From: "1", To: "2", Label: "Lines"}},
+			},
+		},
+		{
+			name:   "graph subKey with string value",
+			subKey: Graph,
+			value:  `"digraph {}"`,
+			want:   "digraph {}",
+		},
+		{
+			name:    "graph subKey with invalid value",
+			subKey:  Graph,
+			value:   "MOCK_INVALID",
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "logEntries subKey",
+			subKey: LogEntries,
+			value:  `[{"Severity":4,"Timestamp":1000,"Message":"MOCK_ERROR"},{"Severity":2,"Timestamp":0,"Message":"MOCK_MESSAGE"}]`,
+			want: []LogEntry{
+				{Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Timestamp: 1000, Message: "MOCK_ERROR"},
+				{Severity: pb.LogSeverity_LOG_SEVERITY_INFO, Message: "MOCK_MESSAGE"},
+			},
+		},
+		{
+			name:    "invalid value",
+			subKey:  RunOutput,
+			value:   "MOCK_INVALID",
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(tt.subKey, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Decode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Decode() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterDecoder(t *testing.T) {
+	type customValue struct {
+		Name string
+	}
+	subKey := SubKey("MOCK_CUSTOM")
+	RegisterDecoder(subKey, func(codec Codec, value string) (interface{}, error) {
+		return customValue{Name: value}, nil
+	})
+	defer func() {
+		decodersMu.Lock()
+		delete(decoders, subKey)
+		decodersMu.Unlock()
+	}()
+
+	got, err := Decode(subKey, "MOCK_NAME")
+	if err != nil {
+		t.Errorf("Decode() error = %v", err)
+	}
+	if want := (customValue{Name: "MOCK_NAME"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Decode() got = %v, want %v", got, want)
+	}
+}
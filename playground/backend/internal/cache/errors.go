@@ -0,0 +1,87 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"syscall"
+)
+
+var (
+	// ErrConnection is the category of errors which are caused by unavailability of the cache's storage
+	ErrConnection = errors.New("cache is unavailable")
+
+	// ErrDecode is the category of errors which are caused by values of the cache which can't be decoded
+	ErrDecode = errors.New("couldn't decode value from cache")
+
+	// ErrValueTooLarge is returned by Cache when the value which is set exceeds the max size of values of the cache
+	ErrValueTooLarge = errors.New("value is too large for cache")
+)
+
+// categorizedError is the error of the category (ErrConnection or ErrDecode) which keeps the original error as its cause.
+// errors.Is reports true for both the category and the cause, errors.As looks for the target in the cause.
+type categorizedError struct {
+	category error
+	cause    error
+}
+
+func (e *categorizedError) Error() string {
+	return e.category.Error() + ": " + e.cause.Error()
+}
+
+func (e *categorizedError) Unwrap() error {
+	return e.cause
+}
+
+func (e *categorizedError) Is(target error) bool {
+	return target == e.category
+}
+
+// NewConnectionError returns err which is categorized as ErrConnection.
+// If err is nil, NewConnectionError returns nil.
+func NewConnectionError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &categorizedError{category: ErrConnection, cause: err}
+}
+
+// NewDecodeError returns err which is categorized as ErrDecode.
+// If err is nil, NewDecodeError returns nil.
+func NewDecodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &categorizedError{category: ErrDecode, cause: err}
+}
+
+// IsConnectionError checks that err is caused by unavailability of the cache's storage,
+// e.g. the connection is refused, reset or timed out, or err is categorized as ErrConnection
+func IsConnectionError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var netErr net.Error
+	return errors.Is(err, ErrConnection) ||
+		errors.As(err, &netErr) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
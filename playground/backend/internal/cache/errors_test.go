@@ -0,0 +1,85 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+)
+
+func TestIsConnectionError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil error", err: nil, want: false},
+		{name: "network error", err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, want: true},
+		{name: "wrapped network error", err: fmt.Errorf("MOCK_ERROR: %w", &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}), want: true},
+		{name: "closed connection", err: io.EOF, want: true},
+		{name: "timeout", err: context.DeadlineExceeded, want: true},
+		{name: "not found error", err: fmt.Errorf("MOCK_ERROR: %w", ErrNotFound), want: false},
+		{name: "connection category", err: NewConnectionError(fmt.Errorf("MOCK_ERROR")), want: true},
+		{name: "decode category", err: NewDecodeError(fmt.Errorf("MOCK_ERROR")), want: false},
+		{name: "other error", err: fmt.Errorf("MOCK_ERROR"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsConnectionError(tt.err); got != tt.want {
+				t.Errorf("IsConnectionError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCategorizedError(t *testing.T) {
+	cause := &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	tests := []struct {
+		name         string
+		err          error
+		wantCategory error
+		notCategory  error
+	}{
+		{name: "connection error", err: NewConnectionError(cause), wantCategory: ErrConnection, notCategory: ErrDecode},
+		{name: "decode error", err: NewDecodeError(cause), wantCategory: ErrDecode, notCategory: ErrConnection},
+		{name: "wrapped connection error", err: fmt.Errorf("MOCK_ERROR: %w", NewConnectionError(cause)), wantCategory: ErrConnection, notCategory: ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.wantCategory) {
+				t.Errorf("errors.Is(%v, %v) = false, want true", tt.err, tt.wantCategory)
+			}
+			if errors.Is(tt.err, tt.notCategory) {
+				t.Errorf("errors.Is(%v, %v) = true, want false", tt.err, tt.notCategory)
+			}
+			if !errors.Is(tt.err, syscall.ECONNRESET) {
+				t.Errorf("errors.Is(%v, %v) = false, want true", tt.err, syscall.ECONNRESET)
+			}
+			var opErr *net.OpError
+			if !errors.As(tt.err, &opErr) || opErr != cause {
+				t.Errorf("errors.As() = %v, want %v", opErr, cause)
+			}
+		})
+	}
+	if NewConnectionError(nil) != nil || NewDecodeError(nil) != nil {
+		t.Error("categorized nil error isn't nil")
+	}
+}
@@ -0,0 +1,402 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fallback
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/logger"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"sync"
+	"time"
+)
+
+// Cache serves operations from the primary Cache (e.g. Redis) and, while the primary returns connection errors,
+// from the secondary Cache (e.g. local).
+// When the primary returns a connection error, Cache is switched to degraded mode and all operations are served
+// by the secondary until the primary passes the health check again. Then pipelines which were changed in degraded mode
+// are copied to the primary and removed from the secondary.
+//
+// In degraded mode:
+//   - values which were set to the primary before the outage are not available;
+//   - GetPipelines returns only pipelines which are kept by the secondary;
+//   - SubscribeStatus receives only statuses which are set in degraded mode;
+//   - DeleteValue is applied only to the secondary, so the deleted value of the primary is available after recovery;
+//   - DeletePipeline and FlushAll are applied to the primary after recovery.
+type Cache struct {
+	primary   cache.Cache
+	secondary cache.Cache
+
+	// mu is held for reading by operations and for writing by reconciliation,
+	// so values aren't changed while they are copied to the primary
+	mu sync.RWMutex
+
+	stateMu  sync.Mutex
+	degraded bool
+	// changed are pipelines which are set to the secondary in degraded mode
+	changed map[uuid.UUID]struct{}
+	// deleted are pipelines which are deleted in degraded mode
+	deleted map[uuid.UUID]struct{}
+	// flushed is true if FlushAll is called in degraded mode
+	flushed bool
+}
+
+// New returns Cache which falls back to secondary while primary is unavailable.
+// The health of primary is checked every checkInterval in degraded mode until ctx is done.
+func New(ctx context.Context, primary, secondary cache.Cache, checkInterval time.Duration) *Cache {
+	fc := &Cache{
+		primary:   primary,
+		secondary: secondary,
+		changed:   make(map[uuid.UUID]struct{}),
+		deleted:   make(map[uuid.UUID]struct{}),
+	}
+	go fc.startRecovery(ctx, checkInterval)
+	return fc
+}
+
+func (fc *Cache) GetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
+	var value interface{}
+	err := fc.do(ctx, "GetValue", func(c cache.Cache) (err error) {
+		value, err = c.GetValue(ctx, pipelineId, subKey)
+		return err
+	})
+	return value, err
+}
+
+func (fc *Cache) GetValueWithTTL(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, time.Duration, error) {
+	var value interface{}
+	var ttl time.Duration
+	err := fc.do(ctx, "GetValueWithTTL", func(c cache.Cache) (err error) {
+		value, ttl, err = c.GetValueWithTTL(ctx, pipelineId, subKey)
+		return err
+	})
+	return value, ttl, err
+}
+
+func (fc *Cache) GetValues(ctx context.Context, pipelineId uuid.UUID, subKeys []cache.SubKey) (map[cache.SubKey]interface{}, error) {
+	var values map[cache.SubKey]interface{}
+	err := fc.do(ctx, "GetValues", func(c cache.Cache) (err error) {
+		values, err = c.GetValues(ctx, pipelineId, subKeys)
+		return err
+	})
+	return values, err
+}
+
+func (fc *Cache) GetPipelines(ctx context.Context) ([]uuid.UUID, error) {
+	var pipelines []uuid.UUID
+	err := fc.do(ctx, "GetPipelines", func(c cache.Cache) (err error) {
+		pipelines, err = c.GetPipelines(ctx)
+		return err
+	})
+	return pipelines, err
+}
+
+func (fc *Cache) GetAge(ctx context.Context, pipelineId uuid.UUID) (time.Duration, error) {
+	var age time.Duration
+	err := fc.do(ctx, "GetAge", func(c cache.Cache) (err error) {
+		age, err = c.GetAge(ctx, pipelineId)
+		return err
+	})
+	return age, err
+}
+
+func (fc *Cache) ScanValues(ctx context.Context, pipelineId uuid.UUID) (map[cache.SubKey]interface{}, error) {
+	var values map[cache.SubKey]interface{}
+	err := fc.do(ctx, "ScanValues", func(c cache.Cache) (err error) {
+		values, err = c.ScanValues(ctx, pipelineId)
+		return err
+	})
+	return values, err
+}
+
+func (fc *Cache) GetSubKeyCount(ctx context.Context, pipelineId uuid.UUID) (int, error) {
+	var count int
+	err := fc.do(ctx, "GetSubKeyCount", func(c cache.Cache) (err error) {
+		count, err = c.GetSubKeyCount(ctx, pipelineId)
+		return err
+	})
+	return count, err
+}
+
+func (fc *Cache) SetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) error {
+	return fc.doChange(ctx, "SetValue", pipelineId, func(c cache.Cache) error {
+		return c.SetValue(ctx, pipelineId, subKey, value)
+	})
+}
+
+func (fc *Cache) SetValues(ctx context.Context, pipelineId uuid.UUID, values map[cache.SubKey]interface{}) error {
+	return fc.doChange(ctx, "SetValues", pipelineId, func(c cache.Cache) error {
+		return c.SetValues(ctx, pipelineId, values)
+	})
+}
+
+func (fc *Cache) Preload(ctx context.Context, pipelines map[uuid.UUID]map[cache.SubKey]interface{}) error {
+	return fc.do(ctx, "Preload", func(c cache.Cache) error {
+		if c == fc.secondary {
+			fc.stateMu.Lock()
+			for pipelineId := range pipelines {
+				fc.changed[pipelineId] = struct{}{}
+			}
+			fc.stateMu.Unlock()
+		}
+		return c.Preload(ctx, pipelines)
+	})
+}
+
+func (fc *Cache) SetStatusIfNotTerminal(ctx context.Context, pipelineId uuid.UUID, status pb.Status) (bool, error) {
+	var set bool
+	err := fc.doChange(ctx, "SetStatusIfNotTerminal", pipelineId, func(c cache.Cache) (err error) {
+		set, err = c.SetStatusIfNotTerminal(ctx, pipelineId, status)
+		return err
+	})
+	return set, err
+}
+
+func (fc *Cache) SubscribeStatus(ctx context.Context, pipelineId uuid.UUID) (<-chan pb.Status, error) {
+	var statuses <-chan pb.Status
+	err := fc.do(ctx, "SubscribeStatus", func(c cache.Cache) (err error) {
+		statuses, err = c.SubscribeStatus(ctx, pipelineId)
+		return err
+	})
+	return statuses, err
+}
+
+func (fc *Cache) DeleteValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) error {
+	return fc.do(ctx, "DeleteValue", func(c cache.Cache) error {
+		return c.DeleteValue(ctx, pipelineId, subKey)
+	})
+}
+
+func (fc *Cache) DeletePipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	return fc.do(ctx, "DeletePipeline", func(c cache.Cache) error {
+		if c == fc.secondary {
+			fc.stateMu.Lock()
+			delete(fc.changed, pipelineId)
+			fc.deleted[pipelineId] = struct{}{}
+			fc.stateMu.Unlock()
+		}
+		return c.DeletePipeline(ctx, pipelineId)
+	})
+}
+
+func (fc *Cache) FlushAll(ctx context.Context) error {
+	return fc.do(ctx, "FlushAll", func(c cache.Cache) error {
+		if c == fc.secondary {
+			fc.stateMu.Lock()
+			fc.changed = make(map[uuid.UUID]struct{})
+			fc.deleted = make(map[uuid.UUID]struct{})
+			fc.flushed = true
+			fc.stateMu.Unlock()
+		}
+		return c.FlushAll(ctx)
+	})
+}
+
+func (fc *Cache) SetExpTime(ctx context.Context, pipelineId uuid.UUID, expTime time.Duration) error {
+	return fc.doChange(ctx, "SetExpTime", pipelineId, func(c cache.Cache) error {
+		return c.SetExpTime(ctx, pipelineId, expTime)
+	})
+}
+
+func (fc *Cache) TouchPipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	return fc.doChange(ctx, "TouchPipeline", pipelineId, func(c cache.Cache) error {
+		return c.TouchPipeline(ctx, pipelineId)
+	})
+}
+
+// HealthCheck returns nil in degraded mode since operations are served by the secondary Cache.
+// Otherwise, it checks the primary Cache.
+func (fc *Cache) HealthCheck(ctx context.Context) error {
+	if fc.isDegraded() {
+		return fc.secondary.HealthCheck(ctx)
+	}
+	return fc.primary.HealthCheck(ctx)
+}
+
+// Close applies changes which are made in degraded mode to the primary Cache if it is available again,
+// then closes both the primary and the secondary Cache.
+// If the primary is still unavailable, changes of the secondary are lost.
+func (fc *Cache) Close() error {
+	ctx := context.Background()
+	if fc.isDegraded() && fc.primary.HealthCheck(ctx) == nil {
+		if err := fc.reconcile(ctx); err != nil {
+			logger.Errorf("Fallback Cache: close: error during reconciliation with primary cache, err: %s\n", err.Error())
+		}
+	}
+	if err := fc.primary.Close(); err != nil {
+		_ = fc.secondary.Close()
+		return err
+	}
+	return fc.secondary.Close()
+}
+
+// do runs the operation with the primary Cache.
+// If the primary returns a connection error or Cache is in degraded mode, the operation runs with the secondary Cache.
+// Errors of the operation whose ctx is done aren't connection errors of the primary, so they don't switch Cache
+// to degraded mode.
+func (fc *Cache) do(ctx context.Context, operation string, run func(c cache.Cache) error) error {
+	fc.mu.RLock()
+	defer fc.mu.RUnlock()
+
+	if !fc.isDegraded() {
+		err := run(fc.primary)
+		if ctx.Err() != nil || !cache.IsConnectionError(err) {
+			return err
+		}
+		logger.Warnf("Fallback Cache: %s: primary cache is unavailable, switch to secondary cache, err: %s\n", operation, err.Error())
+		fc.stateMu.Lock()
+		fc.degraded = true
+		fc.stateMu.Unlock()
+	}
+	return run(fc.secondary)
+}
+
+// doChange runs the operation which changes the pipeline the same way as do.
+// If the operation runs with the secondary Cache, the pipeline is copied to the primary after recovery.
+func (fc *Cache) doChange(ctx context.Context, operation string, pipelineId uuid.UUID, run func(c cache.Cache) error) error {
+	return fc.do(ctx, operation, func(c cache.Cache) error {
+		if c == fc.secondary {
+			fc.stateMu.Lock()
+			fc.changed[pipelineId] = struct{}{}
+			fc.stateMu.Unlock()
+		}
+		return run(c)
+	})
+}
+
+// isDegraded checks that operations are served by the secondary Cache
+func (fc *Cache) isDegraded() bool {
+	fc.stateMu.Lock()
+	defer fc.stateMu.Unlock()
+	return fc.degraded
+}
+
+// startRecovery checks the health of the primary Cache every checkInterval in degraded mode
+// and reconciles it with the secondary Cache when the primary is available again
+func (fc *Cache) startRecovery(ctx context.Context, checkInterval time.Duration) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !fc.isDegraded() {
+				continue
+			}
+			if err := fc.primary.HealthCheck(ctx); err != nil {
+				continue
+			}
+			if err := fc.reconcile(ctx); err != nil {
+				logger.Errorf("Fallback Cache: error during reconciliation with primary cache, err: %s\n", err.Error())
+			}
+		}
+	}
+}
+
+// reconcile applies changes which are made in degraded mode to the primary Cache and switches back to it.
+// Changed pipelines are copied to the primary and removed from the secondary.
+// If some change can't be applied, Cache stays in degraded mode and reconcile can be repeated.
+func (fc *Cache) reconcile(ctx context.Context) error {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	fc.stateMu.Lock()
+	defer fc.stateMu.Unlock()
+
+	if fc.flushed {
+		if err := fc.primary.FlushAll(ctx); err != nil {
+			return err
+		}
+		fc.flushed = false
+	}
+	for pipelineId := range fc.deleted {
+		if err := fc.primary.DeletePipeline(ctx, pipelineId); err != nil {
+			return err
+		}
+		delete(fc.deleted, pipelineId)
+	}
+	for pipelineId := range fc.changed {
+		if err := fc.copyPipeline(ctx, pipelineId); err != nil {
+			return err
+		}
+		if err := fc.secondary.DeletePipeline(ctx, pipelineId); err != nil {
+			return err
+		}
+		delete(fc.changed, pipelineId)
+	}
+	fc.degraded = false
+	logger.Infof("Fallback Cache: primary cache is available, switch back to primary cache\n")
+	return nil
+}
+
+// copyPipeline copies all values and expiration times of the pipeline from the secondary Cache to the primary.
+// Values are read by ScanValues, so subKeys of other packages (e.g. snippets) are copied as well.
+// The pipeline expires with the longest time to live of its values. SubKeys which expire earlier keep their own time
+// to live if the primary keeps expiration times of subKeys separately (see cache.SubKeyExpirer).
+func (fc *Cache) copyPipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	values, err := fc.secondary.ScanValues(ctx, pipelineId)
+	if err != nil {
+		return err
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	ttls := make(map[cache.SubKey]time.Duration, len(values))
+	var pipelineTtl time.Duration
+	for subKey := range values {
+		_, ttl, err := fc.secondary.GetValueWithTTL(ctx, pipelineId, subKey)
+		if errors.Is(err, cache.ErrNotFound) {
+			// the value is expired after it is read
+			delete(values, subKey)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		ttls[subKey] = ttl
+		if ttl == cache.NoExpiration || (pipelineTtl != cache.NoExpiration && ttl > pipelineTtl) {
+			pipelineTtl = ttl
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	if err = fc.primary.SetValues(ctx, pipelineId, values); err != nil {
+		return err
+	}
+	if pipelineTtl == cache.NoExpiration {
+		return nil
+	}
+	if err = fc.primary.SetExpTime(ctx, pipelineId, pipelineTtl); err != nil {
+		return err
+	}
+	expirer, ok := fc.primary.(cache.SubKeyExpirer)
+	if !ok {
+		return nil
+	}
+	for subKey, ttl := range ttls {
+		if ttl == pipelineTtl {
+			continue
+		}
+		if _, err = expirer.SetSubKeyExpTime(ctx, pipelineId, subKey, ttl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,376 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fallback
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/local"
+	"beam.apache.org/playground/backend/internal/cache/redis"
+	"beam.apache.org/playground/backend/internal/snippets"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-redis/redismock/v8"
+	"github.com/google/uuid"
+	"net"
+	"reflect"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+var errConnection = &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+
+// flakyCache is local Cache which returns connection errors while it is down
+type flakyCache struct {
+	*local.Cache
+	mu     sync.Mutex
+	down   bool
+	closed bool
+}
+
+func (c *flakyCache) setDown(down bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.down = down
+}
+
+func (c *flakyCache) err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.down {
+		return errConnection
+	}
+	return nil
+}
+
+func (c *flakyCache) GetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
+	if err := c.err(); err != nil {
+		return nil, err
+	}
+	return c.Cache.GetValue(ctx, pipelineId, subKey)
+}
+
+func (c *flakyCache) SetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) error {
+	if err := c.err(); err != nil {
+		return err
+	}
+	return c.Cache.SetValue(ctx, pipelineId, subKey, value)
+}
+
+func (c *flakyCache) DeletePipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	if err := c.err(); err != nil {
+		return err
+	}
+	return c.Cache.DeletePipeline(ctx, pipelineId)
+}
+
+func (c *flakyCache) HealthCheck(context.Context) error {
+	return c.err()
+}
+
+func (c *flakyCache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+func TestCache_Fallback(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pipelineId := uuid.New()
+	status := pb.Status_STATUS_EXECUTING
+	marshStatus, _ := json.Marshal(status)
+	marshSubKey, _ := json.Marshal(cache.Status)
+
+	tests := []struct {
+		name         string
+		mocks        func(mock redismock.ClientMock)
+		action       func(fc *Cache) (interface{}, error)
+		want         interface{}
+		wantErr      bool
+		wantDegraded bool
+	}{
+		{
+			// Test case with available primary cache.
+			// As a result, want the value to be received from the primary cache.
+			name: "primary is available",
+			mocks: func(mock redismock.ClientMock) {
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshStatus))
+			},
+			action: func(fc *Cache) (interface{}, error) {
+				return fc.GetValue(ctx, pipelineId, cache.Status)
+			},
+			want:         status,
+			wantDegraded: false,
+		},
+		{
+			// Test case with the primary cache which returns an error which isn't connection error.
+			// As a result, want to receive the error without falling back to the secondary cache.
+			name: "primary returns other error",
+			mocks: func(mock redismock.ClientMock) {
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			action: func(fc *Cache) (interface{}, error) {
+				return fc.GetValue(ctx, pipelineId, cache.Status)
+			},
+			want:         nil,
+			wantErr:      true,
+			wantDegraded: false,
+		},
+		{
+			// Test case with the primary cache which returns connection errors.
+			// As a result, want the value to be set to and received from the secondary cache
+			// without the second request to the primary one.
+			name: "primary returns connection error",
+			mocks: func(mock redismock.ClientMock) {
+				mock.ExpectExists(pipelineId.String()).SetErr(errConnection)
+			},
+			action: func(fc *Cache) (interface{}, error) {
+				if err := fc.SetValue(ctx, pipelineId, cache.Status, status); err != nil {
+					return nil, err
+				}
+				return fc.GetValue(ctx, pipelineId, cache.Status)
+			},
+			want:         status,
+			wantDegraded: true,
+		},
+		{
+			// Test case with the request whose deadline is exceeded during the operation with the primary cache.
+			// As a result, want to receive the error without switching to the secondary cache
+			// since the primary isn't unavailable.
+			name: "request deadline is exceeded",
+			mocks: func(mock redismock.ClientMock) {
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetErr(context.DeadlineExceeded)
+			},
+			action: func(fc *Cache) (interface{}, error) {
+				requestCtx, cancelRequest := context.WithDeadline(ctx, time.Now().Add(-time.Second))
+				defer cancelRequest()
+				return fc.GetValue(requestCtx, pipelineId, cache.Status)
+			},
+			want:         nil,
+			wantErr:      true,
+			wantDegraded: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			mock.ExpectPing().SetVal("PONG")
+			primary, err := redis.NewWithClient(ctx, client, &redis.Options{})
+			if err != nil {
+				t.Fatalf("NewWithClient() error = %v", err)
+			}
+			tt.mocks(mock)
+			fc := New(ctx, primary, local.New(ctx), time.Hour)
+			got, err := tt.action(fc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Fallback() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Fallback() got = %v, want %v", got, tt.want)
+			}
+			if fc.isDegraded() != tt.wantDegraded {
+				t.Errorf("Fallback() degraded = %v, want %v", fc.isDegraded(), tt.wantDegraded)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Fallback() %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestCache_Recovery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pipelineId, deletedId := uuid.New(), uuid.New()
+	primary := &flakyCache{Cache: local.New(ctx)}
+	secondary := local.New(ctx)
+	fc := New(ctx, primary, secondary, time.Millisecond*10)
+
+	if err := primary.Cache.SetValue(ctx, deletedId, cache.Status, pb.Status_STATUS_FINISHED); err != nil {
+		t.Fatalf("SetValue() error = %v", err)
+	}
+	primary.setDown(true)
+	if err := fc.SetValue(ctx, pipelineId, cache.RunOutput, "MOCK_OUTPUT"); err != nil {
+		t.Fatalf("SetValue() error = %v", err)
+	}
+	logEntries := []cache.LogEntry{{Severity: pb.LogSeverity_LOG_SEVERITY_INFO, Message: "MOCK_LOG"}}
+	if err := fc.SetValue(ctx, pipelineId, cache.LogEntries, logEntries); err != nil {
+		t.Fatalf("SetValue() error = %v", err)
+	}
+	if err := fc.SetExpTime(ctx, pipelineId, time.Minute); err != nil {
+		t.Fatalf("SetExpTime() error = %v", err)
+	}
+	if err := fc.DeletePipeline(ctx, deletedId); err != nil {
+		t.Fatalf("DeletePipeline() error = %v", err)
+	}
+	if !fc.isDegraded() {
+		t.Fatalf("Recovery() cache isn't degraded while primary is down")
+	}
+
+	primary.setDown(false)
+	deadline := time.Now().Add(time.Second)
+	for fc.isDegraded() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if fc.isDegraded() {
+		t.Fatalf("Recovery() cache is degraded after primary is recovered")
+	}
+
+	value, ttl, err := primary.Cache.GetValueWithTTL(ctx, pipelineId, cache.RunOutput)
+	if err != nil || value != "MOCK_OUTPUT" {
+		t.Errorf("Recovery() primary value = %v, %v, want %v", value, err, "MOCK_OUTPUT")
+	}
+	if ttl <= 0 || ttl > time.Minute {
+		t.Errorf("Recovery() primary ttl = %s, want up to %s", ttl, time.Minute)
+	}
+	if value, err := primary.Cache.GetValue(ctx, pipelineId, cache.LogEntries); err != nil || !reflect.DeepEqual(value, logEntries) {
+		t.Errorf("Recovery() primary log entries = %v, %v, want %v", value, err, logEntries)
+	}
+	if _, err := primary.Cache.GetValue(ctx, deletedId, cache.Status); !errors.Is(err, cache.ErrNotFound) {
+		t.Errorf("Recovery() deleted pipeline is kept by primary, err = %v", err)
+	}
+	if _, err := secondary.GetValue(ctx, pipelineId, cache.RunOutput); !errors.Is(err, cache.ErrNotFound) {
+		t.Errorf("Recovery() reconciled pipeline is kept by secondary, err = %v", err)
+	}
+	if got, err := fc.GetValue(ctx, pipelineId, cache.RunOutput); err != nil || got != "MOCK_OUTPUT" {
+		t.Errorf("Recovery() GetValue() = %v, %v, want %v", got, err, "MOCK_OUTPUT")
+	}
+}
+
+func TestCache_RecoverySnippet(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	primary := &flakyCache{Cache: local.New(ctx)}
+	fc := New(ctx, primary, local.New(ctx), time.Millisecond*10)
+	snippet := &snippets.Snippet{Sdk: pb.Sdk_SDK_PYTHON, Code: "MOCK_CODE", PipelineOptions: "--option=value"}
+
+	primary.setDown(true)
+	id, err := snippets.Save(ctx, snippets.NewCacheStore(fc), snippet, time.Hour)
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	primary.setDown(false)
+	deadline := time.Now().Add(time.Second)
+	for fc.isDegraded() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if fc.isDegraded() {
+		t.Fatalf("Recovery() cache is degraded after primary is recovered")
+	}
+
+	// the id of the snippet is returned during the outage, so the snippet is available after recovery
+	got, err := snippets.Get(ctx, snippets.NewCacheStore(primary.Cache), id)
+	if err != nil || !reflect.DeepEqual(got, snippet) {
+		t.Errorf("Recovery() primary snippet = %v, %v, want %v", got, err, snippet)
+	}
+}
+
+// ttlCache is local Cache which returns the time to live of each subKey from ttls
+type ttlCache struct {
+	*local.Cache
+	ttls map[cache.SubKey]time.Duration
+}
+
+func (c *ttlCache) GetValueWithTTL(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, time.Duration, error) {
+	value, _, err := c.Cache.GetValueWithTTL(ctx, pipelineId, subKey)
+	return value, c.ttls[subKey], err
+}
+
+// expiringCache is local Cache which keeps expiration times of separateSubKeys independently of the pipeline
+type expiringCache struct {
+	*local.Cache
+	separateSubKeys map[cache.SubKey]bool
+	pipelineTtl     time.Duration
+	subKeyTtls      map[cache.SubKey]time.Duration
+}
+
+func (c *expiringCache) SetExpTime(ctx context.Context, pipelineId uuid.UUID, expTime time.Duration) error {
+	c.pipelineTtl = expTime
+	return c.Cache.SetExpTime(ctx, pipelineId, expTime)
+}
+
+func (c *expiringCache) SetSubKeyExpTime(_ context.Context, _ uuid.UUID, subKey cache.SubKey, expTime time.Duration) (bool, error) {
+	if !c.separateSubKeys[subKey] {
+		return false, nil
+	}
+	c.subKeyTtls[subKey] = expTime
+	return true, nil
+}
+
+func TestCache_copyPipeline(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pipelineId := uuid.New()
+	secondary := &ttlCache{Cache: local.New(ctx), ttls: map[cache.SubKey]time.Duration{
+		cache.Status:    time.Minute * 10,
+		cache.RunOutput: time.Minute * 10,
+		cache.Logs:      time.Minute,
+	}}
+	primary := &expiringCache{Cache: local.New(ctx), separateSubKeys: map[cache.SubKey]bool{cache.Logs: true}, subKeyTtls: map[cache.SubKey]time.Duration{}}
+	values := map[cache.SubKey]interface{}{cache.Status: pb.Status_STATUS_FINISHED, cache.RunOutput: "MOCK_OUTPUT", cache.Logs: "MOCK_LOGS"}
+	if err := secondary.SetValues(ctx, pipelineId, values); err != nil {
+		t.Fatalf("SetValues() error = %v", err)
+	}
+	fc := &Cache{primary: primary, secondary: secondary}
+
+	if err := fc.copyPipeline(ctx, pipelineId); err != nil {
+		t.Fatalf("copyPipeline() error = %v", err)
+	}
+	got, err := primary.Cache.GetValues(ctx, pipelineId, []cache.SubKey{cache.Status, cache.RunOutput, cache.Logs})
+	if err != nil || !reflect.DeepEqual(got, values) {
+		t.Errorf("copyPipeline() primary values = %v, %v, want %v", got, err, values)
+	}
+	// the pipeline expires with its longest living values, Logs keep their own shorter time to live
+	if primary.pipelineTtl != time.Minute*10 {
+		t.Errorf("copyPipeline() pipeline ttl = %s, want %s", primary.pipelineTtl, time.Minute*10)
+	}
+	wantSubKeyTtls := map[cache.SubKey]time.Duration{cache.Logs: time.Minute}
+	if !reflect.DeepEqual(primary.subKeyTtls, wantSubKeyTtls) {
+		t.Errorf("copyPipeline() subKey ttls = %v, want %v", primary.subKeyTtls, wantSubKeyTtls)
+	}
+}
+
+func TestCache_Close(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pipelineId := uuid.New()
+	primary := &flakyCache{Cache: local.New(ctx)}
+	secondary := &flakyCache{Cache: local.New(ctx)}
+	// recovery doesn't start during the test, so changes are applied to the primary only by Close
+	fc := New(ctx, primary, secondary, time.Hour)
+
+	primary.setDown(true)
+	if err := fc.SetValue(ctx, pipelineId, cache.RunOutput, "MOCK_OUTPUT"); err != nil {
+		t.Fatalf("SetValue() error = %v", err)
+	}
+	primary.setDown(false)
+
+	if err := fc.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if value, err := primary.Cache.GetValue(ctx, pipelineId, cache.RunOutput); err != nil || value != "MOCK_OUTPUT" {
+		t.Errorf("Close() primary value = %v, %v, want %v", value, err, "MOCK_OUTPUT")
+	}
+	if !primary.closed || !secondary.closed {
+		t.Errorf("Close() primary closed = %t, secondary closed = %t, want both closed", primary.closed, secondary.closed)
+	}
+}
@@ -16,6 +16,7 @@
 package local
 
 import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/cache"
 	"context"
 	"fmt"
@@ -25,7 +26,10 @@ import (
 )
 
 const (
-	cleanupInterval = 5 * time.Second
+	cleanupInterval          = 5 * time.Second
+	defaultKeyExpirationTime = 15 * time.Minute
+	preloadExpirationTime    = 24 * time.Hour
+	statusesBufferSize       = 10
 )
 
 type Cache struct {
@@ -33,6 +37,18 @@ type Cache struct {
 	cleanupInterval     time.Duration
 	items               map[uuid.UUID]map[cache.SubKey]interface{}
 	pipelinesExpiration map[uuid.UUID]time.Time
+	// pipelinesCreation keeps the time when each pipeline was first set to cache
+	pipelinesCreation map[uuid.UUID]time.Time
+	// keyExpirationTime is expiration time which is set to the pipeline when it is created. If it is zero, the pipeline doesn't expire.
+	keyExpirationTime time.Duration
+	// now returns the current time. If it is nil, time.Now is used.
+	now func() time.Time
+	// statusSubscribers keeps channels of status subscribers of each pipeline
+	statusSubscribers map[uuid.UUID][]chan pb.Status
+	// snapshotPath is the file which the cache is saved to, empty if the cache isn't saved (see NewWithSnapshot)
+	snapshotPath string
+	// snapshotMu serializes writing of the snapshot file
+	snapshotMu sync.Mutex
 }
 
 func New(ctx context.Context) *Cache {
@@ -42,6 +58,9 @@ func New(ctx context.Context) *Cache {
 		cleanupInterval:     cleanupInterval,
 		items:               items,
 		pipelinesExpiration: pipelinesExpiration,
+		pipelinesCreation:   make(map[uuid.UUID]time.Time),
+		keyExpirationTime:   defaultKeyExpirationTime,
+		now:                 time.Now,
 	}
 
 	go ls.startGC(ctx)
@@ -49,47 +68,280 @@ func New(ctx context.Context) *Cache {
 
 }
 
-// GetValue returns value from cache. If not found or key is expired, GetValue returns an error.
+// GetValue returns value from cache. If not found or key is expired, GetValue returns an error which wraps cache.ErrNotFound.
 func (lc *Cache) GetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
 	lc.RLock()
 	value, found := lc.items[pipelineId][subKey]
 	if !found {
 		lc.RUnlock()
-		return nil, fmt.Errorf("value with pipelineId: %s and subKey: %s not found", pipelineId, subKey)
+		return nil, fmt.Errorf("value with pipelineId: %s and subKey: %s: %w", pipelineId, subKey, cache.ErrNotFound)
 	}
 	expTime, found := lc.pipelinesExpiration[pipelineId]
 	lc.RUnlock()
 
-	if found && expTime.Before(time.Now()) {
+	if found && expTime.Before(lc.currentTime()) {
 		lc.Lock()
-		delete(lc.items[pipelineId], subKey)
+		delete(lc.items, pipelineId)
 		delete(lc.pipelinesExpiration, pipelineId)
 		lc.Unlock()
-		return nil, fmt.Errorf("value with pipelineId: %s and subKey: %s is expired", pipelineId, subKey)
+		return nil, fmt.Errorf("value with pipelineId: %s and subKey: %s is expired: %w", pipelineId, subKey, cache.ErrNotFound)
 	}
 
 	return value, nil
 }
 
+// GetValueWithTTL returns value from cache together with remaining time to live of the pipeline.
+// If expiration time of the pipeline isn't set, the time to live is cache.NoExpiration.
+// If not found or key is expired, GetValueWithTTL returns an error which wraps cache.ErrNotFound.
+func (lc *Cache) GetValueWithTTL(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, time.Duration, error) {
+	value, err := lc.GetValue(ctx, pipelineId, subKey)
+	if err != nil {
+		return nil, 0, err
+	}
+	lc.RLock()
+	expTime, found := lc.pipelinesExpiration[pipelineId]
+	lc.RUnlock()
+	if !found {
+		return value, cache.NoExpiration, nil
+	}
+	return value, expTime.Sub(lc.currentTime()), nil
+}
+
+// GetValues returns values from cache by list of subKeys.
+// SubKeys which are not found are absent from the result map. If key is expired, GetValues returns an empty map.
+func (lc *Cache) GetValues(ctx context.Context, pipelineId uuid.UUID, subKeys []cache.SubKey) (map[cache.SubKey]interface{}, error) {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	result := make(map[cache.SubKey]interface{}, len(subKeys))
+	if expTime, found := lc.pipelinesExpiration[pipelineId]; found && expTime.Before(lc.currentTime()) {
+		return result, nil
+	}
+	for _, subKey := range subKeys {
+		if value, found := lc.items[pipelineId][subKey]; found {
+			result[subKey] = value
+		}
+	}
+	return result, nil
+}
+
+// ScanValues returns all values of the pipeline in cache. If key is expired, ScanValues returns an empty map.
+func (lc *Cache) ScanValues(ctx context.Context, pipelineId uuid.UUID) (map[cache.SubKey]interface{}, error) {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	result := make(map[cache.SubKey]interface{}, len(lc.items[pipelineId]))
+	if expTime, found := lc.pipelinesExpiration[pipelineId]; found && expTime.Before(lc.currentTime()) {
+		return result, nil
+	}
+	for subKey, value := range lc.items[pipelineId] {
+		result[subKey] = value
+	}
+	return result, nil
+}
+
+// GetPipelines returns ids of all pipelines which are kept in cache and are not expired.
+func (lc *Cache) GetPipelines(ctx context.Context) ([]uuid.UUID, error) {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	pipelines := make([]uuid.UUID, 0, len(lc.items))
+	for pipelineId := range lc.items {
+		if expTime, found := lc.pipelinesExpiration[pipelineId]; found && expTime.Before(lc.currentTime()) {
+			continue
+		}
+		pipelines = append(pipelines, pipelineId)
+	}
+	return pipelines, nil
+}
+
+// GetAge returns the time passed since the pipeline was first set to cache.
+// If pipeline is not found or key is expired, GetAge returns an error which wraps cache.ErrNotFound.
+func (lc *Cache) GetAge(ctx context.Context, pipelineId uuid.UUID) (time.Duration, error) {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	if expTime, found := lc.pipelinesExpiration[pipelineId]; found && expTime.Before(lc.currentTime()) {
+		return 0, fmt.Errorf("creation time of pipelineId: %s is expired: %w", pipelineId, cache.ErrNotFound)
+	}
+	createdAt, found := lc.pipelinesCreation[pipelineId]
+	if !found {
+		return 0, fmt.Errorf("creation time of pipelineId: %s: %w", pipelineId, cache.ErrNotFound)
+	}
+	return lc.currentTime().Sub(createdAt), nil
+}
+
+// GetSubKeyCount returns the number of subKeys of the pipeline in cache.
+// If pipeline is not found or key is expired, GetSubKeyCount returns 0.
+func (lc *Cache) GetSubKeyCount(ctx context.Context, pipelineId uuid.UUID) (int, error) {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	if expTime, found := lc.pipelinesExpiration[pipelineId]; found && expTime.Before(lc.currentTime()) {
+		return 0, nil
+	}
+	return len(lc.items[pipelineId]), nil
+}
+
 // SetValue puts element to cache.
-// If a particular pipelineId does not contain in the cache, SetValue creates a new element for this pipelineId without expiration time.
-// Use SetExpTime to set expiration time for cache elements.
+// If a particular pipelineId does not contain in the cache, SetValue creates a new element for this pipelineId with default expiration time.
+// Use SetExpTime to change expiration time for cache elements.
 // If data for a particular pipelineId is already contained in the cache, SetValue sets or updates the value for the specific subKey.
 func (lc *Cache) SetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) error {
 	lc.Lock()
 	defer lc.Unlock()
 
+	lc.setValue(pipelineId, subKey, value)
+	return nil
+}
+
+// SetValues puts all elements to cache under one lock.
+// If a particular pipelineId does not contain in the cache, SetValues creates a new element for this pipelineId with default expiration time.
+func (lc *Cache) SetValues(ctx context.Context, pipelineId uuid.UUID, values map[cache.SubKey]interface{}) error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	for subKey, value := range values {
+		lc.setValue(pipelineId, subKey, value)
+	}
+	return nil
+}
+
+// setValue puts element to cache. It should be called under the lock.
+func (lc *Cache) setValue(pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) {
 	_, ok := lc.items[pipelineId]
 	if !ok {
 		lc.items[pipelineId] = make(map[cache.SubKey]interface{})
+		if _, found := lc.pipelinesExpiration[pipelineId]; !found && lc.keyExpirationTime != 0 {
+			lc.pipelinesExpiration[pipelineId] = lc.currentTime().Add(lc.keyExpirationTime)
+		}
+		if lc.pipelinesCreation == nil {
+			lc.pipelinesCreation = make(map[uuid.UUID]time.Time)
+		}
+		if _, found := lc.pipelinesCreation[pipelineId]; !found {
+			lc.pipelinesCreation[pipelineId] = lc.currentTime()
+		}
 	}
 
+	if raw, ok := value.([]byte); ok {
+		// binary values are copied so the caller can reuse the slice
+		value = append([]byte(nil), raw...)
+	}
 	switch subKey {
 	case cache.RunOutputIndex, cache.LogsIndex:
 		value = float64(value.(int))
+	case cache.Status:
+		lc.notifyStatusSubscribers(pipelineId, value)
 	}
 
 	lc.items[pipelineId][subKey] = value
+}
+
+// Preload puts all elements of the pipelines to cache under one lock.
+// Preloaded pipelines expire after preloadExpirationTime.
+func (lc *Cache) Preload(ctx context.Context, pipelines map[uuid.UUID]map[cache.SubKey]interface{}) error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	for pipelineId, values := range pipelines {
+		for subKey, value := range values {
+			lc.setValue(pipelineId, subKey, value)
+		}
+		lc.pipelinesExpiration[pipelineId] = lc.currentTime().Add(preloadExpirationTime)
+	}
+	return nil
+}
+
+// SetStatusIfNotTerminal sets status of the pipeline unless the current status is terminal.
+// The check and the update are done under the lock of the cache.
+func (lc *Cache) SetStatusIfNotTerminal(ctx context.Context, pipelineId uuid.UUID, status pb.Status) (bool, error) {
+	lc.Lock()
+	defer lc.Unlock()
+	if current, ok := lc.items[pipelineId][cache.Status].(pb.Status); ok && cache.IsTerminalStatus(current) {
+		return false, nil
+	}
+	lc.setValue(pipelineId, cache.Status, status)
+	return true, nil
+}
+
+// SubscribeStatus returns channel which receives statuses of the pipeline which are set after the subscription.
+// If the subscriber doesn't read statuses, new statuses are dropped when the channel buffer is full.
+// The channel is closed when ctx is done.
+func (lc *Cache) SubscribeStatus(ctx context.Context, pipelineId uuid.UUID) (<-chan pb.Status, error) {
+	lc.Lock()
+	defer lc.Unlock()
+
+	if lc.statusSubscribers == nil {
+		lc.statusSubscribers = make(map[uuid.UUID][]chan pb.Status)
+	}
+	statuses := make(chan pb.Status, statusesBufferSize)
+	lc.statusSubscribers[pipelineId] = append(lc.statusSubscribers[pipelineId], statuses)
+	go func() {
+		<-ctx.Done()
+		lc.unsubscribeStatus(pipelineId, statuses)
+	}()
+	return statuses, nil
+}
+
+// notifyStatusSubscribers sends status to all status subscribers of the pipeline. It should be called under the lock.
+func (lc *Cache) notifyStatusSubscribers(pipelineId uuid.UUID, value interface{}) {
+	status, ok := value.(pb.Status)
+	if !ok {
+		return
+	}
+	for _, statuses := range lc.statusSubscribers[pipelineId] {
+		select {
+		case statuses <- status:
+		default:
+		}
+	}
+}
+
+// unsubscribeStatus removes channel from status subscribers of the pipeline and closes it
+func (lc *Cache) unsubscribeStatus(pipelineId uuid.UUID, statuses chan pb.Status) {
+	lc.Lock()
+	defer lc.Unlock()
+
+	subscribers := lc.statusSubscribers[pipelineId]
+	for i, subscriber := range subscribers {
+		if subscriber == statuses {
+			lc.statusSubscribers[pipelineId] = append(subscribers[:i], subscribers[i+1:]...)
+			break
+		}
+	}
+	if len(lc.statusSubscribers[pipelineId]) == 0 {
+		delete(lc.statusSubscribers, pipelineId)
+	}
+	close(statuses)
+}
+
+// DeleteValue removes element with the specific subKey from cache.
+// If element doesn't exist in the cache, DeleteValue does nothing.
+func (lc *Cache) DeleteValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) error {
+	lc.Lock()
+	defer lc.Unlock()
+	delete(lc.items[pipelineId], subKey)
+	return nil
+}
+
+// DeletePipeline removes all elements of the pipeline from cache.
+// If pipeline doesn't exist in the cache, DeletePipeline does nothing.
+func (lc *Cache) DeletePipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	lc.Lock()
+	defer lc.Unlock()
+	delete(lc.items, pipelineId)
+	delete(lc.pipelinesExpiration, pipelineId)
+	delete(lc.pipelinesCreation, pipelineId)
+	return nil
+}
+
+// FlushAll removes all pipelines from cache.
+func (lc *Cache) FlushAll(ctx context.Context) error {
+	lc.Lock()
+	defer lc.Unlock()
+	lc.items = make(map[uuid.UUID]map[cache.SubKey]interface{})
+	lc.pipelinesExpiration = make(map[uuid.UUID]time.Time)
+	lc.pipelinesCreation = make(map[uuid.UUID]time.Time)
 	return nil
 }
 
@@ -101,10 +353,44 @@ func (lc *Cache) SetExpTime(ctx context.Context, pipelineId uuid.UUID, expTime t
 	if _, found := lc.items[pipelineId]; !found {
 		return fmt.Errorf("%s pipeline id doesn't presented in cache", pipelineId.String())
 	}
-	lc.pipelinesExpiration[pipelineId] = time.Now().Add(expTime)
+	lc.pipelinesExpiration[pipelineId] = lc.currentTime().Add(expTime)
 	return nil
 }
 
+// TouchPipeline sets the key expiration time of the cache to particular pipelineId in cache.
+// If pipelineId doesn't present in the cache or is expired, TouchPipeline returns an error which wraps cache.ErrNotFound.
+func (lc *Cache) TouchPipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	lc.Lock()
+	defer lc.Unlock()
+	if _, found := lc.items[pipelineId]; !found {
+		return fmt.Errorf("pipelineId: %s: %w", pipelineId, cache.ErrNotFound)
+	}
+	if expTime, found := lc.pipelinesExpiration[pipelineId]; found && expTime.Before(lc.currentTime()) {
+		return fmt.Errorf("pipelineId: %s is expired: %w", pipelineId, cache.ErrNotFound)
+	}
+	if lc.keyExpirationTime == 0 {
+		delete(lc.pipelinesExpiration, pipelineId)
+		return nil
+	}
+	lc.pipelinesExpiration[pipelineId] = lc.currentTime().Add(lc.keyExpirationTime)
+	return nil
+}
+
+// HealthCheck always returns nil since local cache doesn't depend on any external service
+func (lc *Cache) HealthCheck(ctx context.Context) error {
+	return nil
+}
+
+// Close saves the snapshot of the cache if it is created by NewWithSnapshot, otherwise does nothing
+// since local cache doesn't keep any connections.
+// Values are removed by the garbage collector until the context of New is done.
+func (lc *Cache) Close() error {
+	if lc.snapshotPath == "" {
+		return nil
+	}
+	return lc.saveSnapshot(lc.snapshotPath)
+}
+
 func (lc *Cache) startGC(ctx context.Context) {
 	ticker := time.NewTicker(lc.cleanupInterval)
 	for {
@@ -128,18 +414,27 @@ func (lc *Cache) expiredPipelines() (pipelines []uuid.UUID) {
 	lc.RLock()
 	defer lc.RUnlock()
 	for pipelineId, expTime := range lc.pipelinesExpiration {
-		if expTime.Before(time.Now()) {
+		if expTime.Before(lc.currentTime()) {
 			pipelines = append(pipelines, pipelineId)
 		}
 	}
 	return
 }
 
+// currentTime returns the current time using the clock of the cache
+func (lc *Cache) currentTime() time.Time {
+	if lc.now == nil {
+		return time.Now()
+	}
+	return lc.now()
+}
+
 func (lc *Cache) clearItems(pipelines []uuid.UUID) {
 	lc.Lock()
 	defer lc.Unlock()
 	for _, pipeline := range pipelines {
 		delete(lc.items, pipeline)
 		delete(lc.pipelinesExpiration, pipeline)
+		delete(lc.pipelinesCreation, pipeline)
 	}
 }
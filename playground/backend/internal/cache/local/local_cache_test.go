@@ -16,11 +16,15 @@
 package local
 
 import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/cache"
 	"context"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"go.uber.org/goleak"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 )
@@ -122,6 +126,216 @@ func TestLocalCache_GetValue(t *testing.T) {
 	}
 }
 
+func TestLocalCache_GetValues(t *testing.T) {
+	preparedId, _ := uuid.NewUUID()
+	expiredId, _ := uuid.NewUUID()
+	status := "TEST_STATUS"
+	output := "TEST_OUTPUT"
+	preparedItemsMap := make(map[uuid.UUID]map[cache.SubKey]interface{})
+	preparedItemsMap[preparedId] = map[cache.SubKey]interface{}{cache.Status: status, cache.RunOutput: output}
+	preparedItemsMap[expiredId] = map[cache.SubKey]interface{}{cache.Status: status}
+	preparedExpMap := make(map[uuid.UUID]time.Time)
+	preparedExpMap[preparedId] = time.Now().Add(time.Minute)
+	preparedExpMap[expiredId] = time.Now().Add(-time.Minute)
+	type args struct {
+		ctx        context.Context
+		pipelineId uuid.UUID
+		subKeys    []cache.SubKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    map[cache.SubKey]interface{}
+		wantErr bool
+	}{
+		{
+			name: "Get exist values",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: preparedId,
+				subKeys:    []cache.SubKey{cache.Status, cache.RunOutput},
+			},
+			want:    map[cache.SubKey]interface{}{cache.Status: status, cache.RunOutput: output},
+			wantErr: false,
+		},
+		{
+			name: "Get values with not exist subKey",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: preparedId,
+				subKeys:    []cache.SubKey{cache.Status, cache.Graph},
+			},
+			want:    map[cache.SubKey]interface{}{cache.Status: status},
+			wantErr: false,
+		},
+		{
+			name: "Get values of expired pipeline",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: expiredId,
+				subKeys:    []cache.SubKey{cache.Status},
+			},
+			want:    map[cache.SubKey]interface{}{},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lc := &Cache{
+				cleanupInterval:     cleanupInterval,
+				items:               preparedItemsMap,
+				pipelinesExpiration: preparedExpMap,
+			}
+			got, err := lc.GetValues(tt.args.ctx, tt.args.pipelineId, tt.args.subKeys)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetValues() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetValues() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalCache_ScanValues(t *testing.T) {
+	preparedId, _ := uuid.NewUUID()
+	expiredId, _ := uuid.NewUUID()
+	notExistId, _ := uuid.NewUUID()
+	preparedItemsMap := make(map[uuid.UUID]map[cache.SubKey]interface{})
+	preparedItemsMap[preparedId] = map[cache.SubKey]interface{}{cache.Status: "TEST_STATUS", cache.RunOutput: "TEST_OUTPUT", cache.Logs: "TEST_LOGS"}
+	preparedItemsMap[expiredId] = map[cache.SubKey]interface{}{cache.Status: "TEST_STATUS"}
+	preparedExpMap := make(map[uuid.UUID]time.Time)
+	preparedExpMap[preparedId] = time.Now().Add(time.Minute)
+	preparedExpMap[expiredId] = time.Now().Add(-time.Minute)
+	tests := []struct {
+		name       string
+		pipelineId uuid.UUID
+		want       map[cache.SubKey]interface{}
+	}{
+		{
+			name:       "Scan values of exist pipeline",
+			pipelineId: preparedId,
+			want:       map[cache.SubKey]interface{}{cache.Status: "TEST_STATUS", cache.RunOutput: "TEST_OUTPUT", cache.Logs: "TEST_LOGS"},
+		},
+		{
+			name:       "Scan values of expired pipeline",
+			pipelineId: expiredId,
+			want:       map[cache.SubKey]interface{}{},
+		},
+		{
+			name:       "Scan values of not exist pipeline",
+			pipelineId: notExistId,
+			want:       map[cache.SubKey]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lc := &Cache{
+				cleanupInterval:     cleanupInterval,
+				items:               preparedItemsMap,
+				pipelinesExpiration: preparedExpMap,
+			}
+			got, err := lc.ScanValues(context.Background(), tt.pipelineId)
+			if err != nil {
+				t.Errorf("ScanValues() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScanValues() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalCache_GetPipelines(t *testing.T) {
+	preparedId, _ := uuid.NewUUID()
+	expiredId, _ := uuid.NewUUID()
+	preparedItemsMap := make(map[uuid.UUID]map[cache.SubKey]interface{})
+	preparedItemsMap[preparedId] = map[cache.SubKey]interface{}{cache.Status: "TEST_STATUS"}
+	preparedItemsMap[expiredId] = map[cache.SubKey]interface{}{cache.Status: "TEST_STATUS"}
+	preparedExpMap := make(map[uuid.UUID]time.Time)
+	preparedExpMap[preparedId] = time.Now().Add(time.Minute)
+	preparedExpMap[expiredId] = time.Now().Add(-time.Minute)
+	lc := &Cache{
+		cleanupInterval:     cleanupInterval,
+		items:               preparedItemsMap,
+		pipelinesExpiration: preparedExpMap,
+	}
+	got, err := lc.GetPipelines(context.Background())
+	if err != nil {
+		t.Errorf("GetPipelines() error = %v", err)
+	}
+	if want := []uuid.UUID{preparedId}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetPipelines() got = %v, want %v", got, want)
+	}
+}
+
+func TestLocalCache_GetSubKeyCount(t *testing.T) {
+	preparedId, _ := uuid.NewUUID()
+	expiredId, _ := uuid.NewUUID()
+	preparedItemsMap := make(map[uuid.UUID]map[cache.SubKey]interface{})
+	preparedItemsMap[preparedId] = map[cache.SubKey]interface{}{cache.Status: "TEST_STATUS", cache.RunOutput: "TEST_OUTPUT"}
+	preparedItemsMap[expiredId] = map[cache.SubKey]interface{}{cache.Status: "TEST_STATUS"}
+	preparedExpMap := make(map[uuid.UUID]time.Time)
+	preparedExpMap[preparedId] = time.Now().Add(time.Minute)
+	preparedExpMap[expiredId] = time.Now().Add(-time.Minute)
+	type args struct {
+		ctx        context.Context
+		pipelineId uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Get count of exist pipeline",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: preparedId,
+			},
+			want:    2,
+			wantErr: false,
+		},
+		{
+			name: "Get count of expired pipeline",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: expiredId,
+			},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name: "Get count of not exist pipeline",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: uuid.New(),
+			},
+			want:    0,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lc := &Cache{
+				cleanupInterval:     cleanupInterval,
+				items:               preparedItemsMap,
+				pipelinesExpiration: preparedExpMap,
+			}
+			got, err := lc.GetSubKeyCount(tt.args.ctx, tt.args.pipelineId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSubKeyCount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetSubKeyCount() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestLocalCache_SetValue(t *testing.T) {
 	preparedId, _ := uuid.NewUUID()
 	preparedExpMap := make(map[uuid.UUID]time.Time)
@@ -177,6 +391,344 @@ func TestLocalCache_SetValue(t *testing.T) {
 	}
 }
 
+func TestLocalCache_SetValues(t *testing.T) {
+	preparedId, _ := uuid.NewUUID()
+	type args struct {
+		ctx        context.Context
+		pipelineId uuid.UUID
+		values     map[cache.SubKey]interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    map[cache.SubKey]interface{}
+		wantErr bool
+	}{
+		{
+			name: "Set values",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: preparedId,
+				values:     map[cache.SubKey]interface{}{cache.Status: "TEST_STATUS", cache.RunOutput: "TEST_OUTPUT", cache.LogsIndex: 1},
+			},
+			want:    map[cache.SubKey]interface{}{cache.Status: "TEST_STATUS", cache.RunOutput: "TEST_OUTPUT", cache.LogsIndex: float64(1)},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lc := &Cache{
+				cleanupInterval:     cleanupInterval,
+				items:               make(map[uuid.UUID]map[cache.SubKey]interface{}),
+				pipelinesExpiration: make(map[uuid.UUID]time.Time),
+			}
+			if err := lc.SetValues(tt.args.ctx, tt.args.pipelineId, tt.args.values); (err != nil) != tt.wantErr {
+				t.Errorf("SetValues() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(lc.items[tt.args.pipelineId], tt.want) {
+				t.Errorf("SetValues() got = %v, want %v", lc.items[tt.args.pipelineId], tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalCache_GetAge(t *testing.T) {
+	ctx := context.Background()
+	pipelineId := uuid.New()
+	currentTime := time.Now()
+	lc := &Cache{
+		items:               make(map[uuid.UUID]map[cache.SubKey]interface{}),
+		pipelinesExpiration: make(map[uuid.UUID]time.Time),
+		keyExpirationTime:   time.Hour,
+		now: func() time.Time {
+			return currentTime
+		},
+	}
+
+	if _, err := lc.GetAge(ctx, pipelineId); !errors.Is(err, cache.ErrNotFound) {
+		t.Errorf("GetAge() error = %v, want %v", err, cache.ErrNotFound)
+	}
+	if err := lc.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_VALIDATING); err != nil {
+		t.Fatalf("SetValue() error = %v", err)
+	}
+	currentTime = currentTime.Add(time.Minute)
+	// subsequent sets of the pipeline don't change the creation time
+	if err := lc.SetValues(ctx, pipelineId, map[cache.SubKey]interface{}{cache.Status: pb.Status_STATUS_FINISHED}); err != nil {
+		t.Fatalf("SetValues() error = %v", err)
+	}
+	currentTime = currentTime.Add(time.Minute * 2)
+	if got, err := lc.GetAge(ctx, pipelineId); err != nil || got != time.Minute*3 {
+		t.Errorf("GetAge() got = %v, %v, want %v", got, err, time.Minute*3)
+	}
+
+	currentTime = currentTime.Add(time.Hour)
+	if _, err := lc.GetAge(ctx, pipelineId); !errors.Is(err, cache.ErrNotFound) {
+		t.Errorf("GetAge() of expired pipeline error = %v, want %v", err, cache.ErrNotFound)
+	}
+}
+
+func TestLocalCache_Preload(t *testing.T) {
+	pipelineId1, pipelineId2 := uuid.New(), uuid.New()
+	currentTime := time.Now()
+	lc := &Cache{
+		items:               make(map[uuid.UUID]map[cache.SubKey]interface{}),
+		pipelinesExpiration: make(map[uuid.UUID]time.Time),
+		now: func() time.Time {
+			return currentTime
+		},
+	}
+	pipelines := map[uuid.UUID]map[cache.SubKey]interface{}{
+		pipelineId1: {cache.Status: pb.Status_STATUS_FINISHED, cache.RunOutput: "MOCK_OUTPUT"},
+		pipelineId2: {cache.Status: pb.Status_STATUS_RUN_ERROR, cache.RunError: "MOCK_ERROR"},
+	}
+	if err := lc.Preload(context.Background(), pipelines); err != nil {
+		t.Fatalf("Preload() error = %v", err)
+	}
+	if !reflect.DeepEqual(lc.items, pipelines) {
+		t.Errorf("Preload() items = %v, want %v", lc.items, pipelines)
+	}
+	for pipelineId := range pipelines {
+		if got := lc.pipelinesExpiration[pipelineId]; !got.Equal(currentTime.Add(preloadExpirationTime)) {
+			t.Errorf("Preload() expiration time of %s = %v, want %v", pipelineId, got, currentTime.Add(preloadExpirationTime))
+		}
+	}
+}
+
+func TestLocalCache_SetStatusIfNotTerminal(t *testing.T) {
+	tests := []struct {
+		name    string
+		current interface{}
+		status  pb.Status
+		want    bool
+		wantNow pb.Status
+	}{
+		{
+			name:    "pipeline doesn't exist",
+			current: nil,
+			status:  pb.Status_STATUS_VALIDATING,
+			want:    true,
+			wantNow: pb.Status_STATUS_VALIDATING,
+		},
+		{
+			name:    "not terminal status is changed",
+			current: pb.Status_STATUS_EXECUTING,
+			status:  pb.Status_STATUS_CANCELED,
+			want:    true,
+			wantNow: pb.Status_STATUS_CANCELED,
+		},
+		{
+			// Test case with late cancellation of the finished pipeline.
+			// As a result, want the finished status to be kept.
+			name:    "finished status is kept",
+			current: pb.Status_STATUS_FINISHED,
+			status:  pb.Status_STATUS_CANCELED,
+			want:    false,
+			wantNow: pb.Status_STATUS_FINISHED,
+		},
+		{
+			name:    "canceled status is kept",
+			current: pb.Status_STATUS_CANCELED,
+			status:  pb.Status_STATUS_RUN_TIMEOUT,
+			want:    false,
+			wantNow: pb.Status_STATUS_CANCELED,
+		},
+		{
+			name:    "error status is kept",
+			current: pb.Status_STATUS_ERROR,
+			status:  pb.Status_STATUS_FINISHED,
+			want:    false,
+			wantNow: pb.Status_STATUS_ERROR,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			lc := &Cache{
+				items:               make(map[uuid.UUID]map[cache.SubKey]interface{}),
+				pipelinesExpiration: make(map[uuid.UUID]time.Time),
+			}
+			if tt.current != nil {
+				lc.items[pipelineId] = map[cache.SubKey]interface{}{cache.Status: tt.current}
+			}
+			got, err := lc.SetStatusIfNotTerminal(context.Background(), pipelineId, tt.status)
+			if err != nil {
+				t.Errorf("SetStatusIfNotTerminal() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("SetStatusIfNotTerminal() got = %v, want %v", got, tt.want)
+			}
+			if now := lc.items[pipelineId][cache.Status]; now != tt.wantNow {
+				t.Errorf("SetStatusIfNotTerminal() status = %v, want %v", now, tt.wantNow)
+			}
+		})
+	}
+}
+
+func TestLocalCache_SubscribeStatus(t *testing.T) {
+	preparedId, _ := uuid.NewUUID()
+	ctx, cancel := context.WithCancel(context.Background())
+	lc := &Cache{
+		cleanupInterval:     cleanupInterval,
+		items:               make(map[uuid.UUID]map[cache.SubKey]interface{}),
+		pipelinesExpiration: make(map[uuid.UUID]time.Time),
+	}
+	statuses, err := lc.SubscribeStatus(ctx, preparedId)
+	if err != nil {
+		t.Fatalf("SubscribeStatus() error = %v", err)
+	}
+	_ = lc.SetValue(context.Background(), preparedId, cache.Status, pb.Status_STATUS_EXECUTING)
+	_ = lc.SetValues(context.Background(), preparedId, map[cache.SubKey]interface{}{cache.Status: pb.Status_STATUS_FINISHED})
+	_ = lc.SetValue(context.Background(), uuid.New(), cache.Status, pb.Status_STATUS_ERROR)
+	for _, want := range []pb.Status{pb.Status_STATUS_EXECUTING, pb.Status_STATUS_FINISHED} {
+		if got := <-statuses; got != want {
+			t.Errorf("SubscribeStatus() got = %v, want %v", got, want)
+		}
+	}
+	cancel()
+	select {
+	case _, ok := <-statuses:
+		if ok {
+			t.Error("SubscribeStatus() sent status, want closed channel")
+		}
+	case <-time.After(time.Second):
+		t.Error("SubscribeStatus() doesn't close channel after context is done")
+	}
+}
+
+func TestLocalCache_DeleteValue(t *testing.T) {
+	preparedId, _ := uuid.NewUUID()
+	preparedItemsMap := make(map[uuid.UUID]map[cache.SubKey]interface{})
+	preparedItemsMap[preparedId] = map[cache.SubKey]interface{}{cache.Status: "TEST_STATUS", cache.CompileOutput: "TEST_OUTPUT"}
+	type args struct {
+		ctx        context.Context
+		pipelineId uuid.UUID
+		subKey     cache.SubKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Delete exist value",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: preparedId,
+				subKey:     cache.CompileOutput,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Delete not exist value",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: preparedId,
+				subKey:     cache.Graph,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Delete value of not exist pipeline",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: uuid.New(),
+				subKey:     cache.CompileOutput,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lc := &Cache{
+				cleanupInterval:     cleanupInterval,
+				items:               preparedItemsMap,
+				pipelinesExpiration: make(map[uuid.UUID]time.Time),
+			}
+			if err := lc.DeleteValue(tt.args.ctx, tt.args.pipelineId, tt.args.subKey); (err != nil) != tt.wantErr {
+				t.Errorf("DeleteValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if _, found := lc.items[tt.args.pipelineId][tt.args.subKey]; found {
+				t.Errorf("Value with pipelineId: %s and subKey: %v has not been deleted.", tt.args.pipelineId, tt.args.subKey)
+			}
+		})
+	}
+	if _, found := preparedItemsMap[preparedId][cache.Status]; !found {
+		t.Errorf("Value with pipelineId: %s and subKey: %v shouldn't be deleted.", preparedId, cache.Status)
+	}
+}
+
+func TestLocalCache_FlushAll(t *testing.T) {
+	pipelineId1, pipelineId2 := uuid.New(), uuid.New()
+	lc := &Cache{
+		cleanupInterval: cleanupInterval,
+		items: map[uuid.UUID]map[cache.SubKey]interface{}{
+			pipelineId1: {cache.Status: pb.Status_STATUS_FINISHED},
+			pipelineId2: {cache.RunOutput: "TEST_OUTPUT"},
+		},
+		pipelinesExpiration: map[uuid.UUID]time.Time{pipelineId1: time.Now().Add(time.Minute)},
+	}
+	if err := lc.FlushAll(context.Background()); err != nil {
+		t.Errorf("FlushAll() error = %v", err)
+	}
+	if len(lc.items) != 0 || len(lc.pipelinesExpiration) != 0 {
+		t.Errorf("FlushAll() items = %v, pipelinesExpiration = %v, want empty", lc.items, lc.pipelinesExpiration)
+	}
+	if err := lc.SetValue(context.Background(), pipelineId1, cache.RunOutput, "TEST_OUTPUT"); err != nil {
+		t.Errorf("SetValue() after FlushAll() error = %v", err)
+	}
+}
+
+func TestLocalCache_DeletePipeline(t *testing.T) {
+	preparedId, _ := uuid.NewUUID()
+	preparedItemsMap := make(map[uuid.UUID]map[cache.SubKey]interface{})
+	preparedItemsMap[preparedId] = map[cache.SubKey]interface{}{cache.Status: "TEST_STATUS", cache.RunOutput: "TEST_OUTPUT"}
+	preparedExpMap := make(map[uuid.UUID]time.Time)
+	preparedExpMap[preparedId] = time.Now().Add(time.Minute)
+	type args struct {
+		ctx        context.Context
+		pipelineId uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Delete exist pipeline",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: preparedId,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Delete not exist pipeline",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: uuid.New(),
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lc := &Cache{
+				cleanupInterval:     cleanupInterval,
+				items:               preparedItemsMap,
+				pipelinesExpiration: preparedExpMap,
+			}
+			if err := lc.DeletePipeline(tt.args.ctx, tt.args.pipelineId); (err != nil) != tt.wantErr {
+				t.Errorf("DeletePipeline() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			_, itemsFound := lc.items[tt.args.pipelineId]
+			_, expFound := lc.pipelinesExpiration[tt.args.pipelineId]
+			if itemsFound || expFound {
+				t.Errorf("Pipeline: %s has not been deleted.", tt.args.pipelineId)
+			}
+		})
+	}
+}
+
 func TestLocalCache_SetExpTime(t *testing.T) {
 	preparedId, _ := uuid.NewUUID()
 	type fields struct {
@@ -228,8 +780,230 @@ func TestLocalCache_SetExpTime(t *testing.T) {
 	}
 }
 
+func TestLocalCache_TouchPipeline(t *testing.T) {
+	preparedId, _ := uuid.NewUUID()
+	expiredId, _ := uuid.NewUUID()
+	notExistId, _ := uuid.NewUUID()
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		pipelineId uuid.UUID
+		wantErr    bool
+	}{
+		{name: "Touch exist pipeline", pipelineId: preparedId, wantErr: false},
+		{name: "Touch expired pipeline", pipelineId: expiredId, wantErr: true},
+		{name: "Touch not exist pipeline", pipelineId: notExistId, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lc := &Cache{
+				cleanupInterval: cleanupInterval,
+				items: map[uuid.UUID]map[cache.SubKey]interface{}{
+					preparedId: {cache.Status: pb.Status_STATUS_EXECUTING},
+					expiredId:  {cache.Status: pb.Status_STATUS_EXECUTING},
+				},
+				pipelinesExpiration: map[uuid.UUID]time.Time{
+					preparedId: now.Add(time.Second),
+					expiredId:  now.Add(-time.Second),
+				},
+				keyExpirationTime: time.Minute,
+				now:               func() time.Time { return now },
+			}
+			err := lc.TouchPipeline(context.Background(), tt.pipelineId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TouchPipeline() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, cache.ErrNotFound) {
+					t.Errorf("TouchPipeline() error = %v, want %v", err, cache.ErrNotFound)
+				}
+				return
+			}
+			if expTime := lc.pipelinesExpiration[tt.pipelineId]; !expTime.Equal(now.Add(time.Minute)) {
+				t.Errorf("TouchPipeline() expiration time = %v, want %v", expTime, now.Add(time.Minute))
+			}
+			if value := lc.items[tt.pipelineId][cache.Status]; value != pb.Status_STATUS_EXECUTING {
+				t.Errorf("TouchPipeline() changed value to %v", value)
+			}
+		})
+	}
+}
+
+func TestLocalCache_HealthCheck(t *testing.T) {
+	lc := &Cache{
+		cleanupInterval:     cleanupInterval,
+		items:               make(map[uuid.UUID]map[cache.SubKey]interface{}),
+		pipelinesExpiration: make(map[uuid.UUID]time.Time),
+	}
+	if err := lc.HealthCheck(context.Background()); err != nil {
+		t.Errorf("HealthCheck() error = %v, want nil", err)
+	}
+}
+
+func TestLocalCache_GetValueWithTTL(t *testing.T) {
+	preparedId, endlessId := uuid.New(), uuid.New()
+	currentTime := time.Now()
+	lc := &Cache{
+		items: map[uuid.UUID]map[cache.SubKey]interface{}{
+			preparedId: {cache.RunOutput: "TEST_OUTPUT"},
+			endlessId:  {cache.RunOutput: "TEST_OUTPUT"},
+		},
+		pipelinesExpiration: map[uuid.UUID]time.Time{preparedId: currentTime.Add(time.Minute)},
+		now: func() time.Time {
+			return currentTime
+		},
+	}
+	tests := []struct {
+		name         string
+		pipelineId   uuid.UUID
+		want         interface{}
+		wantTTL      time.Duration
+		wantNotFound bool
+	}{
+		{
+			name:       "value with expiration time",
+			pipelineId: preparedId,
+			want:       "TEST_OUTPUT",
+			wantTTL:    time.Minute,
+		},
+		{
+			name:       "value without expiration time",
+			pipelineId: endlessId,
+			want:       "TEST_OUTPUT",
+			wantTTL:    cache.NoExpiration,
+		},
+		{
+			name:         "not exist value",
+			pipelineId:   uuid.New(),
+			want:         nil,
+			wantNotFound: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ttl, err := lc.GetValueWithTTL(context.Background(), tt.pipelineId, cache.RunOutput)
+			if errors.Is(err, cache.ErrNotFound) != tt.wantNotFound {
+				t.Errorf("GetValueWithTTL() error = %v, wantNotFound %v", err, tt.wantNotFound)
+			}
+			if got != tt.want || ttl != tt.wantTTL {
+				t.Errorf("GetValueWithTTL() got = %v, %s, want %v, %s", got, ttl, tt.want, tt.wantTTL)
+			}
+		})
+	}
+}
+
+func TestLocalCache_Expiration(t *testing.T) {
+	preparedId, _ := uuid.NewUUID()
+	currentTime := time.Now()
+	type args struct {
+		ctx        context.Context
+		pipelineId uuid.UUID
+		expTime    time.Duration
+		advance    time.Duration
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantExpired bool
+	}{
+		{
+			// Test case with advancing the clock less than default expiration time.
+			// As a result, want to receive the value from cache.
+			name: "default expiration time is not passed",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: preparedId,
+				advance:    time.Second * 30,
+			},
+			wantExpired: false,
+		},
+		{
+			// Test case with advancing the clock more than default expiration time.
+			// As a result, want to receive an error and the pipeline to be evicted.
+			name: "default expiration time is passed",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: preparedId,
+				advance:    time.Minute * 2,
+			},
+			wantExpired: true,
+		},
+		{
+			// Test case with advancing the clock more than default expiration time after SetExpTime prolongs it.
+			// As a result, want to receive the value from cache.
+			name: "expiration time is prolonged by SetExpTime",
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: preparedId,
+				expTime:    time.Minute * 5,
+				advance:    time.Minute * 2,
+			},
+			wantExpired: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := currentTime
+			lc := &Cache{
+				cleanupInterval:     cleanupInterval,
+				items:               make(map[uuid.UUID]map[cache.SubKey]interface{}),
+				pipelinesExpiration: make(map[uuid.UUID]time.Time),
+				keyExpirationTime:   time.Minute,
+				now:                 func() time.Time { return now },
+			}
+			if err := lc.SetValue(tt.args.ctx, tt.args.pipelineId, cache.RunOutput, "TEST_VALUE"); err != nil {
+				t.Error(err)
+			}
+			if tt.args.expTime != 0 {
+				if err := lc.SetExpTime(tt.args.ctx, tt.args.pipelineId, tt.args.expTime); err != nil {
+					t.Error(err)
+				}
+			}
+			now = now.Add(tt.args.advance)
+			if _, err := lc.GetValue(tt.args.ctx, tt.args.pipelineId, cache.RunOutput); (err != nil) != tt.wantExpired {
+				t.Errorf("GetValue() error = %v, wantExpired %v", err, tt.wantExpired)
+			}
+			lc.clearItems(lc.expiredPipelines())
+			if _, found := lc.items[tt.args.pipelineId]; found == tt.wantExpired {
+				t.Errorf("Pipeline: %s found in cache = %v, wantExpired %v", tt.args.pipelineId, found, tt.wantExpired)
+			}
+		})
+	}
+}
+
+func TestLocalCache_ConcurrentAccess(t *testing.T) {
+	preparedId, _ := uuid.NewUUID()
+	ctx := context.Background()
+	lc := &Cache{
+		cleanupInterval:     cleanupInterval,
+		items:               make(map[uuid.UUID]map[cache.SubKey]interface{}),
+		pipelinesExpiration: make(map[uuid.UUID]time.Time),
+	}
+	numOfGoroutines := 50
+	wg := sync.WaitGroup{}
+	wg.Add(numOfGoroutines)
+	for i := 0; i < numOfGoroutines; i++ {
+		go func(i int) {
+			defer wg.Done()
+			_ = lc.SetValue(ctx, preparedId, cache.RunOutput, fmt.Sprintf("TEST_VALUE_%d", i))
+			_, _ = lc.GetValue(ctx, preparedId, cache.RunOutput)
+			_, _ = lc.GetValues(ctx, preparedId, []cache.SubKey{cache.RunOutput, cache.Status})
+			_, _ = lc.GetSubKeyCount(ctx, preparedId)
+			_ = lc.SetExpTime(ctx, preparedId, time.Minute)
+			_ = lc.DeleteValue(ctx, preparedId, cache.Status)
+			if i%10 == 0 {
+				_ = lc.DeletePipeline(ctx, preparedId)
+			}
+			lc.clearItems(lc.expiredPipelines())
+		}(i)
+	}
+	wg.Wait()
+}
+
 func TestLocalCache_startGC(t *testing.T) {
-	defer goleak.VerifyNone(t)
+	// the worker of opencensus is started by dependencies of the logger of snapshots
+	defer goleak.VerifyNone(t, goleak.IgnoreTopFunction("go.opencensus.io/stats/view.(*worker).start"))
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -268,7 +1042,10 @@ func TestLocalCache_startGC(t *testing.T) {
 			}
 			go lc.startGC(ctx)
 			time.Sleep(time.Millisecond)
-			if len(tt.fields.items) != 0 {
+			lc.RLock()
+			numOfItems := len(tt.fields.items)
+			lc.RUnlock()
+			if numOfItems != 0 {
 				t.Errorf("Pipeline: %s not deleted in time.", preparedId)
 			}
 		})
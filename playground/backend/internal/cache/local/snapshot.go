@@ -0,0 +1,169 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/logger"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// snapshot is the state of the cache which is kept on disk
+type snapshot struct {
+	Pipelines map[uuid.UUID]pipelineSnapshot `json:"pipelines"`
+}
+
+// pipelineSnapshot is the state of one pipeline which is kept on disk.
+// []byte values are kept in Bytes since they can't be restored from JSON values without knowing their type.
+type pipelineSnapshot struct {
+	Values    map[cache.SubKey]json.RawMessage `json:"values"`
+	Bytes     map[cache.SubKey][]byte          `json:"bytes,omitempty"`
+	ExpiresAt *time.Time                       `json:"expires_at,omitempty"`
+	CreatedAt *time.Time                       `json:"created_at,omitempty"`
+}
+
+// NewWithSnapshot returns local cache which is loaded from the snapshot file by path
+// and saved to it each snapshotInterval, when ctx is done and when the cache is closed.
+// If the snapshot file doesn't exist, the cache is empty.
+func NewWithSnapshot(ctx context.Context, path string, snapshotInterval time.Duration) (*Cache, error) {
+	lc := New(ctx)
+	lc.snapshotPath = path
+	if err := lc.loadSnapshot(path); err != nil {
+		return nil, err
+	}
+	go lc.startSnapshots(ctx, path, snapshotInterval)
+	return lc, nil
+}
+
+// startSnapshots saves snapshot of the cache to the file by path each interval and when ctx is done
+func (lc *Cache) startSnapshots(ctx context.Context, path string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := lc.saveSnapshot(path); err != nil {
+				logger.Errorf("Local Cache: save snapshot: error during saving to %s: %s\n", path, err.Error())
+			}
+			return
+		case <-ticker.C:
+			if err := lc.saveSnapshot(path); err != nil {
+				logger.Errorf("Local Cache: save snapshot: error during saving to %s: %s\n", path, err.Error())
+			}
+		}
+	}
+}
+
+// saveSnapshot writes all not expired pipelines to the file by path.
+// The snapshot is written to a temporary file first so the previous snapshot isn't corrupted if writing fails.
+func (lc *Cache) saveSnapshot(path string) error {
+	lc.snapshotMu.Lock()
+	defer lc.snapshotMu.Unlock()
+
+	lc.RLock()
+	s := snapshot{Pipelines: make(map[uuid.UUID]pipelineSnapshot, len(lc.items))}
+	for pipelineId, values := range lc.items {
+		p := pipelineSnapshot{Values: make(map[cache.SubKey]json.RawMessage, len(values))}
+		if expTime, found := lc.pipelinesExpiration[pipelineId]; found {
+			if expTime.Before(lc.currentTime()) {
+				continue
+			}
+			p.ExpiresAt = &expTime
+		}
+		if createdAt, found := lc.pipelinesCreation[pipelineId]; found {
+			p.CreatedAt = &createdAt
+		}
+		for subKey, value := range values {
+			if raw, ok := value.([]byte); ok {
+				if p.Bytes == nil {
+					p.Bytes = make(map[cache.SubKey][]byte)
+				}
+				p.Bytes[subKey] = raw
+				continue
+			}
+			valueMarsh, err := json.Marshal(value)
+			if err != nil {
+				lc.RUnlock()
+				return fmt.Errorf("error during marshal value of pipeline %s and subKey %s: %w", pipelineId, subKey, err)
+			}
+			p.Values[subKey] = valueMarsh
+		}
+		s.Pipelines[pipelineId] = p
+	}
+	lc.RUnlock()
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	tmpPath := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// loadSnapshot puts all not expired pipelines from the file by path to the cache.
+// If the file doesn't exist, loadSnapshot does nothing.
+func (lc *Cache) loadSnapshot(path string) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s := snapshot{}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("error during unmarshal snapshot %s: %w", path, err)
+	}
+
+	lc.Lock()
+	defer lc.Unlock()
+	for pipelineId, p := range s.Pipelines {
+		if p.ExpiresAt != nil && p.ExpiresAt.Before(lc.currentTime()) {
+			continue
+		}
+		values := make(map[cache.SubKey]interface{}, len(p.Values)+len(p.Bytes))
+		for subKey, valueMarsh := range p.Values {
+			value, err := cache.Decode(subKey, string(valueMarsh))
+			if err != nil {
+				return fmt.Errorf("error during unmarshal value of pipeline %s and subKey %s: %w", pipelineId, subKey, err)
+			}
+			values[subKey] = value
+		}
+		for subKey, raw := range p.Bytes {
+			values[subKey] = raw
+		}
+		lc.items[pipelineId] = values
+		if p.ExpiresAt != nil {
+			lc.pipelinesExpiration[pipelineId] = *p.ExpiresAt
+		}
+		if p.CreatedAt != nil {
+			if lc.pipelinesCreation == nil {
+				lc.pipelinesCreation = make(map[uuid.UUID]time.Time)
+			}
+			lc.pipelinesCreation[pipelineId] = *p.CreatedAt
+		}
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLocalCache_snapshotRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	pipelineId, expiredId, endlessId := uuid.New(), uuid.New(), uuid.New()
+	expTime := time.Now().Add(time.Minute).Round(time.Millisecond)
+	values := map[cache.SubKey]interface{}{
+		cache.Status:         pb.Status_STATUS_FINISHED,
+		cache.RunOutput:      "MOCK_OUTPUT",
+		cache.RunOutputIndex: float64(11),
+		cache.Canceled:       false,
+		cache.RunResult:      cache.ExecutionResult{ExitCode: 0, Duration: time.Second, PeakMemory: 1024},
+		cache.Graph:          []byte{0x00, 'P', 'N', 'G', 0xff, 0x80, 0x00},
+	}
+	saved := &Cache{
+		items: map[uuid.UUID]map[cache.SubKey]interface{}{
+			pipelineId: values,
+			expiredId:  {cache.Status: pb.Status_STATUS_FINISHED},
+			endlessId:  {cache.Logs: "MOCK_LOGS"},
+		},
+		pipelinesExpiration: map[uuid.UUID]time.Time{
+			pipelineId: expTime,
+			expiredId:  time.Now().Add(-time.Minute),
+		},
+	}
+	if err := saved.saveSnapshot(path); err != nil {
+		t.Fatalf("saveSnapshot() error = %v", err)
+	}
+
+	loaded := &Cache{
+		items:               make(map[uuid.UUID]map[cache.SubKey]interface{}),
+		pipelinesExpiration: make(map[uuid.UUID]time.Time),
+	}
+	if err := loaded.loadSnapshot(path); err != nil {
+		t.Fatalf("loadSnapshot() error = %v", err)
+	}
+	wantItems := map[uuid.UUID]map[cache.SubKey]interface{}{
+		pipelineId: values,
+		endlessId:  {cache.Logs: "MOCK_LOGS"},
+	}
+	if !reflect.DeepEqual(loaded.items, wantItems) {
+		t.Errorf("loadSnapshot() items = %v, want %v", loaded.items, wantItems)
+	}
+	if got := loaded.pipelinesExpiration[pipelineId]; !got.Equal(expTime) {
+		t.Errorf("loadSnapshot() expiration time = %v, want %v", got, expTime)
+	}
+	if _, found := loaded.pipelinesExpiration[endlessId]; found {
+		t.Errorf("loadSnapshot() expiration time of pipeline %s is set, want not set", endlessId)
+	}
+}
+
+func TestLocalCache_loadSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	pipelineId, expiredId := uuid.New(), uuid.New()
+	expiredTime := time.Now().Add(-time.Minute)
+	statusMarsh, _ := json.Marshal(pb.Status_STATUS_EXECUTING)
+	data, _ := json.Marshal(snapshot{Pipelines: map[uuid.UUID]pipelineSnapshot{
+		pipelineId: {Values: map[cache.SubKey]json.RawMessage{cache.Status: statusMarsh}},
+		expiredId:  {Values: map[cache.SubKey]json.RawMessage{cache.Status: statusMarsh}, ExpiresAt: &expiredTime},
+	}})
+	snapshotPath := filepath.Join(dir, "cache.json")
+	if err := os.WriteFile(snapshotPath, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	invalidPath := filepath.Join(dir, "invalid.json")
+	if err := os.WriteFile(invalidPath, []byte("MOCK_INVALID_JSON"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		path      string
+		wantItems map[uuid.UUID]map[cache.SubKey]interface{}
+		wantErr   bool
+	}{
+		{
+			// Test case with loading snapshot which contains expired pipeline.
+			// As a result, want to receive only not expired pipeline.
+			name:      "expired pipeline is dropped",
+			path:      snapshotPath,
+			wantItems: map[uuid.UUID]map[cache.SubKey]interface{}{pipelineId: {cache.Status: pb.Status_STATUS_EXECUTING}},
+			wantErr:   false,
+		},
+		{
+			// Test case with loading snapshot which doesn't exist.
+			// As a result, want to receive empty cache without error.
+			name:      "snapshot doesn't exist",
+			path:      filepath.Join(dir, "not_exist.json"),
+			wantItems: map[uuid.UUID]map[cache.SubKey]interface{}{},
+			wantErr:   false,
+		},
+		{
+			// Test case with loading snapshot with incorrect content.
+			// As a result, want to receive an error.
+			name:      "invalid snapshot",
+			path:      invalidPath,
+			wantItems: map[uuid.UUID]map[cache.SubKey]interface{}{},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lc := &Cache{
+				items:               make(map[uuid.UUID]map[cache.SubKey]interface{}),
+				pipelinesExpiration: make(map[uuid.UUID]time.Time),
+			}
+			if err := lc.loadSnapshot(tt.path); (err != nil) != tt.wantErr {
+				t.Errorf("loadSnapshot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(lc.items, tt.wantItems) {
+				t.Errorf("loadSnapshot() items = %v, want %v", lc.items, tt.wantItems)
+			}
+		})
+	}
+}
+
+func TestNewWithSnapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	pipelineId := uuid.New()
+	ctx, cancel := context.WithCancel(context.Background())
+	lc, err := NewWithSnapshot(ctx, path, time.Hour)
+	if err != nil {
+		t.Fatalf("NewWithSnapshot() error = %v", err)
+	}
+	_ = lc.SetValue(ctx, pipelineId, cache.RunOutput, "MOCK_OUTPUT")
+	cancel()
+
+	// the snapshot is saved when ctx is done
+	var restored *Cache
+	for i := 0; i < 100; i++ {
+		time.Sleep(time.Millisecond * 10)
+		restoredCtx, restoredCancel := context.WithCancel(context.Background())
+		restored, err = NewWithSnapshot(restoredCtx, path, time.Hour)
+		restoredCancel()
+		if err == nil && len(restored.items) != 0 {
+			break
+		}
+	}
+	got, err := restored.GetValue(context.Background(), pipelineId, cache.RunOutput)
+	if err != nil || got != "MOCK_OUTPUT" {
+		t.Errorf("NewWithSnapshot() restored value = %v, err = %v, want %v", got, err, "MOCK_OUTPUT")
+	}
+}
+
+func TestLocalCache_CloseSavesSnapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	pipelineId := uuid.New()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	lc, err := NewWithSnapshot(ctx, path, time.Hour)
+	if err != nil {
+		t.Fatalf("NewWithSnapshot() error = %v", err)
+	}
+	_ = lc.SetValue(ctx, pipelineId, cache.RunOutput, "MOCK_OUTPUT")
+	if err := lc.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// the snapshot is saved before Close returns, so the restarted server restores the value
+	restored, err := NewWithSnapshot(ctx, path, time.Hour)
+	if err != nil {
+		t.Fatalf("NewWithSnapshot() error = %v", err)
+	}
+	got, err := restored.GetValue(ctx, pipelineId, cache.RunOutput)
+	if err != nil || got != "MOCK_OUTPUT" {
+		t.Errorf("Close() restored value = %v, err = %v, want %v", got, err, "MOCK_OUTPUT")
+	}
+}
@@ -0,0 +1,207 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lru
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"container/list"
+	"context"
+	"github.com/google/uuid"
+	"sync"
+	"time"
+)
+
+// outputSubKeys are subKeys which don't change after the pipeline is finished
+var outputSubKeys = []cache.SubKey{cache.CompileOutput, cache.RunOutput}
+
+// entryKey is the key of the value in LRU
+type entryKey struct {
+	pipelineId uuid.UUID
+	subKey     cache.SubKey
+}
+
+// entry is the value kept in LRU
+type entry struct {
+	key       entryKey
+	value     interface{}
+	expiresAt time.Time
+}
+
+// Cache is read-through LRU layer in front of another Cache.
+// It keeps values which don't change anymore: Status equals to STATUS_FINISHED,
+// and CompileOutput/RunOutput of the pipeline which is known to be finished.
+// All other operations are delegated to the wrapped Cache.
+type Cache struct {
+	cache.Cache
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	entries map[entryKey]*list.Element
+	order   *list.List
+}
+
+// New returns LRU layer in front of received Cache which keeps up to size values for ttl each
+func New(wrapped cache.Cache, size int, ttl time.Duration) *Cache {
+	return &Cache{
+		Cache:   wrapped,
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[entryKey]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// GetValue returns value from LRU if it is kept there, otherwise from the wrapped Cache
+func (lc *Cache) GetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
+	key := entryKey{pipelineId: pipelineId, subKey: subKey}
+	if value, found := lc.get(key); found {
+		return value, nil
+	}
+	value, err := lc.Cache.GetValue(ctx, pipelineId, subKey)
+	if err != nil {
+		return nil, err
+	}
+	if lc.isCacheable(key, value) {
+		lc.add(key, value)
+	}
+	return value, nil
+}
+
+// SetValue adds value to the wrapped Cache and removes the previous value from LRU
+func (lc *Cache) SetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) error {
+	lc.remove(entryKey{pipelineId: pipelineId, subKey: subKey})
+	return lc.Cache.SetValue(ctx, pipelineId, subKey, value)
+}
+
+// SetValues adds values to the wrapped Cache and removes the previous values from LRU
+func (lc *Cache) SetValues(ctx context.Context, pipelineId uuid.UUID, values map[cache.SubKey]interface{}) error {
+	for subKey := range values {
+		lc.remove(entryKey{pipelineId: pipelineId, subKey: subKey})
+	}
+	return lc.Cache.SetValues(ctx, pipelineId, values)
+}
+
+// DeleteValue removes value from LRU and the wrapped Cache
+func (lc *Cache) DeleteValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) error {
+	lc.remove(entryKey{pipelineId: pipelineId, subKey: subKey})
+	return lc.Cache.DeleteValue(ctx, pipelineId, subKey)
+}
+
+// DeletePipeline removes all values of the pipeline from LRU and the wrapped Cache
+func (lc *Cache) DeletePipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	lc.removePipeline(pipelineId)
+	return lc.Cache.DeletePipeline(ctx, pipelineId)
+}
+
+// Preload removes values of the pipelines from LRU and sets them to the wrapped Cache
+func (lc *Cache) Preload(ctx context.Context, pipelines map[uuid.UUID]map[cache.SubKey]interface{}) error {
+	for pipelineId := range pipelines {
+		lc.removePipeline(pipelineId)
+	}
+	return lc.Cache.Preload(ctx, pipelines)
+}
+
+// FlushAll removes all values from LRU and all pipelines from the wrapped Cache
+func (lc *Cache) FlushAll(ctx context.Context) error {
+	lc.mu.Lock()
+	lc.entries = make(map[entryKey]*list.Element)
+	lc.order.Init()
+	lc.mu.Unlock()
+	return lc.Cache.FlushAll(ctx)
+}
+
+// Close removes all values from LRU and closes the wrapped Cache
+func (lc *Cache) Close() error {
+	lc.mu.Lock()
+	lc.entries = make(map[entryKey]*list.Element)
+	lc.order.Init()
+	lc.mu.Unlock()
+	return lc.Cache.Close()
+}
+
+// isCacheable checks that the value doesn't change anymore and can be kept in LRU
+func (lc *Cache) isCacheable(key entryKey, value interface{}) bool {
+	if key.subKey == cache.Status {
+		return value == pb.Status_STATUS_FINISHED
+	}
+	for _, subKey := range outputSubKeys {
+		if key.subKey == subKey {
+			// the output can be changed until the pipeline is finished
+			_, finished := lc.get(entryKey{pipelineId: key.pipelineId, subKey: cache.Status})
+			return finished
+		}
+	}
+	return false
+}
+
+// get returns value from LRU. If value is expired, get removes it.
+func (lc *Cache) get(key entryKey) (interface{}, bool) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	element, found := lc.entries[key]
+	if !found {
+		return nil, false
+	}
+	e := element.Value.(*entry)
+	if e.expiresAt.Before(time.Now()) {
+		lc.order.Remove(element)
+		delete(lc.entries, key)
+		return nil, false
+	}
+	lc.order.MoveToFront(element)
+	return e.value, true
+}
+
+// add puts value to LRU. If LRU is full, add removes the least recently used value.
+func (lc *Cache) add(key entryKey, value interface{}) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	if lc.size <= 0 {
+		return
+	}
+	if element, found := lc.entries[key]; found {
+		lc.order.Remove(element)
+		delete(lc.entries, key)
+	}
+	for lc.order.Len() >= lc.size {
+		oldest := lc.order.Back()
+		lc.order.Remove(oldest)
+		delete(lc.entries, oldest.Value.(*entry).key)
+	}
+	lc.entries[key] = lc.order.PushFront(&entry{key: key, value: value, expiresAt: time.Now().Add(lc.ttl)})
+}
+
+// remove removes value from LRU
+func (lc *Cache) remove(key entryKey) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	if element, found := lc.entries[key]; found {
+		lc.order.Remove(element)
+		delete(lc.entries, key)
+	}
+}
+
+// removePipeline removes all values of the pipeline from LRU
+func (lc *Cache) removePipeline(pipelineId uuid.UUID) {
+	lc.remove(entryKey{pipelineId: pipelineId, subKey: cache.Status})
+	for _, subKey := range outputSubKeys {
+		lc.remove(entryKey{pipelineId: pipelineId, subKey: subKey})
+	}
+}
@@ -0,0 +1,239 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lru
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/local"
+	"context"
+	"github.com/google/uuid"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// countingCache counts GetValue calls which reach the wrapped Cache
+type countingCache struct {
+	*local.Cache
+	getValueCalls int
+}
+
+func (c *countingCache) GetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
+	c.getValueCalls++
+	return c.Cache.GetValue(ctx, pipelineId, subKey)
+}
+
+func TestCache_GetValue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pipelineId := uuid.New()
+
+	type args struct {
+		subKey cache.SubKey
+		values map[cache.SubKey]interface{}
+		ttl    time.Duration
+		pause  time.Duration
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      interface{}
+		wantCalls int
+	}{
+		{
+			// Test case with reading finished status twice.
+			// As a result, want the second read to be served by LRU.
+			name: "finished status is served by LRU",
+			args: args{
+				subKey: cache.Status,
+				values: map[cache.SubKey]interface{}{cache.Status: pb.Status_STATUS_FINISHED},
+				ttl:    time.Minute,
+			},
+			want:      pb.Status_STATUS_FINISHED,
+			wantCalls: 1,
+		},
+		{
+			// Test case with reading executing status twice.
+			// As a result, want both reads to reach the wrapped cache.
+			name: "executing status is not served by LRU",
+			args: args{
+				subKey: cache.Status,
+				values: map[cache.SubKey]interface{}{cache.Status: pb.Status_STATUS_EXECUTING},
+				ttl:    time.Minute,
+			},
+			want:      pb.Status_STATUS_EXECUTING,
+			wantCalls: 2,
+		},
+		{
+			// Test case with reading finished status twice after ttl is passed.
+			// As a result, want both reads to reach the wrapped cache.
+			name: "expired value is not served by LRU",
+			args: args{
+				subKey: cache.Status,
+				values: map[cache.SubKey]interface{}{cache.Status: pb.Status_STATUS_FINISHED},
+				ttl:    time.Millisecond,
+				pause:  time.Millisecond * 5,
+			},
+			want:      pb.Status_STATUS_FINISHED,
+			wantCalls: 2,
+		},
+		{
+			// Test case with reading run output of the pipeline which status is not known to be finished.
+			// As a result, want both reads to reach the wrapped cache.
+			name: "run output of not finished pipeline is not served by LRU",
+			args: args{
+				subKey: cache.RunOutput,
+				values: map[cache.SubKey]interface{}{cache.Status: pb.Status_STATUS_FINISHED, cache.RunOutput: "MOCK_OUTPUT"},
+				ttl:    time.Minute,
+			},
+			want:      "MOCK_OUTPUT",
+			wantCalls: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapped := &countingCache{Cache: local.New(ctx)}
+			lc := New(wrapped, 10, tt.args.ttl)
+			if err := wrapped.SetValues(ctx, pipelineId, tt.args.values); err != nil {
+				t.Fatalf("SetValues() error = %v", err)
+			}
+			for i := 0; i < 2; i++ {
+				got, err := lc.GetValue(ctx, pipelineId, tt.args.subKey)
+				if err != nil {
+					t.Fatalf("GetValue() error = %v", err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("GetValue() got = %v, want %v", got, tt.want)
+				}
+				time.Sleep(tt.args.pause)
+			}
+			if wrapped.getValueCalls != tt.wantCalls {
+				t.Errorf("GetValue() calls of wrapped cache = %d, want %d", wrapped.getValueCalls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCache_OutputOfFinishedPipeline(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pipelineId := uuid.New()
+	wrapped := &countingCache{Cache: local.New(ctx)}
+	lc := New(wrapped, 10, time.Minute)
+	_ = wrapped.SetValues(ctx, pipelineId, map[cache.SubKey]interface{}{cache.Status: pb.Status_STATUS_FINISHED, cache.RunOutput: "MOCK_OUTPUT"})
+
+	_, _ = lc.GetValue(ctx, pipelineId, cache.Status)
+	_, _ = lc.GetValue(ctx, pipelineId, cache.RunOutput)
+	got, _ := lc.GetValue(ctx, pipelineId, cache.RunOutput)
+	if got != "MOCK_OUTPUT" {
+		t.Errorf("GetValue() got = %v, want %v", got, "MOCK_OUTPUT")
+	}
+	if wrapped.getValueCalls != 2 {
+		t.Errorf("GetValue() calls of wrapped cache = %d, want %d", wrapped.getValueCalls, 2)
+	}
+}
+
+func TestCache_Invalidation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pipelineId := uuid.New()
+	tests := []struct {
+		name       string
+		invalidate func(lc *Cache) error
+		want       interface{}
+		wantErr    bool
+	}{
+		{
+			name: "SetValue invalidates value",
+			invalidate: func(lc *Cache) error {
+				return lc.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_CANCELED)
+			},
+			want: pb.Status_STATUS_CANCELED,
+		},
+		{
+			name: "SetValues invalidates value",
+			invalidate: func(lc *Cache) error {
+				return lc.SetValues(ctx, pipelineId, map[cache.SubKey]interface{}{cache.Status: pb.Status_STATUS_CANCELED})
+			},
+			want: pb.Status_STATUS_CANCELED,
+		},
+		{
+			name: "DeleteValue invalidates value",
+			invalidate: func(lc *Cache) error {
+				return lc.DeleteValue(ctx, pipelineId, cache.Status)
+			},
+			wantErr: true,
+		},
+		{
+			name: "DeletePipeline invalidates value",
+			invalidate: func(lc *Cache) error {
+				return lc.DeletePipeline(ctx, pipelineId)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Preload invalidates value",
+			invalidate: func(lc *Cache) error {
+				return lc.Preload(ctx, map[uuid.UUID]map[cache.SubKey]interface{}{
+					pipelineId: {cache.Status: pb.Status_STATUS_CANCELED},
+				})
+			},
+			want: pb.Status_STATUS_CANCELED,
+		},
+		{
+			name: "FlushAll invalidates value",
+			invalidate: func(lc *Cache) error {
+				return lc.FlushAll(ctx)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lc := New(local.New(ctx), 10, time.Minute)
+			_ = lc.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_FINISHED)
+			_, _ = lc.GetValue(ctx, pipelineId, cache.Status)
+			if err := tt.invalidate(lc); err != nil {
+				t.Fatalf("invalidate error = %v", err)
+			}
+			got, err := lc.GetValue(ctx, pipelineId, cache.Status)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("GetValue() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCache_Eviction(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	wrapped := &countingCache{Cache: local.New(ctx)}
+	lc := New(wrapped, 1, time.Minute)
+	first, second := uuid.New(), uuid.New()
+	_ = wrapped.SetValue(ctx, first, cache.Status, pb.Status_STATUS_FINISHED)
+	_ = wrapped.SetValue(ctx, second, cache.Status, pb.Status_STATUS_FINISHED)
+
+	_, _ = lc.GetValue(ctx, first, cache.Status)
+	_, _ = lc.GetValue(ctx, second, cache.Status)
+	_, _ = lc.GetValue(ctx, first, cache.Status)
+	if wrapped.getValueCalls != 3 {
+		t.Errorf("GetValue() calls of wrapped cache = %d, want %d", wrapped.getValueCalls, 3)
+	}
+}
@@ -0,0 +1,183 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memo
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"context"
+	"github.com/google/uuid"
+	"sync"
+)
+
+type contextKey struct{}
+
+// valueKey is the key of the value in the request's memo
+type valueKey struct {
+	pipelineId uuid.UUID
+	subKey     cache.SubKey
+}
+
+// memo keeps values which are read during one request
+type memo struct {
+	mu     sync.Mutex
+	values map[valueKey]interface{}
+}
+
+// NewContext returns a copy of ctx which carries an empty memo of the request and the function which clears the memo.
+// The function must be called when the request ends. After that the memo isn't used anymore.
+func NewContext(ctx context.Context) (context.Context, func()) {
+	m := &memo{values: make(map[valueKey]interface{})}
+	return context.WithValue(ctx, contextKey{}, m), func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.values = nil
+	}
+}
+
+// fromContext returns the memo of the request or nil if ctx doesn't carry it
+func fromContext(ctx context.Context) *memo {
+	m, _ := ctx.Value(contextKey{}).(*memo)
+	return m
+}
+
+func (m *memo) get(key valueKey) (interface{}, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, found := m.values[key]
+	return value, found
+}
+
+func (m *memo) set(key valueKey, value interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.values != nil {
+		m.values[key] = value
+	}
+}
+
+// remove removes values of the pipeline with received subKeys or all values of the pipeline if subKeys are empty
+func (m *memo) remove(pipelineId uuid.UUID, subKeys ...cache.SubKey) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(subKeys) != 0 {
+		for _, subKey := range subKeys {
+			delete(m.values, valueKey{pipelineId: pipelineId, subKey: subKey})
+		}
+		return
+	}
+	for key := range m.values {
+		if key.pipelineId == pipelineId {
+			delete(m.values, key)
+		}
+	}
+}
+
+// Cache is request-scoped memoization layer in front of another Cache.
+// If ctx of GetValue carries the memo of the request (see NewContext), repeated reads of the same pipelineId
+// and subKey within the request return the value from the memo instead of the wrapped Cache.
+// Values which are changed through Cache within the request are removed from the memo.
+// Without the memo all operations are delegated to the wrapped Cache.
+type Cache struct {
+	cache.Cache
+}
+
+// New returns request-scoped memoization layer in front of received Cache
+func New(wrapped cache.Cache) *Cache {
+	return &Cache{Cache: wrapped}
+}
+
+// GetValue returns value from the memo of the request if it is kept there, otherwise from the wrapped Cache
+func (mc *Cache) GetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
+	m := fromContext(ctx)
+	if m == nil {
+		return mc.Cache.GetValue(ctx, pipelineId, subKey)
+	}
+	key := valueKey{pipelineId: pipelineId, subKey: subKey}
+	if value, found := m.get(key); found {
+		return value, nil
+	}
+	value, err := mc.Cache.GetValue(ctx, pipelineId, subKey)
+	if err != nil {
+		return nil, err
+	}
+	m.set(key, value)
+	return value, nil
+}
+
+// SetValue adds value to the wrapped Cache and removes the previous value from the memo of the request
+func (mc *Cache) SetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) error {
+	if m := fromContext(ctx); m != nil {
+		m.remove(pipelineId, subKey)
+	}
+	return mc.Cache.SetValue(ctx, pipelineId, subKey, value)
+}
+
+// SetValues adds values to the wrapped Cache and removes the previous values from the memo of the request
+func (mc *Cache) SetValues(ctx context.Context, pipelineId uuid.UUID, values map[cache.SubKey]interface{}) error {
+	if m := fromContext(ctx); m != nil {
+		for subKey := range values {
+			m.remove(pipelineId, subKey)
+		}
+	}
+	return mc.Cache.SetValues(ctx, pipelineId, values)
+}
+
+// Preload removes values of the pipelines from the memo of the request and sets them to the wrapped Cache
+func (mc *Cache) Preload(ctx context.Context, pipelines map[uuid.UUID]map[cache.SubKey]interface{}) error {
+	if m := fromContext(ctx); m != nil {
+		for pipelineId := range pipelines {
+			m.remove(pipelineId)
+		}
+	}
+	return mc.Cache.Preload(ctx, pipelines)
+}
+
+// SetStatusIfNotTerminal removes the status from the memo of the request and sets it to the wrapped Cache
+func (mc *Cache) SetStatusIfNotTerminal(ctx context.Context, pipelineId uuid.UUID, status pb.Status) (bool, error) {
+	if m := fromContext(ctx); m != nil {
+		m.remove(pipelineId, cache.Status)
+	}
+	return mc.Cache.SetStatusIfNotTerminal(ctx, pipelineId, status)
+}
+
+// DeleteValue removes value from the memo of the request and the wrapped Cache
+func (mc *Cache) DeleteValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) error {
+	if m := fromContext(ctx); m != nil {
+		m.remove(pipelineId, subKey)
+	}
+	return mc.Cache.DeleteValue(ctx, pipelineId, subKey)
+}
+
+// DeletePipeline removes all values of the pipeline from the memo of the request and the wrapped Cache
+func (mc *Cache) DeletePipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	if m := fromContext(ctx); m != nil {
+		m.remove(pipelineId)
+	}
+	return mc.Cache.DeletePipeline(ctx, pipelineId)
+}
+
+// FlushAll removes all values from the memo of the request and all pipelines from the wrapped Cache
+func (mc *Cache) FlushAll(ctx context.Context) error {
+	if m := fromContext(ctx); m != nil {
+		m.mu.Lock()
+		if m.values != nil {
+			m.values = make(map[valueKey]interface{})
+		}
+		m.mu.Unlock()
+	}
+	return mc.Cache.FlushAll(ctx)
+}
@@ -0,0 +1,151 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memo
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/local"
+	"context"
+	"github.com/google/uuid"
+	"testing"
+)
+
+// countingCache counts GetValue calls which reach the wrapped Cache
+type countingCache struct {
+	*local.Cache
+	getValueCalls int
+}
+
+func (c *countingCache) GetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
+	c.getValueCalls++
+	return c.Cache.GetValue(ctx, pipelineId, subKey)
+}
+
+func TestCache_GetValue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pipelineId := uuid.New()
+
+	tests := []struct {
+		name      string
+		read      func(mc *Cache) (interface{}, error)
+		want      interface{}
+		wantCalls int
+	}{
+		{
+			// Test case with reading the status twice within one request.
+			// As a result, want the second read to be served by the memo.
+			name: "second read within request is served by memo",
+			read: func(mc *Cache) (interface{}, error) {
+				requestCtx, done := NewContext(ctx)
+				defer done()
+				_, _ = mc.GetValue(requestCtx, pipelineId, cache.Status)
+				return mc.GetValue(requestCtx, pipelineId, cache.Status)
+			},
+			want:      pb.Status_STATUS_EXECUTING,
+			wantCalls: 1,
+		},
+		{
+			// Test case with reading the status twice without memo in ctx.
+			// As a result, want both reads to reach the wrapped Cache.
+			name: "memo isn't used without opt-in",
+			read: func(mc *Cache) (interface{}, error) {
+				_, _ = mc.GetValue(ctx, pipelineId, cache.Status)
+				return mc.GetValue(ctx, pipelineId, cache.Status)
+			},
+			want:      pb.Status_STATUS_EXECUTING,
+			wantCalls: 2,
+		},
+		{
+			// Test case with reading the status in two requests.
+			// As a result, want the memo of the first request not to be used by the second one.
+			name: "memo isn't shared between requests",
+			read: func(mc *Cache) (interface{}, error) {
+				firstCtx, clearFirst := NewContext(ctx)
+				_, _ = mc.GetValue(firstCtx, pipelineId, cache.Status)
+				clearFirst()
+				secondCtx, clearSecond := NewContext(ctx)
+				defer clearSecond()
+				return mc.GetValue(secondCtx, pipelineId, cache.Status)
+			},
+			want:      pb.Status_STATUS_EXECUTING,
+			wantCalls: 2,
+		},
+		{
+			// Test case with reading the status after the memo is cleared.
+			// As a result, want the read to reach the wrapped Cache.
+			name: "memo isn't used after request ends",
+			read: func(mc *Cache) (interface{}, error) {
+				requestCtx, done := NewContext(ctx)
+				_, _ = mc.GetValue(requestCtx, pipelineId, cache.Status)
+				done()
+				return mc.GetValue(requestCtx, pipelineId, cache.Status)
+			},
+			want:      pb.Status_STATUS_EXECUTING,
+			wantCalls: 2,
+		},
+		{
+			// Test case with setting the status between two reads within one request.
+			// As a result, want the second read to return the new status from the wrapped Cache.
+			name: "SetValue invalidates memo",
+			read: func(mc *Cache) (interface{}, error) {
+				requestCtx, done := NewContext(ctx)
+				defer done()
+				_, _ = mc.GetValue(requestCtx, pipelineId, cache.Status)
+				_ = mc.SetValue(requestCtx, pipelineId, cache.Status, pb.Status_STATUS_FINISHED)
+				return mc.GetValue(requestCtx, pipelineId, cache.Status)
+			},
+			want:      pb.Status_STATUS_FINISHED,
+			wantCalls: 2,
+		},
+		{
+			// Test case with deleting the pipeline between two reads within one request.
+			// As a result, want the second read to reach the wrapped Cache and fail.
+			name: "DeletePipeline invalidates memo",
+			read: func(mc *Cache) (interface{}, error) {
+				requestCtx, done := NewContext(ctx)
+				defer done()
+				_, _ = mc.GetValue(requestCtx, pipelineId, cache.Status)
+				_ = mc.DeletePipeline(requestCtx, pipelineId)
+				value, _ := mc.GetValue(requestCtx, pipelineId, cache.Status)
+				return value, nil
+			},
+			want:      nil,
+			wantCalls: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapped := &countingCache{Cache: local.New(ctx)}
+			if err := wrapped.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_EXECUTING); err != nil {
+				t.Fatalf("SetValue() error = %v", err)
+			}
+			mc := New(wrapped)
+
+			got, err := tt.read(mc)
+			if err != nil {
+				t.Errorf("GetValue() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("GetValue() got = %v, want %v", got, tt.want)
+			}
+			if wrapped.getValueCalls != tt.wantCalls {
+				t.Errorf("GetValue() calls of wrapped cache = %d, want %d", wrapped.getValueCalls, tt.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import "time"
+
+// Metrics is used to record metrics of cache operations
+type Metrics interface {
+	// ObserveGetValue records GetValue operation which is finished without an error or with a cache miss.
+	// hit is true if value was found in cache.
+	ObserveGetValue(hit bool, latency time.Duration)
+
+	// ObserveSetValue records SetValue operation which is finished without an error.
+	ObserveSetValue(latency time.Duration)
+
+	// ObserveError records an error of the cache operation.
+	ObserveError(operation string)
+}
+
+// NoOpMetrics is Metrics implementation which doesn't record anything
+type NoOpMetrics struct{}
+
+func (NoOpMetrics) ObserveGetValue(bool, time.Duration) {}
+
+func (NoOpMetrics) ObserveSetValue(time.Duration) {}
+
+func (NoOpMetrics) ObserveError(string) {}
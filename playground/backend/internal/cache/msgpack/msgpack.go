@@ -0,0 +1,65 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package msgpack implements cache.Codec which encodes values to MessagePack (https://msgpack.org)
+// using github.com/vmihailenco/msgpack/v5.
+package msgpack
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Codec encodes values to MessagePack
+var Codec = codec{}
+
+type codec struct{}
+
+func (codec) Marshal(value interface{}) ([]byte, error) {
+	return Marshal(value)
+}
+
+func (codec) Unmarshal(data []byte, value interface{}) error {
+	return Unmarshal(data, value)
+}
+
+// Marshal returns MessagePack encoding of the value.
+// Keys of map[string]string and map[string]interface{} are sorted so such maps are always encoded to the same bytes.
+func Marshal(value interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	enc.SetSortMapKeys(true)
+	if err := enc.Encode(value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes MessagePack encoded data and stores the result in the value pointed to by value.
+// Integers are decoded to int64 (uint64 if they don't fit) and maps with string keys to map[string]interface{}
+// if the destination is an empty interface.
+func Unmarshal(data []byte, value interface{}) error {
+	r := bytes.NewReader(data)
+	dec := msgpack.NewDecoder(r)
+	dec.UseLooseInterfaceDecoding(true)
+	if err := dec.Decode(value); err != nil {
+		return err
+	}
+	if r.Len() != 0 {
+		return fmt.Errorf("msgpack: %d unexpected bytes after the value", r.Len())
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgpack
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    []byte
+		wantErr bool
+	}{
+		{name: "nil", value: nil, want: []byte{0xc0}},
+		{name: "bool", value: true, want: []byte{0xc3}},
+		{name: "positive fixint", value: 1, want: []byte{0x01}},
+		{name: "negative fixint", value: -1, want: []byte{0xff}},
+		{name: "uint16", value: 256, want: []byte{0xcd, 0x01, 0x00}},
+		{name: "int8", value: -100, want: []byte{0xd0, 0x9c}},
+		{name: "int64", value: int64(math.MinInt64), want: []byte{0xd3, 0x80, 0, 0, 0, 0, 0, 0, 0}},
+		{name: "float64", value: 1.5, want: []byte{0xcb, 0x3f, 0xf8, 0, 0, 0, 0, 0, 0}},
+		{name: "fixstr", value: "a", want: []byte{0xa1, 'a'}},
+		{name: "str8", value: strings.Repeat("a", 32), want: append([]byte{0xd9, 32}, strings.Repeat("a", 32)...)},
+		{name: "bin8", value: []byte{1, 2}, want: []byte{0xc4, 0x02, 0x01, 0x02}},
+		{name: "fixarray", value: []int{1, 2}, want: []byte{0x92, 0x01, 0x02}},
+		{name: "fixmap with sorted keys", value: map[string]interface{}{"b": 2, "a": 1}, want: []byte{0x82, 0xa1, 'a', 0x01, 0xa1, 'b', 0x02}},
+		{name: "struct", value: struct{ A int }{A: 1}, want: []byte{0x81, 0xa1, 'A', 0x01}},
+		{name: "unsupported type", value: make(chan int), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Marshal(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Marshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("Marshal() got = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		value   interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "int to interface", data: []byte{0x01}, value: new(interface{}), want: int64(1)},
+		{name: "map to interface", data: []byte{0x81, 0xa1, 'a', 0x01}, value: new(interface{}), want: map[string]interface{}{"a": int64(1)}},
+		{name: "int to float64", data: []byte{0xcd, 0x01, 0x00}, value: new(float64), want: float64(256)},
+		{name: "type mismatch", data: []byte{0xa1, 'a'}, value: new(int), wantErr: true},
+		{name: "unexpected end of data", data: []byte{0xd9, 32, 'a'}, value: new(string), wantErr: true},
+		{name: "unexpected bytes after the value", data: []byte{0x01, 0x02}, value: new(int), wantErr: true},
+		{name: "unsupported code", data: []byte{0xc1}, value: new(interface{}), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Unmarshal(tt.data, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := reflect.ValueOf(tt.value).Elem().Interface(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unmarshal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if err := Unmarshal([]byte{0x01}, 1); err == nil {
+		t.Errorf("Unmarshal() to non-pointer value doesn't return an error")
+	}
+}
+
+func TestCodec_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		subKey cache.SubKey
+		value  interface{}
+	}{
+		{name: "status", subKey: cache.Status, value: pb.Status_STATUS_FINISHED},
+		{name: "output", subKey: cache.RunOutput, value: "MOCK_OUTPUT"},
+		{name: "large output", subKey: cache.RunOutput, value: strings.Repeat("MOCK_OUTPUT", 10000)},
+		{name: "index", subKey: cache.RunOutputIndex, value: float64(42)},
+		{name: "canceled", subKey: cache.Canceled, value: true},
+		{name: "runResult", subKey: cache.RunResult, value: cache.ExecutionResult{ExitCode: -1, Duration: time.Second, PeakMemory: 1 << 40}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Codec.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			got, err := cache.DecodeWith(Codec, tt.subKey, string(data))
+			if err != nil {
+				t.Fatalf("DecodeWith() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("DecodeWith() got = %v, want %v", got, tt.value)
+			}
+		})
+	}
+}
+
+// benchmarkOutput is 1MB output of the pipeline
+var benchmarkOutput = strings.Repeat("INFO: MOCK_OUTPUT of the \"pipeline\"\n", 1<<20/37)
+
+func benchmarkMarshal(b *testing.B, codec cache.Codec) {
+	b.SetBytes(int64(len(benchmarkOutput)))
+	for i := 0; i < b.N; i++ {
+		if _, err := codec.Marshal(benchmarkOutput); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkUnmarshal(b *testing.B, codec cache.Codec) {
+	data, err := codec.Marshal(benchmarkOutput)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(benchmarkOutput)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var output string
+		if err := codec.Unmarshal(data, &output); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkJSONCodec_Marshal(b *testing.B) {
+	benchmarkMarshal(b, cache.JSONCodec)
+}
+
+func BenchmarkMsgpackCodec_Marshal(b *testing.B) {
+	benchmarkMarshal(b, Codec)
+}
+
+func BenchmarkJSONCodec_Unmarshal(b *testing.B) {
+	benchmarkUnmarshal(b, cache.JSONCodec)
+}
+
+func BenchmarkMsgpackCodec_Unmarshal(b *testing.B) {
+	benchmarkUnmarshal(b, Codec)
+}
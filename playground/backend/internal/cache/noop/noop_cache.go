@@ -0,0 +1,135 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noop
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Cache is cache.Cache which doesn't keep anything, so caching is disabled.
+// Setters succeed without any effect and getters behave as if cache is empty.
+type Cache struct{}
+
+// New returns new no-op cache
+func New() *Cache {
+	return &Cache{}
+}
+
+// GetValue always returns an error which wraps cache.ErrNotFound
+func (c *Cache) GetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
+	return nil, fmt.Errorf("value with pipelineId: %s and subKey: %s: %w", pipelineId, subKey, cache.ErrNotFound)
+}
+
+// GetValueWithTTL always returns an error which wraps cache.ErrNotFound
+func (c *Cache) GetValueWithTTL(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, time.Duration, error) {
+	value, err := c.GetValue(ctx, pipelineId, subKey)
+	return value, 0, err
+}
+
+// GetValues always returns an empty map
+func (c *Cache) GetValues(ctx context.Context, pipelineId uuid.UUID, subKeys []cache.SubKey) (map[cache.SubKey]interface{}, error) {
+	return map[cache.SubKey]interface{}{}, nil
+}
+
+// ScanValues always returns an empty map
+func (c *Cache) ScanValues(ctx context.Context, pipelineId uuid.UUID) (map[cache.SubKey]interface{}, error) {
+	return map[cache.SubKey]interface{}{}, nil
+}
+
+// GetPipelines always returns an empty list
+func (c *Cache) GetPipelines(ctx context.Context) ([]uuid.UUID, error) {
+	return []uuid.UUID{}, nil
+}
+
+// GetAge always returns an error which wraps cache.ErrNotFound
+func (c *Cache) GetAge(ctx context.Context, pipelineId uuid.UUID) (time.Duration, error) {
+	return 0, fmt.Errorf("creation time of pipelineId: %s: %w", pipelineId, cache.ErrNotFound)
+}
+
+// GetSubKeyCount always returns 0
+func (c *Cache) GetSubKeyCount(ctx context.Context, pipelineId uuid.UUID) (int, error) {
+	return 0, nil
+}
+
+// SetValue does nothing
+func (c *Cache) SetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) error {
+	return nil
+}
+
+// SetValues does nothing
+func (c *Cache) SetValues(ctx context.Context, pipelineId uuid.UUID, values map[cache.SubKey]interface{}) error {
+	return nil
+}
+
+// Preload does nothing
+func (c *Cache) Preload(ctx context.Context, pipelines map[uuid.UUID]map[cache.SubKey]interface{}) error {
+	return nil
+}
+
+// SetStatusIfNotTerminal does nothing and returns true, because there is no current status which could be terminal
+func (c *Cache) SetStatusIfNotTerminal(ctx context.Context, pipelineId uuid.UUID, status pb.Status) (bool, error) {
+	return true, nil
+}
+
+// SubscribeStatus returns channel which never receives values and is closed when ctx is done
+func (c *Cache) SubscribeStatus(ctx context.Context, pipelineId uuid.UUID) (<-chan pb.Status, error) {
+	statuses := make(chan pb.Status)
+	go func() {
+		<-ctx.Done()
+		close(statuses)
+	}()
+	return statuses, nil
+}
+
+// DeleteValue does nothing
+func (c *Cache) DeleteValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) error {
+	return nil
+}
+
+// DeletePipeline does nothing
+func (c *Cache) DeletePipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	return nil
+}
+
+// FlushAll does nothing
+func (c *Cache) FlushAll(ctx context.Context) error {
+	return nil
+}
+
+// SetExpTime does nothing
+func (c *Cache) SetExpTime(ctx context.Context, pipelineId uuid.UUID, expTime time.Duration) error {
+	return nil
+}
+
+// TouchPipeline does nothing
+func (c *Cache) TouchPipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	return nil
+}
+
+// HealthCheck always succeeds
+func (c *Cache) HealthCheck(ctx context.Context) error {
+	return nil
+}
+
+// Close does nothing
+func (c *Cache) Close() error {
+	return nil
+}
@@ -0,0 +1,149 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noop
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+var _ cache.Cache = (*Cache)(nil)
+
+func TestCache_Setters(t *testing.T) {
+	c := New()
+	ctx := context.Background()
+	pipelineId := uuid.New()
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "SetValue",
+			call: func() error { return c.SetValue(ctx, pipelineId, cache.RunOutput, "MOCK_OUTPUT") },
+		},
+		{
+			name: "SetValues",
+			call: func() error {
+				return c.SetValues(ctx, pipelineId, map[cache.SubKey]interface{}{cache.Status: pb.Status_STATUS_FINISHED})
+			},
+		},
+		{
+			name: "Preload",
+			call: func() error {
+				return c.Preload(ctx, map[uuid.UUID]map[cache.SubKey]interface{}{pipelineId: {cache.RunOutput: "MOCK_OUTPUT"}})
+			},
+		},
+		{
+			name: "SetExpTime",
+			call: func() error { return c.SetExpTime(ctx, pipelineId, time.Minute) },
+		},
+		{
+			name: "TouchPipeline",
+			call: func() error { return c.TouchPipeline(ctx, pipelineId) },
+		},
+		{
+			name: "DeleteValue",
+			call: func() error { return c.DeleteValue(ctx, pipelineId, cache.RunOutput) },
+		},
+		{
+			name: "DeletePipeline",
+			call: func() error { return c.DeletePipeline(ctx, pipelineId) },
+		},
+		{
+			name: "FlushAll",
+			call: func() error { return c.FlushAll(ctx) },
+		},
+		{
+			name: "HealthCheck",
+			call: func() error { return c.HealthCheck(ctx) },
+		},
+		{
+			name: "Close",
+			call: func() error { return c.Close() },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != nil {
+				t.Errorf("%s() error = %v, want nil", tt.name, err)
+			}
+		})
+	}
+	// nothing is kept after setters
+	if _, err := c.GetValue(ctx, pipelineId, cache.RunOutput); !errors.Is(err, cache.ErrNotFound) {
+		t.Errorf("GetValue() error = %v, want %v", err, cache.ErrNotFound)
+	}
+}
+
+func TestCache_Getters(t *testing.T) {
+	c := New()
+	ctx := context.Background()
+	pipelineId := uuid.New()
+	if err := c.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_FINISHED); err != nil {
+		t.Fatalf("SetValue() error = %v", err)
+	}
+
+	if value, err := c.GetValue(ctx, pipelineId, cache.Status); !errors.Is(err, cache.ErrNotFound) || value != nil {
+		t.Errorf("GetValue() = %v, %v, want nil, %v", value, err, cache.ErrNotFound)
+	}
+	if value, ttl, err := c.GetValueWithTTL(ctx, pipelineId, cache.Status); !errors.Is(err, cache.ErrNotFound) || value != nil || ttl != 0 {
+		t.Errorf("GetValueWithTTL() = %v, %v, %v, want nil, 0, %v", value, ttl, err, cache.ErrNotFound)
+	}
+	if values, err := c.GetValues(ctx, pipelineId, []cache.SubKey{cache.Status}); err != nil || len(values) != 0 {
+		t.Errorf("GetValues() = %v, %v, want empty map, nil", values, err)
+	}
+	if values, err := c.ScanValues(ctx, pipelineId); err != nil || len(values) != 0 {
+		t.Errorf("ScanValues() = %v, %v, want empty map, nil", values, err)
+	}
+	if pipelines, err := c.GetPipelines(ctx); err != nil || len(pipelines) != 0 {
+		t.Errorf("GetPipelines() = %v, %v, want empty list, nil", pipelines, err)
+	}
+	if age, err := c.GetAge(ctx, pipelineId); !errors.Is(err, cache.ErrNotFound) || age != 0 {
+		t.Errorf("GetAge() = %v, %v, want 0, %v", age, err, cache.ErrNotFound)
+	}
+	if count, err := c.GetSubKeyCount(ctx, pipelineId); err != nil || count != 0 {
+		t.Errorf("GetSubKeyCount() = %v, %v, want 0, nil", count, err)
+	}
+	if isSet, err := c.SetStatusIfNotTerminal(ctx, pipelineId, pb.Status_STATUS_CANCELED); err != nil || !isSet {
+		t.Errorf("SetStatusIfNotTerminal() = %v, %v, want true, nil", isSet, err)
+	}
+}
+
+func TestCache_SubscribeStatus(t *testing.T) {
+	c := New()
+	ctx, cancel := context.WithCancel(context.Background())
+	statuses, err := c.SubscribeStatus(ctx, uuid.New())
+	if err != nil {
+		t.Fatalf("SubscribeStatus() error = %v", err)
+	}
+	if err := c.SetValue(ctx, uuid.New(), cache.Status, pb.Status_STATUS_FINISHED); err != nil {
+		t.Fatalf("SetValue() error = %v", err)
+	}
+	cancel()
+	select {
+	case status, ok := <-statuses:
+		if ok {
+			t.Errorf("SubscribeStatus() received %v, want closed channel", status)
+		}
+	case <-time.After(time.Second):
+		t.Error("SubscribeStatus() channel isn't closed after ctx is done")
+	}
+}
@@ -0,0 +1,256 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reaper
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/logger"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"sync"
+	"time"
+)
+
+// maxReapInterval is the max duration between checks of idle pipelines
+const maxReapInterval = 30 * time.Second
+
+// Cache is the layer in front of another Cache which deletes pipelines which aren't accessed during the idle timeout,
+// so abandoned pipelines don't keep memory of the cache until they expire.
+// Only pipelines which are created by SetValue or SetValues of this Cache are tracked. Their last access time is
+// updated by every operation of the pipeline. Pipelines which status isn't terminal (e.g. the code is running) and
+// pipelines without status (e.g. snippets) aren't deleted. Preloaded pipelines aren't tracked.
+// The last access time is kept in memory, so accesses of the pipeline through other instances of the server aren't seen.
+type Cache struct {
+	cache.Cache
+	mu          sync.Mutex
+	idleTimeout time.Duration
+	// lastAccess keeps the last access time of each tracked pipeline
+	lastAccess map[uuid.UUID]time.Time
+	// now returns the current time. If it is nil, time.Now is used.
+	now func() time.Time
+}
+
+// New returns the layer in front of received Cache which deletes pipelines which aren't accessed during idleTimeout.
+// Idle pipelines are checked in the background until ctx is done.
+func New(ctx context.Context, wrapped cache.Cache, idleTimeout time.Duration) *Cache {
+	rc := &Cache{
+		Cache:       wrapped,
+		idleTimeout: idleTimeout,
+		lastAccess:  make(map[uuid.UUID]time.Time),
+		now:         time.Now,
+	}
+	go rc.startReaper(ctx)
+	return rc
+}
+
+// GetValue updates the last access time of the pipeline and returns value from the wrapped Cache
+func (rc *Cache) GetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
+	rc.touch(pipelineId)
+	return rc.Cache.GetValue(ctx, pipelineId, subKey)
+}
+
+// GetValueWithTTL updates the last access time of the pipeline and returns value with its time to live from the wrapped Cache
+func (rc *Cache) GetValueWithTTL(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, time.Duration, error) {
+	rc.touch(pipelineId)
+	return rc.Cache.GetValueWithTTL(ctx, pipelineId, subKey)
+}
+
+// GetValues updates the last access time of the pipeline and returns values from the wrapped Cache
+func (rc *Cache) GetValues(ctx context.Context, pipelineId uuid.UUID, subKeys []cache.SubKey) (map[cache.SubKey]interface{}, error) {
+	rc.touch(pipelineId)
+	return rc.Cache.GetValues(ctx, pipelineId, subKeys)
+}
+
+// ScanValues updates the last access time of the pipeline and returns all its values from the wrapped Cache
+func (rc *Cache) ScanValues(ctx context.Context, pipelineId uuid.UUID) (map[cache.SubKey]interface{}, error) {
+	rc.touch(pipelineId)
+	return rc.Cache.ScanValues(ctx, pipelineId)
+}
+
+// SetValue starts tracking of the pipeline or updates its last access time and adds value to the wrapped Cache
+func (rc *Cache) SetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) error {
+	rc.track(pipelineId)
+	return rc.Cache.SetValue(ctx, pipelineId, subKey, value)
+}
+
+// SetValues starts tracking of the pipeline or updates its last access time and adds values to the wrapped Cache
+func (rc *Cache) SetValues(ctx context.Context, pipelineId uuid.UUID, values map[cache.SubKey]interface{}) error {
+	rc.track(pipelineId)
+	return rc.Cache.SetValues(ctx, pipelineId, values)
+}
+
+// Preload stops tracking of the pipelines and sets them to the wrapped Cache, so they are kept until they expire
+func (rc *Cache) Preload(ctx context.Context, pipelines map[uuid.UUID]map[cache.SubKey]interface{}) error {
+	rc.mu.Lock()
+	for pipelineId := range pipelines {
+		delete(rc.lastAccess, pipelineId)
+	}
+	rc.mu.Unlock()
+	return rc.Cache.Preload(ctx, pipelines)
+}
+
+// SetStatusIfNotTerminal updates the last access time of the pipeline and sets its status to the wrapped Cache
+func (rc *Cache) SetStatusIfNotTerminal(ctx context.Context, pipelineId uuid.UUID, status pb.Status) (bool, error) {
+	rc.touch(pipelineId)
+	return rc.Cache.SetStatusIfNotTerminal(ctx, pipelineId, status)
+}
+
+// SubscribeStatus updates the last access time of the pipeline and subscribes to its status in the wrapped Cache
+func (rc *Cache) SubscribeStatus(ctx context.Context, pipelineId uuid.UUID) (<-chan pb.Status, error) {
+	rc.touch(pipelineId)
+	return rc.Cache.SubscribeStatus(ctx, pipelineId)
+}
+
+// DeleteValue updates the last access time of the pipeline and removes value from the wrapped Cache
+func (rc *Cache) DeleteValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) error {
+	rc.touch(pipelineId)
+	return rc.Cache.DeleteValue(ctx, pipelineId, subKey)
+}
+
+// DeletePipeline stops tracking of the pipeline and removes it from the wrapped Cache
+func (rc *Cache) DeletePipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	rc.forget(pipelineId)
+	return rc.Cache.DeletePipeline(ctx, pipelineId)
+}
+
+// FlushAll stops tracking of all pipelines and removes them from the wrapped Cache
+func (rc *Cache) FlushAll(ctx context.Context) error {
+	rc.mu.Lock()
+	rc.lastAccess = make(map[uuid.UUID]time.Time)
+	rc.mu.Unlock()
+	return rc.Cache.FlushAll(ctx)
+}
+
+// SetExpTime updates the last access time of the pipeline and sets its expiration time to the wrapped Cache
+func (rc *Cache) SetExpTime(ctx context.Context, pipelineId uuid.UUID, expTime time.Duration) error {
+	rc.touch(pipelineId)
+	return rc.Cache.SetExpTime(ctx, pipelineId, expTime)
+}
+
+// TouchPipeline updates the last access time of the pipeline and resets its expiration time in the wrapped Cache
+func (rc *Cache) TouchPipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	rc.touch(pipelineId)
+	return rc.Cache.TouchPipeline(ctx, pipelineId)
+}
+
+// startReaper deletes idle pipelines periodically until ctx is done
+func (rc *Cache) startReaper(ctx context.Context) {
+	interval := rc.idleTimeout
+	if interval > maxReapInterval {
+		interval = maxReapInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rc.reap(ctx)
+		}
+	}
+}
+
+// reap deletes tracked pipelines which aren't accessed during the idle timeout and which status is terminal.
+// Idle pipelines without status (e.g. expired ones or snippets) aren't tracked anymore.
+// Returns ids of deleted pipelines.
+func (rc *Cache) reap(ctx context.Context) []uuid.UUID {
+	reaped := make([]uuid.UUID, 0)
+	for _, pipelineId := range rc.idlePipelines() {
+		status, err := rc.Cache.GetValue(ctx, pipelineId, cache.Status)
+		if errors.Is(err, cache.ErrNotFound) {
+			rc.forget(pipelineId)
+			continue
+		}
+		if err != nil {
+			logger.Errorf("Reaper Cache: error during getting status of idle pipeline %s: %s\n", pipelineId, err.Error())
+			continue
+		}
+		if pipelineStatus, ok := status.(pb.Status); !ok || !cache.IsTerminalStatus(pipelineStatus) {
+			// the code of the pipeline is still processed
+			continue
+		}
+		if !rc.forgetIfIdle(pipelineId) {
+			continue
+		}
+		if err = rc.Cache.DeletePipeline(ctx, pipelineId); err != nil {
+			logger.Errorf("Reaper Cache: error during deleting idle pipeline %s: %s\n", pipelineId, err.Error())
+			continue
+		}
+		logger.Infof("Reaper Cache: idle pipeline %s is deleted\n", pipelineId)
+		reaped = append(reaped, pipelineId)
+	}
+	return reaped
+}
+
+// idlePipelines returns tracked pipelines which aren't accessed during the idle timeout
+func (rc *Cache) idlePipelines() []uuid.UUID {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	pipelines := make([]uuid.UUID, 0)
+	for pipelineId, lastAccess := range rc.lastAccess {
+		if rc.currentTime().Sub(lastAccess) >= rc.idleTimeout {
+			pipelines = append(pipelines, pipelineId)
+		}
+	}
+	return pipelines
+}
+
+// forgetIfIdle stops tracking of the pipeline if it still isn't accessed during the idle timeout.
+// Returns true if tracking of the pipeline is stopped.
+func (rc *Cache) forgetIfIdle(pipelineId uuid.UUID) bool {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	lastAccess, found := rc.lastAccess[pipelineId]
+	if !found || rc.currentTime().Sub(lastAccess) < rc.idleTimeout {
+		return false
+	}
+	delete(rc.lastAccess, pipelineId)
+	return true
+}
+
+// track starts tracking of the pipeline or updates its last access time
+func (rc *Cache) track(pipelineId uuid.UUID) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.lastAccess[pipelineId] = rc.currentTime()
+}
+
+// touch updates the last access time of the pipeline if it is tracked
+func (rc *Cache) touch(pipelineId uuid.UUID) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if _, found := rc.lastAccess[pipelineId]; found {
+		rc.lastAccess[pipelineId] = rc.currentTime()
+	}
+}
+
+// forget stops tracking of the pipeline
+func (rc *Cache) forget(pipelineId uuid.UUID) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	delete(rc.lastAccess, pipelineId)
+}
+
+// currentTime returns the current time using the clock of the cache
+func (rc *Cache) currentTime() time.Time {
+	if rc.now == nil {
+		return time.Now()
+	}
+	return rc.now()
+}
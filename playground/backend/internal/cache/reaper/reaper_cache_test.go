@@ -0,0 +1,173 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reaper
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/local"
+	"context"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+func TestCache_reap(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	idleTimeout := 5 * time.Minute
+
+	tests := []struct {
+		name string
+		// prepare sets the pipeline to cache and returns the fake clock of the cache after the pipeline is accessed
+		prepare    func(rc *Cache, pipelineId uuid.UUID, currentTime *time.Time)
+		wantReaped bool
+	}{
+		{
+			// Test case with the finished pipeline which isn't accessed during the idle timeout.
+			// As a result, want the pipeline to be deleted.
+			name: "idle finished pipeline",
+			prepare: func(rc *Cache, pipelineId uuid.UUID, currentTime *time.Time) {
+				_ = rc.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_FINISHED)
+				*currentTime = currentTime.Add(idleTimeout)
+			},
+			wantReaped: true,
+		},
+		{
+			// Test case with the finished pipeline which is read during the idle timeout.
+			// As a result, want the pipeline to be kept.
+			name: "finished pipeline is read",
+			prepare: func(rc *Cache, pipelineId uuid.UUID, currentTime *time.Time) {
+				_ = rc.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_FINISHED)
+				*currentTime = currentTime.Add(idleTimeout - time.Minute)
+				_, _ = rc.GetValue(ctx, pipelineId, cache.RunOutput)
+				*currentTime = currentTime.Add(idleTimeout - time.Minute)
+			},
+			wantReaped: false,
+		},
+		{
+			// Test case with the pipeline which code is still running after the idle timeout.
+			// As a result, want the pipeline to be kept.
+			name: "active pipeline",
+			prepare: func(rc *Cache, pipelineId uuid.UUID, currentTime *time.Time) {
+				_ = rc.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_EXECUTING)
+				*currentTime = currentTime.Add(idleTimeout * 2)
+			},
+			wantReaped: false,
+		},
+		{
+			// Test case with the pipeline without status which isn't accessed during the idle timeout (e.g. the snippet).
+			// As a result, want the pipeline to be kept.
+			name: "pipeline without status",
+			prepare: func(rc *Cache, pipelineId uuid.UUID, currentTime *time.Time) {
+				_ = rc.SetValue(ctx, pipelineId, cache.SubKey("MOCK_SUBKEY"), "MOCK_VALUE")
+				*currentTime = currentTime.Add(idleTimeout)
+			},
+			wantReaped: false,
+		},
+		{
+			// Test case with the preloaded pipeline which isn't accessed during the idle timeout.
+			// As a result, want the pipeline to be kept.
+			name: "preloaded pipeline",
+			prepare: func(rc *Cache, pipelineId uuid.UUID, currentTime *time.Time) {
+				_ = rc.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_FINISHED)
+				_ = rc.Preload(ctx, map[uuid.UUID]map[cache.SubKey]interface{}{pipelineId: {cache.Status: pb.Status_STATUS_FINISHED}})
+				*currentTime = currentTime.Add(idleTimeout)
+			},
+			wantReaped: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			currentTime := time.Now()
+			rc := &Cache{
+				Cache:       local.New(ctx),
+				idleTimeout: idleTimeout,
+				lastAccess:  make(map[uuid.UUID]time.Time),
+				now: func() time.Time {
+					return currentTime
+				},
+			}
+			pipelineId := uuid.New()
+			tt.prepare(rc, pipelineId, &currentTime)
+
+			reaped := rc.reap(ctx)
+			if gotReaped := len(reaped) == 1 && reaped[0] == pipelineId; gotReaped != tt.wantReaped || len(reaped) > 1 {
+				t.Fatalf("reap() = %v, want the pipeline to be reaped: %v", reaped, tt.wantReaped)
+			}
+			count, err := rc.Cache.GetSubKeyCount(ctx, pipelineId)
+			if err != nil {
+				t.Fatalf("GetSubKeyCount() error = %v", err)
+			}
+			if (count == 0) != tt.wantReaped {
+				t.Errorf("GetSubKeyCount() = %d, want the pipeline to be deleted: %v", count, tt.wantReaped)
+			}
+		})
+	}
+}
+
+func TestCache_reapFinishedActivePipeline(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	idleTimeout := 5 * time.Minute
+	currentTime := time.Now()
+	rc := &Cache{
+		Cache:       local.New(ctx),
+		idleTimeout: idleTimeout,
+		lastAccess:  make(map[uuid.UUID]time.Time),
+		now: func() time.Time {
+			return currentTime
+		},
+	}
+	pipelineId := uuid.New()
+	_ = rc.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_EXECUTING)
+
+	// the pipeline is spared while its code is running
+	currentTime = currentTime.Add(idleTimeout)
+	if reaped := rc.reap(ctx); len(reaped) != 0 {
+		t.Fatalf("reap() of the active pipeline = %v, want no pipelines", reaped)
+	}
+
+	// the pipeline is reaped when it isn't accessed during the idle timeout after it is finished
+	_ = rc.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_FINISHED)
+	currentTime = currentTime.Add(idleTimeout - time.Second)
+	if reaped := rc.reap(ctx); len(reaped) != 0 {
+		t.Fatalf("reap() of the recently finished pipeline = %v, want no pipelines", reaped)
+	}
+	currentTime = currentTime.Add(time.Second)
+	if reaped := rc.reap(ctx); len(reaped) != 1 || reaped[0] != pipelineId {
+		t.Errorf("reap() of the idle finished pipeline = %v, want %v", reaped, []uuid.UUID{pipelineId})
+	}
+}
+
+func TestNew(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rc := New(ctx, local.New(ctx), 10*time.Millisecond)
+	pipelineId := uuid.New()
+	if err := rc.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_FINISHED); err != nil {
+		t.Fatalf("SetValue() error = %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if count, _ := rc.Cache.GetSubKeyCount(ctx, pipelineId); count == 0 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("the idle pipeline isn't deleted in the background")
+}
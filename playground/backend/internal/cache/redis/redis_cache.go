@@ -19,105 +19,1436 @@ import (
 	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/cache"
 	"beam.apache.org/playground/backend/internal/logger"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"sort"
+	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 )
 
+const (
+	defaultKeyExpirationTime     = time.Minute * 15
+	defaultPreloadExpirationTime = time.Hour * 24
+	defaultOperationTimeout      = time.Second * 2
+	healthCheckTimeout           = time.Second
+	defaultPingRetryDelay        = time.Millisecond * 500
+	scanCount                    = 100
+	// createdAtSubKey is reserved subKey of the hash field which keeps the creation time of the pipeline in Unix nanoseconds
+	createdAtSubKey cache.SubKey = "CREATED_AT"
+	// compressedValueHeader is added at the beginning of the compressed values to distinguish them from the JSON values
+	compressedValueHeader = "\x00GZ\x00"
+	// rawValueHeader is added at the beginning of []byte values which are kept as is instead of being marshaled
+	rawValueHeader = "\x00RAW\x00"
+	// truncatedValueMarker is added at the end of string values which are truncated to the max size of values
+	truncatedValueMarker = "\n[TRUNCATED: the value exceeds the max size of cache values]"
+)
+
+// setStatusIfNotTerminalScript sets the status unless the current status is one of terminal statuses.
+// KEYS[1] is the key which keeps the status, ARGV[1] is the hash field of the status or an empty string
+// if the status is kept under a separate key, ARGV[2] is encoded status, ARGV[3] is expiration time in milliseconds
+// which is set to the new key, the rest ARGV are encoded terminal statuses.
+// Returns 1 if the status is set, otherwise 0.
+var setStatusIfNotTerminalScript = redis.NewScript(`
+local current
+if ARGV[1] == '' then
+	current = redis.call('GET', KEYS[1])
+else
+	current = redis.call('HGET', KEYS[1], ARGV[1])
+end
+if current then
+	for i = 4, #ARGV do
+		if current == ARGV[i] then
+			return 0
+		end
+	end
+end
+if ARGV[1] == '' then
+	redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+	return 1
+end
+local created = redis.call('EXISTS', KEYS[1]) == 0
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+if created then
+	redis.call('PEXPIRE', KEYS[1], ARGV[3])
+end
+return 1
+`)
+
 type Cache struct {
-	*redis.Client
+	// Cmdable is implemented by both single-node and cluster Redis clients
+	redis.Cmdable
+	// keyPrefix is added to each pipelineId to get the key of the pipeline in Redis
+	keyPrefix string
+	// keyExpirationTime is expiration time which is set to the pipeline's key when it is created
+	keyExpirationTime time.Duration
+	// subKeyExpirationTimes are expiration times of subKeys which are kept under separate keys
+	subKeyExpirationTimes map[cache.SubKey]time.Duration
+	// preloadExpirationTime is expiration time which is set to keys of preloaded pipelines
+	preloadExpirationTime time.Duration
+	// codec marshals values before they are set to Redis and unmarshals them back
+	codec cache.Codec
+	// compressionThreshold is the size of marshaled value in bytes after which the value is compressed
+	compressionThreshold int
+	// maxValueBytes is the max size of marshaled value in bytes. If it is zero, the size isn't limited.
+	maxValueBytes int
+	// truncateLargeValues is true if string values over maxValueBytes are truncated instead of being rejected
+	truncateLargeValues bool
+	// operationTimeout is the max duration of one operation with Redis
+	operationTimeout time.Duration
+	// metrics records metrics of cache operations
+	metrics cache.Metrics
+	// tracer starts spans around cache operations
+	tracer trace.Tracer
+	// slowOperationThreshold is the duration after which the operation is reported as slow. If it is zero, operations are not reported.
+	slowOperationThreshold time.Duration
+	// onSlowOperation is called for each slow operation. If it is nil, slow operations are logged.
+	onSlowOperation func(operation string, pipelineId uuid.UUID, subKey cache.SubKey, duration time.Duration)
+	// now returns the current time. If it is nil, time.Now is used.
+	now func() time.Time
+}
+
+// subscriber is implemented by Redis clients which support Pub/Sub
+type subscriber interface {
+	Subscribe(ctx context.Context, channels ...string) *redis.PubSub
+}
+
+// Options contains additional parameters to connect to Redis
+type Options struct {
+	// TLSConfig is used to connect to Redis over TLS. If it is nil, plaintext connection is used.
+	TLSConfig *tls.Config
+
+	// Username and Password are used to authenticate connections to Redis, e.g. by the user of Redis 6 ACL.
+	// If Username is empty, Password is used to authenticate as the default user. If both are empty, connections
+	// aren't authenticated. The client authenticates each new connection, so the initial Ping checks the credentials.
+	// Credentials aren't logged by the cache.
+	Username string
+	Password string
+
+	// DB is the index of the logical database of Redis which keeps values of the playground.
+	// It allows to isolate the playground in shared Redis, e.g. FlushAll of another database doesn't affect it.
+	// Redis Cluster supports only the database 0, so DB is ignored by NewCluster. If it is zero, the database 0 is used.
+	DB int
+
+	// PoolSize is the max number of connections to Redis (to each node of Redis Cluster).
+	// If it is zero, the default of go-redis is used (10 connections per CPU).
+	PoolSize int
+
+	// MinIdleConns is the number of idle connections which are kept open to serve bursts of operations.
+	// If it is zero, idle connections are not kept.
+	MinIdleConns int
+
+	// PoolTimeout is the max duration of waiting for a free connection when all connections of the pool are busy.
+	// If it is zero, the default of go-redis is used (read timeout + 1 second).
+	PoolTimeout time.Duration
+
+	// KeyPrefix is added to each pipelineId before operations with Redis.
+	// It allows several Playground environments to share one Redis instance.
+	KeyPrefix string
+
+	// KeyExpirationTime is expiration time which is set to the pipeline's key when it is created.
+	// If it is zero, 15 minutes are used.
+	KeyExpirationTime time.Duration
+
+	// SubKeyExpirationTimes are expiration times of subKeys which should expire independently of the pipeline,
+	// e.g. Logs which expire faster than Status.
+	// Fields of Redis hash can't expire individually (HEXPIRE is available only since Redis 7.4 and isn't supported
+	// by the client), so each of these subKeys is kept under a separate key "<pipeline key>:<subKey>"
+	// with its own expiration time. Such subKeys aren't part of the single HSet operation of SetValues.
+	SubKeyExpirationTimes map[cache.SubKey]time.Duration
+
+	// PreloadExpirationTime is expiration time which is set to keys of pipelines which are set by Preload.
+	// If it is zero, 24 hours are used.
+	PreloadExpirationTime time.Duration
+
+	// Codec marshals values before they are set to Redis and unmarshals them back, e.g. msgpack.Codec.
+	// SubKeys are always marshaled to JSON, so only values are affected.
+	// All instances which share Redis must use the same codec. If it is nil, cache.JSONCodec is used.
+	Codec cache.Codec
+
+	// CompressionThreshold is the size of marshaled value in bytes after which the value is compressed using gzip.
+	// If it is zero, values are not compressed.
+	CompressionThreshold int
+
+	// MaxValueBytes is the max size of marshaled value in bytes (before compression) which can be set to Redis,
+	// e.g. to protect Redis memory from the run which prints gigabytes of output.
+	// Values over the limit are rejected with cache.ErrValueTooLarge. If it is zero, the size isn't limited.
+	MaxValueBytes int
+
+	// TruncateLargeValues enables truncation of string values over MaxValueBytes instead of rejecting them.
+	// The end of the value is cut and the marker of truncation is added, so readers can see that the value is incomplete.
+	// Values of other types over the limit are still rejected.
+	TruncateLargeValues bool
+
+	// OperationTimeout is the max duration of one operation with Redis.
+	// If it is zero, 2 seconds are used.
+	OperationTimeout time.Duration
+
+	// Metrics records metrics of cache operations. If it is nil, metrics are not recorded.
+	Metrics cache.Metrics
+
+	// Tracer starts OpenTelemetry spans around GetValue, SetValue and SetExpTime operations.
+	// If it is nil, the tracer of the global TracerProvider is used.
+	Tracer trace.Tracer
+
+	// PingAttempts is the max number of Ping operations to check the connection to Redis during creating of the cache.
+	// If it is zero, only one attempt is made.
+	PingAttempts int
+
+	// PingRetryDelay is the delay before the second Ping attempt. The delay is doubled before each next attempt.
+	// If it is zero, 500 milliseconds are used.
+	PingRetryDelay time.Duration
+
+	// SlowOperationThreshold is the duration of GetValue, SetValue or Expire operation after which the operation is logged as slow.
+	// If it is zero, slow operations are not logged.
+	SlowOperationThreshold time.Duration
 }
 
 // New returns Redis implementation of Cache interface.
 // In case of problem with connection to Redis returns error.
 func New(ctx context.Context, addr string) (*Cache, error) {
-	rc := Cache{redis.NewClient(&redis.Options{Addr: addr})}
-	_, err := rc.Ping(ctx).Result()
-	if err != nil {
+	return NewWithOptions(ctx, addr, &Options{})
+}
+
+// NewWithOptions returns Redis implementation of Cache interface which connects to Redis using received options.
+// In case of problem with connection to Redis returns error.
+func NewWithOptions(ctx context.Context, addr string, options *Options) (*Cache, error) {
+	return newCache(ctx, newClient(addr, options), options)
+}
+
+// NewCluster returns Redis implementation of Cache interface which connects to Redis Cluster by received addresses.
+// All values of one pipeline are kept in one hash so each operation with the pipeline is processed by one node.
+// In case of problem with connection to Redis Cluster returns error.
+func NewCluster(ctx context.Context, addrs []string, options *Options) (*Cache, error) {
+	return newCache(ctx, newClusterClient(addrs, options), options)
+}
+
+// NewFailover returns Redis implementation of Cache interface which connects to Redis master using Redis Sentinel.
+// On master failover the client reconnects to the new master automatically.
+// In case of problem with connection to Redis returns error.
+func NewFailover(ctx context.Context, masterName string, sentinelAddrs []string, options *Options) (*Cache, error) {
+	return newCache(ctx, newFailoverClient(masterName, sentinelAddrs, options), options)
+}
+
+// NewWithClient returns Redis implementation of Cache interface which uses received client.
+// It allows to pass the client which is decorated with redis.Hook-based instrumentation (tracing, metrics)
+// or any other implementation of redis.Cmdable. TLSConfig of options is ignored since the client is already configured.
+// In case of problem with connection to Redis returns error.
+func NewWithClient(ctx context.Context, client redis.Cmdable, options *Options) (*Cache, error) {
+	return newCache(ctx, client, options)
+}
+
+// newCache returns Redis implementation of Cache interface which uses received client.
+// In case of problem with connection to Redis returns error.
+func newCache(ctx context.Context, client redis.Cmdable, options *Options) (*Cache, error) {
+	keyExpirationTime := options.KeyExpirationTime
+	if keyExpirationTime == 0 {
+		keyExpirationTime = defaultKeyExpirationTime
+	}
+	operationTimeout := options.OperationTimeout
+	if operationTimeout == 0 {
+		operationTimeout = defaultOperationTimeout
+	}
+	preloadExpirationTime := options.PreloadExpirationTime
+	if preloadExpirationTime == 0 {
+		preloadExpirationTime = defaultPreloadExpirationTime
+	}
+	rc := Cache{
+		Cmdable:                client,
+		keyPrefix:              options.KeyPrefix,
+		keyExpirationTime:      keyExpirationTime,
+		subKeyExpirationTimes:  options.SubKeyExpirationTimes,
+		preloadExpirationTime:  preloadExpirationTime,
+		codec:                  options.Codec,
+		compressionThreshold:   options.CompressionThreshold,
+		maxValueBytes:          options.MaxValueBytes,
+		truncateLargeValues:    options.TruncateLargeValues,
+		operationTimeout:       operationTimeout,
+		metrics:                options.Metrics,
+		tracer:                 options.Tracer,
+		slowOperationThreshold: options.SlowOperationThreshold,
+	}
+	pingAttempts := options.PingAttempts
+	if pingAttempts == 0 {
+		pingAttempts = 1
+	}
+	pingRetryDelay := options.PingRetryDelay
+	if pingRetryDelay == 0 {
+		pingRetryDelay = defaultPingRetryDelay
+	}
+	if err := rc.pingWithRetry(ctx, pingAttempts, pingRetryDelay); err != nil {
 		logger.Errorf("Redis Cache: connect to Redis: error during Ping operation, err: %s\n", err.Error())
 		return nil, err
 	}
 	return &rc, nil
 }
 
+// pingWithRetry checks the connection to Redis using Ping operation.
+// If Ping operation fails, pingWithRetry repeats it with exponential backoff until attempts are exhausted or ctx is done.
+func (rc *Cache) pingWithRetry(ctx context.Context, attempts int, delay time.Duration) error {
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			logger.Warnf("Redis Cache: connect to Redis: Ping operation failed, retry in %s, err: %s\n", delay, err.Error())
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+		pingCtx, cancel := rc.withTimeout(ctx)
+		_, err = rc.Ping(pingCtx).Result()
+		cancel()
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// newClient returns Redis client configured according to received options
+func newClient(addr string, options *Options) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:         addr,
+		Username:     options.Username,
+		Password:     options.Password,
+		DB:           options.DB,
+		TLSConfig:    options.TLSConfig,
+		PoolSize:     options.PoolSize,
+		MinIdleConns: options.MinIdleConns,
+		PoolTimeout:  options.PoolTimeout,
+	})
+}
+
+// newClusterClient returns Redis Cluster client configured according to received options
+func newClusterClient(addrs []string, options *Options) *redis.ClusterClient {
+	return redis.NewClusterClient(&redis.ClusterOptions{
+		Addrs:        addrs,
+		Username:     options.Username,
+		Password:     options.Password,
+		TLSConfig:    options.TLSConfig,
+		PoolSize:     options.PoolSize,
+		MinIdleConns: options.MinIdleConns,
+		PoolTimeout:  options.PoolTimeout,
+	})
+}
+
+// newFailoverClient returns Redis client which uses Redis Sentinel and is configured according to received options
+func newFailoverClient(masterName string, sentinelAddrs []string, options *Options) *redis.Client {
+	return redis.NewFailoverClient(&redis.FailoverOptions{
+		MasterName:    masterName,
+		SentinelAddrs: sentinelAddrs,
+		Username:      options.Username,
+		Password:      options.Password,
+		DB:            options.DB,
+		TLSConfig:     options.TLSConfig,
+		PoolSize:      options.PoolSize,
+		MinIdleConns:  options.MinIdleConns,
+		PoolTimeout:   options.PoolTimeout,
+	})
+}
+
 func (rc *Cache) GetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
+	ctx, span := rc.startSpan(ctx, "GetValue", pipelineId, subKey)
+	defer span.End()
+
+	start := time.Now()
+	value, err := rc.getValue(ctx, pipelineId, subKey)
+	rc.checkSlowOperation("GetValue", pipelineId, subKey, start)
+	switch {
+	case err == nil:
+		rc.recorder().ObserveGetValue(true, time.Since(start))
+	case errors.Is(err, cache.ErrNotFound):
+		rc.recorder().ObserveGetValue(false, time.Since(start))
+	default:
+		rc.recorder().ObserveError("GetValue")
+		recordSpanError(span, err)
+	}
+	return value, err
+}
+
+// getValue returns value from Redis by pipelineId and subKey
+func (rc *Cache) getValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
 	subKeyMarsh, err := json.Marshal(subKey)
 	if err != nil {
 		logger.Errorf("Redis Cache: get value: error during marshal subKey: %s, err: %s\n", subKey, err.Error())
 		return nil, err
 	}
-	value, err := rc.HGet(ctx, pipelineId.String(), string(subKeyMarsh)).Result()
+	var value string
+	if _, separate := rc.subKeyExpirationTimes[subKey]; separate {
+		value, err = rc.Get(ctx, rc.subKeyKey(pipelineId, subKey)).Result()
+	} else {
+		value, err = rc.HGet(ctx, rc.key(pipelineId), string(subKeyMarsh)).Result()
+	}
+	if errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("key: %s, subKey: %s: %w", rc.key(pipelineId), subKey, cache.ErrNotFound)
+	}
 	if err != nil {
-		logger.Errorf("Redis Cache: get value: error during HGet operation for key: %s, subKey: %s, err: %s\n", pipelineId.String(), subKey, err.Error())
+		logger.Errorf("Redis Cache: get value: error during get operation for key: %s, subKey: %s, err: %s\n", rc.key(pipelineId), subKey, err.Error())
+		if cache.IsConnectionError(err) {
+			return nil, cache.NewConnectionError(err)
+		}
 		return nil, err
 	}
 
-	return unmarshalBySubKey(subKey, value)
+	decoded, err := decodeValue(rc.valueCodec(), subKey, value)
+	if err != nil {
+		return nil, cache.NewDecodeError(err)
+	}
+	return decoded, nil
+}
+
+// GetValueWithTTL returns value from Redis by pipelineId and subKey together with remaining time to live of its key.
+// HGet (or Get for subKeys with own expiration time) and TTL operations are sent in one pipeline.
+func (rc *Cache) GetValueWithTTL(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, time.Duration, error) {
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
+	subKeyMarsh, err := json.Marshal(subKey)
+	if err != nil {
+		logger.Errorf("Redis Cache: get value with ttl: error during marshal subKey: %s, err: %s\n", subKey, err.Error())
+		return nil, 0, err
+	}
+	key := rc.key(pipelineId)
+	_, separate := rc.subKeyExpirationTimes[subKey]
+	if separate {
+		key = rc.subKeyKey(pipelineId, subKey)
+	}
+	var valueCmd *redis.StringCmd
+	var ttlCmd *redis.DurationCmd
+	// the error of the pipeline is the error of the first failed command, so results are checked separately
+	_, _ = rc.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		if separate {
+			valueCmd = pipe.Get(ctx, key)
+		} else {
+			valueCmd = pipe.HGet(ctx, key, string(subKeyMarsh))
+		}
+		ttlCmd = pipe.TTL(ctx, key)
+		return nil
+	})
+	value, err := valueCmd.Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, 0, fmt.Errorf("key: %s, subKey: %s: %w", rc.key(pipelineId), subKey, cache.ErrNotFound)
+	}
+	if err != nil {
+		logger.Errorf("Redis Cache: get value with ttl: error during get operation for key: %s, subKey: %s, err: %s\n", key, subKey, err.Error())
+		if cache.IsConnectionError(err) {
+			return nil, 0, cache.NewConnectionError(err)
+		}
+		return nil, 0, err
+	}
+	ttl, err := ttlCmd.Result()
+	if err != nil {
+		logger.Errorf("Redis Cache: get value with ttl: error during TTL operation for key: %s, err: %s\n", key, err.Error())
+		if cache.IsConnectionError(err) {
+			return nil, 0, cache.NewConnectionError(err)
+		}
+		return nil, 0, err
+	}
+	// TTL returns -1 if the key doesn't expire and -2 if the key doesn't exist anymore
+	switch ttl {
+	case -1:
+		ttl = cache.NoExpiration
+	case -2:
+		return nil, 0, fmt.Errorf("key: %s, subKey: %s: %w", rc.key(pipelineId), subKey, cache.ErrNotFound)
+	}
+
+	decoded, err := decodeValue(rc.valueCodec(), subKey, value)
+	if err != nil {
+		return nil, 0, cache.NewDecodeError(err)
+	}
+	return decoded, ttl, nil
+}
+
+func (rc *Cache) GetValues(ctx context.Context, pipelineId uuid.UUID, subKeys []cache.SubKey) (map[cache.SubKey]interface{}, error) {
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
+	result := make(map[cache.SubKey]interface{}, len(subKeys))
+	hashSubKeys := make([]cache.SubKey, 0, len(subKeys))
+	subKeysMarsh := make([]string, 0, len(subKeys))
+	for _, subKey := range subKeys {
+		if _, separate := rc.subKeyExpirationTimes[subKey]; separate {
+			value, err := rc.Get(ctx, rc.subKeyKey(pipelineId, subKey)).Result()
+			if errors.Is(err, redis.Nil) {
+				continue
+			}
+			if err != nil {
+				logger.Errorf("Redis Cache: get values: error during Get operation for key: %s, err: %s\n", rc.subKeyKey(pipelineId, subKey), err.Error())
+				if cache.IsConnectionError(err) {
+					return nil, cache.NewConnectionError(err)
+				}
+				return nil, err
+			}
+			unmarshalledValue, err := decodeValue(rc.valueCodec(), subKey, value)
+			if err != nil {
+				return nil, cache.NewDecodeError(err)
+			}
+			result[subKey] = unmarshalledValue
+			continue
+		}
+		subKeyMarsh, err := json.Marshal(subKey)
+		if err != nil {
+			logger.Errorf("Redis Cache: get values: error during marshal subKey: %s, err: %s\n", subKey, err.Error())
+			return nil, err
+		}
+		hashSubKeys = append(hashSubKeys, subKey)
+		subKeysMarsh = append(subKeysMarsh, string(subKeyMarsh))
+	}
+	if len(hashSubKeys) == 0 {
+		return result, nil
+	}
+	values, err := rc.HMGet(ctx, rc.key(pipelineId), subKeysMarsh...).Result()
+	if err != nil {
+		logger.Errorf("Redis Cache: get values: error during HMGet operation for key: %s, subKeys: %s, err: %s\n", rc.key(pipelineId), hashSubKeys, err.Error())
+		if cache.IsConnectionError(err) {
+			return nil, cache.NewConnectionError(err)
+		}
+		return nil, err
+	}
+
+	for i, value := range values {
+		stringValue, ok := value.(string)
+		if !ok {
+			// HMGet returns nil for fields that don't exist
+			continue
+		}
+		unmarshalledValue, err := decodeValue(rc.valueCodec(), hashSubKeys[i], stringValue)
+		if err != nil {
+			return nil, cache.NewDecodeError(err)
+		}
+		result[hashSubKeys[i]] = unmarshalledValue
+	}
+	return result, nil
+}
+
+// ScanValues returns all values of the pipeline using HScan operation for the hash of the pipeline
+// and Get operations for subKeys with own expiration time.
+// Fields which can't be parsed as subKeys are returned by their names as raw strings.
+// The reserved field with the creation time of the pipeline isn't returned.
+func (rc *Cache) ScanValues(ctx context.Context, pipelineId uuid.UUID) (map[cache.SubKey]interface{}, error) {
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
+	result := make(map[cache.SubKey]interface{})
+	var cursor uint64
+	for {
+		fields, nextCursor, err := rc.HScan(ctx, rc.key(pipelineId), cursor, "", scanCount).Result()
+		if err != nil {
+			logger.Errorf("Redis Cache: scan values: error during HScan operation for key: %s, cursor: %d, err: %s\n", rc.key(pipelineId), cursor, err.Error())
+			return nil, err
+		}
+		// HScan returns names and values of the fields one after another
+		for i := 0; i+1 < len(fields); i += 2 {
+			field, value := fields[i], fields[i+1]
+			var subKey cache.SubKey
+			if err := json.Unmarshal([]byte(field), &subKey); err != nil {
+				result[cache.SubKey(field)] = value
+				continue
+			}
+			if subKey == createdAtSubKey {
+				continue
+			}
+			decoded, err := decodeValue(rc.valueCodec(), subKey, value)
+			if err != nil {
+				return nil, err
+			}
+			result[subKey] = decoded
+		}
+		if nextCursor == 0 {
+			break
+		}
+		cursor = nextCursor
+	}
+	for _, subKey := range rc.separateSubKeys() {
+		value, err := rc.Get(ctx, rc.subKeyKey(pipelineId, subKey)).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			logger.Errorf("Redis Cache: scan values: error during Get operation for key: %s, err: %s\n", rc.subKeyKey(pipelineId, subKey), err.Error())
+			return nil, err
+		}
+		decoded, err := decodeValue(rc.valueCodec(), subKey, value)
+		if err != nil {
+			return nil, err
+		}
+		result[subKey] = decoded
+	}
+	return result, nil
+}
+
+// GetPipelines returns ids of all pipelines which are kept in Redis using Scan operation.
+// Keys which can't be parsed as pipeline ids are skipped.
+func (rc *Cache) GetPipelines(ctx context.Context) ([]uuid.UUID, error) {
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
+	cluster, ok := rc.Cmdable.(*redis.ClusterClient)
+	if !ok {
+		return rc.scanPipelines(ctx, rc.Cmdable)
+	}
+	// keys are distributed between nodes of the cluster, so each master is scanned separately
+	mu := sync.Mutex{}
+	pipelines := make([]uuid.UUID, 0)
+	err := cluster.ForEachMaster(ctx, func(ctx context.Context, client *redis.Client) error {
+		nodePipelines, err := rc.scanPipelines(ctx, client)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		pipelines = append(pipelines, nodePipelines...)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pipelines, nil
+}
+
+// GetAge returns the time passed since the creation time which is kept in the reserved hash field of the pipeline
+func (rc *Cache) GetAge(ctx context.Context, pipelineId uuid.UUID) (time.Duration, error) {
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
+	createdAtMarsh, err := json.Marshal(createdAtSubKey)
+	if err != nil {
+		logger.Errorf("Redis Cache: get age: error during marshal subKey: %s, err: %s\n", createdAtSubKey, err.Error())
+		return 0, err
+	}
+	value, err := rc.HGet(ctx, rc.key(pipelineId), string(createdAtMarsh)).Result()
+	if errors.Is(err, redis.Nil) {
+		return 0, fmt.Errorf("key: %s, subKey: %s: %w", rc.key(pipelineId), createdAtSubKey, cache.ErrNotFound)
+	}
+	if err != nil {
+		logger.Errorf("Redis Cache: get age: error during HGet operation for key: %s, err: %s\n", rc.key(pipelineId), err.Error())
+		return 0, err
+	}
+	var createdAt int64
+	if err = rc.valueCodec().Unmarshal([]byte(value), &createdAt); err != nil {
+		logger.Errorf("Redis Cache: get age: error during unmarshal creation time of key: %s, err: %s\n", rc.key(pipelineId), err.Error())
+		return 0, err
+	}
+	return rc.currentTime().Sub(time.Unix(0, createdAt)), nil
+}
+
+// GetSubKeyCount returns the number of subKeys of the pipeline using HLen operation.
+// The reserved field with the creation time of the pipeline isn't counted.
+func (rc *Cache) GetSubKeyCount(ctx context.Context, pipelineId uuid.UUID) (int, error) {
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
+	count, err := rc.HLen(ctx, rc.key(pipelineId)).Result()
+	if err != nil {
+		logger.Errorf("Redis Cache: get subKey count: error during HLen operation for key: %s, err: %s\n", rc.key(pipelineId), err.Error())
+		return 0, err
+	}
+	if count > 0 {
+		createdAtMarsh, err := json.Marshal(createdAtSubKey)
+		if err != nil {
+			logger.Errorf("Redis Cache: get subKey count: error during marshal subKey: %s, err: %s\n", createdAtSubKey, err.Error())
+			return 0, err
+		}
+		created, err := rc.HExists(ctx, rc.key(pipelineId), string(createdAtMarsh)).Result()
+		if err != nil {
+			logger.Errorf("Redis Cache: get subKey count: error during HExists operation for key: %s, err: %s\n", rc.key(pipelineId), err.Error())
+			return 0, err
+		}
+		if created {
+			count--
+		}
+	}
+	for _, subKey := range rc.separateSubKeys() {
+		exists, err := rc.Exists(ctx, rc.subKeyKey(pipelineId, subKey)).Result()
+		if err != nil {
+			logger.Errorf("Redis Cache: get subKey count: error during Exists operation for key: %s, err: %s\n", rc.subKeyKey(pipelineId, subKey), err.Error())
+			return 0, err
+		}
+		count += exists
+	}
+	return int(count), nil
 }
 
 func (rc *Cache) SetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) error {
+	ctx, span := rc.startSpan(ctx, "SetValue", pipelineId, subKey)
+	defer span.End()
+
+	start := time.Now()
+	err := rc.setValue(ctx, pipelineId, subKey, value)
+	rc.checkSlowOperation("SetValue", pipelineId, subKey, start)
+	if err != nil {
+		rc.recorder().ObserveError("SetValue")
+		recordSpanError(span, err)
+		return err
+	}
+	rc.recorder().ObserveSetValue(time.Since(start))
+	return nil
+}
+
+// setValue adds value to Redis by pipelineId and subKey
+func (rc *Cache) setValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) error {
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
 	subKeyMarsh, err := json.Marshal(subKey)
 	if err != nil {
 		logger.Errorf("Redis Cache: set value: error during marshal subKey: %s, err: %s\n", subKey, err.Error())
 		return err
 	}
-	valueMarsh, err := json.Marshal(value)
+	valueMarsh, err := rc.encodeValue(value)
 	if err != nil {
-		logger.Errorf("Redis Cache: set value: error during marshal value: %s, err: %s\n", value, err.Error())
+		logger.Errorf("Redis Cache: set value: error during encode value: %s, err: %s\n", value, err.Error())
 		return err
 	}
-	_, err = rc.HSet(ctx, pipelineId.String(), subKeyMarsh, valueMarsh).Result()
+	if expTime, separate := rc.subKeyExpirationTimes[subKey]; separate {
+		if err = rc.setSeparateValue(ctx, pipelineId, subKey, valueMarsh, expTime); err != nil {
+			return err
+		}
+		if subKey == cache.Status {
+			rc.publishStatus(ctx, pipelineId, valueMarsh)
+		}
+		return nil
+	}
+	exists, err := rc.Exists(ctx, rc.key(pipelineId)).Result()
+	if err != nil {
+		logger.Errorf("Redis Cache: set value: error during Exists operation for key: %s, err: %s\n", rc.key(pipelineId), err.Error())
+		return err
+	}
+	_, err = rc.HSet(ctx, rc.key(pipelineId), subKeyMarsh, valueMarsh).Result()
 	if err != nil {
 		logger.Errorf("Redis Cache: set value: error during HSet operation, err: %s\n", err.Error())
 		return err
 	}
+	if exists == 0 {
+		if err = rc.setCreatedAt(ctx, pipelineId); err != nil {
+			return err
+		}
+		// set expiration time only for the new key to not extend it for each update of the pipeline
+		expireStart := time.Now()
+		_, err = rc.Expire(ctx, rc.key(pipelineId), rc.keyExpirationTime).Result()
+		rc.checkSlowOperation("Expire", pipelineId, "", expireStart)
+		if err != nil {
+			logger.Errorf("Redis Cache: set value: error during Expire operation for key: %s, err: %s\n", rc.key(pipelineId), err.Error())
+			return err
+		}
+	}
+	if subKey == cache.Status {
+		rc.publishStatus(ctx, pipelineId, valueMarsh)
+	}
+	return nil
+}
+
+// SetValues adds all values to Redis by pipelineId using one HSet operation.
+// Values of subKeys with own expiration time are set separately before the HSet operation.
+func (rc *Cache) SetValues(ctx context.Context, pipelineId uuid.UUID, values map[cache.SubKey]interface{}) error {
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
+	if len(values) == 0 {
+		return nil
+	}
+	subKeys := make([]string, 0, len(values))
+	for subKey := range values {
+		subKeys = append(subKeys, string(subKey))
+	}
+	// sort subKeys to send them to Redis in the same order each time
+	sort.Strings(subKeys)
+	pairs := make([]interface{}, 0, len(values)*2)
+	var statusMarsh []byte
+	for _, subKey := range subKeys {
+		subKeyMarsh, err := json.Marshal(subKey)
+		if err != nil {
+			logger.Errorf("Redis Cache: set values: error during marshal subKey: %s, err: %s\n", subKey, err.Error())
+			return err
+		}
+		valueMarsh, err := rc.encodeValue(values[cache.SubKey(subKey)])
+		if err != nil {
+			logger.Errorf("Redis Cache: set values: error during encode value: %s, err: %s\n", values[cache.SubKey(subKey)], err.Error())
+			return err
+		}
+		if cache.SubKey(subKey) == cache.Status {
+			statusMarsh = valueMarsh
+		}
+		if expTime, separate := rc.subKeyExpirationTimes[cache.SubKey(subKey)]; separate {
+			if err = rc.setSeparateValue(ctx, pipelineId, cache.SubKey(subKey), valueMarsh, expTime); err != nil {
+				return err
+			}
+			continue
+		}
+		pairs = append(pairs, subKeyMarsh, valueMarsh)
+	}
+	if len(pairs) == 0 {
+		if statusMarsh != nil {
+			rc.publishStatus(ctx, pipelineId, statusMarsh)
+		}
+		return nil
+	}
+	exists, err := rc.Exists(ctx, rc.key(pipelineId)).Result()
+	if err != nil {
+		logger.Errorf("Redis Cache: set values: error during Exists operation for key: %s, err: %s\n", rc.key(pipelineId), err.Error())
+		return err
+	}
+	_, err = rc.HSet(ctx, rc.key(pipelineId), pairs...).Result()
+	if err != nil {
+		logger.Errorf("Redis Cache: set values: error during HSet operation, err: %s\n", err.Error())
+		return err
+	}
+	if exists == 0 {
+		if err = rc.setCreatedAt(ctx, pipelineId); err != nil {
+			return err
+		}
+		// set expiration time only for the new key to not extend it for each update of the pipeline
+		expireStart := time.Now()
+		_, err = rc.Expire(ctx, rc.key(pipelineId), rc.keyExpirationTime).Result()
+		rc.checkSlowOperation("Expire", pipelineId, "", expireStart)
+		if err != nil {
+			logger.Errorf("Redis Cache: set values: error during Expire operation for key: %s, err: %s\n", rc.key(pipelineId), err.Error())
+			return err
+		}
+	}
+	if statusMarsh != nil {
+		rc.publishStatus(ctx, pipelineId, statusMarsh)
+	}
+	return nil
+}
+
+// Preload sets values of all pipelines using HSet and Expire operations which are sent in one MULTI/EXEC transaction.
+// Subkeys with own expiration time are set using Set operation with their expiration time in the same transaction.
+// The creation time is set using HSetNX operation, so the creation time of existing pipelines is kept.
+func (rc *Cache) Preload(ctx context.Context, pipelines map[uuid.UUID]map[cache.SubKey]interface{}) error {
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
+	if len(pipelines) == 0 {
+		return nil
+	}
+	createdAtMarsh, createdAtValue, err := rc.createdAt()
+	if err != nil {
+		logger.Errorf("Redis Cache: preload: error during encode creation time, err: %s\n", err.Error())
+		return err
+	}
+	pipelineIds := make([]uuid.UUID, 0, len(pipelines))
+	for pipelineId := range pipelines {
+		pipelineIds = append(pipelineIds, pipelineId)
+	}
+	// sort pipelines to send them to Redis in the same order each time
+	sort.Slice(pipelineIds, func(i, j int) bool {
+		return pipelineIds[i].String() < pipelineIds[j].String()
+	})
+
+	_, err = rc.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, pipelineId := range pipelineIds {
+			values := pipelines[pipelineId]
+			subKeys := make([]string, 0, len(values))
+			for subKey := range values {
+				subKeys = append(subKeys, string(subKey))
+			}
+			sort.Strings(subKeys)
+			pairs := make([]interface{}, 0, len(values)*2)
+			for _, subKey := range subKeys {
+				valueMarsh, err := rc.encodeValue(values[cache.SubKey(subKey)])
+				if err != nil {
+					logger.Errorf("Redis Cache: preload: error during encode value: %s, err: %s\n", values[cache.SubKey(subKey)], err.Error())
+					return err
+				}
+				if expTime, separate := rc.subKeyExpirationTimes[cache.SubKey(subKey)]; separate {
+					pipe.Set(ctx, rc.subKeyKey(pipelineId, cache.SubKey(subKey)), valueMarsh, expTime)
+					continue
+				}
+				subKeyMarsh, err := json.Marshal(subKey)
+				if err != nil {
+					logger.Errorf("Redis Cache: preload: error during marshal subKey: %s, err: %s\n", subKey, err.Error())
+					return err
+				}
+				pairs = append(pairs, subKeyMarsh, valueMarsh)
+			}
+			if len(pairs) == 0 {
+				continue
+			}
+			pipe.HSet(ctx, rc.key(pipelineId), pairs...)
+			pipe.HSetNX(ctx, rc.key(pipelineId), string(createdAtMarsh), createdAtValue)
+			pipe.Expire(ctx, rc.key(pipelineId), rc.preloadExpirationTime)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Errorf("Redis Cache: preload: error during transaction for %d pipelines, err: %s\n", len(pipelines), err.Error())
+		return err
+	}
+	return nil
+}
+
+// SetStatusIfNotTerminal sets status of the pipeline unless the current status is terminal.
+// The check and the update are done atomically by Lua script.
+func (rc *Cache) SetStatusIfNotTerminal(ctx context.Context, pipelineId uuid.UUID, status pb.Status) (bool, error) {
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
+	statusMarsh, err := rc.encodeValue(status)
+	if err != nil {
+		logger.Errorf("Redis Cache: set status if not terminal: error during encode status: %s, err: %s\n", status, err.Error())
+		return false, err
+	}
+	key := rc.key(pipelineId)
+	field := ""
+	expTime := rc.keyExpirationTime
+	if subKeyExpTime, separate := rc.subKeyExpirationTimes[cache.Status]; separate {
+		key = rc.subKeyKey(pipelineId, cache.Status)
+		expTime = subKeyExpTime
+	} else {
+		subKeyMarsh, err := json.Marshal(cache.Status)
+		if err != nil {
+			logger.Errorf("Redis Cache: set status if not terminal: error during marshal subKey: %s, err: %s\n", cache.Status, err.Error())
+			return false, err
+		}
+		field = string(subKeyMarsh)
+	}
+	args := []interface{}{field, statusMarsh, expTime.Milliseconds()}
+	for _, terminalStatus := range cache.TerminalStatuses() {
+		terminalMarsh, err := rc.encodeValue(terminalStatus)
+		if err != nil {
+			logger.Errorf("Redis Cache: set status if not terminal: error during encode status: %s, err: %s\n", terminalStatus, err.Error())
+			return false, err
+		}
+		args = append(args, terminalMarsh)
+	}
+	set, err := setStatusIfNotTerminalScript.Run(ctx, rc, []string{key}, args...).Int()
+	if err != nil {
+		logger.Errorf("Redis Cache: set status if not terminal: error during script execution for key: %s, err: %s\n", key, err.Error())
+		return false, err
+	}
+	if set == 0 {
+		return false, nil
+	}
+	rc.publishStatus(ctx, pipelineId, statusMarsh)
+	return true, nil
+}
+
+// SubscribeStatus returns channel which receives statuses of the pipeline which are set after the subscription.
+// The channel is closed when ctx is done.
+func (rc *Cache) SubscribeStatus(ctx context.Context, pipelineId uuid.UUID) (<-chan pb.Status, error) {
+	client, ok := rc.Cmdable.(subscriber)
+	if !ok {
+		logger.Errorf("Redis Cache: subscribe status: Redis client doesn't support subscription\n")
+		return nil, fmt.Errorf("redis client doesn't support subscription")
+	}
+	pubSub := client.Subscribe(ctx, rc.statusChannel(pipelineId))
+	receiveCtx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+	// wait for the confirmation to not miss statuses which are published right after SubscribeStatus
+	if _, err := pubSub.Receive(receiveCtx); err != nil {
+		logger.Errorf("Redis Cache: subscribe status: error during Subscribe operation for channel: %s, err: %s\n", rc.statusChannel(pipelineId), err.Error())
+		_ = pubSub.Close()
+		return nil, err
+	}
+	statuses := make(chan pb.Status)
+	go func() {
+		defer pubSub.Close()
+		forwardStatuses(ctx, rc.valueCodec(), pubSub.Channel(), statuses)
+	}()
+	return statuses, nil
+}
+
+func (rc *Cache) DeleteValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) error {
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
+	if _, separate := rc.subKeyExpirationTimes[subKey]; separate {
+		_, err := rc.Del(ctx, rc.subKeyKey(pipelineId, subKey)).Result()
+		if err != nil {
+			logger.Errorf("Redis Cache: delete value: error during Del operation for key: %s, err: %s\n", rc.subKeyKey(pipelineId, subKey), err.Error())
+			return err
+		}
+		return nil
+	}
+	subKeyMarsh, err := json.Marshal(subKey)
+	if err != nil {
+		logger.Errorf("Redis Cache: delete value: error during marshal subKey: %s, err: %s\n", subKey, err.Error())
+		return err
+	}
+	_, err = rc.HDel(ctx, rc.key(pipelineId), string(subKeyMarsh)).Result()
+	if err != nil {
+		logger.Errorf("Redis Cache: delete value: error during HDel operation for key: %s, subKey: %s, err: %s\n", rc.key(pipelineId), subKey, err.Error())
+		return err
+	}
+	return nil
+}
+
+func (rc *Cache) DeletePipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
+	return rc.deletePipeline(ctx, pipelineId, "delete pipeline")
+}
+
+// deletePipeline removes the pipeline's key and keys of its subKeys with own expiration time using Del operation.
+// Keys are deleted one by one since keys of a cluster can be kept in different slots.
+func (rc *Cache) deletePipeline(ctx context.Context, pipelineId uuid.UUID, operation string) error {
+	keys := []string{rc.key(pipelineId)}
+	for _, subKey := range rc.separateSubKeys() {
+		keys = append(keys, rc.subKeyKey(pipelineId, subKey))
+	}
+	for _, key := range keys {
+		_, err := rc.Del(ctx, key).Result()
+		if err != nil {
+			logger.Errorf("Redis Cache: %s: error during Del operation for key: %s, err: %s\n", operation, key, err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+// FlushAll removes all pipelines under the key prefix from Redis using Scan and Del operations.
+// Keys which aren't pipelines of the playground are kept, so FLUSHDB is never used.
+func (rc *Cache) FlushAll(ctx context.Context) error {
+	pipelines, err := rc.GetPipelines(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
+	for _, pipelineId := range pipelines {
+		if err = rc.deletePipeline(ctx, pipelineId, "flush all"); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (rc *Cache) SetExpTime(ctx context.Context, pipelineId uuid.UUID, expTime time.Duration) error {
-	exists, err := rc.Exists(ctx, pipelineId.String()).Result()
+	ctx, span := rc.startSpan(ctx, "SetExpTime", pipelineId, "")
+	defer span.End()
+
+	err := rc.setExpTime(ctx, pipelineId, expTime)
+	if err != nil {
+		recordSpanError(span, err)
+	}
+	return err
+}
+
+// setExpTime sets expiration time of the pipeline's key using Expire operation
+func (rc *Cache) setExpTime(ctx context.Context, pipelineId uuid.UUID, expTime time.Duration) error {
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
+	exists, err := rc.Exists(ctx, rc.key(pipelineId)).Result()
 	if err != nil {
-		logger.Errorf("Redis Cache: set expiration time value: error during Exists operation for key: %s, err: %s\n", pipelineId, err.Error())
+		logger.Errorf("Redis Cache: set expiration time value: error during Exists operation for key: %s, err: %s\n", rc.key(pipelineId), err.Error())
 		return err
 	}
 	if exists == 0 {
-		logger.Errorf("Redis Cache: set expiration time value: key doesn't exist, key: %s\n", pipelineId)
-		return fmt.Errorf("key: %s doesn't exist", pipelineId)
+		logger.Errorf("Redis Cache: set expiration time value: key doesn't exist, key: %s\n", rc.key(pipelineId))
+		return fmt.Errorf("key: %s doesn't exist: %w", rc.key(pipelineId), cache.ErrNotFound)
 	}
 
-	_, err = rc.Expire(ctx, pipelineId.String(), expTime).Result()
+	expireStart := time.Now()
+	_, err = rc.Expire(ctx, rc.key(pipelineId), expTime).Result()
+	rc.checkSlowOperation("Expire", pipelineId, "", expireStart)
 	if err != nil {
-		logger.Errorf("Redis Cache: set expiration time value: error during Expire operation for key: %s, err: %s\n", pipelineId, err.Error())
+		logger.Errorf("Redis Cache: set expiration time value: error during Expire operation for key: %s, err: %s\n", rc.key(pipelineId), err.Error())
 		return err
 	}
+	// subKeys with own expiration time don't outlive the pipeline
+	for _, subKey := range rc.separateSubKeys() {
+		subKeyExpTime := rc.subKeyExpirationTimes[subKey]
+		if expTime < subKeyExpTime {
+			subKeyExpTime = expTime
+		}
+		_, err = rc.Expire(ctx, rc.subKeyKey(pipelineId, subKey), subKeyExpTime).Result()
+		if err != nil {
+			logger.Errorf("Redis Cache: set expiration time value: error during Expire operation for key: %s, err: %s\n", rc.subKeyKey(pipelineId, subKey), err.Error())
+			return err
+		}
+	}
 	return nil
 }
 
-// unmarshalBySubKey unmarshal value by subKey
-func unmarshalBySubKey(subKey cache.SubKey, value string) (interface{}, error) {
-	var result interface{}
-	switch subKey {
-	case cache.Status:
-		result = new(pb.Status)
-	case cache.RunOutput, cache.RunError, cache.ValidationOutput, cache.PreparationOutput, cache.CompileOutput, cache.Logs, cache.Graph:
-		result = ""
-	case cache.Canceled:
-		result = false
-	case cache.RunOutputIndex, cache.LogsIndex:
-		result = 0
+// SetSubKeyExpTime sets expiration time of the separate key of the subKey using Expire operation.
+// SubKeys which aren't in SubKeyExpirationTimes are kept in the pipeline's key, so their expiration time isn't changed.
+func (rc *Cache) SetSubKeyExpTime(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, expTime time.Duration) (bool, error) {
+	if _, separate := rc.subKeyExpirationTimes[subKey]; !separate {
+		return false, nil
 	}
-	err := json.Unmarshal([]byte(value), &result)
+	ctx, cancel := rc.withTimeout(ctx)
+	defer cancel()
+
+	exists, err := rc.Expire(ctx, rc.subKeyKey(pipelineId, subKey), expTime).Result()
 	if err != nil {
-		logger.Errorf("Redis Cache: get value: error during unmarshal value, err: %s\n", err.Error())
+		logger.Errorf("Redis Cache: set subKey expiration time: error during Expire operation for key: %s, err: %s\n", rc.subKeyKey(pipelineId, subKey), err.Error())
+		return false, err
+	}
+	if !exists {
+		return false, fmt.Errorf("key: %s doesn't exist: %w", rc.subKeyKey(pipelineId, subKey), cache.ErrNotFound)
+	}
+	return true, nil
+}
+
+// TouchPipeline sets the key expiration time of the cache to the pipeline's key using Exists and Expire operations
+func (rc *Cache) TouchPipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	return rc.setExpTime(ctx, pipelineId, rc.keyExpirationTime)
+}
+
+// HealthCheck checks the connection to Redis using Ping operation
+func (rc *Cache) HealthCheck(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	_, err := rc.Ping(ctx).Result()
+	if err != nil {
+		logger.Errorf("Redis Cache: health check: error during Ping operation, err: %s\n", err.Error())
+		return err
+	}
+	return nil
+}
+
+// Close closes the client of Redis if the client can be closed.
+// Clients which are passed to NewWithClient are closed as well.
+func (rc *Cache) Close() error {
+	closer, ok := rc.Cmdable.(io.Closer)
+	if !ok {
+		return nil
+	}
+	if err := closer.Close(); err != nil {
+		logger.Errorf("Redis Cache: close: error during closing of the client, err: %s\n", err.Error())
+		return err
+	}
+	return nil
+}
+
+// withTimeout returns a copy of the context which is done after operation timeout is passed
+func (rc *Cache) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if rc.operationTimeout == 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, rc.operationTimeout)
+}
+
+// scanPipelines returns ids of all pipelines which are kept in Redis node using Scan operation
+func (rc *Cache) scanPipelines(ctx context.Context, client redis.Cmdable) ([]uuid.UUID, error) {
+	pipelines := make([]uuid.UUID, 0)
+	seen := make(map[uuid.UUID]struct{})
+	var cursor uint64
+	for {
+		keys, nextCursor, err := client.Scan(ctx, cursor, rc.keyPrefix+"*", scanCount).Result()
+		if err != nil {
+			logger.Errorf("Redis Cache: get pipelines: error during Scan operation, cursor: %d, err: %s\n", cursor, err.Error())
+			return nil, err
+		}
+		for _, key := range keys {
+			// keys of subKeys with own expiration time look like "<pipeline key>:<subKey>"
+			pipelineKey := strings.SplitN(strings.TrimPrefix(key, rc.keyPrefix), ":", 2)
+			pipelineId, err := uuid.Parse(pipelineKey[0])
+			if err != nil {
+				continue
+			}
+			if _, found := seen[pipelineId]; found {
+				continue
+			}
+			seen[pipelineId] = struct{}{}
+			pipelines = append(pipelines, pipelineId)
+		}
+		if nextCursor == 0 {
+			return pipelines, nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// publishStatus publishes marshaled status of the pipeline to the status channel.
+// Subscribers get statuses on a best-effort basis, so an error only is logged.
+func (rc *Cache) publishStatus(ctx context.Context, pipelineId uuid.UUID, statusMarsh []byte) {
+	_, err := rc.Publish(ctx, rc.statusChannel(pipelineId), statusMarsh).Result()
+	if err != nil {
+		logger.Errorf("Redis Cache: publish status: error during Publish operation for channel: %s, err: %s\n", rc.statusChannel(pipelineId), err.Error())
 	}
+}
 
-	switch subKey {
-	case cache.Status:
-		result = *result.(*pb.Status)
+// forwardStatuses decodes statuses from messages using codec and sends them to statuses channel until ctx is done
+// or messages channel is closed. Then statuses channel is closed.
+func forwardStatuses(ctx context.Context, codec cache.Codec, messages <-chan *redis.Message, statuses chan<- pb.Status) {
+	defer close(statuses)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+			value, err := decodeValue(codec, cache.Status, message.Payload)
+			if err != nil {
+				logger.Errorf("Redis Cache: subscribe status: error during decode status from channel: %s, err: %s\n", message.Channel, err.Error())
+				continue
+			}
+			select {
+			case statuses <- value.(pb.Status):
+			case <-ctx.Done():
+				return
+			}
+		}
 	}
+}
+
+// setCreatedAt sets the current time as the creation time of the pipeline using HSetNX operation,
+// so the creation time isn't overwritten if it is already set
+func (rc *Cache) setCreatedAt(ctx context.Context, pipelineId uuid.UUID) error {
+	createdAtMarsh, createdAtValue, err := rc.createdAt()
+	if err != nil {
+		logger.Errorf("Redis Cache: set creation time: error during encode creation time, err: %s\n", err.Error())
+		return err
+	}
+	if _, err = rc.HSetNX(ctx, rc.key(pipelineId), string(createdAtMarsh), createdAtValue).Result(); err != nil {
+		logger.Errorf("Redis Cache: set creation time: error during HSetNX operation for key: %s, err: %s\n", rc.key(pipelineId), err.Error())
+		return err
+	}
+	return nil
+}
+
+// createdAt returns the marshaled reserved subKey and the encoded current time which are kept as the creation time
+func (rc *Cache) createdAt() ([]byte, []byte, error) {
+	createdAtMarsh, err := json.Marshal(createdAtSubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	createdAtValue, err := rc.valueCodec().Marshal(rc.currentTime().UnixNano())
+	if err != nil {
+		return nil, nil, err
+	}
+	return createdAtMarsh, createdAtValue, nil
+}
+
+// currentTime returns the current time using the clock of the cache
+func (rc *Cache) currentTime() time.Time {
+	if rc.now == nil {
+		return time.Now()
+	}
+	return rc.now()
+}
+
+// subKeyKey returns the key which is used to keep the value of the subKey with own expiration time
+func (rc *Cache) subKeyKey(pipelineId uuid.UUID, subKey cache.SubKey) string {
+	return rc.key(pipelineId) + ":" + string(subKey)
+}
+
+// separateSubKeys returns sorted subKeys which have own expiration time
+func (rc *Cache) separateSubKeys() []cache.SubKey {
+	subKeys := make([]cache.SubKey, 0, len(rc.subKeyExpirationTimes))
+	for subKey := range rc.subKeyExpirationTimes {
+		subKeys = append(subKeys, subKey)
+	}
+	sort.Slice(subKeys, func(i, j int) bool {
+		return subKeys[i] < subKeys[j]
+	})
+	return subKeys
+}
+
+// setSeparateValue sets value of the subKey with own expiration time using Set operation
+func (rc *Cache) setSeparateValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, valueMarsh []byte, expTime time.Duration) error {
+	_, err := rc.Set(ctx, rc.subKeyKey(pipelineId, subKey), valueMarsh, expTime).Result()
+	if err != nil {
+		logger.Errorf("Redis Cache: set value: error during Set operation for key: %s, err: %s\n", rc.subKeyKey(pipelineId, subKey), err.Error())
+		return err
+	}
+	return nil
+}
+
+// statusChannel returns the channel which is used to publish statuses of the pipeline
+func (rc *Cache) statusChannel(pipelineId uuid.UUID) string {
+	return rc.key(pipelineId) + ":status"
+}
+
+// checkSlowOperation reports the operation which is started at start if its duration exceeds slow operation threshold
+func (rc *Cache) checkSlowOperation(operation string, pipelineId uuid.UUID, subKey cache.SubKey, start time.Time) {
+	if rc.slowOperationThreshold == 0 {
+		return
+	}
+	duration := time.Since(start)
+	if duration <= rc.slowOperationThreshold {
+		return
+	}
+	if rc.onSlowOperation != nil {
+		rc.onSlowOperation(operation, pipelineId, subKey, duration)
+		return
+	}
+	logger.Warnf("Redis Cache: slow operation: %s for key: %s, subKey: %s, duration: %s\n", operation, rc.key(pipelineId), subKey, duration)
+}
+
+// recorder returns metrics which are used to record cache operations.
+// If metrics are not set, NoOpMetrics is returned.
+func (rc *Cache) recorder() cache.Metrics {
+	if rc.metrics == nil {
+		return cache.NoOpMetrics{}
+	}
+	return rc.metrics
+}
 
-	return result, err
+// valueCodec returns codec which is used to marshal values.
+// If codec is not set, JSONCodec is returned.
+func (rc *Cache) valueCodec() cache.Codec {
+	if rc.codec == nil {
+		return cache.JSONCodec
+	}
+	return rc.codec
+}
+
+// startSpan starts span of the operation with the pipeline's subKey using the tracer.
+// If the tracer isn't set, the tracer of the global OpenTelemetry TracerProvider is used,
+// so spans aren't recorded until the provider is registered by otel.SetTracerProvider.
+func (rc *Cache) startSpan(ctx context.Context, operation string, pipelineId uuid.UUID, subKey cache.SubKey) (context.Context, trace.Span) {
+	tracer := rc.tracer
+	if tracer == nil {
+		tracer = otel.Tracer(cache.TracerName)
+	}
+	attributes := []attribute.KeyValue{
+		cache.OperationAttribute.String(operation),
+		cache.PipelineIdAttribute.String(pipelineId.String()),
+	}
+	if subKey != "" {
+		attributes = append(attributes, cache.SubKeyAttribute.String(string(subKey)))
+	}
+	return tracer.Start(ctx, "cache."+operation, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+}
+
+// recordSpanError records err as an event of the span and marks the span as failed
+func recordSpanError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// key returns the key of the pipeline in Redis
+func (rc *Cache) key(pipelineId uuid.UUID) string {
+	return rc.keyPrefix + pipelineId.String()
+}
+
+// encodeValue marshals value using codec and compresses it if its size is more than compression threshold.
+// []byte values aren't marshaled, so binary data is kept as is after rawValueHeader.
+// Values over the max size are rejected or truncated according to options of the cache.
+func (rc *Cache) encodeValue(value interface{}) ([]byte, error) {
+	var valueMarsh []byte
+	if raw, ok := value.([]byte); ok {
+		valueMarsh = make([]byte, 0, len(rawValueHeader)+len(raw))
+		valueMarsh = append(append(valueMarsh, rawValueHeader...), raw...)
+	} else {
+		var err error
+		if valueMarsh, err = rc.valueCodec().Marshal(value); err != nil {
+			return nil, err
+		}
+	}
+	if rc.maxValueBytes > 0 && len(valueMarsh) > rc.maxValueBytes {
+		stringValue, isString := value.(string)
+		if !rc.truncateLargeValues || !isString {
+			logger.Errorf("Redis Cache: set value: value size: %d bytes exceeds max size: %d bytes\n", len(valueMarsh), rc.maxValueBytes)
+			return nil, fmt.Errorf("value size: %d bytes, max size: %d bytes: %w", len(valueMarsh), rc.maxValueBytes, cache.ErrValueTooLarge)
+		}
+		truncated, err := rc.truncateValue(stringValue)
+		if err != nil {
+			return nil, err
+		}
+		logger.Warnf("Redis Cache: set value: value size: %d bytes exceeds max size: %d bytes, value is truncated\n", len(valueMarsh), rc.maxValueBytes)
+		valueMarsh = truncated
+	}
+	if rc.compressionThreshold > 0 && len(valueMarsh) > rc.compressionThreshold {
+		return compress(valueMarsh)
+	}
+	return valueMarsh, nil
+}
+
+// truncateValue cuts the end of the value and adds truncatedValueMarker, so the marshaled result fits the max size.
+// If the max size can't fit even the marker, truncateValue returns an error which wraps cache.ErrValueTooLarge.
+func (rc *Cache) truncateValue(value string) ([]byte, error) {
+	size := rc.maxValueBytes - len(truncatedValueMarker)
+	for size > 0 {
+		if size > len(value) {
+			size = len(value)
+		}
+		// the value isn't cut in the middle of a multibyte character
+		for size > 0 && size < len(value) && !utf8.RuneStart(value[size]) {
+			size--
+		}
+		valueMarsh, err := rc.valueCodec().Marshal(value[:size] + truncatedValueMarker)
+		if err != nil {
+			return nil, err
+		}
+		if len(valueMarsh) <= rc.maxValueBytes {
+			return valueMarsh, nil
+		}
+		// escaped characters and the codec's framing take more bytes than the value itself
+		size -= len(valueMarsh) - rc.maxValueBytes
+	}
+	return nil, fmt.Errorf("max size: %d bytes can't fit truncated value: %w", rc.maxValueBytes, cache.ErrValueTooLarge)
+}
+
+// decodeValue decompresses value if it was compressed and unmarshal it by subKey using codec
+func decodeValue(codec cache.Codec, subKey cache.SubKey, value string) (interface{}, error) {
+	if strings.HasPrefix(value, compressedValueHeader) {
+		decompressed, err := decompress(value)
+		if err != nil {
+			logger.Errorf("Redis Cache: get value: error during decompress value, err: %s\n", err.Error())
+			return nil, err
+		}
+		value = decompressed
+	}
+	return unmarshalBySubKey(codec, subKey, value)
+}
+
+// compress compresses value using gzip and adds compressedValueHeader at the beginning of the result
+func compress(value []byte) ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteString(compressedValueHeader)
+	writer := gzip.NewWriter(&buffer)
+	if _, err := writer.Write(value); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// decompress removes compressedValueHeader from the value and decompresses the rest of it using gzip
+func decompress(value string) (string, error) {
+	reader, err := gzip.NewReader(strings.NewReader(strings.TrimPrefix(value, compressedValueHeader)))
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	decompressed, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return string(decompressed), nil
+}
+
+// unmarshalBySubKey unmarshal value by subKey using codec and decoder which is registered in cache package.
+// Values which are kept as is after rawValueHeader are returned as []byte regardless of subKey.
+func unmarshalBySubKey(codec cache.Codec, subKey cache.SubKey, value string) (interface{}, error) {
+	if strings.HasPrefix(value, rawValueHeader) {
+		return []byte(strings.TrimPrefix(value, rawValueHeader)), nil
+	}
+	result, err := cache.DecodeWith(codec, subKey, value)
+	if err != nil {
+		logger.Errorf("Redis Cache: get value: error during unmarshal value, err: %s\n", err.Error())
+		return nil, err
+	}
+	return result, nil
 }
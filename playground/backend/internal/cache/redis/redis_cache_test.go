@@ -18,27 +18,42 @@ package redis
 import (
 	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/msgpack"
+	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/go-redis/redis/v8"
 	"github.com/go-redis/redismock/v8"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"net"
 	"reflect"
+	"strings"
+	"syscall"
 	"testing"
 	"time"
+	"unicode/utf8"
 )
 
 func TestRedisCache_GetValue(t *testing.T) {
 	pipelineId := uuid.New()
 	subKey := cache.RunOutput
 	value := "MOCK_OUTPUT"
+	keyPrefix := "MOCK_PREFIX:"
 	client, mock := redismock.NewClientMock()
 	marshSubKey, _ := json.Marshal(subKey)
 	marshValue, _ := json.Marshal(value)
 
 	type fields struct {
 		redisClient *redis.Client
+		keyPrefix   string
 	}
 	type args struct {
 		ctx        context.Context
@@ -46,33 +61,81 @@ func TestRedisCache_GetValue(t *testing.T) {
 		subKey     cache.SubKey
 	}
 	tests := []struct {
-		name    string
-		mocks   func()
-		fields  fields
-		args    args
-		want    interface{}
-		wantErr bool
+		name         string
+		mocks        func()
+		fields       fields
+		args         args
+		want         interface{}
+		wantErr      bool
+		wantNotFound bool
+		wantCategory error
 	}{
 		{
 			name: "error during HGet operation",
 			mocks: func() {
-				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetErr(fmt.Errorf("MOCK_ERROR"))
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetErr(&net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET})
 			},
-			fields: fields{client},
+			fields: fields{redisClient: client},
 			args: args{
 				ctx:        context.TODO(),
 				pipelineId: pipelineId,
 				subKey:     subKey,
 			},
-			want:    nil,
-			wantErr: true,
+			want:         nil,
+			wantErr:      true,
+			wantCategory: cache.ErrConnection,
+		},
+		{
+			name: "HGet operation returns malformed value",
+			mocks: func() {
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal("{MOCK_VALUE")
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.TODO(),
+				pipelineId: pipelineId,
+				subKey:     subKey,
+			},
+			want:         nil,
+			wantErr:      true,
+			wantCategory: cache.ErrDecode,
+		},
+		{
+			name: "HGet operation returns redis.Nil",
+			mocks: func() {
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).RedisNil()
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.TODO(),
+				pipelineId: pipelineId,
+				subKey:     subKey,
+			},
+			want:         nil,
+			wantErr:      true,
+			wantNotFound: true,
+			wantCategory: cache.ErrNotFound,
 		},
 		{
 			name: "all success",
 			mocks: func() {
 				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshValue))
 			},
-			fields: fields{client},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.TODO(),
+				pipelineId: pipelineId,
+				subKey:     subKey,
+			},
+			want:    value,
+			wantErr: false,
+		},
+		{
+			name: "all success with key prefix",
+			mocks: func() {
+				mock.ExpectHGet(keyPrefix+pipelineId.String(), string(marshSubKey)).SetVal(string(marshValue))
+			},
+			fields: fields{redisClient: client, keyPrefix: keyPrefix},
 			args: args{
 				ctx:        context.TODO(),
 				pipelineId: pipelineId,
@@ -86,13 +149,22 @@ func TestRedisCache_GetValue(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mocks()
 			rc := &Cache{
-				tt.fields.redisClient,
+				Cmdable:   tt.fields.redisClient,
+				keyPrefix: tt.fields.keyPrefix,
 			}
 			got, err := rc.GetValue(tt.args.ctx, tt.args.pipelineId, tt.args.subKey)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetValue() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if errors.Is(err, cache.ErrNotFound) != tt.wantNotFound {
+				t.Errorf("GetValue() error = %v, wantNotFound %v", err, tt.wantNotFound)
+			}
+			for _, category := range []error{cache.ErrConnection, cache.ErrDecode, cache.ErrNotFound} {
+				if errors.Is(err, category) != (category == tt.wantCategory) {
+					t.Errorf("GetValue() error = %v, wantCategory %v", err, tt.wantCategory)
+				}
+			}
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("GetValue() got = %v, want %v", got, tt.want)
 			}
@@ -101,145 +173,170 @@ func TestRedisCache_GetValue(t *testing.T) {
 	}
 }
 
-func TestRedisCache_SetExpTime(t *testing.T) {
+func TestRedisCache_GetValueWithTTL(t *testing.T) {
 	pipelineId := uuid.New()
-	expTime := time.Second
+	output := "MOCK_OUTPUT"
+	marshOutput, _ := json.Marshal(output)
+	marshSubKey, _ := json.Marshal(cache.RunOutput)
 	client, mock := redismock.NewClientMock()
 
-	type fields struct {
-		redisClient *redis.Client
-	}
-	type args struct {
-		ctx        context.Context
-		pipelineId uuid.UUID
-		expTime    time.Duration
-	}
 	tests := []struct {
-		name    string
-		mocks   func()
-		fields  fields
-		args    args
-		wantErr bool
+		name         string
+		mocks        func()
+		want         interface{}
+		wantTTL      time.Duration
+		wantErr      bool
+		wantNotFound bool
+		wantCategory error
 	}{
 		{
-			name: "error during Exists operation",
+			name: "error during HGet operation",
 			mocks: func() {
-				mock.ExpectExists(pipelineId.String()).SetErr(fmt.Errorf("MOCK_ERROR"))
-			},
-			fields: fields{client},
-			args: args{
-				ctx:        context.Background(),
-				pipelineId: pipelineId,
-				expTime:    expTime,
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetErr(fmt.Errorf("MOCK_ERROR"))
 			},
+			want:    nil,
 			wantErr: true,
 		},
 		{
-			name: "Exists operation returns 0",
+			name: "connection error during HGet operation",
 			mocks: func() {
-				mock.ExpectExists(pipelineId.String()).SetVal(0)
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetErr(&net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET})
 			},
-			fields: fields{client},
-			args: args{
-				ctx:        context.Background(),
-				pipelineId: pipelineId,
-				expTime:    expTime,
+			want:         nil,
+			wantErr:      true,
+			wantCategory: cache.ErrConnection,
+		},
+		{
+			name: "value doesn't exist",
+			mocks: func() {
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).RedisNil()
+			},
+			want:         nil,
+			wantErr:      true,
+			wantNotFound: true,
+			wantCategory: cache.ErrNotFound,
+		},
+		{
+			name: "error during TTL operation",
+			mocks: func() {
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshOutput))
+				mock.ExpectTTL(pipelineId.String()).SetErr(fmt.Errorf("MOCK_ERROR"))
 			},
+			want:    nil,
 			wantErr: true,
 		},
 		{
-			name: "error during Expire operation",
+			name: "connection error during TTL operation",
 			mocks: func() {
-				mock.ExpectExists(pipelineId.String()).SetVal(1)
-				mock.ExpectExpire(pipelineId.String(), expTime).SetErr(fmt.Errorf("MOCK_ERROR"))
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshOutput))
+				mock.ExpectTTL(pipelineId.String()).SetErr(&net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET})
 			},
-			fields: fields{client},
-			args: args{
-				ctx:        context.Background(),
-				pipelineId: pipelineId,
-				expTime:    expTime,
+			want:         nil,
+			wantErr:      true,
+			wantCategory: cache.ErrConnection,
+		},
+		{
+			name: "malformed value",
+			mocks: func() {
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal("{MOCK_VALUE")
+				mock.ExpectTTL(pipelineId.String()).SetVal(time.Minute)
 			},
-			wantErr: true,
+			want:         nil,
+			wantErr:      true,
+			wantCategory: cache.ErrDecode,
 		},
 		{
-			name: "all success",
+			name: "value with expiration time",
 			mocks: func() {
-				mock.ExpectExists(pipelineId.String()).SetVal(1)
-				mock.ExpectExpire(pipelineId.String(), expTime).SetVal(true)
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshOutput))
+				mock.ExpectTTL(pipelineId.String()).SetVal(time.Minute)
 			},
-			fields: fields{client},
-			args: args{
-				ctx:        context.Background(),
-				pipelineId: pipelineId,
-				expTime:    expTime,
+			want:    output,
+			wantTTL: time.Minute,
+		},
+		{
+			// Test case with the key which doesn't expire.
+			// As a result, want to receive cache.NoExpiration time to live.
+			name: "value without expiration time",
+			mocks: func() {
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshOutput))
+				mock.ExpectTTL(pipelineId.String()).SetVal(-1)
 			},
-			wantErr: false,
+			want:    output,
+			wantTTL: cache.NoExpiration,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mocks()
-			rc := &Cache{
-				tt.fields.redisClient,
+			rc := &Cache{Cmdable: client}
+			got, ttl, err := rc.GetValueWithTTL(context.Background(), pipelineId, cache.RunOutput)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetValueWithTTL() error = %v, wantErr %v", err, tt.wantErr)
 			}
-			if err := rc.SetExpTime(tt.args.ctx, tt.args.pipelineId, tt.args.expTime); (err != nil) != tt.wantErr {
-				t.Errorf("SetExpTime() error = %v, wantErr %v", err, tt.wantErr)
+			if errors.Is(err, cache.ErrNotFound) != tt.wantNotFound {
+				t.Errorf("GetValueWithTTL() error = %v, wantNotFound %v", err, tt.wantNotFound)
+			}
+			for _, category := range []error{cache.ErrConnection, cache.ErrDecode, cache.ErrNotFound} {
+				if errors.Is(err, category) != (category == tt.wantCategory) {
+					t.Errorf("GetValueWithTTL() error = %v, wantCategory %v", err, tt.wantCategory)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) || ttl != tt.wantTTL {
+				t.Errorf("GetValueWithTTL() got = %v, %s, want %v, %s", got, ttl, tt.want, tt.wantTTL)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("GetValueWithTTL() %s", err.Error())
 			}
 			mock.ClearExpect()
 		})
 	}
 }
 
-func TestRedisCache_SetValue(t *testing.T) {
-	pipelineId := uuid.New()
-	subKey := cache.Status
-	value := pb.Status_STATUS_FINISHED
-	client, mock := redismock.NewClientMock()
-	marshSubKey, _ := json.Marshal(subKey)
-	marshValue, _ := json.Marshal(value)
-
-	type fields struct {
-		redisClient *redis.Client
+func TestRedisCache_Preload(t *testing.T) {
+	pipelineId1, pipelineId2 := uuid.New(), uuid.New()
+	if pipelineId2.String() < pipelineId1.String() {
+		pipelineId1, pipelineId2 = pipelineId2, pipelineId1
 	}
-	type args struct {
-		ctx        context.Context
-		pipelineId uuid.UUID
-		subKey     cache.SubKey
-		value      interface{}
+	marshStatusSubKey, _ := json.Marshal(cache.Status)
+	marshOutputSubKey, _ := json.Marshal(cache.RunOutput)
+	marshStatus, _ := json.Marshal(pb.Status_STATUS_FINISHED)
+	marshOutput, _ := json.Marshal("MOCK_OUTPUT")
+	marshLogs, _ := json.Marshal("MOCK_LOGS")
+	preloadExpTime := time.Hour * 24
+	pipelines := map[uuid.UUID]map[cache.SubKey]interface{}{
+		pipelineId1: {cache.Status: pb.Status_STATUS_FINISHED, cache.RunOutput: "MOCK_OUTPUT"},
+		pipelineId2: {cache.Status: pb.Status_STATUS_FINISHED, cache.Logs: "MOCK_LOGS"},
 	}
+	client, mock := redismock.NewClientMock()
+
 	tests := []struct {
 		name    string
 		mocks   func()
-		fields  fields
-		args    args
 		wantErr bool
 	}{
 		{
-			name: "error during HSet operation",
+			name: "error during transaction",
 			mocks: func() {
-				mock.ExpectHSet(pipelineId.String(), marshSubKey, marshValue).SetErr(fmt.Errorf("MOCK_ERROR"))
-			},
-			fields: fields{client},
-			args: args{
-				ctx:        context.Background(),
-				pipelineId: pipelineId,
-				subKey:     subKey,
-				value:      value,
+				mock.ExpectTxPipeline()
+				mock.ExpectHSet(pipelineId1.String(), marshOutputSubKey, marshOutput, marshStatusSubKey, marshStatus).SetErr(fmt.Errorf("MOCK_ERROR"))
 			},
 			wantErr: true,
 		},
 		{
+			// Test case with preloading of two pipelines where Logs have own expiration time.
+			// As a result, want all commands to be sent in one transaction.
 			name: "all success",
 			mocks: func() {
-				mock.ExpectHSet(pipelineId.String(), marshSubKey, marshValue).SetVal(1)
-				mock.ExpectExpire(pipelineId.String(), time.Minute*15).SetVal(true)
-			},
-			fields: fields{client},
-			args: args{
-				ctx:        context.Background(),
-				pipelineId: pipelineId,
-				subKey:     subKey,
-				value:      value,
+				mock.ExpectTxPipeline()
+				mock.ExpectHSet(pipelineId1.String(), marshOutputSubKey, marshOutput, marshStatusSubKey, marshStatus).SetVal(2)
+				mock.Regexp().ExpectHSetNX(pipelineId1.String(), `"CREATED_AT"`, `.+`).SetVal(true)
+				mock.ExpectExpire(pipelineId1.String(), preloadExpTime).SetVal(true)
+				mock.ExpectSet(pipelineId2.String()+":"+string(cache.Logs), marshLogs, time.Minute).SetVal("OK")
+				mock.ExpectHSet(pipelineId2.String(), marshStatusSubKey, marshStatus).SetVal(1)
+				mock.Regexp().ExpectHSetNX(pipelineId2.String(), `"CREATED_AT"`, `.+`).SetVal(true)
+				mock.ExpectExpire(pipelineId2.String(), preloadExpTime).SetVal(true)
+				mock.ExpectTxPipelineExec()
 			},
 			wantErr: false,
 		},
@@ -248,102 +345,3001 @@ func TestRedisCache_SetValue(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mocks()
 			rc := &Cache{
-				tt.fields.redisClient,
+				Cmdable:               client,
+				subKeyExpirationTimes: map[cache.SubKey]time.Duration{cache.Logs: time.Minute},
+				preloadExpirationTime: preloadExpTime,
 			}
-			if err := rc.SetValue(tt.args.ctx, tt.args.pipelineId, tt.args.subKey, tt.args.value); (err != nil) != tt.wantErr {
-				t.Errorf("SetValue() error = %v, wantErr %v", err, tt.wantErr)
+			if err := rc.Preload(context.Background(), pipelines); (err != nil) != tt.wantErr {
+				t.Errorf("Preload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Preload() %s", err.Error())
 			}
 			mock.ClearExpect()
 		})
 	}
 }
 
-func Test_newRedisCache(t *testing.T) {
-	address := "host:port"
-	type args struct {
-		ctx  context.Context
-		addr string
+func TestRedisCache_SetStatusIfNotTerminal(t *testing.T) {
+	pipelineId := uuid.New()
+	status := pb.Status_STATUS_CANCELED
+	marshStatus, _ := json.Marshal(status)
+	marshSubKey, _ := json.Marshal(cache.Status)
+	args := []interface{}{string(marshSubKey), marshStatus, int64(900000)}
+	for _, terminalStatus := range cache.TerminalStatuses() {
+		marshTerminalStatus, _ := json.Marshal(terminalStatus)
+		args = append(args, marshTerminalStatus)
 	}
+	sha := setStatusIfNotTerminalScript.Hash()
+	client, mock := redismock.NewClientMock()
+
 	tests := []struct {
 		name    string
-		args    args
+		mocks   func()
+		want    bool
 		wantErr bool
 	}{
 		{
-			name: "error during Ping operation",
-			args: args{
-				ctx:  context.Background(),
-				addr: address,
+			name: "error during script execution",
+			mocks: func() {
+				mock.ExpectEvalSha(sha, []string{pipelineId.String()}, args...).SetErr(fmt.Errorf("MOCK_ERROR"))
 			},
+			want:    false,
 			wantErr: true,
 		},
+		{
+			// Test case with the pipeline which status isn't terminal.
+			// As a result, want the status to be set and published.
+			name: "allowed transition",
+			mocks: func() {
+				mock.ExpectEvalSha(sha, []string{pipelineId.String()}, args...).SetVal(int64(1))
+				mock.ExpectPublish(pipelineId.String()+":status", marshStatus).SetVal(0)
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			// Test case with the pipeline which status is terminal.
+			// As a result, want the status not to be set and published.
+			name: "refused transition",
+			mocks: func() {
+				mock.ExpectEvalSha(sha, []string{pipelineId.String()}, args...).SetVal(int64(0))
+			},
+			want:    false,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if _, err := New(tt.args.ctx, tt.args.addr); (err != nil) != tt.wantErr {
-				t.Errorf("newRedisCache() error = %v, wantErr %v", err, tt.wantErr)
+			tt.mocks()
+			rc := &Cache{
+				Cmdable:           client,
+				keyExpirationTime: time.Minute * 15,
+			}
+			got, err := rc.SetStatusIfNotTerminal(context.Background(), pipelineId, status)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetStatusIfNotTerminal() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if got != tt.want {
+				t.Errorf("SetStatusIfNotTerminal() got = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("SetStatusIfNotTerminal() %s", err.Error())
+			}
+			mock.ClearExpect()
 		})
 	}
 }
 
-func Test_unmarshalBySubKey(t *testing.T) {
-	status := pb.Status_STATUS_FINISHED
-	statusValue, _ := json.Marshal(status)
-	output := "MOCK_OUTPUT"
-	outputValue, _ := json.Marshal(output)
-	type args struct {
-		ctx    context.Context
-		subKey cache.SubKey
-		value  string
-	}
+func TestRedisCache_GetAge(t *testing.T) {
+	pipelineId := uuid.New()
+	createdAt := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	currentTime := createdAt.Add(time.Minute * 3)
+	createdAtValue, _ := json.Marshal(createdAt.UnixNano())
+	client, mock := redismock.NewClientMock()
 	tests := []struct {
 		name    string
-		args    args
-		want    interface{}
-		wantErr bool
+		mocks   func()
+		want    time.Duration
+		wantErr error
 	}{
 		{
-			name: "status subKey",
-			args: args{
-				ctx:    context.Background(),
-				subKey: cache.Status,
-				value:  string(statusValue),
-			},
-			want:    status,
-			wantErr: false,
-		},
-		{
-			name: "runOutput subKey",
-			args: args{
-				subKey: cache.RunOutput,
-				value:  string(outputValue),
+			name: "error during HGet operation",
+			mocks: func() {
+				mock.ExpectHGet(pipelineId.String(), `"CREATED_AT"`).SetErr(fmt.Errorf("MOCK_ERROR"))
 			},
-			want:    output,
-			wantErr: false,
+			want:    0,
+			wantErr: fmt.Errorf("MOCK_ERROR"),
 		},
 		{
-			name: "compileOutput subKey",
-			args: args{
-				subKey: cache.CompileOutput,
-				value:  string(outputValue),
+			name: "creation time doesn't exist",
+			mocks: func() {
+				mock.ExpectHGet(pipelineId.String(), `"CREATED_AT"`).RedisNil()
 			},
-			want:    output,
-			wantErr: false,
+			want:    0,
+			wantErr: cache.ErrNotFound,
 		},
 		{
-			name: "graph subKey",
-			args: args{
-				subKey: cache.Graph,
-				value:  string(outputValue),
+			name: "all success",
+			mocks: func() {
+				mock.ExpectHGet(pipelineId.String(), `"CREATED_AT"`).SetVal(string(createdAtValue))
 			},
-			want:    output,
-			wantErr: false,
+			want:    time.Minute * 3,
+			wantErr: nil,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := unmarshalBySubKey(tt.args.subKey, tt.args.value)
+			tt.mocks()
+			rc := &Cache{
+				Cmdable: client,
+				now: func() time.Time {
+					return currentTime
+				},
+			}
+			got, err := rc.GetAge(context.Background(), pipelineId)
+			if (err != nil) != (tt.wantErr != nil) || (errors.Is(tt.wantErr, cache.ErrNotFound) && !errors.Is(err, cache.ErrNotFound)) {
+				t.Errorf("GetAge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetAge() got = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("GetAge() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_SetValue_CreatedAt(t *testing.T) {
+	pipelineId := uuid.New()
+	createdAt := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	createdAtValue, _ := json.Marshal(createdAt.UnixNano())
+	marshSubKey, _ := json.Marshal(cache.RunOutput)
+	marshValue, _ := json.Marshal("MOCK_OUTPUT")
+	client, mock := redismock.NewClientMock()
+	rc := &Cache{
+		Cmdable:           client,
+		keyExpirationTime: time.Minute,
+		now: func() time.Time {
+			return createdAt
+		},
+	}
+
+	// the creation time is set only for the new key
+	mock.ExpectExists(pipelineId.String()).SetVal(0)
+	mock.ExpectHSet(pipelineId.String(), marshSubKey, marshValue).SetVal(1)
+	mock.ExpectHSetNX(pipelineId.String(), `"CREATED_AT"`, createdAtValue).SetVal(true)
+	mock.ExpectExpire(pipelineId.String(), time.Minute).SetVal(true)
+	mock.ExpectExists(pipelineId.String()).SetVal(1)
+	mock.ExpectHSet(pipelineId.String(), marshSubKey, marshValue).SetVal(0)
+	for i := 0; i < 2; i++ {
+		if err := rc.SetValue(context.Background(), pipelineId, cache.RunOutput, "MOCK_OUTPUT"); err != nil {
+			t.Errorf("SetValue() error = %v", err)
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("SetValue() %s", err.Error())
+	}
+}
+
+func TestRedisCache_GetValues(t *testing.T) {
+	pipelineId := uuid.New()
+	status := pb.Status_STATUS_FINISHED
+	output := "MOCK_OUTPUT"
+	keyPrefix := "MOCK_PREFIX:"
+	client, mock := redismock.NewClientMock()
+	marshStatusSubKey, _ := json.Marshal(cache.Status)
+	marshOutputSubKey, _ := json.Marshal(cache.RunOutput)
+	marshStatus, _ := json.Marshal(status)
+	marshOutput, _ := json.Marshal(output)
+
+	type fields struct {
+		redisClient *redis.Client
+		keyPrefix   string
+	}
+	type args struct {
+		ctx        context.Context
+		pipelineId uuid.UUID
+		subKeys    []cache.SubKey
+	}
+	tests := []struct {
+		name         string
+		mocks        func()
+		fields       fields
+		args         args
+		want         map[cache.SubKey]interface{}
+		wantErr      bool
+		wantCategory error
+	}{
+		{
+			name: "error during HMGet operation",
+			mocks: func() {
+				mock.ExpectHMGet(pipelineId.String(), string(marshStatusSubKey), string(marshOutputSubKey)).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.TODO(),
+				pipelineId: pipelineId,
+				subKeys:    []cache.SubKey{cache.Status, cache.RunOutput},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "connection error during HMGet operation",
+			mocks: func() {
+				mock.ExpectHMGet(pipelineId.String(), string(marshStatusSubKey), string(marshOutputSubKey)).SetErr(&net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET})
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.TODO(),
+				pipelineId: pipelineId,
+				subKeys:    []cache.SubKey{cache.Status, cache.RunOutput},
+			},
+			want:         nil,
+			wantErr:      true,
+			wantCategory: cache.ErrConnection,
+		},
+		{
+			name: "HMGet operation returns malformed value",
+			mocks: func() {
+				mock.ExpectHMGet(pipelineId.String(), string(marshStatusSubKey), string(marshOutputSubKey)).SetVal([]interface{}{string(marshStatus), "{MOCK_VALUE"})
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.TODO(),
+				pipelineId: pipelineId,
+				subKeys:    []cache.SubKey{cache.Status, cache.RunOutput},
+			},
+			want:         nil,
+			wantErr:      true,
+			wantCategory: cache.ErrDecode,
+		},
+		{
+			name: "missing subKey",
+			mocks: func() {
+				mock.ExpectHMGet(pipelineId.String(), string(marshStatusSubKey), string(marshOutputSubKey)).SetVal([]interface{}{string(marshStatus), nil})
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.TODO(),
+				pipelineId: pipelineId,
+				subKeys:    []cache.SubKey{cache.Status, cache.RunOutput},
+			},
+			want:    map[cache.SubKey]interface{}{cache.Status: status},
+			wantErr: false,
+		},
+		{
+			name: "all success",
+			mocks: func() {
+				mock.ExpectHMGet(pipelineId.String(), string(marshStatusSubKey), string(marshOutputSubKey)).SetVal([]interface{}{string(marshStatus), string(marshOutput)})
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.TODO(),
+				pipelineId: pipelineId,
+				subKeys:    []cache.SubKey{cache.Status, cache.RunOutput},
+			},
+			want:    map[cache.SubKey]interface{}{cache.Status: status, cache.RunOutput: output},
+			wantErr: false,
+		},
+		{
+			name: "all success with key prefix",
+			mocks: func() {
+				mock.ExpectHMGet(keyPrefix+pipelineId.String(), string(marshStatusSubKey), string(marshOutputSubKey)).SetVal([]interface{}{string(marshStatus), string(marshOutput)})
+			},
+			fields: fields{redisClient: client, keyPrefix: keyPrefix},
+			args: args{
+				ctx:        context.TODO(),
+				pipelineId: pipelineId,
+				subKeys:    []cache.SubKey{cache.Status, cache.RunOutput},
+			},
+			want:    map[cache.SubKey]interface{}{cache.Status: status, cache.RunOutput: output},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			rc := &Cache{
+				Cmdable:   tt.fields.redisClient,
+				keyPrefix: tt.fields.keyPrefix,
+			}
+			got, err := rc.GetValues(tt.args.ctx, tt.args.pipelineId, tt.args.subKeys)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetValues() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			for _, category := range []error{cache.ErrConnection, cache.ErrDecode} {
+				if errors.Is(err, category) != (category == tt.wantCategory) {
+					t.Errorf("GetValues() error = %v, wantCategory %v", err, tt.wantCategory)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetValues() got = %v, want %v", got, tt.want)
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_ScanValues(t *testing.T) {
+	pipelineId := uuid.New()
+	status := pb.Status_STATUS_FINISHED
+	output := "MOCK_OUTPUT"
+	index := 5.0
+	client, mock := redismock.NewClientMock()
+	marshStatusSubKey, _ := json.Marshal(cache.Status)
+	marshOutputSubKey, _ := json.Marshal(cache.RunOutput)
+	marshIndexSubKey, _ := json.Marshal(cache.RunOutputIndex)
+	marshCreatedAtSubKey, _ := json.Marshal(createdAtSubKey)
+	marshStatus, _ := json.Marshal(status)
+	marshOutput, _ := json.Marshal(output)
+	marshIndex, _ := json.Marshal(index)
+	tests := []struct {
+		name    string
+		mocks   func()
+		want    map[cache.SubKey]interface{}
+		wantErr bool
+	}{
+		{
+			name: "error during HScan operation",
+			mocks: func() {
+				mock.ExpectHScan(pipelineId.String(), 0, "", scanCount).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "pipeline doesn't exist",
+			mocks: func() {
+				mock.ExpectHScan(pipelineId.String(), 0, "", scanCount).SetVal([]string{}, 0)
+			},
+			want:    map[cache.SubKey]interface{}{},
+			wantErr: false,
+		},
+		{
+			name: "values of several HScan pages",
+			mocks: func() {
+				mock.ExpectHScan(pipelineId.String(), 0, "", scanCount).SetVal([]string{
+					string(marshStatusSubKey), string(marshStatus),
+					string(marshCreatedAtSubKey), "1",
+				}, 7)
+				mock.ExpectHScan(pipelineId.String(), 7, "", scanCount).SetVal([]string{
+					string(marshOutputSubKey), string(marshOutput),
+					string(marshIndexSubKey), string(marshIndex),
+					"MOCK_FIELD", "MOCK_VALUE",
+				}, 0)
+			},
+			want: map[cache.SubKey]interface{}{
+				cache.Status:         status,
+				cache.RunOutput:      output,
+				cache.RunOutputIndex: index,
+				"MOCK_FIELD":         "MOCK_VALUE",
+			},
+			wantErr: false,
+		},
+		{
+			name: "error during unmarshal value",
+			mocks: func() {
+				mock.ExpectHScan(pipelineId.String(), 0, "", scanCount).SetVal([]string{string(marshStatusSubKey), "MOCK_VALUE"}, 0)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			rc := &Cache{Cmdable: client}
+			got, err := rc.ScanValues(context.Background(), pipelineId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ScanValues() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScanValues() got = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("ScanValues() expectations: %v", err)
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_DeleteValue(t *testing.T) {
+	pipelineId := uuid.New()
+	subKey := cache.CompileOutput
+	client, mock := redismock.NewClientMock()
+	marshSubKey, _ := json.Marshal(subKey)
+
+	type fields struct {
+		redisClient *redis.Client
+	}
+	type args struct {
+		ctx        context.Context
+		pipelineId uuid.UUID
+		subKey     cache.SubKey
+	}
+	tests := []struct {
+		name    string
+		mocks   func()
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "error during HDel operation",
+			mocks: func() {
+				mock.ExpectHDel(pipelineId.String(), string(marshSubKey)).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			fields: fields{client},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				subKey:     subKey,
+			},
+			wantErr: true,
+		},
+		{
+			name: "HDel operation returns 0",
+			mocks: func() {
+				mock.ExpectHDel(pipelineId.String(), string(marshSubKey)).SetVal(0)
+			},
+			fields: fields{client},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				subKey:     subKey,
+			},
+			wantErr: false,
+		},
+		{
+			name: "all success",
+			mocks: func() {
+				mock.ExpectHDel(pipelineId.String(), string(marshSubKey)).SetVal(1)
+			},
+			fields: fields{client},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				subKey:     subKey,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			rc := &Cache{
+				Cmdable: tt.fields.redisClient,
+			}
+			if err := rc.DeleteValue(tt.args.ctx, tt.args.pipelineId, tt.args.subKey); (err != nil) != tt.wantErr {
+				t.Errorf("DeleteValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_DeletePipeline(t *testing.T) {
+	pipelineId := uuid.New()
+	keyPrefix := "MOCK_PREFIX:"
+	client, mock := redismock.NewClientMock()
+
+	type fields struct {
+		redisClient *redis.Client
+		keyPrefix   string
+	}
+	type args struct {
+		ctx        context.Context
+		pipelineId uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		mocks   func()
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "error during Del operation",
+			mocks: func() {
+				mock.ExpectDel(pipelineId.String()).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Del operation returns 0",
+			mocks: func() {
+				mock.ExpectDel(pipelineId.String()).SetVal(0)
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+			},
+			wantErr: false,
+		},
+		{
+			name: "all success with key prefix",
+			mocks: func() {
+				mock.ExpectDel(keyPrefix + pipelineId.String()).SetVal(1)
+			},
+			fields: fields{redisClient: client, keyPrefix: keyPrefix},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			rc := &Cache{
+				Cmdable:   tt.fields.redisClient,
+				keyPrefix: tt.fields.keyPrefix,
+			}
+			if err := rc.DeletePipeline(tt.args.ctx, tt.args.pipelineId); (err != nil) != tt.wantErr {
+				t.Errorf("DeletePipeline() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("DeletePipeline() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_SetExpTime(t *testing.T) {
+	pipelineId := uuid.New()
+	expTime := time.Second
+	keyPrefix := "MOCK_PREFIX:"
+	client, mock := redismock.NewClientMock()
+
+	type fields struct {
+		redisClient *redis.Client
+		keyPrefix   string
+	}
+	type args struct {
+		ctx        context.Context
+		pipelineId uuid.UUID
+		expTime    time.Duration
+	}
+	tests := []struct {
+		name    string
+		mocks   func()
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "error during Exists operation",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				expTime:    expTime,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Exists operation returns 0",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(0)
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				expTime:    expTime,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error during Expire operation",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(1)
+				mock.ExpectExpire(pipelineId.String(), expTime).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				expTime:    expTime,
+			},
+			wantErr: true,
+		},
+		{
+			name: "all success",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(1)
+				mock.ExpectExpire(pipelineId.String(), expTime).SetVal(true)
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				expTime:    expTime,
+			},
+			wantErr: false,
+		},
+		{
+			name: "all success with key prefix",
+			mocks: func() {
+				mock.ExpectExists(keyPrefix + pipelineId.String()).SetVal(1)
+				mock.ExpectExpire(keyPrefix+pipelineId.String(), expTime).SetVal(true)
+			},
+			fields: fields{redisClient: client, keyPrefix: keyPrefix},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				expTime:    expTime,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			rc := &Cache{
+				Cmdable:   tt.fields.redisClient,
+				keyPrefix: tt.fields.keyPrefix,
+			}
+			if err := rc.SetExpTime(tt.args.ctx, tt.args.pipelineId, tt.args.expTime); (err != nil) != tt.wantErr {
+				t.Errorf("SetExpTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_TouchPipeline(t *testing.T) {
+	pipelineId := uuid.New()
+	client, mock := redismock.NewClientMock()
+	tests := []struct {
+		name         string
+		mocks        func()
+		wantErr      bool
+		wantNotFound bool
+	}{
+		{
+			name: "error during Exists operation",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "key doesn't exist",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(0)
+			},
+			wantErr:      true,
+			wantNotFound: true,
+		},
+		{
+			name: "error during Expire operation",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(1)
+				mock.ExpectExpire(pipelineId.String(), defaultKeyExpirationTime).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "all success",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(1)
+				mock.ExpectExpire(pipelineId.String(), defaultKeyExpirationTime).SetVal(true)
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			rc := &Cache{Cmdable: client, keyExpirationTime: defaultKeyExpirationTime}
+			err := rc.TouchPipeline(context.Background(), pipelineId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TouchPipeline() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, cache.ErrNotFound) != tt.wantNotFound {
+				t.Errorf("TouchPipeline() error = %v, wantNotFound %v", err, tt.wantNotFound)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("TouchPipeline() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_SetSubKeyExpTime(t *testing.T) {
+	pipelineId := uuid.New()
+	client, mock := redismock.NewClientMock()
+	logsKey := pipelineId.String() + ":" + string(cache.Logs)
+	tests := []struct {
+		name         string
+		mocks        func()
+		subKey       cache.SubKey
+		want         bool
+		wantErr      bool
+		wantNotFound bool
+	}{
+		{
+			// Test case with the subKey which is kept in the pipeline's key.
+			// As a result, want the expiration time not to be set.
+			name:   "subKey expires with the pipeline",
+			mocks:  func() {},
+			subKey: cache.Status,
+			want:   false,
+		},
+		{
+			name: "separate key doesn't exist",
+			mocks: func() {
+				mock.ExpectExpire(logsKey, time.Minute).SetVal(false)
+			},
+			subKey:       cache.Logs,
+			wantErr:      true,
+			wantNotFound: true,
+		},
+		{
+			name: "error during Expire operation",
+			mocks: func() {
+				mock.ExpectExpire(logsKey, time.Minute).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			subKey:  cache.Logs,
+			wantErr: true,
+		},
+		{
+			name: "all success",
+			mocks: func() {
+				mock.ExpectExpire(logsKey, time.Minute).SetVal(true)
+			},
+			subKey: cache.Logs,
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			rc := &Cache{Cmdable: client, subKeyExpirationTimes: map[cache.SubKey]time.Duration{cache.Logs: time.Minute * 5}}
+			got, err := rc.SetSubKeyExpTime(context.Background(), pipelineId, tt.subKey, time.Minute)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetSubKeyExpTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, cache.ErrNotFound) != tt.wantNotFound {
+				t.Errorf("SetSubKeyExpTime() error = %v, wantNotFound %v", err, tt.wantNotFound)
+			}
+			if got != tt.want {
+				t.Errorf("SetSubKeyExpTime() got = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("SetSubKeyExpTime() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_GetPipelines(t *testing.T) {
+	pipelineId1, pipelineId2, pipelineId3 := uuid.New(), uuid.New(), uuid.New()
+	keyPrefix := "MOCK_PREFIX:"
+	client, mock := redismock.NewClientMock()
+
+	type fields struct {
+		redisClient *redis.Client
+		keyPrefix   string
+	}
+	tests := []struct {
+		name    string
+		mocks   func()
+		fields  fields
+		want    []uuid.UUID
+		wantErr bool
+	}{
+		{
+			name: "error during Scan operation",
+			mocks: func() {
+				mock.ExpectScan(0, "*", scanCount).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			fields:  fields{redisClient: client},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "several pages",
+			mocks: func() {
+				mock.ExpectScan(0, "*", scanCount).SetVal([]string{pipelineId1.String(), "MOCK_NOT_UUID_KEY"}, 17)
+				mock.ExpectScan(17, "*", scanCount).SetVal([]string{}, 42)
+				mock.ExpectScan(42, "*", scanCount).SetVal([]string{pipelineId2.String(), pipelineId3.String()}, 0)
+			},
+			fields:  fields{redisClient: client},
+			want:    []uuid.UUID{pipelineId1, pipelineId2, pipelineId3},
+			wantErr: false,
+		},
+		{
+			// Test case with keys of subKeys which have own expiration time.
+			// As a result, want each pipeline to be received once.
+			name: "keys of subKeys with own expiration time",
+			mocks: func() {
+				mock.ExpectScan(0, "*", scanCount).SetVal([]string{pipelineId1.String() + ":LOGS", pipelineId1.String(), pipelineId2.String() + ":LOGS"}, 0)
+			},
+			fields:  fields{redisClient: client},
+			want:    []uuid.UUID{pipelineId1, pipelineId2},
+			wantErr: false,
+		},
+		{
+			name: "all success with key prefix",
+			mocks: func() {
+				mock.ExpectScan(0, keyPrefix+"*", scanCount).SetVal([]string{keyPrefix + pipelineId1.String()}, 0)
+			},
+			fields:  fields{redisClient: client, keyPrefix: keyPrefix},
+			want:    []uuid.UUID{pipelineId1},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			rc := &Cache{
+				Cmdable:   tt.fields.redisClient,
+				keyPrefix: tt.fields.keyPrefix,
+			}
+			got, err := rc.GetPipelines(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPipelines() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetPipelines() got = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("GetPipelines() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_FlushAll(t *testing.T) {
+	pipelineId1, pipelineId2 := uuid.New(), uuid.New()
+	keyPrefix := "MOCK_PREFIX:"
+	client, mock := redismock.NewClientMock()
+
+	tests := []struct {
+		name    string
+		mocks   func()
+		wantErr bool
+	}{
+		{
+			name: "error during Scan operation",
+			mocks: func() {
+				mock.ExpectScan(0, keyPrefix+"*", scanCount).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "error during Del operation",
+			mocks: func() {
+				mock.ExpectScan(0, keyPrefix+"*", scanCount).SetVal([]string{keyPrefix + pipelineId1.String()}, 0)
+				mock.ExpectDel(keyPrefix + pipelineId1.String()).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			wantErr: true,
+		},
+		{
+			// Test case with prefixed pipelines and other prefixed key.
+			// Unprefixed keys don't match the Scan pattern.
+			// As a result, want only prefixed pipelines to be deleted.
+			name: "only prefixed pipelines are deleted",
+			mocks: func() {
+				mock.ExpectScan(0, keyPrefix+"*", scanCount).SetVal([]string{keyPrefix + pipelineId1.String(), keyPrefix + "MOCK_NOT_UUID_KEY"}, 17)
+				mock.ExpectScan(17, keyPrefix+"*", scanCount).SetVal([]string{keyPrefix + pipelineId2.String()}, 0)
+				mock.ExpectDel(keyPrefix + pipelineId1.String()).SetVal(1)
+				mock.ExpectDel(keyPrefix + pipelineId2.String()).SetVal(1)
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			rc := &Cache{
+				Cmdable:   client,
+				keyPrefix: keyPrefix,
+			}
+			if err := rc.FlushAll(context.Background()); (err != nil) != tt.wantErr {
+				t.Errorf("FlushAll() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("FlushAll() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_GetSubKeyCount(t *testing.T) {
+	pipelineId := uuid.New()
+	keyPrefix := "MOCK_PREFIX:"
+	client, mock := redismock.NewClientMock()
+
+	type fields struct {
+		redisClient *redis.Client
+		keyPrefix   string
+	}
+	type args struct {
+		ctx        context.Context
+		pipelineId uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		mocks   func()
+		fields  fields
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "error during HLen operation",
+			mocks: func() {
+				mock.ExpectHLen(pipelineId.String()).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "key doesn't exist",
+			mocks: func() {
+				mock.ExpectHLen(pipelineId.String()).SetVal(0)
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+			},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name: "all success with key prefix",
+			mocks: func() {
+				mock.ExpectHLen(keyPrefix + pipelineId.String()).SetVal(3)
+				mock.ExpectHExists(keyPrefix+pipelineId.String(), `"CREATED_AT"`).SetVal(false)
+			},
+			fields: fields{redisClient: client, keyPrefix: keyPrefix},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+			},
+			want:    3,
+			wantErr: false,
+		},
+		{
+			name: "creation time isn't counted",
+			mocks: func() {
+				mock.ExpectHLen(pipelineId.String()).SetVal(3)
+				mock.ExpectHExists(pipelineId.String(), `"CREATED_AT"`).SetVal(true)
+			},
+			fields: fields{redisClient: client},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+			},
+			want:    2,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			rc := &Cache{
+				Cmdable:   tt.fields.redisClient,
+				keyPrefix: tt.fields.keyPrefix,
+			}
+			got, err := rc.GetSubKeyCount(tt.args.ctx, tt.args.pipelineId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSubKeyCount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetSubKeyCount() got = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("GetSubKeyCount() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_SetValue(t *testing.T) {
+	pipelineId := uuid.New()
+	subKey := cache.Status
+	value := pb.Status_STATUS_FINISHED
+	keyPrefix := "MOCK_PREFIX:"
+	keyExpirationTime := time.Minute * 15
+	client, mock := redismock.NewClientMock()
+	marshSubKey, _ := json.Marshal(subKey)
+	marshValue, _ := json.Marshal(value)
+
+	type fields struct {
+		redisClient       *redis.Client
+		keyPrefix         string
+		keyExpirationTime time.Duration
+	}
+	type args struct {
+		ctx        context.Context
+		pipelineId uuid.UUID
+		subKey     cache.SubKey
+		value      interface{}
+	}
+	tests := []struct {
+		name    string
+		mocks   func()
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "error during Exists operation",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			fields: fields{redisClient: client, keyExpirationTime: keyExpirationTime},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				subKey:     subKey,
+				value:      value,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error during HSet operation",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(0)
+				mock.ExpectHSet(pipelineId.String(), marshSubKey, marshValue).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			fields: fields{redisClient: client, keyExpirationTime: keyExpirationTime},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				subKey:     subKey,
+				value:      value,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error during Expire operation",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(0)
+				mock.ExpectHSet(pipelineId.String(), marshSubKey, marshValue).SetVal(1)
+				mock.Regexp().ExpectHSetNX(pipelineId.String(), `"CREATED_AT"`, `.+`).SetVal(true)
+				mock.ExpectExpire(pipelineId.String(), keyExpirationTime).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			fields: fields{redisClient: client, keyExpirationTime: keyExpirationTime},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				subKey:     subKey,
+				value:      value,
+			},
+			wantErr: true,
+		},
+		{
+			name: "all success for new key",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(0)
+				mock.ExpectHSet(pipelineId.String(), marshSubKey, marshValue).SetVal(1)
+				mock.Regexp().ExpectHSetNX(pipelineId.String(), `"CREATED_AT"`, `.+`).SetVal(true)
+				mock.ExpectExpire(pipelineId.String(), keyExpirationTime).SetVal(true)
+				mock.ExpectPublish(pipelineId.String()+":status", marshValue).SetVal(0)
+			},
+			fields: fields{redisClient: client, keyExpirationTime: keyExpirationTime},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				subKey:     subKey,
+				value:      value,
+			},
+			wantErr: false,
+		},
+		{
+			name: "all success for existing key without updating expiration time",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(1)
+				mock.ExpectHSet(pipelineId.String(), marshSubKey, marshValue).SetVal(0)
+				mock.ExpectPublish(pipelineId.String()+":status", marshValue).SetVal(0)
+			},
+			fields: fields{redisClient: client, keyExpirationTime: keyExpirationTime},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				subKey:     subKey,
+				value:      value,
+			},
+			wantErr: false,
+		},
+		{
+			name: "all success with key prefix",
+			mocks: func() {
+				mock.ExpectExists(keyPrefix + pipelineId.String()).SetVal(0)
+				mock.ExpectHSet(keyPrefix+pipelineId.String(), marshSubKey, marshValue).SetVal(1)
+				mock.Regexp().ExpectHSetNX(keyPrefix+pipelineId.String(), `"CREATED_AT"`, `.+`).SetVal(true)
+				mock.ExpectExpire(keyPrefix+pipelineId.String(), time.Hour).SetVal(true)
+				mock.ExpectPublish(keyPrefix+pipelineId.String()+":status", marshValue).SetVal(0)
+			},
+			fields: fields{redisClient: client, keyPrefix: keyPrefix, keyExpirationTime: time.Hour},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				subKey:     subKey,
+				value:      value,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			rc := &Cache{
+				Cmdable:           tt.fields.redisClient,
+				keyPrefix:         tt.fields.keyPrefix,
+				keyExpirationTime: tt.fields.keyExpirationTime,
+			}
+			if err := rc.SetValue(tt.args.ctx, tt.args.pipelineId, tt.args.subKey, tt.args.value); (err != nil) != tt.wantErr {
+				t.Errorf("SetValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("SetValue() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_SetValues(t *testing.T) {
+	pipelineId := uuid.New()
+	client, mock := redismock.NewClientMock()
+	values := map[cache.SubKey]interface{}{
+		cache.Status:        1,
+		cache.CompileOutput: "MOCK_COMPILE_OUTPUT",
+		cache.Logs:          "MOCK_LOGS",
+	}
+	marshStatus, _ := json.Marshal(cache.Status)
+	marshCompileOutput, _ := json.Marshal(cache.CompileOutput)
+	marshLogs, _ := json.Marshal(cache.Logs)
+	marshStatusValue, _ := json.Marshal(1)
+	marshCompileOutputValue, _ := json.Marshal("MOCK_COMPILE_OUTPUT")
+	marshLogsValue, _ := json.Marshal("MOCK_LOGS")
+	// pairs are sorted by subKey
+	pairs := []interface{}{marshCompileOutput, marshCompileOutputValue, marshLogs, marshLogsValue, marshStatus, marshStatusValue}
+	expTime := time.Second
+
+	type fields struct {
+		redisClient       *redis.Client
+		keyExpirationTime time.Duration
+	}
+	type args struct {
+		ctx        context.Context
+		pipelineId uuid.UUID
+		values     map[cache.SubKey]interface{}
+	}
+	tests := []struct {
+		name    string
+		mocks   func()
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "error during HSet operation",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(1)
+				mock.ExpectHSet(pipelineId.String(), pairs...).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			fields: fields{redisClient: client, keyExpirationTime: expTime},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				values:     values,
+			},
+			wantErr: true,
+		},
+		{
+			name: "one HSet operation for existing key",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(1)
+				mock.ExpectHSet(pipelineId.String(), pairs...).SetVal(3)
+				mock.ExpectPublish(pipelineId.String()+":status", marshStatusValue).SetVal(0)
+			},
+			fields: fields{redisClient: client, keyExpirationTime: expTime},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				values:     values,
+			},
+			wantErr: false,
+		},
+		{
+			name: "one HSet and one Expire operation for new key",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(0)
+				mock.ExpectHSet(pipelineId.String(), pairs...).SetVal(3)
+				mock.Regexp().ExpectHSetNX(pipelineId.String(), `"CREATED_AT"`, `.+`).SetVal(true)
+				mock.ExpectExpire(pipelineId.String(), expTime).SetVal(true)
+				mock.ExpectPublish(pipelineId.String()+":status", marshStatusValue).SetVal(0)
+			},
+			fields: fields{redisClient: client, keyExpirationTime: expTime},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				values:     values,
+			},
+			wantErr: false,
+		},
+		{
+			name:   "empty values",
+			mocks:  func() {},
+			fields: fields{redisClient: client, keyExpirationTime: expTime},
+			args: args{
+				ctx:        context.Background(),
+				pipelineId: pipelineId,
+				values:     map[cache.SubKey]interface{}{},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			rc := &Cache{
+				Cmdable:           tt.fields.redisClient,
+				keyExpirationTime: tt.fields.keyExpirationTime,
+			}
+			if err := rc.SetValues(tt.args.ctx, tt.args.pipelineId, tt.args.values); (err != nil) != tt.wantErr {
+				t.Errorf("SetValues() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("SetValues() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_HealthCheck(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	type fields struct {
+		redisClient *redis.Client
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		mocks   func()
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Ping operation returns PONG",
+			mocks: func() {
+				mock.ExpectPing().SetVal("PONG")
+			},
+			fields:  fields{redisClient: client},
+			args:    args{ctx: context.Background()},
+			wantErr: false,
+		},
+		{
+			name: "error during Ping operation",
+			mocks: func() {
+				mock.ExpectPing().SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			fields:  fields{redisClient: client},
+			args:    args{ctx: context.Background()},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			rc := &Cache{
+				Cmdable: tt.fields.redisClient,
+			}
+			if err := rc.HealthCheck(tt.args.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("HealthCheck() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("HealthCheck() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+// fakeMetrics counts recorded cache operations
+type fakeMetrics struct {
+	hits   int
+	misses int
+	sets   int
+	errors map[string]int
+}
+
+func (m *fakeMetrics) ObserveGetValue(hit bool, _ time.Duration) {
+	if hit {
+		m.hits++
+	} else {
+		m.misses++
+	}
+}
+
+func (m *fakeMetrics) ObserveSetValue(time.Duration) {
+	m.sets++
+}
+
+func (m *fakeMetrics) ObserveError(operation string) {
+	m.errors[operation]++
+}
+
+// closingClient is Redis client which records calls of Close
+type closingClient struct {
+	redis.Cmdable
+	closed bool
+	err    error
+}
+
+func (c *closingClient) Close() error {
+	c.closed = true
+	return c.err
+}
+
+func TestRedisCache_Close(t *testing.T) {
+	client, _ := redismock.NewClientMock()
+	tests := []struct {
+		name       string
+		client     redis.Cmdable
+		wantClosed bool
+		wantErr    bool
+	}{
+		{
+			name:       "error during Close operation",
+			client:     &closingClient{Cmdable: client, err: fmt.Errorf("MOCK_ERROR")},
+			wantClosed: true,
+			wantErr:    true,
+		},
+		{
+			name:       "client can't be closed",
+			client:     struct{ redis.Cmdable }{client},
+			wantClosed: false,
+			wantErr:    false,
+		},
+		{
+			name:       "all success",
+			client:     &closingClient{Cmdable: client},
+			wantClosed: true,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc := &Cache{Cmdable: tt.client}
+			if err := rc.Close(); (err != nil) != tt.wantErr {
+				t.Errorf("Close() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if closing, ok := tt.client.(*closingClient); ok && closing.closed != tt.wantClosed {
+				t.Errorf("Close() client closed = %t, want %t", closing.closed, tt.wantClosed)
+			}
+		})
+	}
+}
+
+func TestRedisCache_Codec(t *testing.T) {
+	pipelineId := uuid.New()
+	client, mock := redismock.NewClientMock()
+	marshSubKey, _ := json.Marshal(cache.RunOutput)
+	marshValue, _ := msgpack.Marshal("MOCK_OUTPUT")
+	rc := &Cache{Cmdable: client, codec: msgpack.Codec}
+
+	mock.ExpectExists(pipelineId.String()).SetVal(1)
+	mock.ExpectHSet(pipelineId.String(), marshSubKey, marshValue).SetVal(1)
+	if err := rc.SetValue(context.Background(), pipelineId, cache.RunOutput, "MOCK_OUTPUT"); err != nil {
+		t.Errorf("SetValue() error = %v", err)
+	}
+	mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshValue))
+	if got, err := rc.GetValue(context.Background(), pipelineId, cache.RunOutput); err != nil || got != "MOCK_OUTPUT" {
+		t.Errorf("GetValue() got = %v, %v, want %v", got, err, "MOCK_OUTPUT")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Codec: %s", err.Error())
+	}
+}
+
+func TestRedisCache_StructuredGraph(t *testing.T) {
+	pipelineId := uuid.New()
+	graph := cache.PipelineGraph{
+		Nodes: []cache.GraphNode{{Id: "1", Label: "Read"}, {Id: "2", Label: "Write"}},
+		Edges: []cache.GraphEdge{{From: "1", To: "2", Label: "Lines"}},
+	}
+	marshSubKey, _ := json.Marshal(cache.Graph)
+	client, mock := redismock.NewClientMock()
+	for _, codec := range []cache.Codec{cache.JSONCodec, msgpack.Codec} {
+		rc := &Cache{Cmdable: client, codec: codec}
+		valueMarsh, err := rc.encodeValue(graph)
+		if err != nil {
+			t.Fatalf("encodeValue() error = %v", err)
+		}
+		mock.ExpectExists(pipelineId.String()).SetVal(1)
+		mock.ExpectHSet(pipelineId.String(), marshSubKey, valueMarsh).SetVal(1)
+		mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(valueMarsh))
+
+		if err = rc.SetValue(context.Background(), pipelineId, cache.Graph, graph); err != nil {
+			t.Errorf("SetValue() error = %v", err)
+		}
+		got, err := rc.GetValue(context.Background(), pipelineId, cache.Graph)
+		if err != nil {
+			t.Errorf("GetValue() error = %v", err)
+		}
+		if !reflect.DeepEqual(got, graph) {
+			t.Errorf("GetValue() got = %v, want %v", got, graph)
+		}
+		if err = mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("StructuredGraph() %s", err.Error())
+		}
+		mock.ClearExpect()
+	}
+}
+
+func TestRedisCache_BinaryValue(t *testing.T) {
+	pipelineId := uuid.New()
+	payload := []byte{0x00, 'P', 'N', 'G', 0xff, 0xfe, 0x80, 0x00, 0x7f}
+	marshSubKey, _ := json.Marshal(cache.Graph)
+	client, mock := redismock.NewClientMock()
+	tests := []struct {
+		name                 string
+		payload              []byte
+		compressionThreshold int
+	}{
+		{name: "binary value", payload: payload},
+		{name: "empty binary value", payload: []byte{}},
+		{name: "compressed binary value", payload: bytes.Repeat(payload, 100), compressionThreshold: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc := &Cache{Cmdable: client, compressionThreshold: tt.compressionThreshold}
+			valueMarsh, err := rc.encodeValue(tt.payload)
+			if err != nil {
+				t.Fatalf("encodeValue() error = %v", err)
+			}
+			mock.ExpectExists(pipelineId.String()).SetVal(1)
+			mock.ExpectHSet(pipelineId.String(), marshSubKey, valueMarsh).SetVal(1)
+			mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(valueMarsh))
+
+			if err = rc.SetValue(context.Background(), pipelineId, cache.Graph, tt.payload); err != nil {
+				t.Errorf("SetValue() error = %v", err)
+			}
+			got, err := rc.GetValue(context.Background(), pipelineId, cache.Graph)
+			if err != nil {
+				t.Errorf("GetValue() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.payload) {
+				t.Errorf("GetValue() got = %v, want %v", got, tt.payload)
+			}
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("BinaryValue() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_Metrics(t *testing.T) {
+	pipelineId := uuid.New()
+	subKey := cache.Status
+	client, mock := redismock.NewClientMock()
+	marshSubKey, _ := json.Marshal(subKey)
+	marshValue, _ := json.Marshal(1)
+
+	tests := []struct {
+		name   string
+		mocks  func()
+		action func(rc *Cache)
+		want   fakeMetrics
+	}{
+		{
+			name:  "GetValue hit",
+			mocks: func() { mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshValue)) },
+			action: func(rc *Cache) {
+				_, _ = rc.GetValue(context.Background(), pipelineId, subKey)
+			},
+			want: fakeMetrics{hits: 1, errors: map[string]int{}},
+		},
+		{
+			name:  "GetValue miss",
+			mocks: func() { mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).RedisNil() },
+			action: func(rc *Cache) {
+				_, _ = rc.GetValue(context.Background(), pipelineId, subKey)
+			},
+			want: fakeMetrics{misses: 1, errors: map[string]int{}},
+		},
+		{
+			name:  "GetValue error",
+			mocks: func() { mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetErr(fmt.Errorf("MOCK_ERROR")) },
+			action: func(rc *Cache) {
+				_, _ = rc.GetValue(context.Background(), pipelineId, subKey)
+			},
+			want: fakeMetrics{errors: map[string]int{"GetValue": 1}},
+		},
+		{
+			name: "SetValue success",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(1)
+				mock.ExpectHSet(pipelineId.String(), marshSubKey, marshValue).SetVal(1)
+				mock.ExpectPublish(pipelineId.String()+":status", marshValue).SetVal(0)
+			},
+			action: func(rc *Cache) {
+				_ = rc.SetValue(context.Background(), pipelineId, subKey, 1)
+			},
+			want: fakeMetrics{sets: 1, errors: map[string]int{}},
+		},
+		{
+			name:  "SetValue error",
+			mocks: func() { mock.ExpectExists(pipelineId.String()).SetErr(fmt.Errorf("MOCK_ERROR")) },
+			action: func(rc *Cache) {
+				_ = rc.SetValue(context.Background(), pipelineId, subKey, 1)
+			},
+			want: fakeMetrics{errors: map[string]int{"SetValue": 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			metrics := &fakeMetrics{errors: map[string]int{}}
+			rc := &Cache{
+				Cmdable: client,
+				metrics: metrics,
+			}
+			tt.action(rc)
+			if !reflect.DeepEqual(*metrics, tt.want) {
+				t.Errorf("Metrics() got = %+v, want %+v", *metrics, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Metrics() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_Tracing(t *testing.T) {
+	pipelineId := uuid.New()
+	subKey := cache.Status
+	client, mock := redismock.NewClientMock()
+	marshSubKey, _ := json.Marshal(subKey)
+	marshValue, _ := json.Marshal(1)
+
+	tests := []struct {
+		name           string
+		mocks          func()
+		action         func(rc *Cache)
+		wantName       string
+		wantAttributes []attribute.KeyValue
+		wantErr        bool
+	}{
+		{
+			name:     "GetValue",
+			mocks:    func() { mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshValue)) },
+			action:   func(rc *Cache) { _, _ = rc.GetValue(context.Background(), pipelineId, subKey) },
+			wantName: "cache.GetValue",
+			wantAttributes: []attribute.KeyValue{
+				cache.OperationAttribute.String("GetValue"),
+				cache.PipelineIdAttribute.String(pipelineId.String()),
+				cache.SubKeyAttribute.String(string(subKey)),
+			},
+		},
+		{
+			// Test case with a cache miss.
+			// As a result, want the miss not to be recorded as an error of the span.
+			name:     "GetValue miss",
+			mocks:    func() { mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).RedisNil() },
+			action:   func(rc *Cache) { _, _ = rc.GetValue(context.Background(), pipelineId, subKey) },
+			wantName: "cache.GetValue",
+			wantAttributes: []attribute.KeyValue{
+				cache.OperationAttribute.String("GetValue"),
+				cache.PipelineIdAttribute.String(pipelineId.String()),
+				cache.SubKeyAttribute.String(string(subKey)),
+			},
+		},
+		{
+			name:     "GetValue error",
+			mocks:    func() { mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetErr(fmt.Errorf("MOCK_ERROR")) },
+			action:   func(rc *Cache) { _, _ = rc.GetValue(context.Background(), pipelineId, subKey) },
+			wantName: "cache.GetValue",
+			wantAttributes: []attribute.KeyValue{
+				cache.OperationAttribute.String("GetValue"),
+				cache.PipelineIdAttribute.String(pipelineId.String()),
+				cache.SubKeyAttribute.String(string(subKey)),
+			},
+			wantErr: true,
+		},
+		{
+			name:     "SetValue error",
+			mocks:    func() { mock.ExpectExists(pipelineId.String()).SetErr(fmt.Errorf("MOCK_ERROR")) },
+			action:   func(rc *Cache) { _ = rc.SetValue(context.Background(), pipelineId, subKey, 1) },
+			wantName: "cache.SetValue",
+			wantAttributes: []attribute.KeyValue{
+				cache.OperationAttribute.String("SetValue"),
+				cache.PipelineIdAttribute.String(pipelineId.String()),
+				cache.SubKeyAttribute.String(string(subKey)),
+			},
+			wantErr: true,
+		},
+		{
+			name: "SetExpTime",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(1)
+				mock.ExpectExpire(pipelineId.String(), time.Minute).SetVal(true)
+			},
+			action:   func(rc *Cache) { _ = rc.SetExpTime(context.Background(), pipelineId, time.Minute) },
+			wantName: "cache.SetExpTime",
+			wantAttributes: []attribute.KeyValue{
+				cache.OperationAttribute.String("SetExpTime"),
+				cache.PipelineIdAttribute.String(pipelineId.String()),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			recorder := tracetest.NewSpanRecorder()
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+			rc := &Cache{
+				Cmdable: client,
+				tracer:  provider.Tracer(cache.TracerName),
+			}
+			tt.action(rc)
+			spans := recorder.Ended()
+			if len(spans) != 1 || len(recorder.Started()) != 1 {
+				t.Fatalf("Tracing() got %d ended spans of %d, want 1", len(spans), len(recorder.Started()))
+			}
+			span := spans[0]
+			if span.Name() != tt.wantName || !reflect.DeepEqual(span.Attributes(), tt.wantAttributes) {
+				t.Errorf("Tracing() got span %s %v, want %s %v", span.Name(), span.Attributes(), tt.wantName, tt.wantAttributes)
+			}
+			if span.SpanKind() != trace.SpanKindClient {
+				t.Errorf("Tracing() span kind = %v, want %v", span.SpanKind(), trace.SpanKindClient)
+			}
+			failed := span.Status().Code == codes.Error && len(span.Events()) != 0
+			if failed != tt.wantErr {
+				t.Errorf("Tracing() span status = %v, events = %v, wantErr %v", span.Status(), span.Events(), tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Tracing() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_CompressedValue(t *testing.T) {
+	pipelineId := uuid.New()
+	subKey := cache.RunOutput
+	client, mock := redismock.NewClientMock()
+	marshSubKey, _ := json.Marshal(subKey)
+	compressionThreshold := 1024
+
+	tests := []struct {
+		name           string
+		value          string
+		wantCompressed bool
+	}{
+		{
+			name:           "value below compression threshold",
+			value:          "MOCK_OUTPUT",
+			wantCompressed: false,
+		},
+		{
+			name:           "value above compression threshold",
+			value:          strings.Repeat("MOCK_OUTPUT\n", 1024),
+			wantCompressed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			marshValue, _ := json.Marshal(tt.value)
+			storedValue := marshValue
+			if tt.wantCompressed {
+				storedValue, _ = compress(marshValue)
+				if len(storedValue) >= len(marshValue) {
+					t.Errorf("compress() size = %d, want less than %d", len(storedValue), len(marshValue))
+				}
+			}
+			mock.ExpectExists(pipelineId.String()).SetVal(1)
+			mock.ExpectHSet(pipelineId.String(), marshSubKey, storedValue).SetVal(1)
+			mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(storedValue))
+			rc := &Cache{
+				Cmdable:              client,
+				compressionThreshold: compressionThreshold,
+			}
+			if err := rc.SetValue(context.Background(), pipelineId, subKey, tt.value); err != nil {
+				t.Errorf("SetValue() error = %v", err)
+			}
+			got, err := rc.GetValue(context.Background(), pipelineId, subKey)
+			if err != nil {
+				t.Errorf("GetValue() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("GetValue() got = %v, want %v", got, tt.value)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("CompressedValue() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_MaxValueBytes(t *testing.T) {
+	maxValueBytes := 100
+	// JSON string of maxValueBytes bytes including quotes
+	boundaryValue := strings.Repeat("a", maxValueBytes-2)
+	tests := []struct {
+		name                string
+		value               interface{}
+		truncateLargeValues bool
+		want                string
+		wantErr             bool
+	}{
+		{
+			name:  "value at the limit",
+			value: boundaryValue,
+			want:  boundaryValue,
+		},
+		{
+			name:    "value over the limit is rejected",
+			value:   boundaryValue + "a",
+			wantErr: true,
+		},
+		{
+			name:                "value at the limit isn't truncated",
+			value:               boundaryValue,
+			truncateLargeValues: true,
+			want:                boundaryValue,
+		},
+		{
+			name:                "value over the limit is truncated",
+			value:               boundaryValue + "a",
+			truncateLargeValues: true,
+			want:                strings.Repeat("a", maxValueBytes-2-len(truncatedValueMarker)-1) + truncatedValueMarker,
+		},
+		{
+			name:                "escaped and multibyte characters are truncated by whole characters",
+			value:               strings.Repeat("\"ж", maxValueBytes),
+			truncateLargeValues: true,
+		},
+		{
+			name:                "non-string value over the limit is rejected",
+			value:               []byte(boundaryValue),
+			truncateLargeValues: true,
+			wantErr:             true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc := &Cache{maxValueBytes: maxValueBytes, truncateLargeValues: tt.truncateLargeValues}
+			got, err := rc.encodeValue(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("encodeValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, cache.ErrValueTooLarge) {
+					t.Errorf("encodeValue() error = %v, want %v", err, cache.ErrValueTooLarge)
+				}
+				return
+			}
+			if len(got) > maxValueBytes {
+				t.Errorf("encodeValue() size = %d, want at most %d", len(got), maxValueBytes)
+			}
+			var decoded string
+			if err := json.Unmarshal(got, &decoded); err != nil {
+				t.Fatalf("encodeValue() result isn't valid JSON string: %v", err)
+			}
+			if tt.want != "" && decoded != tt.want {
+				t.Errorf("encodeValue() decoded = %q, want %q", decoded, tt.want)
+			}
+			if tt.truncateLargeValues && decoded != tt.value && !strings.HasSuffix(decoded, truncatedValueMarker) {
+				t.Errorf("encodeValue() decoded = %q, want suffix %q", decoded, truncatedValueMarker)
+			}
+			if !utf8.ValidString(decoded) {
+				t.Errorf("encodeValue() decoded = %q isn't valid UTF-8", decoded)
+			}
+		})
+	}
+
+	// rejected value isn't set to Redis
+	client, mock := redismock.NewClientMock()
+	rc := &Cache{Cmdable: client, maxValueBytes: maxValueBytes}
+	if err := rc.SetValue(context.Background(), uuid.New(), cache.RunOutput, boundaryValue+"a"); !errors.Is(err, cache.ErrValueTooLarge) {
+		t.Errorf("SetValue() error = %v, want %v", err, cache.ErrValueTooLarge)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("MaxValueBytes() %s", err.Error())
+	}
+	// limit which can't fit the marker
+	rc = &Cache{maxValueBytes: 10, truncateLargeValues: true}
+	if _, err := rc.encodeValue(boundaryValue); !errors.Is(err, cache.ErrValueTooLarge) {
+		t.Errorf("encodeValue() error = %v, want %v", err, cache.ErrValueTooLarge)
+	}
+}
+
+// slowHook delays each command until its context is done to simulate a slow reply from Redis
+type slowHook struct{}
+
+func (slowHook) BeforeProcess(ctx context.Context, _ redis.Cmder) (context.Context, error) {
+	<-ctx.Done()
+	return ctx, ctx.Err()
+}
+
+func (slowHook) AfterProcess(context.Context, redis.Cmder) error {
+	return nil
+}
+
+func (slowHook) BeforeProcessPipeline(ctx context.Context, _ []redis.Cmder) (context.Context, error) {
+	<-ctx.Done()
+	return ctx, ctx.Err()
+}
+
+func (slowHook) AfterProcessPipeline(context.Context, []redis.Cmder) error {
+	return nil
+}
+
+func TestRedisCache_OperationTimeout(t *testing.T) {
+	pipelineId := uuid.New()
+	subKey := cache.Status
+	client, mock := redismock.NewClientMock()
+	client.AddHook(slowHook{})
+	marshSubKey, _ := json.Marshal(subKey)
+	marshValue, _ := json.Marshal(1)
+	rc := &Cache{
+		Cmdable:          client,
+		operationTimeout: time.Millisecond * 10,
+	}
+
+	tests := []struct {
+		name   string
+		mocks  func()
+		action func() error
+	}{
+		{
+			name:  "GetValue with slow reply",
+			mocks: func() { mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshValue)) },
+			action: func() error {
+				_, err := rc.GetValue(context.Background(), pipelineId, subKey)
+				return err
+			},
+		},
+		{
+			name:  "SetValue with slow reply",
+			mocks: func() { mock.ExpectExists(pipelineId.String()).SetVal(1) },
+			action: func() error {
+				return rc.SetValue(context.Background(), pipelineId, subKey, 1)
+			},
+		},
+		{
+			name:  "SetExpTime with slow reply",
+			mocks: func() { mock.ExpectExists(pipelineId.String()).SetVal(1) },
+			action: func() error {
+				return rc.SetExpTime(context.Background(), pipelineId, time.Minute)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			start := time.Now()
+			err := tt.action()
+			if !errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("%s error = %v, want %v", tt.name, err, context.DeadlineExceeded)
+			}
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Errorf("%s took %s, want less than %s", tt.name, elapsed, time.Second)
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+// delayHook delays each command to simulate a slow reply from Redis
+type delayHook struct {
+	delay time.Duration
+}
+
+func (h delayHook) BeforeProcess(ctx context.Context, _ redis.Cmder) (context.Context, error) {
+	time.Sleep(h.delay)
+	return ctx, nil
+}
+
+func (delayHook) AfterProcess(context.Context, redis.Cmder) error {
+	return nil
+}
+
+func (h delayHook) BeforeProcessPipeline(ctx context.Context, _ []redis.Cmder) (context.Context, error) {
+	time.Sleep(h.delay)
+	return ctx, nil
+}
+
+func (delayHook) AfterProcessPipeline(context.Context, []redis.Cmder) error {
+	return nil
+}
+
+func TestRedisCache_SubKeyExpirationTime(t *testing.T) {
+	pipelineId := uuid.New()
+	logs := "MOCK_LOGS"
+	marshLogs, _ := json.Marshal(logs)
+	status := pb.Status_STATUS_FINISHED
+	marshStatus, _ := json.Marshal(status)
+	marshStatusSubKey, _ := json.Marshal(cache.Status)
+	logsKey := pipelineId.String() + ":" + string(cache.Logs)
+	logsExpTime := time.Minute * 5
+	client, mock := redismock.NewClientMock()
+
+	tests := []struct {
+		name    string
+		mocks   func()
+		action  func(rc *Cache) (interface{}, error)
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			// Test case with setting logs which have own expiration time.
+			// As a result, want logs to be kept under separate key with own expiration time.
+			name: "SetValue with own expiration time",
+			mocks: func() {
+				mock.ExpectSet(logsKey, marshLogs, logsExpTime).SetVal("OK")
+			},
+			action: func(rc *Cache) (interface{}, error) {
+				return nil, rc.SetValue(context.Background(), pipelineId, cache.Logs, logs)
+			},
+			want: nil,
+		},
+		{
+			// Test case with setting status which doesn't have own expiration time.
+			// As a result, want status to be kept in the pipeline's hash.
+			name: "SetValue without own expiration time",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(0)
+				mock.ExpectHSet(pipelineId.String(), marshStatusSubKey, marshStatus).SetVal(1)
+				mock.Regexp().ExpectHSetNX(pipelineId.String(), `"CREATED_AT"`, `.+`).SetVal(true)
+				mock.ExpectExpire(pipelineId.String(), time.Minute*15).SetVal(true)
+				mock.ExpectPublish(pipelineId.String()+":status", marshStatus).SetVal(0)
+			},
+			action: func(rc *Cache) (interface{}, error) {
+				return nil, rc.SetValue(context.Background(), pipelineId, cache.Status, status)
+			},
+			want: nil,
+		},
+		{
+			name: "SetValues with and without own expiration time",
+			mocks: func() {
+				mock.ExpectSet(logsKey, marshLogs, logsExpTime).SetVal("OK")
+				mock.ExpectExists(pipelineId.String()).SetVal(1)
+				mock.ExpectHSet(pipelineId.String(), marshStatusSubKey, marshStatus).SetVal(1)
+				mock.ExpectPublish(pipelineId.String()+":status", marshStatus).SetVal(0)
+			},
+			action: func(rc *Cache) (interface{}, error) {
+				return nil, rc.SetValues(context.Background(), pipelineId, map[cache.SubKey]interface{}{cache.Logs: logs, cache.Status: status})
+			},
+			want: nil,
+		},
+		{
+			name: "GetValue with own expiration time",
+			mocks: func() {
+				mock.ExpectGet(logsKey).SetVal(string(marshLogs))
+			},
+			action: func(rc *Cache) (interface{}, error) {
+				return rc.GetValue(context.Background(), pipelineId, cache.Logs)
+			},
+			want: logs,
+		},
+		{
+			// Test case with getting logs which are already expired.
+			// As a result, want to receive an error.
+			name: "GetValue of expired subKey",
+			mocks: func() {
+				mock.ExpectGet(logsKey).RedisNil()
+			},
+			action: func(rc *Cache) (interface{}, error) {
+				return rc.GetValue(context.Background(), pipelineId, cache.Logs)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "GetValues with and without own expiration time",
+			mocks: func() {
+				mock.ExpectGet(logsKey).SetVal(string(marshLogs))
+				mock.ExpectHMGet(pipelineId.String(), string(marshStatusSubKey)).SetVal([]interface{}{string(marshStatus)})
+			},
+			action: func(rc *Cache) (interface{}, error) {
+				return rc.GetValues(context.Background(), pipelineId, []cache.SubKey{cache.Logs, cache.Status})
+			},
+			want: map[cache.SubKey]interface{}{cache.Logs: logs, cache.Status: status},
+		},
+		{
+			name: "GetSubKeyCount counts subKeys with own expiration time",
+			mocks: func() {
+				mock.ExpectHLen(pipelineId.String()).SetVal(2)
+				mock.ExpectHExists(pipelineId.String(), `"CREATED_AT"`).SetVal(false)
+				mock.ExpectExists(logsKey).SetVal(1)
+			},
+			action: func(rc *Cache) (interface{}, error) {
+				return rc.GetSubKeyCount(context.Background(), pipelineId)
+			},
+			want: 3,
+		},
+		{
+			name: "DeleteValue with own expiration time",
+			mocks: func() {
+				mock.ExpectDel(logsKey).SetVal(1)
+			},
+			action: func(rc *Cache) (interface{}, error) {
+				return nil, rc.DeleteValue(context.Background(), pipelineId, cache.Logs)
+			},
+			want: nil,
+		},
+		{
+			name: "DeletePipeline removes subKeys with own expiration time",
+			mocks: func() {
+				mock.ExpectDel(pipelineId.String()).SetVal(1)
+				mock.ExpectDel(logsKey).SetVal(1)
+			},
+			action: func(rc *Cache) (interface{}, error) {
+				return nil, rc.DeletePipeline(context.Background(), pipelineId)
+			},
+			want: nil,
+		},
+		{
+			// Test case with setting pipeline's expiration time which is less than logs' one.
+			// As a result, want logs to expire together with the pipeline.
+			name: "SetExpTime shortens own expiration time",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(1)
+				mock.ExpectExpire(pipelineId.String(), time.Minute).SetVal(true)
+				mock.ExpectExpire(logsKey, time.Minute).SetVal(true)
+			},
+			action: func(rc *Cache) (interface{}, error) {
+				return nil, rc.SetExpTime(context.Background(), pipelineId, time.Minute)
+			},
+			want: nil,
+		},
+		{
+			name: "SetExpTime keeps own expiration time",
+			mocks: func() {
+				mock.ExpectExists(pipelineId.String()).SetVal(1)
+				mock.ExpectExpire(pipelineId.String(), time.Hour).SetVal(true)
+				mock.ExpectExpire(logsKey, logsExpTime).SetVal(true)
+			},
+			action: func(rc *Cache) (interface{}, error) {
+				return nil, rc.SetExpTime(context.Background(), pipelineId, time.Hour)
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			rc := &Cache{
+				Cmdable:               client,
+				keyExpirationTime:     time.Minute * 15,
+				subKeyExpirationTimes: map[cache.SubKey]time.Duration{cache.Logs: logsExpTime},
+			}
+			got, err := tt.action(rc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SubKeyExpirationTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SubKeyExpirationTime() got = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("SubKeyExpirationTime() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestRedisCache_SlowOperation(t *testing.T) {
+	pipelineId := uuid.New()
+	subKey := cache.RunOutput
+	marshSubKey, _ := json.Marshal(subKey)
+	marshValue, _ := json.Marshal("MOCK_OUTPUT")
+
+	type slowOperation struct {
+		operation string
+		subKey    cache.SubKey
+	}
+	tests := []struct {
+		name      string
+		delay     time.Duration
+		threshold time.Duration
+		mocks     func(mock redismock.ClientMock)
+		action    func(rc *Cache)
+		want      []slowOperation
+	}{
+		{
+			name:      "slow GetValue",
+			delay:     time.Millisecond * 20,
+			threshold: time.Millisecond * 10,
+			mocks: func(mock redismock.ClientMock) {
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshValue))
+			},
+			action: func(rc *Cache) {
+				_, _ = rc.GetValue(context.Background(), pipelineId, subKey)
+			},
+			want: []slowOperation{{operation: "GetValue", subKey: subKey}},
+		},
+		{
+			name:      "slow SetValue and Expire",
+			delay:     time.Millisecond * 20,
+			threshold: time.Millisecond * 10,
+			mocks: func(mock redismock.ClientMock) {
+				mock.ExpectExists(pipelineId.String()).SetVal(0)
+				mock.ExpectHSet(pipelineId.String(), marshSubKey, marshValue).SetVal(1)
+				mock.Regexp().ExpectHSetNX(pipelineId.String(), `"CREATED_AT"`, `.+`).SetVal(true)
+				mock.ExpectExpire(pipelineId.String(), time.Minute).SetVal(true)
+			},
+			action: func(rc *Cache) {
+				_ = rc.SetValue(context.Background(), pipelineId, subKey, "MOCK_OUTPUT")
+			},
+			want: []slowOperation{{operation: "Expire"}, {operation: "SetValue", subKey: subKey}},
+		},
+		{
+			name:      "fast GetValue",
+			threshold: time.Second,
+			mocks: func(mock redismock.ClientMock) {
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshValue))
+			},
+			action: func(rc *Cache) {
+				_, _ = rc.GetValue(context.Background(), pipelineId, subKey)
+			},
+			want: nil,
+		},
+		{
+			name:      "disabled threshold",
+			delay:     time.Millisecond * 20,
+			threshold: 0,
+			mocks: func(mock redismock.ClientMock) {
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshValue))
+			},
+			action: func(rc *Cache) {
+				_, _ = rc.GetValue(context.Background(), pipelineId, subKey)
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			client.AddHook(delayHook{delay: tt.delay})
+			tt.mocks(mock)
+			var got []slowOperation
+			rc := &Cache{
+				Cmdable:                client,
+				keyExpirationTime:      time.Minute,
+				slowOperationThreshold: tt.threshold,
+				onSlowOperation: func(operation string, id uuid.UUID, subKey cache.SubKey, duration time.Duration) {
+					if id != pipelineId || duration <= tt.threshold {
+						t.Errorf("onSlowOperation() pipelineId = %s, duration = %s", id, duration)
+					}
+					got = append(got, slowOperation{operation: operation, subKey: subKey})
+				},
+			}
+			tt.action(rc)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("onSlowOperation() got = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("SlowOperation() %s", err.Error())
+			}
+		})
+	}
+}
+
+func Test_newRedisCache(t *testing.T) {
+	address := "host:port"
+	type args struct {
+		ctx  context.Context
+		addr string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "error during Ping operation",
+			args: args{
+				ctx:  context.Background(),
+				addr: address,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.args.ctx, tt.args.addr); (err != nil) != tt.wantErr {
+				t.Errorf("newRedisCache() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_newCache(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	type args struct {
+		ctx     context.Context
+		options *Options
+	}
+	tests := []struct {
+		name    string
+		mocks   func()
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Ping operation succeeds after several failures",
+			mocks: func() {
+				mock.ExpectPing().SetErr(fmt.Errorf("MOCK_ERROR"))
+				mock.ExpectPing().SetErr(fmt.Errorf("MOCK_ERROR"))
+				mock.ExpectPing().SetVal("PONG")
+			},
+			args: args{
+				ctx:     context.Background(),
+				options: &Options{PingAttempts: 3, PingRetryDelay: time.Millisecond},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Ping operation fails after all attempts",
+			mocks: func() {
+				mock.ExpectPing().SetErr(fmt.Errorf("MOCK_ERROR"))
+				mock.ExpectPing().SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			args: args{
+				ctx:     context.Background(),
+				options: &Options{PingAttempts: 2, PingRetryDelay: time.Millisecond},
+			},
+			wantErr: true,
+		},
+		{
+			name: "context is canceled between attempts",
+			mocks: func() {
+				mock.ExpectPing().SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			args: args{
+				ctx:     canceledCtx,
+				options: &Options{PingAttempts: 3, PingRetryDelay: time.Minute},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			if _, err := newCache(tt.args.ctx, client, tt.args.options); (err != nil) != tt.wantErr {
+				t.Errorf("newCache() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("newCache() %s", err.Error())
+			}
+			mock.ClearExpect()
+		})
+	}
+}
+
+func TestNewWithOptions(t *testing.T) {
+	address := "host:port"
+	type args struct {
+		ctx     context.Context
+		addr    string
+		options *Options
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "error during Ping operation over TLS",
+			args: args{
+				ctx:     context.Background(),
+				addr:    address,
+				options: &Options{TLSConfig: &tls.Config{MinVersion: tls.VersionTLS12}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewWithOptions(tt.args.ctx, tt.args.addr, tt.args.options); (err != nil) != tt.wantErr {
+				t.Errorf("NewWithOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_newClient(t *testing.T) {
+	address := "host:port"
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	type args struct {
+		addr    string
+		options *Options
+	}
+	defaultOptions := redis.Options{}
+	redis.NewClient(&defaultOptions).Close()
+	tests := []struct {
+		name             string
+		args             args
+		wantTLSConfig    *tls.Config
+		wantPoolSize     int
+		wantMinIdleConns int
+		wantPoolTimeout  time.Duration
+		wantDB           int
+		wantUsername     string
+		wantPassword     string
+	}{
+		{
+			name: "without TLS",
+			args: args{
+				addr:    address,
+				options: &Options{},
+			},
+			wantTLSConfig:    nil,
+			wantPoolSize:     defaultOptions.PoolSize,
+			wantMinIdleConns: defaultOptions.MinIdleConns,
+			wantPoolTimeout:  defaultOptions.PoolTimeout,
+		},
+		{
+			name: "with TLS",
+			args: args{
+				addr:    address,
+				options: &Options{TLSConfig: tlsConfig},
+			},
+			wantTLSConfig:    tlsConfig,
+			wantPoolSize:     defaultOptions.PoolSize,
+			wantMinIdleConns: defaultOptions.MinIdleConns,
+			wantPoolTimeout:  defaultOptions.PoolTimeout,
+		},
+		{
+			name: "with pool options",
+			args: args{
+				addr:    address,
+				options: &Options{PoolSize: 100, MinIdleConns: 10, PoolTimeout: time.Second * 5},
+			},
+			wantTLSConfig:    nil,
+			wantPoolSize:     100,
+			wantMinIdleConns: 10,
+			wantPoolTimeout:  time.Second * 5,
+		},
+		{
+			name: "with DB",
+			args: args{
+				addr:    address,
+				options: &Options{DB: 3},
+			},
+			wantTLSConfig:    nil,
+			wantPoolSize:     defaultOptions.PoolSize,
+			wantMinIdleConns: defaultOptions.MinIdleConns,
+			wantPoolTimeout:  defaultOptions.PoolTimeout,
+			wantDB:           3,
+		},
+		{
+			name: "with credentials",
+			args: args{
+				addr:    address,
+				options: &Options{Username: "MOCK_USERNAME", Password: "MOCK_PASSWORD"},
+			},
+			wantTLSConfig:    nil,
+			wantPoolSize:     defaultOptions.PoolSize,
+			wantMinIdleConns: defaultOptions.MinIdleConns,
+			wantPoolTimeout:  defaultOptions.PoolTimeout,
+			wantUsername:     "MOCK_USERNAME",
+			wantPassword:     "MOCK_PASSWORD",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newClient(tt.args.addr, tt.args.options)
+			defer client.Close()
+			if client.Options().Addr != tt.args.addr {
+				t.Errorf("newClient() addr = %v, want %v", client.Options().Addr, tt.args.addr)
+			}
+			if client.Options().Username != tt.wantUsername || client.Options().Password != tt.wantPassword {
+				t.Errorf("newClient() credentials = %v, %v, want %v, %v", client.Options().Username, client.Options().Password, tt.wantUsername, tt.wantPassword)
+			}
+			if client.Options().DB != tt.wantDB {
+				t.Errorf("newClient() DB = %v, want %v", client.Options().DB, tt.wantDB)
+			}
+			if client.Options().TLSConfig != tt.wantTLSConfig {
+				t.Errorf("newClient() TLSConfig = %v, want %v", client.Options().TLSConfig, tt.wantTLSConfig)
+			}
+			if client.Options().PoolSize != tt.wantPoolSize {
+				t.Errorf("newClient() PoolSize = %v, want %v", client.Options().PoolSize, tt.wantPoolSize)
+			}
+			if client.Options().MinIdleConns != tt.wantMinIdleConns {
+				t.Errorf("newClient() MinIdleConns = %v, want %v", client.Options().MinIdleConns, tt.wantMinIdleConns)
+			}
+			if client.Options().PoolTimeout != tt.wantPoolTimeout {
+				t.Errorf("newClient() PoolTimeout = %v, want %v", client.Options().PoolTimeout, tt.wantPoolTimeout)
+			}
+		})
+	}
+}
+
+func TestNewCluster(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		addrs   []string
+		options *Options
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "error during Ping operation",
+			args: args{
+				ctx:     context.Background(),
+				addrs:   []string{"host1:port", "host2:port"},
+				options: &Options{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewCluster(tt.args.ctx, tt.args.addrs, tt.args.options); (err != nil) != tt.wantErr {
+				t.Errorf("NewCluster() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_newClusterClient(t *testing.T) {
+	addrs := []string{"host1:port", "host2:port"}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	client := newClusterClient(addrs, &Options{Username: "MOCK_USERNAME", Password: "MOCK_PASSWORD", TLSConfig: tlsConfig, PoolSize: 100, MinIdleConns: 10, PoolTimeout: time.Second * 5})
+	defer client.Close()
+	if client.Options().Username != "MOCK_USERNAME" || client.Options().Password != "MOCK_PASSWORD" {
+		t.Errorf("newClusterClient() credentials = %v, %v, want %v, %v", client.Options().Username, client.Options().Password, "MOCK_USERNAME", "MOCK_PASSWORD")
+	}
+	if !reflect.DeepEqual(client.Options().Addrs, addrs) {
+		t.Errorf("newClusterClient() addrs = %v, want %v", client.Options().Addrs, addrs)
+	}
+	if client.Options().TLSConfig != tlsConfig {
+		t.Errorf("newClusterClient() TLSConfig = %v, want %v", client.Options().TLSConfig, tlsConfig)
+	}
+	if options := client.Options(); options.PoolSize != 100 || options.MinIdleConns != 10 || options.PoolTimeout != time.Second*5 {
+		t.Errorf("newClusterClient() pool options = %v, %v, %v, want %v, %v, %v", options.PoolSize, options.MinIdleConns, options.PoolTimeout, 100, 10, time.Second*5)
+	}
+}
+
+// countingHook counts commands which are sent to the client
+type countingHook struct {
+	cmds int
+}
+
+func (h *countingHook) BeforeProcess(ctx context.Context, _ redis.Cmder) (context.Context, error) {
+	h.cmds++
+	return ctx, nil
+}
+
+func (h *countingHook) AfterProcess(context.Context, redis.Cmder) error {
+	return nil
+}
+
+func (h *countingHook) BeforeProcessPipeline(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
+	h.cmds += len(cmds)
+	return ctx, nil
+}
+
+func (h *countingHook) AfterProcessPipeline(context.Context, []redis.Cmder) error {
+	return nil
+}
+
+func TestNewWithClient(t *testing.T) {
+	pipelineId := uuid.New()
+	marshSubKey, _ := json.Marshal(cache.RunOutput)
+	marshValue, _ := json.Marshal("MOCK_OUTPUT")
+
+	tests := []struct {
+		name     string
+		mocks    func(mock redismock.ClientMock)
+		wantErr  bool
+		wantCmds int
+	}{
+		{
+			name: "error during Ping operation",
+			mocks: func(mock redismock.ClientMock) {
+				mock.ExpectPing().SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			wantErr:  true,
+			wantCmds: 0,
+		},
+		{
+			// Test case with the client which is decorated with the hook.
+			// As a result, want all commands of the cache to pass through the hook.
+			name: "all success",
+			mocks: func(mock redismock.ClientMock) {
+				mock.ExpectPing().SetVal("PONG")
+				mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshValue))
+			},
+			wantErr:  false,
+			wantCmds: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			hook := &countingHook{}
+			client.AddHook(hook)
+			tt.mocks(mock)
+			rc, err := NewWithClient(context.Background(), client, &Options{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewWithClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				if _, err := rc.GetValue(context.Background(), pipelineId, cache.RunOutput); err != nil {
+					t.Errorf("GetValue() error = %v", err)
+				}
+			}
+			if hook.cmds != tt.wantCmds {
+				t.Errorf("NewWithClient() commands through hook = %d, want %d", hook.cmds, tt.wantCmds)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("NewWithClient() %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestNewFailover(t *testing.T) {
+	type args struct {
+		ctx           context.Context
+		masterName    string
+		sentinelAddrs []string
+		options       *Options
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "error during Ping operation",
+			args: args{
+				ctx:           context.Background(),
+				masterName:    "mymaster",
+				sentinelAddrs: []string{"host1:port", "host2:port"},
+				options:       &Options{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewFailover(tt.args.ctx, tt.args.masterName, tt.args.sentinelAddrs, tt.args.options); (err != nil) != tt.wantErr {
+				t.Errorf("NewFailover() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// recordingHook keeps all commands which are sent to the client and fails them to not connect to Redis
+type recordingHook struct {
+	cmds [][]interface{}
+}
+
+func (h *recordingHook) BeforeProcess(ctx context.Context, cmd redis.Cmder) (context.Context, error) {
+	h.cmds = append(h.cmds, cmd.Args())
+	return ctx, fmt.Errorf("MOCK_ERROR")
+}
+
+func (h *recordingHook) AfterProcess(context.Context, redis.Cmder) error {
+	return nil
+}
+
+func (h *recordingHook) BeforeProcessPipeline(ctx context.Context, _ []redis.Cmder) (context.Context, error) {
+	return ctx, fmt.Errorf("MOCK_ERROR")
+}
+
+func (h *recordingHook) AfterProcessPipeline(context.Context, []redis.Cmder) error {
+	return nil
+}
+
+func Test_newFailoverClient(t *testing.T) {
+	pipelineId := uuid.New()
+	subKey := cache.RunOutput
+	marshSubKey, _ := json.Marshal(subKey)
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	client := newFailoverClient("mymaster", []string{"host1:port", "host2:port"}, &Options{Username: "MOCK_USERNAME", Password: "MOCK_PASSWORD", DB: 3, TLSConfig: tlsConfig, PoolSize: 100, MinIdleConns: 10, PoolTimeout: time.Second * 5})
+	defer client.Close()
+	if client.Options().Username != "MOCK_USERNAME" || client.Options().Password != "MOCK_PASSWORD" {
+		t.Errorf("newFailoverClient() credentials = %v, %v, want %v, %v", client.Options().Username, client.Options().Password, "MOCK_USERNAME", "MOCK_PASSWORD")
+	}
+	if client.Options().DB != 3 {
+		t.Errorf("newFailoverClient() DB = %v, want %v", client.Options().DB, 3)
+	}
+	if client.Options().TLSConfig != tlsConfig {
+		t.Errorf("newFailoverClient() TLSConfig = %v, want %v", client.Options().TLSConfig, tlsConfig)
+	}
+	if options := client.Options(); options.PoolSize != 100 || options.MinIdleConns != 10 || options.PoolTimeout != time.Second*5 {
+		t.Errorf("newFailoverClient() pool options = %v, %v, %v, want %v, %v, %v", options.PoolSize, options.MinIdleConns, options.PoolTimeout, 100, 10, time.Second*5)
+	}
+
+	hook := &recordingHook{}
+	client.AddHook(hook)
+	rc := &Cache{Cmdable: client}
+	if _, err := rc.GetValue(context.Background(), pipelineId, subKey); err == nil {
+		t.Errorf("GetValue() error = %v, want MOCK_ERROR", err)
+	}
+	if err := rc.SetValue(context.Background(), pipelineId, subKey, "MOCK_OUTPUT"); err == nil {
+		t.Errorf("SetValue() error = %v, want MOCK_ERROR", err)
+	}
+	want := [][]interface{}{
+		{"hget", pipelineId.String(), string(marshSubKey)},
+		{"exists", pipelineId.String()},
+	}
+	if !reflect.DeepEqual(hook.cmds, want) {
+		t.Errorf("newFailoverClient() commands = %v, want %v", hook.cmds, want)
+	}
+}
+
+func Test_forwardStatuses(t *testing.T) {
+	statusValue, _ := json.Marshal(pb.Status_STATUS_FINISHED)
+	tests := []struct {
+		name     string
+		payloads []string
+		want     []pb.Status
+	}{
+		{
+			name:     "statuses are forwarded",
+			payloads: []string{string(statusValue), string(statusValue)},
+			want:     []pb.Status{pb.Status_STATUS_FINISHED, pb.Status_STATUS_FINISHED},
+		},
+		{
+			name:     "invalid payload is skipped",
+			payloads: []string{"MOCK_INVALID_STATUS", string(statusValue)},
+			want:     []pb.Status{pb.Status_STATUS_FINISHED},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			messages := make(chan *redis.Message, len(tt.payloads))
+			for _, payload := range tt.payloads {
+				messages <- &redis.Message{Channel: "MOCK_CHANNEL", Payload: payload}
+			}
+			close(messages)
+			statuses := make(chan pb.Status)
+			go forwardStatuses(ctx, cache.JSONCodec, messages, statuses)
+			var got []pb.Status
+			for status := range statuses {
+				got = append(got, status)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("forwardStatuses() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_forwardStatusesContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	statuses := make(chan pb.Status)
+	go forwardStatuses(ctx, cache.JSONCodec, make(chan *redis.Message), statuses)
+	cancel()
+	select {
+	case _, ok := <-statuses:
+		if ok {
+			t.Error("forwardStatuses() sent status, want closed channel")
+		}
+	case <-time.After(time.Second):
+		t.Error("forwardStatuses() doesn't close channel after context is done")
+	}
+}
+
+func TestRedisCache_Cluster(t *testing.T) {
+	pipelineId := uuid.New()
+	subKey := cache.RunOutput
+	value := "MOCK_OUTPUT"
+	expTime := time.Minute
+	client, mock := redismock.NewClusterMock()
+	defer client.Close()
+	marshSubKey, _ := json.Marshal(subKey)
+	marshValue, _ := json.Marshal(value)
+
+	mock.ExpectExists(pipelineId.String()).SetVal(0)
+	mock.ExpectHSet(pipelineId.String(), marshSubKey, marshValue).SetVal(1)
+	mock.Regexp().ExpectHSetNX(pipelineId.String(), `"CREATED_AT"`, `.+`).SetVal(true)
+	mock.ExpectExpire(pipelineId.String(), defaultKeyExpirationTime).SetVal(true)
+	mock.ExpectHGet(pipelineId.String(), string(marshSubKey)).SetVal(string(marshValue))
+	mock.ExpectExists(pipelineId.String()).SetVal(1)
+	mock.ExpectExpire(pipelineId.String(), expTime).SetVal(true)
+
+	rc := &Cache{
+		Cmdable:           client,
+		keyExpirationTime: defaultKeyExpirationTime,
+	}
+	if err := rc.SetValue(context.Background(), pipelineId, subKey, value); err != nil {
+		t.Errorf("SetValue() error = %v", err)
+	}
+	got, err := rc.GetValue(context.Background(), pipelineId, subKey)
+	if err != nil {
+		t.Errorf("GetValue() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, value) {
+		t.Errorf("GetValue() got = %v, want %v", got, value)
+	}
+	if err := rc.SetExpTime(context.Background(), pipelineId, expTime); err != nil {
+		t.Errorf("SetExpTime() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Cluster() %s", err.Error())
+	}
+}
+
+func Test_unmarshalBySubKey(t *testing.T) {
+	status := pb.Status_STATUS_FINISHED
+	statusValue, _ := json.Marshal(status)
+	output := "MOCK_OUTPUT"
+	outputValue, _ := json.Marshal(output)
+	runResult := cache.ExecutionResult{ExitCode: 1, Duration: time.Second * 3, PeakMemory: 1024}
+	runResultValue, _ := json.Marshal(runResult)
+	msgpackStatusValue, _ := msgpack.Marshal(status)
+	msgpackRunResultValue, _ := msgpack.Marshal(runResult)
+	timings := cache.StageTimings{cache.ValidateStage: time.Millisecond * 5, cache.CompileStage: time.Second * 2, cache.RunStage: time.Second * 3}
+	timingsValue, _ := json.Marshal(timings)
+	msgpackTimingsValue, _ := msgpack.Marshal(timings)
+	msgpackCanceledValue, _ := msgpack.Marshal(true)
+	command := "javac -d bin Main.java\njava -cp bin: Main --password=<redacted>"
+	commandValue, _ := json.Marshal(command)
+	msgpackCommandValue, _ := msgpack.Marshal(command)
+	graph := cache.PipelineGraph{
+		Nodes: []cache.GraphNode{{Id: "1", Label: "Read"}, {Id: "2", Label: "Write"}},
+		Edges: []cache.GraphEdge{{From: "1", To: "2", Label: "Lines"}},
+	}
+	graphValue, _ := json.Marshal(graph)
+	msgpackGraphValue, _ := msgpack.Marshal(graph)
+	type args struct {
+		ctx    context.Context
+		codec  cache.Codec
+		subKey cache.SubKey
+		value  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "status subKey",
+			args: args{
+				ctx:    context.Background(),
+				subKey: cache.Status,
+				value:  string(statusValue),
+			},
+			want:    status,
+			wantErr: false,
+		},
+		{
+			name: "runOutput subKey",
+			args: args{
+				subKey: cache.RunOutput,
+				value:  string(outputValue),
+			},
+			want:    output,
+			wantErr: false,
+		},
+		{
+			name: "runError subKey",
+			args: args{
+				subKey: cache.RunError,
+				value:  string(outputValue),
+			},
+			want:    output,
+			wantErr: false,
+		},
+		{
+			name: "validationOutput subKey",
+			args: args{
+				subKey: cache.ValidationOutput,
+				value:  string(outputValue),
+			},
+			want:    output,
+			wantErr: false,
+		},
+		{
+			name: "compileOutput subKey",
+			args: args{
+				subKey: cache.CompileOutput,
+				value:  string(outputValue),
+			},
+			want:    output,
+			wantErr: false,
+		},
+		{
+			name: "graph subKey",
+			args: args{
+				subKey: cache.Graph,
+				value:  string(outputValue),
+			},
+			want:    output,
+			wantErr: false,
+		},
+		{
+			name: "structured graph subKey",
+			args: args{
+				subKey: cache.Graph,
+				value:  string(graphValue),
+			},
+			want:    graph,
+			wantErr: false,
+		},
+		{
+			name: "structured graph subKey with msgpack codec",
+			args: args{
+				codec:  msgpack.Codec,
+				subKey: cache.Graph,
+				value:  string(msgpackGraphValue),
+			},
+			want:    graph,
+			wantErr: false,
+		},
+		{
+			name: "runResult subKey",
+			args: args{
+				subKey: cache.RunResult,
+				value:  string(runResultValue),
+			},
+			want:    runResult,
+			wantErr: false,
+		},
+		{
+			name: "status subKey with msgpack codec",
+			args: args{
+				codec:  msgpack.Codec,
+				subKey: cache.Status,
+				value:  string(msgpackStatusValue),
+			},
+			want:    status,
+			wantErr: false,
+		},
+		{
+			name: "runResult subKey with msgpack codec",
+			args: args{
+				codec:  msgpack.Codec,
+				subKey: cache.RunResult,
+				value:  string(msgpackRunResultValue),
+			},
+			want:    runResult,
+			wantErr: false,
+		},
+		{
+			name: "timings subKey",
+			args: args{
+				subKey: cache.Timings,
+				value:  string(timingsValue),
+			},
+			want:    timings,
+			wantErr: false,
+		},
+		{
+			name: "timings subKey with msgpack codec",
+			args: args{
+				codec:  msgpack.Codec,
+				subKey: cache.Timings,
+				value:  string(msgpackTimingsValue),
+			},
+			want:    timings,
+			wantErr: false,
+		},
+		{
+			name: "canceled subKey with true value",
+			args: args{
+				subKey: cache.Canceled,
+				value:  "true",
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "executionCommand subKey",
+			args: args{
+				subKey: cache.ExecutionCommand,
+				value:  string(commandValue),
+			},
+			want:    command,
+			wantErr: false,
+		},
+		{
+			name: "executionCommand subKey with msgpack codec",
+			args: args{
+				codec:  msgpack.Codec,
+				subKey: cache.ExecutionCommand,
+				value:  string(msgpackCommandValue),
+			},
+			want:    command,
+			wantErr: false,
+		},
+		{
+			name: "canceled subKey with false value",
+			args: args{
+				subKey: cache.Canceled,
+				value:  "false",
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "canceled subKey with msgpack codec",
+			args: args{
+				codec:  msgpack.Codec,
+				subKey: cache.Canceled,
+				value:  string(msgpackCanceledValue),
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "binary value",
+			args: args{
+				subKey: cache.Graph,
+				value:  rawValueHeader + "\x00PNG\xff\x80\x00",
+			},
+			want:    []byte("\x00PNG\xff\x80\x00"),
+			wantErr: false,
+		},
+		{
+			name: "JSON value with msgpack codec",
+			args: args{
+				codec:  msgpack.Codec,
+				subKey: cache.RunOutput,
+				value:  string(outputValue),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec := tt.args.codec
+			if codec == nil {
+				codec = cache.JSONCodec
+			}
+			got, err := unmarshalBySubKey(codec, tt.args.subKey, tt.args.value)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("unmarshalBySubKey() error = %v, wantErr %v", err, tt.wantErr)
 			}
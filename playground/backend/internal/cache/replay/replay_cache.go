@@ -0,0 +1,457 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrUnexpectedOperation is returned in replay mode when the operation doesn't match the next operation of the log
+var ErrUnexpectedOperation = errors.New("operation doesn't match the recorded log")
+
+// errorCategories are categories of errors of the cache package which are kept for recorded errors
+var errorCategories = []struct {
+	name     string
+	category error
+}{
+	{name: "NOT_FOUND", category: cache.ErrNotFound},
+	{name: "CONNECTION", category: cache.ErrConnection},
+	{name: "DECODE", category: cache.ErrDecode},
+	{name: "VALUE_TOO_LARGE", category: cache.ErrValueTooLarge},
+}
+
+// entry is one recorded operation which is kept as a line of JSON in the log
+type entry struct {
+	Operation  string
+	PipelineId uuid.UUID
+	SubKey     cache.SubKey    `json:",omitempty"`
+	Args       json.RawMessage `json:",omitempty"`
+	Result     json.RawMessage `json:",omitempty"`
+	Error      string          `json:",omitempty"`
+	Category   string          `json:",omitempty"`
+}
+
+// recordedValue is a value of the cache in the log. Values are decoded by their subKeys in replay mode,
+// so types of replayed values are the same as types of values which are read from Redis.
+type recordedValue struct {
+	Value  json.RawMessage
+	Binary bool `json:",omitempty"`
+}
+
+// ttlValue is the result of GetValueWithTTL in the log
+type ttlValue struct {
+	Value recordedValue
+	TTL   time.Duration
+}
+
+// recordedError is the error of the recorded operation which keeps its message and category
+type recordedError struct {
+	message  string
+	category error
+}
+
+func (e *recordedError) Error() string {
+	return e.message
+}
+
+func (e *recordedError) Is(target error) bool {
+	return e.category != nil && target == e.category
+}
+
+// Cache records operations of the wrapped Cache to the log or replays them from the log, e.g. to test code which uses
+// the cache deterministically without Redis.
+// In record mode each operation is forwarded to the wrapped Cache, and the operation with its arguments and result
+// is written to the log file. In replay mode results of operations are served from the log. Operations must be
+// called in the recorded order with the same arguments, otherwise they fail with ErrUnexpectedOperation.
+// SubscribeStatus and HealthCheck aren't recorded: in replay mode statuses aren't received and HealthCheck succeeds.
+type Cache struct {
+	// wrapped is the Cache of record mode, it is nil in replay mode
+	wrapped cache.Cache
+	mu      sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+	entries []entry
+	next    int
+}
+
+// NewRecorder returns Cache which forwards operations to the wrapped Cache and records them to the file by path.
+// The file is created or truncated. It is closed by Close.
+func NewRecorder(wrapped cache.Cache, path string) (*Cache, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Cache{
+		wrapped: wrapped,
+		file:    file,
+		encoder: json.NewEncoder(file),
+	}, nil
+}
+
+// NewReplayer returns Cache which replays operations from the file by path which is written by NewRecorder
+func NewReplayer(path string) (*Cache, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	entries := make([]entry, 0)
+	decoder := json.NewDecoder(file)
+	for {
+		var e entry
+		err = decoder.Decode(&e)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("entry: %d of log: %s: %w", len(entries), path, err)
+		}
+		entries = append(entries, e)
+	}
+	return &Cache{entries: entries}, nil
+}
+
+func (c *Cache) GetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
+	var value interface{}
+	var recorded recordedValue
+	err := c.do(entry{Operation: "GetValue", PipelineId: pipelineId, SubKey: subKey}, nil, func() (interface{}, error) {
+		var err error
+		if value, err = c.wrapped.GetValue(ctx, pipelineId, subKey); err != nil {
+			return nil, err
+		}
+		return newRecordedValue(value)
+	}, &recorded)
+	if err != nil || c.wrapped != nil {
+		return value, err
+	}
+	return recorded.decode(subKey)
+}
+
+func (c *Cache) GetValueWithTTL(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, time.Duration, error) {
+	var value interface{}
+	var ttl time.Duration
+	var recorded ttlValue
+	err := c.do(entry{Operation: "GetValueWithTTL", PipelineId: pipelineId, SubKey: subKey}, nil, func() (interface{}, error) {
+		var err error
+		if value, ttl, err = c.wrapped.GetValueWithTTL(ctx, pipelineId, subKey); err != nil {
+			return nil, err
+		}
+		valueRecord, err := newRecordedValue(value)
+		return ttlValue{Value: valueRecord, TTL: ttl}, err
+	}, &recorded)
+	if err != nil || c.wrapped != nil {
+		return value, ttl, err
+	}
+	value, err = recorded.Value.decode(subKey)
+	return value, recorded.TTL, err
+}
+
+func (c *Cache) GetValues(ctx context.Context, pipelineId uuid.UUID, subKeys []cache.SubKey) (map[cache.SubKey]interface{}, error) {
+	var values map[cache.SubKey]interface{}
+	var recorded map[cache.SubKey]recordedValue
+	err := c.do(entry{Operation: "GetValues", PipelineId: pipelineId}, subKeys, func() (interface{}, error) {
+		var err error
+		if values, err = c.wrapped.GetValues(ctx, pipelineId, subKeys); err != nil {
+			return nil, err
+		}
+		return newRecordedValues(values)
+	}, &recorded)
+	if err != nil || c.wrapped != nil {
+		return values, err
+	}
+	return decodeValues(recorded)
+}
+
+func (c *Cache) ScanValues(ctx context.Context, pipelineId uuid.UUID) (map[cache.SubKey]interface{}, error) {
+	var values map[cache.SubKey]interface{}
+	var recorded map[cache.SubKey]recordedValue
+	err := c.do(entry{Operation: "ScanValues", PipelineId: pipelineId}, nil, func() (interface{}, error) {
+		var err error
+		if values, err = c.wrapped.ScanValues(ctx, pipelineId); err != nil {
+			return nil, err
+		}
+		return newRecordedValues(values)
+	}, &recorded)
+	if err != nil || c.wrapped != nil {
+		return values, err
+	}
+	return decodeValues(recorded)
+}
+
+func (c *Cache) GetPipelines(ctx context.Context) ([]uuid.UUID, error) {
+	var pipelines []uuid.UUID
+	err := c.do(entry{Operation: "GetPipelines"}, nil, func() (interface{}, error) {
+		var err error
+		pipelines, err = c.wrapped.GetPipelines(ctx)
+		return pipelines, err
+	}, &pipelines)
+	return pipelines, err
+}
+
+func (c *Cache) GetAge(ctx context.Context, pipelineId uuid.UUID) (time.Duration, error) {
+	var age time.Duration
+	err := c.do(entry{Operation: "GetAge", PipelineId: pipelineId}, nil, func() (interface{}, error) {
+		var err error
+		age, err = c.wrapped.GetAge(ctx, pipelineId)
+		return age, err
+	}, &age)
+	return age, err
+}
+
+func (c *Cache) GetSubKeyCount(ctx context.Context, pipelineId uuid.UUID) (int, error) {
+	var count int
+	err := c.do(entry{Operation: "GetSubKeyCount", PipelineId: pipelineId}, nil, func() (interface{}, error) {
+		var err error
+		count, err = c.wrapped.GetSubKeyCount(ctx, pipelineId)
+		return count, err
+	}, &count)
+	return count, err
+}
+
+func (c *Cache) SetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) error {
+	return c.do(entry{Operation: "SetValue", PipelineId: pipelineId, SubKey: subKey}, value, func() (interface{}, error) {
+		return nil, c.wrapped.SetValue(ctx, pipelineId, subKey, value)
+	}, nil)
+}
+
+func (c *Cache) SetValues(ctx context.Context, pipelineId uuid.UUID, values map[cache.SubKey]interface{}) error {
+	return c.do(entry{Operation: "SetValues", PipelineId: pipelineId}, values, func() (interface{}, error) {
+		return nil, c.wrapped.SetValues(ctx, pipelineId, values)
+	}, nil)
+}
+
+func (c *Cache) Preload(ctx context.Context, pipelines map[uuid.UUID]map[cache.SubKey]interface{}) error {
+	return c.do(entry{Operation: "Preload"}, pipelines, func() (interface{}, error) {
+		return nil, c.wrapped.Preload(ctx, pipelines)
+	}, nil)
+}
+
+func (c *Cache) SetStatusIfNotTerminal(ctx context.Context, pipelineId uuid.UUID, status pb.Status) (bool, error) {
+	var isSet bool
+	err := c.do(entry{Operation: "SetStatusIfNotTerminal", PipelineId: pipelineId}, status, func() (interface{}, error) {
+		var err error
+		isSet, err = c.wrapped.SetStatusIfNotTerminal(ctx, pipelineId, status)
+		return isSet, err
+	}, &isSet)
+	return isSet, err
+}
+
+// SubscribeStatus subscribes to statuses of the wrapped Cache in record mode without recording.
+// In replay mode it returns channel which doesn't receive statuses and is closed when ctx is done.
+func (c *Cache) SubscribeStatus(ctx context.Context, pipelineId uuid.UUID) (<-chan pb.Status, error) {
+	if c.wrapped != nil {
+		return c.wrapped.SubscribeStatus(ctx, pipelineId)
+	}
+	statuses := make(chan pb.Status)
+	go func() {
+		<-ctx.Done()
+		close(statuses)
+	}()
+	return statuses, nil
+}
+
+func (c *Cache) DeleteValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) error {
+	return c.do(entry{Operation: "DeleteValue", PipelineId: pipelineId, SubKey: subKey}, nil, func() (interface{}, error) {
+		return nil, c.wrapped.DeleteValue(ctx, pipelineId, subKey)
+	}, nil)
+}
+
+func (c *Cache) DeletePipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	return c.do(entry{Operation: "DeletePipeline", PipelineId: pipelineId}, nil, func() (interface{}, error) {
+		return nil, c.wrapped.DeletePipeline(ctx, pipelineId)
+	}, nil)
+}
+
+func (c *Cache) FlushAll(ctx context.Context) error {
+	return c.do(entry{Operation: "FlushAll"}, nil, func() (interface{}, error) {
+		return nil, c.wrapped.FlushAll(ctx)
+	}, nil)
+}
+
+func (c *Cache) SetExpTime(ctx context.Context, pipelineId uuid.UUID, expTime time.Duration) error {
+	return c.do(entry{Operation: "SetExpTime", PipelineId: pipelineId}, expTime, func() (interface{}, error) {
+		return nil, c.wrapped.SetExpTime(ctx, pipelineId, expTime)
+	}, nil)
+}
+
+func (c *Cache) TouchPipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	return c.do(entry{Operation: "TouchPipeline", PipelineId: pipelineId}, nil, func() (interface{}, error) {
+		return nil, c.wrapped.TouchPipeline(ctx, pipelineId)
+	}, nil)
+}
+
+// HealthCheck checks the wrapped Cache without recording in record mode and always succeeds in replay mode
+func (c *Cache) HealthCheck(ctx context.Context) error {
+	if c.wrapped != nil {
+		return c.wrapped.HealthCheck(ctx)
+	}
+	return nil
+}
+
+// Close closes the wrapped Cache and the log file in record mode. In replay mode it does nothing.
+func (c *Cache) Close() error {
+	if c.wrapped == nil {
+		return nil
+	}
+	err := c.wrapped.Close()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if closeErr := c.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// do records the operation in record mode or replays it in replay mode.
+// args are marshaled to the log to check that replayed operations are called with the same arguments.
+// In record mode result of run is marshaled to the log. In replay mode the recorded result is unmarshaled to result.
+func (c *Cache) do(e entry, args interface{}, run func() (interface{}, error), result interface{}) error {
+	if args != nil {
+		argsMarsh, err := json.Marshal(args)
+		if err != nil {
+			return err
+		}
+		e.Args = argsMarsh
+	}
+	if c.wrapped == nil {
+		return c.replay(e, result)
+	}
+	return c.record(e, run)
+}
+
+// record calls the operation of the wrapped Cache and writes it with its result or error to the log
+func (c *Cache) record(e entry, run func() (interface{}, error)) error {
+	result, err := run()
+	if err != nil {
+		e.Error = err.Error()
+		e.Category = categoryName(err)
+	} else if result != nil {
+		resultMarsh, marshErr := json.Marshal(result)
+		if marshErr != nil {
+			return marshErr
+		}
+		e.Result = resultMarsh
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if encodeErr := c.encoder.Encode(e); encodeErr != nil && err == nil {
+		return encodeErr
+	}
+	return err
+}
+
+// replay checks that the operation matches the next entry of the log and returns the recorded result or error
+func (c *Cache) replay(e entry, result interface{}) error {
+	c.mu.Lock()
+	if c.next >= len(c.entries) {
+		c.mu.Unlock()
+		return fmt.Errorf("operation: %s, pipelineId: %s, subKey: %s after the end of the log: %w", e.Operation, e.PipelineId, e.SubKey, ErrUnexpectedOperation)
+	}
+	recorded := c.entries[c.next]
+	if recorded.Operation != e.Operation || recorded.PipelineId != e.PipelineId || recorded.SubKey != e.SubKey || !bytes.Equal(recorded.Args, e.Args) {
+		c.mu.Unlock()
+		return fmt.Errorf("operation: %s, pipelineId: %s, subKey: %s, recorded operation: %s, pipelineId: %s, subKey: %s: %w",
+			e.Operation, e.PipelineId, e.SubKey, recorded.Operation, recorded.PipelineId, recorded.SubKey, ErrUnexpectedOperation)
+	}
+	c.next++
+	c.mu.Unlock()
+
+	if recorded.Error != "" {
+		return &recordedError{message: recorded.Error, category: category(recorded.Category)}
+	}
+	if result == nil || len(recorded.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(recorded.Result, result)
+}
+
+// newRecordedValue marshals value of the cache to recordedValue
+func newRecordedValue(value interface{}) (recordedValue, error) {
+	_, binary := value.([]byte)
+	valueMarsh, err := json.Marshal(value)
+	if err != nil {
+		return recordedValue{}, err
+	}
+	return recordedValue{Value: valueMarsh, Binary: binary}, nil
+}
+
+// newRecordedValues marshals values of the cache to recordedValue by their subKeys
+func newRecordedValues(values map[cache.SubKey]interface{}) (map[cache.SubKey]recordedValue, error) {
+	recorded := make(map[cache.SubKey]recordedValue, len(values))
+	for subKey, value := range values {
+		valueRecord, err := newRecordedValue(value)
+		if err != nil {
+			return nil, err
+		}
+		recorded[subKey] = valueRecord
+	}
+	return recorded, nil
+}
+
+// decode decodes the recorded value by subKey using decoders of the cache package
+func (v recordedValue) decode(subKey cache.SubKey) (interface{}, error) {
+	if v.Binary {
+		var value []byte
+		err := json.Unmarshal(v.Value, &value)
+		return value, err
+	}
+	return cache.Decode(subKey, string(v.Value))
+}
+
+// decodeValues decodes recorded values by their subKeys
+func decodeValues(recorded map[cache.SubKey]recordedValue) (map[cache.SubKey]interface{}, error) {
+	values := make(map[cache.SubKey]interface{}, len(recorded))
+	for subKey, valueRecord := range recorded {
+		value, err := valueRecord.decode(subKey)
+		if err != nil {
+			return nil, err
+		}
+		values[subKey] = value
+	}
+	return values, nil
+}
+
+// categoryName returns the name of the category of err or an empty string if err isn't categorized
+func categoryName(err error) string {
+	for _, errorCategory := range errorCategories {
+		if errors.Is(err, errorCategory.category) {
+			return errorCategory.name
+		}
+	}
+	return ""
+}
+
+// category returns the category of the error by its name or nil if the name is unknown
+func category(name string) error {
+	for _, errorCategory := range errorCategories {
+		if errorCategory.name == name {
+			return errorCategory.category
+		}
+	}
+	return nil
+}
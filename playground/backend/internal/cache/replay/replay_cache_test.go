@@ -0,0 +1,151 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/local"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+var _ cache.Cache = (*Cache)(nil)
+
+// operation is one call of the recorded sequence with its result
+type operation struct {
+	name string
+	call func(c cache.Cache) (interface{}, error)
+}
+
+func TestCache_RecordReplay(t *testing.T) {
+	ctx := context.Background()
+	pipelineId, _ := uuid.Parse("7b7a1b18-13f3-4a9c-9b8e-2d6a2c0b6f7e")
+	path := filepath.Join(t.TempDir(), "cache.log")
+	operations := []operation{
+		{name: "SetValue status", call: func(c cache.Cache) (interface{}, error) {
+			return nil, c.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_EXECUTING)
+		}},
+		{name: "SetValue output", call: func(c cache.Cache) (interface{}, error) {
+			return nil, c.SetValue(ctx, pipelineId, cache.RunOutput, "MOCK_OUTPUT")
+		}},
+		{name: "SetValue graph", call: func(c cache.Cache) (interface{}, error) {
+			return nil, c.SetValue(ctx, pipelineId, cache.Graph, []byte{0x00, 0xff})
+		}},
+		{name: "GetValue status", call: func(c cache.Cache) (interface{}, error) {
+			return c.GetValue(ctx, pipelineId, cache.Status)
+		}},
+		{name: "GetValue output", call: func(c cache.Cache) (interface{}, error) {
+			return c.GetValue(ctx, pipelineId, cache.RunOutput)
+		}},
+		{name: "GetValue graph", call: func(c cache.Cache) (interface{}, error) {
+			return c.GetValue(ctx, pipelineId, cache.Graph)
+		}},
+		{name: "GetValue missing subKey", call: func(c cache.Cache) (interface{}, error) {
+			return c.GetValue(ctx, pipelineId, cache.RunError)
+		}},
+		{name: "GetValues", call: func(c cache.Cache) (interface{}, error) {
+			return c.GetValues(ctx, pipelineId, []cache.SubKey{cache.Status, cache.RunOutput})
+		}},
+		{name: "GetSubKeyCount", call: func(c cache.Cache) (interface{}, error) {
+			return c.GetSubKeyCount(ctx, pipelineId)
+		}},
+	}
+
+	recorder, err := NewRecorder(local.New(ctx), path)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	recordedResults := make([]interface{}, len(operations))
+	recordedErrors := make([]error, len(operations))
+	for i, op := range operations {
+		recordedResults[i], recordedErrors[i] = op.call(recorder)
+	}
+	if err = recorder.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("NewReplayer() error = %v", err)
+	}
+	for i, op := range operations {
+		t.Run(op.name, func(t *testing.T) {
+			got, err := op.call(replayer)
+			if (err != nil) != (recordedErrors[i] != nil) {
+				t.Fatalf("replayed error = %v, recorded error = %v", err, recordedErrors[i])
+			}
+			if errors.Is(err, cache.ErrNotFound) != errors.Is(recordedErrors[i], cache.ErrNotFound) {
+				t.Errorf("replayed error = %v, recorded error = %v", err, recordedErrors[i])
+			}
+			if !reflect.DeepEqual(got, recordedResults[i]) {
+				t.Errorf("replayed result = %#v, recorded result = %#v", got, recordedResults[i])
+			}
+		})
+	}
+	if _, err = replayer.GetValue(ctx, pipelineId, cache.Status); !errors.Is(err, ErrUnexpectedOperation) {
+		t.Errorf("GetValue() after the end of the log error = %v, want %v", err, ErrUnexpectedOperation)
+	}
+}
+
+func TestCache_ReplayUnexpectedOperation(t *testing.T) {
+	ctx := context.Background()
+	pipelineId := uuid.New()
+	path := filepath.Join(t.TempDir(), "cache.log")
+	recorder, err := NewRecorder(local.New(ctx), path)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	if err = recorder.SetValue(ctx, pipelineId, cache.RunOutput, "MOCK_OUTPUT"); err != nil {
+		t.Fatalf("SetValue() error = %v", err)
+	}
+	if err = recorder.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		call func(c cache.Cache) error
+	}{
+		{
+			name: "other operation",
+			call: func(c cache.Cache) error { return c.DeletePipeline(ctx, pipelineId) },
+		},
+		{
+			name: "other subKey",
+			call: func(c cache.Cache) error { return c.SetValue(ctx, pipelineId, cache.RunError, "MOCK_OUTPUT") },
+		},
+		{
+			name: "other value",
+			call: func(c cache.Cache) error { return c.SetValue(ctx, pipelineId, cache.RunOutput, "MOCK_OTHER_OUTPUT") },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replayer, err := NewReplayer(path)
+			if err != nil {
+				t.Fatalf("NewReplayer() error = %v", err)
+			}
+			if err = tt.call(replayer); !errors.Is(err, ErrUnexpectedOperation) {
+				t.Errorf("error = %v, want %v", err, ErrUnexpectedOperation)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/logger"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+// maxRetryDelay is the max delay between attempts of one operation
+const maxRetryDelay = time.Second * 2
+
+// Cache retries operations of the wrapped Cache which fail with connection errors (see cache.IsConnectionError),
+// e.g. connection reset or timeout. The delay before the first retry is baseDelay and it is doubled before each next
+// retry up to maxRetryDelay. Logical errors (e.g. cache.ErrNotFound) are returned without retries,
+// and operations are not retried after ctx is done.
+// HealthCheck and Close are not retried.
+type Cache struct {
+	wrapped    cache.Cache
+	maxRetries int
+	baseDelay  time.Duration
+}
+
+// New returns Cache which retries failed operations of the wrapped Cache up to maxRetries times
+func New(wrapped cache.Cache, maxRetries int, baseDelay time.Duration) *Cache {
+	return &Cache{
+		wrapped:    wrapped,
+		maxRetries: maxRetries,
+		baseDelay:  baseDelay,
+	}
+}
+
+func (rc *Cache) GetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
+	var value interface{}
+	err := rc.do(ctx, "GetValue", func() (err error) {
+		value, err = rc.wrapped.GetValue(ctx, pipelineId, subKey)
+		return err
+	})
+	return value, err
+}
+
+func (rc *Cache) GetValueWithTTL(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, time.Duration, error) {
+	var value interface{}
+	var ttl time.Duration
+	err := rc.do(ctx, "GetValueWithTTL", func() (err error) {
+		value, ttl, err = rc.wrapped.GetValueWithTTL(ctx, pipelineId, subKey)
+		return err
+	})
+	return value, ttl, err
+}
+
+func (rc *Cache) GetValues(ctx context.Context, pipelineId uuid.UUID, subKeys []cache.SubKey) (map[cache.SubKey]interface{}, error) {
+	var values map[cache.SubKey]interface{}
+	err := rc.do(ctx, "GetValues", func() (err error) {
+		values, err = rc.wrapped.GetValues(ctx, pipelineId, subKeys)
+		return err
+	})
+	return values, err
+}
+
+func (rc *Cache) GetPipelines(ctx context.Context) ([]uuid.UUID, error) {
+	var pipelines []uuid.UUID
+	err := rc.do(ctx, "GetPipelines", func() (err error) {
+		pipelines, err = rc.wrapped.GetPipelines(ctx)
+		return err
+	})
+	return pipelines, err
+}
+
+func (rc *Cache) GetAge(ctx context.Context, pipelineId uuid.UUID) (time.Duration, error) {
+	var age time.Duration
+	err := rc.do(ctx, "GetAge", func() (err error) {
+		age, err = rc.wrapped.GetAge(ctx, pipelineId)
+		return err
+	})
+	return age, err
+}
+
+func (rc *Cache) ScanValues(ctx context.Context, pipelineId uuid.UUID) (map[cache.SubKey]interface{}, error) {
+	var values map[cache.SubKey]interface{}
+	err := rc.do(ctx, "ScanValues", func() (err error) {
+		values, err = rc.wrapped.ScanValues(ctx, pipelineId)
+		return err
+	})
+	return values, err
+}
+
+func (rc *Cache) GetSubKeyCount(ctx context.Context, pipelineId uuid.UUID) (int, error) {
+	var count int
+	err := rc.do(ctx, "GetSubKeyCount", func() (err error) {
+		count, err = rc.wrapped.GetSubKeyCount(ctx, pipelineId)
+		return err
+	})
+	return count, err
+}
+
+func (rc *Cache) SetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) error {
+	return rc.do(ctx, "SetValue", func() error {
+		return rc.wrapped.SetValue(ctx, pipelineId, subKey, value)
+	})
+}
+
+func (rc *Cache) SetValues(ctx context.Context, pipelineId uuid.UUID, values map[cache.SubKey]interface{}) error {
+	return rc.do(ctx, "SetValues", func() error {
+		return rc.wrapped.SetValues(ctx, pipelineId, values)
+	})
+}
+
+func (rc *Cache) Preload(ctx context.Context, pipelines map[uuid.UUID]map[cache.SubKey]interface{}) error {
+	return rc.do(ctx, "Preload", func() error {
+		return rc.wrapped.Preload(ctx, pipelines)
+	})
+}
+
+func (rc *Cache) SetStatusIfNotTerminal(ctx context.Context, pipelineId uuid.UUID, status pb.Status) (bool, error) {
+	var set bool
+	err := rc.do(ctx, "SetStatusIfNotTerminal", func() (err error) {
+		set, err = rc.wrapped.SetStatusIfNotTerminal(ctx, pipelineId, status)
+		return err
+	})
+	return set, err
+}
+
+func (rc *Cache) SubscribeStatus(ctx context.Context, pipelineId uuid.UUID) (<-chan pb.Status, error) {
+	var statuses <-chan pb.Status
+	err := rc.do(ctx, "SubscribeStatus", func() (err error) {
+		statuses, err = rc.wrapped.SubscribeStatus(ctx, pipelineId)
+		return err
+	})
+	return statuses, err
+}
+
+func (rc *Cache) DeleteValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) error {
+	return rc.do(ctx, "DeleteValue", func() error {
+		return rc.wrapped.DeleteValue(ctx, pipelineId, subKey)
+	})
+}
+
+func (rc *Cache) DeletePipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	return rc.do(ctx, "DeletePipeline", func() error {
+		return rc.wrapped.DeletePipeline(ctx, pipelineId)
+	})
+}
+
+func (rc *Cache) FlushAll(ctx context.Context) error {
+	return rc.do(ctx, "FlushAll", func() error {
+		return rc.wrapped.FlushAll(ctx)
+	})
+}
+
+func (rc *Cache) SetExpTime(ctx context.Context, pipelineId uuid.UUID, expTime time.Duration) error {
+	return rc.do(ctx, "SetExpTime", func() error {
+		return rc.wrapped.SetExpTime(ctx, pipelineId, expTime)
+	})
+}
+
+func (rc *Cache) TouchPipeline(ctx context.Context, pipelineId uuid.UUID) error {
+	return rc.do(ctx, "TouchPipeline", func() error {
+		return rc.wrapped.TouchPipeline(ctx, pipelineId)
+	})
+}
+
+// HealthCheck checks the wrapped Cache without retries, so unavailability of the storage is reported at once
+func (rc *Cache) HealthCheck(ctx context.Context) error {
+	return rc.wrapped.HealthCheck(ctx)
+}
+
+func (rc *Cache) Close() error {
+	return rc.wrapped.Close()
+}
+
+// do runs the operation and repeats it with exponential backoff while it returns retryable errors
+// until retries are exhausted or ctx is done. The error of the last attempt is returned.
+func (rc *Cache) do(ctx context.Context, operation string, run func() error) error {
+	delay := rc.baseDelay
+	for attempt := 1; ; attempt++ {
+		err := run()
+		if attempt > rc.maxRetries || !isRetryable(ctx, err) {
+			return err
+		}
+		logger.Warnf("Retry Cache: %s: retry %d of %d in %s, err: %s\n", operation, attempt, rc.maxRetries, delay, err.Error())
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		delay *= 2
+		if delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+	}
+}
+
+// isRetryable checks that the operation failed because of a connection error and not because ctx is done
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	return cache.IsConnectionError(err)
+}
@@ -0,0 +1,158 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/local"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+)
+
+var errConnection = &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+
+// failingCache is local Cache which returns err from the first fails calls of GetValue
+type failingCache struct {
+	*local.Cache
+	fails int
+	err   error
+	calls int
+}
+
+func (c *failingCache) GetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) (interface{}, error) {
+	c.calls++
+	if c.calls <= c.fails {
+		return nil, c.err
+	}
+	return c.Cache.GetValue(ctx, pipelineId, subKey)
+}
+
+func TestCache_GetValue(t *testing.T) {
+	pipelineId := uuid.New()
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		fails     int
+		err       error
+		want      interface{}
+		wantErr   error
+		wantCalls int
+	}{
+		{
+			name:      "success without retries",
+			ctx:       context.Background(),
+			want:      pb.Status_STATUS_FINISHED,
+			wantCalls: 1,
+		},
+		{
+			name:      "retry then success",
+			ctx:       context.Background(),
+			fails:     2,
+			err:       errConnection,
+			want:      pb.Status_STATUS_FINISHED,
+			wantCalls: 3,
+		},
+		{
+			name:      "give up after retries are exhausted",
+			ctx:       context.Background(),
+			fails:     5,
+			err:       errConnection,
+			wantErr:   errConnection,
+			wantCalls: 4,
+		},
+		{
+			name:      "timeout is retried",
+			ctx:       context.Background(),
+			fails:     1,
+			err:       fmt.Errorf("MOCK_ERROR: %w", context.DeadlineExceeded),
+			want:      pb.Status_STATUS_FINISHED,
+			wantCalls: 2,
+		},
+		{
+			name:      "logical error isn't retried",
+			ctx:       context.Background(),
+			fails:     1,
+			err:       cache.ErrNotFound,
+			wantErr:   cache.ErrNotFound,
+			wantCalls: 1,
+		},
+		{
+			name:      "canceled context isn't retried",
+			ctx:       canceledCtx,
+			fails:     1,
+			err:       errConnection,
+			wantErr:   errConnection,
+			wantCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			wrapped := &failingCache{Cache: local.New(ctx), fails: tt.fails, err: tt.err}
+			if err := wrapped.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_FINISHED); err != nil {
+				t.Fatalf("SetValue() error = %v", err)
+			}
+			rc := New(wrapped, 3, time.Millisecond)
+
+			got, err := rc.GetValue(tt.ctx, pipelineId, cache.Status)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GetValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetValue() got = %v, want %v", got, tt.want)
+			}
+			if wrapped.calls != tt.wantCalls {
+				t.Errorf("GetValue() calls = %d, want %d", wrapped.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCache_Backoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	wrapped := &failingCache{Cache: local.New(ctx), fails: 3, err: errConnection}
+	rc := New(wrapped, 3, time.Millisecond*10)
+
+	start := time.Now()
+	_, _ = rc.GetValue(ctx, uuid.New(), cache.Status)
+	// delays before retries are 10ms, 20ms and 40ms
+	if elapsed := time.Since(start); elapsed < time.Millisecond*70 {
+		t.Errorf("GetValue() elapsed = %s, want at least %s", elapsed, time.Millisecond*70)
+	}
+
+	rc = New(wrapped, 10, time.Second)
+	wrapped.calls, wrapped.fails = 0, 10
+	timeoutCtx, timeoutCancel := context.WithTimeout(ctx, time.Millisecond*50)
+	defer timeoutCancel()
+	start = time.Now()
+	if _, err := rc.GetValue(timeoutCtx, uuid.New(), cache.Status); !errors.Is(err, errConnection) {
+		t.Errorf("GetValue() error = %v, want %v", err, errConnection)
+	}
+	if elapsed := time.Since(start); elapsed > time.Millisecond*500 {
+		t.Errorf("GetValue() waits for retry after context is done, elapsed = %s", elapsed)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"cloud.google.com/go/storage"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
@@ -34,6 +35,7 @@ import (
 const (
 	BucketName       = "playground-precompiled-objects"
 	OutputExtension  = "output"
+	CompileExtension = "compile"
 	LogsExtension    = "log"
 	MetaInfoName     = "meta.info"
 	Timeout          = time.Second * 10
@@ -54,6 +56,21 @@ type ObjectInfo struct {
 	Link            string                   `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
 }
 
+// PrecompiledObjectData is the precompiled object with its source code and precompiled outputs.
+// If the output isn't precompiled, it is empty and its Has field is false.
+type PrecompiledObjectData struct {
+	Info             ObjectInfo
+	Sdk              pb.Sdk
+	Code             string
+	CompileOutput    string
+	RunOutput        string
+	HasCompileOutput bool
+	HasRunOutput     bool
+}
+
+// ErrPrecompiledObjectNotFound is returned if there is no source code of the precompiled object at the storage
+var ErrPrecompiledObjectNotFound = errors.New("precompiled object is not found")
+
 type PrecompiledObjects []ObjectInfo
 type CategoryToPrecompiledObjects map[string]PrecompiledObjects
 type SdkToCategories map[string]CategoryToPrecompiledObjects
@@ -66,6 +83,7 @@ type SdkToCategories map[string]CategoryToPrecompiledObjects
 // --------MinimalWordCount/
 // ----------- MinimalWordCount.java
 // ----------- MinimalWordCount.output
+// ----------- MinimalWordCount.compile
 // ----------- meta.info
 // --------JoinExamples/
 // ----------- JoinExamples.java
@@ -86,10 +104,22 @@ type SdkToCategories map[string]CategoryToPrecompiledObjects
 //	"type": 1, ## 1 - Example, 2 - Kata, 3 - Unit-test
 //	"categories": ["Common", "IO"]
 // }
+// .output and .compile files are the precompiled run and compile outputs, they are optional.
 //
 type CloudStorage struct {
 }
 
+// ExamplesStorage is the storage of examples which are got by sdk and category.
+// CloudStorage keeps examples at the cloud storage bucket.
+type ExamplesStorage interface {
+	// GetPrecompiledObjects returns examples of the target sdk and the target category.
+	// If the target sdk is pb.Sdk_SDK_UNSPECIFIED or the target category is empty, examples of all sdks or categories are returned.
+	GetPrecompiledObjects(ctx context.Context, targetSdk pb.Sdk, targetCategory string) (*SdkToCategories, error)
+	// GetPrecompiledObjectData returns the example by its cloud path with its source code and precompiled outputs.
+	// If there is no source code of the example, ErrPrecompiledObjectNotFound is returned.
+	GetPrecompiledObjectData(ctx context.Context, precompiledObjectPath string) (*PrecompiledObjectData, error)
+}
+
 func New() *CloudStorage {
 	return &CloudStorage{}
 }
@@ -128,6 +158,61 @@ func (cd *CloudStorage) GetPrecompiledObjectLogs(ctx context.Context, precompile
 	return result, nil
 }
 
+// GetPrecompiledObjectData returns the source code, the information and precompiled outputs of the example
+func (cd *CloudStorage) GetPrecompiledObjectData(ctx context.Context, precompiledObjectPath string) (*PrecompiledObjectData, error) {
+	extension, err := getFileExtensionBySdk(precompiledObjectPath)
+	if err != nil {
+		return nil, ErrPrecompiledObjectNotFound
+	}
+	client, err := storage.NewClient(ctx, option.WithoutAuthentication())
+	if err != nil {
+		return nil, fmt.Errorf("storage.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
+
+	bucket := client.Bucket(BucketName)
+
+	code, found, err := readObject(ctx, bucket, getFullFilePath(precompiledObjectPath, extension))
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrPrecompiledObjectNotFound
+	}
+	info := ObjectInfo{}
+	metaInfo, found, err := readObject(ctx, bucket, filepath.Join(precompiledObjectPath, MetaInfoName))
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		if err = json.Unmarshal(metaInfo, &info); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal: %v", err)
+		}
+	}
+	info.CloudPath = precompiledObjectPath
+	info.Name = filepath.Base(precompiledObjectPath)
+	compileOutput, hasCompileOutput, err := readObject(ctx, bucket, getFullFilePath(precompiledObjectPath, CompileExtension))
+	if err != nil {
+		return nil, err
+	}
+	runOutput, hasRunOutput, err := readObject(ctx, bucket, getFullFilePath(precompiledObjectPath, OutputExtension))
+	if err != nil {
+		return nil, err
+	}
+	return &PrecompiledObjectData{
+		Info:             info,
+		Sdk:              pb.Sdk(pb.Sdk_value[getSdkName(precompiledObjectPath)]),
+		Code:             string(code),
+		CompileOutput:    string(compileOutput),
+		RunOutput:        string(runOutput),
+		HasCompileOutput: hasCompileOutput,
+		HasRunOutput:     hasRunOutput,
+	}, nil
+}
+
 // GetPrecompiledObjects returns stored at the cloud storage bucket precompiled objects for the target category
 func (cd *CloudStorage) GetPrecompiledObjects(ctx context.Context, targetSdk pb.Sdk, targetCategory string) (*SdkToCategories, error) {
 	client, err := storage.NewClient(ctx, option.WithoutAuthentication())
@@ -247,6 +332,25 @@ func (cd *CloudStorage) getFileFromBucket(ctx context.Context, pathToObject stri
 	return data, nil
 }
 
+// readObject receives the file from the bucket by its full path.
+// If there is no such file, false is returned without an error.
+func readObject(ctx context.Context, bucket *storage.BucketHandle, filePath string) ([]byte, bool, error) {
+	rc, err := bucket.Object(filePath).NewReader(ctx)
+	if err == storage.ErrObjectNotExist {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("Object(%q).NewReader: %v", filePath, err)
+	}
+	defer rc.Close()
+
+	data, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, false, fmt.Errorf("ioutil.ReadAll: %v", err)
+	}
+	return data, true, nil
+}
+
 // getFileExtensionBySdk get extension of the file with code by the sdk name
 func getFileExtensionBySdk(precompiledObjectPath string) (string, error) {
 	sdk := strings.Split(precompiledObjectPath, string(os.PathSeparator))[0]
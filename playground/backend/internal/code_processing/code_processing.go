@@ -18,31 +18,71 @@ package code_processing
 import (
 	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/dependencies"
+	"beam.apache.org/playground/backend/internal/env_vars"
 	"beam.apache.org/playground/backend/internal/environment"
 	"beam.apache.org/playground/backend/internal/errors"
 	"beam.apache.org/playground/backend/internal/executors"
 	"beam.apache.org/playground/backend/internal/fs_tool"
+	"beam.apache.org/playground/backend/internal/job_queue"
+	"beam.apache.org/playground/backend/internal/log_entries"
 	"beam.apache.org/playground/backend/internal/logger"
+	"beam.apache.org/playground/backend/internal/pipeline_graph"
 	"beam.apache.org/playground/backend/internal/setup_tools/builder"
+	"beam.apache.org/playground/backend/internal/source_cache"
 	"beam.apache.org/playground/backend/internal/streaming"
 	"beam.apache.org/playground/backend/internal/utils"
 	"beam.apache.org/playground/backend/internal/validators"
 	"bytes"
 	"context"
+	"encoding/json"
+	goerrors "errors"
 	"fmt"
 	"github.com/google/uuid"
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"reflect"
+	"regexp"
+	"sort"
+	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 )
 
 const (
 	pauseDuration = 500 * time.Millisecond
+	// MaxOutputPageSize is the max size in bytes of the page of the output, so the page fits the message size limit of gRPC
+	MaxOutputPageSize = 1024 * 1024
+	// graphFileName is the name of the file in the base folder of the code which graph args of the SDK write the graph to
+	graphFileName = "graph.dot"
+	// graphTimeout is the max duration of generating the graph of the pipeline
+	graphTimeout = 30 * time.Second
+	// maxGraphOutputSize is the max size in bytes of the output of the graph step which is kept in memory
+	maxGraphOutputSize = 1024 * 1024
+	// graphOutputLogSize is the max size in bytes of the end of the output of the graph step which is logged
+	graphOutputLogSize = 4 * 1024
+	// diskQuotaCheckInterval is the interval of checking the size of files which the running code writes
+	diskQuotaCheckInterval = 200 * time.Millisecond
+	// ShutdownMessage is saved as cache.RunError of the code processing which is terminated because the server is shut down
+	ShutdownMessage = "Code processing is terminated because the server is shutting down, please run the code again"
 )
 
+// outOfMemoryMessages are errors of SDK runtimes (Python, Java, Go) when the process can't allocate memory
+var outOfMemoryMessages = []string{"MemoryError", "java.lang.OutOfMemoryError", "runtime: out of memory", "Cannot allocate memory"}
+
+// runningProcesses contains runningProcess of each code processing which is executed by this server
+var runningProcesses sync.Map
+
+// runningProcess keeps the channel to cancel the code processing and the command of the current compile/run step
+type runningProcess struct {
+	mu            sync.Mutex
+	cmd           *exec.Cmd
+	cancelChannel chan bool
+}
+
 // Process validates, compiles and runs code by pipelineId.
 // During each operation updates status of execution and saves it into cache:
 // - In case of processing works more that timeout duration saves playground.Status_STATUS_RUN_TIMEOUT as cache.Status into cache.
@@ -50,28 +90,47 @@ const (
 // - In case of validation step is failed saves playground.Status_STATUS_VALIDATION_ERROR as cache.Status into cache.
 // - In case of compile step is failed saves playground.Status_STATUS_COMPILE_ERROR as cache.Status and compile logs as cache.CompileOutput into cache.
 // - In case of compile step is completed with no errors saves compile output as cache.CompileOutput into cache.
+// - In case of the graph of the compiled pipeline is generated saves it in DOT format as cache.Graph into cache.
+// - In case of compile and run commands are started saves their command lines as cache.ExecutionCommand into cache.
 // - In case of run step is failed saves playground.Status_STATUS_RUN_ERROR as cache.Status and run logs as cache.RunError into cache.
 // - In case of run step is completed with no errors saves playground.Status_STATUS_FINISHED as cache.Status and run output as cache.RunOutput into cache.
-// At the end of this method deletes all created folders.
-func Process(ctx context.Context, cacheService cache.Cache, lc *fs_tool.LifeCycle, pipelineId uuid.UUID, appEnv *environment.ApplicationEnvs, sdkEnv *environment.BeamEnvs, pipelineOptions string) {
-	pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, appEnv.PipelineExecuteTimeout())
+// After each of validation, preparation, waiting, compile, graph and run steps is finished saves durations of the passed steps
+//	as cache.Timings into cache. Durations are also saved before a terminal status is set, so they are in cache with the status.
+// If source isn't nil, the compiled files of the identical code are reused instead of compiling the code
+//	and the results of the code processing are kept in cache for next identical requests.
+// If deadline is positive, the code processing is terminated by timeout after the deadline of the request
+//	even if the client doesn't wait for the result anymore.
+// If queue isn't nil, compile and run steps are executed only when the queue has a free slot.
+//	While the code waits for the free slot saves playground.Status_STATUS_WAITING as cache.Status into cache.
+// At the end of this method deletes all created folders unless they are marked to be kept (see fs_tool.LifeCycle.RetainFolders).
+func Process(ctx context.Context, cacheService cache.Cache, lc *fs_tool.LifeCycle, pipelineId uuid.UUID, appEnv *environment.ApplicationEnvs, sdkEnv *environment.BeamEnvs, pipelineOptions string, deadline time.Duration, source *source_cache.Source, queue *job_queue.Queue) {
+	pipelineLifeCycleCtx, finishCtxFunc := pipelineContext(ctx, appEnv.PipelineExecuteTimeout(), deadline)
 	defer func(lc *fs_tool.LifeCycle) {
 		finishCtxFunc()
-		DeleteFolders(pipelineId, lc)
+		deleteOrRetainFolders(pipelineId, lc)
 	}(lc)
 
 	cancelChannel := make(chan bool, 1)
+	runningProcesses.Store(pipelineId, &runningProcess{cancelChannel: cancelChannel})
+	defer runningProcesses.Delete(pipelineId)
 
 	var validationResults sync.Map
 
 	go cancelCheck(pipelineLifeCycleCtx, pipelineId, cancelChannel, cacheService)
 
-	executor := validateStep(ctx, cacheService, &lc.Paths, pipelineId, sdkEnv, pipelineLifeCycleCtx, &validationResults, cancelChannel)
+	// Steps use the cache through the timer, so durations of the stages are saved before a terminal status
+	timer := newStageTimer(cacheService, pipelineId)
+
+	finishStage := timer.start(cache.ValidateStage)
+	executor := validateStep(ctx, timer, &lc.Paths, pipelineId, sdkEnv, pipelineLifeCycleCtx, &validationResults, cancelChannel)
+	finishStage(ctx)
 	if executor == nil {
 		return
 	}
 
-	executor = prepareStep(ctx, cacheService, &lc.Paths, pipelineId, sdkEnv, pipelineLifeCycleCtx, &validationResults, cancelChannel)
+	finishStage = timer.start(cache.PrepareStage)
+	executor = prepareStep(ctx, timer, &lc.Paths, pipelineId, sdkEnv, pipelineLifeCycleCtx, &validationResults, cancelChannel)
+	finishStage(ctx)
 	if executor == nil {
 		return
 	}
@@ -80,13 +139,123 @@ func Process(ctx context.Context, cacheService cache.Cache, lc *fs_tool.LifeCycl
 	validateIsUnitTest, _ := validationResults.Load(validators.UnitTestValidatorName)
 	isUnitTest := validateIsUnitTest.(bool)
 
-	executor = compileStep(ctx, cacheService, &lc.Paths, pipelineId, sdkEnv, isUnitTest, pipelineLifeCycleCtx, cancelChannel)
+	if queue != nil {
+		finishStage = timer.start(cache.WaitStage)
+	}
+	release, ok := waitStep(ctx, timer, pipelineId, queue, pipelineLifeCycleCtx, cancelChannel)
+	if queue != nil {
+		finishStage(ctx)
+	}
+	if !ok {
+		return
+	}
+	defer release()
+
+	finishStage = timer.start(cache.CompileStage)
+	executor = compileStep(ctx, timer, &lc.Paths, pipelineId, sdkEnv, isUnitTest, pipelineLifeCycleCtx, cancelChannel, source)
+	finishStage(ctx)
 	if executor == nil {
 		return
 	}
 
+	if !isUnitTest && len(sdkEnv.ExecutorConfig.GraphArgs) != 0 {
+		finishStage = timer.start(cache.GraphStage)
+		graphStep(timer, &lc.Paths, pipelineId, sdkEnv, pipelineOptions, pipelineLifeCycleCtx)
+		finishStage(ctx)
+	}
+
 	// Run/RunTest
-	runStep(ctx, cacheService, &lc.Paths, pipelineId, isUnitTest, sdkEnv, pipelineOptions, pipelineLifeCycleCtx, cancelChannel)
+	finishStage = timer.start(cache.RunStage)
+	runStep(ctx, timer, &lc.Paths, pipelineId, isUnitTest, sdkEnv, pipelineOptions, pipelineLifeCycleCtx, cancelChannel)
+	finishStage(ctx)
+	if source != nil {
+		if err := source.SaveRunResult(ctx, cacheService, pipelineId); err != nil {
+			logger.Errorf("%s: Process(): error during saving results of the code processing: %s\n", pipelineId, err.Error())
+		}
+	}
+}
+
+// CheckSyntax checks whether the code by pipelineId could be parsed without compiling and running it.
+// The code is validated by validators of the SDK and is checked by the syntax check command of the SDK
+//	(e.g. python3 -m py_compile) which doesn't produce an executable.
+// Saves the status of the check as cache.Status and its diagnostics as cache.ValidationOutput into cache:
+// - In case of validation or syntax check is failed saves playground.Status_STATUS_VALIDATION_ERROR.
+// - In case of syntax check works more than compile timeout saves playground.Status_STATUS_RUN_TIMEOUT.
+// - In case of the code is parsed successfully saves playground.Status_STATUS_FINISHED.
+// Returns the status and the diagnostics. At the end of this method deletes all created folders.
+func CheckSyntax(ctx context.Context, cacheService cache.Cache, lc *fs_tool.LifeCycle, pipelineId uuid.UUID, sdkEnv *environment.BeamEnvs) (pb.Status, string, error) {
+	defer DeleteFolders(pipelineId, lc)
+
+	logger.Infof("%s: CheckSyntax() ...\n", pipelineId)
+	status, diagnostics, err := checkSyntax(ctx, &lc.Paths, sdkEnv)
+	if err != nil {
+		logger.Errorf("%s: CheckSyntax(): %s\n", pipelineId, err.Error())
+		return pb.Status_STATUS_ERROR, "", err
+	}
+	logger.Infof("%s: CheckSyntax() finish: %s\n", pipelineId, status)
+
+	err = utils.SetValuesToCache(ctx, cacheService, pipelineId, map[cache.SubKey]interface{}{
+		cache.ValidationOutput: diagnostics,
+		cache.Status:           status,
+	})
+	if err != nil {
+		return pb.Status_STATUS_ERROR, "", err
+	}
+	return status, diagnostics, nil
+}
+
+// checkSyntax validates the code and runs the syntax check command of the SDK.
+// Returns the status of the check and its diagnostics.
+func checkSyntax(ctx context.Context, paths *fs_tool.LifeCyclePaths, sdkEnv *environment.BeamEnvs) (pb.Status, string, error) {
+	var validationResults sync.Map
+	errorChannel, successChannel := createStatusChannels()
+	validatorBuilder, err := builder.Validator(paths, sdkEnv)
+	if err != nil {
+		return pb.Status_STATUS_ERROR, "", err
+	}
+	validator := validatorBuilder.Build()
+	validator.Validate()(successChannel, errorChannel, &validationResults)
+	if !<-successChannel {
+		return pb.Status_STATUS_VALIDATION_ERROR, (<-errorChannel).Error(), nil
+	}
+
+	// javac requires the public class to be declared in the file named by the class,
+	// so the Java code is prepared as before the compile step.
+	// Preparers of other SDKs are skipped because they could change lines of the code.
+	if sdkEnv.ApacheBeamSdk == pb.Sdk_SDK_JAVA {
+		preparerBuilder, err := builder.Preparer(paths, sdkEnv, &validationResults)
+		if err != nil {
+			return pb.Status_STATUS_ERROR, "", err
+		}
+		preparer := preparerBuilder.Build()
+		preparer.Prepare()(successChannel, errorChannel, &validationResults)
+		if !<-successChannel {
+			return pb.Status_STATUS_ERROR, "", <-errorChannel
+		}
+	}
+
+	executor := builder.SyntaxChecker(paths, sdkEnv).Build()
+	checkCtx, finishCheckCtxFunc := stepContext(ctx, "SyntaxCheck", sdkEnv.ApacheBeamSdk, sdkEnv.CompileTimeout())
+	defer finishCheckCtxFunc()
+	cmd := executor.CheckSyntax(checkCtx)
+	// Only the error output contains diagnostics, e.g. gofmt writes names of files to the standard output
+	var diagnostics bytes.Buffer
+	cmd.Stderr = &diagnostics
+	err = cmd.Run()
+	if timeoutMessage := getStepTimeoutMessage(checkCtx); timeoutMessage != "" {
+		return pb.Status_STATUS_RUN_TIMEOUT, timeoutMessage, nil
+	}
+	var exitError *exec.ExitError
+	if goerrors.As(err, &exitError) {
+		if diagnostics.Len() == 0 {
+			return pb.Status_STATUS_VALIDATION_ERROR, err.Error(), nil
+		}
+		return pb.Status_STATUS_VALIDATION_ERROR, diagnostics.String(), nil
+	}
+	if err != nil {
+		return pb.Status_STATUS_ERROR, "", err
+	}
+	return pb.Status_STATUS_FINISHED, diagnostics.String(), nil
 }
 
 func runStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeCyclePaths, pipelineId uuid.UUID, isUnitTest bool, sdkEnv *environment.BeamEnvs, pipelineOptions string, pipelineLifeCycleCtx context.Context, cancelChannel chan bool) {
@@ -94,12 +263,20 @@ func runStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeC
 	stopReadLogsChannel := make(chan bool, 1)
 	finishReadLogsChannel := make(chan bool, 1)
 
+	// Unit tests are run by the test command, so the runner of the code matters only for pipelines
+	runner, err := fs_tool.ReadRunnerFile(paths.AbsoluteBaseFolderPath)
+	if err != nil {
+		_ = processSetupError(err, pipelineId, cacheService, pipelineLifeCycleCtx)
+		return
+	}
+	runnerConfig := sdkEnv.ExecutorConfig.Runners[runner]
+
 	var executorBuilder *executors.ExecutorBuilder
-	err := error(nil)
 	if isUnitTest {
 		executorBuilder, err = builder.TestRunner(paths, sdkEnv)
 	} else {
-		executorBuilder, err = builder.Runner(paths, utils.ReduceWhiteSpacesToSinge(pipelineOptions), sdkEnv)
+		runOptions := strings.TrimSpace(utils.ReduceWhiteSpacesToSinge(pipelineOptions) + " " + strings.Join(runnerConfig.RunArgs, " "))
+		executorBuilder, err = builder.Runner(paths, runOptions, sdkEnv)
 	}
 	if err != nil {
 		_ = processSetupError(err, pipelineId, cacheService, pipelineLifeCycleCtx)
@@ -108,32 +285,98 @@ func runStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeC
 
 	executor := executorBuilder.Build()
 	logger.Infof("%s: Run()/Test() ...\n", pipelineId)
-	runCmd := getExecuteCmd(isUnitTest, &executor, pipelineLifeCycleCtx)
+	runCtx, finishRunCtxFunc := stepContext(pipelineLifeCycleCtx, "Run", sdkEnv.ApacheBeamSdk, sdkEnv.RunTimeout())
+	defer finishRunCtxFunc()
+	runCmd := getExecuteCmd(isUnitTest, &executor, runCtx)
+	saveExecutionCommand(pipelineLifeCycleCtx, cacheService, pipelineId, runCmd, true)
 	var runError bytes.Buffer
-	runOutput := streaming.RunOutputWriter{Ctx: pipelineLifeCycleCtx, CacheService: cacheService, PipelineId: pipelineId}
-	go readLogFile(pipelineLifeCycleCtx, ctx, cacheService, paths.AbsoluteLogFilePath, pipelineId, stopReadLogsChannel, finishReadLogsChannel)
+	runOutput := newOutputWriter(pipelineLifeCycleCtx, cacheService, pipelineId, cache.RunOutput, sdkEnv)
+	defer runOutput.Close(ctx)
+	go readLogFile(pipelineLifeCycleCtx, ctx, cacheService, paths.AbsoluteLogFilePath, pipelineId, sdkEnv, stopReadLogsChannel, finishReadLogsChannel)
 
+	var diskQuota *fs_tool.DiskQuota
+	if limits := sdkEnv.ResourceLimits(); limits.DiskBytes > 0 {
+		if diskQuota, err = fs_tool.NewDiskQuota(paths.AbsoluteBaseFolderPath, limits.DiskBytes); err != nil {
+			_ = processSetupError(err, pipelineId, cacheService, pipelineLifeCycleCtx)
+			return
+		}
+	}
+
+	// Without the input the standard input of the code is the null device, so the code reading it gets EOF at once
+	stdin, err := fs_tool.OpenStdinFile(paths.AbsoluteBaseFolderPath)
+	if err != nil {
+		_ = processSetupError(err, pipelineId, cacheService, pipelineLifeCycleCtx)
+		return
+	}
+	if stdin != nil {
+		defer stdin.Close()
+		runCmd.Stdin = stdin
+	}
+	// Environment variables of the request override ones of the SDK config, host variables like PATH aren't overridden
+	envVars, err := env_vars.Read(paths.AbsoluteBaseFolderPath)
+	if err != nil {
+		_ = processSetupError(err, pipelineId, cacheService, pipelineLifeCycleCtx)
+		return
+	}
+	runCmd.Env = env_vars.Environ(env_vars.HostEnviron(os.Environ()), sdkEnv.ExecutorConfig.Environment, envVars)
+
+	// Other SDKs write logs to the log file on their own, so stdErr is kept as the run error.
+	var errorOutput io.Writer = &runError
 	if sdkEnv.ApacheBeamSdk == pb.Sdk_SDK_GO {
 		// For go SDK all logs are placed to stdErr.
 		file, err := os.Create(paths.AbsoluteLogFilePath)
 		if err != nil {
 			// If some error with creating a log file do the same as with other SDK.
 			logger.Errorf("%s: error during create log file (go sdk): %s", pipelineId, err.Error())
-			runCmdWithOutput(runCmd, &runOutput, &runError, successChannel, errorChannel)
 		} else {
 			// Use the log file to write all stdErr into it.
-			runCmdWithOutput(runCmd, &runOutput, file, successChannel, errorChannel)
+			errorOutput = file
 		}
-	} else {
-		// Other SDKs write logs to the log file on their own.
-		runCmdWithOutput(runCmd, &runOutput, &runError, successChannel, errorChannel)
 	}
+	// The output over the output limits is discarded, so the runaway output doesn't fill cache
+	limits := sdkEnv.ResourceLimits()
+	onOutputTruncated := func() {
+		if limits.KillOnOutputLimit {
+			logger.Errorf("%s: Run(): the output limit is exceeded, the process is killed", pipelineId)
+			_ = killProcessGroup(runCmd)
+		}
+	}
+	cappedRunOutput := streaming.NewCappedWriter(runOutput, limits.OutputLines, limits.OutputBytes, onOutputTruncated)
+	cappedRunError := streaming.NewCappedWriter(errorOutput, limits.OutputLines, limits.OutputBytes, onOutputTruncated)
+	// The code mustn't be executed without limits, so the limits are set before the process is started
+	if err := setResourceLimits(runCmd, limits); err != nil {
+		_ = processSetupError(err, pipelineId, cacheService, pipelineLifeCycleCtx)
+		return
+	}
+
+	runStart := time.Now()
+	// Processes of the runner (e.g. the mini-cluster or the job server) which are started by the code
+	// don't outlive the code, otherwise they keep the run step until the timeout
+	runCmdWithOutput(runCmd, outputWriter(sdkEnv, cappedRunOutput), outputWriter(sdkEnv, cappedRunError), runner != "", successChannel, errorChannel)
+	if runCmd.Process != nil {
+		if diskQuota != nil {
+			// The code is stopped when it writes more files to the working directory than the disk quota allows
+			diskQuotaCtx, stopDiskQuota := context.WithCancel(runCtx)
+			defer stopDiskQuota()
+			go diskQuota.Watch(diskQuotaCtx, diskQuotaCheckInterval, func() {
+				logger.Errorf("%s: Run(): the disk quota is exceeded, the process is killed", pipelineId)
+				_ = killProcessGroup(runCmd)
+			})
+		}
+	}
+	setRunningCmd(pipelineId, runCmd)
+	defer setRunningCmd(pipelineId, nil)
 
 	// Start of the monitoring of background tasks (run step/cancellation/timeout)
-	ok, err := reconcileBackgroundTask(pipelineLifeCycleCtx, ctx, pipelineId, cacheService, cancelChannel, successChannel)
+	ok, err := reconcileBackgroundTask(runCtx, ctx, pipelineId, cacheService, cancelChannel, successChannel)
 	if err != nil {
 		return
 	}
+	runDuration := time.Since(runStart)
+	// The process is finished, so the whole run output is saved before it is read or the final status is set
+	_ = runOutput.Close(pipelineLifeCycleCtx)
+	limitExceeded := ""
+	outputTruncated := cappedRunOutput.Truncated() || cappedRunError.Truncated()
 	if !ok {
 		// If unit test has some error then error output is placed as RunOutput
 		if isUnitTest {
@@ -152,47 +395,364 @@ func runStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeC
 			}
 			runError.Write(errData)
 		}
-		_ = processRunError(pipelineLifeCycleCtx, errorChannel, runError.Bytes(), pipelineId, cacheService, stopReadLogsChannel, finishReadLogsChannel)
+		if diskQuota != nil && diskQuota.Exceeded() {
+			limitExceeded = cache.DiskLimit
+		} else if outputTruncated && limits.KillOnOutputLimit {
+			limitExceeded = cache.OutputLimit
+		} else {
+			limitExceeded = getExceededLimit(runCmd.ProcessState, sdkEnv.ResourceLimits(), runError.Bytes())
+		}
+	}
+	// Run step is finished, so metadata is set before the final status
+	_ = processRunResult(pipelineLifeCycleCtx, pipelineId, cacheService, runCmd.ProcessState, runDuration, limitExceeded, outputTruncated)
+	if !ok {
+		failureMessage := ""
+		if limitExceeded != "" {
+			failureMessage = getLimitExceededMessage(limitExceeded, sdkEnv.ResourceLimits())
+		} else if isSubmissionError(runnerConfig, runError.Bytes()) {
+			failureMessage = fmt.Sprintf("Run step couldn't submit the pipeline to the %s runner", runner)
+		}
+		_ = processRunError(pipelineLifeCycleCtx, errorChannel, runError.Bytes(), failureMessage, pipelineId, cacheService, stopReadLogsChannel, finishReadLogsChannel)
 		return
 	}
 	// Run step is finished and code is executed
 	_ = processRunSuccess(pipelineLifeCycleCtx, pipelineId, cacheService, stopReadLogsChannel, finishReadLogsChannel)
 }
 
-func compileStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeCyclePaths, pipelineId uuid.UUID, sdkEnv *environment.BeamEnvs, isUnitTest bool, pipelineLifeCycleCtx context.Context, cancelChannel chan bool) *executors.Executor {
-	errorChannel, successChannel := createStatusChannels()
+// waitStep waits for the free slot of the queue to compile and run the code.
+// Returns the function which releases the slot and true if the slot is taken.
+// If the queue has no free slot, saves playground.Status_STATUS_WAITING as cache.Status into cache while waiting.
+//	In case of waiting works more than the timeout of the queue saves playground.Status_STATUS_RUN_TIMEOUT as cache.Status
+//	and the timeout message as cache.RunError into cache.
+func waitStep(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID, queue *job_queue.Queue, pipelineLifeCycleCtx context.Context, cancelChannel chan bool) (func(), bool) {
+	if queue == nil {
+		return func() {}, true
+	}
+	if release, ok := queue.TryAcquire(); ok {
+		return release, true
+	}
+	logger.Infof("%s: Wait() ...\n", pipelineId)
+	if _, err := cacheService.SetStatusIfNotTerminal(pipelineLifeCycleCtx, pipelineId, pb.Status_STATUS_WAITING); err != nil {
+		logger.Errorf("%s: cache.SetStatusIfNotTerminal: %s\n", pipelineId, err.Error())
+		return nil, false
+	}
+
+	waitCtx, finishWaitCtxFunc := context.WithCancel(pipelineLifeCycleCtx)
+	defer finishWaitCtxFunc()
+	canceledChannel := make(chan bool, 1)
+	go func() {
+		select {
+		case <-cancelChannel:
+			canceledChannel <- true
+			finishWaitCtxFunc()
+		case <-waitCtx.Done():
+			canceledChannel <- false
+		}
+	}()
+	release, err := queue.Acquire(waitCtx)
+	finishWaitCtxFunc()
+	if <-canceledChannel {
+		if release != nil {
+			release()
+		}
+		_ = processCancel(pipelineLifeCycleCtx, cacheService, pipelineId)
+		return nil, false
+	}
+	if goerrors.Is(err, job_queue.ErrTimeout) {
+		_ = finishByTimeout(ctx, pipelineId, cacheService, fmt.Sprintf("Waiting for a free slot to compile and run the code exceeded the timeout: %s", queue.Timeout()))
+		return nil, false
+	}
+	if err != nil {
+		_ = finishByTimeout(ctx, pipelineId, cacheService, getStepTimeoutMessage(pipelineLifeCycleCtx))
+		return nil, false
+	}
+	if _, err := cacheService.SetStatusIfNotTerminal(pipelineLifeCycleCtx, pipelineId, pb.Status_STATUS_COMPILING); err != nil {
+		logger.Errorf("%s: cache.SetStatusIfNotTerminal: %s\n", pipelineId, err.Error())
+		release()
+		return nil, false
+	}
+	logger.Infof("%s: Wait() finish\n", pipelineId)
+	return release, true
+}
+
+func compileStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeCyclePaths, pipelineId uuid.UUID, sdkEnv *environment.BeamEnvs, isUnitTest bool, pipelineLifeCycleCtx context.Context, cancelChannel chan bool, source *source_cache.Source) *executors.Executor {
 	var executor = executors.Executor{}
-	// This condition is used for cases when the playground doesn't compile source files. For the Python code and the Go Unit Tests
-	if sdkEnv.ApacheBeamSdk == pb.Sdk_SDK_PYTHON || (sdkEnv.ApacheBeamSdk == pb.Sdk_SDK_GO && isUnitTest) {
+	if sdkEnv.ApacheBeamSdk == pb.Sdk_SDK_PYTHON && dependencies.HasRequirements(paths.AbsoluteBaseFolderPath) {
+		// The Python code isn't compiled, but its requirements are installed before it is run
+		installOutput, ok := installStep(ctx, cacheService, paths, pipelineId, sdkEnv, pipelineLifeCycleCtx, cancelChannel)
+		if !ok {
+			return nil
+		}
+		if err := processCompileSuccess(pipelineLifeCycleCtx, installOutput, pipelineId, cacheService); err != nil {
+			return nil
+		}
+	} else if sdkEnv.ApacheBeamSdk == pb.Sdk_SDK_PYTHON || (sdkEnv.ApacheBeamSdk == pb.Sdk_SDK_GO && isUnitTest) {
+		// This condition is used for cases when the playground doesn't compile source files. For the Python code and the Go Unit Tests
 		if err := processCompileSuccess(pipelineLifeCycleCtx, []byte(""), pipelineId, cacheService); err != nil {
 			return nil
 		}
+	} else if compileOutput, ok := restoreCompileResult(pipelineLifeCycleCtx, cacheService, paths, pipelineId, source); ok {
+		// The compiled files of the identical code are reused
+		if err := processCompileSuccess(pipelineLifeCycleCtx, []byte(compileOutput), pipelineId, cacheService); err != nil {
+			return nil
+		}
 	} else { // in case of Java, Go (not unit test), Scala - need compile step
 		executorBuilder := builder.Compiler(paths, sdkEnv)
 		executor := executorBuilder.Build()
 		logger.Infof("%s: Compile() ...\n", pipelineId)
-		compileCmd := executor.Compile(pipelineLifeCycleCtx)
-		var compileError bytes.Buffer
-		var compileOutput bytes.Buffer
-		runCmdWithOutput(compileCmd, &compileOutput, &compileError, successChannel, errorChannel)
+		compileCtx, finishCompileCtxFunc := stepContext(pipelineLifeCycleCtx, "Compile", sdkEnv.ApacheBeamSdk, sdkEnv.CompileTimeout())
+		defer finishCompileCtxFunc()
+		defer setRunningCmd(pipelineId, nil)
 
-		// Start of the monitoring of background tasks (compile step/cancellation/timeout)
-		ok, err := reconcileBackgroundTask(pipelineLifeCycleCtx, ctx, pipelineId, cacheService, cancelChannel, successChannel)
-		if err != nil {
-			return nil
+		var compileOutput, compileError []byte
+		var compileErr error
+		for attempt := 1; ; attempt++ {
+			var err error
+			compileOutput, compileError, compileErr, err = runCompileCmd(ctx, compileCtx, pipelineLifeCycleCtx, cacheService, pipelineId, sdkEnv, &executor, cancelChannel)
+			if err != nil {
+				return nil
+			}
+			// Only failures of the toolchain are retried, errors of the code are returned to the user right away
+			if compileErr == nil || attempt > sdkEnv.ExecutorConfig.CompileRetries || !isTransientCompileError(sdkEnv.ExecutorConfig, compileOutput, compileError) {
+				break
+			}
+			logger.Warnf("%s: Compile(): attempt %d is failed by the toolchain, retrying: %s\n", pipelineId, attempt, compileErr.Error())
 		}
-		if !ok { // Compile step is finished, but code couldn't be compiled (some typos for example)
-			err := <-errorChannel
-			_ = processErrorWithSavingOutput(pipelineLifeCycleCtx, err, compileError.Bytes(), pipelineId, cache.CompileOutput, cacheService, "Compile", pb.Status_STATUS_COMPILE_ERROR)
+		if compileErr != nil { // Compile step is finished, but code couldn't be compiled (some typos for example)
+			_ = processErrorWithSavingOutput(pipelineLifeCycleCtx, compileErr, compileError, pipelineId, cache.CompileOutput, cacheService, "Compile", pb.Status_STATUS_COMPILE_ERROR)
 			return nil
 		} // Compile step is finished and code is compiled
-		if err := processCompileSuccess(pipelineLifeCycleCtx, compileOutput.Bytes(), pipelineId, cacheService); err != nil {
+		if err := processCompileSuccess(pipelineLifeCycleCtx, compileOutput, pipelineId, cacheService); err != nil {
 			return nil
 		}
+		if source != nil {
+			if err := source.SaveCompileResult(pipelineLifeCycleCtx, cacheService, pipelineId, paths, string(compileOutput)); err != nil {
+				logger.Errorf("%s: Compile(): error during saving compiled files: %s\n", pipelineId, err.Error())
+			}
+		}
 	}
 	return &executor
 }
 
+// runCompileCmd runs the compile command of the executor once and streams its output to the cache.
+// Returns the output and the error output of the command and the error of the command if the code isn't compiled.
+// In case of the compile step is canceled or timed out returns an error, the status of the code processing is
+//	already saved into cache in this case.
+func runCompileCmd(ctx, compileCtx, pipelineLifeCycleCtx context.Context, cacheService cache.Cache, pipelineId uuid.UUID, sdkEnv *environment.BeamEnvs, executor *executors.Executor, cancelChannel chan bool) (output []byte, errorOutput []byte, compileErr error, err error) {
+	errorChannel, successChannel := createStatusChannels()
+	compileCmd := executor.Compile(compileCtx)
+	saveExecutionCommand(pipelineLifeCycleCtx, cacheService, pipelineId, compileCmd, false)
+	var compileError bytes.Buffer
+	var compileOutput bytes.Buffer
+	// Both stdout and stderr are streamed to the cache while the code is compiled
+	compileStream := newOutputWriter(pipelineLifeCycleCtx, cacheService, pipelineId, cache.CompileOutput, sdkEnv)
+	defer compileStream.Close(ctx)
+	runCmdWithOutput(compileCmd, outputWriter(sdkEnv, io.MultiWriter(&compileOutput, compileStream)), outputWriter(sdkEnv, io.MultiWriter(&compileError, compileStream)), false, successChannel, errorChannel)
+	setRunningCmd(pipelineId, compileCmd)
+
+	// Start of the monitoring of background tasks (compile step/cancellation/timeout)
+	ok, err := reconcileBackgroundTask(compileCtx, ctx, pipelineId, cacheService, cancelChannel, successChannel)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// The streamed output is saved before the final compile output, so it doesn't overwrite the final one
+	_ = compileStream.Close(pipelineLifeCycleCtx)
+	if !ok {
+		return compileOutput.Bytes(), compileError.Bytes(), <-errorChannel, nil
+	}
+	return compileOutput.Bytes(), compileError.Bytes(), nil, nil
+}
+
+// isTransientCompileError checks whether the compile step is failed by the toolchain (e.g. a race of temporary files)
+// instead of an error of the code, i.e. a line of the output of the compile command matches one of
+// TransientCompileErrors of the SDK.
+func isTransientCompileError(config *environment.ExecutorConfig, compileOutput, compileError []byte) bool {
+	for _, transientError := range config.TransientCompileErrors {
+		pattern, err := regexp.Compile("(?m)" + transientError)
+		if err != nil {
+			continue
+		}
+		if pattern.Match(compileError) || pattern.Match(compileOutput) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSubmissionError checks whether the run step is failed because the pipeline couldn't be submitted to the runner
+// (e.g. the runner isn't available) instead of an error of the code, i.e. a line of the run error matches one of
+// SubmissionErrors of the runner.
+func isSubmissionError(runnerConfig environment.RunnerConfig, runError []byte) bool {
+	for _, submissionError := range runnerConfig.SubmissionErrors {
+		pattern, err := regexp.Compile("(?m)" + submissionError)
+		if err != nil {
+			continue
+		}
+		if pattern.Match(runError) {
+			return true
+		}
+	}
+	return false
+}
+
+// installStep installs requirements of the Python code into the virtual environment in the base folder of the code.
+// Installation is limited by the compile timeout of the SDK.
+// Returns the output of the installation and true if requirements are installed.
+// In case of installation is failed saves playground.Status_STATUS_COMPILE_ERROR as cache.Status and
+//	the error of the installation as cache.CompileOutput into cache.
+func installStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeCyclePaths, pipelineId uuid.UUID, sdkEnv *environment.BeamEnvs, pipelineLifeCycleCtx context.Context, cancelChannel chan bool) ([]byte, bool) {
+	logger.Infof("%s: Install() ...\n", pipelineId)
+	installCtx, finishInstallCtxFunc := stepContext(pipelineLifeCycleCtx, "Install", sdkEnv.ApacheBeamSdk, sdkEnv.CompileTimeout())
+	defer finishInstallCtxFunc()
+	defer setRunningCmd(pipelineId, nil)
+
+	var installOutput bytes.Buffer
+	for _, installCmd := range dependencies.GetPyInstallCmds(installCtx, paths.AbsoluteBaseFolderPath) {
+		errorChannel, successChannel := createStatusChannels()
+		runCmdWithOutput(installCmd, &installOutput, &installOutput, false, successChannel, errorChannel)
+		setRunningCmd(pipelineId, installCmd)
+
+		// Start of the monitoring of background tasks (install step/cancellation/timeout)
+		ok, err := reconcileBackgroundTask(installCtx, ctx, pipelineId, cacheService, cancelChannel, successChannel)
+		if err != nil {
+			return nil, false
+		}
+		if !ok { // Install step is finished, but requirements couldn't be installed (e.g. the package doesn't exist)
+			err := fmt.Errorf("installation of dependencies failed: %s", (<-errorChannel).Error())
+			_ = processErrorWithSavingOutput(pipelineLifeCycleCtx, err, installOutput.Bytes(), pipelineId, cache.CompileOutput, cacheService, "Install", pb.Status_STATUS_COMPILE_ERROR)
+			return nil, false
+		}
+	}
+	maxSizeBytes := sdkEnv.ExecutorConfig.MaxDependenciesSizeMb * 1024 * 1024
+	if err := dependencies.CheckPyVenvSize(paths.AbsoluteBaseFolderPath, maxSizeBytes); err != nil {
+		err = fmt.Errorf("installation of dependencies failed: %s", err.Error())
+		_ = processErrorWithSavingOutput(pipelineLifeCycleCtx, err, installOutput.Bytes(), pipelineId, cache.CompileOutput, cacheService, "Install", pb.Status_STATUS_COMPILE_ERROR)
+		return nil, false
+	}
+	logger.Infof("%s: Install() finish\n", pipelineId)
+	return installOutput.Bytes(), true
+}
+
+// graphStep generates the graph of the compiled pipeline and saves it in DOT format as cache.Graph into cache.
+// The code is run with graph args of the SDK which make it write the graph to graphFileName instead of running the pipeline.
+// The graph isn't required to run the code, so the step is skipped if the SDK doesn't have graph args
+//	and errors of the step are only logged.
+func graphStep(cacheService cache.Cache, paths *fs_tool.LifeCyclePaths, pipelineId uuid.UUID, sdkEnv *environment.BeamEnvs, pipelineOptions string, pipelineLifeCycleCtx context.Context) {
+	graphArgs := sdkEnv.ExecutorConfig.GraphArgs
+	if len(graphArgs) == 0 {
+		return
+	}
+	logger.Infof("%s: Graph() ...\n", pipelineId)
+	graphOptions := strings.TrimSpace(utils.ReduceWhiteSpacesToSinge(pipelineOptions) + " " + strings.Join(graphArgs, " "))
+	executorBuilder, err := builder.Runner(paths, graphOptions, sdkEnv)
+	if err != nil {
+		logger.Errorf("%s: Graph(): error during setup builder: %s\n", pipelineId, err.Error())
+		return
+	}
+	executor := executorBuilder.Build()
+	graphCtx, finishGraphCtxFunc := context.WithTimeout(pipelineLifeCycleCtx, graphTimeout)
+	defer finishGraphCtxFunc()
+	graphCmd := executor.Run(graphCtx)
+	// The code is run as on the run step, so it gets the same input, environment variables and limits
+	stdin, err := fs_tool.OpenStdinFile(paths.AbsoluteBaseFolderPath)
+	if err != nil {
+		logger.Errorf("%s: Graph(): error during open the input: %s\n", pipelineId, err.Error())
+		return
+	}
+	if stdin != nil {
+		defer stdin.Close()
+		graphCmd.Stdin = stdin
+	}
+	envVars, err := env_vars.Read(paths.AbsoluteBaseFolderPath)
+	if err != nil {
+		logger.Errorf("%s: Graph(): error during read environment variables: %s\n", pipelineId, err.Error())
+		return
+	}
+	graphCmd.Env = env_vars.Environ(env_vars.HostEnviron(os.Environ()), sdkEnv.ExecutorConfig.Environment, envVars)
+	limits := sdkEnv.ResourceLimits()
+	var diskQuota *fs_tool.DiskQuota
+	if limits.DiskBytes > 0 {
+		if diskQuota, err = fs_tool.NewDiskQuota(paths.AbsoluteBaseFolderPath, limits.DiskBytes); err != nil {
+			logger.Errorf("%s: Graph(): error during prepare the disk quota: %s\n", pipelineId, err.Error())
+			return
+		}
+	}
+	// The output is only logged, so it is kept in memory up to maxGraphOutputSize even if the output isn't limited
+	maxOutputBytes := limits.OutputBytes
+	if maxOutputBytes <= 0 || maxOutputBytes > maxGraphOutputSize {
+		maxOutputBytes = maxGraphOutputSize
+	}
+	var graphOutput bytes.Buffer
+	cappedGraphOutput := streaming.NewCappedWriter(&graphOutput, limits.OutputLines, maxOutputBytes, func() {
+		if limits.KillOnOutputLimit {
+			logger.Errorf("%s: Graph(): the output limit is exceeded, the process is killed", pipelineId)
+			_ = killProcessGroup(graphCmd)
+		}
+	})
+	graphCmd.Stdout = cappedGraphOutput
+	graphCmd.Stderr = cappedGraphOutput
+	setProcessGroup(graphCmd)
+	// The code mustn't be executed without limits, so the limits are set before the process is started
+	if err = setResourceLimits(graphCmd, limits); err != nil {
+		logger.Errorf("%s: Graph(): error during set resource limits: %s\n", pipelineId, err.Error())
+		return
+	}
+	if err = graphCmd.Start(); err != nil {
+		logger.Errorf("%s: Graph(): error during start: %s\n", pipelineId, err.Error())
+		return
+	}
+	if diskQuota != nil {
+		// The code is stopped when it writes more files to the working directory than the disk quota allows
+		diskQuotaCtx, stopDiskQuota := context.WithCancel(graphCtx)
+		defer stopDiskQuota()
+		go diskQuota.Watch(diskQuotaCtx, diskQuotaCheckInterval, func() {
+			logger.Errorf("%s: Graph(): the disk quota is exceeded, the process is killed", pipelineId)
+			_ = killProcessGroup(graphCmd)
+		})
+	}
+	setRunningCmd(pipelineId, graphCmd)
+	err = graphCmd.Wait()
+	setRunningCmd(pipelineId, nil)
+	if err != nil {
+		logger.Warnf("%s: Graph(): the graph isn't generated: %s, output: %s\n", pipelineId, err.Error(), outputTail(graphOutput.Bytes(), graphOutputLogSize))
+		return
+	}
+	graph, err := os.ReadFile(filepath.Join(paths.AbsoluteBaseFolderPath, graphFileName))
+	if err != nil {
+		logger.Warnf("%s: Graph(): the graph isn't generated: %s\n", pipelineId, err.Error())
+		return
+	}
+	if err = utils.SetToCache(pipelineLifeCycleCtx, cacheService, pipelineId, cache.Graph, string(graph)); err != nil {
+		return
+	}
+	logger.Infof("%s: Graph() finish\n", pipelineId)
+}
+
+// outputTail returns the end of the output of at most maxSize bytes, so the long output doesn't flood the log
+func outputTail(output []byte, maxSize int) string {
+	if len(output) <= maxSize {
+		return string(output)
+	}
+	return "..." + string(output[len(output)-maxSize:])
+}
+
+// restoreCompileResult restores the compiled files of the identical code which are kept in cache.
+// Returns the compile output and true if the compiled files are restored.
+func restoreCompileResult(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeCyclePaths, pipelineId uuid.UUID, source *source_cache.Source) (string, bool) {
+	if source == nil {
+		return "", false
+	}
+	compileOutput, ok, err := source.RestoreCompileResult(ctx, cacheService, pipelineId, paths)
+	if err != nil {
+		logger.Errorf("%s: Compile(): error during restoring compiled files: %s\n", pipelineId, err.Error())
+		return "", false
+	}
+	if ok {
+		logger.Infof("%s: Compile(): compiled files of the identical code are reused\n", pipelineId)
+	}
+	return compileOutput, ok
+}
+
 func prepareStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeCyclePaths, pipelineId uuid.UUID, sdkEnv *environment.BeamEnvs, pipelineLifeCycleCtx context.Context, validationResults *sync.Map, cancelChannel chan bool) *executors.Executor {
 	errorChannel, successChannel := createStatusChannels()
 	executorBuilder, err := builder.Preparer(paths, sdkEnv, validationResults)
@@ -260,6 +820,58 @@ func createStatusChannels() (chan error, chan bool) {
 	return errorChannel, successChannel
 }
 
+// requestDeadlineKey is the key of the deadline of the request in the context of the code processing
+type requestDeadlineKey struct{}
+
+// pipelineContext returns a context of the code processing which is done after the timeout of the code processing.
+// If deadline of the request is positive and shorter than the timeout, the context is done after the deadline instead.
+func pipelineContext(ctx context.Context, timeout, deadline time.Duration) (context.Context, context.CancelFunc) {
+	if deadline <= 0 || deadline >= timeout {
+		return context.WithTimeout(ctx, timeout)
+	}
+	deadlineCtx := context.WithValue(ctx, requestDeadlineKey{}, deadline)
+	return context.WithTimeout(deadlineCtx, deadline)
+}
+
+// stepTimeoutKey is the key of the stepTimeout value in the context of the step
+type stepTimeoutKey struct{}
+
+// stepTimeout contains information about the timeout of the step which is used to describe the timeout to the client
+type stepTimeout struct {
+	step    string
+	sdk     pb.Sdk
+	timeout time.Duration
+}
+
+// stepContext returns a context of the step which is done after the SDK-specific timeout of the step.
+// If timeout isn't positive or the pipeline context is done earlier, the step is limited only by the pipeline context.
+func stepContext(pipelineLifeCycleCtx context.Context, step string, sdk pb.Sdk, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(pipelineLifeCycleCtx)
+	}
+	if deadline, ok := pipelineLifeCycleCtx.Deadline(); ok && !time.Now().Add(timeout).Before(deadline) {
+		return context.WithCancel(pipelineLifeCycleCtx)
+	}
+	stepCtx := context.WithValue(pipelineLifeCycleCtx, stepTimeoutKey{}, stepTimeout{step: step, sdk: sdk, timeout: timeout})
+	return context.WithTimeout(stepCtx, timeout)
+}
+
+// getStepTimeoutMessage returns the message about the exceeded step timeout or the exceeded deadline of the request
+// if the step context is done because of it. Otherwise, returns an empty string.
+func getStepTimeoutMessage(stepCtx context.Context) string {
+	if stepCtx.Err() != context.DeadlineExceeded {
+		return ""
+	}
+	// the step timeout is set only if it is earlier than the deadline of the request
+	if info, ok := stepCtx.Value(stepTimeoutKey{}).(stepTimeout); ok {
+		return fmt.Sprintf("%s step of %s exceeded the timeout: %s", info.step, info.sdk, info.timeout)
+	}
+	if deadline, ok := stepCtx.Value(requestDeadlineKey{}).(time.Duration); ok {
+		return fmt.Sprintf("Code processing exceeded the deadline of the request: %s", deadline)
+	}
+	return ""
+}
+
 // getExecuteCmd return cmd instance based on the code type: unit test or example code
 func getExecuteCmd(isUnitTest bool, executor *executors.Executor, ctxWithTimeout context.Context) *exec.Cmd {
 	runType := executors.Run
@@ -281,13 +893,17 @@ func processSetupError(err error, pipelineId uuid.UUID, cacheService cache.Cache
 
 // GetProcessingOutput gets processing output value from cache by key and subKey.
 // In case key doesn't exist in cache - returns an errors.NotFoundError.
+// In case of other errors of the cache - returns an errors.InternalError.
 // In case subKey doesn't exist in cache for the key - returns an errors.NotFoundError.
 // In case value from cache by key and subKey couldn't be converted to string - returns an errors.InternalError.
 func GetProcessingOutput(ctx context.Context, cacheService cache.Cache, key uuid.UUID, subKey cache.SubKey, errorTitle string) (string, error) {
 	value, err := cacheService.GetValue(ctx, key, subKey)
 	if err != nil {
 		logger.Errorf("%s: GetProcessingOutput(): cache.GetValue: error: %s", key, err.Error())
-		return "", errors.NotFoundError(errorTitle, "Error during getting output")
+		if goerrors.Is(err, cache.ErrNotFound) {
+			return "", errors.NotFoundError(errorTitle, "Error during getting output")
+		}
+		return "", errors.InternalError(errorTitle, "Error during getting output")
 	}
 	stringValue, converted := value.(string)
 	if !converted {
@@ -297,14 +913,53 @@ func GetProcessingOutput(ctx context.Context, cacheService cache.Cache, key uuid
 	return stringValue, nil
 }
 
+// GetProcessingOutputPage gets the page of processing output from cache by key and subKey.
+// The page starts at offset bytes from the beginning of the output and contains at most limit bytes.
+// If limit is 0 or exceeds MaxOutputPageSize, MaxOutputPageSize is used.
+// The end of the page is moved back to the beginning of the character, so the page doesn't split UTF-8 characters.
+// Returns the page, the offset of the next page and the size of the whole output.
+// In case offset is out of the output - returns an errors.InvalidArgumentError.
+// Errors of getting the output are the same as GetProcessingOutput returns.
+func GetProcessingOutputPage(ctx context.Context, cacheService cache.Cache, key uuid.UUID, subKey cache.SubKey, offset, limit int64, errorTitle string) (string, int64, int64, error) {
+	output, err := GetProcessingOutput(ctx, cacheService, key, subKey, errorTitle)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	totalSize := int64(len(output))
+	if offset < 0 || offset > totalSize {
+		return "", 0, 0, errors.InvalidArgumentError(errorTitle, "offset %d is out of the output of size %d", offset, totalSize)
+	}
+	if limit <= 0 || limit > MaxOutputPageSize {
+		limit = MaxOutputPageSize
+	}
+	end := offset + limit
+	if end >= totalSize {
+		end = totalSize
+	} else {
+		for end > offset && !utf8.RuneStart(output[end]) {
+			end--
+		}
+		if end == offset {
+			// the limit is less than the size of the character, so the whole character is returned
+			_, size := utf8.DecodeRuneInString(output[offset:])
+			end = offset + int64(size)
+		}
+	}
+	return output[offset:end], end, totalSize, nil
+}
+
 // GetProcessingStatus gets processing status from cache by key.
 // In case key doesn't exist in cache - returns an errors.NotFoundError.
+// In case of other errors of the cache - returns an errors.InternalError.
 // In case value from cache by key and subKey couldn't be converted to playground.Status - returns an errors.InternalError.
 func GetProcessingStatus(ctx context.Context, cacheService cache.Cache, key uuid.UUID, errorTitle string) (pb.Status, error) {
 	value, err := cacheService.GetValue(ctx, key, cache.Status)
 	if err != nil {
 		logger.Errorf("%s: GetProcessingStatus(): cache.GetValue: error: %s", key, err.Error())
-		return pb.Status_STATUS_UNSPECIFIED, errors.NotFoundError(errorTitle, "Error during getting status")
+		if goerrors.Is(err, cache.ErrNotFound) {
+			return pb.Status_STATUS_UNSPECIFIED, errors.NotFoundError(errorTitle, "Error during getting status")
+		}
+		return pb.Status_STATUS_UNSPECIFIED, errors.InternalError(errorTitle, "Error during getting status")
 	}
 	statusValue, converted := value.(pb.Status)
 	if !converted {
@@ -316,12 +971,16 @@ func GetProcessingStatus(ctx context.Context, cacheService cache.Cache, key uuid
 
 // GetLastIndex gets last index for run output or logs from cache by key.
 // In case key doesn't exist in cache - returns an errors.NotFoundError.
+// In case of other errors of the cache - returns an errors.InternalError.
 // In case value from cache by key and subKey couldn't be converted to int - returns an errors.InternalError.
 func GetLastIndex(ctx context.Context, cacheService cache.Cache, key uuid.UUID, subKey cache.SubKey, errorTitle string) (int, error) {
 	value, err := cacheService.GetValue(ctx, key, subKey)
 	if err != nil {
 		logger.Errorf("%s: GetLastIndex(): cache.GetValue: error: %s", key, err.Error())
-		return 0, errors.NotFoundError(errorTitle, "Error during getting pagination value")
+		if goerrors.Is(err, cache.ErrNotFound) {
+			return 0, errors.NotFoundError(errorTitle, "Error during getting pagination value")
+		}
+		return 0, errors.InternalError(errorTitle, "Error during getting pagination value")
 	}
 	convertedValue, converted := value.(float64)
 	if !converted {
@@ -333,12 +992,25 @@ func GetLastIndex(ctx context.Context, cacheService cache.Cache, key uuid.UUID,
 
 // GetGraph gets graph from cache by key.
 // In case key doesn't exist in cache - returns an errors.NotFoundError.
-// In case value from cache by key couldn't be converted to []byte - returns an errors.InternalError.
+// In case of other errors of the cache - returns an errors.InternalError.
+// Structured graph (cache.PipelineGraph) is returned as JSON, graph which is kept as string is returned as is.
+// In case value from cache by key couldn't be converted to string - returns an errors.InternalError.
 func GetGraph(ctx context.Context, cacheService cache.Cache, key uuid.UUID, errorTitle string) (string, error) {
 	value, err := cacheService.GetValue(ctx, key, cache.Graph)
 	if err != nil {
 		logger.Errorf("%s: GetGraph(): cache.GetValue: error: %s", key, err.Error())
-		return "", errors.NotFoundError(errorTitle, "Error during getting graph")
+		if goerrors.Is(err, cache.ErrNotFound) {
+			return "", errors.NotFoundError(errorTitle, "Error during getting graph")
+		}
+		return "", errors.InternalError(errorTitle, "Error during getting graph")
+	}
+	if graph, isGraph := value.(cache.PipelineGraph); isGraph {
+		graphJson, err := json.Marshal(graph)
+		if err != nil {
+			logger.Errorf("%s: GetGraph(): json.Marshal: error: %s", key, err.Error())
+			return "", errors.InternalError(errorTitle, "Error during getting graph")
+		}
+		return string(graphJson), nil
 	}
 	stringValue, converted := value.(string)
 	if !converted {
@@ -348,12 +1020,152 @@ func GetGraph(ctx context.Context, cacheService cache.Cache, key uuid.UUID, erro
 	return stringValue, nil
 }
 
-// runCmdWithOutput runs command with keeping stdOut and stdErr
-func runCmdWithOutput(cmd *exec.Cmd, stdOutput io.Writer, stdError io.Writer, successChannel chan bool, errorChannel chan error) {
+// GetPipelineGraph gets the graph of the pipeline from cache by key and returns it in DOT format and as the structured graph.
+// The graph which is kept in DOT format is parsed, the structured graph is converted to DOT format.
+// If the graph isn't generated yet, returns false without an error.
+// If the graph in DOT format couldn't be parsed, returns nil as the structured graph.
+// In case of other errors of the cache - returns an errors.InternalError.
+func GetPipelineGraph(ctx context.Context, cacheService cache.Cache, key uuid.UUID, errorTitle string) (string, *cache.PipelineGraph, bool, error) {
+	value, err := cacheService.GetValue(ctx, key, cache.Graph)
+	if goerrors.Is(err, cache.ErrNotFound) {
+		return "", nil, false, nil
+	}
+	if err != nil {
+		logger.Errorf("%s: GetPipelineGraph(): cache.GetValue: error: %s", key, err.Error())
+		return "", nil, false, errors.InternalError(errorTitle, "Error during getting graph")
+	}
+	switch graph := value.(type) {
+	case cache.PipelineGraph:
+		return pipeline_graph.ToDot(graph), &graph, true, nil
+	case string:
+		structuredGraph, err := pipeline_graph.ParseDot(graph)
+		if err != nil {
+			logger.Warnf("%s: GetPipelineGraph(): the graph couldn't be parsed: %s", key, err.Error())
+			return graph, nil, true, nil
+		}
+		return graph, &structuredGraph, true, nil
+	default:
+		logger.Errorf("%s: couldn't convert value to graph. value: %s type %s", key, value, reflect.TypeOf(value))
+		return "", nil, false, errors.InternalError(errorTitle, "Error during getting graph")
+	}
+}
+
+// GetLogEntries gets structured entries of logs of the pipeline from cache by key.
+// While the run step isn't finished, entries are parsed from logs which are currently available.
+// In case there are no logs of the pipeline - returns an errors.NotFoundError.
+// In case of other errors of the cache - returns an errors.InternalError.
+func GetLogEntries(ctx context.Context, cacheService cache.Cache, key uuid.UUID, errorTitle string) ([]cache.LogEntry, error) {
+	value, err := cacheService.GetValue(ctx, key, cache.LogEntries)
+	if err != nil {
+		if !goerrors.Is(err, cache.ErrNotFound) {
+			logger.Errorf("%s: GetLogEntries(): cache.GetValue: error: %s", key, err.Error())
+			return nil, errors.InternalError(errorTitle, "Error during getting log entries")
+		}
+		logs, err := GetProcessingOutput(ctx, cacheService, key, cache.Logs, errorTitle)
+		if err != nil {
+			return nil, err
+		}
+		return log_entries.Parse(logs), nil
+	}
+	entries, converted := value.([]cache.LogEntry)
+	if !converted {
+		logger.Errorf("%s: couldn't convert value to log entries. value: %s type %s", key, value, reflect.TypeOf(value))
+		return nil, errors.InternalError(errorTitle, "Error during getting log entries")
+	}
+	return entries, nil
+}
+
+// GetMetadata gets metadata of all values of the pipeline which are kept in cache sorted by their subKeys.
+// Values are received by one scan of the pipeline, but the time to live is received for each subKey,
+// because subKeys could be kept with different expiration times.
+// In case there are no values of the pipeline in cache - returns an errors.NotFoundError.
+// In case of other errors of the cache - returns an errors.InternalError.
+func GetMetadata(ctx context.Context, cacheService cache.Cache, key uuid.UUID, errorTitle string) ([]*pb.SubKeyMetadata, error) {
+	values, err := cacheService.ScanValues(ctx, key)
+	if err != nil {
+		logger.Errorf("%s: GetMetadata(): cache.ScanValues: error: %s", key, err.Error())
+		return nil, errors.InternalError(errorTitle, "Error during getting metadata")
+	}
+	if len(values) == 0 {
+		return nil, errors.NotFoundError(errorTitle, "Error during getting metadata")
+	}
+	subKeys := make([]cache.SubKey, 0, len(values))
+	for subKey := range values {
+		subKeys = append(subKeys, subKey)
+	}
+	sort.Slice(subKeys, func(i, j int) bool { return subKeys[i] < subKeys[j] })
+
+	metadata := make([]*pb.SubKeyMetadata, 0, len(subKeys))
+	for _, subKey := range subKeys {
+		size, err := getValueSize(values[subKey])
+		if err != nil {
+			logger.Errorf("%s: GetMetadata(): error during get size of %s: %s", key, subKey, err.Error())
+			return nil, errors.InternalError(errorTitle, "Error during getting metadata")
+		}
+		_, ttl, err := cacheService.GetValueWithTTL(ctx, key, subKey)
+		if err != nil {
+			if goerrors.Is(err, cache.ErrNotFound) {
+				// the value is expired or deleted after the scan
+				continue
+			}
+			logger.Errorf("%s: GetMetadata(): cache.GetValueWithTTL: error: %s", key, err.Error())
+			return nil, errors.InternalError(errorTitle, "Error during getting metadata")
+		}
+		ttlSeconds := int64(-1)
+		if ttl != cache.NoExpiration {
+			ttlSeconds = int64(ttl / time.Second)
+		}
+		metadata = append(metadata, &pb.SubKeyMetadata{SubKey: string(subKey), Size: size, TtlSeconds: ttlSeconds})
+	}
+	return metadata, nil
+}
+
+// getValueSize returns the size of the value in bytes.
+// The size of values which aren't strings or bytes (e.g. playground.Status or cache.PipelineGraph) is the size of their JSON.
+func getValueSize(value interface{}) (int64, error) {
+	switch value := value.(type) {
+	case string:
+		return int64(len(value)), nil
+	case []byte:
+		return int64(len(value)), nil
+	default:
+		valueJson, err := json.Marshal(value)
+		if err != nil {
+			return 0, err
+		}
+		return int64(len(valueJson)), nil
+	}
+}
+
+// outputWriter returns the writer of the output of the SDK's process.
+// If the SDK is configured to strip ANSI escape sequences, they are removed before the output is written to w.
+func outputWriter(sdkEnv *environment.BeamEnvs, w io.Writer) io.Writer {
+	if sdkEnv.ExecutorConfig.StripAnsiCodes {
+		return streaming.NewAnsiStripWriter(w)
+	}
+	return w
+}
+
+// runCmdWithOutput runs command with keeping stdOut and stdErr.
+// The command is started in its own process group before the method returns, so it could be killed by Cancel.
+// If killGroupOnExit is true, the process group is killed when the process of the command exits.
+// The group is killed before the process is reaped by cmd.Wait, so its pid can't be reused by another group meanwhile.
+func runCmdWithOutput(cmd *exec.Cmd, stdOutput io.Writer, stdError io.Writer, killGroupOnExit bool, successChannel chan bool, errorChannel chan error) {
 	cmd.Stdout = stdOutput
 	cmd.Stderr = stdError
+	setProcessGroup(cmd)
+	if err := cmd.Start(); err != nil {
+		errorChannel <- err
+		successChannel <- false
+		return
+	}
 	go func(cmd *exec.Cmd, successChannel chan bool, errChannel chan error) {
-		err := cmd.Run()
+		if killGroupOnExit {
+			if err := waitProcessExit(cmd); err == nil {
+				_ = killProcessGroup(cmd)
+			}
+		}
+		err := cmd.Wait()
 		if err != nil {
 			errChannel <- err
 			successChannel <- false
@@ -372,16 +1184,99 @@ func runCmdWithOutput(cmd *exec.Cmd, stdOutput io.Writer, stdError io.Writer, su
 func reconcileBackgroundTask(pipelineLifeCycleCtx, backgroundCtx context.Context, pipelineId uuid.UUID, cacheService cache.Cache, cancelChannel, successChannel chan bool) (bool, error) {
 	select {
 	case <-pipelineLifeCycleCtx.Done():
-		_ = finishByTimeout(backgroundCtx, pipelineId, cacheService)
+		_ = finishByTimeout(backgroundCtx, pipelineId, cacheService, getStepTimeoutMessage(pipelineLifeCycleCtx))
 		return false, fmt.Errorf("%s: context was done", pipelineId)
 	case <-cancelChannel:
 		_ = processCancel(pipelineLifeCycleCtx, cacheService, pipelineId)
 		return false, fmt.Errorf("%s: code processing was canceled", pipelineId)
 	case ok := <-successChannel:
-		return ok, nil
+		// Cancel kills the process after the signal to cancelChannel, so the cancellation has priority over the result
+		select {
+		case <-cancelChannel:
+			_ = processCancel(pipelineLifeCycleCtx, cacheService, pipelineId)
+			return false, fmt.Errorf("%s: code processing was canceled", pipelineId)
+		default:
+			return ok, nil
+		}
+	}
+}
+
+// setRunningCmd keeps the command of the current compile/run step of the code processing, so it could be killed by Cancel.
+// If the code processing isn't executed by this server, does nothing.
+func setRunningCmd(pipelineId uuid.UUID, cmd *exec.Cmd) {
+	value, ok := runningProcesses.Load(pipelineId)
+	if !ok {
+		return
+	}
+	process := value.(*runningProcess)
+	process.mu.Lock()
+	defer process.mu.Unlock()
+	process.cmd = cmd
+}
+
+// Cancel cancels the code processing by pipelineId.
+// Saves playground.Status_STATUS_CANCELED as cache.Status and true as cache.Canceled into cache.
+// If the code processing is executed by this server, the process group of the current compile/run step is killed.
+//	Otherwise, the code processing is stopped by cancelCheck of the server which executes it.
+// If the code processing is already finished, does nothing.
+func Cancel(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID) error {
+	isSet, err := cacheService.SetStatusIfNotTerminal(ctx, pipelineId, pb.Status_STATUS_CANCELED)
+	if err != nil {
+		logger.Errorf("%s: Cancel(): cache.SetStatusIfNotTerminal(): %s\n", pipelineId, err.Error())
+		return err
+	}
+	if !isSet {
+		logger.Infof("%s: Cancel(): code processing is already finished\n", pipelineId)
+		return nil
+	}
+	if err = utils.SetToCache(ctx, cacheService, pipelineId, cache.Canceled, true); err != nil {
+		return err
+	}
+
+	if value, ok := runningProcesses.Load(pipelineId); ok {
+		value.(*runningProcess).stop(pipelineId)
+	}
+	return nil
+}
+
+// stop signals the code processing to stop and kills the process group of the current compile/run step
+func (process *runningProcess) stop(pipelineId uuid.UUID) {
+	process.mu.Lock()
+	defer process.mu.Unlock()
+	select {
+	case process.cancelChannel <- true:
+	default:
+	}
+	if process.cmd != nil && process.cmd.Process != nil {
+		if err := killProcessGroup(process.cmd); err != nil {
+			logger.Errorf("%s: error during kill of the process: %s\n", pipelineId, err.Error())
+		}
 	}
 }
 
+// TerminateAll terminates code processings which are executed by this server, e.g. when the server is shut down.
+// Saves playground.Status_STATUS_ERROR as cache.Status and ShutdownMessage as cache.RunError into cache
+//	unless the code processing is already finished, then kills the process of the current compile/run step.
+// Returns the number of terminated code processings, they are stopped asynchronously.
+func TerminateAll(ctx context.Context, cacheService cache.Cache) int {
+	terminated := 0
+	runningProcesses.Range(func(key, value interface{}) bool {
+		pipelineId := key.(uuid.UUID)
+		isSet, err := cacheService.SetStatusIfNotTerminal(ctx, pipelineId, pb.Status_STATUS_ERROR)
+		if err != nil {
+			logger.Errorf("%s: TerminateAll(): cache.SetStatusIfNotTerminal(): %s\n", pipelineId, err.Error())
+		}
+		if isSet {
+			logger.Warnf("%s: TerminateAll(): code processing is terminated\n", pipelineId)
+			_ = utils.SetToCache(ctx, cacheService, pipelineId, cache.RunError, ShutdownMessage)
+		}
+		value.(*runningProcess).stop(pipelineId)
+		terminated++
+		return true
+	})
+	return terminated
+}
+
 // cancelCheck checks cancel flag for code processing.
 // If cancel flag doesn't exist in cache continue working.
 // If context is done it means that the code processing was finished (successfully/with error/timeout). Return.
@@ -396,10 +1291,21 @@ func cancelCheck(ctx context.Context, pipelineId uuid.UUID, cancelChannel chan b
 		case <-ticker.C:
 			cancel, err := cacheService.GetValue(ctx, pipelineId, cache.Canceled)
 			if err != nil {
-				logger.Errorf("%s: Error during getting value from the cache: %s", pipelineId, err.Error())
+				if !goerrors.Is(err, cache.ErrNotFound) {
+					logger.Errorf("%s: Error during getting value from the cache: %s", pipelineId, err.Error())
+				}
+				continue
+			}
+			canceled, ok := cancel.(bool)
+			if !ok {
+				logger.Errorf("%s: couldn't convert cancel flag to bool. value: %v type %s", pipelineId, cancel, reflect.TypeOf(cancel))
+				continue
 			}
-			if cancel.(bool) {
-				cancelChannel <- true
+			if canceled {
+				select {
+				case cancelChannel <- true:
+				default:
+				}
 				return
 			}
 		}
@@ -412,47 +1318,117 @@ func cancelCheck(ctx context.Context, pipelineId uuid.UUID, cancelChannel chan b
 // 	and it waits until the method stops the work to change status to the pb.Status_STATUS_FINISHED. Write last logs
 //	to the cache and set value to the finishReadLogChannel channel to unblock the code processing.
 // In other case each pauseDuration write to cache logs of the code processing.
-func readLogFile(pipelineLifeCycleCtx, backgroundCtx context.Context, cacheService cache.Cache, logFilePath string, pipelineId uuid.UUID, stopReadLogsChannel, finishReadLogChannel chan bool) {
+func readLogFile(pipelineLifeCycleCtx, backgroundCtx context.Context, cacheService cache.Cache, logFilePath string, pipelineId uuid.UUID, sdkEnv *environment.BeamEnvs, stopReadLogsChannel, finishReadLogChannel chan bool) {
 	ticker := time.NewTicker(pauseDuration)
+	logs := &logFileTail{
+		path:   logFilePath,
+		writer: newOutputWriter(pipelineLifeCycleCtx, cacheService, pipelineId, cache.Logs, sdkEnv),
+	}
+	logs.output = logs.writer
+	if sdkEnv.ExecutorConfig.StripAnsiCodes {
+		logs.output = streaming.NewAnsiStripWriter(logs.writer)
+	}
 	for {
 		select {
 		// in case of timeout or cancel
 		case <-pipelineLifeCycleCtx.Done():
-			_ = finishReadLogFile(backgroundCtx, ticker, cacheService, logFilePath, pipelineId)
+			_ = finishReadLogFile(backgroundCtx, ticker, logs, pipelineId)
+			_ = saveLogEntries(backgroundCtx, cacheService, pipelineId)
 			return
 		// in case of pipeline finish successfully or has error on the run step
 		case <-stopReadLogsChannel:
-			_ = finishReadLogFile(pipelineLifeCycleCtx, ticker, cacheService, logFilePath, pipelineId)
+			_ = finishReadLogFile(pipelineLifeCycleCtx, ticker, logs, pipelineId)
+			_ = saveLogEntries(pipelineLifeCycleCtx, cacheService, pipelineId)
 			finishReadLogChannel <- true
 			return
 		case <-ticker.C:
-			_ = writeLogsToCache(pipelineLifeCycleCtx, cacheService, logFilePath, pipelineId)
+			_ = writeLogsToCache(logs, pipelineId)
 		}
 	}
 }
 
-// finishReadLogFile is used to read logs file for the last time
-func finishReadLogFile(ctx context.Context, ticker *time.Ticker, cacheService cache.Cache, logFilePath string, pipelineId uuid.UUID) error {
+// logFileTail keeps the offset of the log file which is already appended to the cache
+type logFileTail struct {
+	path   string
+	offset int64
+	writer *streaming.BufferedWriter
+	// output is the writer which the read logs are copied to, it writes them to writer
+	output io.Writer
+}
+
+// finishReadLogFile is used to read logs file for the last time and save all read logs to the cache
+func finishReadLogFile(ctx context.Context, ticker *time.Ticker, logs *logFileTail, pipelineId uuid.UUID) error {
 	ticker.Stop()
-	return writeLogsToCache(ctx, cacheService, logFilePath, pipelineId)
+	err := writeLogsToCache(logs, pipelineId)
+	if closeErr := logs.writer.Close(ctx); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// saveLogEntries parses the whole logs of the pipeline and saves their entries as cache.LogEntries into cache.
+// If the pipeline doesn't have logs, nothing is saved.
+func saveLogEntries(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID) error {
+	value, err := cacheService.GetValue(ctx, pipelineId, cache.Logs)
+	if err != nil {
+		if goerrors.Is(err, cache.ErrNotFound) {
+			return nil
+		}
+		logger.Errorf("%s: saveLogEntries(): error during getting logs: %s", pipelineId, err.Error())
+		return err
+	}
+	logs, ok := value.(string)
+	if !ok {
+		return nil
+	}
+	return utils.SetToCache(ctx, cacheService, pipelineId, cache.LogEntries, log_entries.Parse(logs))
 }
 
-// writeLogsToCache write all logs from the log file to the cache.
+// writeLogsToCache appends new logs from the log file to the cache using cache.Logs subKey.
 // If log file doesn't exist, return nil.
 //	Reading logs works as a parallel with code processing so when program tries to read file
 //	it could be that the file doesn't exist yet.
-// If log file exists, read all from the log file and keep it to the cache using cache.Logs subKey.
+// If log file exists, read logs which are written after the previous reading and append them to the writer of logs,
+// which saves them to the cache by its flush interval and max buffer size.
+//	If the log file is shorter than the previous reading, it was rewritten, so it is read from the beginning.
 // If some error occurs, log the error and return the error.
-func writeLogsToCache(ctx context.Context, cacheService cache.Cache, logFilePath string, pipelineId uuid.UUID) error {
-	if _, err := os.Stat(logFilePath); os.IsNotExist(err) {
+func writeLogsToCache(logs *logFileTail, pipelineId uuid.UUID) error {
+	file, err := os.Open(logs.path)
+	if os.IsNotExist(err) {
 		return nil
 	}
-	logs, err := os.ReadFile(logFilePath)
+	if err != nil {
+		logger.Errorf("%s: writeLogsToCache(): error during open logs file: %s", pipelineId, err.Error())
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		logger.Errorf("%s: writeLogsToCache(): error during read from logs file: %s", pipelineId, err.Error())
+		return err
+	}
+	if info.Size() < logs.offset {
+		logs.offset = 0
+	}
+	if _, err = file.Seek(logs.offset, io.SeekStart); err != nil {
+		logger.Errorf("%s: writeLogsToCache(): error during read from logs file: %s", pipelineId, err.Error())
+		return err
+	}
+	read, err := io.Copy(logs.output, file)
+	logs.offset += read
 	if err != nil {
 		logger.Errorf("%s: writeLogsToCache(): error during read from logs file: %s", pipelineId, err.Error())
 		return err
 	}
-	return utils.SetToCache(ctx, cacheService, pipelineId, cache.Logs, string(logs))
+	return nil
+}
+
+// newOutputWriter returns the writer which streams the output of the pipeline's subKey to the cache
+// with the flush interval and the max buffer size of the SDK's config
+func newOutputWriter(pipelineLifeCycleCtx context.Context, cacheService cache.Cache, pipelineId uuid.UUID, subKey cache.SubKey, sdkEnv *environment.BeamEnvs) *streaming.BufferedWriter {
+	config := sdkEnv.ExecutorConfig
+	return streaming.NewBufferedWriter(pipelineLifeCycleCtx, cacheService, pipelineId, subKey, config.OutputFlushInterval(), config.OutputBufferSize())
 }
 
 // DeleteFolders removes all prepared folders for received LifeCycle
@@ -465,10 +1441,32 @@ func DeleteFolders(pipelineId uuid.UUID, lc *fs_tool.LifeCycle) {
 	logger.Infof("%s: complete\n", pipelineId)
 }
 
-// finishByTimeout is used in case of runCode method finished by timeout
-func finishByTimeout(ctx context.Context, pipelineId uuid.UUID, cacheService cache.Cache) error {
+// deleteOrRetainFolders starts the retention of folders of the LifeCycle if they are marked to be kept,
+// otherwise removes them like DeleteFolders
+func deleteOrRetainFolders(pipelineId uuid.UUID, lc *fs_tool.LifeCycle) {
+	retained, err := lc.RetainFolders()
+	if err != nil {
+		logger.Errorf("%s: RetainFolders(): %s\n", pipelineId, err.Error())
+	}
+	if retained {
+		logger.Infof("%s: working directory is kept\n", pipelineId)
+		logger.Infof("%s: complete\n", pipelineId)
+		return
+	}
+	DeleteFolders(pipelineId, lc)
+}
+
+// finishByTimeout is used in case of runCode method finished by timeout.
+// If timeoutMessage isn't empty, it is saved to the cache as cache.RunError before the status.
+func finishByTimeout(ctx context.Context, pipelineId uuid.UUID, cacheService cache.Cache, timeoutMessage string) error {
 	logger.Errorf("%s: code processing finishes because of timeout\n", pipelineId)
 
+	if timeoutMessage != "" {
+		if err := utils.SetToCache(ctx, cacheService, pipelineId, cache.RunError, timeoutMessage); err != nil {
+			return err
+		}
+	}
+
 	// set to cache pipelineId: cache.SubKey_Status: Status_STATUS_RUN_TIMEOUT
 	return utils.SetToCache(ctx, cacheService, pipelineId, cache.Status, pb.Status_STATUS_RUN_TIMEOUT)
 }
@@ -488,11 +1486,17 @@ func processErrorWithSavingOutput(ctx context.Context, err error, errorOutput []
 // This method sets error output to the cache and after that sets value to channel to stop goroutine which writes logs.
 //	After receiving a signal that goroutine was finished (read value from finishReadLogsChannel) this method
 //	sets corresponding status to the cache.
-func processRunError(ctx context.Context, errorChannel chan error, errorOutput []byte, pipelineId uuid.UUID, cacheService cache.Cache, stopReadLogsChannel, finishReadLogsChannel chan bool) error {
+// If failureMessage about the exceeded resource limit or the failed submission to the runner isn't empty,
+//	it is placed before the error in the cache.
+func processRunError(ctx context.Context, errorChannel chan error, errorOutput []byte, failureMessage string, pipelineId uuid.UUID, cacheService cache.Cache, stopReadLogsChannel, finishReadLogsChannel chan bool) error {
 	err := <-errorChannel
 	logger.Errorf("%s: Run(): err: %s, output: %s\n", pipelineId, err.Error(), errorOutput)
 
-	if err := utils.SetToCache(ctx, cacheService, pipelineId, cache.RunError, fmt.Sprintf("error: %s\noutput: %s", err.Error(), string(errorOutput))); err != nil {
+	runError := fmt.Sprintf("error: %s\noutput: %s", err.Error(), string(errorOutput))
+	if failureMessage != "" {
+		runError = fmt.Sprintf("%s\n%s", failureMessage, runError)
+	}
+	if err := utils.SetToCache(ctx, cacheService, pipelineId, cache.RunError, runError); err != nil {
 		return err
 	}
 
@@ -510,25 +1514,117 @@ func processSuccess(ctx context.Context, pipelineId uuid.UUID, cacheService cach
 	return utils.SetToCache(ctx, cacheService, pipelineId, cache.Status, newStatus)
 }
 
+// saveExecutionCommand saves the command line of cmd with redacted credentials as cache.ExecutionCommand into cache.
+// If appendToPrevious is true, the command line is added after command lines of previous steps, otherwise it replaces
+//	them, so the command of the retried compile step is kept once.
+func saveExecutionCommand(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID, cmd *exec.Cmd, appendToPrevious bool) {
+	commandLine := executors.CommandLine(cmd)
+	if appendToPrevious {
+		if previous, err := cacheService.GetValue(ctx, pipelineId, cache.ExecutionCommand); err == nil {
+			if previous, ok := previous.(string); ok && previous != "" {
+				commandLine = previous + "\n" + commandLine
+			}
+		}
+	}
+	_ = utils.SetToCache(ctx, cacheService, pipelineId, cache.ExecutionCommand, commandLine)
+}
+
+// stageTimer measures durations of the stages of the code processing by pipelineId.
+// It is the cache which is used by the stages: before a terminal status of the pipeline is set, it saves durations
+//	of the passed stages and the current one, so clients which receive the terminal status find all timings.
+//	After that timings aren't changed anymore.
+type stageTimer struct {
+	cache.Cache
+	pipelineId uuid.UUID
+
+	mu         sync.Mutex
+	timings    cache.StageTimings
+	stage      string
+	stageStart time.Time
+	finished   bool
+}
+
+// newStageTimer returns stageTimer with no passed stages.
+func newStageTimer(cacheService cache.Cache, pipelineId uuid.UUID) *stageTimer {
+	return &stageTimer{Cache: cacheService, pipelineId: pipelineId, timings: cache.StageTimings{}}
+}
+
+// start starts measuring of the stage. The returned function finishes it and saves durations of all passed stages
+//	as cache.Timings into cache.
+func (timer *stageTimer) start(stage string) func(ctx context.Context) {
+	timer.mu.Lock()
+	timer.stage, timer.stageStart = stage, time.Now()
+	timer.mu.Unlock()
+	return func(ctx context.Context) {
+		timer.mu.Lock()
+		defer timer.mu.Unlock()
+		timer.save(ctx)
+	}
+}
+
+// finish saves timings if the status of the pipeline is terminal, it must be called before the status is set
+func (timer *stageTimer) finish(ctx context.Context, pipelineId uuid.UUID, status pb.Status) {
+	if pipelineId != timer.pipelineId || !cache.IsTerminalStatus(status) {
+		return
+	}
+	timer.mu.Lock()
+	defer timer.mu.Unlock()
+	timer.save(ctx)
+	timer.finished = true
+}
+
+// save adds the duration of the current stage to timings and saves them as cache.Timings into cache.
+// Does nothing if there is no current stage or the terminal status is already set. Must be called with mu locked.
+func (timer *stageTimer) save(ctx context.Context) {
+	if timer.finished || timer.stage == "" {
+		return
+	}
+	timer.timings[timer.stage] = time.Since(timer.stageStart)
+	timer.stage = ""
+	// save a copy, the local cache keeps the value itself and it mustn't be changed by next stages
+	timings := make(cache.StageTimings, len(timer.timings))
+	for name, duration := range timer.timings {
+		timings[name] = duration
+	}
+	_ = utils.SetToCache(ctx, timer.Cache, timer.pipelineId, cache.Timings, timings)
+}
+
+// SetValue saves timings before the terminal status is set
+func (timer *stageTimer) SetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) error {
+	if status, ok := value.(pb.Status); ok && subKey == cache.Status {
+		timer.finish(ctx, pipelineId, status)
+	}
+	return timer.Cache.SetValue(ctx, pipelineId, subKey, value)
+}
+
+// SetValues saves timings before the terminal status is set
+func (timer *stageTimer) SetValues(ctx context.Context, pipelineId uuid.UUID, values map[cache.SubKey]interface{}) error {
+	if status, ok := values[cache.Status].(pb.Status); ok {
+		timer.finish(ctx, pipelineId, status)
+	}
+	return timer.Cache.SetValues(ctx, pipelineId, values)
+}
+
+// SetStatusIfNotTerminal saves timings before the terminal status is set
+func (timer *stageTimer) SetStatusIfNotTerminal(ctx context.Context, pipelineId uuid.UUID, status pb.Status) (bool, error) {
+	timer.finish(ctx, pipelineId, status)
+	return timer.Cache.SetStatusIfNotTerminal(ctx, pipelineId, status)
+}
+
 // processCompileSuccess processes case after successful compile step.
 // This method sets output of the compile step, sets empty string as output of the run step and
 //	sets corresponding status to the cache.
 func processCompileSuccess(ctx context.Context, output []byte, pipelineId uuid.UUID, cacheService cache.Cache) error {
 	logger.Infof("%s: Compile() finish\n", pipelineId)
 
-	if err := utils.SetToCache(ctx, cacheService, pipelineId, cache.CompileOutput, string(output)); err != nil {
-		return err
-	}
-	if err := utils.SetToCache(ctx, cacheService, pipelineId, cache.RunOutput, ""); err != nil {
-		return err
-	}
-	if err := utils.SetToCache(ctx, cacheService, pipelineId, cache.RunError, ""); err != nil {
-		return err
-	}
-	if err := utils.SetToCache(ctx, cacheService, pipelineId, cache.Logs, ""); err != nil {
-		return err
-	}
-	return utils.SetToCache(ctx, cacheService, pipelineId, cache.Status, pb.Status_STATUS_EXECUTING)
+	// set all values in one operation so readers never see the status without outputs
+	return utils.SetValuesToCache(ctx, cacheService, pipelineId, map[cache.SubKey]interface{}{
+		cache.CompileOutput: string(output),
+		cache.RunOutput:     "",
+		cache.RunError:      "",
+		cache.Logs:          "",
+		cache.Status:        pb.Status_STATUS_EXECUTING,
+	})
 }
 
 // processRunSuccess processes case after successful run step.
@@ -544,10 +1640,73 @@ func processRunSuccess(ctx context.Context, pipelineId uuid.UUID, cacheService c
 	return utils.SetToCache(ctx, cacheService, pipelineId, cache.Status, pb.Status_STATUS_FINISHED)
 }
 
+// processRunResult sets metadata of the finished run step to the cache using cache.RunResult subKey.
+// If the process wasn't started, ExitCode of the result is -1.
+func processRunResult(ctx context.Context, pipelineId uuid.UUID, cacheService cache.Cache, state *os.ProcessState, duration time.Duration, limitExceeded string, outputTruncated bool) error {
+	result := cache.ExecutionResult{ExitCode: -1, Duration: duration, LimitExceeded: limitExceeded, OutputTruncated: outputTruncated}
+	if state != nil {
+		result.ExitCode = state.ExitCode()
+		result.PeakMemory = peakMemory(state)
+	}
+	return utils.SetToCache(ctx, cacheService, pipelineId, cache.RunResult, result)
+}
+
+// getExceededLimit returns the resource limit (cache.MemoryLimit or cache.CpuLimit) which was hit by the failed run process.
+// The CPU time limit is hit if the process used all available CPU time.
+// The memory limit is hit if the error output contains an out of memory error of the SDK runtime.
+// Returns an empty string if no limit was hit.
+func getExceededLimit(state *os.ProcessState, limits environment.ResourceLimits, errorOutput []byte) string {
+	if state == nil {
+		return ""
+	}
+	if limits.CpuTime > 0 && state.UserTime()+state.SystemTime() >= limits.CpuTime {
+		return cache.CpuLimit
+	}
+	if limits.MemoryBytes > 0 {
+		for _, message := range outOfMemoryMessages {
+			if bytes.Contains(errorOutput, []byte(message)) {
+				return cache.MemoryLimit
+			}
+		}
+	}
+	return ""
+}
+
+// getLimitExceededMessage returns the message about the exceeded resource limit which is saved as cache.RunError
+func getLimitExceededMessage(limitExceeded string, limits environment.ResourceLimits) string {
+	if limitExceeded == cache.CpuLimit {
+		return fmt.Sprintf("Run step exceeded the CPU time limit: %s", limits.CpuTime)
+	}
+	if limitExceeded == cache.DiskLimit {
+		return fmt.Sprintf("Run step exceeded the disk quota: %d MB", limits.DiskBytes/(1024*1024))
+	}
+	if limitExceeded == cache.OutputLimit {
+		return fmt.Sprintf("Run step exceeded the output limit: %s", getOutputLimitsDescription(limits))
+	}
+	return fmt.Sprintf("Run step exceeded the memory limit: %d MB", limits.MemoryBytes/(1024*1024))
+}
+
+// getOutputLimitsDescription returns the description of output limits (e.g. "1000 lines or 1 MB")
+func getOutputLimitsDescription(limits environment.ResourceLimits) string {
+	descriptions := make([]string, 0, 2)
+	if limits.OutputLines > 0 {
+		descriptions = append(descriptions, fmt.Sprintf("%d lines", limits.OutputLines))
+	}
+	if limits.OutputBytes > 0 {
+		descriptions = append(descriptions, fmt.Sprintf("%d MB", limits.OutputBytes/(1024*1024)))
+	}
+	return strings.Join(descriptions, " or ")
+}
+
 // processCancel process case when code processing was canceled
 func processCancel(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID) error {
 	logger.Infof("%s: was canceled\n", pipelineId)
 
 	// set to cache pipelineId: cache.SubKey_Status: pb.Status_STATUS_CANCELED
-	return utils.SetToCache(ctx, cacheService, pipelineId, cache.Status, pb.Status_STATUS_CANCELED)
+	// unless the pipeline has been already finished, so a late cancellation doesn't overwrite the final status
+	_, err := cacheService.SetStatusIfNotTerminal(ctx, pipelineId, pb.Status_STATUS_CANCELED)
+	if err != nil {
+		logger.Errorf("%s: cache.SetStatusIfNotTerminal: %s\n", pipelineId, err.Error())
+	}
+	return err
 }
@@ -19,9 +19,14 @@ import (
 	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/cache"
 	"beam.apache.org/playground/backend/internal/cache/local"
+	"beam.apache.org/playground/backend/internal/env_vars"
 	"beam.apache.org/playground/backend/internal/environment"
+	"beam.apache.org/playground/backend/internal/dependencies"
 	"beam.apache.org/playground/backend/internal/executors"
 	"beam.apache.org/playground/backend/internal/fs_tool"
+	"beam.apache.org/playground/backend/internal/job_queue"
+	"beam.apache.org/playground/backend/internal/source_cache"
+	"beam.apache.org/playground/backend/internal/streaming"
 	"beam.apache.org/playground/backend/internal/utils"
 	"beam.apache.org/playground/backend/internal/validators"
 	"context"
@@ -257,7 +262,7 @@ func Test_Process(t *testing.T) {
 					cacheService.SetValue(ctx, pipelineId, cache.Canceled, true)
 				}(tt.args.ctx, tt.args.pipelineId)
 			}
-			Process(tt.args.ctx, cacheService, lc, tt.args.pipelineId, tt.args.appEnv, tt.args.sdkEnv, tt.args.pipelineOptions)
+			Process(tt.args.ctx, cacheService, lc, tt.args.pipelineId, tt.args.appEnv, tt.args.sdkEnv, tt.args.pipelineOptions, 0, nil, nil)
 
 			status, _ := cacheService.GetValue(tt.args.ctx, tt.args.pipelineId, cache.Status)
 			if !reflect.DeepEqual(status, tt.expectedStatus) {
@@ -371,6 +376,112 @@ func TestGetProcessingOutput(t *testing.T) {
 	}
 }
 
+func TestGetProcessingOutputPage(t *testing.T) {
+	defer goleak.VerifyNone(t, opt)
+	pipelineId := uuid.New()
+	// "€" takes 3 bytes, so the output takes 7 bytes
+	err := cacheService.SetValue(context.Background(), pipelineId, cache.RunOutput, "ab€cd")
+	if err != nil {
+		panic(err)
+	}
+
+	type args struct {
+		key    uuid.UUID
+		offset int64
+		limit  int64
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           string
+		wantNextOffset int64
+		wantTotalSize  int64
+		wantErr        bool
+	}{
+		{
+			// Test case with calling GetProcessingOutputPage with pipelineId which doesn't contain run output.
+			// As a result, want to receive an error.
+			name:    "pipelineId without output",
+			args:    args{key: uuid.New(), offset: 0, limit: 2},
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetProcessingOutputPage with negative offset.
+			// As a result, want to receive an error.
+			name:    "negative offset",
+			args:    args{key: pipelineId, offset: -1, limit: 2},
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetProcessingOutputPage with offset after the end of the output.
+			// As a result, want to receive an error.
+			name:    "offset after the end",
+			args:    args{key: pipelineId, offset: 8, limit: 2},
+			wantErr: true,
+		},
+		{
+			// Test case with calling GetProcessingOutputPage with offset equal to the size of the output.
+			// As a result, want to receive an empty page.
+			name:           "offset at the end",
+			args:           args{key: pipelineId, offset: 7, limit: 2},
+			want:           "",
+			wantNextOffset: 7,
+			wantTotalSize:  7,
+		},
+		{
+			// Test case with calling GetProcessingOutputPage with the page which ends inside the multibyte character.
+			// As a result, want to receive the page without this character.
+			name:           "page ends inside character",
+			args:           args{key: pipelineId, offset: 0, limit: 4},
+			want:           "ab",
+			wantNextOffset: 2,
+			wantTotalSize:  7,
+		},
+		{
+			// Test case with calling GetProcessingOutputPage with limit less than the size of the character.
+			// As a result, want to receive the whole character.
+			name:           "limit less than character",
+			args:           args{key: pipelineId, offset: 2, limit: 1},
+			want:           "€",
+			wantNextOffset: 5,
+			wantTotalSize:  7,
+		},
+		{
+			// Test case with calling GetProcessingOutputPage with limit greater than the rest of the output.
+			// As a result, want to receive the rest of the output.
+			name:           "limit greater than rest",
+			args:           args{key: pipelineId, offset: 5, limit: 10},
+			want:           "cd",
+			wantNextOffset: 7,
+			wantTotalSize:  7,
+		},
+		{
+			// Test case with calling GetProcessingOutputPage without limit.
+			// As a result, want to receive the whole output.
+			name:           "without limit",
+			args:           args{key: pipelineId, offset: 0, limit: 0},
+			want:           "ab€cd",
+			wantNextOffset: 7,
+			wantTotalSize:  7,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotNextOffset, gotTotalSize, err := GetProcessingOutputPage(context.Background(), cacheService, tt.args.key, cache.RunOutput, tt.args.offset, tt.args.limit, "")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProcessingOutputPage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got != tt.want || gotNextOffset != tt.wantNextOffset || gotTotalSize != tt.wantTotalSize {
+				t.Errorf("GetProcessingOutputPage() got = (%q, %d, %d), want (%q, %d, %d)", got, gotNextOffset, gotTotalSize, tt.want, tt.wantNextOffset, tt.wantTotalSize)
+			}
+		})
+	}
+}
+
 func TestGetProcessingStatus(t *testing.T) {
 	defer goleak.VerifyNone(t, opt)
 	pipelineId := uuid.New()
@@ -587,6 +698,87 @@ func Test_getRunOrTestCmd(t *testing.T) {
 	}
 }
 
+func Test_processRunResult(t *testing.T) {
+	successCmd := exec.Command("true")
+	_ = successCmd.Run()
+	errorCmd := exec.Command("false")
+	_ = errorCmd.Run()
+
+	tests := []struct {
+		name          string
+		state         *os.ProcessState
+		limitExceeded   string
+		outputTruncated bool
+		wantExitCode    int
+	}{
+		{
+			// Run process which finishes successfully.
+			// As a result, want to receive 0 exit code.
+			name:         "successful process",
+			state:        successCmd.ProcessState,
+			wantExitCode: 0,
+		},
+		{
+			// Run process which finishes with error.
+			// As a result, want to receive exit code of the process.
+			name:         "failed process",
+			state:        errorCmd.ProcessState,
+			wantExitCode: 1,
+		},
+		{
+			// Run process which exceeded the memory limit.
+			// As a result, want to receive the exceeded limit in the result.
+			name:          "process exceeded memory limit",
+			state:         errorCmd.ProcessState,
+			limitExceeded: cache.MemoryLimit,
+			wantExitCode:  1,
+		},
+		{
+			// Run process whose output exceeded the output limits.
+			// As a result, want the result to show that the output is truncated.
+			name:            "process with truncated output",
+			state:           successCmd.ProcessState,
+			outputTruncated: true,
+			wantExitCode:    0,
+		},
+		{
+			// Process which wasn't started.
+			// As a result, want to receive -1 exit code.
+			name:         "not started process",
+			state:        nil,
+			wantExitCode: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			if err := processRunResult(context.Background(), pipelineId, cacheService, tt.state, time.Second, tt.limitExceeded, tt.outputTruncated); err != nil {
+				t.Fatalf("processRunResult() error = %v", err)
+			}
+			value, err := cacheService.GetValue(context.Background(), pipelineId, cache.RunResult)
+			if err != nil {
+				t.Fatalf("GetValue() error = %v", err)
+			}
+			result, ok := value.(cache.ExecutionResult)
+			if !ok {
+				t.Fatalf("GetValue() got = %v, want cache.ExecutionResult", value)
+			}
+			if result.ExitCode != tt.wantExitCode || result.Duration != time.Second {
+				t.Errorf("processRunResult() got = %+v, want exit code %d and duration %s", result, tt.wantExitCode, time.Second)
+			}
+			if tt.state != nil && result.PeakMemory != peakMemory(tt.state) {
+				t.Errorf("processRunResult() peak memory = %d, want %d", result.PeakMemory, peakMemory(tt.state))
+			}
+			if result.LimitExceeded != tt.limitExceeded {
+				t.Errorf("processRunResult() limit exceeded = %s, want %s", result.LimitExceeded, tt.limitExceeded)
+			}
+			if result.OutputTruncated != tt.outputTruncated {
+				t.Errorf("processRunResult() output truncated = %v, want %v", result.OutputTruncated, tt.outputTruncated)
+			}
+		})
+	}
+}
+
 func setupBenchmarks(sdk pb.Sdk) {
 	err := os.MkdirAll(configFolder, fs.ModePerm)
 	if err != nil {
@@ -665,7 +857,7 @@ func Benchmark_ProcessJava(b *testing.B) {
 		}
 		b.StartTimer()
 
-		Process(ctx, cacheService, lc, pipelineId, appEnv, sdkEnv, "")
+		Process(ctx, cacheService, lc, pipelineId, appEnv, sdkEnv, "", 0, nil, nil)
 	}
 }
 
@@ -695,7 +887,7 @@ func Benchmark_ProcessPython(b *testing.B) {
 		}
 		b.StartTimer()
 
-		Process(ctx, cacheService, lc, pipelineId, appEnv, sdkEnv, "")
+		Process(ctx, cacheService, lc, pipelineId, appEnv, sdkEnv, "", 0, nil, nil)
 	}
 }
 
@@ -725,7 +917,7 @@ func Benchmark_ProcessGo(b *testing.B) {
 		}
 		b.StartTimer()
 
-		Process(ctx, cacheService, lc, pipelineId, appEnv, sdkEnv, "")
+		Process(ctx, cacheService, lc, pipelineId, appEnv, sdkEnv, "", 0, nil, nil)
 	}
 }
 
@@ -812,7 +1004,7 @@ func Test_validateStep(t *testing.T) {
 				validationResults:    &sync.Map{},
 				cancelChannel:        make(chan bool, 1),
 			},
-			want: 3,
+			want: 4,
 			code: "class HelloWorld {\n    public static void main(String[] args) {\n        System.out.println(\"Hello world!\");\n    }\n}",
 		},
 	}
@@ -889,6 +1081,63 @@ func Test_prepareStep(t *testing.T) {
 	}
 }
 
+func Test_validateStepDisallowedApis(t *testing.T) {
+	executorConfig := environment.NewExecutorConfig("", "python3", "", []string{}, []string{}, []string{})
+	executorConfig.DisallowedApis = []string{"os", "subprocess"}
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+	ctx := context.Background()
+
+	tests := []struct {
+		name                 string
+		code                 string
+		wantStatus           pb.Status
+		wantValidationOutput string
+	}{
+		{
+			// Test case with the code which mentions the disallowed module only in the comment.
+			// As a result, want to receive the preparing status.
+			name:       "allowed code",
+			code:       "# import os\nprint(\"os.system\")\n",
+			wantStatus: pb.Status_STATUS_PREPARING,
+		},
+		{
+			// Test case with the code which uses the disallowed module.
+			// As a result, want to receive the validation error status and the description of the disallowed API.
+			name:                 "disallowed code",
+			code:                 "import subprocess\n\nsubprocess.run([\"ls\"])\n",
+			wantStatus:           pb.Status_STATUS_VALIDATION_ERROR,
+			wantValidationOutput: "the code uses the disallowed API subprocess at line 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			defer DeleteFolders(pipelineId, lc)
+			if err := lc.CreateSourceCodeFile(tt.code); err != nil {
+				t.Fatalf("error during create source file: %s", err.Error())
+			}
+			executor := validateStep(ctx, cacheService, &lc.Paths, pipelineId, sdkEnv, ctx, &sync.Map{}, make(chan bool, 1))
+			if (executor == nil) != (tt.wantStatus == pb.Status_STATUS_VALIDATION_ERROR) {
+				t.Errorf("validateStep() executor = %v, want nil only for the validation error", executor)
+			}
+			status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status)
+			if status != tt.wantStatus {
+				t.Errorf("validateStep() status = %v, want %v", status, tt.wantStatus)
+			}
+			if tt.wantValidationOutput != "" {
+				output, _ := cacheService.GetValue(ctx, pipelineId, cache.ValidationOutput)
+				if output, ok := output.(string); !ok || !strings.Contains(output, tt.wantValidationOutput) {
+					t.Errorf("validateStep() validation output = %v, want to contain %q", output, tt.wantValidationOutput)
+				}
+			}
+		})
+	}
+}
+
 func Test_compileStep(t *testing.T) {
 	appEnvs, err := environment.GetApplicationEnvsFromOsEnvs()
 	if err != nil {
@@ -936,7 +1185,7 @@ func Test_compileStep(t *testing.T) {
 				t.Fatalf("error during prepare folders: %s", err.Error())
 			}
 			_ = lc.CreateSourceCodeFile(tt.code)
-			if got := compileStep(tt.args.ctx, tt.args.cacheService, &lc.Paths, tt.args.pipelineId, tt.args.sdkEnv, tt.args.isUnitTest, tt.args.pipelineLifeCycleCtx, tt.args.cancelChannel); got == nil {
+			if got := compileStep(tt.args.ctx, tt.args.cacheService, &lc.Paths, tt.args.pipelineId, tt.args.sdkEnv, tt.args.isUnitTest, tt.args.pipelineLifeCycleCtx, tt.args.cancelChannel, nil); got == nil {
 				t.Errorf("compileStep: got nil instead of compiler executor")
 			}
 		})
@@ -996,11 +1245,1604 @@ func Test_runStep(t *testing.T) {
 	}
 }
 
-func syncMapLen(syncMap *sync.Map) int {
-	length := 0
-	syncMap.Range(func(_, _ interface{}) bool {
-		length++
-		return true
-	})
-	return length
+func Test_runStepAnsiCodes(t *testing.T) {
+	ctx := context.Background()
+	code := "import sys\nprint('\\x1b[1;32mINFO\\x1b[0m: done')\nsys.exit('\\x1b[31mERROR\\x1b[0m: failed')\n"
+	tests := []struct {
+		name           string
+		stripAnsiCodes bool
+		wantRunOutput  string
+		wantRunError   string
+	}{
+		{
+			// Test case with running the code which writes the colored output when ANSI codes are preserved.
+			// As a result, want to receive the output with ANSI codes.
+			name:           "ANSI codes are preserved",
+			stripAnsiCodes: false,
+			wantRunOutput:  "\x1b[1;32mINFO\x1b[0m: done\n",
+			wantRunError:   "\x1b[31mERROR\x1b[0m: failed",
+		},
+		{
+			// Test case with running the code which writes the colored output when ANSI codes are stripped.
+			// As a result, want to receive the run output and the run error without ANSI codes.
+			name:           "ANSI codes are stripped",
+			stripAnsiCodes: true,
+			wantRunOutput:  "INFO: done\n",
+			wantRunError:   "output: ERROR: failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+			executorConfig.StripAnsiCodes = tt.stripAnsiCodes
+			sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+			pipelineId := uuid.New()
+			lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			defer DeleteFolders(pipelineId, lc)
+			if err := lc.CreateSourceCodeFile(code); err != nil {
+				t.Fatalf("error during create source file: %s", err.Error())
+			}
+
+			runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", ctx, make(chan bool, 1))
+
+			if runOutput, _ := cacheService.GetValue(ctx, pipelineId, cache.RunOutput); runOutput != tt.wantRunOutput {
+				t.Errorf("run output = %q, want %q", runOutput, tt.wantRunOutput)
+			}
+			if runError, _ := cacheService.GetValue(ctx, pipelineId, cache.RunError); !strings.Contains(fmt.Sprint(runError), tt.wantRunError) {
+				t.Errorf("run error = %q, want to contain %q", runError, tt.wantRunError)
+			}
+		})
+	}
+}
+
+func Test_runStepStdin(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name          string
+		code          string
+		stdin         string
+		wantRunOutput string
+	}{
+		{
+			// Test case with running the code which echoes the standard input when the input is provided.
+			// As a result, want to receive the input in the run output.
+			name:          "code echoes the input",
+			code:          "import sys\nfor line in sys.stdin:\n    print('echo: ' + line, end='')\n",
+			stdin:         "line1\nline2\n",
+			wantRunOutput: "echo: line1\necho: line2\n",
+		},
+		{
+			// Test case with running the code which waits for the standard input when the input isn't provided.
+			// As a result, want the standard input to be closed, so the code finishes instead of hanging until the run timeout.
+			name:          "code reads the input which isn't provided",
+			code:          "import sys\ndata = sys.stdin.read()\nprint('read %d bytes' % len(data))\n",
+			stdin:         "",
+			wantRunOutput: "read 0 bytes\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+			sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 10*time.Second, environment.ResourceLimits{})
+			pipelineId := uuid.New()
+			lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			defer DeleteFolders(pipelineId, lc)
+			if err := lc.CreateSourceCodeFile(tt.code); err != nil {
+				t.Fatalf("error during create source file: %s", err.Error())
+			}
+			if err := lc.CreateStdinFile(tt.stdin); err != nil {
+				t.Fatalf("error during create input file: %s", err.Error())
+			}
+
+			runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", ctx, make(chan bool, 1))
+
+			if status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status); status != pb.Status_STATUS_FINISHED {
+				t.Errorf("runStep() status = %v, want %v", status, pb.Status_STATUS_FINISHED)
+			}
+			if runOutput, _ := cacheService.GetValue(ctx, pipelineId, cache.RunOutput); runOutput != tt.wantRunOutput {
+				t.Errorf("run output = %q, want %q", runOutput, tt.wantRunOutput)
+			}
+		})
+	}
+}
+
+func Test_runStepEnvVars(t *testing.T) {
+	ctx := context.Background()
+	// secrets of the server mustn't be visible to the code
+	secrets := map[string]string{"CACHE_PASSWORD": "MOCK_PASSWORD", "RATE_LIMIT_API_KEYS": "MOCK_KEY", "GOOGLE_APPLICATION_CREDENTIALS": "/key.json"}
+	for name, value := range secrets {
+		_ = os.Setenv(name, value)
+		defer os.Unsetenv(name)
+	}
+	code := "import os\nfor name in ['CONFIG_VAR', 'REQUEST_VAR', 'SHARED_VAR', 'HOME', 'LD_PRELOAD', 'CACHE_PASSWORD', 'RATE_LIMIT_API_KEYS', 'GOOGLE_APPLICATION_CREDENTIALS']:\n    print(name + '=' + os.environ.get(name, ''))\n"
+	tests := []struct {
+		name          string
+		configEnvVars map[string]string
+		envVars       map[string]string
+		wantRunOutput string
+	}{
+		{
+			// Test case with running the code with environment variables of the SDK config and the request.
+			// As a result, want the code to receive variables, variables of the request override ones of the config.
+			name:          "injected variables",
+			configEnvVars: map[string]string{"CONFIG_VAR": "config", "SHARED_VAR": "config"},
+			envVars:       map[string]string{"REQUEST_VAR": "request", "SHARED_VAR": "request"},
+			wantRunOutput: "CONFIG_VAR=config\nREQUEST_VAR=request\nSHARED_VAR=request\nHOME=" + os.Getenv("HOME") + "\nLD_PRELOAD=\nCACHE_PASSWORD=\nRATE_LIMIT_API_KEYS=\nGOOGLE_APPLICATION_CREDENTIALS=\n",
+		},
+		{
+			// Test case with running the code with denied environment variables.
+			// As a result, want the code to receive host values of denied variables which are passed to the code.
+			name:          "denied variables",
+			configEnvVars: map[string]string{"HOME": "/config"},
+			envVars:       map[string]string{"HOME": "/request", "LD_PRELOAD": "/request/lib.so"},
+			wantRunOutput: "CONFIG_VAR=\nREQUEST_VAR=\nSHARED_VAR=\nHOME=" + os.Getenv("HOME") + "\nLD_PRELOAD=\nCACHE_PASSWORD=\nRATE_LIMIT_API_KEYS=\nGOOGLE_APPLICATION_CREDENTIALS=\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+			executorConfig.Environment = tt.configEnvVars
+			sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, time.Minute, environment.ResourceLimits{})
+			pipelineId := uuid.New()
+			lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			defer DeleteFolders(pipelineId, lc)
+			if err := lc.CreateSourceCodeFile(code); err != nil {
+				t.Fatalf("error during create source file: %s", err.Error())
+			}
+			if err := env_vars.Write(lc.Paths.AbsoluteBaseFolderPath, tt.envVars); err != nil {
+				t.Fatalf("error during write environment variables: %s", err.Error())
+			}
+
+			runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", ctx, make(chan bool, 1))
+
+			if status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status); status != pb.Status_STATUS_FINISHED {
+				t.Errorf("runStep() status = %v, want %v", status, pb.Status_STATUS_FINISHED)
+			}
+			if runOutput, _ := cacheService.GetValue(ctx, pipelineId, cache.RunOutput); runOutput != tt.wantRunOutput {
+				t.Errorf("run output = %q, want %q", runOutput, tt.wantRunOutput)
+			}
+		})
+	}
+}
+
+func Test_runStepExecutionCommand(t *testing.T) {
+	ctx := context.Background()
+	executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, time.Minute, environment.ResourceLimits{})
+	pipelineId := uuid.New()
+	lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+	if err := lc.CreateFolders(); err != nil {
+		t.Fatalf("error during prepare folders: %s", err.Error())
+	}
+	defer DeleteFolders(pipelineId, lc)
+	if err := lc.CreateSourceCodeFile("print('MOCK_OUTPUT')\n"); err != nil {
+		t.Fatalf("error during create source file: %s", err.Error())
+	}
+	// the command line of the compile step is kept before the run step
+	_ = cacheService.SetValue(ctx, pipelineId, cache.ExecutionCommand, "MOCK_COMPILE_CMD")
+
+	runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "--output out.txt --password MOCK_PASSWORD", ctx, make(chan bool, 1))
+
+	value, err := cacheService.GetValue(ctx, pipelineId, cache.ExecutionCommand)
+	if err != nil {
+		t.Fatalf("error during get execution command: %s", err.Error())
+	}
+	command, _ := value.(string)
+	wantPrefix := "MOCK_COMPILE_CMD\npython3 "
+	wantSuffix := " --output out.txt --password <redacted>"
+	if !strings.HasPrefix(command, wantPrefix) || !strings.HasSuffix(command, wantSuffix) {
+		t.Errorf("runStep() execution command = %q, want %q...%q", command, wantPrefix, wantSuffix)
+	}
+	if strings.Contains(command, "MOCK_PASSWORD") {
+		t.Errorf("runStep() execution command = %q, want the password to be redacted", command)
+	}
+}
+
+// timingsCheckCache keeps timings of the pipeline which are in cache when its terminal status is set
+type timingsCheckCache struct {
+	cache.Cache
+	timingsBeforeStatus interface{}
+}
+
+func (c *timingsCheckCache) check(ctx context.Context, pipelineId uuid.UUID, value interface{}) {
+	if status, ok := value.(pb.Status); ok && cache.IsTerminalStatus(status) {
+		c.timingsBeforeStatus, _ = c.Cache.GetValue(ctx, pipelineId, cache.Timings)
+	}
+}
+
+func (c *timingsCheckCache) SetValue(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, value interface{}) error {
+	if subKey == cache.Status {
+		c.check(ctx, pipelineId, value)
+	}
+	return c.Cache.SetValue(ctx, pipelineId, subKey, value)
+}
+
+func (c *timingsCheckCache) SetValues(ctx context.Context, pipelineId uuid.UUID, values map[cache.SubKey]interface{}) error {
+	if value, ok := values[cache.Status]; ok {
+		c.check(ctx, pipelineId, value)
+	}
+	return c.Cache.SetValues(ctx, pipelineId, values)
+}
+
+func Test_ProcessTimings(t *testing.T) {
+	ctx := context.Background()
+	appEnv := environment.NewApplicationEnvs(os.Getenv("APP_WORK_DIR"), "", "", pipelinesFolder, environment.NewCacheEnvs("local", "", time.Minute, 0, 0, 0, "", false), time.Minute, 0, environment.NewQueueEnvs(0, 0), environment.NewSnippetEnvs(0, 0), environment.NewRateLimitEnvs(0, map[string]int{}, nil), environment.NewWorkingDirEnvs(0, nil), environment.NewCodeEnvs(0, 0))
+	executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+	// The code doesn't write the graph, so the graph step only runs the code and its errors are logged
+	executorConfig.GraphArgs = []string{"--graph"}
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, time.Minute, environment.ResourceLimits{})
+	pipelineId := uuid.New()
+	lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+	if err := lc.CreateFolders(); err != nil {
+		t.Fatalf("error during prepare folders: %s", err.Error())
+	}
+	if err := lc.CreateSourceCodeFile("import time\n\ntime.sleep(0.3)\nprint('MOCK_OUTPUT')\n"); err != nil {
+		t.Fatalf("error during create source file: %s", err.Error())
+	}
+	if err := utils.SetToCache(ctx, cacheService, pipelineId, cache.Canceled, false); err != nil {
+		t.Fatal("error during set cancel flag to cache")
+	}
+	checkCache := &timingsCheckCache{Cache: cacheService}
+
+	startTime := time.Now()
+	Process(ctx, checkCache, lc, pipelineId, appEnv, sdkEnv, "", 0, nil, job_queue.New(1, time.Minute))
+	total := time.Since(startTime)
+
+	status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status)
+	if status != pb.Status_STATUS_FINISHED {
+		t.Fatalf("Process() status = %v, want %v", status, pb.Status_STATUS_FINISHED)
+	}
+	value, err := cacheService.GetValue(ctx, pipelineId, cache.Timings)
+	if err != nil {
+		t.Fatalf("error during get timings: %s", err.Error())
+	}
+	timings, ok := value.(cache.StageTimings)
+	if !ok {
+		t.Fatalf("Process() timings = %v, want cache.StageTimings", value)
+	}
+	if !reflect.DeepEqual(checkCache.timingsBeforeStatus, timings) {
+		t.Errorf("Process() timings when the terminal status is set = %v, want %v", checkCache.timingsBeforeStatus, timings)
+	}
+	var sum time.Duration
+	for _, stage := range []string{cache.ValidateStage, cache.PrepareStage, cache.WaitStage, cache.CompileStage, cache.GraphStage, cache.RunStage} {
+		duration, ok := timings[stage]
+		if !ok || duration <= 0 {
+			t.Errorf("Process() timing of %s = %v, want a positive duration", stage, duration)
+		}
+		sum += duration
+	}
+	for _, stage := range []string{cache.GraphStage, cache.RunStage} {
+		if timings[stage] < 300*time.Millisecond {
+			t.Errorf("Process() timing of %s = %v, want at least the sleep of the code", stage, timings[stage])
+		}
+	}
+	if sum > total {
+		t.Errorf("Process() sum of timings = %v, want at most the duration of the processing %v", sum, total)
+	}
+}
+
+func Test_runStepRunner(t *testing.T) {
+	ctx := context.Background()
+	flinkRunner := environment.RunnerConfig{
+		RunArgs:          []string{"--runner=FlinkRunner", "--flink_master=[local]"},
+		SubmissionErrors: []string{`^org\.apache\.flink\.runtime\.client\.JobSubmissionException`},
+	}
+	printOptions := "import sys\nprint(' '.join(arg for arg in sys.argv[1:] if arg.startswith('--')))\n"
+	tests := []struct {
+		name               string
+		code               string
+		runner             string
+		wantStatus         pb.Status
+		wantRunOutput      string
+		wantRunErrorPrefix string
+	}{
+		{
+			// Test case with running the code by the flink runner.
+			// As a result, want the code to be run with pipeline options of the runner and the output of the job to be kept.
+			name:          "flink runner",
+			code:          printOptions,
+			runner:        "flink",
+			wantStatus:    pb.Status_STATUS_FINISHED,
+			wantRunOutput: "--option=value --runner=FlinkRunner --flink_master=[local]\n",
+		},
+		{
+			// Test case with running the code without the runner.
+			// As a result, want the code to be run by the direct runner without pipeline options of other runners.
+			name:          "direct runner",
+			code:          printOptions,
+			runner:        "",
+			wantStatus:    pb.Status_STATUS_FINISHED,
+			wantRunOutput: "--option=value\n",
+		},
+		{
+			// Test case with the pipeline which couldn't be submitted to the flink runner.
+			// As a result, want the run error to start with the message about the failed submission.
+			name:               "submission failure",
+			code:               "import sys\nsys.stderr.write('org.apache.flink.runtime.client.JobSubmissionException: Failed to submit the job\\n')\nsys.exit(1)\n",
+			runner:             "flink",
+			wantStatus:         pb.Status_STATUS_RUN_ERROR,
+			wantRunErrorPrefix: "Run step couldn't submit the pipeline to the flink runner\nerror: exit status 1\n",
+		},
+		{
+			// Test case with the code which fails by itself when it is run by the flink runner.
+			// As a result, want the run error without the message about the failed submission.
+			name:               "error of the code",
+			code:               "print(1 / 0)\n",
+			runner:             "flink",
+			wantStatus:         pb.Status_STATUS_RUN_ERROR,
+			wantRunErrorPrefix: "error: exit status 1\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+			executorConfig.Runners = map[string]environment.RunnerConfig{"flink": flinkRunner}
+			sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, time.Minute, environment.ResourceLimits{})
+			pipelineId := uuid.New()
+			lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			defer DeleteFolders(pipelineId, lc)
+			if err := lc.CreateSourceCodeFile(tt.code); err != nil {
+				t.Fatalf("error during create source file: %s", err.Error())
+			}
+			if err := lc.CreateRunnerFile(tt.runner); err != nil {
+				t.Fatalf("error during create runner file: %s", err.Error())
+			}
+
+			runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "--option=value", ctx, make(chan bool, 1))
+
+			if status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status); status != tt.wantStatus {
+				t.Errorf("runStep() status = %v, want %v", status, tt.wantStatus)
+			}
+			if tt.wantStatus == pb.Status_STATUS_FINISHED {
+				if runOutput, _ := cacheService.GetValue(ctx, pipelineId, cache.RunOutput); runOutput != tt.wantRunOutput {
+					t.Errorf("run output = %q, want %q", runOutput, tt.wantRunOutput)
+				}
+				return
+			}
+			runError, _ := cacheService.GetValue(ctx, pipelineId, cache.RunError)
+			if runErrorString, ok := runError.(string); !ok || !strings.HasPrefix(runErrorString, tt.wantRunErrorPrefix) {
+				t.Errorf("run error = %q, want prefix %q", runError, tt.wantRunErrorPrefix)
+			}
+		})
+	}
+}
+
+func Test_runStepOutputLimits(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name                string
+		code                string
+		limits              environment.ResourceLimits
+		wantStatus          pb.Status
+		wantRunOutput       string
+		wantRunErrorPrefix  string
+		wantLimitExceeded   string
+		wantOutputTruncated bool
+	}{
+		{
+			// Test case with running the code which prints more lines than the line cap.
+			// As a result, want to receive the first lines with the marker, the code is finished.
+			name:                "line cap",
+			code:                "for i in range(10):\n    print(i)\n",
+			limits:              environment.ResourceLimits{OutputLines: 3},
+			wantStatus:          pb.Status_STATUS_FINISHED,
+			wantRunOutput:       "0\n1\n2\n" + streaming.OutputTruncatedMarker + "\n",
+			wantOutputTruncated: true,
+		},
+		{
+			// Test case with running the code which prints more bytes than the byte cap.
+			// As a result, want to receive the first bytes with the marker, the code is finished.
+			name:                "byte cap",
+			code:                "print('a' * 30)\n",
+			limits:              environment.ResourceLimits{OutputBytes: 10},
+			wantStatus:          pb.Status_STATUS_FINISHED,
+			wantRunOutput:       "aaaaaaaaaa\n" + streaming.OutputTruncatedMarker + "\n",
+			wantOutputTruncated: true,
+		},
+		{
+			// Test case with running the code which prints the output until it is stopped when the process is killed by the byte cap.
+			// As a result, want the code to be stopped with the run error about the output limit.
+			name:                "byte cap kills the process",
+			code:                "while True:\n    print('a' * 1024)\n",
+			limits:              environment.ResourceLimits{OutputBytes: 1024 * 1024, KillOnOutputLimit: true},
+			wantStatus:          pb.Status_STATUS_RUN_ERROR,
+			wantRunErrorPrefix:  "Run step exceeded the output limit: 1 MB",
+			wantLimitExceeded:   cache.OutputLimit,
+			wantOutputTruncated: true,
+		},
+		{
+			// Test case with running the code which prints the output under caps.
+			// As a result, want to receive the whole output.
+			name:          "output under caps",
+			code:          "print('done')\n",
+			limits:        environment.ResourceLimits{OutputLines: 3, OutputBytes: 1024 * 1024},
+			wantStatus:    pb.Status_STATUS_FINISHED,
+			wantRunOutput: "done\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+			sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, time.Minute, tt.limits)
+			pipelineId := uuid.New()
+			lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			defer DeleteFolders(pipelineId, lc)
+			if err := lc.CreateSourceCodeFile(tt.code); err != nil {
+				t.Fatalf("error during create source file: %s", err.Error())
+			}
+
+			runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", ctx, make(chan bool, 1))
+
+			if status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status); status != tt.wantStatus {
+				t.Errorf("runStep() status = %v, want %v", status, tt.wantStatus)
+			}
+			if tt.wantRunOutput != "" {
+				if runOutput, _ := cacheService.GetValue(ctx, pipelineId, cache.RunOutput); runOutput != tt.wantRunOutput {
+					t.Errorf("run output = %q, want %q", runOutput, tt.wantRunOutput)
+				}
+			}
+			if tt.wantRunErrorPrefix != "" {
+				if runError, _ := cacheService.GetValue(ctx, pipelineId, cache.RunError); !strings.HasPrefix(fmt.Sprint(runError), tt.wantRunErrorPrefix) {
+					t.Errorf("run error = %q, want prefix %q", runError, tt.wantRunErrorPrefix)
+				}
+			}
+			runResult, _ := cacheService.GetValue(ctx, pipelineId, cache.RunResult)
+			result, ok := runResult.(cache.ExecutionResult)
+			if !ok || result.LimitExceeded != tt.wantLimitExceeded || result.OutputTruncated != tt.wantOutputTruncated {
+				t.Errorf("run result = %+v, want limit exceeded %q and output truncated %v", runResult, tt.wantLimitExceeded, tt.wantOutputTruncated)
+			}
+		})
+	}
+}
+
+func Test_runStepTimeout(t *testing.T) {
+	executorConfig := environment.NewExecutorConfig("", "python3", "", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, time.Second, environment.ResourceLimits{})
+	pipelineId := uuid.New()
+	code := "import time\n\nif __name__ == \"__main__\":\n    time.sleep(30)\n"
+
+	lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+	err := lc.CreateFolders()
+	if err != nil {
+		t.Fatalf("error during prepare folders: %s", err.Error())
+	}
+	defer DeleteFolders(pipelineId, lc)
+	_ = lc.CreateSourceCodeFile(code)
+
+	ctx := context.Background()
+	pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, time.Minute)
+	defer finishCtxFunc()
+
+	start := time.Now()
+	runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", pipelineLifeCycleCtx, make(chan bool, 1))
+	if duration := time.Since(start); duration > 10*time.Second {
+		t.Errorf("runStep() finished after %s, want it to be terminated by the run timeout", duration)
+	}
+
+	status, err := cacheService.GetValue(ctx, pipelineId, cache.Status)
+	if err != nil {
+		t.Fatalf("error during get status: %s", err.Error())
+	}
+	if status != pb.Status_STATUS_RUN_TIMEOUT {
+		t.Errorf("runStep() status = %v, want %v", status, pb.Status_STATUS_RUN_TIMEOUT)
+	}
+	runError, err := cacheService.GetValue(ctx, pipelineId, cache.RunError)
+	if err != nil {
+		t.Fatalf("error during get run error: %s", err.Error())
+	}
+	wantRunError := "Run step of SDK_PYTHON exceeded the timeout: 1s"
+	if runError != wantRunError {
+		t.Errorf("runStep() run error = %v, want %v", runError, wantRunError)
+	}
+}
+
+func Test_runStepDeadline(t *testing.T) {
+	executorConfig := environment.NewExecutorConfig("", "python3", "", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, time.Minute, environment.ResourceLimits{})
+	pipelineId := uuid.New()
+	code := "import time\n\nif __name__ == \"__main__\":\n    time.sleep(30)\n"
+
+	lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+	err := lc.CreateFolders()
+	if err != nil {
+		t.Fatalf("error during prepare folders: %s", err.Error())
+	}
+	defer DeleteFolders(pipelineId, lc)
+	_ = lc.CreateSourceCodeFile(code)
+
+	ctx := context.Background()
+	pipelineLifeCycleCtx, finishCtxFunc := pipelineContext(ctx, time.Minute, time.Second)
+	defer finishCtxFunc()
+
+	start := time.Now()
+	runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", pipelineLifeCycleCtx, make(chan bool, 1))
+	if duration := time.Since(start); duration > 10*time.Second {
+		t.Errorf("runStep() finished after %s, want it to be terminated by the deadline of the request", duration)
+	}
+
+	status, err := cacheService.GetValue(ctx, pipelineId, cache.Status)
+	if err != nil {
+		t.Fatalf("error during get status: %s", err.Error())
+	}
+	if status != pb.Status_STATUS_RUN_TIMEOUT {
+		t.Errorf("runStep() status = %v, want %v", status, pb.Status_STATUS_RUN_TIMEOUT)
+	}
+	runError, err := cacheService.GetValue(ctx, pipelineId, cache.RunError)
+	if err != nil {
+		t.Fatalf("error during get run error: %s", err.Error())
+	}
+	wantRunError := "Code processing exceeded the deadline of the request: 1s"
+	if runError != wantRunError {
+		t.Errorf("runStep() run error = %v, want %v", runError, wantRunError)
+	}
+}
+
+func Test_pipelineContext(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeout  time.Duration
+		deadline time.Duration
+		// want is the duration after which the context is done
+		want time.Duration
+		// wantDeadline is true if the context is done because of the deadline of the request
+		wantDeadline bool
+	}{
+		{
+			// Test case with the request without the deadline.
+			// As a result, want to receive the context which is done after the timeout.
+			name:         "without deadline",
+			timeout:      time.Minute,
+			deadline:     0,
+			want:         time.Minute,
+			wantDeadline: false,
+		},
+		{
+			// Test case with the deadline which is shorter than the timeout.
+			// As a result, want to receive the context which is done after the deadline.
+			name:         "deadline before timeout",
+			timeout:      time.Minute,
+			deadline:     time.Second,
+			want:         time.Second,
+			wantDeadline: true,
+		},
+		{
+			// Test case with the deadline which is longer than the timeout.
+			// As a result, want to receive the context which is done after the timeout, so it isn't extended.
+			name:         "deadline after timeout",
+			timeout:      time.Minute,
+			deadline:     time.Hour,
+			want:         time.Minute,
+			wantDeadline: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			ctx, cancel := pipelineContext(context.Background(), tt.timeout, tt.deadline)
+			defer cancel()
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				t.Fatalf("pipelineContext() has no deadline")
+			}
+			if got := deadline.Sub(start); got < tt.want || got > tt.want+time.Second {
+				t.Errorf("pipelineContext() is done after %s, want %s", got, tt.want)
+			}
+			if _, got := ctx.Value(requestDeadlineKey{}).(time.Duration); got != tt.wantDeadline {
+				t.Errorf("pipelineContext() keeps the deadline of the request = %v, want %v", got, tt.wantDeadline)
+			}
+		})
+	}
+}
+
+func Test_runStepMultiFile(t *testing.T) {
+	executorConfig := environment.NewExecutorConfig("", "python3", "", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+	pipelineId := uuid.New()
+	files := []fs_tool.SourceFile{
+		{Name: "main.py", Code: "from utils.helper import greet\n\nif __name__ == \"__main__\":\n    greet()\n", IsMain: true},
+		{Name: "utils/__init__.py", Code: ""},
+		{Name: "utils/helper.py", Code: "def greet():\n    print(\"Hello from submodule!\")\n"},
+	}
+
+	lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+	err := lc.CreateFolders()
+	if err != nil {
+		t.Fatalf("error during prepare folders: %s", err.Error())
+	}
+	defer DeleteFolders(pipelineId, lc)
+	if err = lc.CreateSourceCodeFiles(files); err != nil {
+		t.Fatalf("error during create source files: %s", err.Error())
+	}
+
+	ctx := context.Background()
+	pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, time.Minute)
+	defer finishCtxFunc()
+
+	if err = cacheService.SetValue(ctx, pipelineId, cache.RunOutput, ""); err != nil {
+		t.Fatalf("error during set run output: %s", err.Error())
+	}
+	runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", pipelineLifeCycleCtx, make(chan bool, 1))
+
+	status, err := cacheService.GetValue(ctx, pipelineId, cache.Status)
+	if err != nil {
+		t.Fatalf("error during get status: %s", err.Error())
+	}
+	if status != pb.Status_STATUS_FINISHED {
+		runError, _ := cacheService.GetValue(ctx, pipelineId, cache.RunError)
+		t.Fatalf("runStep() status = %v, want %v, run error: %v", status, pb.Status_STATUS_FINISHED, runError)
+	}
+	runOutput, err := cacheService.GetValue(ctx, pipelineId, cache.RunOutput)
+	if err != nil {
+		t.Fatalf("error during get run output: %s", err.Error())
+	}
+	if runOutput != "Hello from submodule!\n" {
+		t.Errorf("runStep() run output = %q, want %q", runOutput, "Hello from submodule!\n")
+	}
+}
+
+func Test_runStepStreamingOutput(t *testing.T) {
+	executorConfig := environment.NewExecutorConfig("", "python3", "", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+	pipelineId := uuid.New()
+	lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+	if err := lc.CreateFolders(); err != nil {
+		t.Fatalf("error during prepare folders: %s", err.Error())
+	}
+	defer DeleteFolders(pipelineId, lc)
+	// The code prints lines to the output and to the log file over time
+	code := fmt.Sprintf("import time\n\nif __name__ == \"__main__\":\n"+
+		"    for i in range(5):\n"+
+		"        print(\"line %%d\" %% i, flush=True)\n"+
+		"        with open(%q, \"a\") as logs:\n"+
+		"            logs.write(\"log %%d\\n\" %% i)\n"+
+		"        time.sleep(0.6)\n", lc.Paths.AbsoluteLogFilePath)
+	if err := lc.CreateSourceCodeFile(code); err != nil {
+		t.Fatalf("error during create source file: %s", err.Error())
+	}
+	wantOutput := "line 0\nline 1\nline 2\nline 3\nline 4\n"
+	wantLogs := "log 0\nlog 1\nlog 2\nlog 3\nlog 4\n"
+
+	ctx := context.Background()
+	pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, time.Minute)
+	defer finishCtxFunc()
+	if err := cacheService.SetValue(ctx, pipelineId, cache.RunOutput, ""); err != nil {
+		t.Fatalf("error during set run output: %s", err.Error())
+	}
+	if err := cacheService.SetValue(ctx, pipelineId, cache.Logs, ""); err != nil {
+		t.Fatalf("error during set logs: %s", err.Error())
+	}
+
+	finished := make(chan bool, 1)
+	go func() {
+		runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", pipelineLifeCycleCtx, make(chan bool, 1))
+		finished <- true
+	}()
+
+	var intermediateOutput, intermediateLogs bool
+	for running := true; running; {
+		select {
+		case <-finished:
+			running = false
+		case <-time.After(100 * time.Millisecond):
+			output, _ := cacheService.GetValue(ctx, pipelineId, cache.RunOutput)
+			logs, _ := cacheService.GetValue(ctx, pipelineId, cache.Logs)
+			if output, ok := output.(string); ok && output != "" && output != wantOutput {
+				if !strings.HasPrefix(wantOutput, output) {
+					t.Fatalf("runStep() intermediate run output = %q, want prefix of %q", output, wantOutput)
+				}
+				intermediateOutput = true
+			}
+			if logs, ok := logs.(string); ok && logs != "" && logs != wantLogs {
+				if !strings.HasPrefix(wantLogs, logs) {
+					t.Fatalf("runStep() intermediate logs = %q, want prefix of %q", logs, wantLogs)
+				}
+				intermediateLogs = true
+			}
+		}
+	}
+	if !intermediateOutput {
+		t.Errorf("runStep() run output isn't saved to the cache while the code is running")
+	}
+	if !intermediateLogs {
+		t.Errorf("runStep() logs aren't saved to the cache while the code is running")
+	}
+
+	status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status)
+	if status != pb.Status_STATUS_FINISHED {
+		t.Fatalf("runStep() status = %v, want %v", status, pb.Status_STATUS_FINISHED)
+	}
+	if output, _ := cacheService.GetValue(ctx, pipelineId, cache.RunOutput); output != wantOutput {
+		t.Errorf("runStep() run output = %q, want %q", output, wantOutput)
+	}
+	if logs, _ := cacheService.GetValue(ctx, pipelineId, cache.Logs); logs != wantLogs {
+		t.Errorf("runStep() logs = %q, want %q", logs, wantLogs)
+	}
+}
+
+func Test_compileAndRunStepGo(t *testing.T) {
+	executorConfig := environment.NewExecutorConfig("go", "", "go", []string{"build", "-mod=mod", "-o", "bin"}, []string{"--runner=direct"}, []string{"test", "-v"})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_GO, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		code              string
+		wantStatus        pb.Status
+		wantCompileOutput string
+		wantRunOutput     string
+	}{
+		{
+			// Test case with compiling and running the correct Go pipeline.
+			// As a result, want to receive the finished status and the output of the pipeline which is run with the direct runner.
+			name: "correct code",
+			code: "package main\n\nimport (\n\t\"flag\"\n\t\"fmt\"\n)\n\n" +
+				"var runner = flag.String(\"runner\", \"\", \"Pipeline runner.\")\n\n" +
+				"func main() {\n\tflag.Parse()\n\tfmt.Printf(\"Hello from the %s runner!\\n\", *runner)\n}\n",
+			wantStatus:    pb.Status_STATUS_FINISHED,
+			wantRunOutput: "Hello from the direct runner!\n",
+		},
+		{
+			// Test case with compiling the Go code which contains an error.
+			// As a result, want to receive the compile error status and the compiler output in the CompileOutput.
+			name:              "compile error",
+			code:              "package main\n\nfunc main() {\n\tundefinedFunction()\n}\n",
+			wantStatus:        pb.Status_STATUS_COMPILE_ERROR,
+			wantCompileOutput: "undefined: undefinedFunction",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_GO, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			defer DeleteFolders(pipelineId, lc)
+			goMod := filepath.Join(lc.Paths.AbsoluteBaseFolderPath, "go.mod")
+			if err := os.WriteFile(goMod, []byte("module executable_files\n\ngo 1.16\n"), fs.ModePerm); err != nil {
+				t.Fatalf("error during create go.mod: %s", err.Error())
+			}
+			if err := lc.CreateSourceCodeFile(tt.code); err != nil {
+				t.Fatalf("error during create source file: %s", err.Error())
+			}
+			if err := cacheService.SetValue(ctx, pipelineId, cache.RunOutput, ""); err != nil {
+				t.Fatalf("error during set run output: %s", err.Error())
+			}
+			pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, time.Minute)
+			defer finishCtxFunc()
+
+			if executor := compileStep(ctx, cacheService, &lc.Paths, pipelineId, sdkEnv, false, pipelineLifeCycleCtx, make(chan bool, 1), nil); executor != nil {
+				runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", pipelineLifeCycleCtx, make(chan bool, 1))
+			}
+
+			status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status)
+			compileOutput, _ := cacheService.GetValue(ctx, pipelineId, cache.CompileOutput)
+			if status != tt.wantStatus {
+				runError, _ := cacheService.GetValue(ctx, pipelineId, cache.RunError)
+				t.Fatalf("status = %v, want %v, compile output: %v, run error: %v", status, tt.wantStatus, compileOutput, runError)
+			}
+			if compileOutput, ok := compileOutput.(string); !ok || !strings.Contains(compileOutput, tt.wantCompileOutput) {
+				t.Errorf("compile output = %v, want to contain %q", compileOutput, tt.wantCompileOutput)
+			}
+			if tt.wantStatus == pb.Status_STATUS_FINISHED {
+				if runOutput, _ := cacheService.GetValue(ctx, pipelineId, cache.RunOutput); runOutput != tt.wantRunOutput {
+					t.Errorf("run output = %q, want %q", runOutput, tt.wantRunOutput)
+				}
+			}
+		})
+	}
+}
+
+func Test_compileStepReusesCompiledFiles(t *testing.T) {
+	code := "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"Hello from the cached binary!\")\n}\n"
+	wantRunOutput := "Hello from the cached binary!\n"
+	ctx := context.Background()
+	source := source_cache.New(pb.Sdk_SDK_GO, code, "", nil, "", nil, "", true, time.Minute)
+
+	tests := []struct {
+		name       string
+		compileCmd string
+	}{
+		{
+			// Test case with compiling the code for the first time.
+			// As a result, want the code to be compiled and the compiled files to be kept in cache.
+			name:       "first submission",
+			compileCmd: "go",
+		},
+		{
+			// Test case with compiling the identical code using the compiler which doesn't exist.
+			// As a result, want the compiled files of the first submission to be reused, so the code is run successfully.
+			name:       "identical submission",
+			compileCmd: "not_existing_compiler",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executorConfig := environment.NewExecutorConfig(tt.compileCmd, "", "go", []string{"build", "-mod=mod", "-o", "bin"}, []string{}, []string{"test", "-v"})
+			sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_GO, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+			pipelineId := uuid.New()
+			lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_GO, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			defer DeleteFolders(pipelineId, lc)
+			goMod := filepath.Join(lc.Paths.AbsoluteBaseFolderPath, "go.mod")
+			if err := os.WriteFile(goMod, []byte("module executable_files\n\ngo 1.16\n"), fs.ModePerm); err != nil {
+				t.Fatalf("error during create go.mod: %s", err.Error())
+			}
+			if err := lc.CreateSourceCodeFile(code); err != nil {
+				t.Fatalf("error during create source file: %s", err.Error())
+			}
+			pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, time.Minute)
+			defer finishCtxFunc()
+
+			if executor := compileStep(ctx, cacheService, &lc.Paths, pipelineId, sdkEnv, false, pipelineLifeCycleCtx, make(chan bool, 1), source); executor == nil {
+				compileOutput, _ := cacheService.GetValue(ctx, pipelineId, cache.CompileOutput)
+				t.Fatalf("compileStep() = nil, compile output: %v", compileOutput)
+			}
+			runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", pipelineLifeCycleCtx, make(chan bool, 1))
+
+			status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status)
+			if status != pb.Status_STATUS_FINISHED {
+				runError, _ := cacheService.GetValue(ctx, pipelineId, cache.RunError)
+				t.Fatalf("status = %v, want %v, run error: %v", status, pb.Status_STATUS_FINISHED, runError)
+			}
+			if runOutput, _ := cacheService.GetValue(ctx, pipelineId, cache.RunOutput); runOutput != wantRunOutput {
+				t.Errorf("run output = %q, want %q", runOutput, wantRunOutput)
+			}
+		})
+	}
+}
+
+func Test_compileStepRetries(t *testing.T) {
+	// every attempt of the compile command is appended to attempts.txt in the base folder of the code
+	countAttempt := "echo attempt >> attempts.txt; "
+	transientErrors := []string{"^error: error while writing "}
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		script            string
+		wantStatus        pb.Status
+		wantAttempts      int
+		wantCompileOutput string
+	}{
+		{
+			// Test case with the toolchain which fails by itself on the first attempt.
+			// As a result, want the compile step to be retried and the code to be compiled on the second attempt.
+			name:              "transient toolchain error",
+			script:            countAttempt + "if [ $(wc -l < attempts.txt) -lt 2 ]; then echo 'error: error while writing main' >&2; exit 1; fi; echo compiled",
+			wantStatus:        pb.Status_STATUS_EXECUTING,
+			wantAttempts:      2,
+			wantCompileOutput: "compiled\n",
+		},
+		{
+			// Test case with the syntax error of the code which mentions the message of the transient error.
+			// As a result, want the compile step not to be retried and the compile error to be returned.
+			name:              "syntax error of the code",
+			script:            countAttempt + "echo './main.go:3:1: syntax error: error while writing ' >&2; exit 1",
+			wantStatus:        pb.Status_STATUS_COMPILE_ERROR,
+			wantAttempts:      1,
+			wantCompileOutput: "error: exit status 1\noutput: ./main.go:3:1: syntax error: error while writing \n",
+		},
+		{
+			// Test case with the toolchain which fails by itself on every attempt.
+			// As a result, want the compile step to be retried the configured number of times and the compile error to be returned.
+			name:              "retries are exhausted",
+			script:            countAttempt + "echo 'error: error while writing main' >&2; exit 1",
+			wantStatus:        pb.Status_STATUS_COMPILE_ERROR,
+			wantAttempts:      3,
+			wantCompileOutput: "error: exit status 1\noutput: error: error while writing main\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executorConfig := environment.NewExecutorConfig("sh", "", "", []string{"-c", tt.script, "sh"}, []string{}, []string{})
+			executorConfig.CompileRetries = 2
+			executorConfig.TransientCompileErrors = transientErrors
+			sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_GO, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+			pipelineId := uuid.New()
+			lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_GO, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			defer DeleteFolders(pipelineId, lc)
+			if err := lc.CreateSourceCodeFile("package main\n"); err != nil {
+				t.Fatalf("error during create source file: %s", err.Error())
+			}
+			pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, time.Minute)
+			defer finishCtxFunc()
+
+			compileStep(ctx, cacheService, &lc.Paths, pipelineId, sdkEnv, false, pipelineLifeCycleCtx, make(chan bool, 1), nil)
+
+			if status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status); status != tt.wantStatus {
+				t.Errorf("status = %v, want %v", status, tt.wantStatus)
+			}
+			if compileOutput, _ := cacheService.GetValue(ctx, pipelineId, cache.CompileOutput); compileOutput != tt.wantCompileOutput {
+				t.Errorf("compile output = %q, want %q", compileOutput, tt.wantCompileOutput)
+			}
+			attempts, err := os.ReadFile(filepath.Join(lc.Paths.AbsoluteBaseFolderPath, "attempts.txt"))
+			if err != nil {
+				t.Fatalf("error during read attempts: %s", err.Error())
+			}
+			if got := strings.Count(string(attempts), "attempt"); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+			// the command line of the retried compile step is kept once
+			value, _ := cacheService.GetValue(ctx, pipelineId, cache.ExecutionCommand)
+			if command, _ := value.(string); !strings.HasPrefix(command, "sh -c ") || strings.Contains(command, "\n") {
+				t.Errorf("execution command = %q, want one command line of the compile step", command)
+			}
+		})
+	}
+}
+
+func Test_outputTail(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		maxSize int
+		want    string
+	}{
+		{
+			// Test case with the output which is shorter than the max size.
+			// As a result, want to receive the whole output.
+			name:    "short output",
+			output:  "error",
+			maxSize: 10,
+			want:    "error",
+		},
+		{
+			// Test case with the output which is longer than the max size.
+			// As a result, want to receive the end of the output of the max size.
+			name:    "long output",
+			output:  "first line\nerror",
+			maxSize: 5,
+			want:    "...error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := outputTail([]byte(tt.output), tt.maxSize); got != tt.want {
+				t.Errorf("outputTail() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_graphStep(t *testing.T) {
+	// pipelineWithGraph writes the graph when it is run by the dot runner as pipelines of the Go SDK do
+	pipelineWithGraph := "package main\n\nimport (\n\t\"flag\"\n\t\"fmt\"\n\t\"io/ioutil\"\n)\n\nfunc main() {\n\trunner := flag.String(\"runner\", \"direct\", \"\")\n\tdotFile := flag.String(\"dot_file\", \"\", \"\")\n\tflag.Parse()\n\tif *runner == \"dot\" {\n\t\t_ = ioutil.WriteFile(*dotFile, []byte(\"digraph G {\\n  Read -> Write\\n}\\n\"), 0600)\n\t\treturn\n\t}\n\tfmt.Println(\"Hello\")\n}\n"
+	pipelineWithoutGraph := "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"Hello\")\n}\n"
+	// pipelineWithEnvGraph writes names of its graph from environment variables
+	pipelineWithEnvGraph := "package main\n\nimport (\n\t\"flag\"\n\t\"fmt\"\n\t\"io/ioutil\"\n\t\"os\"\n)\n\nfunc main() {\n\tdotFile := flag.String(\"dot_file\", \"\", \"\")\n\tflag.String(\"runner\", \"direct\", \"\")\n\tflag.Parse()\n\tif *dotFile != \"\" {\n\t\t_ = ioutil.WriteFile(*dotFile, []byte(fmt.Sprintf(\"digraph G {\\n  %q -> %q\\n}\\n\", os.Getenv(\"CONFIG_VAR\"), os.Getenv(\"CACHE_PASSWORD\"))), 0600)\n\t}\n}\n"
+	graphArgs := []string{"--runner=dot", "--dot_file=graph.dot"}
+	ctx := context.Background()
+	// secrets of the server mustn't be visible to the code
+	_ = os.Setenv("CACHE_PASSWORD", "MOCK_PASSWORD")
+	defer os.Unsetenv("CACHE_PASSWORD")
+
+	tests := []struct {
+		name        string
+		code        string
+		graphArgs   []string
+		environment map[string]string
+		// wantGraph is the graph which is saved in cache, nil if the graph isn't saved
+		wantGraph interface{}
+	}{
+		{
+			// Test case with the pipeline which writes its graph when it is run with graph args.
+			// As a result, want the graph in DOT format as cache.Graph and the code to be run successfully.
+			name:      "pipeline supports graph",
+			code:      pipelineWithGraph,
+			graphArgs: graphArgs,
+			wantGraph: "digraph G {\n  Read -> Write\n}\n",
+		},
+		{
+			// Test case with the pipeline which ignores graph args and doesn't write the graph.
+			// As a result, want the graph not to be saved and the code to be run successfully.
+			name:      "pipeline doesn't support graph",
+			code:      pipelineWithoutGraph,
+			graphArgs: graphArgs,
+			wantGraph: nil,
+		},
+		{
+			// Test case with the SDK which doesn't have graph args.
+			// As a result, want the graph step to be skipped and the code to be run successfully.
+			name:      "sdk doesn't support graph",
+			code:      pipelineWithGraph,
+			graphArgs: nil,
+			wantGraph: nil,
+		},
+		{
+			// Test case with the pipeline which writes environment variables to its graph.
+			// As a result, want the graph with variables of the SDK config but without secrets of the server.
+			name:        "environment variables",
+			code:        pipelineWithEnvGraph,
+			graphArgs:   graphArgs,
+			environment: map[string]string{"CONFIG_VAR": "config"},
+			wantGraph:   "digraph G {\n  \"config\" -> \"\"\n}\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executorConfig := environment.NewExecutorConfig("go", "", "go", []string{"build", "-mod=mod", "-o", "bin"}, []string{"--runner=direct"}, []string{"test", "-v"})
+			executorConfig.GraphArgs = tt.graphArgs
+			executorConfig.Environment = tt.environment
+			sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_GO, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+			pipelineId := uuid.New()
+			lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_GO, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			defer DeleteFolders(pipelineId, lc)
+			goMod := filepath.Join(lc.Paths.AbsoluteBaseFolderPath, "go.mod")
+			if err := os.WriteFile(goMod, []byte("module executable_files\n\ngo 1.16\n"), fs.ModePerm); err != nil {
+				t.Fatalf("error during create go.mod: %s", err.Error())
+			}
+			if err := lc.CreateSourceCodeFile(tt.code); err != nil {
+				t.Fatalf("error during create source file: %s", err.Error())
+			}
+			pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, time.Minute)
+			defer finishCtxFunc()
+			if executor := compileStep(ctx, cacheService, &lc.Paths, pipelineId, sdkEnv, false, pipelineLifeCycleCtx, make(chan bool, 1), nil); executor == nil {
+				compileOutput, _ := cacheService.GetValue(ctx, pipelineId, cache.CompileOutput)
+				t.Fatalf("compileStep() = nil, compile output: %v", compileOutput)
+			}
+
+			graphStep(cacheService, &lc.Paths, pipelineId, sdkEnv, "", pipelineLifeCycleCtx)
+
+			graph, err := cacheService.GetValue(ctx, pipelineId, cache.Graph)
+			if tt.wantGraph == nil && err == nil {
+				t.Errorf("graph = %v, want the graph not to be saved", graph)
+			}
+			if tt.wantGraph != nil && !reflect.DeepEqual(graph, tt.wantGraph) {
+				t.Errorf("graph = %v, error = %v, want %v", graph, err, tt.wantGraph)
+			}
+			runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", pipelineLifeCycleCtx, make(chan bool, 1))
+			if status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status); status != pb.Status_STATUS_FINISHED {
+				runError, _ := cacheService.GetValue(ctx, pipelineId, cache.RunError)
+				t.Errorf("status = %v, want %v, run error: %v", status, pb.Status_STATUS_FINISHED, runError)
+			}
+		})
+	}
+}
+
+func Test_compileAndRunStepsScio(t *testing.T) {
+	if _, err := exec.LookPath("scalac"); err != nil {
+		t.Skip("scalac is required to compile scio code")
+	}
+	ctx := context.Background()
+	jars, err := environment.ConcatBeamJarsToString()
+	if err != nil {
+		t.Fatalf("error during get jars: %s", err.Error())
+	}
+	executorConfig := environment.NewExecutorConfig("scalac", "scala", "scala", []string{"-d", "bin", "-classpath", jars}, []string{"-cp", "bin:" + jars}, []string{"-cp", "bin:" + jars, "org.scalatest.run"})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_SCIO, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+
+	tests := []struct {
+		name       string
+		code       string
+		wantStatus pb.Status
+		// wantOutput is the subKey of cache and the part of the output with this subKey
+		wantOutput map[cache.SubKey]string
+	}{
+		{
+			// Test case with compiling and running the minimal scio pipeline.
+			// As a result, want the code to be compiled and the output of the pipeline as the run output.
+			name:       "minimal scio pipeline",
+			code:       "import com.spotify.scio._\n\nobject MinimalWordCount {\n  def main(cmdlineArgs: Array[String]): Unit = {\n    val (sc, _) = ContextAndArgs(cmdlineArgs)\n    sc.parallelize(Seq(\"Hello SCIO\", \"Hello Beam\"))\n      .flatMap(_.split(\" \"))\n      .countByValue\n      .map { case (word, count) => s\"$word: $count\" }\n      .debug()\n    sc.run()\n  }\n}\n",
+			wantStatus: pb.Status_STATUS_FINISHED,
+			wantOutput: map[cache.SubKey]string{cache.RunOutput: "Hello: 2"},
+		},
+		{
+			// Test case with compiling the scio code with a type error.
+			// As a result, want the status to be STATUS_COMPILE_ERROR and the error of scalac as the compile output.
+			name:       "scio code with compile error",
+			code:       "import com.spotify.scio._\n\nobject MinimalWordCount {\n  def main(cmdlineArgs: Array[String]): Unit = {\n    val count: Int = \"one\"\n  }\n}\n",
+			wantStatus: pb.Status_STATUS_COMPILE_ERROR,
+			wantOutput: map[cache.SubKey]string{cache.CompileOutput: "type mismatch"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_SCIO, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			defer DeleteFolders(pipelineId, lc)
+			if err := lc.CreateSourceCodeFile(tt.code); err != nil {
+				t.Fatalf("error during create source file: %s", err.Error())
+			}
+			pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, 10*time.Minute)
+			defer finishCtxFunc()
+
+			if executor := compileStep(ctx, cacheService, &lc.Paths, pipelineId, sdkEnv, false, pipelineLifeCycleCtx, make(chan bool, 1), nil); executor != nil {
+				runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", pipelineLifeCycleCtx, make(chan bool, 1))
+			}
+
+			if status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status); status != tt.wantStatus {
+				runError, _ := cacheService.GetValue(ctx, pipelineId, cache.RunError)
+				t.Errorf("status = %v, want %v, run error: %v", status, tt.wantStatus, runError)
+			}
+			for subKey, wantOutput := range tt.wantOutput {
+				output, _ := cacheService.GetValue(ctx, pipelineId, subKey)
+				if !strings.Contains(fmt.Sprint(output), wantOutput) {
+					t.Errorf("%s = %v, want to contain %q", subKey, output, wantOutput)
+				}
+			}
+		})
+	}
+}
+
+func Test_compileAndRunStepsPythonRequirements(t *testing.T) {
+	ctx := context.Background()
+	executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+
+	tests := []struct {
+		name         string
+		code         string
+		requirements []string
+		wantStatus   pb.Status
+		// wantOutput is the subKey of cache and the part of the output with this subKey
+		wantOutput map[cache.SubKey]string
+	}{
+		{
+			// Test case with running the Python code which imports the package from its requirements.
+			// As a result, want the code to be run by the interpreter of the virtual environment.
+			name:         "code with requirements",
+			code:         "import sys\nimport pip\nprint('venv' in sys.prefix, pip.__name__)\n",
+			requirements: []string{"pip"},
+			wantStatus:   pb.Status_STATUS_FINISHED,
+			wantOutput:   map[cache.SubKey]string{cache.RunOutput: "True pip"},
+		},
+		{
+			// Test case with the Python code which requirements couldn't be installed.
+			// As a result, want the status to be STATUS_COMPILE_ERROR and the error of the installation as the compile output.
+			name:         "requirements couldn't be installed",
+			code:         "print('Hello')\n",
+			requirements: []string{"pip!!1"},
+			wantStatus:   pb.Status_STATUS_COMPILE_ERROR,
+			wantOutput:   map[cache.SubKey]string{cache.CompileOutput: "installation of dependencies failed"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			defer DeleteFolders(pipelineId, lc)
+			if err := lc.CreateSourceCodeFile(tt.code); err != nil {
+				t.Fatalf("error during create source file: %s", err.Error())
+			}
+			if err := dependencies.WriteRequirements(lc.Paths.AbsoluteBaseFolderPath, tt.requirements); err != nil {
+				t.Fatalf("error during write requirements: %s", err.Error())
+			}
+			pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, 5*time.Minute)
+			defer finishCtxFunc()
+
+			if executor := compileStep(ctx, cacheService, &lc.Paths, pipelineId, sdkEnv, false, pipelineLifeCycleCtx, make(chan bool, 1), nil); executor != nil {
+				runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", pipelineLifeCycleCtx, make(chan bool, 1))
+			}
+
+			if status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status); status != tt.wantStatus {
+				runError, _ := cacheService.GetValue(ctx, pipelineId, cache.RunError)
+				t.Errorf("status = %v, want %v, run error: %v", status, tt.wantStatus, runError)
+			}
+			for subKey, wantOutput := range tt.wantOutput {
+				output, _ := cacheService.GetValue(ctx, pipelineId, subKey)
+				if !strings.Contains(fmt.Sprint(output), wantOutput) {
+					t.Errorf("%s = %v, want to contain %q", subKey, output, wantOutput)
+				}
+			}
+		})
+	}
+}
+
+func Test_saveLogEntries(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		// logs is the value of cache.Logs, nil if the pipeline doesn't have logs
+		logs interface{}
+		want interface{}
+	}{
+		{
+			// Test case with saving entries of the pipeline which has logs.
+			// As a result, want parsed entries to be saved as cache.LogEntries.
+			name: "logs exist",
+			logs: "ERROR: MOCK_ERROR\nMOCK_OUTPUT\n",
+			want: []cache.LogEntry{
+				{Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "MOCK_ERROR"},
+				{Severity: pb.LogSeverity_LOG_SEVERITY_INFO, Message: "MOCK_OUTPUT"},
+			},
+		},
+		{
+			// Test case with saving entries of the pipeline which doesn't have logs.
+			// As a result, want nothing to be saved.
+			name: "logs don't exist",
+			logs: nil,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			if tt.logs != nil {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Logs, tt.logs)
+			}
+			if err := saveLogEntries(ctx, cacheService, pipelineId); err != nil {
+				t.Fatalf("saveLogEntries() error = %v", err)
+			}
+			got, _ := cacheService.GetValue(ctx, pipelineId, cache.LogEntries)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("saveLogEntries() saved = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_waitStep(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		// release is true if the running job releases the slot while the code waits
+		release bool
+		// cancel is true if the code processing is canceled while the code waits
+		cancel       bool
+		want         bool
+		wantStatus   pb.Status
+		wantRunError interface{}
+	}{
+		{
+			// Test case with waiting for the slot which is released by the running job.
+			// As a result, want the slot to be taken and the status to be STATUS_COMPILING.
+			name:       "slot is released",
+			release:    true,
+			want:       true,
+			wantStatus: pb.Status_STATUS_COMPILING,
+		},
+		{
+			// Test case with waiting for the slot which isn't released during the timeout of the queue.
+			// As a result, want the status to be STATUS_RUN_TIMEOUT and the timeout message as run error.
+			name:         "timeout of waiting",
+			want:         false,
+			wantStatus:   pb.Status_STATUS_RUN_TIMEOUT,
+			wantRunError: "Waiting for a free slot to compile and run the code exceeded the timeout: 100ms",
+		},
+		{
+			// Test case with canceling the code processing while the code waits for the slot.
+			// As a result, want the status to be STATUS_CANCELED.
+			name:       "canceled while waiting",
+			cancel:     true,
+			want:       false,
+			wantStatus: pb.Status_STATUS_CANCELED,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := job_queue.New(1, 100*time.Millisecond)
+			if tt.release {
+				queue = job_queue.New(1, time.Minute)
+			}
+			releaseRunning, _ := queue.TryAcquire()
+			defer releaseRunning()
+			pipelineId := uuid.New()
+			cancelChannel := make(chan bool, 1)
+			pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, time.Minute)
+			defer finishCtxFunc()
+
+			waitingChannel := make(chan bool, 1)
+			go func(release, cancel bool) {
+				for queue.Waiting() == 0 {
+					time.Sleep(time.Millisecond)
+				}
+				status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status)
+				waitingChannel <- status == pb.Status_STATUS_WAITING
+				if release {
+					releaseRunning()
+				}
+				if cancel {
+					cancelChannel <- true
+				}
+			}(tt.release, tt.cancel)
+
+			release, got := waitStep(ctx, cacheService, pipelineId, queue, pipelineLifeCycleCtx, cancelChannel)
+			if got {
+				release()
+			}
+			if !<-waitingChannel {
+				t.Errorf("waitStep() doesn't set status %s while waiting", pb.Status_STATUS_WAITING)
+			}
+			if got != tt.want {
+				t.Fatalf("waitStep() = %v, want %v", got, tt.want)
+			}
+			if status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status); status != tt.wantStatus {
+				t.Errorf("status = %v, want %v", status, tt.wantStatus)
+			}
+			if tt.wantRunError != nil {
+				if runError, _ := cacheService.GetValue(ctx, pipelineId, cache.RunError); runError != tt.wantRunError {
+					t.Errorf("run error = %v, want %v", runError, tt.wantRunError)
+				}
+			}
+			if tt.release && queue.Running() != 0 {
+				t.Errorf("Running() = %d, want 0", queue.Running())
+			}
+		})
+	}
+}
+
+func TestCheckSyntax(t *testing.T) {
+	pythonConfig := environment.NewExecutorConfig("", "python3", "", []string{}, []string{}, []string{})
+	pythonConfig.SyntaxCheckCmd = "python3"
+	pythonConfig.SyntaxCheckArgs = []string{"-m", "py_compile"}
+	goConfig := environment.NewExecutorConfig("go", "", "go", []string{"build", "-o", "bin"}, []string{}, []string{"test", "-v"})
+	goConfig.SyntaxCheckCmd = "gofmt"
+	goConfig.SyntaxCheckArgs = []string{"-l", "-e"}
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		sdk            pb.Sdk
+		executorConfig *environment.ExecutorConfig
+		code           string
+		wantStatus     pb.Status
+		wantOutput     string
+	}{
+		{
+			// Test case with checking the correct Python code.
+			// As a result, want to receive the finished status.
+			name:           "correct python code",
+			sdk:            pb.Sdk_SDK_PYTHON,
+			executorConfig: pythonConfig,
+			code:           "if __name__ == \"__main__\":\n    print(\"Hello world!\")\n",
+			wantStatus:     pb.Status_STATUS_FINISHED,
+		},
+		{
+			// Test case with checking the Python code with the syntax error.
+			// As a result, want to receive the validation error status and the line of the error in the output.
+			name:           "broken python code",
+			sdk:            pb.Sdk_SDK_PYTHON,
+			executorConfig: pythonConfig,
+			code:           "if __name__ == \"__main__\":\n    print(\"Hello world!\"\n",
+			wantStatus:     pb.Status_STATUS_VALIDATION_ERROR,
+			wantOutput:     "SyntaxError",
+		},
+		{
+			// Test case with checking the correct Go code.
+			// As a result, want to receive the finished status.
+			name:           "correct go code",
+			sdk:            pb.Sdk_SDK_GO,
+			executorConfig: goConfig,
+			code:           "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"Hello world!\")\n}\n",
+			wantStatus:     pb.Status_STATUS_FINISHED,
+		},
+		{
+			// Test case with checking the Go code with the syntax error.
+			// As a result, want to receive the validation error status and the line of the error in the output.
+			name:           "broken go code",
+			sdk:            pb.Sdk_SDK_GO,
+			executorConfig: goConfig,
+			code:           "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"Hello world!\"\n}\n",
+			wantStatus:     pb.Status_STATUS_VALIDATION_ERROR,
+			wantOutput:     ":6:",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sdkEnv := environment.NewBeamEnvs(tt.sdk, tt.executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+			pipelineId := uuid.New()
+			lc, _ := fs_tool.NewLifeCycle(tt.sdk, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			if err := lc.CreateSourceCodeFile(tt.code); err != nil {
+				t.Fatalf("error during create source file: %s", err.Error())
+			}
+
+			status, output, err := CheckSyntax(ctx, cacheService, lc, pipelineId, sdkEnv)
+			if err != nil {
+				t.Fatalf("CheckSyntax() error = %v", err)
+			}
+			if status != tt.wantStatus {
+				t.Errorf("CheckSyntax() status = %v, want %v, output: %s", status, tt.wantStatus, output)
+			}
+			if !strings.Contains(output, tt.wantOutput) {
+				t.Errorf("CheckSyntax() output = %q, want to contain %q", output, tt.wantOutput)
+			}
+			if cachedStatus, _ := cacheService.GetValue(ctx, pipelineId, cache.Status); cachedStatus != tt.wantStatus {
+				t.Errorf("CheckSyntax() cached status = %v, want %v", cachedStatus, tt.wantStatus)
+			}
+			if cachedOutput, _ := cacheService.GetValue(ctx, pipelineId, cache.ValidationOutput); cachedOutput != output {
+				t.Errorf("CheckSyntax() cached validation output = %v, want %v", cachedOutput, output)
+			}
+			if _, err := os.Stat(lc.Paths.AbsoluteBaseFolderPath); !os.IsNotExist(err) {
+				t.Errorf("CheckSyntax() folders of the code processing should be deleted")
+			}
+		})
+	}
+}
+
+func TestCancel(t *testing.T) {
+	executorConfig := environment.NewExecutorConfig("", "python3", "", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		prepare       func(pipelineId uuid.UUID) (finished chan bool)
+		wantStatus    pb.Status
+		wantCanceled  bool
+		wantFinishing bool
+	}{
+		{
+			// Test case with calling Cancel method for the running long-running code.
+			// As a result, want to receive killed process and canceled status and flag in the cache.
+			name: "cancel running code processing",
+			prepare: func(pipelineId uuid.UUID) chan bool {
+				lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+				if err := lc.CreateFolders(); err != nil {
+					t.Fatalf("error during prepare folders: %s", err.Error())
+				}
+				_ = lc.CreateSourceCodeFile("import time\n\nif __name__ == \"__main__\":\n    time.sleep(30)\n")
+				process := &runningProcess{cancelChannel: make(chan bool, 1)}
+				runningProcesses.Store(pipelineId, process)
+
+				finished := make(chan bool, 1)
+				go func() {
+					defer func() {
+						runningProcesses.Delete(pipelineId)
+						DeleteFolders(pipelineId, lc)
+					}()
+					pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, time.Minute)
+					defer finishCtxFunc()
+					_ = cacheService.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_EXECUTING)
+					runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", pipelineLifeCycleCtx, process.cancelChannel)
+					finished <- true
+				}()
+				// wait until the process is started
+				for i := 0; i < 100; i++ {
+					process.mu.Lock()
+					started := process.cmd != nil
+					process.mu.Unlock()
+					if started {
+						break
+					}
+					time.Sleep(50 * time.Millisecond)
+				}
+				return finished
+			},
+			wantStatus:    pb.Status_STATUS_CANCELED,
+			wantCanceled:  true,
+			wantFinishing: true,
+		},
+		{
+			// Test case with calling Cancel method for the finished code processing.
+			// As a result, want to receive no changes in the cache.
+			name: "cancel finished code processing",
+			prepare: func(pipelineId uuid.UUID) chan bool {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_FINISHED)
+				return nil
+			},
+			wantStatus:    pb.Status_STATUS_FINISHED,
+			wantCanceled:  false,
+			wantFinishing: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			finished := tt.prepare(pipelineId)
+			if err := Cancel(ctx, cacheService, pipelineId); err != nil {
+				t.Fatalf("Cancel() error = %v", err)
+			}
+			if tt.wantFinishing {
+				select {
+				case <-finished:
+				case <-time.After(10 * time.Second):
+					t.Fatal("Cancel() doesn't kill the process")
+				}
+			}
+			status, err := cacheService.GetValue(ctx, pipelineId, cache.Status)
+			if err != nil {
+				t.Fatalf("error during get status: %s", err.Error())
+			}
+			if status != tt.wantStatus {
+				t.Errorf("Cancel() status = %v, want %v", status, tt.wantStatus)
+			}
+			canceled, err := cacheService.GetValue(ctx, pipelineId, cache.Canceled)
+			if tt.wantCanceled && (err != nil || canceled != true) {
+				t.Errorf("Cancel() canceled = %v, err = %v, want %v", canceled, err, tt.wantCanceled)
+			}
+			if !tt.wantCanceled && err == nil {
+				t.Errorf("Cancel() canceled = %v, want no value", canceled)
+			}
+		})
+	}
+}
+
+func syncMapLen(syncMap *sync.Map) int {
+	length := 0
+	syncMap.Range(func(_, _ interface{}) bool {
+		length++
+		return true
+	})
+	return length
+}
+
+func Test_newOutputWriter(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *environment.ExecutorConfig
+		writes []string
+		// wantBeforeClose is the output which is saved to the cache before Close
+		wantBeforeClose interface{}
+	}{
+		{
+			// Test case with the short flush interval and the large max buffer size.
+			// As a result, want to receive the output which is saved by the interval.
+			name:            "interval-triggered flush",
+			config:          &environment.ExecutorConfig{OutputFlushIntervalMs: 10, OutputMaxBufferSize: 1024 * 1024},
+			writes:          []string{"line 1\n", "line 2"},
+			wantBeforeClose: "line 1\nline 2",
+		},
+		{
+			// Test case with the long flush interval and the small max buffer size.
+			// As a result, want to receive the output which is saved when the buffer size is reached
+			// and to keep the rest of the output in memory.
+			name:            "size-triggered flush",
+			config:          &environment.ExecutorConfig{OutputFlushIntervalMs: int(time.Hour / time.Millisecond), OutputMaxBufferSize: len("line 1\n")},
+			writes:          []string{"line 1\n", "line"},
+			wantBeforeClose: "line 1\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			localCache := local.New(ctx)
+			pipelineId := uuid.New()
+			sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_JAVA, tt.config, "", 0, 0, 0, environment.ResourceLimits{})
+			w := newOutputWriter(ctx, localCache, pipelineId, cache.RunOutput, sdkEnv)
+			want := ""
+			for _, data := range tt.writes {
+				_, _ = w.Write([]byte(data))
+				want += data
+			}
+			deadline := time.Now().Add(time.Second)
+			got, _ := localCache.GetValue(ctx, pipelineId, cache.RunOutput)
+			for got != tt.wantBeforeClose && time.Now().Before(deadline) {
+				time.Sleep(5 * time.Millisecond)
+				got, _ = localCache.GetValue(ctx, pipelineId, cache.RunOutput)
+			}
+			if got != tt.wantBeforeClose {
+				t.Errorf("newOutputWriter() output before Close = %v, want %v", got, tt.wantBeforeClose)
+			}
+			if err := w.Close(ctx); err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+			got, _ = localCache.GetValue(ctx, pipelineId, cache.RunOutput)
+			if got != want {
+				t.Errorf("newOutputWriter() output after Close = %v, want %v", got, want)
+			}
+		})
+	}
 }
@@ -0,0 +1,118 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package code_processing
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/environment"
+	"beam.apache.org/playground/backend/internal/fs_tool"
+	"bufio"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_killProcessGroup(t *testing.T) {
+	cmd := exec.Command("sh", "-c", "sleep 30 & echo $!; wait")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatalf("error during create stdout pipe: %s", err.Error())
+	}
+	setProcessGroup(cmd)
+	if err = cmd.Start(); err != nil {
+		t.Fatalf("error during start command: %s", err.Error())
+	}
+	line, err := bufio.NewReader(stdout).ReadString('\n')
+	if err != nil {
+		t.Fatalf("error during read pid of the child process: %s", err.Error())
+	}
+	childPid, err := strconv.Atoi(strings.TrimSpace(line))
+	if err != nil {
+		t.Fatalf("error during parse pid of the child process: %s", err.Error())
+	}
+
+	if err = killProcessGroup(cmd); err != nil {
+		t.Fatalf("killProcessGroup() error = %v", err)
+	}
+	_ = cmd.Wait()
+
+	for i := 0; i < 100 && isProcessAlive(childPid); i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	if isProcessAlive(childPid) {
+		t.Errorf("killProcessGroup() doesn't kill the child process %d", childPid)
+	}
+	if err = killProcessGroup(cmd); err != nil {
+		t.Errorf("killProcessGroup() of the finished process error = %v, want nil", err)
+	}
+}
+
+func Test_runStepKillsRunnerProcesses(t *testing.T) {
+	// the code starts the background process as runners do for their clusters or job servers and prints its pid
+	code := "import subprocess\nprint(subprocess.Popen(['sleep', '30']).pid)\n"
+	ctx := context.Background()
+	executorConfig := environment.NewExecutorConfig("", "python3", "pytest", []string{}, []string{}, []string{})
+	executorConfig.Runners = map[string]environment.RunnerConfig{"flink": {RunArgs: []string{"--runner=FlinkRunner"}}}
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, time.Minute, environment.ResourceLimits{})
+	pipelineId := uuid.New()
+	lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+	if err := lc.CreateFolders(); err != nil {
+		t.Fatalf("error during prepare folders: %s", err.Error())
+	}
+	defer DeleteFolders(pipelineId, lc)
+	if err := lc.CreateSourceCodeFile(code); err != nil {
+		t.Fatalf("error during create source file: %s", err.Error())
+	}
+	if err := lc.CreateRunnerFile("flink"); err != nil {
+		t.Fatalf("error during create runner file: %s", err.Error())
+	}
+
+	runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", ctx, make(chan bool, 1))
+
+	if status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status); status != pb.Status_STATUS_FINISHED {
+		t.Fatalf("runStep() status = %v, want %v", status, pb.Status_STATUS_FINISHED)
+	}
+	runOutput, _ := cacheService.GetValue(ctx, pipelineId, cache.RunOutput)
+	childPid, err := strconv.Atoi(strings.TrimSpace(fmt.Sprint(runOutput)))
+	if err != nil {
+		t.Fatalf("error during parse pid of the child process: %s", err.Error())
+	}
+	for i := 0; i < 100 && isProcessAlive(childPid); i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	if isProcessAlive(childPid) {
+		_ = exec.Command("kill", "-9", strconv.Itoa(childPid)).Run()
+		t.Errorf("runStep() doesn't kill the process %d of the runner", childPid)
+	}
+}
+
+// isProcessAlive returns false if the process doesn't exist or is a zombie which is waiting to be reaped
+func isProcessAlive(pid int) bool {
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return false
+	}
+	fields := strings.Fields(string(stat[strings.LastIndex(string(stat), ")")+1:]))
+	return len(fields) > 0 && fields[0] != "Z"
+}
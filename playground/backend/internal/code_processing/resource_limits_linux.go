@@ -0,0 +1,60 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package code_processing
+
+import (
+	"beam.apache.org/playground/backend/internal/environment"
+	"fmt"
+	"math"
+	"os/exec"
+	"strings"
+)
+
+// resourceLimitsShell is the shell which sets limits of the process before the command is executed
+const resourceLimitsShell = "/bin/sh"
+
+// setResourceLimits makes cmd set limits of the virtual memory and the CPU time before its program is executed,
+// so the code never runs without the limits. It must be called before cmd is started.
+// The program is executed by the shell after ulimit, so the process keeps its pid and child processes inherit the limits.
+// If the shell fails to set a limit, it exits without executing the program.
+func setResourceLimits(cmd *exec.Cmd, limits environment.ResourceLimits) error {
+	var ulimits []string
+	if limits.MemoryBytes > 0 {
+		// ulimit -v receives the limit in kilobytes
+		kilobytes := limits.MemoryBytes / 1024
+		if kilobytes == 0 {
+			kilobytes = 1
+		}
+		ulimits = append(ulimits, fmt.Sprintf("ulimit -v %d", kilobytes))
+	}
+	if limits.CpuTime > 0 {
+		// The process receives SIGXCPU after the soft limit and SIGKILL after the hard limit
+		seconds := uint64(math.Ceil(limits.CpuTime.Seconds()))
+		// The soft limit is lowered first since the hard limit can't be lower than the soft one
+		ulimits = append(ulimits, fmt.Sprintf("ulimit -S -t %d", seconds), fmt.Sprintf("ulimit -H -t %d", seconds+1))
+	}
+	if len(ulimits) == 0 {
+		return nil
+	}
+	if cmd.Process != nil {
+		return fmt.Errorf("resource limits are set to the started process %d", cmd.Process.Pid)
+	}
+	script := strings.Join(append(ulimits, `exec "$@"`), " && ")
+	// $0 of the script is the name of the shell, the program and its args are $@
+	cmd.Args = append([]string{resourceLimitsShell, "-c", script, resourceLimitsShell, cmd.Path}, cmd.Args[1:]...)
+	cmd.Path = resourceLimitsShell
+	return nil
+}
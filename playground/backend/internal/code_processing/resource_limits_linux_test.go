@@ -0,0 +1,172 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package code_processing
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/environment"
+	"beam.apache.org/playground/backend/internal/fs_tool"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_setResourceLimits(t *testing.T) {
+	// Run the command which prints its limits.
+	// As a result, want the limits to be set before the command is executed.
+	cmd := exec.Command("sh", "-c", "ulimit -v; ulimit -S -t; ulimit -H -t")
+	if err := setResourceLimits(cmd, environment.ResourceLimits{MemoryBytes: 256 * 1024 * 1024, CpuTime: 1500 * time.Millisecond}); err != nil {
+		t.Fatalf("setResourceLimits() error = %v", err)
+	}
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("error during run command: %s", err.Error())
+	}
+	if want := "262144\n2\n3\n"; string(output) != want {
+		t.Errorf("setResourceLimits() limits = %q, want %q", output, want)
+	}
+
+	// Set limits to the started command.
+	// As a result, want to receive an error since the code mustn't run without limits.
+	started := exec.Command("true")
+	if err := started.Start(); err != nil {
+		t.Fatalf("error during start command: %s", err.Error())
+	}
+	_ = started.Wait()
+	if err := setResourceLimits(started, environment.ResourceLimits{CpuTime: time.Second}); err == nil {
+		t.Errorf("setResourceLimits() to the started command doesn't return an error")
+	}
+}
+
+func Test_runStepResourceLimits(t *testing.T) {
+	executorConfig := environment.NewExecutorConfig("", "python3", "", []string{}, []string{}, []string{})
+	tests := []struct {
+		name              string
+		limits            environment.ResourceLimits
+		code              string
+		wantLimitExceeded string
+		wantRunError      string
+	}{
+		{
+			// Run the code which allocates more memory than the memory limit.
+			// As a result, want to receive the run error about the memory limit.
+			name:              "memory limit",
+			limits:            environment.ResourceLimits{MemoryBytes: 256 * 1024 * 1024},
+			code:              "if __name__ == \"__main__\":\n    data = bytearray(1024 * 1024 * 1024)\n",
+			wantLimitExceeded: cache.MemoryLimit,
+			wantRunError:      "Run step exceeded the memory limit: 256 MB",
+		},
+		{
+			// Run the code which uses more CPU time than the CPU time limit.
+			// As a result, want to receive the run error about the CPU time limit.
+			name:              "CPU time limit",
+			limits:            environment.ResourceLimits{CpuTime: time.Second},
+			code:              "if __name__ == \"__main__\":\n    while True:\n        pass\n",
+			wantLimitExceeded: cache.CpuLimit,
+			wantRunError:      "Run step exceeded the CPU time limit: 1s",
+		},
+		{
+			// Run the code which fails without hitting the limits.
+			// As a result, want to receive the run error without the limit message.
+			name:              "error without hitting limits",
+			limits:            environment.ResourceLimits{MemoryBytes: 256 * 1024 * 1024, CpuTime: 10 * time.Second},
+			code:              "if __name__ == \"__main__\":\n    raise ValueError(\"not a limit\")\n",
+			wantLimitExceeded: "",
+			wantRunError:      "error: exit status 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, tt.limits)
+			pipelineId := uuid.New()
+			lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			defer DeleteFolders(pipelineId, lc)
+			_ = lc.CreateSourceCodeFile(tt.code)
+
+			ctx := context.Background()
+			pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, time.Minute)
+			defer finishCtxFunc()
+			runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", pipelineLifeCycleCtx, make(chan bool, 1))
+
+			status, err := cacheService.GetValue(ctx, pipelineId, cache.Status)
+			if err != nil {
+				t.Fatalf("error during get status: %s", err.Error())
+			}
+			if status != pb.Status_STATUS_RUN_ERROR {
+				t.Errorf("runStep() status = %v, want %v", status, pb.Status_STATUS_RUN_ERROR)
+			}
+			runError, err := cacheService.GetValue(ctx, pipelineId, cache.RunError)
+			if err != nil {
+				t.Fatalf("error during get run error: %s", err.Error())
+			}
+			if !strings.HasPrefix(runError.(string), tt.wantRunError) {
+				t.Errorf("runStep() run error = %v, want prefix %v", runError, tt.wantRunError)
+			}
+			runResult, err := cacheService.GetValue(ctx, pipelineId, cache.RunResult)
+			if err != nil {
+				t.Fatalf("error during get run result: %s", err.Error())
+			}
+			if limitExceeded := runResult.(cache.ExecutionResult).LimitExceeded; limitExceeded != tt.wantLimitExceeded {
+				t.Errorf("runStep() limit exceeded = %v, want %v", limitExceeded, tt.wantLimitExceeded)
+			}
+		})
+	}
+}
+
+func Test_runStepDiskQuota(t *testing.T) {
+	executorConfig := environment.NewExecutorConfig("", "python3", "", []string{}, []string{}, []string{})
+	sdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_PYTHON, executorConfig, "", 0, 0, 0, environment.ResourceLimits{DiskBytes: 1024 * 1024})
+	// Run the code which writes the file to the working directory until it is stopped.
+	// As a result, want the code to be stopped with the run error about the disk quota and the working directory to be deleted.
+	code := "if __name__ == \"__main__\":\n    with open(\"data.bin\", \"wb\") as f:\n        while True:\n            f.write(b\"0\" * 64 * 1024)\n            f.flush()\n"
+	pipelineId := uuid.New()
+	lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_PYTHON, pipelineId, filepath.Join(os.Getenv("APP_WORK_DIR"), pipelinesFolder))
+	if err := lc.CreateFolders(); err != nil {
+		t.Fatalf("error during prepare folders: %s", err.Error())
+	}
+	_ = lc.CreateSourceCodeFile(code)
+
+	ctx := context.Background()
+	pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, time.Minute)
+	defer finishCtxFunc()
+	runStep(ctx, cacheService, &lc.Paths, pipelineId, false, sdkEnv, "", pipelineLifeCycleCtx, make(chan bool, 1))
+	DeleteFolders(pipelineId, lc)
+
+	if status, _ := cacheService.GetValue(ctx, pipelineId, cache.Status); status != pb.Status_STATUS_RUN_ERROR {
+		t.Errorf("runStep() status = %v, want %v", status, pb.Status_STATUS_RUN_ERROR)
+	}
+	runError, _ := cacheService.GetValue(ctx, pipelineId, cache.RunError)
+	if wantRunError := "Run step exceeded the disk quota: 1 MB"; !strings.HasPrefix(fmt.Sprint(runError), wantRunError) {
+		t.Errorf("runStep() run error = %v, want prefix %v", runError, wantRunError)
+	}
+	runResult, _ := cacheService.GetValue(ctx, pipelineId, cache.RunResult)
+	if result, ok := runResult.(cache.ExecutionResult); !ok || result.LimitExceeded != cache.DiskLimit {
+		t.Errorf("runStep() run result = %v, want limit exceeded %v", runResult, cache.DiskLimit)
+	}
+	if _, err := os.Stat(lc.Paths.AbsoluteBaseFolderPath); !os.IsNotExist(err) {
+		t.Errorf("working directory %s isn't deleted, err: %v", lc.Paths.AbsoluteBaseFolderPath, err)
+	}
+}
@@ -0,0 +1,186 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile_diagnostics
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// positionRegexp matches the first line of diagnostics of compilers like "/path/Main.java:3: error: not a statement",
+	// "./main.go:3:2: undefined: x" or "[error] /path/Main.scala:3:5: not found: value x"
+	positionRegexp = regexp.MustCompile(`^(?:\[(error|warn|warning)\]\s*)?(.+?\.(?:go|java|scala)):(\d+)(?::(\d+))?: (?:(error|warning): )?(.*)$`)
+	// caretRegexp matches the line which points to the column of the previous line of the code like "        ^" or "    ~~^^"
+	caretRegexp = regexp.MustCompile(`^\s*[~^]+\s*$`)
+	// pythonFileRegexp matches the location of the Python error like `  File "/path/main.py", line 3`
+	pythonFileRegexp = regexp.MustCompile(`^\s*File "(.+)", line (\d+)`)
+	// pythonErrorRegexp matches the last line of the Python error like "SyntaxError: invalid syntax"
+	pythonErrorRegexp = regexp.MustCompile(`^[A-Za-z_][\w.]*(?:Error|Exception|Warning)(?::|$)`)
+
+	// severities contains severities by levels of diagnostics of compilers
+	severities = map[string]pb.LogSeverity{
+		"error":   pb.LogSeverity_LOG_SEVERITY_ERROR,
+		"warn":    pb.LogSeverity_LOG_SEVERITY_WARNING,
+		"warning": pb.LogSeverity_LOG_SEVERITY_WARNING,
+	}
+)
+
+// Parse returns diagnostics of the compile output of the code of the SDK in the order they are written.
+// Diagnostics of javac, scalac and sbt contain the source line and the caret line, which sets the column of the diagnostic.
+// Diagnostics of the Python code are parsed from tracebacks and errors of py_compile by their last location,
+// their columns are counted from the first character of the code since Python prints the code without its indentation.
+// Lines which aren't parsed are diagnostics which contain only the raw line.
+func Parse(sdk pb.Sdk, output string) []*pb.CompileDiagnostic {
+	lines := make([]string, 0)
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	if sdk == pb.Sdk_SDK_PYTHON {
+		return parsePython(lines)
+	}
+	return parseCompiler(lines)
+}
+
+// parseCompiler parses diagnostics of compilers which start with the position of the diagnostic.
+// The line after the diagnostic without the column is the source line, which can be followed by the caret line.
+// Indented lines after the diagnostic (e.g. "  symbol: variable x" of javac) are appended to its message.
+func parseCompiler(lines []string) []*pb.CompileDiagnostic {
+	diagnostics := make([]*pb.CompileDiagnostic, 0, len(lines))
+	var current *pb.CompileDiagnostic
+	expectSource, expectCaret := false, false
+	for _, line := range lines {
+		if diagnostic, ok := parsePosition(line); ok {
+			diagnostics = append(diagnostics, diagnostic)
+			current, expectSource, expectCaret = diagnostic, diagnostic.Column == 0, false
+			continue
+		}
+		switch {
+		case current != nil && expectSource:
+			current.Raw += "\n" + line
+			expectSource, expectCaret = false, true
+		case current != nil && expectCaret && caretRegexp.MatchString(line):
+			current.Column = int32(strings.IndexAny(line, "~^") + 1)
+			current.Raw += "\n" + line
+			expectCaret = false
+		case current != nil && isIndented(line):
+			current.Message += "\n" + strings.TrimSpace(line)
+			current.Raw += "\n" + line
+			expectCaret = false
+		default:
+			diagnostics = append(diagnostics, &pb.CompileDiagnostic{Raw: line})
+			current = nil
+		}
+	}
+	return diagnostics
+}
+
+// parsePosition parses the first line of the diagnostic of the compiler. Returns false if the line doesn't contain the position.
+func parsePosition(line string) (*pb.CompileDiagnostic, bool) {
+	match := positionRegexp.FindStringSubmatch(line)
+	if match == nil {
+		return nil, false
+	}
+	diagnostic := &pb.CompileDiagnostic{
+		File:     filepath.Base(match[2]),
+		Line:     atoi(match[3]),
+		Column:   atoi(match[4]),
+		Severity: pb.LogSeverity_LOG_SEVERITY_ERROR,
+		Message:  match[6],
+		Raw:      line,
+	}
+	if severity, ok := severities[match[1]+match[5]]; ok {
+		diagnostic.Severity = severity
+	}
+	return diagnostic, true
+}
+
+// parsePython parses Python errors which contain lines like `File "main.py", line 3`, the indented code of the line
+// with the optional caret line and end with the line of the error like "SyntaxError: invalid syntax".
+// The diagnostic has the position of the last location before the line of the error, since it is where the error is raised.
+// Lines of errors which aren't finished by the line of the error are kept as raw lines.
+func parsePython(lines []string) []*pb.CompileDiagnostic {
+	diagnostics := make([]*pb.CompileDiagnostic, 0, len(lines))
+	var current *pb.CompileDiagnostic
+	var pending []string
+	// codeIndent is the indentation of the code of the current location, -1 if the code isn't received yet
+	codeIndent := -1
+	flush := func() {
+		for _, line := range pending {
+			diagnostics = append(diagnostics, &pb.CompileDiagnostic{Raw: line})
+		}
+		current, pending = nil, nil
+	}
+	for _, line := range lines {
+		if strings.HasPrefix(line, "Traceback (") {
+			flush()
+			current, pending = &pb.CompileDiagnostic{}, []string{line}
+			continue
+		}
+		if match := pythonFileRegexp.FindStringSubmatch(line); match != nil {
+			if current == nil {
+				current = &pb.CompileDiagnostic{}
+			}
+			current.File, current.Line, current.Column = filepath.Base(match[1]), atoi(match[2]), 0
+			pending = append(pending, line)
+			codeIndent = -1
+			continue
+		}
+		if current == nil {
+			diagnostics = append(diagnostics, &pb.CompileDiagnostic{Raw: line})
+			continue
+		}
+		pending = append(pending, line)
+		switch {
+		case pythonErrorRegexp.MatchString(line):
+			current.Severity = pb.LogSeverity_LOG_SEVERITY_ERROR
+			if name := strings.SplitN(line, ":", 2)[0]; strings.HasSuffix(name, "Warning") {
+				current.Severity = pb.LogSeverity_LOG_SEVERITY_WARNING
+			}
+			current.Message = line
+			current.Raw = strings.Join(pending, "\n")
+			diagnostics = append(diagnostics, current)
+			current, pending = nil, nil
+		case codeIndent >= 0 && caretRegexp.MatchString(line):
+			// the code is printed without its indentation, so the column is counted from the first character of the code
+			current.Column = int32(strings.IndexAny(line, "~^") - codeIndent + 1)
+		case codeIndent < 0 && current.Line > 0 && isIndented(line):
+			codeIndent = len(line) - len(strings.TrimLeft(line, " \t"))
+		}
+	}
+	flush()
+	return diagnostics
+}
+
+// isIndented returns true if the line starts with the whitespace
+func isIndented(line string) bool {
+	return line[0] == ' ' || line[0] == '\t'
+}
+
+// atoi returns the number of the string, 0 if the string isn't a number
+func atoi(value string) int32 {
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0
+	}
+	return int32(number)
+}
@@ -0,0 +1,160 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile_diagnostics
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		sdk    pb.Sdk
+		output string
+		want   []*pb.CompileDiagnostic
+	}{
+		{
+			// Test case with the output of javac with the error, the error with details and the summary.
+			// As a result, want diagnostics with columns of carets, details in the message and the summary as the raw line.
+			name: "javac output",
+			sdk:  pb.Sdk_SDK_JAVA,
+			output: "/tmp/playground/src/Main.java:3: error: not a statement\n        broken\n        ^\n" +
+				"/tmp/playground/src/Main.java:4: error: cannot find symbol\n        System.out.println(x);\n                           ^\n  symbol:   variable x\n  location: class Main\n" +
+				"2 errors\n",
+			want: []*pb.CompileDiagnostic{
+				{
+					File: "Main.java", Line: 3, Column: 9, Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "not a statement",
+					Raw: "/tmp/playground/src/Main.java:3: error: not a statement\n        broken\n        ^",
+				},
+				{
+					File: "Main.java", Line: 4, Column: 28, Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "cannot find symbol\nsymbol:   variable x\nlocation: class Main",
+					Raw: "/tmp/playground/src/Main.java:4: error: cannot find symbol\n        System.out.println(x);\n                           ^\n  symbol:   variable x\n  location: class Main",
+				},
+				{Raw: "2 errors"},
+			},
+		},
+		{
+			// Test case with the output of javac with the warning and the note.
+			// As a result, want the diagnostic with the warning severity and the note as the raw line.
+			name:   "javac warning",
+			sdk:    pb.Sdk_SDK_JAVA,
+			output: "Main.java:5: warning: [deprecation] Integer(int) in Integer has been deprecated\n        new Integer(1);\n        ^\nNote: Main.java uses unchecked or unsafe operations.\n",
+			want: []*pb.CompileDiagnostic{
+				{
+					File: "Main.java", Line: 5, Column: 9, Severity: pb.LogSeverity_LOG_SEVERITY_WARNING, Message: "[deprecation] Integer(int) in Integer has been deprecated",
+					Raw: "Main.java:5: warning: [deprecation] Integer(int) in Integer has been deprecated\n        new Integer(1);\n        ^",
+				},
+				{Raw: "Note: Main.java uses unchecked or unsafe operations."},
+			},
+		},
+		{
+			// Test case with the output of go build.
+			// As a result, want diagnostics with lines and columns and the name of the package as the raw line.
+			name:   "go output",
+			sdk:    pb.Sdk_SDK_GO,
+			output: "# command-line-arguments\n./prog.go:8:2: undefined: x\n./prog.go:9:1: missing return\n",
+			want: []*pb.CompileDiagnostic{
+				{Raw: "# command-line-arguments"},
+				{File: "prog.go", Line: 8, Column: 2, Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "undefined: x", Raw: "./prog.go:8:2: undefined: x"},
+				{File: "prog.go", Line: 9, Column: 1, Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "missing return", Raw: "./prog.go:9:1: missing return"},
+			},
+		},
+		{
+			// Test case with the output of sbt with the level prefix.
+			// As a result, want the diagnostic with the severity of the prefix.
+			name:   "sbt output",
+			sdk:    pb.Sdk_SDK_SCIO,
+			output: "[warn] /tmp/playground/src/Main.scala:7:11: match may not be exhaustive.\n",
+			want: []*pb.CompileDiagnostic{
+				{
+					File: "Main.scala", Line: 7, Column: 11, Severity: pb.LogSeverity_LOG_SEVERITY_WARNING, Message: "match may not be exhaustive.",
+					Raw: "[warn] /tmp/playground/src/Main.scala:7:11: match may not be exhaustive.",
+				},
+			},
+		},
+		{
+			// Test case with the syntax error of py_compile.
+			// As a result, want the diagnostic with the position of the error and the line of the error as the message.
+			name:   "python syntax error",
+			sdk:    pb.Sdk_SDK_PYTHON,
+			output: "  File \"/tmp/playground/main.py\", line 2\n    print(\"a\" +)\n               ^\nSyntaxError: invalid syntax\n",
+			want: []*pb.CompileDiagnostic{
+				{
+					File: "main.py", Line: 2, Column: 12, Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "SyntaxError: invalid syntax",
+					Raw: "  File \"/tmp/playground/main.py\", line 2\n    print(\"a\" +)\n               ^\nSyntaxError: invalid syntax",
+				},
+			},
+		},
+		{
+			// Test case with the Python traceback with several locations and the line before it.
+			// As a result, want the diagnostic with the last location and the line before the traceback as the raw line.
+			name: "python traceback",
+			sdk:  pb.Sdk_SDK_PYTHON,
+			output: "Collecting numpy\nTraceback (most recent call last):\n  File \"/tmp/playground/main.py\", line 5, in <module>\n    main()\n" +
+				"  File \"/tmp/playground/main.py\", line 2, in main\n    import missing\nModuleNotFoundError: No module named 'missing'\n",
+			want: []*pb.CompileDiagnostic{
+				{Raw: "Collecting numpy"},
+				{
+					File: "main.py", Line: 2, Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "ModuleNotFoundError: No module named 'missing'",
+					Raw: "Traceback (most recent call last):\n  File \"/tmp/playground/main.py\", line 5, in <module>\n    main()\n" +
+						"  File \"/tmp/playground/main.py\", line 2, in main\n    import missing\nModuleNotFoundError: No module named 'missing'",
+				},
+			},
+		},
+		{
+			// Test case with the Python traceback which isn't finished by the line of the error.
+			// As a result, want lines of the traceback as raw lines.
+			name:   "unfinished python traceback",
+			sdk:    pb.Sdk_SDK_PYTHON,
+			output: "Traceback (most recent call last):\n  File \"main.py\", line 1, in <module>\n",
+			want: []*pb.CompileDiagnostic{
+				{Raw: "Traceback (most recent call last):"},
+				{Raw: "  File \"main.py\", line 1, in <module>"},
+			},
+		},
+		{
+			// Test case with the output without diagnostics and empty lines.
+			// As a result, want lines of the output as raw lines without empty lines.
+			name:   "unparseable output",
+			sdk:    pb.Sdk_SDK_JAVA,
+			output: "MOCK_OUTPUT_1\r\n\nMOCK_OUTPUT_2\n",
+			want:   []*pb.CompileDiagnostic{{Raw: "MOCK_OUTPUT_1"}, {Raw: "MOCK_OUTPUT_2"}},
+		},
+		{
+			// Test case with the empty output.
+			// As a result, want no diagnostics.
+			name:   "empty output",
+			sdk:    pb.Sdk_SDK_GO,
+			output: "",
+			want:   []*pb.CompileDiagnostic{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Parse(tt.sdk, tt.output)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Parse() got %d diagnostics = %v, want %d = %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if !proto.Equal(got[i], tt.want[i]) {
+					t.Errorf("Parse() diagnostic %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
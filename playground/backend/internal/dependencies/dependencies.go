@@ -0,0 +1,74 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencies
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// RequirementsFileName is the name of the file with requirements of the code which is placed in the base folder of the code
+	RequirementsFileName = "requirements.txt"
+	// MaxRequirements is the max number of requirements of the code
+	MaxRequirements  = 20
+	requirementsMode = 0600
+)
+
+// DisallowedPackageError is returned when the code requires the package which isn't in the list of allowed packages
+type DisallowedPackageError struct {
+	Package string
+}
+
+func (e *DisallowedPackageError) Error() string {
+	return fmt.Sprintf("package %s isn't allowed", e.Package)
+}
+
+// WriteRequirements writes requirements of the code to the requirements file in the base folder of the code.
+// If there are no requirements, the file isn't created.
+func WriteRequirements(baseFolderPath string, requirements []string) error {
+	if len(requirements) == 0 {
+		return nil
+	}
+	lines := make([]string, 0, len(requirements))
+	for _, requirement := range requirements {
+		lines = append(lines, strings.TrimSpace(requirement))
+	}
+	return os.WriteFile(filepath.Join(baseFolderPath, RequirementsFileName), []byte(strings.Join(lines, "\n")+"\n"), requirementsMode)
+}
+
+// HasRequirements checks that the requirements file exists in the base folder of the code
+func HasRequirements(baseFolderPath string) bool {
+	_, err := os.Stat(filepath.Join(baseFolderPath, RequirementsFileName))
+	return err == nil
+}
+
+// getFolderSize returns the total size of files in the folder and its subfolders in bytes
+func getFolderSize(folderPath string) (int64, error) {
+	var size int64
+	err := filepath.Walk(folderPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
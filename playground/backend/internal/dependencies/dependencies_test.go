@@ -0,0 +1,185 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencies
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckPyRequirements(t *testing.T) {
+	allowedPackages := []string{"numpy", "python-dateutil", "PyYAML"}
+	tooManyRequirements := make([]string, MaxRequirements+1)
+	for i := range tooManyRequirements {
+		tooManyRequirements[i] = "numpy"
+	}
+	tests := []struct {
+		name         string
+		requirements []string
+		wantErr      bool
+		// wantDisallowed is true if DisallowedPackageError is expected
+		wantDisallowed bool
+	}{
+		{
+			// Test case with requirements of allowed packages with and without versions.
+			// As a result, want to receive no error.
+			name:         "allowed packages",
+			requirements: []string{"numpy==1.21.5", " python-dateutil>=2.8, <3 ", "pyyaml"},
+			wantErr:      false,
+		},
+		{
+			// Test case with the requirement of the allowed package which is named with other separators and case.
+			// As a result, want to receive no error because names of Python packages are normalized.
+			name:         "normalized name of allowed package",
+			requirements: []string{"Python_Dateutil[extra]==2.8.2"},
+			wantErr:      false,
+		},
+		{
+			// Test case with the requirement of the package which isn't allowed.
+			// As a result, want to receive DisallowedPackageError.
+			name:           "disallowed package",
+			requirements:   []string{"numpy", "requests==2.27.1"},
+			wantErr:        true,
+			wantDisallowed: true,
+		},
+		{
+			// Test case with the requirement which is a pip option.
+			// As a result, want to receive an error.
+			name:         "pip option",
+			requirements: []string{"--index-url=https://example.com/simple numpy"},
+			wantErr:      true,
+		},
+		{
+			// Test case with the requirement which is a URL of the package.
+			// As a result, want to receive an error.
+			name:         "url of package",
+			requirements: []string{"numpy @ https://example.com/numpy.whl"},
+			wantErr:      true,
+		},
+		{
+			// Test case with more requirements than MaxRequirements.
+			// As a result, want to receive an error.
+			name:         "too many requirements",
+			requirements: tooManyRequirements,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckPyRequirements(tt.requirements, allowedPackages)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckPyRequirements() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var disallowedErr *DisallowedPackageError
+			if errors.As(err, &disallowedErr) != tt.wantDisallowed {
+				t.Errorf("CheckPyRequirements() error = %v, want DisallowedPackageError: %v", err, tt.wantDisallowed)
+			}
+		})
+	}
+}
+
+func TestWriteRequirements(t *testing.T) {
+	tests := []struct {
+		name         string
+		requirements []string
+		wantFile     bool
+		want         string
+	}{
+		{
+			// Test case with writing requirements of the code.
+			// As a result, want the requirements file with one requirement per line.
+			name:         "requirements",
+			requirements: []string{" numpy==1.21.5", "pandas "},
+			wantFile:     true,
+			want:         "numpy==1.21.5\npandas\n",
+		},
+		{
+			// Test case with writing no requirements.
+			// As a result, want the requirements file not to be created.
+			name:         "no requirements",
+			requirements: nil,
+			wantFile:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseFolderPath := t.TempDir()
+			if err := WriteRequirements(baseFolderPath, tt.requirements); err != nil {
+				t.Fatalf("WriteRequirements() error = %v", err)
+			}
+			if got := HasRequirements(baseFolderPath); got != tt.wantFile {
+				t.Fatalf("HasRequirements() = %v, want %v", got, tt.wantFile)
+			}
+			if !tt.wantFile {
+				return
+			}
+			got, err := os.ReadFile(filepath.Join(baseFolderPath, RequirementsFileName))
+			if err != nil {
+				t.Fatalf("error during read requirements file: %s", err.Error())
+			}
+			if string(got) != tt.want {
+				t.Errorf("requirements file = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckPyVenvSize(t *testing.T) {
+	baseFolderPath := t.TempDir()
+	venvFile := filepath.Join(baseFolderPath, PyVenvFolderName, "lib", "package.py")
+	if err := os.MkdirAll(filepath.Dir(venvFile), 0700); err != nil {
+		t.Fatalf("error during prepare venv folder: %s", err.Error())
+	}
+	if err := os.WriteFile(venvFile, make([]byte, 2*1024*1024), 0600); err != nil {
+		t.Fatalf("error during prepare venv file: %s", err.Error())
+	}
+	tests := []struct {
+		name         string
+		maxSizeBytes int64
+		wantErr      bool
+	}{
+		{
+			// Test case with the virtual environment which is smaller than the limit.
+			// As a result, want to receive no error.
+			name:         "size is less than limit",
+			maxSizeBytes: 3 * 1024 * 1024,
+			wantErr:      false,
+		},
+		{
+			// Test case with the virtual environment which is bigger than the limit.
+			// As a result, want to receive an error.
+			name:         "size exceeds limit",
+			maxSizeBytes: 1024 * 1024,
+			wantErr:      true,
+		},
+		{
+			// Test case with the size which isn't limited.
+			// As a result, want to receive no error.
+			name:         "size isn't limited",
+			maxSizeBytes: 0,
+			wantErr:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckPyVenvSize(baseFolderPath, tt.maxSizeBytes); (err != nil) != tt.wantErr {
+				t.Errorf("CheckPyVenvSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencies
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	// PyVenvFolderName is the name of the folder of the virtual environment with dependencies of Python code
+	PyVenvFolderName = "venv"
+	pyInterpreter    = "python3"
+)
+
+// pyRequirementPattern matches the name of the package with optional extras and version specifiers
+// (e.g. "numpy", "apache-beam[gcp]" or "pandas>=1.3,<1.4"). URLs, paths and pip options aren't matched.
+var pyRequirementPattern = regexp.MustCompile(`^([A-Za-z0-9]|[A-Za-z0-9][A-Za-z0-9._-]*[A-Za-z0-9])(\[[A-Za-z0-9._, -]+\])?\s*((===?|!=|<=|>=|~=|<|>)\s*[A-Za-z0-9.*+!_-]+(\s*,\s*(===?|!=|<=|>=|~=|<|>)\s*[A-Za-z0-9.*+!_-]+)*)?$`)
+
+// pyPackageNameSeparators are runs of characters which are equal in names of Python packages (PEP 503)
+var pyPackageNameSeparators = regexp.MustCompile(`[-_.]+`)
+
+// CheckPyRequirements checks that requirements of Python code are names of allowed packages with optional versions.
+// If the requirement requires the package which isn't allowed, returns DisallowedPackageError.
+func CheckPyRequirements(requirements, allowedPackages []string) error {
+	if len(requirements) > MaxRequirements {
+		return fmt.Errorf("number of requirements is %d, but should be at most %d", len(requirements), MaxRequirements)
+	}
+	allowed := make(map[string]bool, len(allowedPackages))
+	for _, pkg := range allowedPackages {
+		allowed[normalizePyPackageName(pkg)] = true
+	}
+	for _, requirement := range requirements {
+		match := pyRequirementPattern.FindStringSubmatch(strings.TrimSpace(requirement))
+		if match == nil {
+			return fmt.Errorf("requirement %q isn't supported. It should be the name of the package with an optional version, e.g. \"numpy==1.21.5\"", requirement)
+		}
+		if !allowed[normalizePyPackageName(match[1])] {
+			return &DisallowedPackageError{Package: match[1]}
+		}
+	}
+	return nil
+}
+
+// GetPyInstallCmds returns commands which create the virtual environment in the base folder of the code
+// and install requirements from the requirements file into it.
+// The virtual environment has access to packages of the system, so the Beam SDK isn't installed again.
+// Only pre-built packages are installed, so no code of packages is executed during the installation.
+func GetPyInstallCmds(ctx context.Context, baseFolderPath string) []*exec.Cmd {
+	venvCmd := exec.CommandContext(ctx, pyInterpreter, "-m", "venv", "--system-site-packages", PyVenvFolderName)
+	pipCmd := exec.CommandContext(ctx, GetPyVenvInterpreter(baseFolderPath), "-m", "pip", "install",
+		"--no-input", "--disable-pip-version-check", "--no-cache-dir", "--only-binary=:all:", "-r", RequirementsFileName)
+	cmds := []*exec.Cmd{venvCmd, pipCmd}
+	for _, cmd := range cmds {
+		cmd.Dir = baseFolderPath
+	}
+	return cmds
+}
+
+// GetPyVenvInterpreter returns the path of the Python interpreter of the virtual environment in the base folder of the code
+func GetPyVenvInterpreter(baseFolderPath string) string {
+	return filepath.Join(baseFolderPath, PyVenvFolderName, "bin", "python")
+}
+
+// CheckPyVenvSize checks that the virtual environment in the base folder of the code isn't bigger than maxSizeBytes.
+// If maxSizeBytes isn't positive, the size isn't limited.
+func CheckPyVenvSize(baseFolderPath string, maxSizeBytes int64) error {
+	if maxSizeBytes <= 0 {
+		return nil
+	}
+	size, err := getFolderSize(filepath.Join(baseFolderPath, PyVenvFolderName))
+	if err != nil {
+		return err
+	}
+	if size > maxSizeBytes {
+		return fmt.Errorf("size of installed dependencies is %d MB, but should be at most %d MB", size/(1024*1024), maxSizeBytes/(1024*1024))
+	}
+	return nil
+}
+
+// normalizePyPackageName returns the name of the package in lower case with "-" instead of "_" and "." (PEP 503)
+func normalizePyPackageName(name string) string {
+	return pyPackageNameSeparators.ReplaceAllString(strings.ToLower(name), "-")
+}
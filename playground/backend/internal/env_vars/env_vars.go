@@ -0,0 +1,251 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package env_vars
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// FileName is the name of the file with environment variables of the code which is placed in the base folder of the code
+	FileName = "env_vars.json"
+	// MaxEnvVars is the max number of environment variables of the code
+	MaxEnvVars  = 20
+	envVarsMode = 0600
+)
+
+// namePattern matches names of environment variables which are supported by shells (e.g. "KAFKA_BOOTSTRAP_SERVERS")
+var namePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// deniedNames are names of host variables which can't be overridden, since they change which programs and libraries
+// are run by the process or keep credentials of the server
+var deniedNames = map[string]bool{
+	"PATH":                           true,
+	"HOME":                           true,
+	"USER":                           true,
+	"SHELL":                          true,
+	"IFS":                            true,
+	"ENV":                            true,
+	"BASH_ENV":                       true,
+	"TMPDIR":                         true,
+	"PYTHONPATH":                     true,
+	"PYTHONHOME":                     true,
+	"PYTHONSTARTUP":                  true,
+	"PYTHONUSERBASE":                 true,
+	"VIRTUAL_ENV":                    true,
+	"JAVA_HOME":                      true,
+	"CLASSPATH":                      true,
+	"JAVA_TOOL_OPTIONS":              true,
+	"JDK_JAVA_OPTIONS":               true,
+	"_JAVA_OPTIONS":                  true,
+	"GOROOT":                         true,
+	"GOPATH":                         true,
+	"GOFLAGS":                        true,
+	"GOCACHE":                        true,
+	"GOMODCACHE":                     true,
+	"GOOGLE_APPLICATION_CREDENTIALS": true,
+}
+
+// deniedPrefixes are prefixes of names of host variables which can't be overridden (e.g. "LD_PRELOAD")
+var deniedPrefixes = []string{"LD_", "DYLD_", "BASH_FUNC_"}
+
+// secretNames are names of host variables with secrets of the server which are never passed to processes of the code
+var secretNames = map[string]bool{
+	"GOOGLE_APPLICATION_CREDENTIALS": true,
+	"CACHE_USERNAME":                 true,
+	"CACHE_PASSWORD":                 true,
+	"RATE_LIMIT_API_KEYS":            true,
+}
+
+// hostNames are names of host variables which are passed to processes of the code, since programs and libraries
+// of SDKs need them (e.g. to find the runtime or to send requests through the proxy of the container).
+// Other host variables (e.g. credentials of the cache) aren't visible to the code.
+var hostNames = map[string]bool{
+	"PATH":        true,
+	"HOME":        true,
+	"USER":        true,
+	"LOGNAME":     true,
+	"SHELL":       true,
+	"HOSTNAME":    true,
+	"TERM":        true,
+	"LANG":        true,
+	"LANGUAGE":    true,
+	"TZ":          true,
+	"TMPDIR":      true,
+	"HTTP_PROXY":  true,
+	"HTTPS_PROXY": true,
+	"NO_PROXY":    true,
+	"http_proxy":  true,
+	"https_proxy": true,
+	"no_proxy":    true,
+	"JAVA_HOME":   true,
+	"GOROOT":      true,
+	"GOPATH":      true,
+	"GOCACHE":     true,
+	"GOMODCACHE":  true,
+	"GOPROXY":     true,
+	"GO111MODULE": true,
+	"PYTHONPATH":  true,
+	"PYTHONHOME":  true,
+	"VIRTUAL_ENV": true,
+}
+
+// hostPrefixes are prefixes of names of host variables which are passed to processes of the code (e.g. "LC_ALL")
+var hostPrefixes = []string{"LC_"}
+
+// DeniedVariableError is returned when the code sets the environment variable which can't be overridden
+type DeniedVariableError struct {
+	Name string
+}
+
+func (e *DeniedVariableError) Error() string {
+	return fmt.Sprintf("environment variable %s can't be overridden", e.Name)
+}
+
+// IsDenied checks that the environment variable with the name can't be overridden
+func IsDenied(name string) bool {
+	upperName := strings.ToUpper(name)
+	return deniedNames[upperName] || hasDeniedPrefix(upperName)
+}
+
+// hasDeniedPrefix checks that the upper-case name of the environment variable has one of deniedPrefixes
+func hasDeniedPrefix(upperName string) bool {
+	for _, prefix := range deniedPrefixes {
+		if strings.HasPrefix(upperName, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Check checks that names of environment variables are correct and the variables could be overridden.
+// If the variable can't be overridden, returns DeniedVariableError.
+func Check(envVars map[string]string) error {
+	if len(envVars) > MaxEnvVars {
+		return fmt.Errorf("number of environment variables is %d, but should be at most %d", len(envVars), MaxEnvVars)
+	}
+	for _, name := range sortedNames(envVars) {
+		if !namePattern.MatchString(name) {
+			return fmt.Errorf("name %q of the environment variable isn't supported. It should contain only letters, digits and underscores and shouldn't start with a digit", name)
+		}
+		if IsDenied(name) {
+			return &DeniedVariableError{Name: name}
+		}
+	}
+	return nil
+}
+
+// Write writes environment variables of the code to the file in the base folder of the code.
+// If there are no environment variables, the file isn't created.
+func Write(baseFolderPath string, envVars map[string]string) error {
+	if len(envVars) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(envVars)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(baseFolderPath, FileName), data, envVarsMode)
+}
+
+// Read reads environment variables of the code from the file in the base folder of the code.
+// If the code has no environment variables, nil is returned without an error.
+func Read(baseFolderPath string) (map[string]string, error) {
+	data, err := os.ReadFile(filepath.Join(baseFolderPath, FileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var envVars map[string]string
+	err = json.Unmarshal(data, &envVars)
+	return envVars, err
+}
+
+// HostEnviron returns variables of environ (e.g. os.Environ()) which are passed to processes of the code,
+// so the code doesn't receive variables of the server which aren't needed to run it (see hostNames)
+func HostEnviron(environ []string) []string {
+	var result []string
+	for _, envVar := range environ {
+		name := strings.SplitN(envVar, "=", 2)[0]
+		if hostNames[name] || hasHostPrefix(name) {
+			result = append(result, envVar)
+		}
+	}
+	return result
+}
+
+// hasHostPrefix checks that the name of the host variable has one of hostPrefixes
+func hasHostPrefix(name string) bool {
+	for _, prefix := range hostPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Environ returns the environment of the process in the form of os.Environ (e.g. "KEY=value").
+// Variables of envVarsSets are added to environ in the order of sets, so later sets override earlier ones.
+// Variables which can't be overridden (see IsDenied) are skipped, so their values of environ are kept.
+// Variables of environ with secrets of the server (see secretNames) or denied prefixes (e.g. "LD_PRELOAD") are removed.
+func Environ(environ []string, envVarsSets ...map[string]string) []string {
+	result := make([]string, 0, len(environ))
+	positions := make(map[string]int, len(environ))
+	for _, envVar := range environ {
+		name := strings.SplitN(envVar, "=", 2)[0]
+		upperName := strings.ToUpper(name)
+		if secretNames[upperName] || hasDeniedPrefix(upperName) {
+			continue
+		}
+		positions[name] = len(result)
+		result = append(result, envVar)
+	}
+	for _, envVars := range envVarsSets {
+		for _, name := range sortedNames(envVars) {
+			if IsDenied(name) || !namePattern.MatchString(name) {
+				continue
+			}
+			envVar := name + "=" + envVars[name]
+			if i, ok := positions[name]; ok {
+				result[i] = envVar
+				continue
+			}
+			positions[name] = len(result)
+			result = append(result, envVar)
+		}
+	}
+	return result
+}
+
+// sortedNames returns names of environment variables in the alphabetical order
+func sortedNames(envVars map[string]string) []string {
+	names := make([]string, 0, len(envVars))
+	for name := range envVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
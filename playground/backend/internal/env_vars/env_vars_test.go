@@ -0,0 +1,173 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package env_vars
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	tooManyEnvVars := make(map[string]string, MaxEnvVars+1)
+	for i := 0; i <= MaxEnvVars; i++ {
+		tooManyEnvVars[string(rune('A'+i))] = "value"
+	}
+	tests := []struct {
+		name       string
+		envVars    map[string]string
+		wantErr    bool
+		wantDenied string
+	}{
+		{
+			// Test case with environment variables which could be set.
+			// As a result, want to receive no error.
+			name:    "allowed variables",
+			envVars: map[string]string{"KAFKA_BOOTSTRAP_SERVERS": "localhost:9092", "_DEBUG": "1"},
+			wantErr: false,
+		},
+		{
+			// Test case with PATH which can't be overridden.
+			// As a result, want to receive DeniedVariableError.
+			name:       "PATH",
+			envVars:    map[string]string{"PATH": "/tmp"},
+			wantErr:    true,
+			wantDenied: "PATH",
+		},
+		{
+			// Test case with the variable whose prefix is denied.
+			// As a result, want to receive DeniedVariableError.
+			name:       "denied prefix",
+			envVars:    map[string]string{"LD_PRELOAD": "/tmp/lib.so"},
+			wantErr:    true,
+			wantDenied: "LD_PRELOAD",
+		},
+		{
+			// Test case with the denied variable in lower case.
+			// As a result, want to receive DeniedVariableError.
+			name:       "denied variable in lower case",
+			envVars:    map[string]string{"pythonpath": "/tmp"},
+			wantErr:    true,
+			wantDenied: "pythonpath",
+		},
+		{
+			// Test case with the incorrect name of the variable.
+			// As a result, want to receive an error.
+			name:    "incorrect name",
+			envVars: map[string]string{"1KEY=": "value"},
+			wantErr: true,
+		},
+		{
+			// Test case with more variables than MaxEnvVars.
+			// As a result, want to receive an error.
+			name:    "too many variables",
+			envVars: tooManyEnvVars,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Check(tt.envVars)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var deniedErr *DeniedVariableError
+			if isDenied := errors.As(err, &deniedErr); isDenied != (tt.wantDenied != "") || (isDenied && deniedErr.Name != tt.wantDenied) {
+				t.Errorf("Check() error = %v, want denied variable %q", err, tt.wantDenied)
+			}
+		})
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		envVars map[string]string
+	}{
+		{
+			// Test case with writing and reading environment variables.
+			// As a result, want to read written variables.
+			name:    "variables",
+			envVars: map[string]string{"KEY_1": "value 1", "KEY_2": "a=b"},
+		},
+		{
+			// Test case with writing and reading no environment variables.
+			// As a result, want to read nil.
+			name:    "no variables",
+			envVars: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseFolder := t.TempDir()
+			if err := Write(baseFolder, tt.envVars); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			got, err := Read(baseFolder)
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.envVars) {
+				t.Errorf("Read() got = %v, want %v", got, tt.envVars)
+			}
+		})
+	}
+}
+
+func TestHostEnviron(t *testing.T) {
+	environ := []string{"PATH=/usr/bin", "CACHE_PASSWORD=MOCK_PASSWORD", "LC_ALL=C.UTF-8", "HTTPS_PROXY=http://127.0.0.1:8081", "GOOGLE_APPLICATION_CREDENTIALS=/key.json", "LD_PRELOAD=/lib.so"}
+	want := []string{"PATH=/usr/bin", "LC_ALL=C.UTF-8", "HTTPS_PROXY=http://127.0.0.1:8081"}
+	if got := HostEnviron(environ); !reflect.DeepEqual(got, want) {
+		t.Errorf("HostEnviron() got = %v, want %v", got, want)
+	}
+}
+
+func TestEnviron(t *testing.T) {
+	environ := []string{"PATH=/usr/bin", "HOME=/home/user", "KEY=host", "CACHE_PASSWORD=MOCK_PASSWORD", "RATE_LIMIT_API_KEYS=MOCK_KEY", "GOOGLE_APPLICATION_CREDENTIALS=/key.json", "LD_PRELOAD=/lib.so"}
+	tests := []struct {
+		name        string
+		envVarsSets []map[string]string
+		want        []string
+	}{
+		{
+			// Test case with variables which are added and override host variables.
+			// As a result, want to receive the host environment with added and overridden variables.
+			name:        "added and overridden variables",
+			envVarsSets: []map[string]string{{"KEY": "config", "CONFIG_KEY": "config"}, {"KEY": "request", "REQUEST_KEY": "request"}},
+			want:        []string{"PATH=/usr/bin", "HOME=/home/user", "KEY=request", "CONFIG_KEY=config", "REQUEST_KEY=request"},
+		},
+		{
+			// Test case with variables which can't be overridden.
+			// As a result, want to receive the host environment without changes.
+			name:        "denied variables",
+			envVarsSets: []map[string]string{{"PATH": "/tmp", "LD_PRELOAD": "/tmp/lib.so", "home": "/tmp"}},
+			want:        []string{"PATH=/usr/bin", "HOME=/home/user", "KEY=host"},
+		},
+		{
+			// Test case without variables.
+			// As a result, want to receive the host environment.
+			name: "no variables",
+			want: []string{"PATH=/usr/bin", "HOME=/home/user", "KEY=host"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Environ(environ, tt.envVarsSets...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Environ() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
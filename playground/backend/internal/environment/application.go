@@ -44,7 +44,7 @@ func (serverEnvs *NetworkEnvs) Protocol() string {
 
 //CacheEnvs contains all environment variables that needed to use cache
 type CacheEnvs struct {
-	// cacheType is type of cache (local/redis)
+	// cacheType is type of cache (local/remote/noop)
 	cacheType string
 
 	// this is a string with hostname:port of the cache server for redis caches
@@ -52,6 +52,24 @@ type CacheEnvs struct {
 
 	// keyExpirationTime is expiration time for cache keys
 	keyExpirationTime time.Duration
+
+	// idleTimeout is the duration after which finished pipelines which aren't accessed are deleted from cache
+	// before they expire. 0 means that pipelines are kept until they expire.
+	idleTimeout time.Duration
+
+	// lruSize is the max number of values of finished pipelines which are kept in memory of the server
+	// in front of the cache. 0 means that values are always read from the cache.
+	lruSize int
+
+	// lruTtl is the duration during which the value is read from memory of the server instead of the cache
+	lruTtl time.Duration
+
+	// snapshotPath is the file which the local cache is saved to and restored from after restart.
+	// Empty string means that the local cache isn't saved.
+	snapshotPath string
+
+	// fallback is true if operations are served by the local cache while the remote cache is unavailable
+	fallback bool
 }
 
 // CacheType returns cache type
@@ -69,12 +87,188 @@ func (ce *CacheEnvs) KeyExpirationTime() time.Duration {
 	return ce.keyExpirationTime
 }
 
+// IdleTimeout returns the duration after which finished pipelines which aren't accessed are deleted from cache
+func (ce *CacheEnvs) IdleTimeout() time.Duration {
+	return ce.idleTimeout
+}
+
+// LruSize returns the max number of values of finished pipelines which are kept in memory of the server
+func (ce *CacheEnvs) LruSize() int {
+	return ce.lruSize
+}
+
+// LruTtl returns the duration during which the value is read from memory of the server instead of the cache
+func (ce *CacheEnvs) LruTtl() time.Duration {
+	return ce.lruTtl
+}
+
+// SnapshotPath returns the file which the local cache is saved to, empty string if the local cache isn't saved
+func (ce *CacheEnvs) SnapshotPath() string {
+	return ce.snapshotPath
+}
+
+// Fallback returns true if operations are served by the local cache while the remote cache is unavailable
+func (ce *CacheEnvs) Fallback() bool {
+	return ce.fallback
+}
+
 // NewCacheEnvs constructor for CacheEnvs
-func NewCacheEnvs(cacheType, cacheAddress string, cacheExpirationTime time.Duration) *CacheEnvs {
+func NewCacheEnvs(cacheType, cacheAddress string, cacheExpirationTime, idleTimeout time.Duration, lruSize int, lruTtl time.Duration, snapshotPath string, fallback bool) *CacheEnvs {
 	return &CacheEnvs{
 		cacheType:         cacheType,
 		address:           cacheAddress,
 		keyExpirationTime: cacheExpirationTime,
+		idleTimeout:       idleTimeout,
+		lruSize:           lruSize,
+		lruTtl:            lruTtl,
+		snapshotPath:      snapshotPath,
+		fallback:          fallback,
+	}
+}
+
+// QueueEnvs contains all environment variables that needed to limit the number of simultaneous code executions
+type QueueEnvs struct {
+	// maxJobs is the max number of code processing requests which compile and run code at the same time.
+	// 0 means that the number isn't limited.
+	maxJobs int
+
+	// timeout is the max duration of waiting for the free slot to compile and run code
+	timeout time.Duration
+}
+
+// MaxJobs returns the max number of code processing requests which compile and run code at the same time
+func (qe *QueueEnvs) MaxJobs() int {
+	return qe.maxJobs
+}
+
+// Timeout returns the max duration of waiting for the free slot to compile and run code
+func (qe *QueueEnvs) Timeout() time.Duration {
+	return qe.timeout
+}
+
+// NewQueueEnvs constructor for QueueEnvs
+func NewQueueEnvs(maxJobs int, timeout time.Duration) *QueueEnvs {
+	return &QueueEnvs{
+		maxJobs: maxJobs,
+		timeout: timeout,
+	}
+}
+
+// SnippetEnvs contains all environment variables that needed to keep snippets which are shared by users
+type SnippetEnvs struct {
+	// retention is the duration of keeping the snippet since it is saved
+	retention time.Duration
+
+	// maxSize is the max size in bytes of the code and pipeline options of the snippet
+	maxSize int
+}
+
+// Retention returns the duration of keeping the snippet since it is saved
+func (se *SnippetEnvs) Retention() time.Duration {
+	return se.retention
+}
+
+// MaxSize returns the max size in bytes of the code and pipeline options of the snippet
+func (se *SnippetEnvs) MaxSize() int {
+	return se.maxSize
+}
+
+// NewSnippetEnvs constructor for SnippetEnvs
+func NewSnippetEnvs(retention time.Duration, maxSize int) *SnippetEnvs {
+	return &SnippetEnvs{
+		retention: retention,
+		maxSize:   maxSize,
+	}
+}
+
+// RateLimitEnvs contains all environment variables that needed to limit the number of requests of each client
+type RateLimitEnvs struct {
+	// defaultLimit is the max number of requests per minute to methods which don't have their own limits, 0 means not limited
+	defaultLimit int
+
+	// methodLimits contains max numbers of requests per minute by names of methods, 0 means not limited
+	methodLimits map[string]int
+
+	// apiKeys contains API keys which identify clients, requests with other keys are identified by the IP address
+	apiKeys []string
+}
+
+// DefaultLimit returns the max number of requests per minute to methods which don't have their own limits
+func (re *RateLimitEnvs) DefaultLimit() int {
+	return re.defaultLimit
+}
+
+// MethodLimits returns max numbers of requests per minute by names of methods
+func (re *RateLimitEnvs) MethodLimits() map[string]int {
+	return re.methodLimits
+}
+
+// ApiKeys returns API keys which identify clients
+func (re *RateLimitEnvs) ApiKeys() []string {
+	return re.apiKeys
+}
+
+// NewRateLimitEnvs constructor for RateLimitEnvs
+func NewRateLimitEnvs(defaultLimit int, methodLimits map[string]int, apiKeys []string) *RateLimitEnvs {
+	return &RateLimitEnvs{
+		defaultLimit: defaultLimit,
+		methodLimits: methodLimits,
+		apiKeys:      apiKeys,
+	}
+}
+
+// WorkingDirEnvs contains all environment variables that needed to keep working directories of the code for downloading
+type WorkingDirEnvs struct {
+	// retention is the duration of keeping the working directory since the code processing is finished.
+	// 0 means that working directories aren't kept.
+	retention time.Duration
+
+	// excludePatterns are patterns of names of files which aren't downloaded from the working directory (e.g. "*.pem")
+	excludePatterns []string
+}
+
+// Retention returns the duration of keeping the working directory since the code processing is finished
+func (we *WorkingDirEnvs) Retention() time.Duration {
+	return we.retention
+}
+
+// ExcludePatterns returns patterns of names of files which aren't downloaded from the working directory
+func (we *WorkingDirEnvs) ExcludePatterns() []string {
+	return we.excludePatterns
+}
+
+// NewWorkingDirEnvs constructor for WorkingDirEnvs
+func NewWorkingDirEnvs(retention time.Duration, excludePatterns []string) *WorkingDirEnvs {
+	return &WorkingDirEnvs{
+		retention:       retention,
+		excludePatterns: excludePatterns,
+	}
+}
+
+// CodeEnvs contains all environment variables that needed to limit the size of the code which is received
+type CodeEnvs struct {
+	// maxCodeSize is the max size in bytes of all files of the code, 0 means not limited
+	maxCodeSize int
+
+	// maxFileSize is the max size in bytes of each file of the code, 0 means not limited
+	maxFileSize int
+}
+
+// MaxCodeSize returns the max size in bytes of all files of the code
+func (ce *CodeEnvs) MaxCodeSize() int {
+	return ce.maxCodeSize
+}
+
+// MaxFileSize returns the max size in bytes of each file of the code
+func (ce *CodeEnvs) MaxFileSize() int {
+	return ce.maxFileSize
+}
+
+// NewCodeEnvs constructor for CodeEnvs
+func NewCodeEnvs(maxCodeSize, maxFileSize int) *CodeEnvs {
+	return &CodeEnvs{
+		maxCodeSize: maxCodeSize,
+		maxFileSize: maxFileSize,
 	}
 }
 
@@ -91,6 +285,24 @@ type ApplicationEnvs struct {
 	// pipelineExecuteTimeout is timeout for code processing
 	pipelineExecuteTimeout time.Duration
 
+	// shutdownGracePeriod is the max duration of waiting for code processings to finish when the server is shut down
+	shutdownGracePeriod time.Duration
+
+	// queueEnvs contains environment variables for the queue of code executions
+	queueEnvs *QueueEnvs
+
+	// snippetEnvs contains environment variables for snippets which are shared by users
+	snippetEnvs *SnippetEnvs
+
+	// rateLimitEnvs contains environment variables for limits of requests of clients
+	rateLimitEnvs *RateLimitEnvs
+
+	// workingDirEnvs contains environment variables for working directories which are kept after the code processing
+	workingDirEnvs *WorkingDirEnvs
+
+	// codeEnvs contains environment variables for limits of the code which is received
+	codeEnvs *CodeEnvs
+
 	// launchSite is a launch site of application
 	launchSite string
 
@@ -102,11 +314,17 @@ type ApplicationEnvs struct {
 }
 
 // NewApplicationEnvs constructor for ApplicationEnvs
-func NewApplicationEnvs(workingDir, launchSite, projectId, pipelinesFolder string, cacheEnvs *CacheEnvs, pipelineExecuteTimeout time.Duration) *ApplicationEnvs {
+func NewApplicationEnvs(workingDir, launchSite, projectId, pipelinesFolder string, cacheEnvs *CacheEnvs, pipelineExecuteTimeout, shutdownGracePeriod time.Duration, queueEnvs *QueueEnvs, snippetEnvs *SnippetEnvs, rateLimitEnvs *RateLimitEnvs, workingDirEnvs *WorkingDirEnvs, codeEnvs *CodeEnvs) *ApplicationEnvs {
 	return &ApplicationEnvs{
 		workingDir:             workingDir,
 		cacheEnvs:              cacheEnvs,
 		pipelineExecuteTimeout: pipelineExecuteTimeout,
+		shutdownGracePeriod:    shutdownGracePeriod,
+		queueEnvs:              queueEnvs,
+		snippetEnvs:            snippetEnvs,
+		rateLimitEnvs:          rateLimitEnvs,
+		workingDirEnvs:         workingDirEnvs,
+		codeEnvs:               codeEnvs,
 		launchSite:             launchSite,
 		projectId:              projectId,
 		pipelinesFolder:        pipelinesFolder,
@@ -128,6 +346,36 @@ func (ae *ApplicationEnvs) PipelineExecuteTimeout() time.Duration {
 	return ae.pipelineExecuteTimeout
 }
 
+// ShutdownGracePeriod returns the max duration of waiting for code processings to finish when the server is shut down
+func (ae *ApplicationEnvs) ShutdownGracePeriod() time.Duration {
+	return ae.shutdownGracePeriod
+}
+
+// QueueEnvs returns environments of the queue of code executions
+func (ae *ApplicationEnvs) QueueEnvs() *QueueEnvs {
+	return ae.queueEnvs
+}
+
+// SnippetEnvs returns environments of snippets which are shared by users
+func (ae *ApplicationEnvs) SnippetEnvs() *SnippetEnvs {
+	return ae.snippetEnvs
+}
+
+// RateLimitEnvs returns environments of limits of requests of clients
+func (ae *ApplicationEnvs) RateLimitEnvs() *RateLimitEnvs {
+	return ae.rateLimitEnvs
+}
+
+// WorkingDirEnvs returns environments of working directories which are kept after the code processing
+func (ae *ApplicationEnvs) WorkingDirEnvs() *WorkingDirEnvs {
+	return ae.workingDirEnvs
+}
+
+// CodeEnvs returns environments of limits of the code which is received
+func (ae *ApplicationEnvs) CodeEnvs() *CodeEnvs {
+	return ae.codeEnvs
+}
+
 // LaunchSite returns launch site of application
 func (ae *ApplicationEnvs) LaunchSite() string {
 	return ae.launchSite
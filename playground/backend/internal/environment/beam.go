@@ -17,6 +17,19 @@ package environment
 
 import (
 	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// DirectRunner is the runner which is used if the runner of the code isn't set
+	DirectRunner = "direct"
+	// DefaultOutputFlushInterval is the flush interval of the streamed output if it isn't set by the config
+	DefaultOutputFlushInterval = 500 * time.Millisecond
+	// DefaultOutputMaxBufferSize is the max buffer size of the streamed output if it isn't set by the config
+	DefaultOutputMaxBufferSize = 4 * 1024
 )
 
 // ExecutorConfig contains all environment variables needed for compiling and execution of the code commands:
@@ -26,13 +39,86 @@ import (
 // - CompileArgs: arguments which are needed to compile files with code
 // - RunArgs: arguments which are needed to run compiled code
 // - TestArgs: arguments which are needed to run unit test code
+// - DisallowedApis: packages, classes and functions which the code mustn't use (e.g. "java.net" or "os.ReadFile")
+// - SyntaxCheckCmd: command to check syntax of files with code without producing an executable
+// - SyntaxCheckArgs: arguments which are needed to check syntax of files with code
+// - AllowedPackages: packages which the code could require to be installed before it is run (e.g. "numpy")
+// - MaxDependenciesSizeMb: max size of installed dependencies of the code in megabytes, 0 means no limit
+// - GraphArgs: pipeline options which make the compiled code write its graph in DOT format to graph.dot instead of running
+// - StripAnsiCodes: whether ANSI escape sequences (e.g. colors) are removed from the compile and run output and logs
+// - Environment: environment variables which are set for the process of the code (e.g. the address of the Kafka emulator)
+// - CompileRetries: how many times the compile step is retried if it is failed by the toolchain instead of the code
+// - TransientCompileErrors: regular expressions of lines of the compile output which mean that the toolchain failed
+//   instead of the code (e.g. "^error: error while writing"), only such failures are retried
+// - Runners: runners which could be selected instead of the direct runner by their names (e.g. "flink")
+// - WarmPoolSize: how many working directories are prepared in advance for the code processing, 0 means no warm pool
+// - OutputFlushIntervalMs: how often in milliseconds the output which is streamed to the cache is saved
+// - OutputMaxBufferSize: size in bytes of not yet saved streamed output after which it is saved before the flush interval
 type ExecutorConfig struct {
-	CompileCmd  string   `json:"compile_cmd"`
-	RunCmd      string   `json:"run_cmd"`
-	TestCmd     string   `json:"test_cmd"`
-	CompileArgs []string `json:"compile_args"`
-	RunArgs     []string `json:"run_args"`
-	TestArgs    []string `json:"test_args"`
+	CompileCmd             string                  `json:"compile_cmd"`
+	RunCmd                 string                  `json:"run_cmd"`
+	TestCmd                string                  `json:"test_cmd"`
+	CompileArgs            []string                `json:"compile_args"`
+	RunArgs                []string                `json:"run_args"`
+	TestArgs               []string                `json:"test_args"`
+	DisallowedApis         []string                `json:"disallowed_apis"`
+	SyntaxCheckCmd         string                  `json:"syntax_check_cmd"`
+	SyntaxCheckArgs        []string                `json:"syntax_check_args"`
+	AllowedPackages        []string                `json:"allowed_packages"`
+	MaxDependenciesSizeMb  int64                   `json:"max_dependencies_size_mb"`
+	GraphArgs              []string                `json:"graph_args"`
+	StripAnsiCodes         bool                    `json:"strip_ansi_codes"`
+	Environment            map[string]string       `json:"environment"`
+	CompileRetries         int                     `json:"compile_retries"`
+	TransientCompileErrors []string                `json:"transient_compile_errors"`
+	Runners                map[string]RunnerConfig `json:"runners"`
+	WarmPoolSize           int                     `json:"warm_pool_size"`
+	OutputFlushIntervalMs  int                     `json:"output_flush_interval_ms"`
+	OutputMaxBufferSize    int                     `json:"output_max_buffer_size"`
+}
+
+// RunnerConfig contains settings of the runner which the code could be run by:
+// - RunArgs: pipeline options which make the code run by the runner (e.g. "--runner=FlinkRunner")
+// - SubmissionErrors: regular expressions of lines of the run error which mean that the pipeline couldn't be
+//   submitted to the runner (e.g. the runner isn't available) instead of being failed by the code
+type RunnerConfig struct {
+	RunArgs          []string `json:"run_args"`
+	SubmissionErrors []string `json:"submission_errors"`
+}
+
+// CheckRunner checks whether the code could be run by the runner.
+// The empty runner and DirectRunner are always supported, other runners should be in Runners.
+func (config *ExecutorConfig) CheckRunner(runner string) error {
+	if runner == "" || runner == DirectRunner {
+		return nil
+	}
+	if _, ok := config.Runners[runner]; ok {
+		return nil
+	}
+	runners := make([]string, 0, len(config.Runners))
+	for name := range config.Runners {
+		runners = append(runners, name)
+	}
+	sort.Strings(runners)
+	return fmt.Errorf("runner %q isn't supported, supported runners: %s", runner, strings.Join(append([]string{DirectRunner}, runners...), ", "))
+}
+
+// OutputFlushInterval returns how often the streamed output (e.g. the run output or logs) is saved to the cache.
+// If OutputFlushIntervalMs isn't positive, returns DefaultOutputFlushInterval.
+func (config *ExecutorConfig) OutputFlushInterval() time.Duration {
+	if config.OutputFlushIntervalMs <= 0 {
+		return DefaultOutputFlushInterval
+	}
+	return time.Duration(config.OutputFlushIntervalMs) * time.Millisecond
+}
+
+// OutputBufferSize returns the size of not yet saved streamed output after which the output is saved to the cache
+// without waiting for the flush interval. If OutputMaxBufferSize isn't positive, returns DefaultOutputMaxBufferSize.
+func (config *ExecutorConfig) OutputBufferSize() int {
+	if config.OutputMaxBufferSize <= 0 {
+		return DefaultOutputMaxBufferSize
+	}
+	return config.OutputMaxBufferSize
 }
 
 // NewExecutorConfig creates and returns ExecutorConfig
@@ -40,17 +126,38 @@ func NewExecutorConfig(compileCmd, runCmd, testCmd string, compileArgs, runArgs,
 	return &ExecutorConfig{CompileCmd: compileCmd, RunCmd: runCmd, TestCmd: testCmd, CompileArgs: compileArgs, RunArgs: runArgs, TestArgs: testArgs}
 }
 
+// ResourceLimits contains limits of resources which are available to the run process of the code:
+// - MemoryBytes: max size of the virtual memory of the process in bytes
+// - CpuTime: max CPU time of the process
+// - DiskBytes: max size in bytes of files which the process writes to the working directory of the pipeline
+// - OutputLines: max number of lines of the run output and the run error which are kept
+// - OutputBytes: max size in bytes of the run output and the run error which are kept
+// - KillOnOutputLimit: if true, the process is killed when its output exceeds OutputLines or OutputBytes,
+//   otherwise the process continues and the rest of its output is discarded
+// Zero value of a limit means that the resource isn't limited.
+type ResourceLimits struct {
+	MemoryBytes       int64
+	CpuTime           time.Duration
+	DiskBytes         int64
+	OutputLines       int
+	OutputBytes       int64
+	KillOnOutputLimit bool
+}
+
 // BeamEnvs contains all environments related of ApacheBeam. These will use to run pipelines
 type BeamEnvs struct {
 	ApacheBeamSdk     pb.Sdk
 	ExecutorConfig    *ExecutorConfig
 	preparedModDir    string
 	numOfParallelJobs int
+	compileTimeout    time.Duration
+	runTimeout        time.Duration
+	resourceLimits    ResourceLimits
 }
 
 // NewBeamEnvs is a BeamEnvs constructor
-func NewBeamEnvs(apacheBeamSdk pb.Sdk, executorConfig *ExecutorConfig, preparedModDir string, numOfParallelJobs int) *BeamEnvs {
-	return &BeamEnvs{ApacheBeamSdk: apacheBeamSdk, ExecutorConfig: executorConfig, preparedModDir: preparedModDir, numOfParallelJobs: numOfParallelJobs}
+func NewBeamEnvs(apacheBeamSdk pb.Sdk, executorConfig *ExecutorConfig, preparedModDir string, numOfParallelJobs int, compileTimeout, runTimeout time.Duration, resourceLimits ResourceLimits) *BeamEnvs {
+	return &BeamEnvs{ApacheBeamSdk: apacheBeamSdk, ExecutorConfig: executorConfig, preparedModDir: preparedModDir, numOfParallelJobs: numOfParallelJobs, compileTimeout: compileTimeout, runTimeout: runTimeout, resourceLimits: resourceLimits}
 }
 
 // PreparedModDir returns the path to the directory where prepared go.mod and go.sum are located
@@ -62,3 +169,20 @@ func (b *BeamEnvs) PreparedModDir() string {
 func (b *BeamEnvs) NumOfParallelJobs() int {
 	return b.numOfParallelJobs
 }
+
+// CompileTimeout returns the max duration of the compile step of the SDK.
+// If it is zero, the compile step is limited only by the pipeline execution timeout.
+func (b *BeamEnvs) CompileTimeout() time.Duration {
+	return b.compileTimeout
+}
+
+// RunTimeout returns the max duration of the run step of the SDK.
+// If it is zero, the run step is limited only by the pipeline execution timeout.
+func (b *BeamEnvs) RunTimeout() time.Duration {
+	return b.runTimeout
+}
+
+// ResourceLimits returns limits of resources of the run process of the code
+func (b *BeamEnvs) ResourceLimits() ResourceLimits {
+	return b.resourceLimits
+}
@@ -18,6 +18,7 @@ package environment
 import (
 	playground "beam.apache.org/playground/backend/internal/api/v1"
 	"testing"
+	"time"
 )
 
 func TestBeamEnvs_PreparedModDir(t *testing.T) {
@@ -55,3 +56,97 @@ func TestBeamEnvs_PreparedModDir(t *testing.T) {
 		})
 	}
 }
+
+func TestExecutorConfig_CheckRunner(t *testing.T) {
+	config := &ExecutorConfig{Runners: map[string]RunnerConfig{"flink": {RunArgs: []string{"--runner=FlinkRunner"}}}}
+	tests := []struct {
+		name    string
+		config  *ExecutorConfig
+		runner  string
+		wantErr bool
+	}{
+		{
+			// Test case with the runner which isn't set.
+			// As a result, want to receive no error since the direct runner is used.
+			name:    "empty runner",
+			config:  &ExecutorConfig{},
+			runner:  "",
+			wantErr: false,
+		},
+		{
+			// Test case with the direct runner which isn't configured.
+			// As a result, want to receive no error since the direct runner is always supported.
+			name:    "direct runner",
+			config:  &ExecutorConfig{},
+			runner:  DirectRunner,
+			wantErr: false,
+		},
+		{
+			// Test case with the runner of the config.
+			// As a result, want to receive no error.
+			name:    "configured runner",
+			config:  config,
+			runner:  "flink",
+			wantErr: false,
+		},
+		{
+			// Test case with the runner which isn't in the config.
+			// As a result, want to receive an error.
+			name:    "unknown runner",
+			config:  config,
+			runner:  "spark",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.CheckRunner(tt.runner); (err != nil) != tt.wantErr {
+				t.Errorf("CheckRunner() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExecutorConfig_OutputBuffering(t *testing.T) {
+	tests := []struct {
+		name              string
+		config            *ExecutorConfig
+		wantInterval      time.Duration
+		wantMaxBufferSize int
+	}{
+		{
+			// Test case with the config which doesn't set output buffering.
+			// As a result, want to receive default values.
+			name:              "not set",
+			config:            &ExecutorConfig{},
+			wantInterval:      DefaultOutputFlushInterval,
+			wantMaxBufferSize: DefaultOutputMaxBufferSize,
+		},
+		{
+			// Test case with the config which sets output buffering.
+			// As a result, want to receive values of the config.
+			name:              "set",
+			config:            &ExecutorConfig{OutputFlushIntervalMs: 100, OutputMaxBufferSize: 1024},
+			wantInterval:      100 * time.Millisecond,
+			wantMaxBufferSize: 1024,
+		},
+		{
+			// Test case with the config which sets negative values.
+			// As a result, want to receive default values.
+			name:              "negative values",
+			config:            &ExecutorConfig{OutputFlushIntervalMs: -1, OutputMaxBufferSize: -1},
+			wantInterval:      DefaultOutputFlushInterval,
+			wantMaxBufferSize: DefaultOutputMaxBufferSize,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.OutputFlushInterval(); got != tt.wantInterval {
+				t.Errorf("OutputFlushInterval() = %v, want %v", got, tt.wantInterval)
+			}
+			if got := tt.config.OutputBufferSize(); got != tt.wantMaxBufferSize {
+				t.Errorf("OutputBufferSize() = %v, want %v", got, tt.wantMaxBufferSize)
+			}
+		})
+	}
+}
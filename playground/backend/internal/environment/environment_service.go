@@ -17,6 +17,7 @@ package environment
 
 import (
 	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/env_vars"
 	"beam.apache.org/playground/backend/internal/logger"
 	"encoding/json"
 	"errors"
@@ -25,6 +26,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -37,15 +39,40 @@ const (
 	workingDirKey                 = "APP_WORK_DIR"
 	preparedModDirKey             = "PREPARED_MOD_DIR"
 	numOfParallelJobsKey          = "NUM_PARALLEL_JOBS"
+	compileTimeoutKey             = "COMPILE_TIMEOUT"
+	runTimeoutKey                 = "RUN_TIMEOUT"
+	memoryLimitKey                = "MEMORY_LIMIT_MB"
+	cpuTimeLimitKey               = "CPU_TIME_LIMIT"
+	diskQuotaKey                  = "DISK_QUOTA_MB"
+	maxOutputLinesKey             = "MAX_OUTPUT_LINES"
+	maxOutputSizeKey              = "MAX_OUTPUT_MB"
+	killOnOutputLimitKey          = "KILL_ON_OUTPUT_LIMIT"
 	cacheTypeKey                  = "CACHE_TYPE"
 	cacheAddressKey               = "CACHE_ADDRESS"
 	beamPathKey                   = "BEAM_PATH"
 	cacheKeyExpirationTimeKey     = "KEY_EXPIRATION_TIME"
+	cacheIdleTimeoutKey           = "PIPELINE_IDLE_TIMEOUT"
+	cacheLruSizeKey               = "CACHE_LRU_SIZE"
+	cacheLruTtlKey                = "CACHE_LRU_TTL"
+	cacheSnapshotPathKey          = "CACHE_SNAPSHOT_PATH"
+	cacheFallbackKey              = "CACHE_FALLBACK"
 	pipelineExecuteTimeoutKey     = "PIPELINE_EXPIRATION_TIMEOUT"
+	maxConcurrentJobsKey          = "MAX_CONCURRENT_JOBS"
+	queueTimeoutKey               = "QUEUE_TIMEOUT"
+	snippetRetentionKey           = "SNIPPET_RETENTION"
+	snippetMaxSizeKey             = "SNIPPET_MAX_SIZE_KB"
+	rateLimitKey                  = "RATE_LIMIT"
+	rateLimitsByMethodKey         = "RATE_LIMITS_BY_METHOD"
+	rateLimitApiKeysKey           = "RATE_LIMIT_API_KEYS"
 	protocolTypeKey               = "PROTOCOL_TYPE"
 	launchSiteKey                 = "LAUNCH_SITE"
 	projectIdKey                  = "GOOGLE_CLOUD_PROJECT"
 	pipelinesFolderKey            = "PIPELINES_FOLDER_NAME"
+	shutdownGracePeriodKey        = "SHUTDOWN_GRACE_PERIOD"
+	workingDirRetentionKey        = "WORKING_DIR_RETENTION"
+	workingDirExcludeKey          = "WORKING_DIR_EXCLUDE"
+	maxCodeSizeKey                = "MAX_CODE_SIZE_KB"
+	maxFileSizeKey                = "MAX_FILE_SIZE_KB"
 	defaultPipelinesFolder        = "executable_files"
 	defaultLaunchSite             = "local"
 	defaultProtocol               = "HTTP"
@@ -56,12 +83,37 @@ const (
 	defaultCacheType              = "local"
 	defaultCacheAddress           = "localhost:6379"
 	defaultCacheKeyExpirationTime = time.Minute * 15
+	defaultCacheLruTtl            = time.Second * 10
 	defaultPipelineExecuteTimeout = time.Minute * 10
+	defaultQueueTimeout           = time.Minute
+	defaultSnippetRetention       = time.Hour * 24 * 90
+	defaultSnippetMaxSizeKb       = 512
+	defaultShutdownGracePeriod    = time.Second * 20
+	defaultWorkingDirRetention    = time.Hour
+	defaultMaxCodeSizeKb          = 1024
+	defaultMaxFileSizeKb          = 512
 	jsonExt                       = ".json"
 	configFolderName              = "configs"
 	defaultNumOfParallelJobs      = 20
 )
 
+// stepTimeouts are timeouts of the compile and run steps of the code processing
+type stepTimeouts struct {
+	compile time.Duration
+	run     time.Duration
+}
+
+// defaultStepTimeouts are timeouts of steps of each SDK which are used if os environment variables don't contain them.
+// Python examples import slow modules at start and aren't compiled, only their dependencies are installed at the compile step.
+// Go examples are compiled and run fast.
+// The Scala compiler and the JVM of scio examples start slowly, so scio steps have the longest timeouts.
+var defaultStepTimeouts = map[pb.Sdk]stepTimeouts{
+	pb.Sdk_SDK_JAVA:   {compile: time.Minute * 2, run: time.Minute * 5},
+	pb.Sdk_SDK_GO:     {compile: time.Minute * 2, run: time.Minute * 2},
+	pb.Sdk_SDK_PYTHON: {compile: time.Minute * 3, run: time.Minute * 8},
+	pb.Sdk_SDK_SCIO:   {compile: time.Minute * 5, run: time.Minute * 8},
+}
+
 // Environment operates with environment structures: NetworkEnvs, BeamEnvs, ApplicationEnvs
 // Environment contains all environment variables which are used by the application
 type Environment struct {
@@ -92,6 +144,17 @@ func NewEnvironment(networkEnvs NetworkEnvs, beamEnvs BeamEnvs, appEnvs Applicat
 //	- cache expiration time: 15 minutes
 //	- type of cache: local
 //	- cache address: localhost:6379
+//	- pipeline idle timeout: pipelines are kept until they expire
+//	- size of LRU in front of the cache: values are always read from the cache
+//	- TTL of values in LRU: 10 seconds
+//	- snapshot of the local cache: the local cache isn't saved
+//	- fallback to the local cache: disabled
+//	- max number of concurrent jobs: not limited
+//	- queue timeout: 1 minute
+//	- snippet retention: 90 days
+//	- max size of the snippet: 512 KB
+//	- rate limits of requests: not limited
+//	- shutdown grace period: 20 seconds
 // If os environment variables don't contain a value for app working dir - returns error.
 func GetApplicationEnvsFromOsEnvs() (*ApplicationEnvs, error) {
 	pipelineExecuteTimeout := defaultPipelineExecuteTimeout
@@ -117,8 +180,59 @@ func GetApplicationEnvsFromOsEnvs() (*ApplicationEnvs, error) {
 		}
 	}
 
+	cacheIdleTimeout := getTimeoutEnv(cacheIdleTimeoutKey, 0)
+	if cacheIdleTimeout >= cacheExpirationTime {
+		log.Printf("pipeline idle timeout should be shorter than cache expiration time. Pipelines will be kept until they expire\n")
+		cacheIdleTimeout = 0
+	}
+	cacheLruSize := getCountLimitEnv(cacheLruSizeKey)
+	cacheLruTtl := getTimeoutEnv(cacheLruTtlKey, defaultCacheLruTtl)
+
+	maxConcurrentJobs := 0
+	if value, present := os.LookupEnv(maxConcurrentJobsKey); present {
+		if converted, err := strconv.Atoi(value); err == nil && converted >= 0 {
+			maxConcurrentJobs = converted
+		} else {
+			log.Printf("couldn't convert provided max number of concurrent jobs. Number of jobs won't be limited\n")
+		}
+	}
+	queueTimeout := getTimeoutEnv(queueTimeoutKey, defaultQueueTimeout)
+	queueEnvs := NewQueueEnvs(maxConcurrentJobs, queueTimeout)
+
+	snippetRetention := getTimeoutEnv(snippetRetentionKey, defaultSnippetRetention)
+	if snippetRetention == 0 {
+		log.Printf("snippet retention should be positive. Using default %s\n", defaultSnippetRetention)
+		snippetRetention = defaultSnippetRetention
+	}
+	snippetMaxSizeKb := defaultSnippetMaxSizeKb
+	if value, present := os.LookupEnv(snippetMaxSizeKey); present {
+		if converted, err := strconv.Atoi(value); err == nil && converted > 0 {
+			snippetMaxSizeKb = converted
+		} else {
+			log.Printf("couldn't convert provided max size of the snippet. Using default %d KB\n", defaultSnippetMaxSizeKb)
+		}
+	}
+	snippetEnvs := NewSnippetEnvs(snippetRetention, snippetMaxSizeKb*1024)
+
+	rateLimit := 0
+	if value, present := os.LookupEnv(rateLimitKey); present {
+		if converted, err := strconv.Atoi(value); err == nil && converted >= 0 {
+			rateLimit = converted
+		} else {
+			log.Printf("couldn't convert provided rate limit. Requests won't be limited by default\n")
+		}
+	}
+	rateLimitEnvs := NewRateLimitEnvs(rateLimit, getRateLimitsByMethodEnv(rateLimitsByMethodKey), getApiKeysEnv(rateLimitApiKeysKey))
+
+	shutdownGracePeriod := getTimeoutEnv(shutdownGracePeriodKey, defaultShutdownGracePeriod)
+
+	workingDirRetention := getTimeoutEnv(workingDirRetentionKey, defaultWorkingDirRetention)
+	workingDirEnvs := NewWorkingDirEnvs(workingDirRetention, getExcludePatternsEnv(workingDirExcludeKey))
+
+	codeEnvs := NewCodeEnvs(getKbSizeEnv(maxCodeSizeKey, defaultMaxCodeSizeKb), getKbSizeEnv(maxFileSizeKey, defaultMaxFileSizeKb))
+
 	if value, present := os.LookupEnv(workingDirKey); present {
-		return NewApplicationEnvs(value, launchSite, projectId, pipelinesFolder, NewCacheEnvs(cacheType, cacheAddress, cacheExpirationTime), pipelineExecuteTimeout), nil
+		return NewApplicationEnvs(value, launchSite, projectId, pipelinesFolder, NewCacheEnvs(cacheType, cacheAddress, cacheExpirationTime, cacheIdleTimeout, cacheLruSize, cacheLruTtl, os.Getenv(cacheSnapshotPathKey), getBoolEnv(cacheFallbackKey)), pipelineExecuteTimeout, shutdownGracePeriod, queueEnvs, snippetEnvs, rateLimitEnvs, workingDirEnvs, codeEnvs), nil
 	}
 	return nil, errors.New("APP_WORK_DIR env should be provided with os.env")
 }
@@ -188,7 +302,155 @@ func ConfigureBeamEnvs(workDir string) (*BeamEnvs, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewBeamEnvs(sdk, executorConfig, preparedModDir, numOfParallelJobs), nil
+	timeouts := defaultStepTimeouts[sdk]
+	compileTimeout := getTimeoutEnv(compileTimeoutKey, timeouts.compile)
+	runTimeout := getTimeoutEnv(runTimeoutKey, timeouts.run)
+	resourceLimits := ResourceLimits{
+		MemoryBytes:       getSizeLimitEnv(memoryLimitKey),
+		CpuTime:           getTimeoutEnv(cpuTimeLimitKey, 0),
+		DiskBytes:         getSizeLimitEnv(diskQuotaKey),
+		OutputLines:       getCountLimitEnv(maxOutputLinesKey),
+		OutputBytes:       getSizeLimitEnv(maxOutputSizeKey),
+		KillOnOutputLimit: getBoolEnv(killOnOutputLimitKey),
+	}
+	return NewBeamEnvs(sdk, executorConfig, preparedModDir, numOfParallelJobs, compileTimeout, runTimeout, resourceLimits), nil
+}
+
+// getSizeLimitEnv returns a memory, disk or output limit in bytes from an environment variable which keeps it in megabytes.
+// If the environment variable doesn't exist or its value isn't a non-negative integer, returns 0 which means no limit.
+func getSizeLimitEnv(key string) int64 {
+	value, present := os.LookupEnv(key)
+	if !present {
+		return 0
+	}
+	megabytes, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || megabytes < 0 {
+		logger.Errorf("Incorrect value for %s. Should be a non-negative integer. It won't be limited", key)
+		return 0
+	}
+	return megabytes * 1024 * 1024
+}
+
+// getCountLimitEnv returns a limit of the number of items (e.g. lines) from an environment variable.
+// If the environment variable doesn't exist or its value isn't a non-negative integer, returns 0 which means no limit.
+func getCountLimitEnv(key string) int {
+	value, present := os.LookupEnv(key)
+	if !present {
+		return 0
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 0 {
+		logger.Errorf("Incorrect value for %s. Should be a non-negative integer. It won't be limited", key)
+		return 0
+	}
+	return limit
+}
+
+// getBoolEnv returns a flag from an environment variable.
+// If the environment variable doesn't exist or its value isn't a boolean value, returns false.
+func getBoolEnv(key string) bool {
+	value, present := os.LookupEnv(key)
+	if !present {
+		return false
+	}
+	flag, err := strconv.ParseBool(value)
+	if err != nil {
+		logger.Errorf("Incorrect value for %s. Should be true or false. Will be used default value: false", key)
+		return false
+	}
+	return flag
+}
+
+// getRateLimitsByMethodEnv returns limits of methods from an environment variable like "RunCode=10,CheckStatus=600".
+// Entries which aren't a name of the method with a non-negative integer limit are logged and skipped.
+func getRateLimitsByMethodEnv(key string) map[string]int {
+	limits := make(map[string]int)
+	value, present := os.LookupEnv(key)
+	if !present || value == "" {
+		return limits
+	}
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			logger.Errorf("Incorrect entry %q of %s. Should be like Method=limit. It will be skipped", entry, key)
+			continue
+		}
+		limit, err := strconv.Atoi(parts[1])
+		if err != nil || limit < 0 {
+			logger.Errorf("Incorrect limit of %s in %s. Should be a non-negative integer. It will be skipped", parts[0], key)
+			continue
+		}
+		limits[parts[0]] = limit
+	}
+	return limits
+}
+
+// getKbSizeEnv returns a size in bytes from an environment variable which keeps it in kilobytes or default value in kilobytes.
+// If the value of the environment variable isn't a non-negative integer, logs an error and returns default value.
+// 0 means that the size isn't limited.
+func getKbSizeEnv(key string, defaultValueKb int) int {
+	value, present := os.LookupEnv(key)
+	if !present {
+		return defaultValueKb * 1024
+	}
+	kilobytes, err := strconv.Atoi(value)
+	if err != nil || kilobytes < 0 {
+		logger.Errorf("Incorrect value for %s. Should be a non-negative integer. Will be used default value: %d KB", key, defaultValueKb)
+		return defaultValueKb * 1024
+	}
+	return kilobytes * 1024
+}
+
+// getApiKeysEnv returns comma-separated API keys from an environment variable
+func getApiKeysEnv(key string) []string {
+	value, present := os.LookupEnv(key)
+	if !present || value == "" {
+		return nil
+	}
+	var apiKeys []string
+	for _, apiKey := range strings.Split(value, ",") {
+		if apiKey = strings.TrimSpace(apiKey); apiKey != "" {
+			apiKeys = append(apiKeys, apiKey)
+		}
+	}
+	return apiKeys
+}
+
+// getExcludePatternsEnv returns comma-separated patterns of names of files from an environment variable.
+// Incorrect patterns (see filepath.Match) are logged and skipped.
+func getExcludePatternsEnv(key string) []string {
+	value, present := os.LookupEnv(key)
+	if !present || value == "" {
+		return nil
+	}
+	var patterns []string
+	for _, pattern := range strings.Split(value, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			logger.Errorf("Incorrect pattern %q of %s. It will be skipped", pattern, key)
+			continue
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns
+}
+
+// getTimeoutEnv returns a timeout from an environment variable or default value.
+// If the value of the environment variable isn't a non-negative duration, logs an error and returns default value.
+func getTimeoutEnv(key string, defaultValue time.Duration) time.Duration {
+	value, present := os.LookupEnv(key)
+	if !present {
+		return defaultValue
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout < 0 {
+		logger.Errorf("Incorrect value for %s. Should be a non-negative duration. Will be used default value: %s", key, defaultValue)
+		return defaultValue
+	}
+	return timeout
 }
 
 // createExecutorConfig creates ExecutorConfig that corresponds to specific Apache Beam SDK.
@@ -198,21 +460,41 @@ func createExecutorConfig(apacheBeamSdk pb.Sdk, configPath string) (*ExecutorCon
 	if err != nil {
 		return nil, err
 	}
+	if err = env_vars.Check(executorConfig.Environment); err != nil {
+		return nil, fmt.Errorf("incorrect environment variables in the config file: %s", err.Error())
+	}
+	for _, transientError := range executorConfig.TransientCompileErrors {
+		if _, err = regexp.Compile(transientError); err != nil {
+			return nil, fmt.Errorf("incorrect transient compile error %q in the config file: %s", transientError, err.Error())
+		}
+	}
+	for runner, runnerConfig := range executorConfig.Runners {
+		if runner == "" || runner == DirectRunner {
+			return nil, fmt.Errorf("incorrect runner %q in the config file: the direct runner doesn't need to be configured", runner)
+		}
+		for _, submissionError := range runnerConfig.SubmissionErrors {
+			if _, err = regexp.Compile(submissionError); err != nil {
+				return nil, fmt.Errorf("incorrect submission error %q of the runner %s in the config file: %s", submissionError, runner, err.Error())
+			}
+		}
+	}
 	switch apacheBeamSdk {
-	case pb.Sdk_SDK_JAVA:
+	case pb.Sdk_SDK_JAVA, pb.Sdk_SDK_SCIO:
+		// scio is a Scala API of Apache Beam, so scio code uses the same jars as Java code
 		args, err := ConcatBeamJarsToString()
 		if err != nil {
 			return nil, fmt.Errorf("error during proccessing jars: %s", err.Error())
 		}
 		executorConfig.CompileArgs = append(executorConfig.CompileArgs, args)
+		if len(executorConfig.SyntaxCheckArgs) != 0 {
+			executorConfig.SyntaxCheckArgs = append(executorConfig.SyntaxCheckArgs, args)
+		}
 		executorConfig.RunArgs[1] = fmt.Sprintf("%s%s", executorConfig.RunArgs[1], args)
 		executorConfig.TestArgs[1] = fmt.Sprintf("%s%s", executorConfig.TestArgs[1], args)
 	case pb.Sdk_SDK_GO:
 		// Go sdk doesn't need any additional arguments from the config file
 	case pb.Sdk_SDK_PYTHON:
 		// Python sdk doesn't need any additional arguments from the config file
-	case pb.Sdk_SDK_SCIO:
-		return nil, errors.New("not yet supported")
 	}
 	return executorConfig, nil
 }
@@ -23,14 +23,17 @@ import (
 	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 )
 
 const (
 	javaConfig       = "{\n  \"compile_cmd\": \"javac\",\n  \"run_cmd\": \"java\",\n  \"test_cmd\": \"java\",\n  \"compile_args\": [\n    \"-d\",\n    \"bin\",\n    \"-classpath\"\n  ],\n  \"run_args\": [\n    \"-cp\",\n    \"bin:\"\n  ],\n  \"test_args\": [\n    \"-cp\",\n    \"bin:\",\n    \"org.junit.runner.JUnitCore\"\n  ]\n}"
+	scioConfig       = "{\n  \"compile_cmd\": \"scalac\",\n  \"run_cmd\": \"scala\",\n  \"test_cmd\": \"scala\",\n  \"compile_args\": [\n    \"-d\",\n    \"bin\",\n    \"-classpath\"\n  ],\n  \"run_args\": [\n    \"-cp\",\n    \"bin:\"\n  ],\n  \"test_args\": [\n    \"-cp\",\n    \"bin:\",\n    \"org.scalatest.run\"\n  ]\n}"
 	defaultProjectId = ""
 )
 
 var executorConfig *ExecutorConfig
+var scioExecutorConfig *ExecutorConfig
 
 func TestMain(m *testing.M) {
 	err := setup()
@@ -51,6 +54,10 @@ func setup() error {
 	if err != nil {
 		return err
 	}
+	err = os.WriteFile(filepath.Join(configFolderName, playground.Sdk_SDK_SCIO.String()+jsonExt), []byte(scioConfig), 0600)
+	if err != nil {
+		return err
+	}
 	os.Clearenv()
 
 	jars, err := ConcatBeamJarsToString()
@@ -63,6 +70,12 @@ func setup() error {
 		[]string{"-cp", "bin:" + jars},
 		[]string{"-cp", "bin:" + jars, "org.junit.runner.JUnitCore"},
 	)
+	scioExecutorConfig = NewExecutorConfig(
+		"scalac", "scala", "scala",
+		[]string{"-d", "bin", "-classpath", jars},
+		[]string{"-cp", "bin:" + jars},
+		[]string{"-cp", "bin:" + jars, "org.scalatest.run"},
+	)
 	return nil
 }
 
@@ -92,16 +105,16 @@ func TestNewEnvironment(t *testing.T) {
 	}{
 		{name: "create env service with default envs", want: &Environment{
 			NetworkEnvs:     *NewNetworkEnvs(defaultIp, defaultPort, defaultProtocol),
-			BeamSdkEnvs:     *NewBeamEnvs(defaultSdk, executorConfig, preparedModDir, 0),
-			ApplicationEnvs: *NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime}, defaultPipelineExecuteTimeout),
+			BeamSdkEnvs:     *NewBeamEnvs(defaultSdk, executorConfig, preparedModDir, 0, 0, 0, ResourceLimits{}),
+			ApplicationEnvs: *NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
 		}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if got := NewEnvironment(
 				*NewNetworkEnvs(defaultIp, defaultPort, defaultProtocol),
-				*NewBeamEnvs(defaultSdk, executorConfig, preparedModDir, 0),
-				*NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime}, defaultPipelineExecuteTimeout)); !reflect.DeepEqual(got, tt.want) {
+				*NewBeamEnvs(defaultSdk, executorConfig, preparedModDir, 0, 0, 0, ResourceLimits{}),
+				*NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024})); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewEnvironment() = %v, want %v", got, tt.want)
 			}
 		})
@@ -125,16 +138,40 @@ func Test_getSdkEnvsFromOsEnvs(t *testing.T) {
 		},
 		{
 			name:      "default beam envs",
-			want:      NewBeamEnvs(defaultSdk, executorConfig, preparedModDir, defaultNumOfParallelJobs),
+			want:      NewBeamEnvs(defaultSdk, executorConfig, preparedModDir, defaultNumOfParallelJobs, defaultStepTimeouts[defaultSdk].compile, defaultStepTimeouts[defaultSdk].run, ResourceLimits{}),
 			envsToSet: map[string]string{beamSdkKey: "SDK_JAVA"},
 			wantErr:   false,
 		},
 		{
 			name:      "specific sdk key in os envs",
-			want:      NewBeamEnvs(defaultSdk, executorConfig, preparedModDir, defaultNumOfParallelJobs),
+			want:      NewBeamEnvs(defaultSdk, executorConfig, preparedModDir, defaultNumOfParallelJobs, defaultStepTimeouts[defaultSdk].compile, defaultStepTimeouts[defaultSdk].run, ResourceLimits{}),
 			envsToSet: map[string]string{beamSdkKey: "SDK_JAVA"},
 			wantErr:   false,
 		},
+		{
+			name:      "step timeouts in os envs",
+			want:      NewBeamEnvs(defaultSdk, executorConfig, preparedModDir, defaultNumOfParallelJobs, 30*time.Second, time.Minute, ResourceLimits{}),
+			envsToSet: map[string]string{beamSdkKey: "SDK_JAVA", compileTimeoutKey: "30s", runTimeoutKey: "1m"},
+			wantErr:   false,
+		},
+		{
+			name:      "incorrect step timeouts in os envs, should be default",
+			want:      NewBeamEnvs(defaultSdk, executorConfig, preparedModDir, defaultNumOfParallelJobs, defaultStepTimeouts[defaultSdk].compile, defaultStepTimeouts[defaultSdk].run, ResourceLimits{}),
+			envsToSet: map[string]string{beamSdkKey: "SDK_JAVA", compileTimeoutKey: "30", runTimeoutKey: "-1m"},
+			wantErr:   false,
+		},
+		{
+			name:      "resource limits in os envs",
+			want:      NewBeamEnvs(defaultSdk, executorConfig, preparedModDir, defaultNumOfParallelJobs, defaultStepTimeouts[defaultSdk].compile, defaultStepTimeouts[defaultSdk].run, ResourceLimits{MemoryBytes: 256 * 1024 * 1024, CpuTime: 10 * time.Second, DiskBytes: 64 * 1024 * 1024, OutputLines: 1000, OutputBytes: 2 * 1024 * 1024, KillOnOutputLimit: true}),
+			envsToSet: map[string]string{beamSdkKey: "SDK_JAVA", memoryLimitKey: "256", cpuTimeLimitKey: "10s", diskQuotaKey: "64", maxOutputLinesKey: "1000", maxOutputSizeKey: "2", killOnOutputLimitKey: "true"},
+			wantErr:   false,
+		},
+		{
+			name:      "incorrect resource limits in os envs, should be without limits",
+			want:      NewBeamEnvs(defaultSdk, executorConfig, preparedModDir, defaultNumOfParallelJobs, defaultStepTimeouts[defaultSdk].compile, defaultStepTimeouts[defaultSdk].run, ResourceLimits{}),
+			envsToSet: map[string]string{beamSdkKey: "SDK_JAVA", memoryLimitKey: "256MB", cpuTimeLimitKey: "-10s", diskQuotaKey: "-1", maxOutputLinesKey: "-1", maxOutputSizeKey: "1KB", killOnOutputLimitKey: "yes"},
+			wantErr:   false,
+		},
 		{
 			name:      "wrong sdk key in os envs",
 			want:      nil,
@@ -210,10 +247,112 @@ func Test_getApplicationEnvsFromOsEnvs(t *testing.T) {
 	}{
 		{
 			name:      "working dir is provided",
-			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime}, defaultPipelineExecuteTimeout),
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
 			wantErr:   false,
 			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId},
 		},
+		{
+			name:      "queue is limited",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{4, 30 * time.Second}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, maxConcurrentJobsKey: "4", queueTimeoutKey: "30s"},
+		},
+		{
+			name:      "idle pipelines are deleted",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 5 * time.Minute, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, cacheIdleTimeoutKey: "5m"},
+		},
+		{
+			name:      "idle timeout isn't shorter than expiration time",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, cacheIdleTimeoutKey: "15m"},
+		},
+		{
+			name:      "lru is configured",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 1000, 30 * time.Second, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, cacheLruSizeKey: "1000", cacheLruTtlKey: "30s"},
+		},
+		{
+			name:      "incorrect lru envs",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, cacheLruSizeKey: "-5", cacheLruTtlKey: "soon"},
+		},
+		{
+			name:      "snapshot of local cache is configured",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "/tmp/cache.json", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, cacheSnapshotPathKey: "/tmp/cache.json"},
+		},
+		{
+			name:      "fallback to local cache is enabled",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", true}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, cacheFallbackKey: "true"},
+		},
+		{
+			name:      "snippets are configured",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{time.Hour, 64 * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, snippetRetentionKey: "1h", snippetMaxSizeKey: "64"},
+		},
+		{
+			name:      "incorrect snippet envs",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, snippetRetentionKey: "0s", snippetMaxSizeKey: "-1"},
+		},
+		{
+			name:      "rate limits are configured",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{600, map[string]int{"RunCode": 10, "GetLogs": 0}, []string{"KEY_1", "KEY_2"}}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, rateLimitKey: "600", rateLimitsByMethodKey: "RunCode=10, GetLogs=0", rateLimitApiKeysKey: "KEY_1, ,KEY_2"},
+		},
+		{
+			name:      "incorrect rate limits",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{"CheckStatus": 100}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, rateLimitKey: "-1", rateLimitsByMethodKey: "RunCode=-10,GetLogs,=5,CheckStatus=100"},
+		},
+		{
+			name:      "shutdown grace period is configured",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, time.Minute, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, shutdownGracePeriodKey: "1m"},
+		},
+		{
+			name:      "incorrect shutdown grace period",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, shutdownGracePeriodKey: "-5s"},
+		},
+		{
+			name:      "working dirs are kept",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{30 * time.Minute, []string{"*.pem", "secrets"}}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, workingDirRetentionKey: "30m", workingDirExcludeKey: "*.pem, secrets,,[incorrect"},
+		},
+		{
+			name:      "working dirs aren't kept",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{0, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, workingDirRetentionKey: "0s"},
+		},
+		{
+			name:      "code size is limited",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{64 * 1024, 0}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, maxCodeSizeKey: "64", maxFileSizeKey: "0"},
+		},
+		{
+			name:      "incorrect code size limits",
+			want:      NewApplicationEnvs("/app", defaultLaunchSite, defaultProjectId, defaultPipelinesFolder, &CacheEnvs{defaultCacheType, defaultCacheAddress, defaultCacheKeyExpirationTime, 0, 0, defaultCacheLruTtl, "", false}, defaultPipelineExecuteTimeout, defaultShutdownGracePeriod, &QueueEnvs{0, defaultQueueTimeout}, &SnippetEnvs{defaultSnippetRetention, defaultSnippetMaxSizeKb * 1024}, &RateLimitEnvs{0, map[string]int{}, nil}, &WorkingDirEnvs{defaultWorkingDirRetention, nil}, &CodeEnvs{defaultMaxCodeSizeKb * 1024, defaultMaxFileSizeKb * 1024}),
+			wantErr:   false,
+			envsToSet: map[string]string{workingDirKey: "/app", launchSiteKey: defaultLaunchSite, projectIdKey: defaultProjectId, maxCodeSizeKey: "-1", maxFileSizeKey: "MOCK_SIZE"},
+		},
 		{
 			name:    "working dir isn't provided",
 			want:    nil,
@@ -241,6 +380,21 @@ func Test_getApplicationEnvsFromOsEnvs(t *testing.T) {
 }
 
 func Test_createExecutorConfig(t *testing.T) {
+	deniedEnvConfigPath := filepath.Join(configFolderName, playground.Sdk_SDK_PYTHON.String()+jsonExt)
+	if err := os.WriteFile(deniedEnvConfigPath, []byte(`{"run_cmd": "python3", "environment": {"PATH": "/tmp"}}`), 0600); err != nil {
+		t.Fatalf("error during prepare config: %s", err.Error())
+	}
+	defer os.Remove(deniedEnvConfigPath)
+	incorrectTransientErrorConfigPath := filepath.Join(configFolderName, "incorrect_transient_compile_errors"+jsonExt)
+	if err := os.WriteFile(incorrectTransientErrorConfigPath, []byte(`{"compile_cmd": "go", "transient_compile_errors": ["(text file busy"]}`), 0600); err != nil {
+		t.Fatalf("error during prepare config: %s", err.Error())
+	}
+	defer os.Remove(incorrectTransientErrorConfigPath)
+	directRunnerConfigPath := filepath.Join(configFolderName, "direct_runner"+jsonExt)
+	if err := os.WriteFile(directRunnerConfigPath, []byte(`{"compile_cmd": "go", "runners": {"direct": {"run_args": ["--runner=direct"]}}}`), 0600); err != nil {
+		t.Fatalf("error during prepare config: %s", err.Error())
+	}
+	defer os.Remove(directRunnerConfigPath)
 	type args struct {
 		apacheBeamSdk playground.Sdk
 		configPath    string
@@ -257,6 +411,30 @@ func Test_createExecutorConfig(t *testing.T) {
 			want:    executorConfig,
 			wantErr: false,
 		},
+		{
+			name:    "create executor configuration of scio sdk from json file",
+			args:    args{apacheBeamSdk: playground.Sdk_SDK_SCIO, configPath: filepath.Join(configFolderName, playground.Sdk_SDK_SCIO.String()+jsonExt)},
+			want:    scioExecutorConfig,
+			wantErr: false,
+		},
+		{
+			name:    "create executor configuration with environment variable which can't be overridden",
+			args:    args{apacheBeamSdk: playground.Sdk_SDK_PYTHON, configPath: deniedEnvConfigPath},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "create executor configuration with transient compile error which isn't a regular expression",
+			args:    args{apacheBeamSdk: playground.Sdk_SDK_GO, configPath: incorrectTransientErrorConfigPath},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "create executor configuration with the direct runner in runners",
+			args:    args{apacheBeamSdk: playground.Sdk_SDK_GO, configPath: directRunnerConfigPath},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -16,6 +16,7 @@
 package errors
 
 import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -33,8 +34,99 @@ func NotFoundError(title string, formatMessage string, args ...interface{}) erro
 	return status.Errorf(codes.NotFound, "%s: %s", title, message)
 }
 
-// InternalError returns error with Internal code error and message like "title: message"
+// InternalError returns error with Internal code error and message like "title: message".
+// The error contains playground.ErrorDetails with ERROR_CODE_INTERNAL.
 func InternalError(title string, formatMessage string, args ...interface{}) error {
 	message := fmt.Sprintf(formatMessage, args...)
-	return status.Errorf(codes.Internal, "%s: %s", title, message)
+	return withErrorCode(status.Newf(codes.Internal, "%s: %s", title, message), pb.ErrorCode_ERROR_CODE_INTERNAL)
+}
+
+// ResourceExhaustedError returns error with ResourceExhausted code error and message like "title: message".
+// The error contains playground.ErrorDetails with ERROR_CODE_RESOURCE_LIMIT.
+func ResourceExhaustedError(title string, formatMessage string, args ...interface{}) error {
+	message := fmt.Sprintf(formatMessage, args...)
+	return withErrorCode(status.Newf(codes.ResourceExhausted, "%s: %s", title, message), pb.ErrorCode_ERROR_CODE_RESOURCE_LIMIT)
+}
+
+// UnavailableError returns error with Unavailable code error and message like "title: message"
+func UnavailableError(title string, formatMessage string, args ...interface{}) error {
+	message := fmt.Sprintf(formatMessage, args...)
+	return status.Errorf(codes.Unavailable, "%s: %s", title, message)
+}
+
+// LimitExceededError returns error with InvalidArgument code error and message like "title: message"
+// for requests which exceed limits of the server (e.g. the size of the code).
+// The error contains playground.ErrorDetails with ERROR_CODE_RESOURCE_LIMIT.
+func LimitExceededError(title string, formatMessage string, args ...interface{}) error {
+	message := fmt.Sprintf(formatMessage, args...)
+	return withErrorCode(status.Newf(codes.InvalidArgument, "%s: %s", title, message), pb.ErrorCode_ERROR_CODE_RESOURCE_LIMIT)
+}
+
+// PipelineError returns error of the failed code processing with message like "title: message".
+// The error contains playground.ErrorDetails with the code which is received by PipelineErrorCode
+// for the terminal status of the code processing:
+// - ERROR_CODE_COMPILE_ERROR and ERROR_CODE_RUN_ERROR with Aborted code error
+// - ERROR_CODE_TIMEOUT with DeadlineExceeded code error
+// - ERROR_CODE_CANCELED with Canceled code error
+// - ERROR_CODE_RESOURCE_LIMIT with ResourceExhausted code error
+// - ERROR_CODE_INTERNAL with Internal code error
+func PipelineError(pipelineStatus pb.Status, limitExceeded bool, title string, formatMessage string, args ...interface{}) error {
+	message := fmt.Sprintf(formatMessage, args...)
+	errorCode := PipelineErrorCode(pipelineStatus, limitExceeded)
+	code := codes.Internal
+	switch errorCode {
+	case pb.ErrorCode_ERROR_CODE_COMPILE_ERROR, pb.ErrorCode_ERROR_CODE_RUN_ERROR:
+		code = codes.Aborted
+	case pb.ErrorCode_ERROR_CODE_TIMEOUT:
+		code = codes.DeadlineExceeded
+	case pb.ErrorCode_ERROR_CODE_CANCELED:
+		code = codes.Canceled
+	case pb.ErrorCode_ERROR_CODE_RESOURCE_LIMIT:
+		code = codes.ResourceExhausted
+	}
+	return withErrorCode(status.Newf(code, "%s: %s", title, message), errorCode)
+}
+
+// PipelineErrorCode returns the code of the failure for the terminal status of the code processing.
+// If the run step exceeded resource limits of the SDK (limitExceeded is true), returns ERROR_CODE_RESOURCE_LIMIT.
+// Failures of the validation and the preparation of the code are reported as ERROR_CODE_COMPILE_ERROR,
+// since the code couldn't be built. Returns ERROR_CODE_UNSPECIFIED for not failed statuses.
+func PipelineErrorCode(pipelineStatus pb.Status, limitExceeded bool) pb.ErrorCode {
+	if limitExceeded {
+		return pb.ErrorCode_ERROR_CODE_RESOURCE_LIMIT
+	}
+	switch pipelineStatus {
+	case pb.Status_STATUS_VALIDATION_ERROR, pb.Status_STATUS_PREPARATION_ERROR, pb.Status_STATUS_COMPILE_ERROR:
+		return pb.ErrorCode_ERROR_CODE_COMPILE_ERROR
+	case pb.Status_STATUS_RUN_ERROR:
+		return pb.ErrorCode_ERROR_CODE_RUN_ERROR
+	case pb.Status_STATUS_RUN_TIMEOUT:
+		return pb.ErrorCode_ERROR_CODE_TIMEOUT
+	case pb.Status_STATUS_CANCELED:
+		return pb.ErrorCode_ERROR_CODE_CANCELED
+	case pb.Status_STATUS_ERROR:
+		return pb.ErrorCode_ERROR_CODE_INTERNAL
+	}
+	return pb.ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// GetErrorCode returns the code of playground.ErrorDetails of the gRPC status of the error.
+// Returns ERROR_CODE_UNSPECIFIED if the error doesn't contain playground.ErrorDetails.
+func GetErrorCode(err error) pb.ErrorCode {
+	for _, detail := range status.Convert(err).Details() {
+		if errorDetails, ok := detail.(*pb.ErrorDetails); ok {
+			return errorDetails.Code
+		}
+	}
+	return pb.ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// withErrorCode returns the error of the status with playground.ErrorDetails which contains the code.
+// If the details couldn't be added, returns the error of the status without them.
+func withErrorCode(st *status.Status, errorCode pb.ErrorCode) error {
+	stWithDetails, err := st.WithDetails(&pb.ErrorDetails{Code: errorCode})
+	if err != nil {
+		return st.Err()
+	}
+	return stWithDetails.Err()
 }
@@ -21,6 +21,8 @@
 package errors
 
 import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -156,3 +158,257 @@ func TestNotFoundError(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceExhaustedError(t *testing.T) {
+	type args struct {
+		title         string
+		formatMessage string
+		arg           []interface{}
+	}
+	tests := []struct {
+		name     string
+		args     args
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "correct count of args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{"TEST_ARG"}},
+			expected: "rpc error: code = ResourceExhausted desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG",
+			wantErr:  true,
+		},
+		{
+			name:     "too many args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{"TEST_ARG", "TEST_ARG"}},
+			expected: "rpc error: code = ResourceExhausted desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG%!(EXTRA string=TEST_ARG)",
+			wantErr:  true,
+		},
+		{
+			name:     "too few args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{}},
+			expected: "rpc error: code = ResourceExhausted desc = TEST_TITLE: TEST_FORMAT_MESSAGE %!s(MISSING)",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ResourceExhaustedError(tt.args.title, tt.args.formatMessage, tt.args.arg...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResourceExhaustedError() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !strings.EqualFold(err.Error(), tt.expected) {
+				t.Errorf("ResourceExhaustedError() error = %v, wantErr %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestUnavailableError(t *testing.T) {
+	type args struct {
+		title         string
+		formatMessage string
+		arg           []interface{}
+	}
+	tests := []struct {
+		name     string
+		args     args
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "correct count of args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{"TEST_ARG"}},
+			expected: "rpc error: code = Unavailable desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG",
+			wantErr:  true,
+		},
+		{
+			name:     "too many args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{"TEST_ARG", "TEST_ARG"}},
+			expected: "rpc error: code = Unavailable desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG%!(EXTRA string=TEST_ARG)",
+			wantErr:  true,
+		},
+		{
+			name:     "too few args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{}},
+			expected: "rpc error: code = Unavailable desc = TEST_TITLE: TEST_FORMAT_MESSAGE %!s(MISSING)",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := UnavailableError(tt.args.title, tt.args.formatMessage, tt.args.arg...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnavailableError() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !strings.EqualFold(err.Error(), tt.expected) {
+				t.Errorf("UnavailableError() error = %v, wantErr %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestLimitExceededError(t *testing.T) {
+	type args struct {
+		title         string
+		formatMessage string
+		arg           []interface{}
+	}
+	tests := []struct {
+		name     string
+		args     args
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "correct count of args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{"TEST_ARG"}},
+			expected: "rpc error: code = InvalidArgument desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG",
+			wantErr:  true,
+		},
+		{
+			name:     "too many args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{"TEST_ARG", "TEST_ARG"}},
+			expected: "rpc error: code = InvalidArgument desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG%!(EXTRA string=TEST_ARG)",
+			wantErr:  true,
+		},
+		{
+			name:     "too few args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{}},
+			expected: "rpc error: code = InvalidArgument desc = TEST_TITLE: TEST_FORMAT_MESSAGE %!s(MISSING)",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := LimitExceededError(tt.args.title, tt.args.formatMessage, tt.args.arg...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LimitExceededError() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !strings.EqualFold(err.Error(), tt.expected) {
+				t.Errorf("LimitExceededError() error = %v, wantErr %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestPipelineError(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        pb.Status
+		limitExceeded bool
+		expected      string
+		wantErrorCode pb.ErrorCode
+	}{
+		{
+			// Test case with the code which isn't compiled.
+			// As a result, want to receive the error with Aborted code and ERROR_CODE_COMPILE_ERROR.
+			name:          "compile error",
+			status:        pb.Status_STATUS_COMPILE_ERROR,
+			expected:      "rpc error: code = Aborted desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG",
+			wantErrorCode: pb.ErrorCode_ERROR_CODE_COMPILE_ERROR,
+		},
+		{
+			// Test case with the code which is failed during the run step.
+			// As a result, want to receive the error with Aborted code and ERROR_CODE_RUN_ERROR.
+			name:          "run error",
+			status:        pb.Status_STATUS_RUN_ERROR,
+			expected:      "rpc error: code = Aborted desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG",
+			wantErrorCode: pb.ErrorCode_ERROR_CODE_RUN_ERROR,
+		},
+		{
+			// Test case with the code which exceeded resource limits during the run step.
+			// As a result, want to receive the error with ResourceExhausted code and ERROR_CODE_RESOURCE_LIMIT.
+			name:          "resource limit",
+			status:        pb.Status_STATUS_RUN_ERROR,
+			limitExceeded: true,
+			expected:      "rpc error: code = ResourceExhausted desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG",
+			wantErrorCode: pb.ErrorCode_ERROR_CODE_RESOURCE_LIMIT,
+		},
+		{
+			// Test case with the code processing which exceeded the timeout.
+			// As a result, want to receive the error with DeadlineExceeded code and ERROR_CODE_TIMEOUT.
+			name:          "timeout",
+			status:        pb.Status_STATUS_RUN_TIMEOUT,
+			expected:      "rpc error: code = DeadlineExceeded desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG",
+			wantErrorCode: pb.ErrorCode_ERROR_CODE_TIMEOUT,
+		},
+		{
+			// Test case with the code processing which is canceled.
+			// As a result, want to receive the error with Canceled code and ERROR_CODE_CANCELED.
+			name:          "canceled",
+			status:        pb.Status_STATUS_CANCELED,
+			expected:      "rpc error: code = Canceled desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG",
+			wantErrorCode: pb.ErrorCode_ERROR_CODE_CANCELED,
+		},
+		{
+			// Test case with the code processing which is failed by the error of the server.
+			// As a result, want to receive the error with Internal code and ERROR_CODE_INTERNAL.
+			name:          "internal error",
+			status:        pb.Status_STATUS_ERROR,
+			expected:      "rpc error: code = Internal desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG",
+			wantErrorCode: pb.ErrorCode_ERROR_CODE_INTERNAL,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := PipelineError(tt.status, tt.limitExceeded, "TEST_TITLE", "TEST_FORMAT_MESSAGE %s", "TEST_ARG")
+			if !strings.EqualFold(err.Error(), tt.expected) {
+				t.Errorf("PipelineError() error = %v, wantErr %v", err.Error(), tt.expected)
+			}
+			if errorCode := GetErrorCode(err); errorCode != tt.wantErrorCode {
+				t.Errorf("PipelineError() error code = %v, want %v", errorCode, tt.wantErrorCode)
+			}
+		})
+	}
+}
+
+func TestGetErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want pb.ErrorCode
+	}{
+		{
+			// Test case with the error of InternalError.
+			// As a result, want to receive ERROR_CODE_INTERNAL.
+			name: "internal error",
+			err:  InternalError("TEST_TITLE", "TEST_FORMAT_MESSAGE"),
+			want: pb.ErrorCode_ERROR_CODE_INTERNAL,
+		},
+		{
+			// Test case with the error of ResourceExhaustedError.
+			// As a result, want to receive ERROR_CODE_RESOURCE_LIMIT.
+			name: "resource exhausted error",
+			err:  ResourceExhaustedError("TEST_TITLE", "TEST_FORMAT_MESSAGE"),
+			want: pb.ErrorCode_ERROR_CODE_RESOURCE_LIMIT,
+		},
+		{
+			// Test case with the error of LimitExceededError.
+			// As a result, want to receive ERROR_CODE_RESOURCE_LIMIT.
+			name: "limit exceeded error",
+			err:  LimitExceededError("TEST_TITLE", "TEST_FORMAT_MESSAGE"),
+			want: pb.ErrorCode_ERROR_CODE_RESOURCE_LIMIT,
+		},
+		{
+			// Test case with the error of InvalidArgumentError which doesn't contain details.
+			// As a result, want to receive ERROR_CODE_UNSPECIFIED.
+			name: "invalid argument error",
+			err:  InvalidArgumentError("TEST_TITLE", "TEST_FORMAT_MESSAGE"),
+			want: pb.ErrorCode_ERROR_CODE_UNSPECIFIED,
+		},
+		{
+			// Test case with the error which isn't a gRPC error.
+			// As a result, want to receive ERROR_CODE_UNSPECIFIED.
+			name: "not gRPC error",
+			err:  fmt.Errorf("MOCK_ERROR"),
+			want: pb.ErrorCode_ERROR_CODE_UNSPECIFIED,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetErrorCode(tt.err); got != tt.want {
+				t.Errorf("GetErrorCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ const (
 //CmdConfiguration for base cmd code execution
 type CmdConfiguration struct {
 	fileName        string
+	fileNames       []string
 	workingDir      string
 	commandName     string
 	commandArgs     []string
@@ -44,6 +45,7 @@ type Executor struct {
 	compileArgs CmdConfiguration
 	runArgs     CmdConfiguration
 	testArgs    CmdConfiguration
+	syntaxArgs  CmdConfiguration
 	validators  []validators.Validator
 	preparers   []preparers.Preparer
 }
@@ -94,12 +96,25 @@ func (ex *Executor) Prepare() func(chan bool, chan error, *sync.Map) {
 // Compile prepares the Cmd for code compilation
 // Returns Cmd instance
 func (ex *Executor) Compile(ctx context.Context) *exec.Cmd {
-	args := append(ex.compileArgs.commandArgs, ex.compileArgs.fileName)
+	fileNames := ex.compileArgs.fileNames
+	if len(fileNames) == 0 {
+		fileNames = []string{ex.compileArgs.fileName}
+	}
+	args := append(ex.compileArgs.commandArgs, fileNames...)
 	cmd := exec.CommandContext(ctx, ex.compileArgs.commandName, args...)
 	cmd.Dir = ex.compileArgs.workingDir
 	return cmd
 }
 
+// CheckSyntax prepares the Cmd for checking syntax of the code
+// Returns Cmd instance
+func (ex *Executor) CheckSyntax(ctx context.Context) *exec.Cmd {
+	args := append(append([]string{}, ex.syntaxArgs.commandArgs...), ex.syntaxArgs.fileNames...)
+	cmd := exec.CommandContext(ctx, ex.syntaxArgs.commandName, args...)
+	cmd.Dir = ex.syntaxArgs.workingDir
+	return cmd
+}
+
 // Run prepares the Cmd for execution of the code
 // Returns Cmd instance
 func (ex *Executor) Run(ctx context.Context) *exec.Cmd {
@@ -52,6 +52,11 @@ type UnitTestExecutorBuilder struct {
 	ExecutorBuilder
 }
 
+//SyntaxCheckBuilder facet of ExecutorBuilder
+type SyntaxCheckBuilder struct {
+	ExecutorBuilder
+}
+
 //NewExecutorBuilder constructor for Executor
 func NewExecutorBuilder() *ExecutorBuilder {
 	return &ExecutorBuilder{}
@@ -82,6 +87,11 @@ func (b *ExecutorBuilder) WithTestRunner() *UnitTestExecutorBuilder {
 	return &UnitTestExecutorBuilder{*b}
 }
 
+// WithSyntaxChecker - Lives chains to type *ExecutorBuilder and returns a *SyntaxCheckBuilder
+func (b *ExecutorBuilder) WithSyntaxChecker() *SyntaxCheckBuilder {
+	return &SyntaxCheckBuilder{*b}
+}
+
 //WithCommand adds compile command to executor
 func (b *CompileBuilder) WithCommand(compileCmd string) *CompileBuilder {
 	b.actions = append(b.actions, func(e *Executor) {
@@ -114,6 +124,14 @@ func (b *CompileBuilder) WithFileName(fileName string) *CompileBuilder {
 	return b
 }
 
+//WithFileNames adds file names of the multi-file code to executor. They are used instead of the file name
+func (b *CompileBuilder) WithFileNames(fileNames []string) *CompileBuilder {
+	b.actions = append(b.actions, func(e *Executor) {
+		e.compileArgs.fileNames = fileNames
+	})
+	return b
+}
+
 //WithExecutableFileName adds file name to executor
 func (b *RunBuilder) WithExecutableFileName(name string) *RunBuilder {
 	b.actions = append(b.actions, func(e *Executor) {
@@ -194,6 +212,38 @@ func (b *UnitTestExecutorBuilder) WithExecutableFileName(name string) *UnitTestE
 	return b
 }
 
+//WithCommand adds syntax check command to executor
+func (b *SyntaxCheckBuilder) WithCommand(syntaxCheckCmd string) *SyntaxCheckBuilder {
+	b.actions = append(b.actions, func(e *Executor) {
+		e.syntaxArgs.commandName = syntaxCheckCmd
+	})
+	return b
+}
+
+//WithArgs adds syntax check args to executor
+func (b *SyntaxCheckBuilder) WithArgs(syntaxCheckArgs []string) *SyntaxCheckBuilder {
+	b.actions = append(b.actions, func(e *Executor) {
+		e.syntaxArgs.commandArgs = syntaxCheckArgs
+	})
+	return b
+}
+
+//WithWorkingDir adds dir path to executor
+func (b *SyntaxCheckBuilder) WithWorkingDir(dir string) *SyntaxCheckBuilder {
+	b.actions = append(b.actions, func(e *Executor) {
+		e.syntaxArgs.workingDir = dir
+	})
+	return b
+}
+
+//WithFileNames adds names of files which syntax is checked to executor
+func (b *SyntaxCheckBuilder) WithFileNames(fileNames []string) *SyntaxCheckBuilder {
+	b.actions = append(b.actions, func(e *Executor) {
+		e.syntaxArgs.fileNames = fileNames
+	})
+	return b
+}
+
 //WithSdkValidators sets validators to executor
 func (b *ValidatorBuilder) WithSdkValidators(validators *[]validators.Validator) *ValidatorBuilder {
 	b.actions = append(b.actions, func(e *Executor) {
@@ -60,6 +60,23 @@ func TestExecutor_Compile(t *testing.T) {
 				ProcessState: nil,
 			},
 		},
+		{
+			name: "TestCompile multi-file code",
+			fields: fields{
+				compileArgs: CmdConfiguration{
+					fileName:        "filePath",
+					fileNames:       []string{"Main.java", "Helper.java"},
+					workingDir:      "./",
+					commandName:     "testCommand",
+					commandArgs:     []string{"-d", "bin"},
+					pipelineOptions: []string{""},
+				},
+			},
+			want: &exec.Cmd{
+				Path: "testCommand",
+				Args: []string{"javac", "-d", "bin", "Main.java", "Helper.java"},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -75,6 +92,40 @@ func TestExecutor_Compile(t *testing.T) {
 	}
 }
 
+func TestExecutor_CheckSyntax(t *testing.T) {
+	tests := []struct {
+		name       string
+		syntaxArgs CmdConfiguration
+		want       *exec.Cmd
+	}{
+		{
+			// Test case with checking syntax of several files.
+			// As a result, want to receive the command with args and names of all files.
+			name: "TestCheckSyntax",
+			syntaxArgs: CmdConfiguration{
+				fileNames:   []string{"Main.java", "Helper.java"},
+				workingDir:  "./",
+				commandName: "testCommand",
+				commandArgs: []string{"-proc:only", "-classpath", "/opt/apache/beam/jars/beam-sdks-java-harness.jar"},
+			},
+			want: &exec.Cmd{
+				Path: "testCommand",
+				Args: []string{"javac", "-proc:only", "-classpath", "/opt/apache/beam/jars/beam-sdks-java-harness.jar", "Main.java", "Helper.java"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ex := &Executor{
+				syntaxArgs: tt.syntaxArgs,
+			}
+			if got := ex.CheckSyntax(context.Background()); !reflect.DeepEqual(got.String(), tt.want.String()) {
+				t.Errorf("CheckSyntax() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestExecutor_Run(t *testing.T) {
 	type fields struct {
 		compileArgs CmdConfiguration
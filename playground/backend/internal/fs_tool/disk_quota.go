@@ -0,0 +1,97 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs_tool
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+)
+
+// DiskQuota limits the size of files which are written to the folder after the quota is created
+type DiskQuota struct {
+	path     string
+	quota    int64
+	baseline int64
+	exceeded int32
+}
+
+// NewDiskQuota returns DiskQuota of the folder. The current size of the folder isn't counted by the quota.
+func NewDiskQuota(path string, quota int64) (*DiskQuota, error) {
+	baseline, err := DirSize(path)
+	if err != nil {
+		return nil, err
+	}
+	return &DiskQuota{path: path, quota: quota, baseline: baseline}, nil
+}
+
+// Watch checks the size of the folder every interval until ctx is done.
+// When the size of written files exceeds the quota, onExceeded is called once and Watch returns.
+func (q *DiskQuota) Watch(ctx context.Context, interval time.Duration, onExceeded func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			size, err := DirSize(q.path)
+			if err != nil {
+				continue
+			}
+			if size-q.baseline > q.quota {
+				atomic.StoreInt32(&q.exceeded, 1)
+				onExceeded()
+				return
+			}
+		}
+	}
+}
+
+// Exceeded returns true if the size of written files has exceeded the quota
+func (q *DiskQuota) Exceeded() bool {
+	return atomic.LoadInt32(&q.exceeded) == 1
+}
+
+// DirSize returns the size of all regular files in the folder and its subfolders.
+// Files which are deleted while the folder is walked aren't counted.
+func DirSize(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && filePath != path {
+				return nil
+			}
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,114 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs_tool
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDirSize(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "sub"), os.ModePerm); err != nil {
+		t.Fatalf("error during prepare folders: %s", err.Error())
+	}
+	_ = os.WriteFile(filepath.Join(dir, "file_1"), make([]byte, 100), fileMode)
+	_ = os.WriteFile(filepath.Join(dir, "sub", "file_2"), make([]byte, 50), fileMode)
+
+	tests := []struct {
+		name    string
+		path    string
+		want    int64
+		wantErr bool
+	}{
+		{
+			// Test case with the folder which contains files in subfolders.
+			// As a result, want to receive the total size of all files.
+			name:    "folder with files",
+			path:    dir,
+			want:    150,
+			wantErr: false,
+		},
+		{
+			// Test case with the folder which doesn't exist.
+			// As a result, want to receive an error.
+			name:    "folder doesn't exist",
+			path:    filepath.Join(dir, "MOCK_FOLDER"),
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DirSize(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DirSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DirSize() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiskQuota_Watch(t *testing.T) {
+	tests := []struct {
+		name string
+		// written is the size of the file which is written after the quota is created
+		written      int64
+		wantExceeded bool
+	}{
+		{
+			// Test case with writing the file which is larger than the quota.
+			// As a result, want the quota to be exceeded.
+			name:         "quota is exceeded",
+			written:      2048,
+			wantExceeded: true,
+		},
+		{
+			// Test case with writing the file which is smaller than the quota.
+			// As a result, want the quota not to be exceeded.
+			name:         "quota isn't exceeded",
+			written:      512,
+			wantExceeded: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			// files which exist before the quota is created aren't counted
+			_ = os.WriteFile(filepath.Join(dir, "existing"), make([]byte, 4096), fileMode)
+			quota, err := NewDiskQuota(dir, 1024)
+			if err != nil {
+				t.Fatalf("NewDiskQuota() error = %v", err)
+			}
+			_ = os.WriteFile(filepath.Join(dir, "written"), make([]byte, tt.written), fileMode)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			called := false
+			quota.Watch(ctx, 10*time.Millisecond, func() {
+				called = true
+			})
+			if called != tt.wantExceeded || quota.Exceeded() != tt.wantExceeded {
+				t.Errorf("Watch() exceeded = %v, onExceeded is called = %v, want %v", quota.Exceeded(), called, tt.wantExceeded)
+			}
+		})
+	}
+}
@@ -17,12 +17,14 @@ package fs_tool
 
 import (
 	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 const (
@@ -43,10 +45,20 @@ type LifeCyclePaths struct {
 	ExecutableName                   func(string) (string, error)
 }
 
+// SourceFile is one of the files of the multi-file code.
+// Name is the path of the file relative to the source file folder.
+// IsMain is true for the entrypoint file of the code.
+type SourceFile struct {
+	Name   string
+	Code   string
+	IsMain bool
+}
+
 // LifeCycle is used for preparing folders and files to process code for one code processing request.
 type LifeCycle struct {
 	folderGlobs []string // folders that should be created to process code
 	Paths       LifeCyclePaths
+	pool        *WarmPool // the pool which the working directory is taken from, nil if it isn't taken from the pool
 }
 
 // NewLifeCycle returns a corresponding LifeCycle depending on the given SDK.
@@ -58,6 +70,8 @@ func NewLifeCycle(sdk pb.Sdk, pipelineId uuid.UUID, pipelinesFolder string) (*Li
 		return newGoLifeCycle(pipelineId, pipelinesFolder), nil
 	case pb.Sdk_SDK_PYTHON:
 		return newPythonLifeCycle(pipelineId, pipelinesFolder), nil
+	case pb.Sdk_SDK_SCIO:
+		return newScioLifeCycle(pipelineId, pipelinesFolder), nil
 	default:
 		return nil, fmt.Errorf("%s isn't supported now", sdk)
 	}
@@ -75,7 +89,11 @@ func (lc *LifeCycle) CreateFolders() error {
 }
 
 // DeleteFolders deletes all previously provisioned folders.
+// If the working directory is taken from WarmPool, it is sanitized and returned to the pool instead.
 func (lc *LifeCycle) DeleteFolders() error {
+	if lc.pool != nil {
+		return lc.pool.put(lc)
+	}
 	for _, folder := range lc.folderGlobs {
 		err := os.RemoveAll(folder)
 		if err != nil {
@@ -99,6 +117,76 @@ func (lc *LifeCycle) CreateSourceCodeFile(code string) error {
 	return nil
 }
 
+// CreateSourceCodeFiles creates files of the multi-file code.
+// The entrypoint file is created as the source file of the life cycle (i.e. {pipelineId}.{sourceFileExtension})
+//	so it is processed by validators, preparers and executors as the single-file code.
+// Other files are created in the source file folder preserving their relative paths.
+func (lc *LifeCycle) CreateSourceCodeFiles(files []SourceFile) error {
+	if _, err := os.Stat(lc.Paths.AbsoluteSourceFileFolderPath); os.IsNotExist(err) {
+		return err
+	}
+
+	mainFile, err := getMainFile(files)
+	if err != nil {
+		return err
+	}
+	names := make(map[string]bool, len(files))
+	for _, file := range files {
+		if file.IsMain {
+			continue
+		}
+		name, err := cleanRelativePath(file.Name)
+		if err != nil {
+			return err
+		}
+		if names[name] || name == lc.Paths.SourceFileName {
+			return fmt.Errorf("file %s is duplicated", file.Name)
+		}
+		names[name] = true
+
+		filePath := filepath.Join(lc.Paths.AbsoluteSourceFileFolderPath, name)
+		if err = os.MkdirAll(filepath.Dir(filePath), fs.ModePerm); err != nil {
+			return err
+		}
+		if err = os.WriteFile(filePath, []byte(file.Code), fileMode); err != nil {
+			return err
+		}
+	}
+	return lc.CreateSourceCodeFile(mainFile.Code)
+}
+
+// getMainFile returns the entrypoint file of the multi-file code.
+// Returns an error if there is no entrypoint file or there are several ones.
+func getMainFile(files []SourceFile) (*SourceFile, error) {
+	var mainFile *SourceFile
+	for i := range files {
+		if !files[i].IsMain {
+			continue
+		}
+		if mainFile != nil {
+			return nil, fmt.Errorf("only one entrypoint file is allowed, but %s and %s are marked as entrypoint", mainFile.Name, files[i].Name)
+		}
+		mainFile = &files[i]
+	}
+	if mainFile == nil {
+		return nil, errors.New("entrypoint file isn't specified")
+	}
+	return mainFile, nil
+}
+
+// cleanRelativePath returns the cleaned path of the file relative to the source file folder.
+// Returns an error if the path is absolute or points outside the source file folder.
+func cleanRelativePath(name string) (string, error) {
+	if name == "" {
+		return "", errors.New("file name is empty")
+	}
+	cleanName := filepath.Clean(filepath.FromSlash(name))
+	if filepath.IsAbs(cleanName) || cleanName == ".." || strings.HasPrefix(cleanName, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("file name %s should be a relative path inside the source folder", name)
+	}
+	return cleanName, nil
+}
+
 // CopyFile copies a file with fileName from sourceDir to destinationDir.
 func (lc *LifeCycle) CopyFile(fileName, sourceDir, destinationDir string) error {
 	absSourcePath := filepath.Join(sourceDir, fileName)
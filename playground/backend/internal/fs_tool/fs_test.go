@@ -220,6 +220,116 @@ func TestLifeCycle_CreateSourceCodeFile(t *testing.T) {
 	}
 }
 
+func TestLifeCycle_CreateSourceCodeFiles(t *testing.T) {
+	pipelineId := uuid.New()
+	baseFileFolder, _ := filepath.Abs(pipelineId.String())
+	if err := prepareFolders(baseFileFolder); err != nil {
+		t.Fatalf("Error during preparing folders for test: %s", err)
+	}
+	defer teardownFolders(baseFileFolder)
+	srcFileFolder := filepath.Join(baseFileFolder, "src")
+
+	type args struct {
+		files []SourceFile
+	}
+	tests := []struct {
+		name      string
+		sdk       pb.Sdk
+		args      args
+		wantFiles map[string]string
+		wantErr   bool
+	}{
+		{
+			name: "entrypoint file isn't specified",
+			sdk:  pb.Sdk_SDK_JAVA,
+			args: args{files: []SourceFile{
+				{Name: "Helper.java", Code: "class Helper {}"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "several entrypoint files",
+			sdk:  pb.Sdk_SDK_JAVA,
+			args: args{files: []SourceFile{
+				{Name: "Main.java", Code: "class Main {}", IsMain: true},
+				{Name: "Other.java", Code: "class Other {}", IsMain: true},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "file outside the source folder",
+			sdk:  pb.Sdk_SDK_PYTHON,
+			args: args{files: []SourceFile{
+				{Name: "main.py", Code: "import helper", IsMain: true},
+				{Name: "../helper.py", Code: "print(1)"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "duplicated files",
+			sdk:  pb.Sdk_SDK_PYTHON,
+			args: args{files: []SourceFile{
+				{Name: "main.py", Code: "import helper", IsMain: true},
+				{Name: "helper.py", Code: "print(1)"},
+				{Name: "./helper.py", Code: "print(2)"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "two-file java code",
+			sdk:  pb.Sdk_SDK_JAVA,
+			args: args{files: []SourceFile{
+				{Name: "Main.java", Code: "public class Main { public static void main(String[] args) { Helper.greet(); } }", IsMain: true},
+				{Name: "Helper.java", Code: "public class Helper { static void greet() {} }"},
+			}},
+			wantFiles: map[string]string{
+				pipelineId.String() + JavaSourceFileExtension: "public class Main { public static void main(String[] args) { Helper.greet(); } }",
+				"Helper.java": "public class Helper { static void greet() {} }",
+			},
+			wantErr: false,
+		},
+		{
+			name: "python package with a submodule",
+			sdk:  pb.Sdk_SDK_PYTHON,
+			args: args{files: []SourceFile{
+				{Name: "main.py", Code: "from utils.helper import greet\ngreet()", IsMain: true},
+				{Name: "utils/__init__.py", Code: ""},
+				{Name: "utils/helper.py", Code: "def greet():\n    print('Hello')"},
+			}},
+			wantFiles: map[string]string{
+				pipelineId.String() + pythonExecutableFileExtension: "from utils.helper import greet\ngreet()",
+				filepath.Join("utils", "__init__.py"):               "",
+				filepath.Join("utils", "helper.py"):                 "def greet():\n    print('Hello')",
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lc, _ := NewLifeCycle(tt.sdk, pipelineId, baseFileFolder)
+			lc.Paths.AbsoluteSourceFileFolderPath = srcFileFolder
+			lc.Paths.AbsoluteSourceFilePath = filepath.Join(srcFileFolder, lc.Paths.SourceFileName)
+			defer func() {
+				os.RemoveAll(srcFileFolder)
+				prepareFolders(baseFileFolder)
+			}()
+			if err := lc.CreateSourceCodeFiles(tt.args.files); (err != nil) != tt.wantErr {
+				t.Errorf("CreateSourceCodeFiles() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for name, code := range tt.wantFiles {
+				data, err := os.ReadFile(filepath.Join(srcFileFolder, name))
+				if err != nil {
+					t.Errorf("CreateSourceCodeFiles() error during open created file %s: %s", name, err)
+					continue
+				}
+				if string(data) != code {
+					t.Errorf("CreateSourceCodeFiles() %s code = %s, want code %s", name, string(data), code)
+				}
+			}
+		})
+	}
+}
+
 func TestLifeCycle_DeleteFolders(t *testing.T) {
 	pipelineId := uuid.New()
 	baseFileFolder := pipelineId.String()
@@ -339,6 +449,27 @@ func TestNewLifeCycle(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Scio LifeCycle",
+			args: args{
+				sdk:             pb.Sdk_SDK_SCIO,
+				pipelineId:      pipelineId,
+				pipelinesFolder: pipelinesFolder,
+			},
+			want: &LifeCycle{
+				folderGlobs: []string{baseFileFolder, srcFileFolder, execFileFolder},
+				Paths: LifeCyclePaths{
+					SourceFileName:                   fmt.Sprintf("%s%s", pipelineId.String(), ScioSourceFileExtension),
+					AbsoluteSourceFileFolderPath:     srcFileFolder,
+					AbsoluteSourceFilePath:           filepath.Join(srcFileFolder, fmt.Sprintf("%s%s", pipelineId.String(), ScioSourceFileExtension)),
+					ExecutableFileName:               fmt.Sprintf("%s%s", pipelineId.String(), scioCompiledFileExtension),
+					AbsoluteExecutableFileFolderPath: execFileFolder,
+					AbsoluteExecutableFilePath:       filepath.Join(execFileFolder, fmt.Sprintf("%s%s", pipelineId.String(), scioCompiledFileExtension)),
+					AbsoluteBaseFolderPath:           baseFileFolder,
+					AbsoluteLogFilePath:              filepath.Join(baseFileFolder, logFileName),
+				},
+			},
+		},
 		{
 			name: "Unavailable SDK",
 			args: args{
@@ -18,7 +18,9 @@ package fs_tool
 import (
 	"errors"
 	"github.com/google/uuid"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"strings"
 )
 
@@ -34,7 +36,9 @@ func newJavaLifeCycle(pipelineId uuid.UUID, pipelinesFolder string) *LifeCycle {
 	return javaLifeCycle
 }
 
-// executableName returns name that should be executed (HelloWorld for HelloWorld.class for java SDK)
+// executableName returns name that should be executed (HelloWorld for HelloWorld.class for java SDK).
+// In case of the multi-file code, classes of additional source files (Helper.class for Helper.java) are skipped,
+//	so the class of the entrypoint file is executed.
 func executableName(executableFileFolderPath string) (string, error) {
 	dirEntries, err := os.ReadDir(executableFileFolderPath)
 	if err != nil {
@@ -43,6 +47,34 @@ func executableName(executableFileFolderPath string) (string, error) {
 	if len(dirEntries) < 1 {
 		return "", errors.New("number of executable files should be at least one")
 	}
+	additionalClasses := additionalSourceClassNames(filepath.Join(filepath.Dir(executableFileFolderPath), sourceFolderName))
+	for i := len(dirEntries) - 1; i >= 0; i-- {
+		name := dirEntries[i].Name()
+		if dirEntries[i].IsDir() || filepath.Ext(name) != javaCompiledFileExtension || strings.Contains(name, "$") {
+			continue
+		}
+		className := strings.Split(name, ".")[0]
+		if !additionalClasses[className] {
+			return className, nil
+		}
+	}
 	//TODO need to find a class with a main method instead of using the last file
 	return strings.Split(dirEntries[len(dirEntries)-1].Name(), ".")[0], nil
 }
+
+// additionalSourceClassNames returns names of classes of java source files except the entrypoint file
+//	which is named with pipelineId.
+func additionalSourceClassNames(sourceFileFolderPath string) map[string]bool {
+	classNames := make(map[string]bool)
+	_ = filepath.WalkDir(sourceFileFolderPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(path) != JavaSourceFileExtension {
+			return nil
+		}
+		className := strings.TrimSuffix(d.Name(), JavaSourceFileExtension)
+		if _, err := uuid.Parse(className); err != nil {
+			classNames[className] = true
+		}
+		return nil
+	})
+	return classNames
+}
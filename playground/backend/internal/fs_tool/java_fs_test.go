@@ -112,6 +112,35 @@ func Test_executableName(t *testing.T) {
 			want:    "temp",
 			wantErr: false,
 		},
+		{
+			// Test case with calling sourceFileName method for the multi-file code.
+			// As a result, want to receive a name of the entrypoint class instead of the helper class.
+			name: "get executable name of multi-file code",
+			prepare: func() {
+				baseFolder := filepath.Join(workDir, pipelinesFolder, pipelineId.String())
+				compiled := filepath.Join(baseFolder, compiledFolderName)
+				if err := os.Remove(filepath.Join(compiled, "temp.class")); err != nil {
+					panic(err)
+				}
+				files := map[string]string{
+					filepath.Join(baseFolder, sourceFolderName, pipelineId.String()+JavaSourceFileExtension): "TEMP_DATA",
+					filepath.Join(baseFolder, sourceFolderName, "Utils"+JavaSourceFileExtension):             "TEMP_DATA",
+					filepath.Join(compiled, "App.class"):                                                     "TEMP_DATA",
+					filepath.Join(compiled, "Utils.class"):                                                   "TEMP_DATA",
+					filepath.Join(compiled, "Utils$Inner.class"):                                             "TEMP_DATA",
+				}
+				for path, data := range files {
+					if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+						panic(err)
+					}
+				}
+			},
+			args: args{
+				executableFolder: filepath.Join(workDir, pipelinesFolder, pipelineId.String(), "bin"),
+			},
+			want:    "App",
+			wantErr: false,
+		},
 		{
 			// Test case with calling sourceFileName method with wrong directory.
 			// As a result, want to receive an error.
@@ -0,0 +1,87 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs_tool
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DeleteOrphanedFolders deletes folders of pipelines which are left in the pipelines folder (e.g. after a crash of the server).
+// The folder is deleted only if its name is the id of a pipeline, nothing in it is modified during maxAge
+// and isActive returns false for the pipeline. Other files and folders of the pipelines folder aren't touched.
+// Returns ids of pipelines whose folders are deleted.
+func DeleteOrphanedFolders(pipelinesFolder string, maxAge time.Duration, isActive func(pipelineId uuid.UUID) bool) ([]uuid.UUID, error) {
+	entries, err := os.ReadDir(pipelinesFolder)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	deleted := make([]uuid.UUID, 0)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		pipelineId, err := uuid.Parse(entry.Name())
+		if err != nil {
+			continue
+		}
+		folder := filepath.Join(pipelinesFolder, entry.Name())
+		modTime, err := lastModTime(folder)
+		if err != nil {
+			return deleted, err
+		}
+		if time.Since(modTime) < maxAge || isActive(pipelineId) {
+			continue
+		}
+		if err = os.RemoveAll(folder); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, pipelineId)
+	}
+	return deleted, nil
+}
+
+// lastModTime returns the latest modification time of the folder and files in it
+func lastModTime(path string) (time.Time, error) {
+	var modTime time.Time
+	err := filepath.WalkDir(path, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && filePath != path {
+				return nil
+			}
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+		return nil
+	})
+	return modTime, err
+}
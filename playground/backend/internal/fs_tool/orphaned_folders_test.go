@@ -0,0 +1,112 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs_tool
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDeleteOrphanedFolders(t *testing.T) {
+	pipelinesFolder := t.TempDir()
+	oldTime := time.Now().Add(-2 * time.Hour)
+	createFolder := func(name string, modTime time.Time) string {
+		folder := filepath.Join(pipelinesFolder, name)
+		if err := os.MkdirAll(filepath.Join(folder, "src"), os.ModePerm); err != nil {
+			t.Fatalf("error during prepare folders: %s", err.Error())
+		}
+		file := filepath.Join(folder, "src", "code.py")
+		if err := os.WriteFile(file, []byte("MOCK_CODE"), fileMode); err != nil {
+			t.Fatalf("error during prepare files: %s", err.Error())
+		}
+		for _, path := range []string{file, filepath.Join(folder, "src"), folder} {
+			if err := os.Chtimes(path, modTime, modTime); err != nil {
+				t.Fatalf("error during change modification time: %s", err.Error())
+			}
+		}
+		return folder
+	}
+
+	oldPipelineId := uuid.New()
+	recentPipelineId := uuid.New()
+	activePipelineId := uuid.New()
+	recentlyWrittenPipelineId := uuid.New()
+	oldFolder := createFolder(oldPipelineId.String(), oldTime)
+	recentFolder := createFolder(recentPipelineId.String(), time.Now())
+	activeFolder := createFolder(activePipelineId.String(), oldTime)
+	recentlyWrittenFolder := createFolder(recentlyWrittenPipelineId.String(), oldTime)
+	if err := os.WriteFile(filepath.Join(recentlyWrittenFolder, "src", "logs.log"), []byte("MOCK_LOGS"), fileMode); err != nil {
+		t.Fatalf("error during prepare files: %s", err.Error())
+	}
+	otherFolder := createFolder("prepared_mod", oldTime)
+	isActive := func(pipelineId uuid.UUID) bool {
+		return pipelineId == activePipelineId
+	}
+
+	tests := []struct {
+		name            string
+		pipelinesFolder string
+		want            []uuid.UUID
+		wantDeleted     []string
+		wantKept        []string
+		wantErr         bool
+	}{
+		{
+			// Test case with the pipelines folder which contains old, recent and active folders of pipelines
+			// and the old folder which doesn't belong to a pipeline.
+			// As a result, want to delete only the old folder of the pipeline which isn't active.
+			name:            "old and recent folders",
+			pipelinesFolder: pipelinesFolder,
+			want:            []uuid.UUID{oldPipelineId},
+			wantDeleted:     []string{oldFolder},
+			wantKept:        []string{recentFolder, activeFolder, recentlyWrittenFolder, otherFolder},
+			wantErr:         false,
+		},
+		{
+			// Test case with the pipelines folder which doesn't exist.
+			// As a result, want to receive nothing without an error.
+			name:            "pipelines folder doesn't exist",
+			pipelinesFolder: filepath.Join(pipelinesFolder, "not_exist"),
+			want:            nil,
+			wantErr:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeleteOrphanedFolders(tt.pipelinesFolder, time.Hour, isActive)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeleteOrphanedFolders() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DeleteOrphanedFolders() got = %v, want %v", got, tt.want)
+			}
+			for _, folder := range tt.wantDeleted {
+				if _, err := os.Stat(folder); !os.IsNotExist(err) {
+					t.Errorf("DeleteOrphanedFolders() should delete %s, but it doesn't", folder)
+				}
+			}
+			for _, folder := range tt.wantKept {
+				if _, err := os.Stat(folder); err != nil {
+					t.Errorf("DeleteOrphanedFolders() should keep %s, but it doesn't: %s", folder, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs_tool
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// RunnerFileName is the name of the file with the runner of the code which is placed in the base folder of the code
+const RunnerFileName = "runner.txt"
+
+// CreateRunnerFile creates the file with the runner which the code is run by.
+// If the runner is empty, the file isn't created, so the code is run by the direct runner.
+func (lc *LifeCycle) CreateRunnerFile(runner string) error {
+	if runner == "" {
+		return nil
+	}
+	return os.WriteFile(filepath.Join(lc.Paths.AbsoluteBaseFolderPath, RunnerFileName), []byte(runner), fileMode)
+}
+
+// ReadRunnerFile reads the runner of the code from the base folder of the code.
+// If the runner isn't set, the empty string is returned without an error.
+func ReadRunnerFile(baseFolderPath string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(baseFolderPath, RunnerFileName))
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,64 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs_tool
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLifeCycle_CreateRunnerFile(t *testing.T) {
+	tests := []struct {
+		name   string
+		runner string
+	}{
+		{
+			// Test case with creating the runner file of the code with the runner.
+			// As a result, want to receive the runner from the file.
+			name:   "runner is set",
+			runner: "flink",
+		},
+		{
+			// Test case with creating the runner file of the code without the runner.
+			// As a result, want to receive the empty runner without an error.
+			name:   "runner is empty",
+			runner: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseFolder, _ := filepath.Abs(uuid.New().String())
+			if err := os.MkdirAll(baseFolder, os.ModePerm); err != nil {
+				t.Fatalf("Error during preparing folders for test: %s", err)
+			}
+			defer os.RemoveAll(baseFolder)
+			lc := &LifeCycle{Paths: LifeCyclePaths{AbsoluteBaseFolderPath: baseFolder}}
+
+			if err := lc.CreateRunnerFile(tt.runner); err != nil {
+				t.Fatalf("CreateRunnerFile() error = %v", err)
+			}
+			got, err := ReadRunnerFile(baseFolder)
+			if err != nil {
+				t.Fatalf("ReadRunnerFile() error = %v", err)
+			}
+			if got != tt.runner {
+				t.Errorf("ReadRunnerFile() = %q, want %q", got, tt.runner)
+			}
+		})
+	}
+}
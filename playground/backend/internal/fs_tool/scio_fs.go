@@ -0,0 +1,138 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs_tool
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	ScioSourceFileExtension   = ".scala"
+	scioCompiledFileExtension = ".class"
+)
+
+var (
+	scioPackagePattern    = regexp.MustCompile(`(?m)^\s*package\s+([\w.]+)\s*$`)
+	scioDefinitionPattern = regexp.MustCompile(`(?m)^\s*(?:(?:final|case|private|sealed|abstract)\s+)*(object|class)\s+(\w+)`)
+	scioMainMethodPattern = regexp.MustCompile(`\bdef\s+main\s*\(`)
+	scioAppExtendsPattern = regexp.MustCompile(`^[^{\n]*\bextends\s+App\b`)
+	errNoScioDefinition   = errors.New("no object or class is found in the scala source file")
+)
+
+// scioDefinition is an object or a class which is defined in the scala source file
+type scioDefinition struct {
+	isObject bool
+	name     string
+	// body is the code from the definition to the next one
+	body string
+}
+
+// newScioLifeCycle creates LifeCycle with scio SDK environment.
+func newScioLifeCycle(pipelineId uuid.UUID, pipelinesFolder string) *LifeCycle {
+	scioLifeCycle := newCompilingLifeCycle(pipelineId, pipelinesFolder, ScioSourceFileExtension, scioCompiledFileExtension)
+	scioLifeCycle.Paths.ExecutableName = scioExecutableName
+	return scioLifeCycle
+}
+
+// scioExecutableName returns the fully qualified name that should be executed for scio SDK
+//
+//	(com.example.WordCount for "object WordCount" in "package com.example").
+//
+// Scala source files aren't named after their classes, so the name is found in the entrypoint file
+//
+//	which is named with pipelineId. The object with the main method is preferred,
+//	otherwise the first class (e.g. the class of unit tests) or the first object is executed.
+func scioExecutableName(executableFileFolderPath string) (string, error) {
+	sourceFilePath, err := scioEntrypointFile(filepath.Join(filepath.Dir(executableFileFolderPath), sourceFolderName))
+	if err != nil {
+		return "", err
+	}
+	code, err := os.ReadFile(sourceFilePath)
+	if err != nil {
+		return "", err
+	}
+	name, err := scioMainDefinitionName(string(code))
+	if err != nil {
+		return "", err
+	}
+	var packages []string
+	for _, match := range scioPackagePattern.FindAllStringSubmatch(string(code), -1) {
+		packages = append(packages, match[1])
+	}
+	qualifiedName := strings.Join(append(packages, name), ".")
+	compiledFilePath := filepath.Join(executableFileFolderPath, filepath.Join(strings.Split(qualifiedName, ".")...)+scioCompiledFileExtension)
+	if _, err := os.Stat(compiledFilePath); err != nil {
+		return "", err
+	}
+	return qualifiedName, nil
+}
+
+// scioEntrypointFile returns the path of the scala source file which is named with pipelineId
+func scioEntrypointFile(sourceFileFolderPath string) (string, error) {
+	files, err := filepath.Glob(filepath.Join(sourceFileFolderPath, "*"+ScioSourceFileExtension))
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		if _, err := uuid.Parse(strings.TrimSuffix(filepath.Base(file), ScioSourceFileExtension)); err == nil {
+			return file, nil
+		}
+	}
+	return "", os.ErrNotExist
+}
+
+// scioMainDefinitionName returns the name of the object with the main method.
+// If there is no such object, returns the name of the first class or the first object.
+func scioMainDefinitionName(code string) (string, error) {
+	definitions := getScioDefinitions(code)
+	for _, definition := range definitions {
+		if definition.isObject && (scioMainMethodPattern.MatchString(definition.body) || scioAppExtendsPattern.MatchString(definition.body)) {
+			return definition.name, nil
+		}
+	}
+	for _, definition := range definitions {
+		if !definition.isObject {
+			return definition.name, nil
+		}
+	}
+	if len(definitions) > 0 {
+		return definitions[0].name, nil
+	}
+	return "", errNoScioDefinition
+}
+
+// getScioDefinitions returns objects and classes of the scala code in order of their definitions
+func getScioDefinitions(code string) []scioDefinition {
+	matches := scioDefinitionPattern.FindAllStringSubmatchIndex(code, -1)
+	definitions := make([]scioDefinition, 0, len(matches))
+	for i, match := range matches {
+		end := len(code)
+		if i+1 < len(matches) {
+			end = matches[i+1][0]
+		}
+		definitions = append(definitions, scioDefinition{
+			isObject: code[match[2]:match[3]] == "object",
+			name:     code[match[4]:match[5]],
+			body:     code[match[2]:end],
+		})
+	}
+	return definitions
+}
@@ -0,0 +1,118 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs_tool
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_scioExecutableName(t *testing.T) {
+	pipelineId := uuid.New()
+	workDir := "workingDir"
+	preparedPipelinesFolder := filepath.Join(workDir, pipelinesFolder)
+	lc := newScioLifeCycle(pipelineId, preparedPipelinesFolder)
+	baseFolder := filepath.Join(workDir, pipelinesFolder, pipelineId.String())
+	compiled := filepath.Join(baseFolder, compiledFolderName)
+	sourceFile := filepath.Join(baseFolder, sourceFolderName, pipelineId.String()+ScioSourceFileExtension)
+	defer os.RemoveAll(workDir)
+
+	tests := []struct {
+		name string
+		code string
+		// compiledFiles are paths of compiled files relative to the bin folder
+		compiledFiles []string
+		want          string
+		wantErr       bool
+	}{
+		{
+			// Test case with calling scioExecutableName method for the code with the object with the main method.
+			// As a result, want to receive the name of the object with the main method instead of the helper object.
+			name:          "object with main method",
+			code:          "object Utils {\n  def greeting = \"Hello\"\n}\n\nobject MinimalWordCount {\n  def main(cmdlineArgs: Array[String]): Unit = {\n    println(Utils.greeting)\n  }\n}\n",
+			compiledFiles: []string{"Utils.class", "Utils$.class", "MinimalWordCount.class", "MinimalWordCount$.class"},
+			want:          "MinimalWordCount",
+			wantErr:       false,
+		},
+		{
+			// Test case with calling scioExecutableName method for the code with the package and the object which extends App.
+			// As a result, want to receive the fully qualified name of the object.
+			name:          "object extends App in package",
+			code:          "package com.example.scio\n\nobject WordCount extends App {\n  println(\"Hello\")\n}\n",
+			compiledFiles: []string{"com/example/scio/WordCount.class", "com/example/scio/WordCount$.class"},
+			want:          "com.example.scio.WordCount",
+			wantErr:       false,
+		},
+		{
+			// Test case with calling scioExecutableName method for the code of unit tests.
+			// As a result, want to receive the name of the class of unit tests.
+			name:          "class of unit tests",
+			code:          "import com.spotify.scio.testing._\n\nclass WordCountTest extends PipelineSpec {\n  \"WordCount\" should \"work\" in {}\n}\n",
+			compiledFiles: []string{"WordCountTest.class"},
+			want:          "WordCountTest",
+			wantErr:       false,
+		},
+		{
+			// Test case with calling scioExecutableName method when the object isn't compiled.
+			// As a result, want to receive an error.
+			name:          "object isn't compiled",
+			code:          "object WordCount extends App {}\n",
+			compiledFiles: []string{},
+			want:          "",
+			wantErr:       true,
+		},
+		{
+			// Test case with calling scioExecutableName method for the code without objects and classes.
+			// As a result, want to receive an error.
+			name:          "no definitions",
+			code:          "val greeting = \"Hello\"\n",
+			compiledFiles: []string{},
+			want:          "",
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			defer lc.DeleteFolders()
+			if err := os.WriteFile(sourceFile, []byte(tt.code), 0600); err != nil {
+				t.Fatalf("error during prepare source file: %s", err.Error())
+			}
+			for _, file := range tt.compiledFiles {
+				path := filepath.Join(compiled, file)
+				if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+					t.Fatalf("error during prepare compiled files: %s", err.Error())
+				}
+				if err := os.WriteFile(path, []byte("TEMP_DATA"), 0600); err != nil {
+					t.Fatalf("error during prepare compiled files: %s", err.Error())
+				}
+			}
+
+			got, err := scioExecutableName(compiled)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("scioExecutableName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("scioExecutableName() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
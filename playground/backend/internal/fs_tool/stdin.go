@@ -0,0 +1,47 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs_tool
+
+import (
+	"os"
+	"path/filepath"
+)
+
+const (
+	// StdinFileName is the name of the file with the input of the code which is placed in the base folder of the code
+	StdinFileName = "stdin.txt"
+	// MaxStdinSize is the max size of the input of the code in bytes
+	MaxStdinSize = 1024 * 1024
+)
+
+// CreateStdinFile creates the file with the input which is fed to the standard input of the code.
+// If the input is empty, the file isn't created.
+func (lc *LifeCycle) CreateStdinFile(input string) error {
+	if input == "" {
+		return nil
+	}
+	return os.WriteFile(filepath.Join(lc.Paths.AbsoluteBaseFolderPath, StdinFileName), []byte(input), fileMode)
+}
+
+// OpenStdinFile opens the file with the input of the code from the base folder of the code.
+// If the code has no input, nil is returned without an error.
+func OpenStdinFile(baseFolderPath string) (*os.File, error) {
+	file, err := os.Open(filepath.Join(baseFolderPath, StdinFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return file, err
+}
@@ -0,0 +1,79 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs_tool
+
+import (
+	"github.com/google/uuid"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLifeCycle_CreateStdinFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantFile bool
+	}{
+		{
+			// Test case with creating the input file of the code with the input.
+			// As a result, want to receive the file with the input.
+			name:     "input is provided",
+			input:    "line1\nline2\n",
+			wantFile: true,
+		},
+		{
+			// Test case with creating the input file of the code without the input.
+			// As a result, want to receive no file, so OpenStdinFile returns nil.
+			name:     "input is empty",
+			input:    "",
+			wantFile: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseFolder, _ := filepath.Abs(uuid.New().String())
+			if err := os.MkdirAll(baseFolder, os.ModePerm); err != nil {
+				t.Fatalf("Error during preparing folders for test: %s", err)
+			}
+			defer os.RemoveAll(baseFolder)
+			lc := &LifeCycle{Paths: LifeCyclePaths{AbsoluteBaseFolderPath: baseFolder}}
+
+			if err := lc.CreateStdinFile(tt.input); err != nil {
+				t.Fatalf("CreateStdinFile() error = %v", err)
+			}
+			file, err := OpenStdinFile(baseFolder)
+			if err != nil {
+				t.Fatalf("OpenStdinFile() error = %v", err)
+			}
+			if (file != nil) != tt.wantFile {
+				t.Fatalf("OpenStdinFile() file = %v, want file %v", file, tt.wantFile)
+			}
+			if file == nil {
+				return
+			}
+			defer file.Close()
+			data, err := io.ReadAll(file)
+			if err != nil {
+				t.Fatalf("error during read the input file: %s", err)
+			}
+			if string(data) != tt.input {
+				t.Errorf("OpenStdinFile() input = %q, want %q", data, tt.input)
+			}
+		})
+	}
+}
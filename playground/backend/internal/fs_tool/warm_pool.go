@@ -0,0 +1,172 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs_tool
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/logger"
+	"github.com/google/uuid"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// warmPoolFolderName is the folder of the pipelines folder which contains ready working directories of the warm pool.
+// Its name isn't the id of a pipeline, so it isn't deleted as an orphaned folder.
+const warmPoolFolderName = ".warm_pool"
+
+// WarmPool keeps working directories of the SDK which are prepared for the code processing in advance, so the
+// code processing takes the ready working directory instead of creating its folders and files during the request.
+// Working directories are returned to the pool by LifeCycle.DeleteFolders after they are sanitized.
+type WarmPool struct {
+	sdk    pb.Sdk
+	folder string
+	// prepare creates files of the working directory which don't depend on the code (e.g. go.mod of the Go SDK)
+	prepare func(lc *LifeCycle, id uuid.UUID) error
+
+	mu    sync.Mutex
+	ready []*LifeCycle
+}
+
+// NewWarmPool returns WarmPool with size working directories of the SDK in the pipelines folder which are prepared
+// by prepare after their folders are created. Working directories which are left by the previous run of the server
+// are deleted.
+func NewWarmPool(sdk pb.Sdk, pipelinesFolder string, size int, prepare func(lc *LifeCycle, id uuid.UUID) error) (*WarmPool, error) {
+	pool := &WarmPool{
+		sdk:     sdk,
+		folder:  filepath.Join(pipelinesFolder, warmPoolFolderName),
+		prepare: prepare,
+		ready:   make([]*LifeCycle, 0, size),
+	}
+	if err := os.RemoveAll(pool.folder); err != nil {
+		return nil, err
+	}
+	for i := 0; i < size; i++ {
+		if err := pool.add(); err != nil {
+			return nil, err
+		}
+	}
+	return pool, nil
+}
+
+// Size returns the number of ready working directories of the pool
+func (pool *WarmPool) Size() int {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	return len(pool.ready)
+}
+
+// Take moves the ready working directory of the pool to the base folder of the life cycle.
+// Returns false if the pool has no ready working directory, so folders of the life cycle should be created as usual.
+func (pool *WarmPool) Take(lc *LifeCycle) bool {
+	pool.mu.Lock()
+	if len(pool.ready) == 0 {
+		pool.mu.Unlock()
+		return false
+	}
+	entry := pool.ready[len(pool.ready)-1]
+	pool.ready = pool.ready[:len(pool.ready)-1]
+	pool.mu.Unlock()
+
+	if err := os.Rename(entry.Paths.AbsoluteBaseFolderPath, lc.Paths.AbsoluteBaseFolderPath); err != nil {
+		logger.Errorf("WarmPool: error during taking the working directory: %s\n", err.Error())
+		_ = entry.DeleteFolders()
+		pool.replace()
+		return false
+	}
+	lc.pool = pool
+	return true
+}
+
+// put sanitizes the working directory of the life cycle and returns it to the pool.
+// All files and folders of the code processing are removed and the working directory is prepared again,
+// so nothing of the code processing is left for the next one.
+// If the working directory couldn't be sanitized, it is deleted and a new one is prepared instead.
+func (pool *WarmPool) put(lc *LifeCycle) error {
+	lc.pool = nil
+	id := uuid.New()
+	entry, err := NewLifeCycle(pool.sdk, id, pool.folder)
+	if err != nil {
+		return err
+	}
+	if err = os.Rename(lc.Paths.AbsoluteBaseFolderPath, entry.Paths.AbsoluteBaseFolderPath); err != nil {
+		pool.replace()
+		return lc.DeleteFolders()
+	}
+	if err = pool.reset(entry, id); err != nil {
+		logger.Errorf("WarmPool: error during sanitizing the working directory: %s\n", err.Error())
+		pool.replace()
+		return os.RemoveAll(entry.Paths.AbsoluteBaseFolderPath)
+	}
+	pool.mu.Lock()
+	pool.ready = append(pool.ready, entry)
+	pool.mu.Unlock()
+	return nil
+}
+
+// release is called when the working directory of the life cycle isn't returned to the pool (e.g. it is kept),
+// so a new working directory is prepared instead of it
+func (pool *WarmPool) release(lc *LifeCycle) {
+	lc.pool = nil
+	pool.replace()
+}
+
+// replace prepares a new working directory in background instead of the one which is lost by the pool
+func (pool *WarmPool) replace() {
+	go func() {
+		if err := pool.add(); err != nil {
+			logger.Errorf("WarmPool: error during preparing a new working directory: %s\n", err.Error())
+		}
+	}()
+}
+
+// add prepares a new working directory and adds it to ready ones
+func (pool *WarmPool) add() error {
+	id := uuid.New()
+	entry, err := NewLifeCycle(pool.sdk, id, pool.folder)
+	if err != nil {
+		return err
+	}
+	if err = entry.CreateFolders(); err != nil {
+		return err
+	}
+	if err = pool.prepare(entry, id); err != nil {
+		_ = entry.DeleteFolders()
+		return err
+	}
+	pool.mu.Lock()
+	pool.ready = append(pool.ready, entry)
+	pool.mu.Unlock()
+	return nil
+}
+
+// reset removes everything from the base folder of the working directory and prepares it again.
+// The base folder itself is kept.
+func (pool *WarmPool) reset(entry *LifeCycle, id uuid.UUID) error {
+	children, err := os.ReadDir(entry.Paths.AbsoluteBaseFolderPath)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		if err = os.RemoveAll(filepath.Join(entry.Paths.AbsoluteBaseFolderPath, child.Name())); err != nil {
+			return err
+		}
+	}
+	if err = entry.CreateFolders(); err != nil {
+		return err
+	}
+	return pool.prepare(entry, id)
+}
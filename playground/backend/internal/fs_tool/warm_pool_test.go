@@ -0,0 +1,217 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs_tool
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"errors"
+	"github.com/google/uuid"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const preparedFileName = "prepared.txt"
+
+// prepareTestFile writes the file which doesn't depend on the pipeline to the base folder of the working directory
+func prepareTestFile(lc *LifeCycle, _ uuid.UUID) error {
+	return os.WriteFile(filepath.Join(lc.Paths.AbsoluteBaseFolderPath, preparedFileName), []byte("PREPARED"), fileMode)
+}
+
+func TestNewWarmPool(t *testing.T) {
+	pipelinesFolder := t.TempDir()
+	leftover := filepath.Join(pipelinesFolder, warmPoolFolderName, uuid.NewString())
+	if err := os.MkdirAll(leftover, fs.ModePerm); err != nil {
+		t.Fatalf("error during creating the leftover working directory: %s", err.Error())
+	}
+	tests := []struct {
+		name     string
+		size     int
+		prepare  func(lc *LifeCycle, id uuid.UUID) error
+		wantSize int
+		wantErr  bool
+	}{
+		{
+			// Test case with the pool which working directories are prepared.
+			// As a result, want the pool with ready working directories.
+			name:     "working directories are prepared",
+			size:     2,
+			prepare:  prepareTestFile,
+			wantSize: 2,
+			wantErr:  false,
+		},
+		{
+			// Test case with the pool which working directories couldn't be prepared.
+			// As a result, want to receive an error.
+			name: "working directories aren't prepared",
+			size: 1,
+			prepare: func(lc *LifeCycle, id uuid.UUID) error {
+				return errors.New("MOCK_ERROR")
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool, err := NewWarmPool(pb.Sdk_SDK_JAVA, pipelinesFolder, tt.size, tt.prepare)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewWarmPool() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if pool.Size() != tt.wantSize {
+				t.Errorf("NewWarmPool() size = %d, want %d", pool.Size(), tt.wantSize)
+			}
+			if _, err = os.Stat(leftover); !errors.Is(err, fs.ErrNotExist) {
+				t.Errorf("NewWarmPool() leftover working directory isn't deleted, err = %v", err)
+			}
+			for _, entry := range pool.ready {
+				if _, err = os.Stat(filepath.Join(entry.Paths.AbsoluteBaseFolderPath, preparedFileName)); err != nil {
+					t.Errorf("NewWarmPool() working directory isn't prepared: %s", err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestWarmPool_Take(t *testing.T) {
+	pipelinesFolder := t.TempDir()
+	pool, err := NewWarmPool(pb.Sdk_SDK_JAVA, pipelinesFolder, 1, prepareTestFile)
+	if err != nil {
+		t.Fatalf("error during preparing the pool: %s", err.Error())
+	}
+
+	lc := newJavaLifeCycle(uuid.New(), pipelinesFolder)
+	if !pool.Take(lc) {
+		t.Fatalf("Take() = false, want true")
+	}
+	for _, path := range []string{lc.Paths.AbsoluteSourceFileFolderPath, lc.Paths.AbsoluteExecutableFileFolderPath, filepath.Join(lc.Paths.AbsoluteBaseFolderPath, preparedFileName)} {
+		if _, err = os.Stat(path); err != nil {
+			t.Errorf("Take() %s of the working directory doesn't exist: %s", path, err.Error())
+		}
+	}
+	if pool.Size() != 0 {
+		t.Errorf("Take() size = %d, want 0", pool.Size())
+	}
+
+	// the pool has no ready working directory, so the folders of the life cycle should be created as usual
+	if pool.Take(newJavaLifeCycle(uuid.New(), pipelinesFolder)) {
+		t.Errorf("Take() = true, want false for the empty pool")
+	}
+}
+
+func TestWarmPool_Reuse(t *testing.T) {
+	pipelinesFolder := t.TempDir()
+	pool, err := NewWarmPool(pb.Sdk_SDK_JAVA, pipelinesFolder, 1, prepareTestFile)
+	if err != nil {
+		t.Fatalf("error during preparing the pool: %s", err.Error())
+	}
+	lc := newJavaLifeCycle(uuid.New(), pipelinesFolder)
+	if !pool.Take(lc) {
+		t.Fatalf("Take() = false, want true")
+	}
+	baseFolder, err := os.Stat(lc.Paths.AbsoluteBaseFolderPath)
+	if err != nil {
+		t.Fatalf("error during getting the working directory: %s", err.Error())
+	}
+	// files of the code processing and the changed prepared file shouldn't be left for the next code processing
+	if err = lc.CreateSourceCodeFile("MOCK_CODE"); err != nil {
+		t.Fatalf("error during creating the source file: %s", err.Error())
+	}
+	files := map[string]string{
+		lc.Paths.AbsoluteExecutableFilePath:                              "MOCK_EXECUTABLE",
+		filepath.Join(lc.Paths.AbsoluteBaseFolderPath, "output.txt"):     "MOCK_OUTPUT",
+		filepath.Join(lc.Paths.AbsoluteBaseFolderPath, preparedFileName): "MOCK_CHANGED",
+	}
+	for path, content := range files {
+		if err = os.WriteFile(path, []byte(content), fileMode); err != nil {
+			t.Fatalf("error during writing %s: %s", path, err.Error())
+		}
+	}
+
+	if err = lc.DeleteFolders(); err != nil {
+		t.Fatalf("DeleteFolders() error = %v", err)
+	}
+	if _, err = os.Stat(lc.Paths.AbsoluteBaseFolderPath); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("DeleteFolders() the working directory of the pipeline isn't removed, err = %v", err)
+	}
+	if pool.Size() != 1 {
+		t.Fatalf("DeleteFolders() size of the pool = %d, want 1", pool.Size())
+	}
+
+	next := newJavaLifeCycle(uuid.New(), pipelinesFolder)
+	if !pool.Take(next) {
+		t.Fatalf("Take() = false, want true")
+	}
+	nextBaseFolder, err := os.Stat(next.Paths.AbsoluteBaseFolderPath)
+	if err != nil {
+		t.Fatalf("error during getting the working directory: %s", err.Error())
+	}
+	if !os.SameFile(baseFolder, nextBaseFolder) {
+		t.Errorf("Take() the working directory isn't reused")
+	}
+	entries, err := os.ReadDir(next.Paths.AbsoluteSourceFileFolderPath)
+	if err != nil {
+		t.Fatalf("error during reading the source folder: %s", err.Error())
+	}
+	if len(entries) != 0 {
+		t.Errorf("Take() the source folder contains %d files of the previous code processing, want 0", len(entries))
+	}
+	for _, path := range []string{filepath.Join(next.Paths.AbsoluteBaseFolderPath, "output.txt"), filepath.Join(next.Paths.AbsoluteExecutableFileFolderPath, lc.Paths.ExecutableFileName)} {
+		if _, err = os.Stat(path); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Take() %s of the previous code processing isn't removed, err = %v", path, err)
+		}
+	}
+	prepared, err := os.ReadFile(filepath.Join(next.Paths.AbsoluteBaseFolderPath, preparedFileName))
+	if err != nil {
+		t.Fatalf("error during reading the prepared file: %s", err.Error())
+	}
+	if string(prepared) != "PREPARED" {
+		t.Errorf("Take() prepared file = %q, want %q", prepared, "PREPARED")
+	}
+}
+
+func TestWarmPool_RetainFolders(t *testing.T) {
+	pipelinesFolder := t.TempDir()
+	pool, err := NewWarmPool(pb.Sdk_SDK_PYTHON, pipelinesFolder, 1, prepareTestFile)
+	if err != nil {
+		t.Fatalf("error during preparing the pool: %s", err.Error())
+	}
+	lc := newPythonLifeCycle(uuid.New(), pipelinesFolder)
+	if !pool.Take(lc) {
+		t.Fatalf("Take() = false, want true")
+	}
+	if err = lc.CreateKeepFile(); err != nil {
+		t.Fatalf("error during creating the keep file: %s", err.Error())
+	}
+	if retained, err := lc.RetainFolders(); err != nil || !retained {
+		t.Fatalf("RetainFolders() = %v, %v, want true", retained, err)
+	}
+	if _, err = os.Stat(lc.Paths.AbsoluteBaseFolderPath); err != nil {
+		t.Errorf("RetainFolders() the kept working directory doesn't exist: %s", err.Error())
+	}
+	// the kept working directory isn't returned, so the pool prepares another one in background
+	deadline := time.Now().Add(5 * time.Second)
+	for pool.Size() != 1 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if pool.Size() != 1 {
+		t.Errorf("RetainFolders() size of the pool = %d, want 1", pool.Size())
+	}
+}
@@ -0,0 +1,212 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs_tool
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// keepFileName is the name of the file in the base folder of the code which is requested to be kept after the code processing
+	keepFileName = ".keep"
+	// retainedFileName is the name of the file in the base folder of the kept code which modification time is the start of the retention
+	retainedFileName = ".retained"
+)
+
+// ErrNotRetained is returned if the working directory of the pipeline isn't kept, e.g. it isn't requested,
+// the code processing isn't finished yet or the retention is expired.
+var ErrNotRetained = errors.New("working directory isn't retained")
+
+// CreateKeepFile marks the base folder of the code to be kept after the code processing (see RetainFolders).
+func (lc *LifeCycle) CreateKeepFile() error {
+	return os.WriteFile(filepath.Join(lc.Paths.AbsoluteBaseFolderPath, keepFileName), nil, fileMode)
+}
+
+// RetainFolders starts the retention of the base folder of the code if it is marked by CreateKeepFile.
+// Returns false if the base folder isn't marked, so its folders should be deleted.
+func (lc *LifeCycle) RetainFolders() (bool, error) {
+	keepFilePath := filepath.Join(lc.Paths.AbsoluteBaseFolderPath, keepFileName)
+	if _, err := os.Stat(keepFilePath); errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	// the retained file is created when the code processing is finished, so its modification time is the start of the retention
+	if err := os.WriteFile(filepath.Join(lc.Paths.AbsoluteBaseFolderPath, retainedFileName), nil, fileMode); err != nil {
+		return false, err
+	}
+	// the retained working directory isn't returned to the pool, so the pool prepares another one
+	if lc.pool != nil {
+		lc.pool.release(lc)
+	}
+	return true, os.Remove(keepFilePath)
+}
+
+// GetRetainedFolder returns the base folder of the pipeline which is retained by RetainFolders during retention.
+// If the base folder isn't retained or its retention is expired, returns an error which wraps ErrNotRetained.
+func GetRetainedFolder(pipelinesFolder string, pipelineId uuid.UUID, retention time.Duration) (string, error) {
+	folder := filepath.Join(pipelinesFolder, pipelineId.String())
+	expired, err := isRetentionExpired(folder, retention)
+	if err != nil {
+		return "", err
+	}
+	if expired {
+		return "", fmt.Errorf("%s: retention of %s is expired: %w", pipelineId, retention, ErrNotRetained)
+	}
+	return folder, nil
+}
+
+// DeleteExpiredRetainedFolders deletes base folders of pipelines which are retained by RetainFolders longer than retention.
+// Folders of pipelines which aren't retained aren't touched.
+// Returns ids of pipelines whose folders are deleted.
+func DeleteExpiredRetainedFolders(pipelinesFolder string, retention time.Duration) ([]uuid.UUID, error) {
+	entries, err := os.ReadDir(pipelinesFolder)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	deleted := make([]uuid.UUID, 0)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		pipelineId, err := uuid.Parse(entry.Name())
+		if err != nil {
+			continue
+		}
+		folder := filepath.Join(pipelinesFolder, entry.Name())
+		expired, err := isRetentionExpired(folder, retention)
+		if errors.Is(err, ErrNotRetained) {
+			continue
+		}
+		if err != nil {
+			return deleted, err
+		}
+		if !expired {
+			continue
+		}
+		if err = os.RemoveAll(folder); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, pipelineId)
+	}
+	return deleted, nil
+}
+
+// isRetentionExpired returns true if the folder is retained by RetainFolders longer than retention.
+// If the folder isn't retained, returns an error which wraps ErrNotRetained.
+func isRetentionExpired(folder string, retention time.Duration) (bool, error) {
+	info, err := os.Stat(filepath.Join(folder, retainedFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, fmt.Errorf("%s: %w", folder, ErrNotRetained)
+	}
+	if err != nil {
+		return false, err
+	}
+	return time.Since(info.ModTime()) >= retention, nil
+}
+
+// ZipFolder writes the zip archive with all files of the folder to w. Paths of files in the archive are relative to the folder.
+// Files which base names or relative paths match one of excludePatterns (see filepath.Match) aren't added to the archive,
+// as well as files of folders which match them. Files which mark the folder to be retained aren't added either.
+func ZipFolder(w io.Writer, folder string, excludePatterns []string) error {
+	archive := zip.NewWriter(w)
+	err := filepath.WalkDir(folder, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == folder {
+			return nil
+		}
+		name, err := filepath.Rel(folder, path)
+		if err != nil {
+			return err
+		}
+		excluded, err := isExcluded(name, excludePatterns)
+		if err != nil {
+			return err
+		}
+		if excluded {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() || !entry.Type().IsRegular() {
+			return nil
+		}
+		return addFileToZip(archive, path, filepath.ToSlash(name))
+	})
+	if err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+// isExcluded returns true if the base name or the relative path of the file matches one of excludePatterns
+// or the file marks the folder to be retained
+func isExcluded(name string, excludePatterns []string) (bool, error) {
+	if name == keepFileName || name == retainedFileName {
+		return true, nil
+	}
+	for _, pattern := range excludePatterns {
+		for _, candidate := range []string{filepath.Base(name), name} {
+			matched, err := filepath.Match(pattern, candidate)
+			if err != nil {
+				return false, err
+			}
+			if matched {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// addFileToZip adds the file by path to the archive with the name
+func addFileToZip(archive *zip.Writer, path, name string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	header.Method = zip.Deflate
+	writer, err := archive.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(writer, file)
+	return err
+}
@@ -0,0 +1,291 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs_tool
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"github.com/google/uuid"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestLifeCycle_RetainFolders(t *testing.T) {
+	pipelinesFolder := t.TempDir()
+	tests := []struct {
+		name         string
+		keep         bool
+		want         bool
+		wantRetained bool
+	}{
+		{
+			// Test case with the code which is requested to be kept.
+			// As a result, want the retention of the base folder to be started.
+			name:         "keep file is created",
+			keep:         true,
+			want:         true,
+			wantRetained: true,
+		},
+		{
+			// Test case with the code which isn't requested to be kept.
+			// As a result, want the base folder not to be retained.
+			name:         "keep file isn't created",
+			keep:         false,
+			want:         false,
+			wantRetained: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			lc := newPythonLifeCycle(pipelineId, pipelinesFolder)
+			if err := lc.CreateFolders(); err != nil {
+				t.Fatalf("error during prepare folders: %s", err.Error())
+			}
+			if tt.keep {
+				if err := lc.CreateKeepFile(); err != nil {
+					t.Fatalf("CreateKeepFile() error = %v", err)
+				}
+				// the code isn't finished yet, so the folder isn't retained
+				if _, err := GetRetainedFolder(pipelinesFolder, pipelineId, time.Hour); !errors.Is(err, ErrNotRetained) {
+					t.Fatalf("GetRetainedFolder() before RetainFolders() error = %v, want %v", err, ErrNotRetained)
+				}
+			}
+			got, err := lc.RetainFolders()
+			if err != nil {
+				t.Fatalf("RetainFolders() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RetainFolders() = %v, want %v", got, tt.want)
+			}
+			folder, err := GetRetainedFolder(pipelinesFolder, pipelineId, time.Hour)
+			if (err == nil) != tt.wantRetained {
+				t.Fatalf("GetRetainedFolder() error = %v, want retained %v", err, tt.wantRetained)
+			}
+			if tt.wantRetained && folder != lc.Paths.AbsoluteBaseFolderPath {
+				t.Errorf("GetRetainedFolder() = %s, want %s", folder, lc.Paths.AbsoluteBaseFolderPath)
+			}
+		})
+	}
+}
+
+func TestDeleteExpiredRetainedFolders(t *testing.T) {
+	pipelinesFolder := t.TempDir()
+	createFolder := func(pipelineId uuid.UUID, retainedFrom time.Time) string {
+		lc := newPythonLifeCycle(pipelineId, pipelinesFolder)
+		if err := lc.CreateFolders(); err != nil {
+			t.Fatalf("error during prepare folders: %s", err.Error())
+		}
+		if retainedFrom.IsZero() {
+			return lc.Paths.AbsoluteBaseFolderPath
+		}
+		if err := lc.CreateKeepFile(); err != nil {
+			t.Fatalf("error during prepare files: %s", err.Error())
+		}
+		if _, err := lc.RetainFolders(); err != nil {
+			t.Fatalf("error during retain folders: %s", err.Error())
+		}
+		retainedFile := filepath.Join(lc.Paths.AbsoluteBaseFolderPath, retainedFileName)
+		if err := os.Chtimes(retainedFile, retainedFrom, retainedFrom); err != nil {
+			t.Fatalf("error during change modification time: %s", err.Error())
+		}
+		return lc.Paths.AbsoluteBaseFolderPath
+	}
+
+	expiredPipelineId := uuid.New()
+	recentPipelineId := uuid.New()
+	expiredFolder := createFolder(expiredPipelineId, time.Now().Add(-2*time.Hour))
+	recentFolder := createFolder(recentPipelineId, time.Now())
+	notRetainedFolder := createFolder(uuid.New(), time.Time{})
+
+	tests := []struct {
+		name            string
+		pipelinesFolder string
+		want            []uuid.UUID
+		wantDeleted     []string
+		wantKept        []string
+	}{
+		{
+			// Test case with the pipelines folder which contains expired and recent retained folders and the folder
+			// of the pipeline which isn't retained.
+			// As a result, want to delete only the retained folder which retention is expired.
+			name:            "expired and recent folders",
+			pipelinesFolder: pipelinesFolder,
+			want:            []uuid.UUID{expiredPipelineId},
+			wantDeleted:     []string{expiredFolder},
+			wantKept:        []string{recentFolder, notRetainedFolder},
+		},
+		{
+			// Test case with the pipelines folder which doesn't exist.
+			// As a result, want nothing to be deleted without an error.
+			name:            "pipelines folder doesn't exist",
+			pipelinesFolder: filepath.Join(pipelinesFolder, "MOCK_FOLDER"),
+			want:            nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeleteExpiredRetainedFolders(tt.pipelinesFolder, time.Hour)
+			if err != nil {
+				t.Fatalf("DeleteExpiredRetainedFolders() error = %v", err)
+			}
+			if len(got) != len(tt.want) || (len(got) > 0 && !reflect.DeepEqual(got, tt.want)) {
+				t.Errorf("DeleteExpiredRetainedFolders() = %v, want %v", got, tt.want)
+			}
+			for _, folder := range tt.wantDeleted {
+				if _, err := os.Stat(folder); !os.IsNotExist(err) {
+					t.Errorf("DeleteExpiredRetainedFolders() doesn't delete %s", folder)
+				}
+			}
+			for _, folder := range tt.wantKept {
+				if _, err := os.Stat(folder); err != nil {
+					t.Errorf("DeleteExpiredRetainedFolders() deletes %s", folder)
+				}
+			}
+		})
+	}
+	if _, err := GetRetainedFolder(pipelinesFolder, recentPipelineId, time.Hour); err != nil {
+		t.Errorf("GetRetainedFolder() of the recent folder error = %v", err)
+	}
+	if _, err := GetRetainedFolder(pipelinesFolder, recentPipelineId, 0); !errors.Is(err, ErrNotRetained) {
+		t.Errorf("GetRetainedFolder() of the expired folder error = %v, want %v", err, ErrNotRetained)
+	}
+}
+
+func TestZipFolder(t *testing.T) {
+	folder := t.TempDir()
+	files := map[string]string{
+		"src/main.py":           "MOCK_CODE",
+		"src/lib/util.py":       "MOCK_LIB",
+		"bin/main.jar":          "MOCK_JAR",
+		"env_vars.json":         "MOCK_SECRET",
+		"secrets/key.txt":       "MOCK_KEY",
+		"src/credentials.pem":   "MOCK_PEM",
+		keepFileName:            "",
+		retainedFileName:        "",
+		"logs.log":              "MOCK_LOGS",
+		"src/lib/unused/.empty": "",
+	}
+	for name, content := range files {
+		path := filepath.Join(folder, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			t.Fatalf("error during prepare folders: %s", err.Error())
+		}
+		if err := os.WriteFile(path, []byte(content), fileMode); err != nil {
+			t.Fatalf("error during prepare files: %s", err.Error())
+		}
+	}
+
+	tests := []struct {
+		name            string
+		excludePatterns []string
+		want            map[string]string
+		wantErr         bool
+	}{
+		{
+			// Test case with zipping the folder without exclude patterns.
+			// As a result, want all files except files of the retention in the archive.
+			name:            "without exclude patterns",
+			excludePatterns: nil,
+			want: map[string]string{
+				"src/main.py":           "MOCK_CODE",
+				"src/lib/util.py":       "MOCK_LIB",
+				"bin/main.jar":          "MOCK_JAR",
+				"env_vars.json":         "MOCK_SECRET",
+				"secrets/key.txt":       "MOCK_KEY",
+				"src/credentials.pem":   "MOCK_PEM",
+				"logs.log":              "MOCK_LOGS",
+				"src/lib/unused/.empty": "",
+			},
+			wantErr: false,
+		},
+		{
+			// Test case with zipping the folder with patterns which match base names of files, the relative path of the file
+			// and the folder.
+			// As a result, want files which match patterns and files of the matched folder not to be in the archive.
+			name:            "with exclude patterns",
+			excludePatterns: []string{"env_vars.json", "*.pem", "secrets", "src/lib/unused/*"},
+			want: map[string]string{
+				"src/main.py":     "MOCK_CODE",
+				"src/lib/util.py": "MOCK_LIB",
+				"bin/main.jar":    "MOCK_JAR",
+				"logs.log":        "MOCK_LOGS",
+			},
+			wantErr: false,
+		},
+		{
+			// Test case with zipping the folder with the incorrect pattern.
+			// As a result, want to receive an error.
+			name:            "incorrect pattern",
+			excludePatterns: []string{"[MOCK"},
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			err := ZipFolder(&buffer, folder, tt.excludePatterns)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ZipFolder() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got := readZip(t, buffer.Bytes())
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ZipFolder() files = %v, want %v", sortedNames(got), sortedNames(tt.want))
+			}
+		})
+	}
+}
+
+// readZip returns contents of files of the zip archive by their names
+func readZip(t *testing.T, data []byte) map[string]string {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("error during read zip archive: %s", err.Error())
+	}
+	files := make(map[string]string, len(reader.File))
+	for _, file := range reader.File {
+		fileReader, err := file.Open()
+		if err != nil {
+			t.Fatalf("error during open %s: %s", file.Name, err.Error())
+		}
+		content, err := io.ReadAll(fileReader)
+		fileReader.Close()
+		if err != nil {
+			t.Fatalf("error during read %s: %s", file.Name, err.Error())
+		}
+		files[file.Name] = string(content)
+	}
+	return files
+}
+
+// sortedNames returns sorted names of files
+func sortedNames(files map[string]string) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
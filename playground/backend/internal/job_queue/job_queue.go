@@ -0,0 +1,112 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job_queue
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// ErrTimeout is returned by Queue when the job waits for the free slot more than the timeout of the queue
+var ErrTimeout = errors.New("timeout of waiting in the queue is exceeded")
+
+// Queue limits the number of jobs which are executed at the same time.
+// Jobs which exceed the limit wait for the free slot at most the timeout of the queue.
+type Queue struct {
+	// slots keeps a value for each running job, nil if the number of jobs isn't limited
+	slots   chan struct{}
+	timeout time.Duration
+	waiting int32
+}
+
+// New returns Queue which executes at most maxJobs jobs at the same time.
+// If maxJobs isn't positive, the number of jobs isn't limited.
+// If timeout isn't positive, jobs wait for the free slot until their context is done.
+func New(maxJobs int, timeout time.Duration) *Queue {
+	q := &Queue{timeout: timeout}
+	if maxJobs > 0 {
+		q.slots = make(chan struct{}, maxJobs)
+	}
+	return q
+}
+
+// Timeout returns the max duration of waiting for the free slot
+func (q *Queue) Timeout() time.Duration {
+	return q.timeout
+}
+
+// TryAcquire takes the free slot without waiting.
+// Returns the function which releases the slot and true if the slot is taken.
+func (q *Queue) TryAcquire() (func(), bool) {
+	if q.slots == nil {
+		return func() {}, true
+	}
+	select {
+	case q.slots <- struct{}{}:
+		return q.releaseFunc(), true
+	default:
+		return nil, false
+	}
+}
+
+// Acquire waits for the free slot and takes it. Returns the function which releases the slot.
+// If the job waits more than the timeout of the queue, returns ErrTimeout.
+// If ctx is done earlier, returns the error of ctx.
+func (q *Queue) Acquire(ctx context.Context) (func(), error) {
+	if release, ok := q.TryAcquire(); ok {
+		return release, nil
+	}
+	atomic.AddInt32(&q.waiting, 1)
+	defer atomic.AddInt32(&q.waiting, -1)
+
+	var timeout <-chan time.Time
+	if q.timeout > 0 {
+		timer := time.NewTimer(q.timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case q.slots <- struct{}{}:
+		return q.releaseFunc(), nil
+	case <-timeout:
+		return nil, ErrTimeout
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Running returns the number of jobs which keep slots of the queue.
+// If the number of jobs isn't limited, returns 0.
+func (q *Queue) Running() int {
+	return len(q.slots)
+}
+
+// Waiting returns the number of jobs which wait for the free slot
+func (q *Queue) Waiting() int {
+	return int(atomic.LoadInt32(&q.waiting))
+}
+
+// releaseFunc returns the function which releases the taken slot only once
+func (q *Queue) releaseFunc() func() {
+	var released int32
+	return func() {
+		if atomic.CompareAndSwapInt32(&released, 0, 1) {
+			<-q.slots
+		}
+	}
+}
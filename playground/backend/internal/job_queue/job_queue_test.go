@@ -0,0 +1,140 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job_queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestQueue_Acquire(t *testing.T) {
+	tests := []struct {
+		name    string
+		maxJobs int
+		timeout time.Duration
+		running int
+		// release is true if the first job releases the slot while the last job waits
+		release bool
+		wantErr error
+	}{
+		{
+			// Test case with acquiring slots of the queue which isn't limited.
+			// As a result, want all jobs to be executed without waiting.
+			name:    "not limited queue",
+			maxJobs: 0,
+			timeout: time.Millisecond,
+			running: 5,
+			wantErr: nil,
+		},
+		{
+			// Test case with acquiring the slot of the queue when less than maxJobs jobs are running.
+			// As a result, want the job to be executed without waiting.
+			name:    "free slot",
+			maxJobs: 2,
+			timeout: time.Millisecond,
+			running: 1,
+			wantErr: nil,
+		},
+		{
+			// Test case with acquiring the slot by the (N+1)th job when the first job finishes while it waits.
+			// As a result, want the job to wait and to take the released slot.
+			name:    "slot is released while waiting",
+			maxJobs: 2,
+			timeout: time.Minute,
+			running: 2,
+			release: true,
+			wantErr: nil,
+		},
+		{
+			// Test case with acquiring the slot by the (N+1)th job when no job finishes.
+			// As a result, want to receive ErrTimeout after the timeout of the queue.
+			name:    "timeout of waiting",
+			maxJobs: 2,
+			timeout: 50 * time.Millisecond,
+			running: 2,
+			wantErr: ErrTimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := New(tt.maxJobs, tt.timeout)
+			var releases []func()
+			for i := 0; i < tt.running; i++ {
+				release, err := q.Acquire(context.Background())
+				if err != nil {
+					t.Fatalf("Acquire() of running job error = %v", err)
+				}
+				releases = append(releases, release)
+			}
+			if tt.release {
+				go func() {
+					for q.Waiting() == 0 {
+						time.Sleep(time.Millisecond)
+					}
+					releases[0]()
+				}()
+			}
+
+			release, err := q.Acquire(context.Background())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Acquire() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil {
+				release()
+			}
+			if q.Waiting() != 0 {
+				t.Errorf("Waiting() = %d, want 0", q.Waiting())
+			}
+		})
+	}
+}
+
+func TestQueue_AcquireContextDone(t *testing.T) {
+	q := New(1, time.Minute)
+	release, _ := q.Acquire(context.Background())
+	defer release()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := q.Acquire(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Acquire() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestQueue_Release(t *testing.T) {
+	q := New(1, time.Millisecond)
+	release, ok := q.TryAcquire()
+	if !ok {
+		t.Fatalf("TryAcquire() = false, want true")
+	}
+	if _, ok := q.TryAcquire(); ok {
+		t.Fatalf("TryAcquire() of the second job = true, want false")
+	}
+	if q.Running() != 1 {
+		t.Errorf("Running() = %d, want 1", q.Running())
+	}
+	// releasing the slot twice doesn't release the slot of another job
+	release()
+	release()
+	if q.Running() != 0 {
+		t.Errorf("Running() after release = %d, want 0", q.Running())
+	}
+	if _, ok := q.TryAcquire(); !ok {
+		t.Errorf("TryAcquire() after release = false, want true")
+	}
+}
@@ -0,0 +1,195 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log_entries
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultSeverity is the severity of lines of logs which don't contain their level
+const DefaultSeverity = pb.LogSeverity_LOG_SEVERITY_INFO
+
+var (
+	// levelRegexp matches the level at the beginning of the line like "INFO: message", "[WARN] message" or "ERROR:root:message"
+	levelRegexp = regexp.MustCompile(`^(?:\[([A-Za-z]+)\]|([A-Z]+)(?::|\s|$))\s*`)
+	// loggerNameRegexp matches the name of the Python logger after the level like "root:" in "ERROR:root:message"
+	loggerNameRegexp = regexp.MustCompile(`^[\w.]+:`)
+	// timestampRegexp matches the timestamp at the beginning of the line like "2022-01-02 15:04:05,123" or "2022/01/02 15:04:05"
+	timestampRegexp = regexp.MustCompile(`^(\d{4}[-/]\d{2}[-/]\d{2}[ T]\d{2}:\d{2}:\d{2}(?:[.,]\d+)?)(Z|[+-]\d{2}:?\d{2})?\s*`)
+	// javaHeaderRegexp matches the first line of the java.util.logging.SimpleFormatter record like "Jan 02, 2022 3:04:05 PM Class method"
+	javaHeaderRegexp = regexp.MustCompile(`^([A-Z][a-z]{2} \d{1,2}, \d{4} \d{1,2}:\d{2}:\d{2} [AP]M)( \S+){0,2}$`)
+
+	// severities contains severities by levels of logging libraries of SDKs
+	severities = map[string]pb.LogSeverity{
+		"TRACE":    pb.LogSeverity_LOG_SEVERITY_DEBUG,
+		"FINEST":   pb.LogSeverity_LOG_SEVERITY_DEBUG,
+		"FINER":    pb.LogSeverity_LOG_SEVERITY_DEBUG,
+		"FINE":     pb.LogSeverity_LOG_SEVERITY_DEBUG,
+		"DEBUG":    pb.LogSeverity_LOG_SEVERITY_DEBUG,
+		"CONFIG":   pb.LogSeverity_LOG_SEVERITY_INFO,
+		"INFO":     pb.LogSeverity_LOG_SEVERITY_INFO,
+		"WARN":     pb.LogSeverity_LOG_SEVERITY_WARNING,
+		"WARNING":  pb.LogSeverity_LOG_SEVERITY_WARNING,
+		"ERROR":    pb.LogSeverity_LOG_SEVERITY_ERROR,
+		"SEVERE":   pb.LogSeverity_LOG_SEVERITY_ERROR,
+		"CRITICAL": pb.LogSeverity_LOG_SEVERITY_ERROR,
+		"FATAL":    pb.LogSeverity_LOG_SEVERITY_ERROR,
+	}
+)
+
+// jsonLine is the line of logs which is written in JSON format by structured loggers
+type jsonLine struct {
+	Severity  string `json:"severity"`
+	Level     string `json:"level"`
+	Time      string `json:"time"`
+	Timestamp string `json:"timestamp"`
+	Message   string `json:"message"`
+	Msg       string `json:"msg"`
+}
+
+// Parse returns structured entries of logs in the order they are written.
+// Lines which contain the level (and optionally the time before it) start new entries,
+// as well as lines in JSON format with "severity" or "level" fields.
+// java.util.logging.SimpleFormatter records are parsed from their two lines.
+// Indented lines and "Caused by:" lines (e.g. stack traces) are appended to the message of the previous entry.
+// Other lines are entries with DefaultSeverity.
+func Parse(logs string) []cache.LogEntry {
+	entries := make([]cache.LogEntry, 0)
+	var headerTime int64
+	for _, line := range strings.Split(logs, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if isContinuation(line) && len(entries) > 0 {
+			entries[len(entries)-1].Message += "\n" + line
+			continue
+		}
+		if match := javaHeaderRegexp.FindStringSubmatch(line); match != nil {
+			if t, err := time.ParseInLocation("Jan 2, 2006 3:04:05 PM", match[1], time.Local); err == nil {
+				headerTime = t.UnixNano()
+				continue
+			}
+		}
+		entry, ok := parseJsonLine(line)
+		if !ok {
+			entry = parseLine(line)
+		}
+		if entry.Timestamp == 0 {
+			entry.Timestamp = headerTime
+		}
+		headerTime = 0
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// Filter returns entries which severity isn't lower than minSeverity and which time is in the range from start to end.
+// If start or end isn't zero, entries without the time are skipped.
+func Filter(entries []cache.LogEntry, minSeverity pb.LogSeverity, start, end time.Time) []cache.LogEntry {
+	filtered := make([]cache.LogEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Severity < minSeverity {
+			continue
+		}
+		if !start.IsZero() || !end.IsZero() {
+			if entry.Timestamp == 0 {
+				continue
+			}
+			entryTime := time.Unix(0, entry.Timestamp)
+			if (!start.IsZero() && entryTime.Before(start)) || (!end.IsZero() && entryTime.After(end)) {
+				continue
+			}
+		}
+		filtered = append(filtered, entry)
+	}
+	return filtered
+}
+
+// isContinuation returns true if the line continues the previous entry
+func isContinuation(line string) bool {
+	return line[0] == ' ' || line[0] == '\t' || strings.HasPrefix(line, "Caused by:")
+}
+
+// parseLine parses the line with the optional time and level at its beginning
+func parseLine(line string) cache.LogEntry {
+	entry := cache.LogEntry{Severity: DefaultSeverity, Message: line}
+	rest := line
+	if match := timestampRegexp.FindStringSubmatch(rest); match != nil {
+		if t, ok := parseTime(match[1], match[2]); ok {
+			entry.Timestamp = t.UnixNano()
+			rest = rest[len(match[0]):]
+		}
+	}
+	if match := levelRegexp.FindStringSubmatch(rest); match != nil {
+		level := match[1] + match[2]
+		if severity, ok := severities[strings.ToUpper(level)]; ok {
+			entry.Severity = severity
+			rest = rest[len(match[0]):]
+			if strings.HasSuffix(match[0], ":") {
+				rest = strings.TrimPrefix(rest, loggerNameRegexp.FindString(rest))
+			}
+			entry.Message = rest
+			return entry
+		}
+	}
+	if entry.Timestamp != 0 {
+		entry.Message = rest
+	}
+	return entry
+}
+
+// parseJsonLine parses the line in JSON format. Returns false if the line isn't in JSON format or doesn't contain the level.
+func parseJsonLine(line string) (cache.LogEntry, bool) {
+	if !strings.HasPrefix(line, "{") {
+		return cache.LogEntry{}, false
+	}
+	var value jsonLine
+	if err := json.Unmarshal([]byte(line), &value); err != nil {
+		return cache.LogEntry{}, false
+	}
+	severity, ok := severities[strings.ToUpper(value.Severity+value.Level)]
+	if !ok {
+		return cache.LogEntry{}, false
+	}
+	entry := cache.LogEntry{Severity: severity, Message: value.Message + value.Msg}
+	if t, err := time.Parse(time.RFC3339Nano, value.Time+value.Timestamp); err == nil {
+		entry.Timestamp = t.UnixNano()
+	}
+	return entry, true
+}
+
+// parseTime parses the time of the line. If the time doesn't contain the zone, it is in the local zone.
+func parseTime(value, zone string) (time.Time, bool) {
+	value = strings.NewReplacer("/", "-", "T", " ", ",", ".").Replace(value)
+	if zone == "" {
+		t, err := time.ParseInLocation("2006-01-02 15:04:05.999999999", value, time.Local)
+		return t, err == nil
+	}
+	if zone == "Z" {
+		zone = "+00:00"
+	}
+	if !strings.Contains(zone, ":") {
+		zone = zone[:3] + ":" + zone[3:]
+	}
+	t, err := time.Parse("2006-01-02 15:04:05.999999999-07:00", value+zone)
+	return t, err == nil
+}
@@ -0,0 +1,151 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log_entries
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	localTime := time.Date(2022, 1, 2, 15, 4, 5, 0, time.Local)
+	tests := []struct {
+		name string
+		logs string
+		want []cache.LogEntry
+	}{
+		{
+			// Test case with logs of java.util.logging.SimpleFormatter with the stack trace.
+			// As a result, want entries with levels and times of records and the stack trace in the message.
+			name: "java logs",
+			logs: "Jan 02, 2022 3:04:05 PM org.apache.beam.Example main\nINFO: Pipeline is started\n" +
+				"Jan 02, 2022 3:04:05 PM org.apache.beam.Example main\nSEVERE: Pipeline is failed\n\tat org.apache.beam.Example.main(Example.java:42)\n",
+			want: []cache.LogEntry{
+				{Severity: pb.LogSeverity_LOG_SEVERITY_INFO, Timestamp: localTime.UnixNano(), Message: "Pipeline is started"},
+				{Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Timestamp: localTime.UnixNano(), Message: "Pipeline is failed\n\tat org.apache.beam.Example.main(Example.java:42)"},
+			},
+		},
+		{
+			// Test case with logs of the Python logging library with and without the time.
+			// As a result, want entries with levels and messages without names of loggers.
+			name: "python logs",
+			logs: "WARNING:root:Make sure that locally built Python SDK docker image is available\n2022-01-02 15:04:05,250 DEBUG urllib3: request\n",
+			want: []cache.LogEntry{
+				{Severity: pb.LogSeverity_LOG_SEVERITY_WARNING, Message: "Make sure that locally built Python SDK docker image is available"},
+				{Severity: pb.LogSeverity_LOG_SEVERITY_DEBUG, Timestamp: localTime.Add(250 * time.Millisecond).UnixNano(), Message: "urllib3: request"},
+			},
+		},
+		{
+			// Test case with lines in JSON format and the line with the time in UTC.
+			// As a result, want entries with levels and times from lines.
+			name: "structured logs",
+			logs: `{"severity":"ERROR","time":"2022-01-02T15:04:05Z","message":"MOCK_ERROR"}` + "\n" + `{"level":"warn","msg":"MOCK_WARNING"}` + "\n2022-01-02T15:04:05Z [WARN] MOCK_WARNING\n",
+			want: []cache.LogEntry{
+				{Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Timestamp: time.Date(2022, 1, 2, 15, 4, 5, 0, time.UTC).UnixNano(), Message: "MOCK_ERROR"},
+				{Severity: pb.LogSeverity_LOG_SEVERITY_WARNING, Message: "MOCK_WARNING"},
+				{Severity: pb.LogSeverity_LOG_SEVERITY_WARNING, Timestamp: time.Date(2022, 1, 2, 15, 4, 5, 0, time.UTC).UnixNano(), Message: "MOCK_WARNING"},
+			},
+		},
+		{
+			// Test case with unstructured lines and the line of the Go log package.
+			// As a result, want entries with the default severity.
+			name: "unstructured logs",
+			logs: "Hello world!\nOK MOCK_MESSAGE\n\n2022/01/02 15:04:05 Executing pipeline\n",
+			want: []cache.LogEntry{
+				{Severity: DefaultSeverity, Message: "Hello world!"},
+				{Severity: DefaultSeverity, Message: "OK MOCK_MESSAGE"},
+				{Severity: DefaultSeverity, Timestamp: localTime.UnixNano(), Message: "Executing pipeline"},
+			},
+		},
+		{
+			// Test case with empty logs.
+			// As a result, want no entries.
+			name: "empty logs",
+			logs: "",
+			want: []cache.LogEntry{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Parse(tt.logs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	start := time.Date(2022, 1, 2, 15, 0, 0, 0, time.UTC)
+	entries := []cache.LogEntry{
+		{Severity: pb.LogSeverity_LOG_SEVERITY_DEBUG, Timestamp: start.UnixNano(), Message: "MOCK_DEBUG"},
+		{Severity: pb.LogSeverity_LOG_SEVERITY_INFO, Timestamp: start.Add(time.Minute).UnixNano(), Message: "MOCK_INFO"},
+		{Severity: pb.LogSeverity_LOG_SEVERITY_WARNING, Message: "MOCK_WARNING"},
+		{Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Timestamp: start.Add(2 * time.Minute).UnixNano(), Message: "MOCK_ERROR"},
+	}
+	type args struct {
+		minSeverity pb.LogSeverity
+		start       time.Time
+		end         time.Time
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			// Test case with filtering without the min severity and the time range.
+			// As a result, want all entries.
+			name: "no filters",
+			args: args{minSeverity: pb.LogSeverity_LOG_SEVERITY_UNSPECIFIED},
+			want: []string{"MOCK_DEBUG", "MOCK_INFO", "MOCK_WARNING", "MOCK_ERROR"},
+		},
+		{
+			// Test case with filtering by the min severity.
+			// As a result, want entries which severity isn't lower than WARNING.
+			name: "min severity",
+			args: args{minSeverity: pb.LogSeverity_LOG_SEVERITY_WARNING},
+			want: []string{"MOCK_WARNING", "MOCK_ERROR"},
+		},
+		{
+			// Test case with filtering by the time range.
+			// As a result, want entries with times in the range including its bounds.
+			name: "time range",
+			args: args{start: start.Add(time.Minute), end: start.Add(2 * time.Minute)},
+			want: []string{"MOCK_INFO", "MOCK_ERROR"},
+		},
+		{
+			// Test case with filtering by the min severity and the start of the time range.
+			// As a result, want entries which satisfy both filters.
+			name: "min severity and start time",
+			args: args{minSeverity: pb.LogSeverity_LOG_SEVERITY_INFO, start: start.Add(30 * time.Second)},
+			want: []string{"MOCK_INFO", "MOCK_ERROR"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]string, 0)
+			for _, entry := range Filter(entries, tt.args.minSeverity, tt.args.start, tt.args.end) {
+				got = append(got, entry.Message)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
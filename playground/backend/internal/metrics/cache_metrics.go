@@ -0,0 +1,98 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"time"
+)
+
+const (
+	getValueOperation = "GetValue"
+	setValueOperation = "SetValue"
+)
+
+// latencyBuckets are upper bounds in seconds of the operation latency histogram
+var latencyBuckets = []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5}
+
+// CacheMetrics records metrics of cache operations and exposes them in Prometheus text format
+type CacheMetrics struct {
+	registry  *prometheus.Registry
+	handler   http.Handler
+	getValues *prometheus.CounterVec
+	setValues prometheus.Counter
+	errors    *prometheus.CounterVec
+	latencies *prometheus.HistogramVec
+}
+
+// NewCacheMetrics returns CacheMetrics without any recorded operation.
+// Metrics are registered in their own registry, so several CacheMetrics could be used at the same time.
+func NewCacheMetrics() *CacheMetrics {
+	m := &CacheMetrics{
+		registry: prometheus.NewRegistry(),
+		getValues: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "playground_cache_get_value_total",
+			Help: "Number of GetValue operations by result.",
+		}, []string{"result"}),
+		setValues: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "playground_cache_set_value_total",
+			Help: "Number of SetValue operations.",
+		}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "playground_cache_errors_total",
+			Help: "Number of failed cache operations.",
+		}, []string{"operation"}),
+		latencies: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "playground_cache_operation_duration_seconds",
+			Help:    "Latency of cache operations.",
+			Buckets: latencyBuckets,
+		}, []string{"operation"}),
+	}
+	m.registry.MustRegister(m.getValues, m.setValues, m.errors, m.latencies)
+	m.handler = promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+
+	// counters and histograms of known label values are exposed even before the first operation
+	m.getValues.WithLabelValues("hit")
+	m.getValues.WithLabelValues("miss")
+	m.latencies.WithLabelValues(getValueOperation)
+	m.latencies.WithLabelValues(setValueOperation)
+	return m
+}
+
+func (m *CacheMetrics) ObserveGetValue(hit bool, latency time.Duration) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.getValues.WithLabelValues(result).Inc()
+	m.latencies.WithLabelValues(getValueOperation).Observe(latency.Seconds())
+}
+
+func (m *CacheMetrics) ObserveSetValue(latency time.Duration) {
+	m.setValues.Inc()
+	m.latencies.WithLabelValues(setValueOperation).Observe(latency.Seconds())
+}
+
+func (m *CacheMetrics) ObserveError(operation string) {
+	m.errors.WithLabelValues(operation).Inc()
+}
+
+// ServeHTTP writes recorded metrics using Prometheus text format
+func (m *CacheMetrics) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	m.handler.ServeHTTP(writer, request)
+}
@@ -0,0 +1,82 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCacheMetrics_ServeHTTP(t *testing.T) {
+	tests := []struct {
+		name      string
+		observe   func(m *CacheMetrics)
+		wantLines []string
+	}{
+		{
+			// Test case with writing metrics without any recorded operation.
+			// As a result, want to receive zero counters.
+			name:    "no operations",
+			observe: func(m *CacheMetrics) {},
+			wantLines: []string{
+				`playground_cache_get_value_total{result="hit"} 0`,
+				`playground_cache_get_value_total{result="miss"} 0`,
+				`playground_cache_set_value_total 0`,
+				`playground_cache_operation_duration_seconds_count{operation="GetValue"} 0`,
+			},
+		},
+		{
+			// Test case with writing metrics after several recorded operations.
+			// As a result, want to receive counters and histogram according to the operations.
+			name: "several operations",
+			observe: func(m *CacheMetrics) {
+				m.ObserveGetValue(true, time.Millisecond*3)
+				m.ObserveGetValue(true, time.Millisecond*3)
+				m.ObserveGetValue(false, time.Second*2)
+				m.ObserveSetValue(time.Millisecond)
+				m.ObserveError("SetValue")
+			},
+			wantLines: []string{
+				`playground_cache_get_value_total{result="hit"} 2`,
+				`playground_cache_get_value_total{result="miss"} 1`,
+				`playground_cache_set_value_total 1`,
+				`playground_cache_errors_total{operation="SetValue"} 1`,
+				`playground_cache_operation_duration_seconds_bucket{operation="GetValue",le="0.005"} 2`,
+				`playground_cache_operation_duration_seconds_bucket{operation="GetValue",le="2.5"} 3`,
+				`playground_cache_operation_duration_seconds_bucket{operation="GetValue",le="+Inf"} 3`,
+				`playground_cache_operation_duration_seconds_count{operation="GetValue"} 3`,
+				`playground_cache_operation_duration_seconds_bucket{operation="SetValue",le="0.001"} 1`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewCacheMetrics()
+			tt.observe(m)
+			recorder := httptest.NewRecorder()
+			m.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+			got := recorder.Body.String()
+			for _, line := range tt.wantLines {
+				if !strings.Contains(got, line+"\n") {
+					t.Errorf("ServeHTTP() got = %s, want line %s", got, line)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,340 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline_graph
+
+import (
+	"beam.apache.org/playground/backend/internal/cache"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// labelAttribute is the attribute of nodes and edges of the graph in DOT format which contains their names
+const labelAttribute = "label"
+
+// ErrIncorrectDot is returned if the graph isn't a correct graph in DOT format
+var ErrIncorrectDot = errors.New("incorrect graph in DOT format")
+
+// token is an id (e.g. a name, a number or a quoted string) or a punctuation mark of the graph in DOT format
+type token struct {
+	value string
+	isId  bool
+}
+
+// parser reads tokens of the graph in DOT format and collects nodes and edges in order of their first appearance
+type parser struct {
+	tokens   []token
+	position int
+	graph    cache.PipelineGraph
+	nodeIds  map[string]int
+}
+
+// ParseDot returns the structured graph of the graph in DOT format.
+// Labels of nodes and edges are taken from their "label" attributes, nodes without the label (e.g. nodes which
+// appear only in edges) are labeled by their ids. Nodes are ordered by their first appearance in the graph.
+// Attributes of the graph, default attributes of nodes and edges and ports are ignored,
+// nodes and edges of subgraphs are added to the graph.
+func ParseDot(dot string) (cache.PipelineGraph, error) {
+	tokens, err := tokenize(dot)
+	if err != nil {
+		return cache.PipelineGraph{}, err
+	}
+	p := &parser{tokens: tokens, graph: cache.PipelineGraph{Nodes: []cache.GraphNode{}, Edges: []cache.GraphEdge{}}, nodeIds: map[string]int{}}
+
+	// header: [strict] (graph | digraph) [id] {
+	header, ok := p.next()
+	if ok && header.isId && strings.EqualFold(header.value, "strict") {
+		header, ok = p.next()
+	}
+	if !ok || !header.isId || !(strings.EqualFold(header.value, "digraph") || strings.EqualFold(header.value, "graph")) {
+		return cache.PipelineGraph{}, fmt.Errorf("%w: the graph should start with \"digraph\"", ErrIncorrectDot)
+	}
+	if t, ok := p.peek(); ok && t.isId {
+		p.next()
+	}
+	if t, ok := p.next(); !ok || t.isId || t.value != "{" {
+		return cache.PipelineGraph{}, fmt.Errorf("%w: \"{\" is expected after the name of the graph", ErrIncorrectDot)
+	}
+
+	depth := 0
+	for {
+		t, ok := p.next()
+		if !ok {
+			return cache.PipelineGraph{}, fmt.Errorf("%w: \"}\" is expected at the end of the graph", ErrIncorrectDot)
+		}
+		switch {
+		case !t.isId && t.value == "}":
+			if depth == 0 {
+				if _, ok := p.peek(); ok {
+					return cache.PipelineGraph{}, fmt.Errorf("%w: unexpected statements after the graph", ErrIncorrectDot)
+				}
+				return p.graph, nil
+			}
+			depth--
+		case !t.isId && t.value == "{":
+			depth++
+		case !t.isId && (t.value == ";" || t.value == ","):
+		case t.isId && strings.EqualFold(t.value, "subgraph"):
+			if name, ok := p.peek(); ok && name.isId {
+				p.next()
+			}
+		case t.isId && (strings.EqualFold(t.value, "graph") || strings.EqualFold(t.value, "node") || strings.EqualFold(t.value, "edge")):
+			if _, err := p.parseAttributes(); err != nil {
+				return cache.PipelineGraph{}, err
+			}
+		case t.isId:
+			p.skipPort()
+			if n, ok := p.peek(); ok && !n.isId && n.value == "=" {
+				// an attribute of the graph like label="name"
+				p.next()
+				if value, ok := p.next(); !ok || !value.isId {
+					return cache.PipelineGraph{}, fmt.Errorf("%w: a value is expected after \"=\"", ErrIncorrectDot)
+				}
+				continue
+			}
+			nodes := []string{t.value}
+			for {
+				n, ok := p.peek()
+				if !ok || n.isId || (n.value != "->" && n.value != "--") {
+					break
+				}
+				p.next()
+				node, ok := p.next()
+				if !ok || !node.isId {
+					return cache.PipelineGraph{}, fmt.Errorf("%w: a node is expected after %q", ErrIncorrectDot, n.value)
+				}
+				p.skipPort()
+				nodes = append(nodes, node.value)
+			}
+			attributes, err := p.parseAttributes()
+			if err != nil {
+				return cache.PipelineGraph{}, err
+			}
+			label, hasLabel := attributes[labelAttribute]
+			if len(nodes) == 1 {
+				p.addNode(nodes[0], label, hasLabel)
+				continue
+			}
+			for i := 0; i < len(nodes)-1; i++ {
+				p.addNode(nodes[i], "", false)
+				p.addNode(nodes[i+1], "", false)
+				p.graph.Edges = append(p.graph.Edges, cache.GraphEdge{From: nodes[i], To: nodes[i+1], Label: label})
+			}
+		default:
+			return cache.PipelineGraph{}, fmt.Errorf("%w: unexpected %q", ErrIncorrectDot, t.value)
+		}
+	}
+}
+
+// ToDot returns the graph in DOT format with labels of nodes and edges
+func ToDot(graph cache.PipelineGraph) string {
+	var dot strings.Builder
+	dot.WriteString("digraph G {\n")
+	for _, node := range graph.Nodes {
+		dot.WriteString(fmt.Sprintf("  %s [label=%s];\n", quote(node.Id), quote(node.Label)))
+	}
+	for _, edge := range graph.Edges {
+		dot.WriteString(fmt.Sprintf("  %s -> %s", quote(edge.From), quote(edge.To)))
+		if edge.Label != "" {
+			dot.WriteString(fmt.Sprintf(" [label=%s]", quote(edge.Label)))
+		}
+		dot.WriteString(";\n")
+	}
+	dot.WriteString("}\n")
+	return dot.String()
+}
+
+// next returns the next token and moves to the token after it
+func (p *parser) next() (token, bool) {
+	t, ok := p.peek()
+	if ok {
+		p.position++
+	}
+	return t, ok
+}
+
+// peek returns the next token without moving to the token after it
+func (p *parser) peek() (token, bool) {
+	if p.position >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.position], true
+}
+
+// addNode adds the node if it isn't added yet and sets its label if the label is set
+func (p *parser) addNode(id, label string, hasLabel bool) {
+	index, ok := p.nodeIds[id]
+	if !ok {
+		index = len(p.graph.Nodes)
+		p.nodeIds[id] = index
+		p.graph.Nodes = append(p.graph.Nodes, cache.GraphNode{Id: id, Label: id})
+	}
+	if hasLabel {
+		p.graph.Nodes[index].Label = label
+	}
+}
+
+// parseAttributes parses lists of attributes like [name1=value1, name2=value2][name3=value3] if they follow
+func (p *parser) parseAttributes() (map[string]string, error) {
+	attributes := map[string]string{}
+	for {
+		t, ok := p.peek()
+		if !ok || t.isId || t.value != "[" {
+			return attributes, nil
+		}
+		p.next()
+		for {
+			name, ok := p.next()
+			if !ok {
+				return nil, fmt.Errorf("%w: \"]\" is expected at the end of attributes", ErrIncorrectDot)
+			}
+			if !name.isId && name.value == "]" {
+				break
+			}
+			if !name.isId && (name.value == "," || name.value == ";") {
+				continue
+			}
+			if !name.isId {
+				return nil, fmt.Errorf("%w: unexpected %q in attributes", ErrIncorrectDot, name.value)
+			}
+			if equals, ok := p.next(); !ok || equals.isId || equals.value != "=" {
+				return nil, fmt.Errorf("%w: \"=\" is expected after the attribute %s", ErrIncorrectDot, name.value)
+			}
+			value, ok := p.next()
+			if !ok || !value.isId {
+				return nil, fmt.Errorf("%w: a value is expected for the attribute %s", ErrIncorrectDot, name.value)
+			}
+			attributes[name.value] = value.value
+		}
+	}
+}
+
+// skipPort skips the port of the node like ":port" or ":port:compass_point"
+func (p *parser) skipPort() {
+	for {
+		t, ok := p.peek()
+		if !ok || t.isId || t.value != ":" {
+			return
+		}
+		p.next()
+		if t, ok := p.peek(); ok && t.isId {
+			p.next()
+		}
+	}
+}
+
+// tokenize splits the graph in DOT format into tokens skipping whitespaces and comments.
+// Quotes of quoted ids are removed and escaped quotes are unescaped, HTML ids are kept without angle brackets.
+func tokenize(dot string) ([]token, error) {
+	runes := []rune(dot)
+	tokens := make([]token, 0)
+	lineStart := true
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == '\n':
+			lineStart = true
+			i++
+			continue
+		case unicode.IsSpace(r):
+			i++
+			continue
+		case lineStart && r == '#':
+			// a line of the C preprocessor output is a comment
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			continue
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '/':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			continue
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := i + 2
+			for end+1 < len(runes) && !(runes[end] == '*' && runes[end+1] == '/') {
+				end++
+			}
+			if end+1 >= len(runes) {
+				return nil, fmt.Errorf("%w: the comment isn't closed", ErrIncorrectDot)
+			}
+			i = end + 2
+			continue
+		}
+		lineStart = false
+		switch {
+		case r == '"':
+			var value strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) && runes[i+1] == '"' {
+					i++
+				}
+				value.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("%w: the quoted string isn't closed", ErrIncorrectDot)
+			}
+			i++
+			tokens = append(tokens, token{value: value.String(), isId: true})
+		case r == '<':
+			depth := 0
+			start := i
+			for ; i < len(runes); i++ {
+				if runes[i] == '<' {
+					depth++
+				} else if runes[i] == '>' {
+					depth--
+					if depth == 0 {
+						break
+					}
+				}
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("%w: the HTML string isn't closed", ErrIncorrectDot)
+			}
+			tokens = append(tokens, token{value: string(runes[start+1 : i]), isId: true})
+			i++
+		case r == '-' && i+1 < len(runes) && (runes[i+1] == '>' || runes[i+1] == '-'):
+			tokens = append(tokens, token{value: string(runes[i : i+2])})
+			i += 2
+		case strings.ContainsRune("{}[]=;,:", r):
+			tokens = append(tokens, token{value: string(r)})
+			i++
+		case isIdRune(r) || r == '-':
+			start := i
+			i++
+			for i < len(runes) && isIdRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{value: string(runes[start:i]), isId: true})
+		default:
+			return nil, fmt.Errorf("%w: unexpected %q", ErrIncorrectDot, string(r))
+		}
+	}
+	return tokens, nil
+}
+
+// isIdRune checks whether the rune could be a part of the id which isn't quoted (e.g. a name or a number)
+func isIdRune(r rune) bool {
+	return r == '_' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// quote returns the quoted id of the graph in DOT format
+func quote(id string) string {
+	return "\"" + strings.ReplaceAll(id, "\"", "\\\"") + "\""
+}
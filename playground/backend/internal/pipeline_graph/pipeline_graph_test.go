@@ -0,0 +1,126 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline_graph
+
+import (
+	"beam.apache.org/playground/backend/internal/cache"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseDot(t *testing.T) {
+	tests := []struct {
+		name    string
+		dot     string
+		want    cache.PipelineGraph
+		wantErr bool
+	}{
+		{
+			// Test case with the graph which nodes and edges have labels.
+			// As a result, want to receive nodes and edges with their labels in order of their appearance.
+			name: "nodes and edges with labels",
+			dot:  "digraph G {\n  \"1\" [label=\"Read\"];\n  \"2\" [label=\"Write\"];\n  \"1\" -> \"2\" [label=\"Lines\"];\n}\n",
+			want: cache.PipelineGraph{
+				Nodes: []cache.GraphNode{{Id: "1", Label: "Read"}, {Id: "2", Label: "Write"}},
+				Edges: []cache.GraphEdge{{From: "1", To: "2", Label: "Lines"}},
+			},
+		},
+		{
+			// Test case with the graph which has only the chain of edges.
+			// As a result, want to receive nodes which are labeled by their ids and edges between them.
+			name: "chain of edges",
+			dot:  "digraph {\n  Read -> Count -> Write\n}\n",
+			want: cache.PipelineGraph{
+				Nodes: []cache.GraphNode{{Id: "Read", Label: "Read"}, {Id: "Count", Label: "Count"}, {Id: "Write", Label: "Write"}},
+				Edges: []cache.GraphEdge{{From: "Read", To: "Count"}, {From: "Count", To: "Write"}},
+			},
+		},
+		{
+			// Test case with the graph which has attributes, comments, ports and subgraphs as the graph of the dot runner.
+			// As a result, want to receive nodes and edges of the graph and its subgraphs without attributes.
+			name: "attributes, comments and subgraphs",
+			dot: `digraph execution_plan {
+  label="execution_plan"
+  node [shape="box" style="filled"]
+  // transforms of the pipeline
+  subgraph cluster_1 {
+    label="Read";
+    "n1" [label="textio.Read", fillcolor="lightblue"]
+  }
+  /* the collection
+     of lines */
+  "n2" [label=<<b>Write</b>>]
+  "n1":out -> "n2" [label="with \"quotes\""]
+}`,
+			want: cache.PipelineGraph{
+				Nodes: []cache.GraphNode{{Id: "n1", Label: "textio.Read"}, {Id: "n2", Label: "<b>Write</b>"}},
+				Edges: []cache.GraphEdge{{From: "n1", To: "n2", Label: "with \"quotes\""}},
+			},
+		},
+		{
+			// Test case with the graph which isn't in DOT format.
+			// As a result, want to receive an error.
+			name:    "not a graph",
+			dot:     `{"nodes": []}`,
+			wantErr: true,
+		},
+		{
+			// Test case with the graph which isn't closed.
+			// As a result, want to receive an error.
+			name:    "graph isn't closed",
+			dot:     "digraph G {\n  Read -> Write\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDot(tt.dot)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrIncorrectDot) {
+					t.Errorf("ParseDot() error = %v, want %v", err, ErrIncorrectDot)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseDot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToDot(t *testing.T) {
+	graph := cache.PipelineGraph{
+		Nodes: []cache.GraphNode{{Id: "1", Label: "Read"}, {Id: "2", Label: "Write \"lines\""}},
+		Edges: []cache.GraphEdge{{From: "1", To: "2", Label: "Lines"}, {From: "2", To: "1"}},
+	}
+	want := "digraph G {\n  \"1\" [label=\"Read\"];\n  \"2\" [label=\"Write \\\"lines\\\"\"];\n  \"1\" -> \"2\" [label=\"Lines\"];\n  \"2\" -> \"1\";\n}\n"
+	got := ToDot(graph)
+	if got != want {
+		t.Errorf("ToDot() = %q, want %q", got, want)
+	}
+	// the graph in DOT format is parsed to the same graph
+	parsed, err := ParseDot(got)
+	if err != nil {
+		t.Fatalf("ParseDot() error = %v", err)
+	}
+	if !reflect.DeepEqual(parsed, graph) {
+		t.Errorf("ParseDot(ToDot()) = %v, want %v", parsed, graph)
+	}
+}
@@ -0,0 +1,251 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline_options
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/utils"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// optionKind is the kind of values of the pipeline option
+type optionKind int
+
+const (
+	stringOption optionKind = iota
+	boolOption
+	intOption
+	enumOption
+)
+
+// option is the pipeline option which is known for the SDK
+type option struct {
+	kind optionKind
+	// values contains allowed values of the enum option
+	values []string
+}
+
+var (
+	javaOptions = map[string]option{
+		"runner":                {kind: stringOption},
+		"appName":               {kind: stringOption},
+		"jobName":               {kind: stringOption},
+		"project":               {kind: stringOption},
+		"region":                {kind: stringOption},
+		"zone":                  {kind: stringOption},
+		"tempLocation":          {kind: stringOption},
+		"gcpTempLocation":       {kind: stringOption},
+		"stagingLocation":       {kind: stringOption},
+		"serviceAccount":        {kind: stringOption},
+		"network":               {kind: stringOption},
+		"subnetwork":            {kind: stringOption},
+		"workerMachineType":     {kind: stringOption},
+		"experiments":           {kind: stringOption},
+		"streaming":             {kind: boolOption},
+		"blockOnRun":            {kind: boolOption},
+		"enforceImmutability":   {kind: boolOption},
+		"enforceEncodability":   {kind: boolOption},
+		"numWorkers":            {kind: intOption},
+		"maxNumWorkers":         {kind: intOption},
+		"targetParallelism":     {kind: intOption},
+		"autoscalingAlgorithm":  {kind: enumOption, values: []string{"NONE", "THROUGHPUT_BASED"}},
+		"defaultWorkerLogLevel": {kind: enumOption, values: []string{"OFF", "ERROR", "WARN", "INFO", "DEBUG", "TRACE"}},
+	}
+	pythonOptions = map[string]option{
+		"runner":                 {kind: stringOption},
+		"job_name":               {kind: stringOption},
+		"project":                {kind: stringOption},
+		"region":                 {kind: stringOption},
+		"zone":                   {kind: stringOption},
+		"temp_location":          {kind: stringOption},
+		"staging_location":       {kind: stringOption},
+		"service_account_email":  {kind: stringOption},
+		"network":                {kind: stringOption},
+		"subnetwork":             {kind: stringOption},
+		"machine_type":           {kind: stringOption},
+		"experiments":            {kind: stringOption},
+		"setup_file":             {kind: stringOption},
+		"requirements_file":      {kind: stringOption},
+		"sdk_location":           {kind: stringOption},
+		"environment_config":     {kind: stringOption},
+		"streaming":              {kind: boolOption},
+		"save_main_session":      {kind: boolOption},
+		"pipeline_type_check":    {kind: boolOption},
+		"no_pipeline_type_check": {kind: boolOption},
+		"num_workers":            {kind: intOption},
+		"max_num_workers":        {kind: intOption},
+		"direct_num_workers":     {kind: intOption},
+		"direct_running_mode":    {kind: enumOption, values: []string{"in_memory", "multi_threading", "multi_processing"}},
+		"environment_type":       {kind: enumOption, values: []string{"DOCKER", "PROCESS", "EXTERNAL", "LOOPBACK"}},
+		"autoscaling_algorithm":  {kind: enumOption, values: []string{"NONE", "THROUGHPUT_BASED"}},
+		"type_check_strictness":  {kind: enumOption, values: []string{"ALL_REQUIRED", "DEFAULT_TO_ANY"}},
+	}
+	goOptions = map[string]option{
+		"runner":                         {kind: stringOption},
+		"job_name":                       {kind: stringOption},
+		"project":                        {kind: stringOption},
+		"region":                         {kind: stringOption},
+		"zone":                           {kind: stringOption},
+		"temp_location":                  {kind: stringOption},
+		"staging_location":               {kind: stringOption},
+		"service_account_email":          {kind: stringOption},
+		"network":                        {kind: stringOption},
+		"subnetwork":                     {kind: stringOption},
+		"worker_machine_type":            {kind: stringOption},
+		"worker_harness_container_image": {kind: stringOption},
+		"experiments":                    {kind: stringOption},
+		"endpoint":                       {kind: stringOption},
+		"environment_config":             {kind: stringOption},
+		"async":                          {kind: boolOption},
+		"num_workers":                    {kind: intOption},
+		"max_num_workers":                {kind: intOption},
+		"environment_type":               {kind: enumOption, values: []string{"DOCKER", "PROCESS", "EXTERNAL", "LOOPBACK"}},
+	}
+
+	// knownOptions contains pipeline options of Beam which are known for the SDK
+	knownOptions = map[pb.Sdk]map[string]option{
+		pb.Sdk_SDK_JAVA:   javaOptions,
+		pb.Sdk_SDK_SCIO:   javaOptions,
+		pb.Sdk_SDK_PYTHON: pythonOptions,
+		pb.Sdk_SDK_GO:     goOptions,
+	}
+)
+
+// Validate returns diagnostics of pipeline options of the SDK in the order of options.
+// Options are split to arguments the same way as they are passed to the code by the executor.
+// Options which aren't known for the SDK are warnings since the code could define them.
+// Bad values of known options and arguments which can't be parsed are errors:
+//   - Java and Scio options are written like "--name=value", a boolean option could be written without the value;
+//   - Python and Go options are written like "--name=value" or "--name value";
+//   - Python boolean options are flags without values, Go boolean options could be written like "-name=value".
+func Validate(sdk pb.Sdk, pipelineOptions string) []*pb.OptionDiagnostic {
+	known := knownOptions[sdk]
+	diagnostics := make([]*pb.OptionDiagnostic, 0)
+	args := utils.SplitPipelineOptions(sdk, pipelineOptions)
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "" {
+			continue
+		}
+		if !strings.HasPrefix(arg, "-") {
+			diagnostics = append(diagnostics, errorDiagnostic(arg, "", "value %q doesn't follow the name of an option", arg))
+			continue
+		}
+		name := strings.TrimLeft(arg, "-")
+		value, hasValue := "", false
+		if index := strings.Index(name, "="); index >= 0 {
+			name, value, hasValue = name[:index], name[index+1:], true
+		}
+		if name == "" {
+			diagnostics = append(diagnostics, errorDiagnostic(arg, "", "option %q doesn't have a name", arg))
+			continue
+		}
+		opt, ok := known[name]
+		if !ok {
+			diagnostics = append(diagnostics, &pb.OptionDiagnostic{
+				Option:   arg,
+				Name:     name,
+				Severity: pb.LogSeverity_LOG_SEVERITY_WARNING,
+				Message:  fmt.Sprintf("unknown option %q, it should be defined by the code", name),
+			})
+			if !hasValue && i+1 < len(args) && isValue(sdk, args[i+1]) {
+				i++
+			}
+			continue
+		}
+		if opt.kind == boolOption {
+			if diagnostic := validateBool(sdk, arg, name, value, hasValue); diagnostic != nil {
+				diagnostics = append(diagnostics, diagnostic)
+			}
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(args) || !isValue(sdk, args[i+1]) {
+				diagnostics = append(diagnostics, errorDiagnostic(arg, name, "option %q requires a value", name))
+				continue
+			}
+			i++
+			arg, value = arg+" "+args[i], args[i]
+		}
+		if diagnostic := validateValue(opt, arg, name, value); diagnostic != nil {
+			diagnostics = append(diagnostics, diagnostic)
+		}
+	}
+	return diagnostics
+}
+
+// IsValid returns true if diagnostics don't contain errors
+func IsValid(diagnostics []*pb.OptionDiagnostic) bool {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == pb.LogSeverity_LOG_SEVERITY_ERROR {
+			return false
+		}
+	}
+	return true
+}
+
+// isValue returns true if the argument is the value of the previous option.
+// Values of Java and Scio options are always joined with names of options.
+func isValue(sdk pb.Sdk, arg string) bool {
+	if sdk == pb.Sdk_SDK_JAVA || sdk == pb.Sdk_SDK_SCIO {
+		return false
+	}
+	return arg != "" && !strings.HasPrefix(arg, "-")
+}
+
+// validateBool returns the diagnostic of the boolean option or nil if the option is correct
+func validateBool(sdk pb.Sdk, arg, name, value string, hasValue bool) *pb.OptionDiagnostic {
+	if !hasValue {
+		return nil
+	}
+	if sdk == pb.Sdk_SDK_PYTHON {
+		return errorDiagnostic(arg, name, "option %q is a flag and doesn't take a value", name)
+	}
+	if _, err := strconv.ParseBool(value); err != nil {
+		return errorDiagnostic(arg, name, "option %q requires a boolean value, but got %q", name, value)
+	}
+	return nil
+}
+
+// validateValue returns the diagnostic of the value of the option or nil if the value is correct
+func validateValue(opt option, arg, name, value string) *pb.OptionDiagnostic {
+	switch opt.kind {
+	case intOption:
+		if _, err := strconv.Atoi(value); err != nil {
+			return errorDiagnostic(arg, name, "option %q requires an integer value, but got %q", name, value)
+		}
+	case enumOption:
+		for _, allowed := range opt.values {
+			if value == allowed {
+				return nil
+			}
+		}
+		return errorDiagnostic(arg, name, "option %q requires one of values %s, but got %q", name, strings.Join(opt.values, ", "), value)
+	}
+	return nil
+}
+
+// errorDiagnostic returns the error diagnostic of the argument with the formatted message
+func errorDiagnostic(arg, name, format string, args ...interface{}) *pb.OptionDiagnostic {
+	return &pb.OptionDiagnostic{
+		Option:   arg,
+		Name:     name,
+		Severity: pb.LogSeverity_LOG_SEVERITY_ERROR,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
@@ -0,0 +1,181 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline_options
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	type args struct {
+		sdk             pb.Sdk
+		pipelineOptions string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []*pb.OptionDiagnostic
+	}{
+		{
+			// Test case with known options of the Java SDK with correct values.
+			// As a result, want to receive no diagnostics.
+			name: "valid Java options",
+			args: args{sdk: pb.Sdk_SDK_JAVA, pipelineOptions: "--numWorkers 3 --streaming --blockOnRun=false --defaultWorkerLogLevel=WARN"},
+			want: []*pb.OptionDiagnostic{},
+		},
+		{
+			// Test case with known options of the Python SDK with values after spaces and a flag.
+			// As a result, want to receive no diagnostics.
+			name: "valid Python options",
+			args: args{sdk: pb.Sdk_SDK_PYTHON, pipelineOptions: "--num_workers 2 --direct_running_mode=multi_threading --save_main_session --temp_location /tmp/beam"},
+			want: []*pb.OptionDiagnostic{},
+		},
+		{
+			// Test case with empty pipeline options.
+			// As a result, want to receive no diagnostics.
+			name: "empty options",
+			args: args{sdk: pb.Sdk_SDK_GO, pipelineOptions: ""},
+			want: []*pb.OptionDiagnostic{},
+		},
+		{
+			// Test case with the option which isn't known for the SDK and has the value.
+			// As a result, want to receive the warning and no diagnostic for the value.
+			name: "unknown option",
+			args: args{sdk: pb.Sdk_SDK_PYTHON, pipelineOptions: "--output out.txt"},
+			want: []*pb.OptionDiagnostic{
+				{Option: "--output", Name: "output", Severity: pb.LogSeverity_LOG_SEVERITY_WARNING, Message: "unknown option \"output\", it should be defined by the code"},
+			},
+		},
+		{
+			// Test case with the name of the Python option used for the Java SDK.
+			// As a result, want to receive the warning since Java options are written in camel case.
+			name: "option of another SDK",
+			args: args{sdk: pb.Sdk_SDK_JAVA, pipelineOptions: "--temp_location=/tmp"},
+			want: []*pb.OptionDiagnostic{
+				{Option: "--temp_location=/tmp", Name: "temp_location", Severity: pb.LogSeverity_LOG_SEVERITY_WARNING, Message: "unknown option \"temp_location\", it should be defined by the code"},
+			},
+		},
+		{
+			// Test case with the integer option which has the incorrect value after the space.
+			// As a result, want to receive the error which contains both arguments.
+			name: "bad integer value",
+			args: args{sdk: pb.Sdk_SDK_GO, pipelineOptions: "--num_workers many"},
+			want: []*pb.OptionDiagnostic{
+				{Option: "--num_workers many", Name: "num_workers", Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "option \"num_workers\" requires an integer value, but got \"many\""},
+			},
+		},
+		{
+			// Test case with the enum option which has the value outside of allowed values.
+			// As a result, want to receive the error with allowed values.
+			name: "bad enum value",
+			args: args{sdk: pb.Sdk_SDK_PYTHON, pipelineOptions: "--direct_running_mode=parallel"},
+			want: []*pb.OptionDiagnostic{
+				{Option: "--direct_running_mode=parallel", Name: "direct_running_mode", Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "option \"direct_running_mode\" requires one of values in_memory, multi_threading, multi_processing, but got \"parallel\""},
+			},
+		},
+		{
+			// Test case with the boolean option of the Java SDK which has the incorrect value.
+			// As a result, want to receive the error.
+			name: "bad boolean value",
+			args: args{sdk: pb.Sdk_SDK_SCIO, pipelineOptions: "--streaming=yes"},
+			want: []*pb.OptionDiagnostic{
+				{Option: "--streaming=yes", Name: "streaming", Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "option \"streaming\" requires a boolean value, but got \"yes\""},
+			},
+		},
+		{
+			// Test case with the Python flag which has the value.
+			// As a result, want to receive the error since Python flags don't take values.
+			name: "Python flag with value",
+			args: args{sdk: pb.Sdk_SDK_PYTHON, pipelineOptions: "--streaming=true"},
+			want: []*pb.OptionDiagnostic{
+				{Option: "--streaming=true", Name: "streaming", Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "option \"streaming\" is a flag and doesn't take a value"},
+			},
+		},
+		{
+			// Test case with the option which requires the value at the end of options.
+			// As a result, want to receive the error about the missing value.
+			name: "missing value",
+			args: args{sdk: pb.Sdk_SDK_GO, pipelineOptions: "--endpoint localhost:8099 --project"},
+			want: []*pb.OptionDiagnostic{
+				{Option: "--project", Name: "project", Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "option \"project\" requires a value"},
+			},
+		},
+		{
+			// Test case with the Java option with the value which isn't joined with the name by the executor.
+			// As a result, want to receive errors for the option and for the value.
+			name: "Java value which isn't joined",
+			args: args{sdk: pb.Sdk_SDK_JAVA, pipelineOptions: "--tempLocation /tmp/beam"},
+			want: []*pb.OptionDiagnostic{
+				{Option: "--tempLocation", Name: "tempLocation", Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "option \"tempLocation\" requires a value"},
+				{Option: "/tmp/beam", Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "value \"/tmp/beam\" doesn't follow the name of an option"},
+			},
+		},
+		{
+			// Test case with the argument without the name of the option.
+			// As a result, want to receive the error.
+			name: "option without name",
+			args: args{sdk: pb.Sdk_SDK_PYTHON, pipelineOptions: "--=value"},
+			want: []*pb.OptionDiagnostic{
+				{Option: "--=value", Severity: pb.LogSeverity_LOG_SEVERITY_ERROR, Message: "option \"--=value\" doesn't have a name"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.args.sdk, tt.args.pipelineOptions)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Validate() got = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !proto.Equal(got[i], tt.want[i]) {
+					t.Errorf("Validate() diagnostic %d got = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	tests := []struct {
+		name        string
+		diagnostics []*pb.OptionDiagnostic
+		want        bool
+	}{
+		{
+			// Test case with warnings only.
+			// As a result, want to receive true.
+			name:        "warnings",
+			diagnostics: []*pb.OptionDiagnostic{{Severity: pb.LogSeverity_LOG_SEVERITY_WARNING}},
+			want:        true,
+		},
+		{
+			// Test case with the error among warnings.
+			// As a result, want to receive false.
+			name:        "error",
+			diagnostics: []*pb.OptionDiagnostic{{Severity: pb.LogSeverity_LOG_SEVERITY_WARNING}, {Severity: pb.LogSeverity_LOG_SEVERITY_ERROR}},
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValid(tt.diagnostics); got != tt.want {
+				t.Errorf("IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rate_limiter
+
+import (
+	"beam.apache.org/playground/backend/internal/errors"
+	"beam.apache.org/playground/backend/internal/logger"
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ApiKeyHeader is the metadata key of the API key which identifies the client
+	ApiKeyHeader = "x-api-key"
+	// period is the period of the limits, each limit is the number of requests per period
+	period = time.Minute
+)
+
+// bucketKey identifies the bucket of the client's requests to the method
+type bucketKey struct {
+	method string
+	client string
+}
+
+// bucket contains tokens of allowed requests which are refilled during the period up to the limit
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// Limiter limits the number of requests of each client to each gRPC method per minute by the token bucket algorithm.
+// Clients are identified by the API key from the request metadata if the key is configured, otherwise by the IP address
+// of the peer, so clients can't get new limits by sending random keys.
+type Limiter struct {
+	mu sync.Mutex
+	// defaultLimit is the limit of methods which don't have their own limits, 0 means not limited
+	defaultLimit int
+	// methodLimits contains limits by names of methods (e.g. "RunCode"), 0 means not limited
+	methodLimits map[string]int
+	// apiKeys contains API keys which identify clients
+	apiKeys     map[string]struct{}
+	buckets     map[bucketKey]*bucket
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+// New returns Limiter with the default limit, limits of specific methods and API keys which identify clients
+func New(defaultLimit int, methodLimits map[string]int, apiKeys []string) *Limiter {
+	keys := make(map[string]struct{}, len(apiKeys))
+	for _, key := range apiKeys {
+		keys[key] = struct{}{}
+	}
+	return &Limiter{
+		defaultLimit: defaultLimit,
+		methodLimits: methodLimits,
+		apiKeys:      keys,
+		buckets:      make(map[bucketKey]*bucket),
+		now:          time.Now,
+	}
+}
+
+// Allow takes a token from the bucket of the client's requests to the method.
+// Returns false if the client has exceeded the limit of the method.
+func (l *Limiter) Allow(method, client string) bool {
+	limit := l.limit(method)
+	if limit <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.cleanup(now)
+
+	key := bucketKey{method: method, client: client}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit), updated: now}
+		l.buckets[key] = b
+	}
+	b.tokens += now.Sub(b.updated).Seconds() / period.Seconds() * float64(limit)
+	if b.tokens > float64(limit) {
+		b.tokens = float64(limit)
+	}
+	b.updated = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// UnaryServerInterceptor returns the interceptor which rejects requests exceeding limits with ResourceExhausted error
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := methodName(info.FullMethod)
+		client := l.clientId(ctx)
+		if !l.Allow(method, client) {
+			logger.Warnf("%s: rate limit of %s is exceeded\n", client, method)
+			return nil, errors.ResourceExhaustedError("Rate limit is exceeded", "Too many %s requests, try again later", method)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns the interceptor which rejects streams exceeding limits with ResourceExhausted error
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		method := methodName(info.FullMethod)
+		client := l.clientId(stream.Context())
+		if !l.Allow(method, client) {
+			logger.Warnf("%s: rate limit of %s is exceeded\n", client, method)
+			return errors.ResourceExhaustedError("Rate limit is exceeded", "Too many %s requests, try again later", method)
+		}
+		return handler(srv, stream)
+	}
+}
+
+// limit returns the limit of the method
+func (l *Limiter) limit(method string) int {
+	if limit, ok := l.methodLimits[method]; ok {
+		return limit
+	}
+	return l.defaultLimit
+}
+
+// cleanup deletes buckets which aren't updated during the period, since they are refilled anyway
+func (l *Limiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < period {
+		return
+	}
+	for key, b := range l.buckets {
+		if now.Sub(b.updated) >= period {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastCleanup = now
+}
+
+// methodName returns the name of the method from its full name (e.g. "RunCode" from "/api.v1.PlaygroundService/RunCode")
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// clientId returns the API key of the request if it is configured, otherwise the IP address of the peer
+func (l *Limiter) clientId(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get(ApiKeyHeader); len(keys) > 0 {
+			if _, configured := l.apiKeys[keys[0]]; configured {
+				return "key:" + keys[0]
+			}
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		address := p.Addr.String()
+		if host, _, err := net.SplitHostPort(address); err == nil {
+			address = host
+		}
+		return "ip:" + address
+	}
+	return "unknown"
+}
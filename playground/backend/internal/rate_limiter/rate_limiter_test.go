@@ -0,0 +1,205 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rate_limiter
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	type request struct {
+		method string
+		client string
+		// after is the duration which passes before the request
+		after time.Duration
+	}
+	tests := []struct {
+		name         string
+		defaultLimit int
+		methodLimits map[string]int
+		requests     []request
+		want         []bool
+	}{
+		{
+			// Test case with requests of the client up to the limit and beyond it.
+			// As a result, want requests up to the limit to be allowed and the next one to be rejected.
+			name:         "requests beyond the limit",
+			defaultLimit: 2,
+			requests:     []request{{"CheckStatus", "client", 0}, {"CheckStatus", "client", 0}, {"CheckStatus", "client", 0}},
+			want:         []bool{true, true, false},
+		},
+		{
+			// Test case with requests to the method which has its own limit.
+			// As a result, want the limit of the method to be used instead of the default limit.
+			name:         "limit of the method",
+			defaultLimit: 10,
+			methodLimits: map[string]int{"RunCode": 1},
+			requests:     []request{{"RunCode", "client", 0}, {"RunCode", "client", 0}, {"CheckStatus", "client", 0}},
+			want:         []bool{true, false, true},
+		},
+		{
+			// Test case with requests of different clients.
+			// As a result, want each client to have its own limit.
+			name:         "different clients",
+			defaultLimit: 1,
+			requests:     []request{{"RunCode", "client_1", 0}, {"RunCode", "client_2", 0}, {"RunCode", "client_1", 0}},
+			want:         []bool{true, true, false},
+		},
+		{
+			// Test case with the request after the limit is refilled.
+			// As a result, want the request after the part of the period to be allowed again.
+			name:         "refilled limit",
+			defaultLimit: 2,
+			requests:     []request{{"RunCode", "client", 0}, {"RunCode", "client", 0}, {"RunCode", "client", 0}, {"RunCode", "client", 30 * time.Second}, {"RunCode", "client", 0}},
+			want:         []bool{true, true, false, true, false},
+		},
+		{
+			// Test case with requests to the method which isn't limited.
+			// As a result, want all requests to be allowed.
+			name:         "not limited method",
+			defaultLimit: 1,
+			methodLimits: map[string]int{"CheckStatus": 0},
+			requests:     []request{{"CheckStatus", "client", 0}, {"CheckStatus", "client", 0}, {"CheckStatus", "client", 0}},
+			want:         []bool{true, true, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			currentTime := time.Now()
+			l := New(tt.defaultLimit, tt.methodLimits, nil)
+			l.now = func() time.Time {
+				return currentTime
+			}
+			for i, r := range tt.requests {
+				currentTime = currentTime.Add(r.after)
+				if got := l.Allow(r.method, r.client); got != tt.want[i] {
+					t.Errorf("Allow() of the request %d got = %v, want %v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLimiter_cleanup(t *testing.T) {
+	currentTime := time.Now()
+	l := New(1, nil, nil)
+	l.now = func() time.Time {
+		return currentTime
+	}
+	l.Allow("RunCode", "client_1")
+	currentTime = currentTime.Add(period)
+	l.Allow("RunCode", "client_2")
+	if _, ok := l.buckets[bucketKey{method: "RunCode", client: "client_1"}]; ok {
+		t.Errorf("cleanup() didn't delete the bucket which isn't updated during the period")
+	}
+	if len(l.buckets) != 1 {
+		t.Errorf("cleanup() got %d buckets, want 1", len(l.buckets))
+	}
+}
+
+func TestLimiter_UnaryServerInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.v1.PlaygroundService/RunCode"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "MOCK_RESPONSE", nil
+	}
+	peerCtx := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+	}
+	apiKeyCtx := func(ip, key string) context.Context {
+		return metadata.NewIncomingContext(peerCtx(ip), metadata.Pairs(ApiKeyHeader, key))
+	}
+
+	tests := []struct {
+		name     string
+		ctxs     []context.Context
+		wantCode []codes.Code
+	}{
+		{
+			// Test case with requests from the same IP address beyond the limit.
+			// As a result, want the exceeding request to be rejected with ResourceExhausted error.
+			name:     "same peer IP",
+			ctxs:     []context.Context{peerCtx("10.0.0.1"), peerCtx("10.0.0.1")},
+			wantCode: []codes.Code{codes.OK, codes.ResourceExhausted},
+		},
+		{
+			// Test case with requests from the same IP address with different API keys.
+			// As a result, want clients to be identified by API keys.
+			name:     "different API keys",
+			ctxs:     []context.Context{apiKeyCtx("10.0.0.1", "KEY_1"), apiKeyCtx("10.0.0.1", "KEY_2"), apiKeyCtx("10.0.0.2", "KEY_1")},
+			wantCode: []codes.Code{codes.OK, codes.OK, codes.ResourceExhausted},
+		},
+		{
+			// Test case with requests from the same IP address with API keys which aren't configured.
+			// As a result, want clients to be identified by the IP address.
+			name:     "unknown API keys",
+			ctxs:     []context.Context{apiKeyCtx("10.0.0.1", "UNKNOWN_KEY_1"), apiKeyCtx("10.0.0.1", "UNKNOWN_KEY_2"), peerCtx("10.0.0.2")},
+			wantCode: []codes.Code{codes.OK, codes.ResourceExhausted, codes.OK},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := New(0, map[string]int{"RunCode": 1}, []string{"KEY_1", "KEY_2"}).UnaryServerInterceptor()
+			for i, ctx := range tt.ctxs {
+				got, err := interceptor(ctx, "MOCK_REQUEST", info, handler)
+				if code := status.Code(err); code != tt.wantCode[i] {
+					t.Fatalf("UnaryServerInterceptor() of the request %d error = %v, want code %v", i, err, tt.wantCode[i])
+				}
+				if err == nil && got != "MOCK_RESPONSE" {
+					t.Errorf("UnaryServerInterceptor() of the request %d got = %v, want the response of the handler", i, got)
+				}
+			}
+		})
+	}
+}
+
+// mockServerStream is grpc.ServerStream which only has the context
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestLimiter_StreamServerInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/api.v1.PlaygroundService/RunProgram", IsServerStream: true}
+	handled := 0
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		handled++
+		return nil
+	}
+	stream := &mockServerStream{ctx: peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234}})}
+	interceptor := New(0, map[string]int{"RunProgram": 1}, nil).StreamServerInterceptor()
+
+	if err := interceptor(nil, stream, info, handler); err != nil {
+		t.Fatalf("StreamServerInterceptor() of the first stream error = %v, want nil", err)
+	}
+	if err := interceptor(nil, stream, info, handler); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("StreamServerInterceptor() of the second stream error = %v, want code %v", err, codes.ResourceExhausted)
+	}
+	if handled != 1 {
+		t.Errorf("StreamServerInterceptor() handled %d streams, want 1", handled)
+	}
+}
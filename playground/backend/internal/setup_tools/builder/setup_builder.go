@@ -17,11 +17,13 @@ package builder
 
 import (
 	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/dependencies"
 	"beam.apache.org/playground/backend/internal/environment"
 	"beam.apache.org/playground/backend/internal/executors"
 	"beam.apache.org/playground/backend/internal/fs_tool"
 	"beam.apache.org/playground/backend/internal/utils"
 	"fmt"
+	"io/fs"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -35,7 +37,7 @@ const (
 // Validator return executor with set args for validator
 func Validator(paths *fs_tool.LifeCyclePaths, sdkEnv *environment.BeamEnvs) (*executors.ExecutorBuilder, error) {
 	sdk := sdkEnv.ApacheBeamSdk
-	val, err := utils.GetValidators(sdk, paths.AbsoluteSourceFilePath)
+	val, err := utils.GetValidators(sdk, paths.AbsoluteSourceFilePath, sdkEnv.ExecutorConfig.DisallowedApis)
 	if err != nil {
 		return nil, err
 	}
@@ -76,25 +78,52 @@ func Compiler(paths *fs_tool.LifeCyclePaths, sdkEnv *environment.BeamEnvs) *exec
 	case pb.Sdk_SDK_JAVA:
 		builder.
 			WithCompiler().
-			WithFileName(GetFirstFileFromFolder(paths.AbsoluteSourceFileFolderPath))
+			WithFileNames(GetFilesFromFolder(paths.AbsoluteSourceFileFolderPath, fs_tool.JavaSourceFileExtension))
+	case pb.Sdk_SDK_SCIO:
+		builder.
+			WithCompiler().
+			WithFileNames(GetFilesFromFolder(paths.AbsoluteSourceFileFolderPath, fs_tool.ScioSourceFileExtension))
+	case pb.Sdk_SDK_GO:
+		builder.
+			WithCompiler().
+			WithFileNames(getTopLevelFilesFromFolder(paths.AbsoluteSourceFileFolderPath, filepath.Ext(paths.AbsoluteSourceFilePath)))
 	}
 	return &builder
 }
 
+// SyntaxChecker return executor with set args for syntax checker
+func SyntaxChecker(paths *fs_tool.LifeCyclePaths, sdkEnv *environment.BeamEnvs) *executors.ExecutorBuilder {
+	executorConfig := sdkEnv.ExecutorConfig
+	fileNames := []string{paths.AbsoluteSourceFilePath}
+	switch sdkEnv.ApacheBeamSdk {
+	case pb.Sdk_SDK_JAVA:
+		fileNames = GetFilesFromFolder(paths.AbsoluteSourceFileFolderPath, fs_tool.JavaSourceFileExtension)
+	case pb.Sdk_SDK_SCIO:
+		fileNames = GetFilesFromFolder(paths.AbsoluteSourceFileFolderPath, fs_tool.ScioSourceFileExtension)
+	case pb.Sdk_SDK_GO:
+		fileNames = getTopLevelFilesFromFolder(paths.AbsoluteSourceFileFolderPath, filepath.Ext(paths.AbsoluteSourceFilePath))
+	}
+	builder := executors.NewExecutorBuilder().
+		WithSyntaxChecker().
+		WithCommand(executorConfig.SyntaxCheckCmd).
+		WithWorkingDir(paths.AbsoluteBaseFolderPath).
+		WithArgs(executorConfig.SyntaxCheckArgs).
+		WithFileNames(fileNames).
+		ExecutorBuilder
+	return &builder
+}
+
 // Runner return executor with set args for runner
 func Runner(paths *fs_tool.LifeCyclePaths, pipelineOptions string, sdkEnv *environment.BeamEnvs) (*executors.ExecutorBuilder, error) {
 	sdk := sdkEnv.ApacheBeamSdk
 
-	if sdk == pb.Sdk_SDK_JAVA {
-		pipelineOptions = utils.ReplaceSpacesWithEquals(pipelineOptions)
-	}
 	executorConfig := sdkEnv.ExecutorConfig
 	builder := executors.NewExecutorBuilder().
 		WithRunner().
 		WithWorkingDir(paths.AbsoluteBaseFolderPath).
 		WithCommand(executorConfig.RunCmd).
 		WithArgs(executorConfig.RunArgs).
-		WithPipelineOptions(strings.Split(pipelineOptions, " ")).
+		WithPipelineOptions(utils.SplitPipelineOptions(sdk, pipelineOptions)).
 		ExecutorBuilder
 
 	switch sdk {
@@ -109,6 +138,15 @@ func Runner(paths *fs_tool.LifeCyclePaths, pipelineOptions string, sdkEnv *envir
 			WithArgs(args).
 			WithExecutableFileName(className).
 			ExecutorBuilder
+	case pb.Sdk_SDK_SCIO: // Executable name for scala object is known after compilation
+		objectName, err := paths.ExecutableName(paths.AbsoluteExecutableFileFolderPath)
+		if err != nil {
+			return nil, fmt.Errorf("no executable file name found for SCIO pipeline at %s", paths.AbsoluteExecutableFileFolderPath)
+		}
+		builder = builder.
+			WithRunner().
+			WithExecutableFileName(objectName).
+			ExecutorBuilder
 	case pb.Sdk_SDK_GO: //go run command is executable file itself
 		builder = builder.
 			WithRunner().
@@ -120,6 +158,12 @@ func Runner(paths *fs_tool.LifeCyclePaths, pipelineOptions string, sdkEnv *envir
 			WithRunner().
 			WithExecutableFileName(paths.AbsoluteExecutableFilePath).
 			ExecutorBuilder
+		if dependencies.HasRequirements(paths.AbsoluteBaseFolderPath) { // requirements are installed into the virtual environment
+			builder = builder.
+				WithRunner().
+				WithCommand(dependencies.GetPyVenvInterpreter(paths.AbsoluteBaseFolderPath)).
+				ExecutorBuilder
+		}
 	}
 	return &builder, nil
 }
@@ -137,15 +181,22 @@ func TestRunner(paths *fs_tool.LifeCyclePaths, sdkEnv *environment.BeamEnvs) (*e
 		ExecutorBuilder
 
 	switch sdk {
-	case pb.Sdk_SDK_JAVA: // Executable name for java class is known after compilation
+	case pb.Sdk_SDK_JAVA, pb.Sdk_SDK_SCIO: // Executable name for java and scala classes is known after compilation
 		className, err := paths.ExecutableName(paths.AbsoluteExecutableFileFolderPath)
 		if err != nil {
-			return nil, fmt.Errorf("no executable file name found for JAVA pipeline at %s", paths.AbsoluteExecutableFileFolderPath)
+			return nil, fmt.Errorf("no executable file name found for %s pipeline at %s", sdk, paths.AbsoluteExecutableFileFolderPath)
 		}
 		builder = builder.WithTestRunner().
 			WithExecutableFileName(className).
 			WithWorkingDir(paths.AbsoluteBaseFolderPath).
 			ExecutorBuilder //change directory for unit test
+	case pb.Sdk_SDK_PYTHON:
+		if dependencies.HasRequirements(paths.AbsoluteBaseFolderPath) { // test runner is run as a module of the virtual environment
+			builder = builder.WithTestRunner().
+				WithCommand(dependencies.GetPyVenvInterpreter(paths.AbsoluteBaseFolderPath)).
+				WithArgs(append([]string{"-m", executorConfig.TestCmd}, executorConfig.TestArgs...)).
+				ExecutorBuilder
+		}
 	}
 	return &builder, nil
 }
@@ -168,3 +219,21 @@ func GetFirstFileFromFolder(folderAbsolutePath string) string {
 	files, _ := filepath.Glob(fmt.Sprintf("%s/*%s", folderAbsolutePath, fs_tool.JavaSourceFileExtension))
 	return files[0]
 }
+
+// GetFilesFromFolder returns paths of all files with the extension in a specified folder and its subfolders
+func GetFilesFromFolder(folderAbsolutePath, extension string) []string {
+	var files []string
+	_ = filepath.WalkDir(folderAbsolutePath, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && filepath.Ext(path) == extension {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files
+}
+
+// getTopLevelFilesFromFolder returns paths of files with the extension in a specified folder without its subfolders
+func getTopLevelFilesFromFolder(folderAbsolutePath, extension string) []string {
+	files, _ := filepath.Glob(filepath.Join(folderAbsolutePath, "*"+extension))
+	return files
+}
@@ -24,6 +24,8 @@ import (
 	"beam.apache.org/playground/backend/internal/validators"
 	"fmt"
 	"github.com/google/uuid"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"sync"
@@ -44,14 +46,16 @@ func setup() {
 	lc, _ := fs_tool.NewLifeCycle(sdk, pipelineId, "")
 	paths = &lc.Paths
 	executorConfig := &environment.ExecutorConfig{
-		CompileCmd:  "MOCK_COMPILE_CMD",
-		CompileArgs: []string{"MOCK_COMPILE_ARG"},
+		CompileCmd:      "MOCK_COMPILE_CMD",
+		CompileArgs:     []string{"MOCK_COMPILE_ARG"},
+		SyntaxCheckCmd:  "MOCK_SYNTAX_CHECK_CMD",
+		SyntaxCheckArgs: []string{"MOCK_SYNTAX_CHECK_ARG"},
 	}
-	sdkEnv = environment.NewBeamEnvs(sdk, executorConfig, "", 0)
+	sdkEnv = environment.NewBeamEnvs(sdk, executorConfig, "", 0, 0, 0, environment.ResourceLimits{})
 }
 
 func TestValidator(t *testing.T) {
-	vals, err := utils.GetValidators(sdkEnv.ApacheBeamSdk, paths.AbsoluteSourceFilePath)
+	vals, err := utils.GetValidators(sdkEnv.ApacheBeamSdk, paths.AbsoluteSourceFilePath, sdkEnv.ExecutorConfig.DisallowedApis)
 	if err != nil {
 		panic(err)
 	}
@@ -59,7 +63,7 @@ func TestValidator(t *testing.T) {
 		WithValidator().
 		WithSdkValidators(vals)
 
-	wrongSdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_UNSPECIFIED, sdkEnv.ExecutorConfig, "", 0)
+	wrongSdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_UNSPECIFIED, sdkEnv.ExecutorConfig, "", 0, 0, 0, environment.ResourceLimits{})
 
 	type args struct {
 		paths  *fs_tool.LifeCyclePaths
@@ -126,7 +130,7 @@ func TestPreparer(t *testing.T) {
 		WithPreparer().
 		WithSdkPreparers(prep)
 
-	wrongSdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_UNSPECIFIED, sdkEnv.ExecutorConfig, "", 0)
+	wrongSdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_UNSPECIFIED, sdkEnv.ExecutorConfig, "", 0, 0, 0, environment.ResourceLimits{})
 
 	type args struct {
 		paths           fs_tool.LifeCyclePaths
@@ -213,6 +217,44 @@ func TestCompiler(t *testing.T) {
 	}
 }
 
+func TestSyntaxChecker(t *testing.T) {
+	wantExecutor := executors.NewExecutorBuilder().
+		WithSyntaxChecker().
+		WithCommand(sdkEnv.ExecutorConfig.SyntaxCheckCmd).
+		WithWorkingDir(paths.AbsoluteBaseFolderPath).
+		WithArgs(sdkEnv.ExecutorConfig.SyntaxCheckArgs).
+		WithFileNames([]string{paths.AbsoluteSourceFilePath})
+
+	type args struct {
+		paths  *fs_tool.LifeCyclePaths
+		sdkEnv *environment.BeamEnvs
+	}
+	tests := []struct {
+		name string
+		args args
+		want *executors.ExecutorBuilder
+	}{
+		{
+			// Test case with calling SyntaxChecker with correct data.
+			// As a result, want to receive an expected syntax checker builder.
+			name: "Test correct syntax checker builder",
+			args: args{
+				paths:  paths,
+				sdkEnv: sdkEnv,
+			},
+			want: &wantExecutor.ExecutorBuilder,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SyntaxChecker(tt.args.paths, tt.args.sdkEnv)
+			if !reflect.DeepEqual(fmt.Sprint(got.Build()), fmt.Sprint(tt.want.Build())) {
+				t.Errorf("SyntaxChecker() = %v, want %v", got.Build(), tt.want.Build())
+			}
+		})
+	}
+}
+
 func TestRunnerBuilder(t *testing.T) {
 	wantExecutor := executors.NewExecutorBuilder().
 		WithRunner().
@@ -222,6 +264,26 @@ func TestRunnerBuilder(t *testing.T) {
 		WithArgs(sdkEnv.ExecutorConfig.RunArgs).
 		WithPipelineOptions(strings.Split("", " "))
 
+	scioPipelineId := uuid.New()
+	scioLc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_SCIO, scioPipelineId, t.TempDir())
+	if err := scioLc.CreateFolders(); err != nil {
+		t.Fatalf("error during prepare folders: %s", err.Error())
+	}
+	if err := scioLc.CreateSourceCodeFile("object WordCount {\n  def main(args: Array[String]): Unit = {}\n}\n"); err != nil {
+		t.Fatalf("error during prepare source file: %s", err.Error())
+	}
+	if err := os.WriteFile(filepath.Join(scioLc.Paths.AbsoluteExecutableFileFolderPath, "WordCount.class"), []byte("TEMP_DATA"), 0600); err != nil {
+		t.Fatalf("error during prepare compiled file: %s", err.Error())
+	}
+	scioSdkEnv := environment.NewBeamEnvs(pb.Sdk_SDK_SCIO, &environment.ExecutorConfig{RunCmd: "scala", RunArgs: []string{"-cp", "bin:"}}, "", 0, 0, 0, environment.ResourceLimits{})
+	wantScioExecutor := executors.NewExecutorBuilder().
+		WithRunner().
+		WithExecutableFileName("WordCount").
+		WithWorkingDir(scioLc.Paths.AbsoluteBaseFolderPath).
+		WithCommand("scala").
+		WithArgs([]string{"-cp", "bin:"}).
+		WithPipelineOptions([]string{"--output=counts"})
+
 	type args struct {
 		paths           *fs_tool.LifeCyclePaths
 		pipelineOptions string
@@ -242,6 +304,17 @@ func TestRunnerBuilder(t *testing.T) {
 			},
 			want: &wantExecutor.ExecutorBuilder,
 		},
+		{
+			// Test case with calling Setup with the compiled scio code.
+			// As a result, want to receive an expected run builder which executes the object with the main method.
+			name: "Test correct scio run builder",
+			args: args{
+				paths:           &scioLc.Paths,
+				pipelineOptions: "--output counts",
+				sdkEnv:          scioSdkEnv,
+			},
+			want: &wantScioExecutor.ExecutorBuilder,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
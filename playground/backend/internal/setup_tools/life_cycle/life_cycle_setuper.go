@@ -39,6 +39,25 @@ const (
 // Setup returns fs_tool.LifeCycle.
 // Also, prepares files and folders needed to code processing according to sdk
 func Setup(sdk pb.Sdk, code string, pipelineId uuid.UUID, workingDir, pipelinesFolder, preparedModDir string) (*fs_tool.LifeCycle, error) {
+	return SetupFiles(sdk, []fs_tool.SourceFile{{Code: code, IsMain: true}}, pipelineId, workingDir, pipelinesFolder, preparedModDir)
+}
+
+// SetupWithPool returns fs_tool.LifeCycle like Setup, but takes the working directory from the warm pool.
+func SetupWithPool(pool *fs_tool.WarmPool, sdk pb.Sdk, code string, pipelineId uuid.UUID, workingDir, pipelinesFolder, preparedModDir string) (*fs_tool.LifeCycle, error) {
+	return SetupFilesWithPool(pool, sdk, []fs_tool.SourceFile{{Code: code, IsMain: true}}, pipelineId, workingDir, pipelinesFolder, preparedModDir)
+}
+
+// SetupFiles returns fs_tool.LifeCycle for the multi-file code.
+// Also, prepares files and folders needed to code processing according to sdk
+func SetupFiles(sdk pb.Sdk, files []fs_tool.SourceFile, pipelineId uuid.UUID, workingDir, pipelinesFolder, preparedModDir string) (*fs_tool.LifeCycle, error) {
+	return SetupFilesWithPool(nil, sdk, files, pipelineId, workingDir, pipelinesFolder, preparedModDir)
+}
+
+// SetupFilesWithPool returns fs_tool.LifeCycle for the multi-file code like SetupFiles.
+// If the pool has a ready working directory, it is taken instead of creating folders and files by PrepareFiles,
+// so only files which depend on the pipeline are created. If the pool is nil or has no ready working directory,
+// the working directory is prepared as by SetupFiles.
+func SetupFilesWithPool(pool *fs_tool.WarmPool, sdk pb.Sdk, files []fs_tool.SourceFile, pipelineId uuid.UUID, workingDir, pipelinesFolder, preparedModDir string) (*fs_tool.LifeCycle, error) {
 	// create file system service
 	lc, err := fs_tool.NewLifeCycle(sdk, pipelineId, filepath.Join(workingDir, pipelinesFolder))
 	if err != nil {
@@ -46,44 +65,72 @@ func Setup(sdk pb.Sdk, code string, pipelineId uuid.UUID, workingDir, pipelinesF
 		return nil, errors.New("error during create a new file system")
 	}
 
-	// create folders
-	err = lc.CreateFolders()
-	if err != nil {
-		logger.Errorf("%s: error during create folders: %s\n", pipelineId, err.Error())
-		return nil, errors.New("error during prepare necessary folders")
-	}
+	if pool == nil || !pool.Take(lc) {
+		// create folders
+		err = lc.CreateFolders()
+		if err != nil {
+			logger.Errorf("%s: error during create folders: %s\n", pipelineId, err.Error())
+			return nil, errors.New("error during prepare necessary folders")
+		}
 
-	// copy necessary files
-	switch sdk {
-	case pb.Sdk_SDK_GO:
-		if err = prepareGoFiles(lc, preparedModDir, pipelineId); err != nil {
-			lc.DeleteFolders()
-			return nil, errors.New("error during create necessary files for the Go sdk")
+		// copy necessary files
+		switch sdk {
+		case pb.Sdk_SDK_GO:
+			if err = prepareGoFiles(lc, preparedModDir, pipelineId); err != nil {
+				lc.DeleteFolders()
+				return nil, errors.New("error during create necessary files for the Go sdk")
+			}
+		case pb.Sdk_SDK_JAVA:
+			if err = prepareJavaFiles(lc, workingDir, pipelineId); err != nil {
+				lc.DeleteFolders()
+				return nil, errors.New("error during create necessary files for the Java sdk")
+			}
 		}
-	case pb.Sdk_SDK_JAVA:
-		if err = prepareJavaFiles(lc, workingDir, pipelineId); err != nil {
+	}
+	if sdk == pb.Sdk_SDK_JAVA {
+		if err = updateJavaLogConfigFile(lc.Paths); err != nil {
+			logger.Errorf("%s: error during updating logging.properties file: %s\n", pipelineId, err.Error())
 			lc.DeleteFolders()
 			return nil, errors.New("error during create necessary files for the Java sdk")
 		}
 	}
 
-	// create file with code
-	err = lc.CreateSourceCodeFile(code)
+	// create files with code
+	err = lc.CreateSourceCodeFiles(files)
 	if err != nil {
-		logger.Errorf("%s: RunCode(): CreateSourceCodeFile(): %s\n", pipelineId, err.Error())
+		logger.Errorf("%s: RunCode(): CreateSourceCodeFiles(): %s\n", pipelineId, err.Error())
 		lc.DeleteFolders()
 		return nil, errors.New("error during create file with code")
 	}
 	return lc, nil
 }
 
+// PrepareFiles copies files which are needed to process the code of the SDK and which don't depend on the pipeline
+// to the base folder of the life cycle, it is used to prepare working directories of fs_tool.WarmPool.
+// The log config file of the Java SDK is updated according to the pipeline by SetupFilesWithPool.
+func PrepareFiles(sdk pb.Sdk, lc *fs_tool.LifeCycle, workingDir, preparedModDir string, pipelineId uuid.UUID) error {
+	switch sdk {
+	case pb.Sdk_SDK_GO:
+		return prepareGoFiles(lc, preparedModDir, pipelineId)
+	case pb.Sdk_SDK_JAVA:
+		return prepareJavaFiles(lc, workingDir, pipelineId)
+	}
+	return nil
+}
+
 // prepareGoFiles prepares file for Go environment.
 // Copy go.mod and go.sum file from /path/to/preparedModDir to /path/to/workingDir/pipelinesFolder/{pipelineId}
+//	The code is built with -mod=mod, so imports which aren't required by the prepared go.mod
+//	are resolved into the copied go.mod of the pipeline.
 func prepareGoFiles(lc *fs_tool.LifeCycle, preparedModDir string, pipelineId uuid.UUID) error {
 	if err := lc.CopyFile(goModFileName, preparedModDir, lc.Paths.AbsoluteBaseFolderPath); err != nil {
 		logger.Errorf("%s: error during copying %s file: %s\n", pipelineId, goModFileName, err.Error())
 		return err
 	}
+	// go.sum doesn't exist if the prepared module has no dependencies
+	if _, err := os.Stat(filepath.Join(preparedModDir, goSumFileName)); os.IsNotExist(err) {
+		return nil
+	}
 	if err := lc.CopyFile(goSumFileName, preparedModDir, lc.Paths.AbsoluteBaseFolderPath); err != nil {
 		logger.Errorf("%s: error during copying %s file: %s\n", pipelineId, goSumFileName, err.Error())
 		return err
@@ -92,19 +139,14 @@ func prepareGoFiles(lc *fs_tool.LifeCycle, preparedModDir string, pipelineId uui
 }
 
 // prepareJavaFiles prepares file for Java environment.
-// Copy log config file from /path/to/workingDir to /path/to/workingDir/pipelinesFolder/{pipelineId}
-//	and update this file according to pipeline.
+// Copy log config file from /path/to/workingDir to /path/to/workingDir/pipelinesFolder/{pipelineId}.
+//	The file is updated according to pipeline by updateJavaLogConfigFile.
 func prepareJavaFiles(lc *fs_tool.LifeCycle, workingDir string, pipelineId uuid.UUID) error {
 	err := lc.CopyFile(javaLogConfigFileName, workingDir, lc.Paths.AbsoluteBaseFolderPath)
 	if err != nil {
 		logger.Errorf("%s: error during copying logging.properties file: %s\n", pipelineId, err.Error())
 		return err
 	}
-	err = updateJavaLogConfigFile(lc.Paths)
-	if err != nil {
-		logger.Errorf("%s: error during updating logging.properties file: %s\n", pipelineId, err.Error())
-		return err
-	}
 	return nil
 }
 
@@ -32,6 +32,7 @@ const (
 	executableFolder          = "bin"
 	javaSourceFileExtension   = ".java"
 	javaCompiledFileExtension = ".class"
+	goSourceFileExtension     = ".go"
 	pipelinesFolder           = "executable_files"
 	logFileName               = "logs.log"
 )
@@ -42,8 +43,17 @@ func TestSetup(t *testing.T) {
 	baseFileFolder, _ := filepath.Abs(filepath.Join(workingDir, pipelinesFolder, successPipelineId.String()))
 	srcFileFolder := filepath.Join(baseFileFolder, sourceFolder)
 	execFileFolder := filepath.Join(baseFileFolder, executableFolder)
+	goPipelineId := uuid.New()
+	goBaseFileFolder, _ := filepath.Abs(filepath.Join(workingDir, pipelinesFolder, goPipelineId.String()))
+	goSrcFileFolder := filepath.Join(goBaseFileFolder, sourceFolder)
+	goExecFileFolder := filepath.Join(goBaseFileFolder, executableFolder)
+	preparedModDir := filepath.Join(workingDir, "prepared_folder")
 
-	err := os.MkdirAll(workingDir, fs.ModePerm)
+	err := os.MkdirAll(preparedModDir, fs.ModePerm)
+	if err != nil {
+		panic(err)
+	}
+	err = os.WriteFile(filepath.Join(preparedModDir, goModFileName), []byte("module executable_files\n\ngo 1.16\n"), fs.ModePerm)
 	if err != nil {
 		panic(err)
 	}
@@ -128,6 +138,38 @@ func TestSetup(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			// Test case with calling Setup method with Go SDK and prepared module without dependencies.
+			// As a result, want to receive an expected life cycle with copied go.mod file.
+			name: "go sdk without go.sum",
+			args: args{
+				sdk:             playground.Sdk_SDK_GO,
+				code:            "",
+				pipelineId:      goPipelineId,
+				workingDir:      workingDir,
+				preparedModDir:  preparedModDir,
+				pipelinesFolder: pipelinesFolder,
+			},
+			check: func() bool {
+				if _, err := os.Stat(filepath.Join(goBaseFileFolder, goModFileName)); os.IsNotExist(err) {
+					return false
+				}
+				return true
+			},
+			want: &fs_tool.LifeCycle{
+				Paths: fs_tool.LifeCyclePaths{
+					SourceFileName:                   fmt.Sprintf("%s%s", goPipelineId.String(), goSourceFileExtension),
+					AbsoluteSourceFileFolderPath:     goSrcFileFolder,
+					AbsoluteSourceFilePath:           filepath.Join(goSrcFileFolder, fmt.Sprintf("%s%s", goPipelineId.String(), goSourceFileExtension)),
+					ExecutableFileName:               goPipelineId.String(),
+					AbsoluteExecutableFileFolderPath: goExecFileFolder,
+					AbsoluteExecutableFilePath:       filepath.Join(goExecFileFolder, goPipelineId.String()),
+					AbsoluteBaseFolderPath:           goBaseFileFolder,
+					AbsoluteLogFilePath:              filepath.Join(goBaseFileFolder, logFileName),
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -156,3 +198,56 @@ func checkPathsEqual(paths1, paths2 fs_tool.LifeCyclePaths) bool {
 		paths1.AbsoluteBaseFolderPath == paths2.AbsoluteBaseFolderPath &&
 		paths1.AbsoluteLogFilePath == paths2.AbsoluteLogFilePath
 }
+
+func TestSetupFilesWithPool(t *testing.T) {
+	poolWorkingDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(poolWorkingDir, javaLogConfigFileName), []byte("handler.pattern="+javaLogFilePlaceholder+"\n"), fs.ModePerm); err != nil {
+		t.Fatalf("error during creating the log config file: %s", err.Error())
+	}
+	prepare := func(lc *fs_tool.LifeCycle, id uuid.UUID) error {
+		return PrepareFiles(playground.Sdk_SDK_JAVA, lc, poolWorkingDir, "", id)
+	}
+	pool, err := fs_tool.NewWarmPool(playground.Sdk_SDK_JAVA, filepath.Join(poolWorkingDir, pipelinesFolder), 1, prepare)
+	if err != nil {
+		t.Fatalf("error during preparing the pool: %s", err.Error())
+	}
+	tests := []struct {
+		name string
+		// wantPoolSize is the size of the pool after the working directory is prepared
+		wantPoolSize int
+	}{
+		{
+			// Test case with the pool which has the ready working directory.
+			// As a result, want the working directory to be taken from the pool.
+			name:         "working directory is taken from the pool",
+			wantPoolSize: 0,
+		},
+		{
+			// Test case with the pool which has no ready working directory.
+			// As a result, want the working directory to be prepared as usual.
+			name:         "pool is empty",
+			wantPoolSize: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			lc, err := SetupFilesWithPool(pool, playground.Sdk_SDK_JAVA, []fs_tool.SourceFile{{Code: "MOCK_CODE", IsMain: true}}, pipelineId, poolWorkingDir, pipelinesFolder, "")
+			if err != nil {
+				t.Fatalf("SetupFilesWithPool() error = %v", err)
+			}
+			if pool.Size() != tt.wantPoolSize {
+				t.Errorf("SetupFilesWithPool() size of the pool = %d, want %d", pool.Size(), tt.wantPoolSize)
+			}
+			code, err := os.ReadFile(lc.Paths.AbsoluteSourceFilePath)
+			if err != nil || string(code) != "MOCK_CODE" {
+				t.Errorf("SetupFilesWithPool() code = %q, %v, want %q", code, err, "MOCK_CODE")
+			}
+			// the log config file is updated according to the pipeline which takes the working directory
+			logConfig, err := os.ReadFile(filepath.Join(lc.Paths.AbsoluteBaseFolderPath, javaLogConfigFileName))
+			if want := "handler.pattern=" + lc.Paths.AbsoluteLogFilePath + "\n"; err != nil || string(logConfig) != want {
+				t.Errorf("SetupFilesWithPool() log config = %q, %v, want %q", logConfig, err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snippets
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"cloud.google.com/go/datastore"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+// snippetKind is the kind of entities of snippets in Datastore
+const snippetKind = "PlaygroundSnippet"
+
+// snippetEntity is the entity of the snippet in Datastore.
+// The code and pipeline options aren't indexed, since unindexed strings can be up to 1 MB.
+type snippetEntity struct {
+	Sdk             string    `datastore:"sdk,noindex"`
+	Code            string    `datastore:"code,noindex"`
+	PipelineOptions string    `datastore:"pipeline_options,noindex"`
+	ExpiresAt       time.Time `datastore:"expires_at"`
+}
+
+// DatastoreStore keeps snippets as entities of Datastore, one entity per snippet which name is the key of the snippet.
+// Snippets are kept when the server is restarted and are shared by all instances of the server.
+// Expired snippets aren't returned, and they are deleted by the TTL policy of Datastore on "expires_at" if it is set.
+type DatastoreStore struct {
+	client *datastore.Client
+}
+
+// NewDatastoreStore returns DatastoreStore which keeps snippets in Datastore of the Google Cloud project
+func NewDatastoreStore(ctx context.Context, projectId string) (*DatastoreStore, error) {
+	client, err := datastore.NewClient(ctx, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("datastore.NewClient: %w", err)
+	}
+	return &DatastoreStore{client: client}, nil
+}
+
+// Put keeps the snippet as the entity of Datastore by the key
+func (s *DatastoreStore) Put(ctx context.Context, key uuid.UUID, snippet *Snippet, expiresAt time.Time) error {
+	entity := &snippetEntity{Sdk: snippet.Sdk.String(), Code: snippet.Code, PipelineOptions: snippet.PipelineOptions, ExpiresAt: expiresAt}
+	if _, err := s.client.Put(ctx, datastore.NameKey(snippetKind, key.String(), nil), entity); err != nil {
+		return fmt.Errorf("error during saving the snippet to datastore: %w", err)
+	}
+	return nil
+}
+
+// Get returns the snippet which is kept as the entity of Datastore by the key
+func (s *DatastoreStore) Get(ctx context.Context, key uuid.UUID) (*Snippet, error) {
+	var entity snippetEntity
+	if err := s.client.Get(ctx, datastore.NameKey(snippetKind, key.String(), nil), &entity); err != nil {
+		if errors.Is(err, datastore.ErrNoSuchEntity) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+		}
+		return nil, fmt.Errorf("error during getting the snippet from datastore: %w", err)
+	}
+	return entityToSnippet(key, &entity, time.Now())
+}
+
+// Close closes the client of Datastore
+func (s *DatastoreStore) Close() error {
+	return s.client.Close()
+}
+
+// entityToSnippet returns the snippet of the entity if it isn't expired at the time.
+// The TTL policy deletes expired entities with a delay, so the expiration time is checked on each read.
+func entityToSnippet(key uuid.UUID, entity *snippetEntity, now time.Time) (*Snippet, error) {
+	if !now.Before(entity.ExpiresAt) {
+		return nil, fmt.Errorf("%w: %s is expired at %s", ErrNotFound, key, entity.ExpiresAt)
+	}
+	return &Snippet{Sdk: pb.Sdk(pb.Sdk_value[entity.Sdk]), Code: entity.Code, PipelineOptions: entity.PipelineOptions}, nil
+}
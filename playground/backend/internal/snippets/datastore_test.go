@@ -0,0 +1,65 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snippets
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"errors"
+	"github.com/google/uuid"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEntityToSnippet(t *testing.T) {
+	now := time.Now()
+	entity := snippetEntity{Sdk: pb.Sdk_SDK_JAVA.String(), Code: "class Snippet {\n}\n", PipelineOptions: "--output out.txt"}
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		want      *Snippet
+		wantErr   error
+	}{
+		{
+			// Test case with the entity which isn't expired.
+			// As a result, want to receive the snippet of the entity.
+			name:      "entity isn't expired",
+			expiresAt: now.Add(time.Minute),
+			want:      &Snippet{Sdk: pb.Sdk_SDK_JAVA, Code: "class Snippet {\n}\n", PipelineOptions: "--output out.txt"},
+			wantErr:   nil,
+		},
+		{
+			// Test case with the entity which is expired, but isn't deleted by the TTL policy yet.
+			// As a result, want to receive an error which wraps ErrNotFound.
+			name:      "entity is expired",
+			expiresAt: now.Add(-time.Minute),
+			want:      nil,
+			wantErr:   ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entity.ExpiresAt = tt.expiresAt
+			got, err := entityToSnippet(uuid.New(), &entity, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("entityToSnippet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("entityToSnippet() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
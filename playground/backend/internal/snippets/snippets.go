@@ -0,0 +1,141 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snippets
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+// SubKey is used to keep Snippet value in cache by the key of the snippet
+const SubKey cache.SubKey = "SNIPPET"
+
+var (
+	// ErrInvalidId is returned if the id of the snippet couldn't be parsed
+	ErrInvalidId = errors.New("invalid id of the snippet")
+	// ErrNotFound is returned if the snippet doesn't exist or is expired
+	ErrNotFound = errors.New("snippet isn't found")
+)
+
+var idEncoding = base64.RawURLEncoding
+
+func init() {
+	cache.RegisterDecoder(SubKey, decodeSnippet)
+}
+
+// Snippet is the code which is saved by the user to share it
+type Snippet struct {
+	Sdk             pb.Sdk `json:"sdk"`
+	Code            string `json:"code"`
+	PipelineOptions string `json:"pipeline_options"`
+}
+
+// Size returns the size in bytes of the code and the pipeline options of the snippet
+func (s *Snippet) Size() int {
+	return len(s.Code) + len(s.PipelineOptions)
+}
+
+// Store keeps snippets by their keys
+type Store interface {
+	// Put keeps the snippet by the key until the expiration time
+	Put(ctx context.Context, key uuid.UUID, snippet *Snippet, expiresAt time.Time) error
+
+	// Get returns the snippet by the key.
+	// In case the snippet doesn't exist or is expired returns an error which wraps ErrNotFound.
+	Get(ctx context.Context, key uuid.UUID) (*Snippet, error)
+}
+
+// Save keeps the snippet in the store during the retention and returns the short opaque id of the snippet.
+// Ids are random, so they don't reveal the code and are different for identical snippets.
+func Save(ctx context.Context, store Store, snippet *Snippet, retention time.Duration) (string, error) {
+	key := uuid.New()
+	if err := store.Put(ctx, key, snippet, time.Now().Add(retention)); err != nil {
+		return "", err
+	}
+	return idEncoding.EncodeToString(key[:]), nil
+}
+
+// Get returns the snippet which is kept in the store by the id.
+// In case the id couldn't be parsed returns ErrInvalidId.
+// In case the snippet doesn't exist or is expired returns an error which wraps ErrNotFound.
+func Get(ctx context.Context, store Store, id string) (*Snippet, error) {
+	key, err := parseId(id)
+	if err != nil {
+		return nil, err
+	}
+	return store.Get(ctx, key)
+}
+
+// CacheStore keeps snippets in cache, so snippets are kept only as long as the cache keeps its values
+// (e.g. snippets of the local cache are lost when the server is restarted)
+type CacheStore struct {
+	cacheService cache.Cache
+}
+
+// NewCacheStore returns CacheStore which keeps snippets in the cache
+func NewCacheStore(cacheService cache.Cache) *CacheStore {
+	return &CacheStore{cacheService: cacheService}
+}
+
+// Put keeps the snippet in cache by the key, the cache deletes the snippet when it is expired
+func (s *CacheStore) Put(ctx context.Context, key uuid.UUID, snippet *Snippet, expiresAt time.Time) error {
+	if err := s.cacheService.SetValue(ctx, key, SubKey, *snippet); err != nil {
+		return err
+	}
+	return s.cacheService.SetExpTime(ctx, key, time.Until(expiresAt))
+}
+
+// Get returns the snippet which is kept in cache by the key
+func (s *CacheStore) Get(ctx context.Context, key uuid.UUID) (*Snippet, error) {
+	value, err := s.cacheService.GetValue(ctx, key, SubKey)
+	if err != nil {
+		if errors.Is(err, cache.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, err.Error())
+		}
+		return nil, err
+	}
+	snippet, ok := value.(Snippet)
+	if !ok {
+		return nil, fmt.Errorf("value of the snippet %s has incorrect type %T", key, value)
+	}
+	return &snippet, nil
+}
+
+// parseId returns the key of the snippet by the id of the snippet
+func parseId(id string) (uuid.UUID, error) {
+	decoded, err := idEncoding.DecodeString(id)
+	if err != nil {
+		return uuid.Nil, ErrInvalidId
+	}
+	key, err := uuid.FromBytes(decoded)
+	if err != nil {
+		return uuid.Nil, ErrInvalidId
+	}
+	return key, nil
+}
+
+// decodeSnippet decodes value to Snippet
+func decodeSnippet(codec cache.Codec, value string) (interface{}, error) {
+	var snippet Snippet
+	err := codec.Unmarshal([]byte(value), &snippet)
+	return snippet, err
+}
@@ -0,0 +1,104 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snippets
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/local"
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSaveAndGet(t *testing.T) {
+	ctx := context.Background()
+	store := NewCacheStore(local.New(ctx))
+	snippet := &Snippet{Sdk: pb.Sdk_SDK_GO, Code: "package main\n\nfunc main() {}\n", PipelineOptions: "--output out.txt"}
+
+	id, err := Save(ctx, store, snippet, time.Minute)
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	otherId, err := Save(ctx, store, snippet, time.Minute)
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if id == otherId {
+		t.Errorf("Save() returned the same id %s for different snippets", id)
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		want    *Snippet
+		wantErr error
+	}{
+		{
+			// Test case with getting the saved snippet by its id.
+			// As a result, want to receive the saved snippet.
+			name:    "saved snippet",
+			id:      id,
+			want:    snippet,
+			wantErr: nil,
+		},
+		{
+			// Test case with getting the snippet by the id which isn't saved.
+			// As a result, want to receive an error which wraps ErrNotFound.
+			name:    "snippet doesn't exist",
+			id:      "AAAAAAAAAAAAAAAAAAAAAA",
+			want:    nil,
+			wantErr: ErrNotFound,
+		},
+		{
+			// Test case with getting the snippet by the id which couldn't be parsed.
+			// As a result, want to receive ErrInvalidId.
+			name:    "invalid id",
+			id:      "MOCK_ID",
+			want:    nil,
+			wantErr: ErrInvalidId,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Get(ctx, store, tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Get() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeSnippet(t *testing.T) {
+	snippet := Snippet{Sdk: pb.Sdk_SDK_PYTHON, Code: "print('Hello')", PipelineOptions: ""}
+	value, err := json.Marshal(snippet)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	got, err := cache.Decode(SubKey, string(value))
+	if err != nil {
+		t.Fatalf("cache.Decode() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, snippet) {
+		t.Errorf("cache.Decode() got = %v, want %v", got, snippet)
+	}
+}
@@ -0,0 +1,290 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source_cache
+
+import (
+	"archive/tar"
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/fs_tool"
+	"beam.apache.org/playground/backend/internal/logger"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// maxArtifactsSize is the max size of the archive of compiled files which is kept in cache
+	maxArtifactsSize = 64 * 1024 * 1024
+	// pipelineIdPlaceholder replaces pipelineId in names of compiled files which are kept in cache
+	pipelineIdPlaceholder = "{pipelineId}"
+)
+
+// namespace is used to generate ids of sources from their hashes
+var namespace = uuid.MustParse("5c6a1e0f-8d3b-4f5e-9a27-3b1d2c4e6f80")
+
+// runSubKeys are subKeys of the finished pipeline which are reused by identical requests
+var runSubKeys = []cache.SubKey{cache.CompileOutput, cache.RunOutput, cache.RunError, cache.Logs, cache.Graph, cache.RunResult}
+
+// Source is the code of the request which results of the code processing are kept in cache
+// and reused by identical requests instead of processing the code again.
+type Source struct {
+	// Id is the key of the results in cache, see Key
+	Id uuid.UUID
+
+	// Deterministic is true if the code produces the same output on every run, so the run output can be reused.
+	// Only the compiled files are reused for other code.
+	Deterministic bool
+
+	// ExpTime is the expiration time of the results in cache
+	ExpTime time.Duration
+}
+
+// New returns Source of the code which is run using sdk, pipelineOptions and requirements
+func New(sdk pb.Sdk, code, pipelineOptions string, requirements []string, stdin string, envVars map[string]string, runner string, deterministic bool, expTime time.Duration) *Source {
+	return &Source{Id: Key(sdk, code, pipelineOptions, requirements, stdin, envVars, runner), Deterministic: deterministic, ExpTime: expTime}
+}
+
+// Key returns id which is used to keep results of the code processing in cache.
+// The id is generated from the hash of the normalized code, sdk, pipeline options, requirements, input, environment variables
+// and the runner, so codes which differ only by line endings or trailing whitespaces have the same id.
+// The order of requirements doesn't change the id.
+func Key(sdk pb.Sdk, code, pipelineOptions string, requirements []string, stdin string, envVars map[string]string, runner string) uuid.UUID {
+	sortedRequirements := make([]string, 0, len(requirements))
+	for _, requirement := range requirements {
+		sortedRequirements = append(sortedRequirements, strings.TrimSpace(requirement))
+	}
+	sort.Strings(sortedRequirements)
+	sortedEnvVars := make([]string, 0, len(envVars))
+	for name, value := range envVars {
+		sortedEnvVars = append(sortedEnvVars, name+"="+value)
+	}
+	sort.Strings(sortedEnvVars)
+	hash := sha256.New()
+	for _, part := range []string{sdk.String(), normalizeCode(code), strings.Join(strings.Fields(pipelineOptions), " "), strings.Join(sortedRequirements, "\n"), stdin, strings.Join(sortedEnvVars, "\n"), runner} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return uuid.NewSHA1(namespace, hash.Sum(nil))
+}
+
+// normalizeCode removes the byte order mark and whitespaces at the end of the code and replaces line endings with "\n"
+func normalizeCode(code string) string {
+	code = strings.TrimPrefix(code, "\ufeff")
+	code = strings.ReplaceAll(code, "\r\n", "\n")
+	code = strings.ReplaceAll(code, "\r", "\n")
+	return strings.TrimRight(code, " \t\n")
+}
+
+// SaveCompileResult keeps the compile output and the compiled files of the pipeline in cache,
+// so they are reused by RestoreCompileResult.
+// If the archive of the compiled files is larger than maxArtifactsSize, nothing is kept.
+func (s *Source) SaveCompileResult(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID, paths *fs_tool.LifeCyclePaths, compileOutput string) error {
+	artifacts, err := archiveFolder(paths.AbsoluteExecutableFileFolderPath, pipelineId)
+	if err != nil {
+		return err
+	}
+	if len(artifacts) > maxArtifactsSize {
+		logger.Warnf("%s: SaveCompileResult(): compiled files take %d bytes which exceeds %d bytes, they aren't kept\n", pipelineId, len(artifacts), maxArtifactsSize)
+		return nil
+	}
+	values := map[cache.SubKey]interface{}{cache.CompileOutput: compileOutput, cache.CompiledArtifacts: artifacts}
+	if err = cacheService.SetValues(ctx, s.Id, values); err != nil {
+		return err
+	}
+	return cacheService.SetExpTime(ctx, s.Id, s.ExpTime)
+}
+
+// RestoreCompileResult extracts the compiled files which are kept in cache to the executable folder of the pipeline
+// and returns the compile output.
+// Returns false if the compiled files of the source aren't kept in cache.
+func (s *Source) RestoreCompileResult(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID, paths *fs_tool.LifeCyclePaths) (string, bool, error) {
+	values, err := cacheService.GetValues(ctx, s.Id, []cache.SubKey{cache.CompileOutput, cache.CompiledArtifacts})
+	if err != nil {
+		return "", false, err
+	}
+	compileOutput, ok := values[cache.CompileOutput].(string)
+	if !ok {
+		return "", false, nil
+	}
+	artifacts, ok := values[cache.CompiledArtifacts].([]byte)
+	if !ok {
+		return "", false, nil
+	}
+	if err = extractArchive(artifacts, paths.AbsoluteExecutableFileFolderPath, pipelineId); err != nil {
+		return "", false, err
+	}
+	return compileOutput, true, nil
+}
+
+// SaveRunResult keeps outputs of the finished pipeline in cache, so they are reused by RestoreRunResult.
+// Outputs are kept only if the source is deterministic and the pipeline is finished successfully.
+// The status isn't kept under the id of the source, so the source isn't taken as an idle finished pipeline
+// (e.g. by reaper.Cache) and is kept until it expires. cache.RunResultKept marks the kept outputs instead.
+func (s *Source) SaveRunResult(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID) error {
+	if !s.Deterministic {
+		return nil
+	}
+	values, err := cacheService.GetValues(ctx, pipelineId, append([]cache.SubKey{cache.Status}, runSubKeys...))
+	if err != nil {
+		return err
+	}
+	if values[cache.Status] != pb.Status_STATUS_FINISHED {
+		return nil
+	}
+	delete(values, cache.Status)
+	values[cache.RunResultKept] = true
+	if err = cacheService.SetValues(ctx, s.Id, values); err != nil {
+		return err
+	}
+	return cacheService.SetExpTime(ctx, s.Id, s.ExpTime)
+}
+
+// RestoreRunResult sets outputs of the finished pipeline which are kept in cache to the pipeline.
+// Returns false if the source isn't deterministic or outputs of the source aren't kept in cache.
+func (s *Source) RestoreRunResult(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID) (bool, error) {
+	if !s.Deterministic {
+		return false, nil
+	}
+	values, err := cacheService.GetValues(ctx, s.Id, append([]cache.SubKey{cache.RunResultKept}, runSubKeys...))
+	if err != nil {
+		return false, err
+	}
+	if values[cache.RunResultKept] != true {
+		return false, nil
+	}
+	delete(values, cache.RunResultKept)
+	values[cache.Status] = pb.Status_STATUS_FINISHED
+	values[cache.RunOutputIndex] = 0
+	values[cache.LogsIndex] = 0
+	values[cache.Canceled] = false
+	if err = cacheService.SetValues(ctx, pipelineId, values); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// archiveFolder returns gzipped tar archive of files of the folder.
+// pipelineId in names of files is replaced with pipelineIdPlaceholder.
+func archiveFolder(folder string, pipelineId uuid.UUID) ([]byte, error) {
+	var archive bytes.Buffer
+	gzipWriter := gzip.NewWriter(&archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+	err := filepath.WalkDir(folder, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || path == folder {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			return nil
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(folder, path)
+		if err != nil {
+			return err
+		}
+		header.Name = strings.ReplaceAll(filepath.ToSlash(name), pipelineId.String(), pipelineIdPlaceholder)
+		if err = tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(tarWriter, file)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = tarWriter.Close(); err != nil {
+		return nil, err
+	}
+	if err = gzipWriter.Close(); err != nil {
+		return nil, err
+	}
+	return archive.Bytes(), nil
+}
+
+// extractArchive extracts files of the archive which is created by archiveFolder to the folder.
+// pipelineIdPlaceholder in names of files is replaced with pipelineId.
+func extractArchive(archive []byte, folder string, pipelineId uuid.UUID) error {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		name := filepath.Clean(filepath.FromSlash(strings.ReplaceAll(header.Name, pipelineIdPlaceholder, pipelineId.String())))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("file %s of the archive is outside the folder", header.Name)
+		}
+		path := filepath.Join(folder, name)
+		if header.Typeflag == tar.TypeDir {
+			if err = os.MkdirAll(path, fs.ModePerm); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = os.MkdirAll(filepath.Dir(path), fs.ModePerm); err != nil {
+			return err
+		}
+		if err = writeFile(path, tarReader, header.FileInfo().Mode().Perm()); err != nil {
+			return err
+		}
+	}
+}
+
+// writeFile creates the file with the content of the reader
+func writeFile(path string, reader io.Reader, mode fs.FileMode) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(file, reader); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
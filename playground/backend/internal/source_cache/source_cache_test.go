@@ -0,0 +1,289 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source_cache
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/local"
+	"beam.apache.org/playground/backend/internal/cache/reaper"
+	"beam.apache.org/playground/backend/internal/fs_tool"
+	"context"
+	"github.com/google/uuid"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestKey(t *testing.T) {
+	code := "package main\n\nfunc main() {\n}\n"
+	tests := []struct {
+		name      string
+		sdk       pb.Sdk
+		code      string
+		options   string
+		wantEqual bool
+	}{
+		{
+			// Test case with the code which differs only by line endings and whitespaces at the end.
+			// As a result, want to receive the same key.
+			name:      "normalized code",
+			sdk:       pb.Sdk_SDK_GO,
+			code:      "\ufeffpackage main\r\n\r\nfunc main() {\r\n}\r\n\r\n  ",
+			wantEqual: true,
+		},
+		{
+			// Test case with pipeline options which differ only by whitespaces.
+			// As a result, want to receive the same key.
+			name:      "normalized pipeline options",
+			sdk:       pb.Sdk_SDK_GO,
+			code:      code,
+			options:   "  --option1 value1   --option2 value2 ",
+			wantEqual: true,
+		},
+		{
+			// Test case with the other code.
+			// As a result, want to receive another key.
+			name:      "other code",
+			sdk:       pb.Sdk_SDK_GO,
+			code:      "package main\n\nfunc main() {\n\tprintln()\n}\n",
+			wantEqual: false,
+		},
+		{
+			// Test case with the other sdk.
+			// As a result, want to receive another key.
+			name:      "other sdk",
+			sdk:       pb.Sdk_SDK_JAVA,
+			code:      code,
+			wantEqual: false,
+		},
+		{
+			// Test case with other pipeline options.
+			// As a result, want to receive another key.
+			name:      "other pipeline options",
+			sdk:       pb.Sdk_SDK_GO,
+			code:      code,
+			options:   "--option1 value2 --option2 value2",
+			wantEqual: false,
+		},
+	}
+	want := Key(pb.Sdk_SDK_GO, code, "", nil, "", nil, "")
+	wantWithOptions := Key(pb.Sdk_SDK_GO, code, "--option1 value1 --option2 value2", nil, "", nil, "")
+	wantWithRequirements := Key(pb.Sdk_SDK_GO, code, "", []string{"numpy==1.21.5", "pandas"}, "", nil, "")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Key(tt.sdk, tt.code, tt.options, nil, "", nil, "")
+			if (got == want || got == wantWithOptions) != tt.wantEqual {
+				t.Errorf("Key() = %v, want equal to %v or %v: %v", got, want, wantWithOptions, tt.wantEqual)
+			}
+		})
+	}
+	// requirements in another order have the same key, other requirements have another key
+	if got := Key(pb.Sdk_SDK_GO, code, "", []string{" pandas", "numpy==1.21.5"}, "", nil, ""); got != wantWithRequirements {
+		t.Errorf("Key() with reordered requirements = %v, want %v", got, wantWithRequirements)
+	}
+	if got := Key(pb.Sdk_SDK_GO, code, "", []string{"numpy==1.22.0", "pandas"}, "", nil, ""); got == wantWithRequirements || got == want {
+		t.Errorf("Key() with other requirements = %v, want another key", got)
+	}
+	// the code with the input has another key
+	if got := Key(pb.Sdk_SDK_GO, code, "", nil, "input", nil, ""); got == want {
+		t.Errorf("Key() with input = %v, want another key", got)
+	}
+	// the code with environment variables has another key
+	if got := Key(pb.Sdk_SDK_GO, code, "", nil, "", map[string]string{"KEY": "value"}, ""); got == want {
+		t.Errorf("Key() with environment variables = %v, want another key", got)
+	}
+	// the code run by another runner has another key
+	if got := Key(pb.Sdk_SDK_GO, code, "", nil, "", nil, "flink"); got == want {
+		t.Errorf("Key() with runner = %v, want another key", got)
+	}
+}
+
+func TestSource_CompileResult(t *testing.T) {
+	ctx := context.Background()
+	cacheService := local.New(ctx)
+	source := New(pb.Sdk_SDK_GO, "MOCK_CODE", "", nil, "", nil, "", true, time.Minute)
+	pipelineId := uuid.New()
+	lc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_GO, pipelineId, t.TempDir())
+	if err := lc.CreateFolders(); err != nil {
+		t.Fatalf("error during prepare folders: %s", err.Error())
+	}
+	otherPipelineId := uuid.New()
+	otherLc, _ := fs_tool.NewLifeCycle(pb.Sdk_SDK_GO, otherPipelineId, t.TempDir())
+	if err := otherLc.CreateFolders(); err != nil {
+		t.Fatalf("error during prepare folders: %s", err.Error())
+	}
+
+	if _, ok, err := source.RestoreCompileResult(ctx, cacheService, otherPipelineId, &otherLc.Paths); ok || err != nil {
+		t.Fatalf("RestoreCompileResult() before SaveCompileResult() = %v, %v, want false, nil", ok, err)
+	}
+
+	if err := os.WriteFile(lc.Paths.AbsoluteExecutableFilePath, []byte("MOCK_EXECUTABLE"), 0700); err != nil {
+		t.Fatalf("error during create executable file: %s", err.Error())
+	}
+	if err := os.MkdirAll(filepath.Join(lc.Paths.AbsoluteExecutableFileFolderPath, "org"), 0700); err != nil {
+		t.Fatalf("error during create folder: %s", err.Error())
+	}
+	if err := os.WriteFile(filepath.Join(lc.Paths.AbsoluteExecutableFileFolderPath, "org", "Helper.class"), []byte("MOCK_CLASS"), 0600); err != nil {
+		t.Fatalf("error during create class file: %s", err.Error())
+	}
+	if err := source.SaveCompileResult(ctx, cacheService, pipelineId, &lc.Paths, "MOCK_COMPILE_OUTPUT"); err != nil {
+		t.Fatalf("SaveCompileResult() error = %v", err)
+	}
+
+	compileOutput, ok, err := source.RestoreCompileResult(ctx, cacheService, otherPipelineId, &otherLc.Paths)
+	if err != nil || !ok {
+		t.Fatalf("RestoreCompileResult() = %v, %v, want true, nil", ok, err)
+	}
+	if compileOutput != "MOCK_COMPILE_OUTPUT" {
+		t.Errorf("RestoreCompileResult() compile output = %q, want %q", compileOutput, "MOCK_COMPILE_OUTPUT")
+	}
+	// The executable file is named with pipelineId, so it is renamed to other pipelineId
+	info, err := os.Stat(otherLc.Paths.AbsoluteExecutableFilePath)
+	if err != nil {
+		t.Fatalf("executable file isn't restored: %s", err.Error())
+	}
+	if info.Mode().Perm() != 0700 {
+		t.Errorf("executable file mode = %v, want %v", info.Mode().Perm(), os.FileMode(0700))
+	}
+	if content, _ := os.ReadFile(filepath.Join(otherLc.Paths.AbsoluteExecutableFileFolderPath, "org", "Helper.class")); string(content) != "MOCK_CLASS" {
+		t.Errorf("class file content = %q, want %q", content, "MOCK_CLASS")
+	}
+}
+
+func TestSource_RunResult(t *testing.T) {
+	ctx := context.Background()
+	runResult := cache.ExecutionResult{ExitCode: 0, Duration: time.Second}
+	finishedValues := map[cache.SubKey]interface{}{
+		cache.Status:        pb.Status_STATUS_FINISHED,
+		cache.CompileOutput: "MOCK_COMPILE_OUTPUT",
+		cache.RunOutput:     "MOCK_RUN_OUTPUT",
+		cache.RunError:      "",
+		cache.Logs:          "MOCK_LOGS",
+		cache.RunResult:     runResult,
+	}
+	tests := []struct {
+		name          string
+		deterministic bool
+		values        map[cache.SubKey]interface{}
+		wantReused    bool
+	}{
+		{
+			// Test case with the finished pipeline of the deterministic code.
+			// As a result, want to receive the outputs of the pipeline for the other pipeline.
+			name:          "finished deterministic pipeline",
+			deterministic: true,
+			values:        finishedValues,
+			wantReused:    true,
+		},
+		{
+			// Test case with the finished pipeline of the nondeterministic code.
+			// As a result, want the outputs of the pipeline not to be reused.
+			name:          "finished nondeterministic pipeline",
+			deterministic: false,
+			values:        finishedValues,
+			wantReused:    false,
+		},
+		{
+			// Test case with the failed pipeline of the deterministic code.
+			// As a result, want the outputs of the pipeline not to be reused.
+			name:          "failed deterministic pipeline",
+			deterministic: true,
+			values: map[cache.SubKey]interface{}{
+				cache.Status:   pb.Status_STATUS_RUN_ERROR,
+				cache.RunError: "MOCK_RUN_ERROR",
+			},
+			wantReused: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cacheService := local.New(ctx)
+			source := New(pb.Sdk_SDK_PYTHON, "MOCK_CODE", "", nil, "", nil, "", tt.deterministic, time.Minute)
+			pipelineId := uuid.New()
+			if err := cacheService.SetValues(ctx, pipelineId, tt.values); err != nil {
+				t.Fatalf("error during set values: %s", err.Error())
+			}
+			if err := source.SaveRunResult(ctx, cacheService, pipelineId); err != nil {
+				t.Fatalf("SaveRunResult() error = %v", err)
+			}
+
+			otherPipelineId := uuid.New()
+			reused, err := source.RestoreRunResult(ctx, cacheService, otherPipelineId)
+			if err != nil {
+				t.Fatalf("RestoreRunResult() error = %v", err)
+			}
+			if reused != tt.wantReused {
+				t.Fatalf("RestoreRunResult() = %v, want %v", reused, tt.wantReused)
+			}
+			if !reused {
+				return
+			}
+			for subKey, want := range tt.values {
+				if got, _ := cacheService.GetValue(ctx, otherPipelineId, subKey); !reflect.DeepEqual(got, want) {
+					t.Errorf("RestoreRunResult() %s = %v, want %v", subKey, got, want)
+				}
+			}
+			if canceled, _ := cacheService.GetValue(ctx, otherPipelineId, cache.Canceled); canceled != false {
+				t.Errorf("RestoreRunResult() canceled = %v, want false", canceled)
+			}
+		})
+	}
+}
+
+func TestSource_RunResultWithReaper(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cacheService := reaper.New(ctx, local.New(ctx), 10*time.Millisecond)
+	source := New(pb.Sdk_SDK_PYTHON, "MOCK_CODE", "", nil, "", nil, "", true, time.Minute)
+	pipelineId := uuid.New()
+	values := map[cache.SubKey]interface{}{cache.Status: pb.Status_STATUS_FINISHED, cache.RunOutput: "MOCK_RUN_OUTPUT"}
+	if err := cacheService.SetValues(ctx, pipelineId, values); err != nil {
+		t.Fatalf("error during set values: %s", err.Error())
+	}
+	if err := source.SaveRunResult(ctx, cacheService, pipelineId); err != nil {
+		t.Fatalf("SaveRunResult() error = %v", err)
+	}
+
+	// the finished pipeline is deleted after the idle timeout, but the outputs of the source are kept
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if count, _ := cacheService.GetSubKeyCount(ctx, pipelineId); count == 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if count, _ := cacheService.GetSubKeyCount(ctx, pipelineId); count != 0 {
+		t.Fatalf("the idle pipeline isn't deleted in the background")
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	otherPipelineId := uuid.New()
+	reused, err := source.RestoreRunResult(ctx, cacheService, otherPipelineId)
+	if err != nil {
+		t.Fatalf("RestoreRunResult() error = %v", err)
+	}
+	if !reused {
+		t.Fatalf("RestoreRunResult() = false, want true")
+	}
+	for subKey, want := range values {
+		if got, _ := cacheService.GetValue(ctx, otherPipelineId, subKey); !reflect.DeepEqual(got, want) {
+			t.Errorf("RestoreRunResult() %s = %v, want %v", subKey, got, want)
+		}
+	}
+}
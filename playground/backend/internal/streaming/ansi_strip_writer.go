@@ -0,0 +1,115 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streaming
+
+import (
+	"io"
+)
+
+const (
+	escByte = 0x1b
+	belByte = 0x07
+)
+
+// ansiState is the state of parsing of ANSI escape sequences
+type ansiState int
+
+const (
+	// ansiText is the state of the text outside of escape sequences
+	ansiText ansiState = iota
+	// ansiEscape is the state after the ESC byte
+	ansiEscape
+	// ansiCsi is the state inside the control sequence like "ESC [ 31 m"
+	ansiCsi
+	// ansiString is the state inside the control string like "ESC ] 0;title BEL" which is terminated by BEL or "ESC \"
+	ansiString
+	// ansiStringEscape is the state after the ESC byte inside the control string
+	ansiStringEscape
+)
+
+// AnsiStripWriter is used to remove ANSI escape sequences (e.g. colors) from the output before it is written to w.
+// The state of parsing is kept between writes, so sequences which are split between writes are also removed.
+type AnsiStripWriter struct {
+	w     io.Writer
+	state ansiState
+}
+
+// NewAnsiStripWriter returns AnsiStripWriter which writes the output without ANSI escape sequences to w
+func NewAnsiStripWriter(w io.Writer) *AnsiStripWriter {
+	return &AnsiStripWriter{w: w}
+}
+
+// Write writes p without ANSI escape sequences to the underlying writer.
+// Returns len(p) if the underlying writer doesn't return an error, since all bytes of p are processed.
+func (aw *AnsiStripWriter) Write(p []byte) (int, error) {
+	text := make([]byte, 0, len(p))
+	for _, b := range p {
+		if aw.next(b) {
+			text = append(text, b)
+		}
+	}
+	if len(text) == 0 {
+		return len(p), nil
+	}
+	if _, err := aw.w.Write(text); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// next moves the state of parsing by the byte and returns true if the byte is a part of the text
+func (aw *AnsiStripWriter) next(b byte) bool {
+	switch aw.state {
+	case ansiEscape:
+		switch {
+		case b == '[':
+			aw.state = ansiCsi
+		case b == ']' || b == 'P' || b == 'X' || b == '^' || b == '_':
+			aw.state = ansiString
+		case b >= 0x20 && b <= 0x2f:
+			// intermediate bytes of the escape sequence like "ESC ( B"
+		default:
+			aw.state = ansiText
+		}
+		return false
+	case ansiCsi:
+		if b >= 0x40 && b <= 0x7e {
+			aw.state = ansiText
+		}
+		return false
+	case ansiString:
+		switch b {
+		case belByte:
+			aw.state = ansiText
+		case escByte:
+			aw.state = ansiStringEscape
+		}
+		return false
+	case ansiStringEscape:
+		if b == '\\' {
+			aw.state = ansiText
+		} else {
+			aw.state = ansiString
+		}
+		return false
+	default:
+		if b == escByte {
+			aw.state = ansiEscape
+			return false
+		}
+		return true
+	}
+}
@@ -0,0 +1,73 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streaming
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAnsiStripWriter_Write(t *testing.T) {
+	tests := []struct {
+		name   string
+		writes []string
+		want   string
+	}{
+		{
+			// Test case with the colored output of the tool.
+			// As a result, want to receive the output without colors.
+			name:   "colored output",
+			writes: []string{"\x1b[1;31mERROR\x1b[0m: build failed\n", "\x1b[32mINFO\x1b[m: done\n"},
+			want:   "ERROR: build failed\nINFO: done\n",
+		},
+		{
+			// Test case with the escape sequence which is split between writes.
+			// As a result, want to receive the output without the whole escape sequence.
+			name:   "split escape sequence",
+			writes: []string{"line 1\x1b", "[3", "3mline 2\x1b[0", "m\n"},
+			want:   "line 1line 2\n",
+		},
+		{
+			// Test case with the control string which sets the title of the terminal and the charset sequence.
+			// As a result, want to receive the output without control sequences.
+			name:   "control strings",
+			writes: []string{"\x1b]0;title\x07text \x1b]8;;link\x1b\\link\x1b]8;;\x1b\\ \x1b(Bend"},
+			want:   "text link end",
+		},
+		{
+			// Test case with the output without escape sequences.
+			// As a result, want to receive the same output.
+			name:   "plain output",
+			writes: []string{"Hello, Мир!\n", "[not an escape]"},
+			want:   "Hello, Мир!\n[not an escape]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got strings.Builder
+			w := NewAnsiStripWriter(&got)
+			for _, data := range tt.writes {
+				n, err := w.Write([]byte(data))
+				if err != nil || n != len(data) {
+					t.Fatalf("Write() = %d, %v, want %d, nil", n, err, len(data))
+				}
+			}
+			if got.String() != tt.want {
+				t.Errorf("Write() output = %q, want %q", got.String(), tt.want)
+			}
+		})
+	}
+}
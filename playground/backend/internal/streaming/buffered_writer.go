@@ -0,0 +1,71 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streaming
+
+import (
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/buffer"
+	"beam.apache.org/playground/backend/internal/logger"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+// BufferedWriter is used to write the step's output (e.g. cache.RunOutput or cache.Logs) to cache as a stream.
+// Written data is appended to the output in memory and the whole output is saved to cache every flush interval
+// and when the size threshold is reached, so readers of the cache see the output in order while the step is running.
+// BufferedWriter owns the value of the subKey, so the value shouldn't be set to the cache by anyone else until Close.
+type BufferedWriter struct {
+	ctx        context.Context
+	pipelineId uuid.UUID
+	subKey     cache.SubKey
+	buffer     *buffer.Buffer
+}
+
+// NewBufferedWriter returns BufferedWriter for the pipeline's subKey.
+// When ctx is done, the written output is saved to cache for the last time.
+func NewBufferedWriter(ctx context.Context, cacheService cache.Cache, pipelineId uuid.UUID, subKey cache.SubKey, flushInterval time.Duration, sizeThreshold int) *BufferedWriter {
+	return &BufferedWriter{
+		ctx:        ctx,
+		pipelineId: pipelineId,
+		subKey:     subKey,
+		buffer:     buffer.New(ctx, cacheService, pipelineId, subKey, flushInterval, sizeThreshold),
+	}
+}
+
+// Write appends p to the output.
+// Write never returns an error, so the process which writes the output isn't broken by the cache:
+// if the output couldn't be saved, the error is logged and the output is saved by the next flush.
+func (w *BufferedWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if err := w.buffer.Append(w.ctx, string(p)); err != nil {
+		logger.Errorf("%s: BufferedWriter: error during saving %s: %s\n", w.pipelineId, w.subKey, err.Error())
+	}
+	return len(p), nil
+}
+
+// Flush saves the written output to cache.
+func (w *BufferedWriter) Flush(ctx context.Context) error {
+	return w.buffer.Flush(ctx)
+}
+
+// Close stops saving the output by interval and saves the whole written output to cache.
+// After Close returns, the cache contains all written data in the written order.
+func (w *BufferedWriter) Close(ctx context.Context) error {
+	return w.buffer.Close(ctx)
+}
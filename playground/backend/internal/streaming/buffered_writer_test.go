@@ -0,0 +1,104 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streaming
+
+import (
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/local"
+	"context"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+)
+
+func TestBufferedWriter_Write(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name          string
+		flushInterval time.Duration
+		sizeThreshold int
+		writes        []string
+		// wantIntermediate is the cache value after all writes and before Close
+		wantIntermediate interface{}
+		want             string
+	}{
+		{
+			// Test case with writing lines which reach the size threshold.
+			// As a result, want to receive written lines in the cache before Close.
+			name:             "size threshold is reached",
+			flushInterval:    time.Hour,
+			sizeThreshold:    len("line 1\nline 2\n"),
+			writes:           []string{"line 1\n", "line 2\n", "line 3"},
+			wantIntermediate: "line 1\nline 2\n",
+			want:             "line 1\nline 2\nline 3",
+		},
+		{
+			// Test case with writing lines which don't reach the size threshold.
+			// As a result, want to receive nothing in the cache before Close and all lines after Close.
+			name:             "size threshold isn't reached",
+			flushInterval:    time.Hour,
+			sizeThreshold:    1024,
+			writes:           []string{"line 1\n", "", "line 2\n"},
+			wantIntermediate: nil,
+			want:             "line 1\nline 2\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cacheService := local.New(ctx)
+			pipelineId := uuid.New()
+			w := NewBufferedWriter(ctx, cacheService, pipelineId, cache.RunOutput, tt.flushInterval, tt.sizeThreshold)
+			for _, data := range tt.writes {
+				n, err := w.Write([]byte(data))
+				if err != nil || n != len(data) {
+					t.Fatalf("Write() = %d, %v, want %d, nil", n, err, len(data))
+				}
+			}
+			got, _ := cacheService.GetValue(ctx, pipelineId, cache.RunOutput)
+			if got != tt.wantIntermediate {
+				t.Errorf("Write() intermediate output = %v, want %v", got, tt.wantIntermediate)
+			}
+			if err := w.Close(ctx); err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+			got, _ = cacheService.GetValue(ctx, pipelineId, cache.RunOutput)
+			if got != tt.want {
+				t.Errorf("Close() output = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBufferedWriter_FlushInterval(t *testing.T) {
+	ctx := context.Background()
+	cacheService := local.New(ctx)
+	pipelineId := uuid.New()
+	w := NewBufferedWriter(ctx, cacheService, pipelineId, cache.Logs, 10*time.Millisecond, 0)
+	defer w.Close(ctx)
+
+	_, _ = w.Write([]byte("log 1\n"))
+	deadline := time.Now().Add(time.Second)
+	for {
+		got, _ := cacheService.GetValue(ctx, pipelineId, cache.Logs)
+		if got == "log 1\n" {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("output isn't saved by the flush interval, got %v", got)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -0,0 +1,100 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streaming
+
+import (
+	"io"
+	"sync/atomic"
+)
+
+// OutputTruncatedMarker is written instead of the output which exceeds the cap of CappedWriter
+const OutputTruncatedMarker = "[output truncated]"
+
+// CappedWriter is used to limit the output which is written to w by the number of lines and bytes.
+// The output after the cap is discarded and OutputTruncatedMarker is written instead of it once.
+type CappedWriter struct {
+	w           io.Writer
+	maxLines    int
+	maxBytes    int64
+	onTruncated func()
+	lines       int
+	bytes       int64
+	lastByte    byte
+	truncated   int32
+}
+
+// NewCappedWriter returns CappedWriter which writes at most maxLines lines and maxBytes bytes of the output to w.
+// Zero value of a cap means that the output isn't limited by it. If onTruncated isn't nil, it is called once
+// when the output is truncated.
+func NewCappedWriter(w io.Writer, maxLines int, maxBytes int64, onTruncated func()) *CappedWriter {
+	return &CappedWriter{w: w, maxLines: maxLines, maxBytes: maxBytes, onTruncated: onTruncated}
+}
+
+// Write writes p to the underlying writer until the cap is reached.
+// Returns len(p) if the underlying writer doesn't return an error, so the writing process isn't stopped by the cap.
+func (cw *CappedWriter) Write(p []byte) (int, error) {
+	if cw.Truncated() {
+		return len(p), nil
+	}
+	n := cw.allowed(p)
+	if n > 0 {
+		if _, err := cw.w.Write(p[:n]); err != nil {
+			return 0, err
+		}
+		cw.bytes += int64(n)
+		cw.lastByte = p[n-1]
+	}
+	if n < len(p) {
+		marker := OutputTruncatedMarker + "\n"
+		if cw.bytes > 0 && cw.lastByte != '\n' {
+			marker = "\n" + marker
+		}
+		atomic.StoreInt32(&cw.truncated, 1)
+		if _, err := cw.w.Write([]byte(marker)); err != nil {
+			return 0, err
+		}
+		if cw.onTruncated != nil {
+			cw.onTruncated()
+		}
+	}
+	return len(p), nil
+}
+
+// Truncated returns true if the output exceeded the cap
+func (cw *CappedWriter) Truncated() bool {
+	return atomic.LoadInt32(&cw.truncated) == 1
+}
+
+// allowed returns the number of first bytes of p which could be written without exceeding the cap
+func (cw *CappedWriter) allowed(p []byte) int {
+	n := len(p)
+	if cw.maxBytes > 0 && cw.bytes+int64(n) > cw.maxBytes {
+		n = int(cw.maxBytes - cw.bytes)
+	}
+	if cw.maxLines <= 0 {
+		return n
+	}
+	for i := 0; i < n; i++ {
+		if cw.lines == cw.maxLines {
+			// the byte starts the line after the max number of lines
+			return i
+		}
+		if p[i] == '\n' {
+			cw.lines++
+		}
+	}
+	return n
+}
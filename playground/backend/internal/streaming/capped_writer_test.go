@@ -0,0 +1,107 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streaming
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCappedWriter_Write(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxLines      int
+		maxBytes      int64
+		writes        []string
+		want          string
+		wantTruncated bool
+	}{
+		{
+			// Test case with the output which is shorter than caps.
+			// As a result, want to receive the whole output.
+			name:          "output under caps",
+			maxLines:      3,
+			maxBytes:      100,
+			writes:        []string{"line 1\n", "line 2\n", "line 3"},
+			want:          "line 1\nline 2\nline 3",
+			wantTruncated: false,
+		},
+		{
+			// Test case with the output which has more lines than the line cap.
+			// As a result, want to receive the first lines and the marker.
+			name:          "line cap",
+			maxLines:      2,
+			writes:        []string{"line 1\nline 2\nline 3\n", "line 4\n"},
+			want:          "line 1\nline 2\n" + OutputTruncatedMarker + "\n",
+			wantTruncated: true,
+		},
+		{
+			// Test case with the output which has more bytes than the byte cap.
+			// As a result, want to receive the first bytes and the marker on the next line.
+			name:          "byte cap",
+			maxBytes:      10,
+			writes:        []string{"12345", "67890abc", "def"},
+			want:          "1234567890\n" + OutputTruncatedMarker + "\n",
+			wantTruncated: true,
+		},
+		{
+			// Test case with the output which reaches the byte cap exactly.
+			// As a result, want to receive the whole output without the marker.
+			name:          "output at byte cap",
+			maxBytes:      6,
+			writes:        []string{"12345\n"},
+			want:          "12345\n",
+			wantTruncated: false,
+		},
+		{
+			// Test case with the output without caps.
+			// As a result, want to receive the whole output.
+			name:          "no caps",
+			writes:        []string{"line 1\n", "line 2\n"},
+			want:          "line 1\nline 2\n",
+			wantTruncated: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var output bytes.Buffer
+			calls := 0
+			cw := NewCappedWriter(&output, tt.maxLines, tt.maxBytes, func() { calls++ })
+			for _, write := range tt.writes {
+				n, err := cw.Write([]byte(write))
+				if err != nil {
+					t.Fatalf("Write() error = %v", err)
+				}
+				if n != len(write) {
+					t.Errorf("Write() n = %d, want %d", n, len(write))
+				}
+			}
+			if got := output.String(); got != tt.want {
+				t.Errorf("Write() output = %q, want %q", got, tt.want)
+			}
+			if cw.Truncated() != tt.wantTruncated {
+				t.Errorf("Truncated() = %v, want %v", cw.Truncated(), tt.wantTruncated)
+			}
+			wantCalls := 0
+			if tt.wantTruncated {
+				wantCalls = 1
+			}
+			if calls != wantCalls {
+				t.Errorf("onTruncated is called %d times, want %d", calls, wantCalls)
+			}
+		})
+	}
+}
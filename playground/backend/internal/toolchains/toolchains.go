@@ -0,0 +1,86 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package toolchains
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"context"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+)
+
+const probeTimeout = 10 * time.Second
+
+// Toolchain is the command which processes the code of the SDK and the arguments to get its version
+type Toolchain struct {
+	Cmd         string
+	VersionArgs []string
+}
+
+// Toolchains contains toolchains of all known SDKs
+var Toolchains = map[pb.Sdk]Toolchain{
+	pb.Sdk_SDK_JAVA:   {Cmd: "javac", VersionArgs: []string{"-version"}},
+	pb.Sdk_SDK_GO:     {Cmd: "go", VersionArgs: []string{"version"}},
+	pb.Sdk_SDK_PYTHON: {Cmd: "python3", VersionArgs: []string{"--version"}},
+	pb.Sdk_SDK_SCIO:   {Cmd: "scalac", VersionArgs: []string{"-version"}},
+}
+
+// Prober returns the version of the toolchain or an error if the toolchain isn't available
+type Prober func(ctx context.Context, toolchain Toolchain) (string, error)
+
+// ExecProber runs the command of the toolchain with its version arguments
+// and returns the first line of the output as the version.
+func ExecProber(ctx context.Context, toolchain Toolchain) (string, error) {
+	if _, err := exec.LookPath(toolchain.Cmd); err != nil {
+		return "", fmt.Errorf("%s isn't found", toolchain.Cmd)
+	}
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	output, err := exec.CommandContext(ctx, toolchain.Cmd, toolchain.VersionArgs...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("%s doesn't work: %s", toolchain.Cmd, err.Error())
+	}
+	version := strings.TrimSpace(strings.SplitN(strings.TrimSpace(string(output)), "\n", 2)[0])
+	return version, nil
+}
+
+// Detect probes toolchains of all known SDKs and returns their availability ordered by SDKs.
+// The SDK is enabled only if it is the SDK which is processed by the server and its toolchain works.
+func Detect(ctx context.Context, serverSdk pb.Sdk, probe Prober) []*pb.SdkInfo {
+	sdks := make([]*pb.SdkInfo, 0, len(Toolchains))
+	for sdk, toolchain := range Toolchains {
+		info := &pb.SdkInfo{Sdk: sdk}
+		version, err := probe(ctx, toolchain)
+		switch {
+		case err != nil:
+			info.DisabledReason = err.Error()
+		case sdk != serverSdk:
+			info.Version = version
+			info.DisabledReason = fmt.Sprintf("the server processes only the code of %s", serverSdk)
+		default:
+			info.Version = version
+			info.Enabled = true
+		}
+		sdks = append(sdks, info)
+	}
+	sort.Slice(sdks, func(i, j int) bool {
+		return sdks[i].Sdk < sdks[j].Sdk
+	})
+	return sdks
+}
@@ -0,0 +1,128 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package toolchains
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"context"
+	"fmt"
+	"google.golang.org/protobuf/proto"
+	"strings"
+	"testing"
+)
+
+// fakeProber returns a prober which finds only toolchains from versions
+func fakeProber(versions map[string]string) Prober {
+	return func(ctx context.Context, toolchain Toolchain) (string, error) {
+		version, ok := versions[toolchain.Cmd]
+		if !ok {
+			return "", fmt.Errorf("%s isn't found", toolchain.Cmd)
+		}
+		return version, nil
+	}
+}
+
+func TestDetect(t *testing.T) {
+	type args struct {
+		serverSdk pb.Sdk
+		probe     Prober
+	}
+	tests := []struct {
+		name string
+		args args
+		want []*pb.SdkInfo
+	}{
+		{
+			// Test case with the server of Go SDK which has toolchains of Go and Python SDKs.
+			// As a result, want Go SDK to be enabled and other SDKs to be disabled with reasons.
+			name: "mix of available and missing toolchains",
+			args: args{
+				serverSdk: pb.Sdk_SDK_GO,
+				probe:     fakeProber(map[string]string{"go": "go version go1.16 linux/amd64", "python3": "Python 3.8.10"}),
+			},
+			want: []*pb.SdkInfo{
+				{Sdk: pb.Sdk_SDK_JAVA, DisabledReason: "javac isn't found"},
+				{Sdk: pb.Sdk_SDK_GO, Enabled: true, Version: "go version go1.16 linux/amd64"},
+				{Sdk: pb.Sdk_SDK_PYTHON, Version: "Python 3.8.10", DisabledReason: "the server processes only the code of SDK_GO"},
+				{Sdk: pb.Sdk_SDK_SCIO, DisabledReason: "scalac isn't found"},
+			},
+		},
+		{
+			// Test case with the server of Java SDK which doesn't have any toolchains.
+			// As a result, want all SDKs to be disabled with reasons.
+			name: "missing toolchain of the server SDK",
+			args: args{
+				serverSdk: pb.Sdk_SDK_JAVA,
+				probe:     fakeProber(map[string]string{}),
+			},
+			want: []*pb.SdkInfo{
+				{Sdk: pb.Sdk_SDK_JAVA, DisabledReason: "javac isn't found"},
+				{Sdk: pb.Sdk_SDK_GO, DisabledReason: "go isn't found"},
+				{Sdk: pb.Sdk_SDK_PYTHON, DisabledReason: "python3 isn't found"},
+				{Sdk: pb.Sdk_SDK_SCIO, DisabledReason: "scalac isn't found"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Detect(context.Background(), tt.args.serverSdk, tt.args.probe)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Detect() got %d SDKs, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if !proto.Equal(got[i], tt.want[i]) {
+					t.Errorf("Detect() got = %v, want %v", got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestExecProber(t *testing.T) {
+	tests := []struct {
+		name        string
+		toolchain   Toolchain
+		wantVersion string
+		wantErr     bool
+	}{
+		{
+			// Test case with the toolchain which is installed.
+			// As a result, want to receive the first line of its version output.
+			name:        "installed toolchain",
+			toolchain:   Toolchain{Cmd: "go", VersionArgs: []string{"version"}},
+			wantVersion: "go version",
+			wantErr:     false,
+		},
+		{
+			// Test case with the toolchain which isn't installed.
+			// As a result, want to receive an error.
+			name:      "missing toolchain",
+			toolchain: Toolchain{Cmd: "mock_compiler", VersionArgs: []string{"-version"}},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExecProber(context.Background(), tt.toolchain)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExecProber() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !strings.HasPrefix(got, tt.wantVersion) || strings.Contains(got, "\n") {
+				t.Errorf("ExecProber() got = %q, want the line starting with %q", got, tt.wantVersion)
+			}
+		})
+	}
+}
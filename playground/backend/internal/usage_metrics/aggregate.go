@@ -0,0 +1,62 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage_metrics
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"sort"
+)
+
+// Aggregate returns numbers of runs of counters per SDK and per SDK and example in one pass over counters.
+// Metrics are sorted by the number of runs starting from the largest one, metrics with the same number of runs
+// are sorted by the SDK and the example.
+func Aggregate(counters []Counter) *pb.GetUsageMetricsResponse {
+	sdks := make(map[pb.Sdk]int64)
+	examples := make(map[Key]int64)
+	var total int64
+	for _, counter := range counters {
+		sdks[counter.Sdk] += counter.Runs
+		examples[Key{Sdk: counter.Sdk, Example: counter.Example}] += counter.Runs
+		total += counter.Runs
+	}
+	response := &pb.GetUsageMetricsResponse{
+		TotalRuns: total,
+		Sdks:      make([]*pb.UsageMetric, 0, len(sdks)),
+		Examples:  make([]*pb.UsageMetric, 0, len(examples)),
+	}
+	for sdk, runs := range sdks {
+		response.Sdks = append(response.Sdks, &pb.UsageMetric{Sdk: sdk, Runs: runs})
+	}
+	for key, runs := range examples {
+		response.Examples = append(response.Examples, &pb.UsageMetric{Sdk: key.Sdk, Example: key.Example, Runs: runs})
+	}
+	sortMetrics(response.Sdks)
+	sortMetrics(response.Examples)
+	return response
+}
+
+// sortMetrics sorts metrics by the number of runs starting from the largest one, then by the SDK and the example
+func sortMetrics(metrics []*pb.UsageMetric) {
+	sort.Slice(metrics, func(i, j int) bool {
+		if metrics[i].Runs != metrics[j].Runs {
+			return metrics[i].Runs > metrics[j].Runs
+		}
+		if metrics[i].Sdk != metrics[j].Sdk {
+			return metrics[i].Sdk < metrics[j].Sdk
+		}
+		return metrics[i].Example < metrics[j].Example
+	})
+}
@@ -0,0 +1,71 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage_metrics
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestAggregate(t *testing.T) {
+	tests := []struct {
+		name     string
+		counters []Counter
+		want     *pb.GetUsageMetricsResponse
+	}{
+		{
+			// Test case with no counters.
+			// As a result, want to receive no runs.
+			name:     "no counters",
+			counters: nil,
+			want:     &pb.GetUsageMetricsResponse{},
+		},
+		{
+			// Test case with counters of several dates, examples and SDKs.
+			// As a result, want to receive runs summed over dates and sorted by the number of runs.
+			name: "several counters",
+			counters: []Counter{
+				{Key: Key{Sdk: pb.Sdk_SDK_JAVA, Example: "SDK_JAVA/EXAMPLE/WordCount", Date: "2022-01-30"}, Runs: 3},
+				{Key: Key{Sdk: pb.Sdk_SDK_JAVA, Example: "SDK_JAVA/EXAMPLE/WordCount", Date: "2022-01-31"}, Runs: 4},
+				{Key: Key{Sdk: pb.Sdk_SDK_JAVA, Example: "", Date: "2022-01-31"}, Runs: 2},
+				{Key: Key{Sdk: pb.Sdk_SDK_GO, Example: "SDK_GO/EXAMPLE/WordCount", Date: "2022-01-31"}, Runs: 2},
+				{Key: Key{Sdk: pb.Sdk_SDK_PYTHON, Example: "", Date: "2022-01-30"}, Runs: 10},
+			},
+			want: &pb.GetUsageMetricsResponse{
+				TotalRuns: 21,
+				Sdks: []*pb.UsageMetric{
+					{Sdk: pb.Sdk_SDK_PYTHON, Runs: 10},
+					{Sdk: pb.Sdk_SDK_JAVA, Runs: 9},
+					{Sdk: pb.Sdk_SDK_GO, Runs: 2},
+				},
+				Examples: []*pb.UsageMetric{
+					{Sdk: pb.Sdk_SDK_PYTHON, Runs: 10},
+					{Sdk: pb.Sdk_SDK_JAVA, Example: "SDK_JAVA/EXAMPLE/WordCount", Runs: 7},
+					{Sdk: pb.Sdk_SDK_JAVA, Runs: 2},
+					{Sdk: pb.Sdk_SDK_GO, Example: "SDK_GO/EXAMPLE/WordCount", Runs: 2},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Aggregate(tt.counters); !proto.Equal(got, tt.want) {
+				t.Errorf("Aggregate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
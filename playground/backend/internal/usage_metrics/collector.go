@@ -0,0 +1,174 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage_metrics
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/logger"
+	"context"
+	"sync"
+	"time"
+)
+
+// DateLayout is the layout of dates of counters
+const DateLayout = "2006-01-02"
+
+// Key identifies the counter of runs of the example of the SDK during the date
+type Key struct {
+	Sdk pb.Sdk
+	// Example is the cloud path of the example, empty for the code which isn't run from the example
+	Example string
+	// Date is the UTC date of runs in DateLayout format
+	Date string
+}
+
+// Counter is the number of runs by the key
+type Counter struct {
+	Key
+	Runs int64
+}
+
+// Store keeps counters of runs
+type Store interface {
+	// Increment adds counts to counters with the same keys, counters which don't exist are created
+	Increment(ctx context.Context, counts map[Key]int64) error
+
+	// Query returns counters which dates are in the range from the from date to the to date, both are included
+	Query(ctx context.Context, from, to string) ([]Counter, error)
+}
+
+// Collector counts runs in memory and adds them to counters of Store in batches,
+// so runs don't wait for writes to Store.
+// Counts are added to Store every flush interval and when there are maxBatchSize keys which aren't added yet.
+type Collector struct {
+	store        Store
+	maxBatchSize int
+
+	mu      sync.Mutex
+	pending map[Key]int64
+
+	// flushMu keeps flushes in order, so counts aren't added twice when the flush is failed
+	flushMu sync.Mutex
+
+	flushNow  chan struct{}
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewCollector returns Collector which adds counts to store every flushInterval and when maxBatchSize keys are counted
+// since the last flush. If maxBatchSize is 0, counts are added only by interval.
+// When ctx is done, remaining counts are added to store.
+func NewCollector(ctx context.Context, store Store, flushInterval time.Duration, maxBatchSize int) *Collector {
+	c := &Collector{
+		store:        store,
+		maxBatchSize: maxBatchSize,
+		pending:      make(map[Key]int64),
+		flushNow:     make(chan struct{}, 1),
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+	go c.run(ctx, flushInterval)
+	return c
+}
+
+// Record counts the run of the example of the SDK at the time.
+// Record does nothing for nil Collector, so runs aren't counted if usage metrics are disabled.
+func (c *Collector) Record(sdk pb.Sdk, example string, at time.Time) {
+	if c == nil {
+		return
+	}
+	key := Key{Sdk: sdk, Example: example, Date: at.UTC().Format(DateLayout)}
+	c.mu.Lock()
+	c.pending[key]++
+	reached := c.maxBatchSize > 0 && len(c.pending) >= c.maxBatchSize
+	c.mu.Unlock()
+
+	if reached {
+		select {
+		case c.flushNow <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Flush adds counted runs to Store.
+// If counts couldn't be added, they are kept in memory and added by the next flush.
+func (c *Collector) Flush(ctx context.Context) error {
+	c.flushMu.Lock()
+	defer c.flushMu.Unlock()
+
+	c.mu.Lock()
+	if len(c.pending) == 0 {
+		c.mu.Unlock()
+		return nil
+	}
+	counts := c.pending
+	c.pending = make(map[Key]int64)
+	c.mu.Unlock()
+
+	if err := c.store.Increment(ctx, counts); err != nil {
+		c.mu.Lock()
+		for key, count := range counts {
+			c.pending[key] += count
+		}
+		c.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Query returns counters of Store during the range of dates.
+// Runs which aren't added to Store yet aren't counted.
+func (c *Collector) Query(ctx context.Context, from, to string) ([]Counter, error) {
+	return c.store.Query(ctx, from, to)
+}
+
+// Close stops adding counts by interval and adds remaining counts to Store.
+func (c *Collector) Close(ctx context.Context) error {
+	c.closeOnce.Do(func() {
+		close(c.stop)
+	})
+	<-c.done
+	return c.Flush(ctx)
+}
+
+// run adds counts to Store every flushInterval and when the batch is full until ctx is done or Collector is closed
+func (c *Collector) run(ctx context.Context, flushInterval time.Duration) {
+	defer close(c.done)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// ctx is already done, so the final flush can't use it
+			if err := c.Flush(context.Background()); err != nil {
+				logger.Errorf("UsageMetrics: error during final flush: %s\n", err.Error())
+			}
+			return
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			if err := c.Flush(ctx); err != nil {
+				logger.Errorf("UsageMetrics: error during flush: %s\n", err.Error())
+			}
+		case <-c.flushNow:
+			if err := c.Flush(ctx); err != nil {
+				logger.Errorf("UsageMetrics: error during flush of the full batch: %s\n", err.Error())
+			}
+		}
+	}
+}
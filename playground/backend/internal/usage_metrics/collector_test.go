@@ -0,0 +1,260 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage_metrics
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeStore keeps counters in memory like Datastore and records batches which are added to counters
+type fakeStore struct {
+	mu       sync.Mutex
+	counters map[Key]int64
+	batches  []map[Key]int64
+	// err is returned by Increment if it isn't nil
+	err error
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{counters: make(map[Key]int64)}
+}
+
+func (s *fakeStore) Increment(ctx context.Context, counts map[Key]int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	batch := make(map[Key]int64, len(counts))
+	for key, count := range counts {
+		s.counters[key] += count
+		batch[key] = count
+	}
+	s.batches = append(s.batches, batch)
+	return nil
+}
+
+func (s *fakeStore) Query(ctx context.Context, from, to string) ([]Counter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	counters := make([]Counter, 0)
+	for key, runs := range s.counters {
+		if key.Date >= from && key.Date <= to {
+			counters = append(counters, Counter{Key: key, Runs: runs})
+		}
+	}
+	sort.Slice(counters, func(i, j int) bool {
+		return entityName(counters[i].Key) < entityName(counters[j].Key)
+	})
+	return counters, nil
+}
+
+func (s *fakeStore) setErr(err error) {
+	s.mu.Lock()
+	s.err = err
+	s.mu.Unlock()
+}
+
+func (s *fakeStore) getBatches() []map[Key]int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]map[Key]int64(nil), s.batches...)
+}
+
+// waitForBatches waits until want batches are added to the store or timeout is reached
+func waitForBatches(s *fakeStore, want int) []map[Key]int64 {
+	deadline := time.Now().Add(time.Second)
+	for len(s.getBatches()) < want && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	return s.getBatches()
+}
+
+var day = time.Date(2022, 1, 31, 23, 30, 0, 0, time.UTC)
+
+func TestCollector_Record(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := newFakeStore()
+	c := NewCollector(ctx, store, time.Hour, 0)
+
+	// Test case with several runs of the same example and runs of another example and another date.
+	// As a result, want to receive counters which are incremented by one batch after Flush.
+	c.Record(pb.Sdk_SDK_JAVA, "SDK_JAVA/EXAMPLE/WordCount", day)
+	c.Record(pb.Sdk_SDK_JAVA, "SDK_JAVA/EXAMPLE/WordCount", day)
+	c.Record(pb.Sdk_SDK_JAVA, "SDK_JAVA/EXAMPLE/WordCount", day.Add(time.Hour))
+	c.Record(pb.Sdk_SDK_GO, "", day.In(time.FixedZone("UTC+3", 3*60*60)))
+	if got := store.getBatches(); len(got) != 0 {
+		t.Fatalf("Record() added batches %v before Flush, want none", got)
+	}
+	if err := c.Flush(ctx); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	want := map[Key]int64{
+		{Sdk: pb.Sdk_SDK_JAVA, Example: "SDK_JAVA/EXAMPLE/WordCount", Date: "2022-01-31"}: 2,
+		{Sdk: pb.Sdk_SDK_JAVA, Example: "SDK_JAVA/EXAMPLE/WordCount", Date: "2022-02-01"}: 1,
+		{Sdk: pb.Sdk_SDK_GO, Example: "", Date: "2022-01-31"}:                             1,
+	}
+	if got := store.getBatches(); !reflect.DeepEqual(got, []map[Key]int64{want}) {
+		t.Errorf("Flush() added batches %v, want %v", got, []map[Key]int64{want})
+	}
+
+	// Test case with the run which is counted after the previous flush.
+	// As a result, want to receive only the new run in the next batch.
+	c.Record(pb.Sdk_SDK_GO, "", day)
+	if err := c.Close(ctx); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	batches := store.getBatches()
+	if len(batches) != 2 || !reflect.DeepEqual(batches[1], map[Key]int64{{Sdk: pb.Sdk_SDK_GO, Date: "2022-01-31"}: 1}) {
+		t.Errorf("Close() added batches %v, want the batch with the new run", batches)
+	}
+	if got := store.counters[Key{Sdk: pb.Sdk_SDK_GO, Date: "2022-01-31"}]; got != 2 {
+		t.Errorf("counter of runs = %d, want 2", got)
+	}
+}
+
+func TestCollector_RecordNil(t *testing.T) {
+	var c *Collector
+	// Test case with the collector which is disabled.
+	// As a result, want Record to do nothing.
+	c.Record(pb.Sdk_SDK_JAVA, "", day)
+}
+
+func TestCollector_IntervalFlush(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := newFakeStore()
+	c := NewCollector(ctx, store, 10*time.Millisecond, 0)
+	defer c.Close(ctx)
+
+	// Test case with runs which are counted before the flush interval.
+	// As a result, want to receive them in one batch without explicit Flush.
+	c.Record(pb.Sdk_SDK_PYTHON, "", day)
+	c.Record(pb.Sdk_SDK_PYTHON, "", day)
+	got := waitForBatches(store, 1)
+	want := []map[Key]int64{{{Sdk: pb.Sdk_SDK_PYTHON, Date: "2022-01-31"}: 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("interval flush added batches %v, want %v", got, want)
+	}
+	// wait a few more intervals to check that empty batches aren't added
+	time.Sleep(50 * time.Millisecond)
+	if got = store.getBatches(); len(got) != 1 {
+		t.Errorf("interval flush added batches %v, want only one batch", got)
+	}
+}
+
+func TestCollector_BatchSizeFlush(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := newFakeStore()
+	c := NewCollector(ctx, store, time.Hour, 2)
+	defer c.Close(ctx)
+
+	// Test case with runs of the same key which don't fill the batch.
+	// As a result, want to receive no batch.
+	c.Record(pb.Sdk_SDK_JAVA, "", day)
+	c.Record(pb.Sdk_SDK_JAVA, "", day)
+	time.Sleep(20 * time.Millisecond)
+	if got := store.getBatches(); len(got) != 0 {
+		t.Fatalf("Record() added batches %v, want none", got)
+	}
+
+	// Test case with the run of another key which fills the batch.
+	// As a result, want to receive the batch before the flush interval.
+	c.Record(pb.Sdk_SDK_GO, "", day)
+	got := waitForBatches(store, 1)
+	want := []map[Key]int64{{{Sdk: pb.Sdk_SDK_JAVA, Date: "2022-01-31"}: 2, {Sdk: pb.Sdk_SDK_GO, Date: "2022-01-31"}: 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("batch flush added batches %v, want %v", got, want)
+	}
+}
+
+func TestCollector_FlushError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := newFakeStore()
+	c := NewCollector(ctx, store, time.Hour, 0)
+	defer c.Close(ctx)
+
+	// Test case with the store which fails to add counts.
+	// As a result, want to receive the error and counts to be added by the next flush.
+	store.setErr(errors.New("MOCK_ERROR"))
+	c.Record(pb.Sdk_SDK_JAVA, "", day)
+	if err := c.Flush(ctx); err == nil {
+		t.Fatalf("Flush() error = nil, want an error")
+	}
+	store.setErr(nil)
+	c.Record(pb.Sdk_SDK_JAVA, "", day)
+	if err := c.Flush(ctx); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	want := []map[Key]int64{{{Sdk: pb.Sdk_SDK_JAVA, Date: "2022-01-31"}: 2}}
+	if got := store.getBatches(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Flush() added batches %v, want %v", got, want)
+	}
+}
+
+func TestCollector_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	store := newFakeStore()
+	c := NewCollector(ctx, store, time.Hour, 0)
+
+	// Test case with the context which is done before the flush interval.
+	// As a result, want to receive remaining counts in the final batch.
+	c.Record(pb.Sdk_SDK_SCIO, "", day)
+	cancel()
+	got := waitForBatches(store, 1)
+	want := []map[Key]int64{{{Sdk: pb.Sdk_SDK_SCIO, Date: "2022-01-31"}: 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("final flush added batches %v, want %v", got, want)
+	}
+}
+
+func TestCollector_Query(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := newFakeStore()
+	c := NewCollector(ctx, store, time.Hour, 0)
+	defer c.Close(ctx)
+	c.Record(pb.Sdk_SDK_JAVA, "", day.AddDate(0, 0, -1))
+	c.Record(pb.Sdk_SDK_JAVA, "", day)
+	c.Record(pb.Sdk_SDK_JAVA, "", day.AddDate(0, 0, 1))
+	if err := c.Flush(ctx); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	// Test case with the range of dates which contains two of three dates.
+	// As a result, want to receive counters of these dates.
+	got, err := c.Query(ctx, "2022-01-30", "2022-01-31")
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	want := []Counter{
+		{Key: Key{Sdk: pb.Sdk_SDK_JAVA, Date: "2022-01-30"}, Runs: 1},
+		{Key: Key{Sdk: pb.Sdk_SDK_JAVA, Date: "2022-01-31"}, Runs: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Query() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,136 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage_metrics
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"cloud.google.com/go/datastore"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+const (
+	// counterKind is the kind of entities of counters in Datastore
+	counterKind = "PlaygroundUsageCounter"
+	// maxEntitiesPerTransaction is the max number of entities which are changed by one transaction of Datastore
+	maxEntitiesPerTransaction = 500
+)
+
+// counterEntity is the entity of the counter in Datastore
+type counterEntity struct {
+	Sdk     string `datastore:"sdk"`
+	Example string `datastore:"example"`
+	Date    string `datastore:"date"`
+	Runs    int64  `datastore:"runs,noindex"`
+}
+
+// DatastoreStore keeps counters as entities of Datastore, one entity per SDK, example and date.
+// Dates are indexed, so counters of the range of dates are read by one query.
+type DatastoreStore struct {
+	client *datastore.Client
+}
+
+// NewDatastoreStore returns DatastoreStore which keeps counters in Datastore of the Google Cloud project
+func NewDatastoreStore(ctx context.Context, projectId string) (*DatastoreStore, error) {
+	client, err := datastore.NewClient(ctx, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("datastore.NewClient: %w", err)
+	}
+	return &DatastoreStore{client: client}, nil
+}
+
+// Increment adds counts to entities of counters in transactions of up to maxEntitiesPerTransaction entities.
+// If one of transactions is failed, counts of the previous transactions are already added.
+func (s *DatastoreStore) Increment(ctx context.Context, counts map[Key]int64) error {
+	keys := make([]Key, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	// keys are sorted, so the same counters are changed by the same transactions
+	sort.Slice(keys, func(i, j int) bool {
+		return entityName(keys[i]) < entityName(keys[j])
+	})
+	for start := 0; start < len(keys); start += maxEntitiesPerTransaction {
+		end := start + maxEntitiesPerTransaction
+		if end > len(keys) {
+			end = len(keys)
+		}
+		if err := s.increment(ctx, keys[start:end], counts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// increment adds counts of keys to entities of counters in one transaction
+func (s *DatastoreStore) increment(ctx context.Context, keys []Key, counts map[Key]int64) error {
+	entityKeys := make([]*datastore.Key, len(keys))
+	for i, key := range keys {
+		entityKeys[i] = datastore.NameKey(counterKind, entityName(key), nil)
+	}
+	_, err := s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+		entities := make([]counterEntity, len(keys))
+		if err := tx.GetMulti(entityKeys, entities); err != nil {
+			var multiErr datastore.MultiError
+			if !errors.As(err, &multiErr) {
+				return err
+			}
+			for _, entityErr := range multiErr {
+				if entityErr != nil && !errors.Is(entityErr, datastore.ErrNoSuchEntity) {
+					return entityErr
+				}
+			}
+		}
+		for i, key := range keys {
+			entities[i] = counterEntity{Sdk: key.Sdk.String(), Example: key.Example, Date: key.Date, Runs: entities[i].Runs + counts[key]}
+		}
+		_, err := tx.PutMulti(entityKeys, entities)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error during incrementing counters in datastore: %w", err)
+	}
+	return nil
+}
+
+// Query returns counters which dates are in the range by one query of Datastore
+func (s *DatastoreStore) Query(ctx context.Context, from, to string) ([]Counter, error) {
+	query := datastore.NewQuery(counterKind).Filter("date >=", from).Filter("date <=", to)
+	var entities []counterEntity
+	if _, err := s.client.GetAll(ctx, query, &entities); err != nil {
+		return nil, fmt.Errorf("error during querying counters in datastore: %w", err)
+	}
+	counters := make([]Counter, 0, len(entities))
+	for _, entity := range entities {
+		counters = append(counters, Counter{
+			Key:  Key{Sdk: pb.Sdk(pb.Sdk_value[entity.Sdk]), Example: entity.Example, Date: entity.Date},
+			Runs: entity.Runs,
+		})
+	}
+	return counters, nil
+}
+
+// Close closes the client of Datastore
+func (s *DatastoreStore) Close() error {
+	return s.client.Close()
+}
+
+// entityName returns the name of the entity of the counter by its key (e.g. "2022-01-31/SDK_JAVA/path/of/example")
+func entityName(key Key) string {
+	return fmt.Sprintf("%s/%s/%s", key.Date, key.Sdk.String(), key.Example)
+}
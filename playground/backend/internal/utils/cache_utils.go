@@ -32,3 +32,13 @@ func SetToCache(ctx context.Context, cacheService cache.Cache, key uuid.UUID, su
 	}
 	return err
 }
+
+// SetValuesToCache puts all values to cache by key in one operation.
+// If error occurs during the function - logs and returns error.
+func SetValuesToCache(ctx context.Context, cacheService cache.Cache, key uuid.UUID, values map[cache.SubKey]interface{}) error {
+	err := cacheService.SetValues(ctx, key, values)
+	if err != nil {
+		logger.Errorf("%s: cache.SetValues: %s\n", key, err.Error())
+	}
+	return err
+}
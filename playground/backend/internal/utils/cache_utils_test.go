@@ -78,3 +78,51 @@ func TestSetToCache(t *testing.T) {
 		})
 	}
 }
+
+func TestSetValuesToCache(t *testing.T) {
+	localCache := local.New(context.Background())
+	key := uuid.New()
+	values := map[cache.SubKey]interface{}{
+		cache.Status:        pb.Status_STATUS_EXECUTING,
+		cache.CompileOutput: "MOCK_COMPILE_OUTPUT",
+		cache.Logs:          "",
+	}
+
+	type args struct {
+		ctx          context.Context
+		cacheService cache.Cache
+		key          uuid.UUID
+		values       map[cache.SubKey]interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			// Test case with calling SetValuesToCache method with correct cacheService.
+			// As a result, want to receive all expected values from cache.
+			name: "set values without error",
+			args: args{
+				ctx:          context.Background(),
+				cacheService: localCache,
+				key:          key,
+				values:       values,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetValuesToCache(tt.args.ctx, tt.args.cacheService, tt.args.key, tt.args.values); (err != nil) != tt.wantErr {
+				t.Errorf("SetValuesToCache() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for subKey, value := range tt.args.values {
+				getValue, err := localCache.GetValue(context.Background(), key, subKey)
+				if err != nil || getValue != value {
+					t.Errorf("SetValuesToCache() doesn't set value for subKey %s to cache", subKey)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"fmt"
+	"strings"
+)
+
+// pipelineOption is the option of the pipeline with its value as it is written in pipeline options
+// (e.g. "--output=out.txt", "--output out.txt" or "--streaming")
+type pipelineOption struct {
+	name   string
+	tokens []string
+}
+
+// MergePipelineOptions returns default pipeline options (e.g. of the example or the snippet) merged with pipeline
+// options of the request.
+// Options of the request override default options with the same name, other default options are kept before
+// options of the request. Options are written like "--name=value", "--name value" or "--flag".
+// Returns an error if defaults or options contain a value which doesn't follow the name of the option.
+func MergePipelineOptions(defaults, options string) (string, error) {
+	defaultOptions, err := parsePipelineOptions(defaults)
+	if err != nil {
+		return "", fmt.Errorf("incorrect default pipeline options: %w", err)
+	}
+	requestOptions, err := parsePipelineOptions(options)
+	if err != nil {
+		return "", fmt.Errorf("incorrect pipeline options: %w", err)
+	}
+	overridden := make(map[string]bool, len(requestOptions))
+	for _, option := range requestOptions {
+		overridden[option.name] = true
+	}
+	merged := make([]string, 0)
+	for _, option := range defaultOptions {
+		if !overridden[option.name] {
+			merged = append(merged, option.tokens...)
+		}
+	}
+	for _, option := range requestOptions {
+		merged = append(merged, option.tokens...)
+	}
+	return strings.Join(merged, " "), nil
+}
+
+// SplitPipelineOptions returns arguments which are passed to the code of the SDK from pipeline options.
+// Values of options of the Java and Scio SDKs are joined with names of options by "=" (see ReplaceSpacesWithEquals).
+func SplitPipelineOptions(sdk pb.Sdk, pipelineOptions string) []string {
+	if sdk == pb.Sdk_SDK_JAVA || sdk == pb.Sdk_SDK_SCIO {
+		pipelineOptions = ReplaceSpacesWithEquals(pipelineOptions)
+	}
+	return strings.Split(pipelineOptions, " ")
+}
+
+// parsePipelineOptions splits pipeline options to options with their values.
+// The token which doesn't start with "-" is the value of the previous option if the option doesn't have a value yet.
+func parsePipelineOptions(options string) ([]pipelineOption, error) {
+	result := make([]pipelineOption, 0)
+	// hasValue is true if the last option already has a value, so the next token should be an option
+	hasValue := true
+	for _, token := range strings.Fields(options) {
+		if !strings.HasPrefix(token, "-") {
+			if hasValue {
+				return nil, fmt.Errorf("value %q doesn't follow the name of an option", token)
+			}
+			last := &result[len(result)-1]
+			last.tokens = append(last.tokens, token)
+			hasValue = true
+			continue
+		}
+		name := strings.TrimLeft(token, "-")
+		hasValue = strings.Contains(name, "=")
+		if hasValue {
+			name = name[:strings.Index(name, "=")]
+		}
+		if name == "" {
+			return nil, fmt.Errorf("option %q doesn't have a name", token)
+		}
+		result = append(result, pipelineOption{name: name, tokens: []string{token}})
+	}
+	return result, nil
+}
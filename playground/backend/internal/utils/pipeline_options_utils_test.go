@@ -0,0 +1,96 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import "testing"
+
+func TestMergePipelineOptions(t *testing.T) {
+	type args struct {
+		defaults string
+		options  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			// Test case with default options of the example and options of the request with different names.
+			// As a result, want to receive default options followed by options of the request.
+			name:    "different options",
+			args:    args{defaults: "--inputFile=gs://bucket/input.txt", options: "--output out.txt"},
+			want:    "--inputFile=gs://bucket/input.txt --output out.txt",
+			wantErr: false,
+		},
+		{
+			// Test case with options of the request which set options of the example in other forms.
+			// As a result, want values of the request to override default values.
+			name:    "request values override defaults",
+			args:    args{defaults: "--output=default.txt --streaming --numWorkers 2", options: "--output request.txt --numWorkers=4"},
+			want:    "--streaming --output request.txt --numWorkers=4",
+			wantErr: false,
+		},
+		{
+			// Test case with the example without default options.
+			// As a result, want to receive options of the request.
+			name:    "no defaults",
+			args:    args{defaults: "", options: "--output   out.txt"},
+			want:    "--output out.txt",
+			wantErr: false,
+		},
+		{
+			// Test case with the request without options.
+			// As a result, want to receive default options of the example.
+			name:    "no request options",
+			args:    args{defaults: "--input_text=hello", options: ""},
+			want:    "--input_text=hello",
+			wantErr: false,
+		},
+		{
+			// Test case with default options which contain a value without the name of the option.
+			// As a result, want to receive an error.
+			name:    "incorrect defaults",
+			args:    args{defaults: "out.txt --output", options: "--runner=DirectRunner"},
+			wantErr: true,
+		},
+		{
+			// Test case with options of the request which contain two values of one option.
+			// As a result, want to receive an error.
+			name:    "incorrect request options",
+			args:    args{defaults: "--output=default.txt", options: "--output out.txt extra.txt"},
+			wantErr: true,
+		},
+		{
+			// Test case with options of the request which contain the option without a name.
+			// As a result, want to receive an error.
+			name:    "option without name",
+			args:    args{defaults: "", options: "--=value"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MergePipelineOptions(tt.args.defaults, tt.args.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MergePipelineOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("MergePipelineOptions() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
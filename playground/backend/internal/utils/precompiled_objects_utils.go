@@ -18,8 +18,14 @@ package utils
 import (
 	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/cloud_bucket"
+	"fmt"
+	"sort"
+	"strings"
 )
 
+// MaxExamplesPageSize is the max number of examples in the page of the found examples
+const MaxExamplesPageSize = 100
+
 // PutPrecompiledObjectsToCategory adds categories with precompiled objects to protobuf object
 func PutPrecompiledObjectsToCategory(categoryName string, precompiledObjects *cloud_bucket.PrecompiledObjects, sdkCategory *pb.Categories) {
 	category := pb.Categories_Category{
@@ -38,3 +44,62 @@ func PutPrecompiledObjectsToCategory(categoryName string, precompiledObjects *cl
 	}
 	sdkCategory.Categories = append(sdkCategory.Categories, &category)
 }
+
+// SearchPrecompiledObjects returns precompiled objects of sdkToCategories which belong to one of sdks and which names or
+// descriptions contain the term case-insensitively. If sdks is empty, objects of all sdks are returned.
+// An object of several categories is returned once. Objects are ordered by cloud paths, so pages of the result are stable.
+func SearchPrecompiledObjects(sdkToCategories *cloud_bucket.SdkToCategories, sdks []pb.Sdk, term string) []*pb.PrecompiledObject {
+	targetSdks := make(map[string]bool, len(sdks))
+	for _, sdk := range sdks {
+		targetSdks[sdk.String()] = true
+	}
+	term = strings.ToLower(strings.TrimSpace(term))
+	found := make(map[string]*pb.PrecompiledObject, 0)
+	for sdkName, categories := range *sdkToCategories {
+		if len(targetSdks) > 0 && !targetSdks[sdkName] {
+			continue
+		}
+		for _, precompiledObjects := range categories {
+			for _, object := range precompiledObjects {
+				if !strings.Contains(strings.ToLower(object.Name), term) && !strings.Contains(strings.ToLower(object.Description), term) {
+					continue
+				}
+				found[object.CloudPath] = &pb.PrecompiledObject{
+					CloudPath:       object.CloudPath,
+					Name:            object.Name,
+					Description:     object.Description,
+					Type:            object.Type,
+					PipelineOptions: object.PipelineOptions,
+					Link:            object.Link,
+				}
+			}
+		}
+	}
+	result := make([]*pb.PrecompiledObject, 0, len(found))
+	for _, object := range found {
+		result = append(result, object)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CloudPath < result[j].CloudPath
+	})
+	return result
+}
+
+// GetPrecompiledObjectsPage returns the page of precompiled objects which starts at offset and contains at most limit objects.
+// If limit is 0 or exceeds MaxExamplesPageSize, MaxExamplesPageSize is used.
+// Returns the page and the offset of the next page.
+// In case offset is out of precompiled objects returns an error.
+func GetPrecompiledObjectsPage(precompiledObjects []*pb.PrecompiledObject, offset, limit int32) ([]*pb.PrecompiledObject, int32, error) {
+	totalCount := int32(len(precompiledObjects))
+	if offset < 0 || offset > totalCount {
+		return nil, 0, fmt.Errorf("offset %d is out of %d found examples", offset, totalCount)
+	}
+	if limit <= 0 || limit > MaxExamplesPageSize {
+		limit = MaxExamplesPageSize
+	}
+	end := offset + limit
+	if end > totalCount {
+		end = totalCount
+	}
+	return precompiledObjects[offset:end], end, nil
+}
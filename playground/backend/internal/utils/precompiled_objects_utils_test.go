@@ -18,6 +18,7 @@ package utils
 import (
 	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/cloud_bucket"
+	"fmt"
 	"reflect"
 	"testing"
 )
@@ -73,3 +74,141 @@ func TestPutPrecompiledObjectsToCategory(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchPrecompiledObjects(t *testing.T) {
+	sdkToCategories := &cloud_bucket.SdkToCategories{
+		"SDK_JAVA": cloud_bucket.CategoryToPrecompiledObjects{
+			"Common": cloud_bucket.PrecompiledObjects{
+				{Name: "WordCount", CloudPath: "SDK_JAVA/Common/WordCount", Description: "Counts words"},
+				{Name: "MinimalWordCount", CloudPath: "SDK_JAVA/Common/MinimalWordCount", Description: "Minimal example"},
+			},
+			"IO": cloud_bucket.PrecompiledObjects{
+				{Name: "WordCount", CloudPath: "SDK_JAVA/Common/WordCount", Description: "Counts words"},
+			},
+		},
+		"SDK_GO": cloud_bucket.CategoryToPrecompiledObjects{
+			"Common": cloud_bucket.PrecompiledObjects{
+				{Name: "Filter", CloudPath: "SDK_GO/Common/Filter", Description: "Filters WORDS of lines"},
+			},
+		},
+	}
+	tests := []struct {
+		name string
+		sdks []pb.Sdk
+		term string
+		// want is cloud paths of the found objects
+		want []string
+	}{
+		{
+			// Test case with searching the term in names and descriptions of objects of all sdks.
+			// As a result, want to receive objects which names or descriptions contain the term case-insensitively ordered by cloud paths.
+			name: "term in names and descriptions",
+			sdks: nil,
+			term: " Words ",
+			want: []string{"SDK_GO/Common/Filter", "SDK_JAVA/Common/WordCount"},
+		},
+		{
+			// Test case with searching objects of the sdk without the term.
+			// As a result, want to receive all objects of the sdk and the object of several categories once.
+			name: "objects of the sdk",
+			sdks: []pb.Sdk{pb.Sdk_SDK_JAVA},
+			term: "",
+			want: []string{"SDK_JAVA/Common/MinimalWordCount", "SDK_JAVA/Common/WordCount"},
+		},
+		{
+			// Test case with searching the term in objects of several sdks.
+			// As a result, want to receive objects of these sdks only.
+			name: "objects of several sdks",
+			sdks: []pb.Sdk{pb.Sdk_SDK_GO, pb.Sdk_SDK_PYTHON},
+			term: "words",
+			want: []string{"SDK_GO/Common/Filter"},
+		},
+		{
+			// Test case with searching the term which isn't found.
+			// As a result, want to receive no objects.
+			name: "term isn't found",
+			sdks: nil,
+			term: "kafka",
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]string, 0)
+			for _, object := range SearchPrecompiledObjects(sdkToCategories, tt.sdks, tt.term) {
+				got = append(got, object.CloudPath)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SearchPrecompiledObjects() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetPrecompiledObjectsPage(t *testing.T) {
+	precompiledObjects := make([]*pb.PrecompiledObject, MaxExamplesPageSize+10)
+	for i := range precompiledObjects {
+		precompiledObjects[i] = &pb.PrecompiledObject{Name: fmt.Sprintf("Example%d", i)}
+	}
+	tests := []struct {
+		name           string
+		offset         int32
+		limit          int32
+		wantSize       int
+		wantNextOffset int32
+		wantErr        bool
+	}{
+		{
+			// Test case with getting the first page of objects.
+			// As a result, want to receive limit objects and the offset of the next page.
+			name:           "first page",
+			offset:         0,
+			limit:          5,
+			wantSize:       5,
+			wantNextOffset: 5,
+			wantErr:        false,
+		},
+		{
+			// Test case with getting the last page which contains less objects than the limit.
+			// As a result, want to receive the rest objects and the number of objects as the offset of the next page.
+			name:           "last page",
+			offset:         MaxExamplesPageSize + 5,
+			limit:          10,
+			wantSize:       5,
+			wantNextOffset: MaxExamplesPageSize + 10,
+			wantErr:        false,
+		},
+		{
+			// Test case with getting the page without the limit.
+			// As a result, want to receive MaxExamplesPageSize objects.
+			name:           "page without limit",
+			offset:         0,
+			limit:          0,
+			wantSize:       MaxExamplesPageSize,
+			wantNextOffset: MaxExamplesPageSize,
+			wantErr:        false,
+		},
+		{
+			// Test case with getting the page which starts out of objects.
+			// As a result, want to receive an error.
+			name:    "offset is out of objects",
+			offset:  MaxExamplesPageSize + 11,
+			limit:   10,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, nextOffset, err := GetPrecompiledObjectsPage(precompiledObjects, tt.offset, tt.limit)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetPrecompiledObjectsPage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(page) != tt.wantSize {
+				t.Errorf("GetPrecompiledObjectsPage() page size = %d, want %d", len(page), tt.wantSize)
+			}
+			if nextOffset != tt.wantNextOffset {
+				t.Errorf("GetPrecompiledObjectsPage() nextOffset = %d, want %d", nextOffset, tt.wantNextOffset)
+			}
+		})
+	}
+}
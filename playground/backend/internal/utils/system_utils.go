@@ -16,6 +16,7 @@
 package utils
 
 import (
+	"beam.apache.org/playground/backend/internal/cache"
 	"beam.apache.org/playground/backend/internal/environment"
 	"beam.apache.org/playground/backend/internal/logger"
 	"net/http"
@@ -55,6 +56,17 @@ func GetReadinessFunction(envs *environment.Environment) func(writer http.Respon
 	}
 }
 
+// GetHealthFunction returns the function that checks the connection of the server to the cache
+func GetHealthFunction(cacheService cache.Cache) func(writer http.ResponseWriter, request *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if err := cacheService.HealthCheck(request.Context()); err != nil {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		} else {
+			writer.WriteHeader(http.StatusOK)
+		}
+	}
+}
+
 // checkNumOfTheParallelJobs checks the number of currently working code executions.
 //  It counts by the number of the /path/to/workingDir/executableFiles/{pipelineId} folders.
 // If it is equals or more than numOfParallelJobs, then returns false.
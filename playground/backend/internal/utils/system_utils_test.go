@@ -16,7 +16,12 @@
 package utils
 
 import (
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/local"
+	"context"
 	"io/fs"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -46,6 +51,34 @@ func TestGetFuncName(t *testing.T) {
 	}
 }
 
+func TestGetHealthFunction(t *testing.T) {
+	type args struct {
+		cacheService cache.Cache
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantStatus int
+	}{
+		{
+			// Test case with calling health function when the cache is available.
+			// As a result, want to receive 200 status code
+			name:       "cache is available",
+			args:       args{cacheService: local.New(context.Background())},
+			wantStatus: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			GetHealthFunction(tt.args.cacheService)(recorder, httptest.NewRequest(http.MethodGet, "/health", nil))
+			if recorder.Code != tt.wantStatus {
+				t.Errorf("GetHealthFunction() status = %v, want %v", recorder.Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
 func Test_checkNumOfTheParallelJobs(t *testing.T) {
 	type args struct {
 		workingDir        string
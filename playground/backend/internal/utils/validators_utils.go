@@ -21,16 +21,19 @@ import (
 	"fmt"
 )
 
-// GetValidators returns slice of validators.Validator according to sdk
-func GetValidators(sdk pb.Sdk, filepath string) (*[]validators.Validator, error) {
+// GetValidators returns slice of validators.Validator according to sdk.
+// disallowedApis are packages, classes and functions which the code mustn't use.
+func GetValidators(sdk pb.Sdk, filepath string, disallowedApis []string) (*[]validators.Validator, error) {
 	var val *[]validators.Validator
 	switch sdk {
 	case pb.Sdk_SDK_JAVA:
-		val = validators.GetJavaValidators(filepath)
+		val = validators.GetJavaValidators(filepath, disallowedApis)
 	case pb.Sdk_SDK_GO:
-		val = validators.GetGoValidators(filepath)
+		val = validators.GetGoValidators(filepath, disallowedApis)
 	case pb.Sdk_SDK_PYTHON:
-		val = validators.GetPyValidators(filepath)
+		val = validators.GetPyValidators(filepath, disallowedApis)
+	case pb.Sdk_SDK_SCIO:
+		val = validators.GetScioValidators(filepath)
 	default:
 		return nil, fmt.Errorf("incorrect sdk: %s", sdk)
 	}
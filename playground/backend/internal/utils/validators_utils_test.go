@@ -52,13 +52,13 @@ func TestGetValidators(t *testing.T) {
 				sdk:      playground.Sdk_SDK_JAVA,
 				filepath: "",
 			},
-			want:    validators.GetJavaValidators(""),
+			want:    validators.GetJavaValidators("", nil),
 			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := GetValidators(tt.args.sdk, tt.args.filepath)
+			got, err := GetValidators(tt.args.sdk, tt.args.filepath, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetValidators() err = %v, wantErr %v", err, tt.wantErr)
 			}
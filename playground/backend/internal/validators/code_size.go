@@ -0,0 +1,43 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validators
+
+import (
+	"beam.apache.org/playground/backend/internal/fs_tool"
+	"fmt"
+)
+
+// CheckCodeSize checks that the code of files fits the size limits before any file of the code is created,
+// so too large code doesn't take memory and disk of the code processing.
+// maxCodeSize is the max total size in bytes of all files and maxFileSize is the max size in bytes of each file.
+// Zero value of a limit means that the size isn't limited.
+func CheckCodeSize(files []fs_tool.SourceFile, maxCodeSize, maxFileSize int) error {
+	total := 0
+	for _, file := range files {
+		size := len(file.Code)
+		if maxFileSize > 0 && size > maxFileSize {
+			if len(files) == 1 {
+				return fmt.Errorf("code is %d bytes, but should be at most %d bytes", size, maxFileSize)
+			}
+			return fmt.Errorf("file %s is %d bytes, but should be at most %d bytes", file.Name, size, maxFileSize)
+		}
+		total += size
+	}
+	if maxCodeSize > 0 && total > maxCodeSize {
+		return fmt.Errorf("code is %d bytes, but should be at most %d bytes", total, maxCodeSize)
+	}
+	return nil
+}
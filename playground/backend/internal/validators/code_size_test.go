@@ -0,0 +1,104 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validators
+
+import (
+	"beam.apache.org/playground/backend/internal/fs_tool"
+	"strings"
+	"testing"
+)
+
+func TestCheckCodeSize(t *testing.T) {
+	type args struct {
+		files       []fs_tool.SourceFile
+		maxCodeSize int
+		maxFileSize int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			// Test case with the code which size is equal to the limits.
+			// As a result, want to receive no error.
+			name: "code size is equal to limits",
+			args: args{
+				files:       []fs_tool.SourceFile{{Code: strings.Repeat("a", 10), IsMain: true}},
+				maxCodeSize: 10,
+				maxFileSize: 10,
+			},
+			wantErr: false,
+		},
+		{
+			// Test case with the code which is larger than the max code size.
+			// As a result, want to receive an error.
+			name: "code is too large",
+			args: args{
+				files:       []fs_tool.SourceFile{{Code: strings.Repeat("a", 11), IsMain: true}},
+				maxCodeSize: 10,
+				maxFileSize: 0,
+			},
+			wantErr: true,
+		},
+		{
+			// Test case with files which fit the max file size, but together are larger than the max code size.
+			// As a result, want to receive an error.
+			name: "files are too large together",
+			args: args{
+				files: []fs_tool.SourceFile{
+					{Name: "main.py", Code: strings.Repeat("a", 6), IsMain: true},
+					{Name: "lib.py", Code: strings.Repeat("a", 6)},
+				},
+				maxCodeSize: 10,
+				maxFileSize: 6,
+			},
+			wantErr: true,
+		},
+		{
+			// Test case with one of files which is larger than the max file size.
+			// As a result, want to receive an error.
+			name: "file is too large",
+			args: args{
+				files: []fs_tool.SourceFile{
+					{Name: "main.py", Code: strings.Repeat("a", 2), IsMain: true},
+					{Name: "lib.py", Code: strings.Repeat("a", 7)},
+				},
+				maxCodeSize: 10,
+				maxFileSize: 6,
+			},
+			wantErr: true,
+		},
+		{
+			// Test case with the large code without limits.
+			// As a result, want to receive no error.
+			name: "without limits",
+			args: args{
+				files:       []fs_tool.SourceFile{{Code: strings.Repeat("a", 1024*1024), IsMain: true}},
+				maxCodeSize: 0,
+				maxFileSize: 0,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckCodeSize(tt.args.files, tt.args.maxCodeSize, tt.args.maxFileSize); (err != nil) != tt.wantErr {
+				t.Errorf("CheckCodeSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validators
+
+import (
+	"beam.apache.org/playground/backend/internal/logger"
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// apiSeparators are separators of the API name parts (e.g. "net/http" or "os.ReadFile")
+const apiSeparators = "./"
+
+// apiUsage is the full name of the package, class or function which is referenced by the code
+type apiUsage struct {
+	name string
+	line int
+}
+
+// apiUsagesFunc returns all APIs which are referenced by the code
+type apiUsagesFunc func(code []byte) []apiUsage
+
+// codeToken is the token of the code without comments and string literals which is used to find API usages.
+// Literals and numbers are kept as not name tokens, so they separate names.
+type codeToken struct {
+	text   string
+	line   int
+	isName bool
+}
+
+// getDottedName returns the dotted name (e.g. "os.path.join") which starts from the name token
+// with the index and the index of the token after the name
+func getDottedName(tokens []codeToken, index int) (string, int) {
+	name := tokens[index].text
+	next := index + 1
+	for next+1 < len(tokens) && tokens[next].text == "." && tokens[next+1].isName {
+		name += "." + tokens[next+1].text
+		next += 2
+	}
+	return name, next
+}
+
+// isAttribute returns true if the token with the index is an attribute of the previous expression (e.g. "join" in "x.join")
+func isAttribute(tokens []codeToken, index int) bool {
+	return index > 0 && tokens[index-1].text == "."
+}
+
+// getTokenText returns the text of the token with the index or empty string if there is no such token
+func getTokenText(tokens []codeToken, index int) string {
+	if index < len(tokens) {
+		return tokens[index].text
+	}
+	return ""
+}
+
+// checkDisallowedApis checks that files with the code don't use disallowed APIs.
+// The main file and all files with the same extension from its folder and subfolders are checked.
+// If the code uses the disallowed API, return the error which describes the disallowed API and where it is used.
+func checkDisallowedApis(filePath string, disallowedApis []string, getApiUsages apiUsagesFunc) (bool, error) {
+	if len(disallowedApis) == 0 {
+		return true, nil
+	}
+	files, err := getSourceFiles(filePath)
+	if err != nil {
+		logger.Errorf("Validation: Error during get source files of: %s, err: %s\n", filePath, err.Error())
+		return false, err
+	}
+	for _, file := range files {
+		code, err := ioutil.ReadFile(file)
+		if err != nil {
+			logger.Errorf("Validation: Error during open file: %s, err: %s\n", file, err.Error())
+			return false, err
+		}
+		for _, usage := range getApiUsages(code) {
+			if api, ok := findDisallowedApi(usage.name, disallowedApis); ok {
+				return false, newDisallowedApiError(api, usage, getLocation(filePath, file, usage.line))
+			}
+		}
+	}
+	return true, nil
+}
+
+// findDisallowedApi returns the disallowed API which contains the used API.
+// The API is contained by the disallowed API if the names are equal or the disallowed API is a parent
+// of the used API (e.g. "os" for "os/exec" or "os.ReadFile", "java.net" for "java.net.URL").
+// The used API which ends with ".*" (e.g. "os.*" for "from os import *") uses all members of the parent,
+// so it is contained by all disallowed members of the parent too.
+func findDisallowedApi(name string, disallowedApis []string) (string, bool) {
+	for _, api := range disallowedApis {
+		if name == api || (strings.HasPrefix(name, api) && strings.ContainsRune(apiSeparators, rune(name[len(api)]))) {
+			return api, true
+		}
+		if strings.HasSuffix(name, ".*") && strings.HasPrefix(api, strings.TrimSuffix(name, "*")) {
+			return api, true
+		}
+	}
+	return "", false
+}
+
+// getSourceFiles returns the main file and all files with the same extension from its folder and subfolders
+func getSourceFiles(filePath string) ([]string, error) {
+	files := []string{filePath}
+	extension := filepath.Ext(filePath)
+	err := filepath.WalkDir(filepath.Dir(filePath), func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() && filepath.Ext(path) == extension && path != filePath {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
+
+// getLocation returns the description of the line of the file which is used in the error message.
+// The file name is omitted for the main file, because it is generated by the playground.
+func getLocation(mainFilePath, filePath string, line int) string {
+	if filePath == mainFilePath {
+		return fmt.Sprintf("line %d", line)
+	}
+	name, err := filepath.Rel(filepath.Dir(mainFilePath), filePath)
+	if err != nil {
+		name = filepath.Base(filePath)
+	}
+	return fmt.Sprintf("%s, line %d", filepath.ToSlash(name), line)
+}
+
+// newDisallowedApiError returns the error which describes the usage of the disallowed API
+func newDisallowedApiError(api string, usage apiUsage, location string) error {
+	if usage.name == api {
+		return fmt.Errorf("the code uses the disallowed API %s at %s", api, location)
+	}
+	return fmt.Errorf("the code uses the disallowed API %s (%s) at %s", api, usage.name, location)
+}
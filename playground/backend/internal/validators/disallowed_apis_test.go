@@ -0,0 +1,199 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeSourceFiles writes files with the code to the temporary source folder and returns the path of the main file
+func writeSourceFiles(t *testing.T, mainFileName string, files map[string]string) string {
+	folder := t.TempDir()
+	for name, code := range files {
+		path := filepath.Join(folder, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatalf("error during test setup: %s", err.Error())
+		}
+		if err := os.WriteFile(path, []byte(code), 0600); err != nil {
+			t.Fatalf("error during test setup: %s", err.Error())
+		}
+	}
+	return filepath.Join(folder, mainFileName)
+}
+
+// disallowedApisTest is the test case of the validator which checks disallowed APIs
+type disallowedApisTest struct {
+	name string
+	// files are the code by file names which are relative to the source folder
+	files   map[string]string
+	want    bool
+	wantErr string
+}
+
+// runDisallowedApisTests runs the validator for each test case
+func runDisallowedApisTests(t *testing.T, validatorName, mainFileName string, validator func(args ...interface{}) (bool, error), disallowedApis []string, tests []disallowedApisTest) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath := writeSourceFiles(t, mainFileName, tt.files)
+			got, err := validator(filePath, disallowedApis)
+			if (err != nil) != (tt.wantErr != "") {
+				t.Fatalf("%s() error = %v, wantErr %q", validatorName, err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%s() error = %q, want to contain %q", validatorName, err.Error(), tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("%s() got = %v, want %v", validatorName, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_findDisallowedApi(t *testing.T) {
+	disallowedApis := []string{"os", "java.net", "subprocess.run"}
+	tests := []struct {
+		name    string
+		api     string
+		want    string
+		wantRes bool
+	}{
+		{
+			// Test case with the same API as the disallowed one.
+			// As a result, want to receive the disallowed API.
+			name:    "same API",
+			api:     "os",
+			want:    "os",
+			wantRes: true,
+		},
+		{
+			// Test case with the child package and the member of the disallowed API.
+			// As a result, want to receive the disallowed API.
+			name:    "child of the disallowed API",
+			api:     "java.net.URL",
+			want:    "java.net",
+			wantRes: true,
+		},
+		{
+			// Test case with the Go sub package of the disallowed package.
+			// As a result, want to receive the disallowed package.
+			name:    "sub package",
+			api:     "os/exec",
+			want:    "os",
+			wantRes: true,
+		},
+		{
+			// Test case with the API which only starts with the name of the disallowed API.
+			// As a result, want to receive that the API is allowed.
+			name:    "API with the same prefix",
+			api:     "oslo",
+			want:    "",
+			wantRes: false,
+		},
+		{
+			// Test case with the parent of the disallowed API.
+			// As a result, want to receive that the API is allowed.
+			name:    "parent of the disallowed API",
+			api:     "subprocess",
+			want:    "",
+			wantRes: false,
+		},
+		{
+			// Test case with all members of the parent of the disallowed API.
+			// As a result, want to receive the disallowed API.
+			name:    "all members of the parent",
+			api:     "subprocess.*",
+			want:    "subprocess.run",
+			wantRes: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotRes := findDisallowedApi(tt.api, disallowedApis)
+			if got != tt.want || gotRes != tt.wantRes {
+				t.Errorf("findDisallowedApi() = %v, %v, want %v, %v", got, gotRes, tt.want, tt.wantRes)
+			}
+		})
+	}
+}
+
+func Test_checkDisallowedApisWithoutDisallowedApis(t *testing.T) {
+	got, err := checkDisallowedApis("not_existing_file.go", nil, getGoApiUsages)
+	if err != nil || !got {
+		t.Errorf("checkDisallowedApis() = %v, %v, want true, nil", got, err)
+	}
+}
+
+// catalogFolders are folders of the Beam repository with examples of the catalog by config files of SDKs
+var catalogFolders = map[string][]string{
+	"SDK_JAVA.json":   {"examples/java", "sdks/java/core/src/test"},
+	"SDK_GO.json":     {"sdks/go/examples", "sdks/go/pkg/beam"},
+	"SDK_PYTHON.json": {"sdks/python/apache_beam"},
+}
+
+// apiUsagesByExtension are functions which find used APIs by extensions of files of examples
+var apiUsagesByExtension = map[string]apiUsagesFunc{
+	".java": getJavaApiUsages,
+	".go":   getGoApiUsages,
+	".py":   getPyApiUsages,
+}
+
+func Test_catalogExamplesWithDefaultDisallowedApis(t *testing.T) {
+	repoDir := filepath.Join("..", "..", "..", "..")
+	for configName, folders := range catalogFolders {
+		t.Run(configName, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("..", "..", "configs", configName))
+			if err != nil {
+				t.Fatalf("error during test setup: %s", err.Error())
+			}
+			var config struct {
+				DisallowedApis []string `json:"disallowed_apis"`
+			}
+			if err := json.Unmarshal(data, &config); err != nil {
+				t.Fatalf("error during test setup: %s", err.Error())
+			}
+			for _, folder := range folders {
+				if _, err := os.Stat(filepath.Join(repoDir, folder)); err != nil {
+					t.Skipf("examples of the catalog aren't found: %s", err.Error())
+				}
+				err := filepath.WalkDir(filepath.Join(repoDir, folder), func(path string, entry fs.DirEntry, err error) error {
+					getApiUsages, ok := apiUsagesByExtension[filepath.Ext(path)]
+					if err != nil || entry.IsDir() || !ok {
+						return err
+					}
+					code, err := os.ReadFile(path)
+					if err != nil || !bytes.Contains(code, []byte("beam-playground:")) {
+						return err
+					}
+					for _, usage := range getApiUsages(code) {
+						if api, ok := findDisallowedApi(usage.name, config.DisallowedApis); ok {
+							t.Errorf("example %s uses disallowed API %q at line %d", path, api, usage.line)
+						}
+					}
+					return nil
+				})
+				if err != nil {
+					t.Fatalf("error during walk of examples: %s", err.Error())
+				}
+			}
+		})
+	}
+}
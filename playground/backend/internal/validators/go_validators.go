@@ -17,22 +17,35 @@ package validators
 
 import (
 	"beam.apache.org/playground/backend/internal/logger"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"io/ioutil"
+	"path"
+	"strconv"
 	"strings"
 )
 
 const goUnitTestPattern = "*testing.T"
 
 // GetGoValidators return validators methods that should be applied to Go code
-func GetGoValidators(filePath string) *[]Validator {
+func GetGoValidators(filePath string, disallowedApis []string) *[]Validator {
 	validatorArgs := make([]interface{}, 1)
 	validatorArgs[0] = filePath
+	disallowedApisValidatorArgs := make([]interface{}, 2)
+	disallowedApisValidatorArgs[0] = filePath
+	disallowedApisValidatorArgs[1] = disallowedApis
+	disallowedApisValidator := Validator{
+		Validator: checkDisallowedApisGo,
+		Args:      disallowedApisValidatorArgs,
+		Name:      DisallowedApisValidatorName,
+	}
 	unitTestValidator := Validator{
 		Validator: CheckIsUnitTestGo,
 		Args:      validatorArgs,
 		Name:      UnitTestValidatorName,
 	}
-	validators := []Validator{unitTestValidator}
+	validators := []Validator{disallowedApisValidator, unitTestValidator}
 	return &validators
 }
 
@@ -46,3 +59,62 @@ func CheckIsUnitTestGo(args ...interface{}) (bool, error) {
 	// check whether Go code is unit test code
 	return strings.Contains(string(code), goUnitTestPattern), nil
 }
+
+// checkDisallowedApisGo checks that Go code doesn't use disallowed packages and functions
+func checkDisallowedApisGo(args ...interface{}) (bool, error) {
+	filePath := args[0].(string)
+	disallowedApis := args[1].([]string)
+	return checkDisallowedApis(filePath, disallowedApis, getGoApiUsages)
+}
+
+// getGoApiUsages returns imported packages (e.g. "net/http") and used functions, types and variables
+// of imported packages (e.g. "os.ReadFile") of Go code.
+// Identifiers of dot imported packages are used without the package name, so for them
+// all identifiers which aren't selectors are returned as members of these packages.
+// If the code can't be parsed, return nothing, because the code isn't compiled anyway.
+func getGoApiUsages(code []byte) []apiUsage {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "", code, 0)
+	if err != nil {
+		return nil
+	}
+	var usages []apiUsage
+	var dotImports []string
+	imports := make(map[string]string)
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		usages = append(usages, apiUsage{name: importPath, line: fileSet.Position(spec.Pos()).Line})
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if name == "." {
+			dotImports = append(dotImports, importPath)
+			continue
+		}
+		imports[name] = importPath
+	}
+	selectors := make(map[*ast.Ident]bool)
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.SelectorExpr:
+			selectors[node.Sel] = true
+			if ident, ok := node.X.(*ast.Ident); ok {
+				if importPath, ok := imports[ident.Name]; ok {
+					usages = append(usages, apiUsage{name: importPath + "." + node.Sel.Name, line: fileSet.Position(node.Pos()).Line})
+				}
+			}
+		case *ast.Ident:
+			if !selectors[node] {
+				for _, importPath := range dotImports {
+					usages = append(usages, apiUsage{name: importPath + "." + node.Name, line: fileSet.Position(node.Pos()).Line})
+				}
+			}
+		}
+		return true
+	})
+	return usages
+}
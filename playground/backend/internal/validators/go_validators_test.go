@@ -74,3 +74,53 @@ func TestCheckIsUnitTestGo(t *testing.T) {
 		})
 	}
 }
+
+func Test_checkDisallowedApisGo(t *testing.T) {
+	disallowedApis := []string{"os", "net", "io/ioutil.ReadFile"}
+	tests := []disallowedApisTest{
+		{
+			// Test case with the code which mentions disallowed APIs only in comments and strings.
+			// As a result, want to receive that the code is valid.
+			name: "disallowed APIs in comments and strings",
+			files: map[string]string{"main.go": "package main\n\nimport (\n\t\"fmt\"\n\t\"io/ioutil\"\n\t\"strings\"\n)\n\n" +
+				"// os.ReadFile and net/http mustn't be used\nfunc main() {\n\tdata, _ := ioutil.ReadAll(strings.NewReader(\"os.Exit(1)\"))\n\tfmt.Println(string(data))\n}\n"},
+			want: true,
+		},
+		{
+			// Test case with the code which imports a sub package of the disallowed package.
+			// As a result, want to receive an error with the disallowed package.
+			name:    "import of disallowed package",
+			files:   map[string]string{"main.go": "package main\n\nimport \"os/exec\"\n\nfunc main() {\n\texec.Command(\"ls\").Run()\n}\n"},
+			want:    false,
+			wantErr: "the code uses the disallowed API os (os/exec) at line 3",
+		},
+		{
+			// Test case with the code which uses the disallowed function of the package with alias.
+			// As a result, want to receive an error with the disallowed function.
+			name:    "disallowed function with alias",
+			files:   map[string]string{"main.go": "package main\n\nimport iu \"io/ioutil\"\n\nfunc main() {\n\tiu.ReadFile(\"/etc/passwd\")\n}\n"},
+			want:    false,
+			wantErr: "the code uses the disallowed API io/ioutil.ReadFile at line 6",
+		},
+		{
+			// Test case with the code which uses the disallowed function of the dot imported package.
+			// As a result, want to receive an error with the disallowed function.
+			name:    "disallowed function with dot import",
+			files:   map[string]string{"main.go": "package main\n\nimport . \"io/ioutil\"\n\nfunc main() {\n\tReadFile(\"/etc/passwd\")\n}\n"},
+			want:    false,
+			wantErr: "io/ioutil.ReadFile at line 6",
+		},
+		{
+			// Test case with the code which uses the disallowed package in the additional file.
+			// As a result, want to receive an error with the name of the additional file.
+			name: "disallowed package in additional file",
+			files: map[string]string{
+				"main.go":  "package main\n\nfunc main() {\n\tget()\n}\n",
+				"utils.go": "package main\n\nimport \"net/http\"\n\nfunc get() {\n\thttp.Get(\"http://example.com\")\n}\n",
+			},
+			want:    false,
+			wantErr: "the code uses the disallowed API net (net/http) at utils.go, line 3",
+		},
+	}
+	runDisallowedApisTests(t, "checkDisallowedApisGo", "main.go", checkDisallowedApisGo, disallowedApis, tests)
+}
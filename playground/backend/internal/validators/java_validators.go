@@ -19,18 +19,22 @@ import (
 	"beam.apache.org/playground/backend/internal/fs_tool"
 	"beam.apache.org/playground/backend/internal/logger"
 	"io/ioutil"
+	"strconv"
 	"strings"
+	"unicode"
 )
 
 const (
 	javaExtension       = ".java"
 	javaUnitTestPattern = "@Test"
 	javaKatasPattern    = "org.apache.beam.learning.katas"
+	// javaLangPackage is imported to all Java files implicitly
+	javaLangPackage = "java.lang"
 )
 
 // GetJavaValidators return validators methods that should be applied to Java code
 // The last validator should check that the code is unit tests or not
-func GetJavaValidators(filePath string) *[]Validator {
+func GetJavaValidators(filePath string, disallowedApis []string) *[]Validator {
 	validatorArgs := make([]interface{}, 2)
 	validatorArgs[0] = filePath
 	validatorArgs[1] = javaExtension
@@ -39,6 +43,14 @@ func GetJavaValidators(filePath string) *[]Validator {
 		Args:      validatorArgs,
 		Name:      "Valid path",
 	}
+	disallowedApisValidatorArgs := make([]interface{}, 2)
+	disallowedApisValidatorArgs[0] = filePath
+	disallowedApisValidatorArgs[1] = disallowedApis
+	disallowedApisValidator := Validator{
+		Validator: checkDisallowedApisJava,
+		Args:      disallowedApisValidatorArgs,
+		Name:      DisallowedApisValidatorName,
+	}
 	unitTestValidator := Validator{
 		Validator: checkIsUnitTestJava,
 		Args:      validatorArgs,
@@ -49,7 +61,7 @@ func GetJavaValidators(filePath string) *[]Validator {
 		Args:      validatorArgs,
 		Name:      KatasValidatorName,
 	}
-	validators := []Validator{pathCheckerValidator, unitTestValidator, katasValidator}
+	validators := []Validator{pathCheckerValidator, disallowedApisValidator, unitTestValidator, katasValidator}
 	return &validators
 }
 
@@ -82,3 +94,183 @@ func checkPipelineType(args ...interface{}) (bool, error) {
 	// check whether s contains substring unit test or katas
 	return strings.Contains(string(code), pattern), nil
 }
+
+// checkDisallowedApisJava checks that Java code doesn't use disallowed packages, classes and methods
+func checkDisallowedApisJava(args ...interface{}) (bool, error) {
+	filePath := args[0].(string)
+	disallowedApis := args[1].([]string)
+	return checkDisallowedApis(filePath, disallowedApis, getJavaApiUsages)
+}
+
+// getJavaApiUsages returns imported packages and classes (e.g. "java.net" or "java.io.File") and
+// used classes and their members (e.g. "java.lang.Runtime.getRuntime") of Java code.
+// Simple names are resolved by single-type imports, on-demand imports and the java.lang package,
+// so "Files.readAllLines" with "import java.nio.file.*;" is returned as "java.nio.file.Files.readAllLines".
+func getJavaApiUsages(code []byte) []apiUsage {
+	tokens := scanJava([]rune(translateJavaUnicodeEscapes(string(code))))
+	var usages []apiUsage
+	imports := make(map[string]string)
+	onDemandImports := []string{javaLangPackage}
+	for i := 0; i < len(tokens); {
+		token := tokens[i]
+		switch {
+		case !token.isName || isAttribute(tokens, i):
+			i++
+		case token.text == "package":
+			_, i = getDottedName(tokens, i+1)
+		case token.text == "import":
+			i++
+			if getTokenText(tokens, i) == "static" {
+				i++
+			}
+			if i >= len(tokens) || !tokens[i].isName {
+				break
+			}
+			var name string
+			name, i = getDottedName(tokens, i)
+			usages = append(usages, apiUsage{name: name, line: token.line})
+			if getTokenText(tokens, i) == "." && getTokenText(tokens, i+1) == "*" {
+				onDemandImports = append(onDemandImports, name)
+				i += 2
+				break
+			}
+			imports[name[strings.LastIndex(name, ".")+1:]] = name
+		default:
+			name, next := getDottedName(tokens, i)
+			usages = append(usages, apiUsage{name: name, line: token.line})
+			head := strings.SplitN(name, ".", 2)[0]
+			if fullName, ok := imports[head]; ok {
+				usages = append(usages, apiUsage{name: fullName + strings.TrimPrefix(name, head), line: token.line})
+			} else {
+				for _, pkg := range onDemandImports {
+					usages = append(usages, apiUsage{name: pkg + "." + name, line: token.line})
+				}
+			}
+			i = next
+		}
+	}
+	return usages
+}
+
+// scanJava splits Java code into tokens skipping comments, string, text block and character literals
+func scanJava(code []rune) []codeToken {
+	var tokens []codeToken
+	line := 1
+	for i := 0; i < len(code); {
+		c := code[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == '/' && i+1 < len(code) && code[i+1] == '/':
+			for i < len(code) && code[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(code) && code[i+1] == '*':
+			i += 2
+			for i < len(code) && !(code[i] == '*' && i+1 < len(code) && code[i+1] == '/') {
+				if code[i] == '\n' {
+					line++
+				}
+				i++
+			}
+			i += 2
+		case c == '"' || c == '\'':
+			tokens = append(tokens, codeToken{text: "\"\"", line: line})
+			i, line = skipJavaLiteral(code, i, line)
+		case c == '_' || c == '$' || unicode.IsLetter(c):
+			start := i
+			for i < len(code) && (code[i] == '_' || code[i] == '$' || unicode.IsLetter(code[i]) || unicode.IsDigit(code[i])) {
+				i++
+			}
+			tokens = append(tokens, codeToken{text: string(code[start:i]), line: line, isName: true})
+		case unicode.IsDigit(c):
+			for i < len(code) && (code[i] == '_' || code[i] == '.' || unicode.IsLetter(code[i]) || unicode.IsDigit(code[i])) {
+				i++
+			}
+			tokens = append(tokens, codeToken{text: "0", line: line})
+		case unicode.IsSpace(c):
+			i++
+		default:
+			tokens = append(tokens, codeToken{text: string(c), line: line})
+			i++
+		}
+	}
+	return tokens
+}
+
+// skipJavaLiteral skips the literal which starts from the quote with the index.
+// Returns the index after the literal and the line of the end of the literal.
+func skipJavaLiteral(code []rune, index, line int) (int, int) {
+	quote := code[index]
+	textBlock := quote == '"' && index+2 < len(code) && code[index+1] == '"' && code[index+2] == '"'
+	if textBlock {
+		index += 3
+	} else {
+		index++
+	}
+	for index < len(code) {
+		c := code[index]
+		switch {
+		case c == '\\':
+			if index+1 < len(code) && code[index+1] == '\n' {
+				line++
+			}
+			index += 2
+		case c == quote && (!textBlock || (index+2 < len(code) && code[index+1] == quote && code[index+2] == quote)):
+			if textBlock {
+				return index + 3, line
+			}
+			return index + 1, line
+		case c == '\n' && !textBlock:
+			// not terminated literal
+			return index, line
+		default:
+			if c == '\n' {
+				line++
+			}
+			index++
+		}
+	}
+	return index, line
+}
+
+// translateJavaUnicodeEscapes replaces unicode escapes (e.g. "\u0069mport") with the characters,
+// because the Java compiler translates them before the code is split into tokens
+func translateJavaUnicodeEscapes(code string) string {
+	if !strings.Contains(code, "\\u") {
+		return code
+	}
+	var result strings.Builder
+	for i := 0; i < len(code); {
+		if code[i] != '\\' {
+			result.WriteByte(code[i])
+			i++
+			continue
+		}
+		// the escaped backslash can't start the unicode escape
+		if i+1 < len(code) && code[i+1] == '\\' {
+			result.WriteString("\\\\")
+			i += 2
+			continue
+		}
+		end := i + 1
+		for end < len(code) && code[end] == 'u' {
+			end++
+		}
+		if end == i+1 || end+4 > len(code) {
+			result.WriteByte(code[i])
+			i++
+			continue
+		}
+		char, err := strconv.ParseUint(code[end:end+4], 16, 32)
+		if err != nil {
+			result.WriteByte(code[i])
+			i++
+			continue
+		}
+		result.WriteRune(rune(char))
+		i = end + 4
+	}
+	return result.String()
+}
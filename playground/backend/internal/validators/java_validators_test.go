@@ -126,3 +126,62 @@ func getValidatorsArgs(args ...interface{}) []interface{} {
 	preparedArgs[2] = args[1]
 	return preparedArgs
 }
+
+func Test_checkDisallowedApisJava(t *testing.T) {
+	disallowedApis := []string{"java.io.File", "java.net", "java.lang.Runtime"}
+	tests := []disallowedApisTest{
+		{
+			// Test case with the code which mentions disallowed APIs only in comments and literals
+			// and uses allowed classes of the same packages.
+			// As a result, want to receive that the code is valid.
+			name: "disallowed APIs in comments and literals",
+			files: map[string]string{"Task.java": "import java.io.*;\nimport java.io.Serializable;\n\n" +
+				"/* new File(\"/etc/passwd\") */\npublic class Task implements Serializable {\n" +
+				"  // Runtime.getRuntime().exec(\"ls\")\n  public static void main(String[] args) throws IOException {\n" +
+				"    String url = \"java.net.URL\";\n    String block = \"\"\"\n        new java.net.Socket()\n        \"\"\";\n" +
+				"    char c = '\\'';\n    FileSystemHelper.create(url, block, c);\n  }\n}\n"},
+			want: true,
+		},
+		{
+			// Test case with the code which imports the disallowed class.
+			// As a result, want to receive an error with the disallowed class.
+			name:    "import of disallowed class",
+			files:   map[string]string{"Task.java": "import java.io.File;\n\npublic class Task {}\n"},
+			want:    false,
+			wantErr: "the code uses the disallowed API java.io.File at line 1",
+		},
+		{
+			// Test case with the code which uses the disallowed class of the on-demand imported package.
+			// As a result, want to receive an error with the disallowed class.
+			name:    "disallowed class with on-demand import",
+			files:   map[string]string{"Task.java": "import java.io.*;\n\npublic class Task {\n  File file = new File(\"/etc/passwd\");\n}\n"},
+			want:    false,
+			wantErr: "the code uses the disallowed API java.io.File at line 4",
+		},
+		{
+			// Test case with the code which uses the fully qualified name of the disallowed package.
+			// As a result, want to receive an error with the disallowed package.
+			name:    "fully qualified name",
+			files:   map[string]string{"Task.java": "public class Task {\n  Object url = new java.net.URL(\"http://example.com\");\n}\n"},
+			want:    false,
+			wantErr: "the code uses the disallowed API java.net (java.net.URL) at line 2",
+		},
+		{
+			// Test case with the code which uses the disallowed class of java.lang package without import.
+			// As a result, want to receive an error with the disallowed class.
+			name:    "disallowed class of java.lang",
+			files:   map[string]string{"Task.java": "public class Task {\n  void run() throws Exception {\n    Runtime.getRuntime().exec(\"ls\");\n  }\n}\n"},
+			want:    false,
+			wantErr: "the code uses the disallowed API java.lang.Runtime (java.lang.Runtime.getRuntime) at line 3",
+		},
+		{
+			// Test case with the code which hides the disallowed package with unicode escapes.
+			// As a result, want to receive an error with the disallowed package.
+			name:    "unicode escapes",
+			files:   map[string]string{"Task.java": "public class Task {\n  Object url = new java\\u002enet.URL(\"http://example.com\");\n}\n"},
+			want:    false,
+			wantErr: "java.net",
+		},
+	}
+	runDisallowedApisTests(t, "checkDisallowedApisJava", "Task.java", checkDisallowedApisJava, disallowedApis, tests)
+}
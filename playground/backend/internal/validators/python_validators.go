@@ -19,20 +19,32 @@ import (
 	"beam.apache.org/playground/backend/internal/logger"
 	"io/ioutil"
 	"strings"
+	"unicode"
 )
 
-const pyUnitTestPattern = "import unittest"
+const (
+	pyUnitTestPattern = "import unittest"
+	pyBuiltinsModule  = "builtins"
+)
 
 // GetPyValidators return validators methods that should be applied to Python code
-func GetPyValidators(filePath string) *[]Validator {
+func GetPyValidators(filePath string, disallowedApis []string) *[]Validator {
 	validatorArgs := make([]interface{}, 1)
 	validatorArgs[0] = filePath
+	disallowedApisValidatorArgs := make([]interface{}, 2)
+	disallowedApisValidatorArgs[0] = filePath
+	disallowedApisValidatorArgs[1] = disallowedApis
+	disallowedApisValidator := Validator{
+		Validator: checkDisallowedApisPy,
+		Args:      disallowedApisValidatorArgs,
+		Name:      DisallowedApisValidatorName,
+	}
 	unitTestValidator := Validator{
 		Validator: CheckIsUnitTestPy,
 		Args:      validatorArgs,
 		Name:      UnitTestValidatorName,
 	}
-	validators := []Validator{unitTestValidator}
+	validators := []Validator{disallowedApisValidator, unitTestValidator}
 	return &validators
 }
 
@@ -46,3 +58,257 @@ func CheckIsUnitTestPy(args ...interface{}) (bool, error) {
 	// check whether Python code is unit test code
 	return strings.Contains(string(code), pyUnitTestPattern), nil
 }
+
+// checkDisallowedApisPy checks that Python code doesn't use disallowed modules and functions
+func checkDisallowedApisPy(args ...interface{}) (bool, error) {
+	filePath := args[0].(string)
+	disallowedApis := args[1].([]string)
+	return checkDisallowedApis(filePath, disallowedApis, getPyApiUsages)
+}
+
+// getPyApiUsages returns imported modules (e.g. "os.path"), used members of imported modules (e.g. "os.system")
+// and used builtins (e.g. "builtins.open") of Python code.
+// Names are resolved by aliases of import statements, so "import subprocess as sp" and "sp.run()"
+// is returned as "subprocess" and "subprocess.run".
+func getPyApiUsages(code []byte) []apiUsage {
+	tokens := scanPy([]rune(string(code)), 1)
+	var usages []apiUsage
+	aliases := make(map[string]string)
+	for i := 0; i < len(tokens); {
+		token := tokens[i]
+		switch {
+		case !token.isName || isAttribute(tokens, i):
+			i++
+		case token.text == "import":
+			i = parsePyImport(tokens, i+1, aliases, &usages)
+		case token.text == "from":
+			i = parsePyFromImport(tokens, i+1, aliases, &usages)
+		case token.text == "def" || token.text == "class":
+			// the name of the definition isn't a usage
+			i += 2
+		default:
+			name, next := getDottedName(tokens, i)
+			head := strings.SplitN(name, ".", 2)[0]
+			if module, ok := aliases[head]; ok {
+				name = module + strings.TrimPrefix(name, head)
+			} else {
+				name = pyBuiltinsModule + "." + name
+			}
+			usages = append(usages, apiUsage{name: name, line: token.line})
+			i = next
+		}
+	}
+	return usages
+}
+
+// parsePyImport parses names of "import a.b as c, d" statement which starts from the index
+// and returns the index of the token after the statement
+func parsePyImport(tokens []codeToken, index int, aliases map[string]string, usages *[]apiUsage) int {
+	for index < len(tokens) && tokens[index].isName {
+		name, next := getDottedName(tokens, index)
+		*usages = append(*usages, apiUsage{name: name, line: tokens[index].line})
+		if getTokenText(tokens, next) == "as" && next+1 < len(tokens) {
+			aliases[tokens[next+1].text] = name
+			next += 2
+		} else {
+			head := strings.SplitN(name, ".", 2)[0]
+			aliases[head] = head
+		}
+		if getTokenText(tokens, next) != "," {
+			return next
+		}
+		index = next + 1
+	}
+	return index
+}
+
+// parsePyFromImport parses names of "from a.b import c as d, e" statement which starts from the index
+// and returns the index of the token after the statement.
+// Relative imports (e.g. "from . import utils") import files of the code which are checked on their own.
+func parsePyFromImport(tokens []codeToken, index int, aliases map[string]string, usages *[]apiUsage) int {
+	relative := false
+	for getTokenText(tokens, index) == "." {
+		relative = true
+		index++
+	}
+	module := ""
+	if index < len(tokens) && tokens[index].isName && tokens[index].text != "import" {
+		module, index = getDottedName(tokens, index)
+	}
+	if getTokenText(tokens, index) != "import" {
+		return index
+	}
+	index++
+	if getTokenText(tokens, index) == "(" {
+		index++
+	}
+	for index < len(tokens) {
+		token := tokens[index]
+		switch {
+		case token.text == "*":
+			if !relative {
+				*usages = append(*usages, apiUsage{name: module + ".*", line: token.line})
+			}
+			index++
+		case token.isName:
+			name := token.text
+			alias := name
+			index++
+			if getTokenText(tokens, index) == "as" && index+1 < len(tokens) {
+				alias = tokens[index+1].text
+				index += 2
+			}
+			if relative {
+				// the name isn't resolved to the module, so it isn't taken as a builtin
+				aliases[alias] = alias
+				break
+			}
+			*usages = append(*usages, apiUsage{name: module + "." + name, line: token.line})
+			aliases[alias] = module + "." + name
+		case token.text == "," || token.text == ")":
+			index++
+		default:
+			return index
+		}
+	}
+	return index
+}
+
+// scanPy splits Python code into tokens skipping comments and string literals.
+// Expressions of formatted string literals (e.g. "{os.getcwd()}" in f"{os.getcwd()}") are code,
+// so they are scanned as well. Newline tokens are added only for the end of the logical line.
+func scanPy(code []rune, line int) []codeToken {
+	var tokens []codeToken
+	depth := 0
+	for i := 0; i < len(code); {
+		c := code[i]
+		switch {
+		case c == '\n':
+			if depth == 0 {
+				tokens = append(tokens, codeToken{text: "\n", line: line})
+			}
+			line++
+			i++
+		case c == '\\' && i+1 < len(code) && code[i+1] == '\n':
+			line++
+			i += 2
+		case c == '#':
+			for i < len(code) && code[i] != '\n' {
+				i++
+			}
+		case c == '"' || c == '\'':
+			var literalTokens []codeToken
+			literalTokens, i, line = scanPyString(code, i, line, false)
+			tokens = append(tokens, literalTokens...)
+		case c == '_' || unicode.IsLetter(c):
+			start := i
+			for i < len(code) && (code[i] == '_' || unicode.IsLetter(code[i]) || unicode.IsDigit(code[i])) {
+				i++
+			}
+			name := string(code[start:i])
+			if i < len(code) && (code[i] == '"' || code[i] == '\'') && isPyStringPrefix(name) {
+				var literalTokens []codeToken
+				literalTokens, i, line = scanPyString(code, i, line, strings.ContainsAny(name, "fF"))
+				tokens = append(tokens, literalTokens...)
+				break
+			}
+			tokens = append(tokens, codeToken{text: name, line: line, isName: true})
+		case unicode.IsDigit(c):
+			for i < len(code) && (code[i] == '_' || code[i] == '.' || unicode.IsLetter(code[i]) || unicode.IsDigit(code[i])) {
+				i++
+			}
+			tokens = append(tokens, codeToken{text: "0", line: line})
+		case unicode.IsSpace(c):
+			i++
+		default:
+			switch c {
+			case '(', '[', '{':
+				depth++
+			case ')', ']', '}':
+				if depth > 0 {
+					depth--
+				}
+			}
+			text := string(c)
+			if c == ';' {
+				text = "\n"
+			}
+			tokens = append(tokens, codeToken{text: text, line: line})
+			i++
+		}
+	}
+	return tokens
+}
+
+// scanPyString skips the string literal which starts from the quote with the index.
+// Returns tokens of the literal, the index after the literal and the line of the end of the literal.
+// The literal is returned as a not name token with tokens of expressions if the literal is formatted.
+func scanPyString(code []rune, index, line int, isFormatted bool) ([]codeToken, int, int) {
+	tokens := []codeToken{{text: "\"\"", line: line}}
+	quote := code[index]
+	triple := index+2 < len(code) && code[index+1] == quote && code[index+2] == quote
+	if triple {
+		index += 3
+	} else {
+		index++
+	}
+	for index < len(code) {
+		c := code[index]
+		switch {
+		case c == '\\':
+			if index+1 < len(code) && code[index+1] == '\n' {
+				line++
+			}
+			index += 2
+		case c == quote && (!triple || (index+2 < len(code) && code[index+1] == quote && code[index+2] == quote)):
+			if triple {
+				return tokens, index + 3, line
+			}
+			return tokens, index + 1, line
+		case c == '\n' && !triple:
+			// not terminated literal
+			return tokens, index, line
+		case c == '{' && isFormatted:
+			if index+1 < len(code) && code[index+1] == '{' {
+				index += 2
+				break
+			}
+			// the expression ends with the closing brace of the same depth
+			end := index + 1
+			depth := 1
+			for ; end < len(code) && code[end] != quote; end++ {
+				if code[end] == '{' {
+					depth++
+				} else if code[end] == '}' {
+					depth--
+				}
+				if depth == 0 {
+					break
+				}
+			}
+			expression := code[index+1 : end]
+			tokens = append(tokens, scanPy(expression, line)...)
+			tokens = append(tokens, codeToken{text: "\"\"", line: line})
+			line += strings.Count(string(expression), "\n")
+			index = end
+			if depth == 0 {
+				index++
+			}
+		default:
+			if c == '\n' {
+				line++
+			}
+			index++
+		}
+	}
+	return tokens, index, line
+}
+
+// isPyStringPrefix returns true if the name is a prefix of the string literal (e.g. "rb" in rb"...")
+func isPyStringPrefix(name string) bool {
+	switch strings.ToLower(name) {
+	case "r", "u", "b", "f", "br", "rb", "fr", "rf":
+		return true
+	}
+	return false
+}
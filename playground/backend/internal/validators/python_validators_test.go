@@ -0,0 +1,90 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validators
+
+import (
+	"testing"
+)
+
+func Test_checkDisallowedApisPy(t *testing.T) {
+	disallowedApis := []string{"os", "subprocess.run", "builtins.open"}
+	tests := []disallowedApisTest{
+		{
+			// Test case with the code which mentions disallowed APIs only in comments and strings
+			// and uses allowed members of the same modules and names of disallowed builtins.
+			// As a result, want to receive that the code is valid.
+			name: "disallowed APIs in comments and strings",
+			files: map[string]string{"main.py": "import subprocess\n" +
+				"# import os\n\"\"\"\nos.system('ls')\n\"\"\"\n\n" +
+				"class File:\n    def open(self):\n        return 'open(\"/etc/passwd\")'\n\n\n" +
+				"if __name__ == \"__main__\":\n    print(File().open(), r'\\os', f\"{{open}}\")\n    subprocess.check_output\n"},
+			want: true,
+		},
+		{
+			// Test case with the code which imports the submodule of the disallowed module.
+			// As a result, want to receive an error with the disallowed module.
+			name:    "import of disallowed module",
+			files:   map[string]string{"main.py": "import logging, os.path\n"},
+			want:    false,
+			wantErr: "the code uses the disallowed API os (os.path) at line 1",
+		},
+		{
+			// Test case with the code which imports the member of the disallowed module.
+			// As a result, want to receive an error with the disallowed module.
+			name:    "from import of disallowed module",
+			files:   map[string]string{"main.py": "from os import (\n    path,\n)\n"},
+			want:    false,
+			wantErr: "the code uses the disallowed API os (os.path) at line 2",
+		},
+		{
+			// Test case with the code which uses the disallowed function of the module with alias.
+			// As a result, want to receive an error with the disallowed function.
+			name:    "disallowed function with alias",
+			files:   map[string]string{"main.py": "import subprocess as sp\n\nsp.run(['ls'])\n"},
+			want:    false,
+			wantErr: "the code uses the disallowed API subprocess.run at line 3",
+		},
+		{
+			// Test case with the code which imports all members of the module with the disallowed function.
+			// As a result, want to receive an error with the disallowed function.
+			name:    "star import",
+			files:   map[string]string{"main.py": "from subprocess import *\n"},
+			want:    false,
+			wantErr: "the code uses the disallowed API subprocess.run (subprocess.*) at line 1",
+		},
+		{
+			// Test case with the code which uses the disallowed builtin in the formatted string.
+			// As a result, want to receive an error with the disallowed builtin.
+			name:    "disallowed builtin in formatted string",
+			files:   map[string]string{"main.py": "print(f\"passwd: {open('/etc/passwd').read()}\")\n"},
+			want:    false,
+			wantErr: "the code uses the disallowed API builtins.open at line 1",
+		},
+		{
+			// Test case with the code which uses the disallowed module in the submodule.
+			// As a result, want to receive an error with the name of the submodule.
+			name: "disallowed module in submodule",
+			files: map[string]string{
+				"main.py":           "from utils import helper\n\nhelper.run()\n",
+				"utils/__init__.py": "",
+				"utils/helper.py":   "\ndef run():\n    import os\n    os.system('ls')\n",
+			},
+			want:    false,
+			wantErr: "the code uses the disallowed API os at utils/helper.py, line 3",
+		},
+	}
+	runDisallowedApisTests(t, "checkDisallowedApisPy", "main.py", checkDisallowedApisPy, disallowedApis, tests)
+}
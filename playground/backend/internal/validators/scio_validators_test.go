@@ -0,0 +1,82 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validators
+
+import (
+	"testing"
+)
+
+const (
+	scioUnitTestFilePath = "unitTestCode.scala"
+	scioCodePath         = "code.scala"
+	scioUnitTestCode     = "import com.spotify.scio.testing._\n\nclass MinimalWordCountTest extends PipelineSpec {\n  \"MinimalWordCount\" should \"work\" in {\n    runWithContext { sc =>\n      sc.parallelize(Seq(\"a b\", \"b\")).flatMap(_.split(\" \")).countByValue should containInAnyOrder(Seq((\"a\", 1L), (\"b\", 2L)))\n    }\n  }\n}\n"
+	scioCode             = "import com.spotify.scio._\n\nobject MinimalWordCount {\n  def main(cmdlineArgs: Array[String]): Unit = {\n    val (sc, _) = ContextAndArgs(cmdlineArgs)\n    sc.parallelize(Seq(\"a b\", \"b\")).flatMap(_.split(\" \")).countByValue.debug()\n    sc.run()\n  }\n}\n"
+)
+
+func TestCheckIsUnitTestScio(t *testing.T) {
+	testValidatorArgs := []interface{}{scioUnitTestFilePath, scioExtension}
+	validatorArgs := []interface{}{scioCodePath, scioExtension}
+
+	type args struct {
+		args []interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			// Test if code is unit test code
+			name: "if unit test",
+			args: args{
+				testValidatorArgs,
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			// Test if code is not unit test code
+			name: "if not unit test",
+			args: args{
+				validatorArgs,
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			// Test if file with code doesn't exist
+			name: "file doesn't exist",
+			args: args{
+				[]interface{}{"notExistingCode.scala", scioExtension},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checkIsUnitTestScio(tt.args.args...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkIsUnitTestScio error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("checkIsUnitTestScio got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ package validators
 const (
 	UnitTestValidatorName = "UnitTest"
 	KatasValidatorName    = "Katas"
+	// DisallowedApisValidatorName is the name of the validator which checks that the code doesn't use disallowed APIs
+	DisallowedApisValidatorName = "DisallowedApis"
 )
 
 type Validator struct {
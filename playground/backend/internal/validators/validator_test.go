@@ -23,7 +23,7 @@ import (
 
 // TestMain setups and teardown all necessary functionality for tests
 // in 'validators' package (i.e. for java_validators_test, go_validators_test,
-// python_validators_test)
+// python_validators_test, scio_validators_test)
 func TestMain(m *testing.M) {
 	setup()
 	defer teardown()
@@ -36,6 +36,8 @@ func setup() {
 	writeFile(goUnitTestFilePath, goUnitTestCode)
 	writeFile(goCodePath, goCode)
 	writeFile(javaKataFilePath, javaKataCode)
+	writeFile(scioUnitTestFilePath, scioUnitTestCode)
+	writeFile(scioCodePath, scioCode)
 }
 
 func teardown() {
@@ -44,6 +46,8 @@ func teardown() {
 	removeFile(goUnitTestFilePath)
 	removeFile(goCodePath)
 	removeFile(javaKataFilePath)
+	removeFile(scioUnitTestFilePath)
+	removeFile(scioCodePath)
 }
 
 func removeFile(path string) {
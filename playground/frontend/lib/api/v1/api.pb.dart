@@ -19,17 +19,77 @@
 ///
 import 'dart:core' as $core;
 
+import 'package:fixnum/fixnum.dart' as $fixnum;
 import 'package:protobuf/protobuf.dart' as $pb;
 
+import '../../google/protobuf/duration.pb.dart' as $1;
+import '../../google/protobuf/timestamp.pb.dart' as $2;
+
 import 'api.pbenum.dart';
 
 export 'api.pbenum.dart';
 
+class ErrorDetails extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ErrorDetails', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..e<ErrorCode>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'code', $pb.PbFieldType.OE, defaultOrMaker: ErrorCode.ERROR_CODE_UNSPECIFIED, valueOf: ErrorCode.valueOf, enumValues: ErrorCode.values)
+    ..hasRequiredFields = false
+  ;
+
+  ErrorDetails._() : super();
+  factory ErrorDetails({
+    ErrorCode? code,
+  }) {
+    final _result = create();
+    if (code != null) {
+      _result.code = code;
+    }
+    return _result;
+  }
+  factory ErrorDetails.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ErrorDetails.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  ErrorDetails clone() => ErrorDetails()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  ErrorDetails copyWith(void Function(ErrorDetails) updates) => super.copyWith((message) => updates(message as ErrorDetails)) as ErrorDetails; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static ErrorDetails create() => ErrorDetails._();
+  ErrorDetails createEmptyInstance() => create();
+  static $pb.PbList<ErrorDetails> createRepeated() => $pb.PbList<ErrorDetails>();
+  @$core.pragma('dart2js:noInline')
+  static ErrorDetails getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ErrorDetails>(create);
+  static ErrorDetails? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  ErrorCode get code => $_getN(0);
+  @$pb.TagNumber(1)
+  set code(ErrorCode v) { setField(1, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasCode() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCode() => clearField(1);
+}
+
 class RunCodeRequest extends $pb.GeneratedMessage {
   static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'RunCodeRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'code')
     ..e<Sdk>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
     ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineOptions')
+    ..aOB(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'nondeterministic')
+    ..pPS(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'requirements')
+    ..aOS(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'stdin')
+    ..m<$core.String, $core.String>(7, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'envVars', entryClassName: 'RunCodeRequest.EnvVarsEntry', keyFieldType: $pb.PbFieldType.OS, valueFieldType: $pb.PbFieldType.OS, packageName: const $pb.PackageName('api.v1'))
+    ..aOS(8, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'runner')
+    ..aOM<$1.Duration>(9, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'deadline', subBuilder: $1.Duration.create)
+    ..aOS(10, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'exampleCloudPath')
+    ..aOB(11, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'keepWorkingDir')
+    ..aOS(12, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'snippetId')
     ..hasRequiredFields = false
   ;
 
@@ -38,6 +98,15 @@ class RunCodeRequest extends $pb.GeneratedMessage {
     $core.String? code,
     Sdk? sdk,
     $core.String? pipelineOptions,
+    $core.bool? nondeterministic,
+    $core.Iterable<$core.String>? requirements,
+    $core.String? stdin,
+    $core.Map<$core.String, $core.String>? envVars,
+    $core.String? runner,
+    $1.Duration? deadline,
+    $core.String? exampleCloudPath,
+    $core.bool? keepWorkingDir,
+    $core.String? snippetId,
   }) {
     final _result = create();
     if (code != null) {
@@ -49,6 +118,33 @@ class RunCodeRequest extends $pb.GeneratedMessage {
     if (pipelineOptions != null) {
       _result.pipelineOptions = pipelineOptions;
     }
+    if (nondeterministic != null) {
+      _result.nondeterministic = nondeterministic;
+    }
+    if (requirements != null) {
+      _result.requirements.addAll(requirements);
+    }
+    if (stdin != null) {
+      _result.stdin = stdin;
+    }
+    if (envVars != null) {
+      _result.envVars.addAll(envVars);
+    }
+    if (runner != null) {
+      _result.runner = runner;
+    }
+    if (deadline != null) {
+      _result.deadline = deadline;
+    }
+    if (exampleCloudPath != null) {
+      _result.exampleCloudPath = exampleCloudPath;
+    }
+    if (keepWorkingDir != null) {
+      _result.keepWorkingDir = keepWorkingDir;
+    }
+    if (snippetId != null) {
+      _result.snippetId = snippetId;
+    }
     return _result;
   }
   factory RunCodeRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
@@ -98,6 +194,77 @@ class RunCodeRequest extends $pb.GeneratedMessage {
   $core.bool hasPipelineOptions() => $_has(2);
   @$pb.TagNumber(3)
   void clearPipelineOptions() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.bool get nondeterministic => $_getBF(3);
+  @$pb.TagNumber(4)
+  set nondeterministic($core.bool v) { $_setBool(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasNondeterministic() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearNondeterministic() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.List<$core.String> get requirements => $_getList(4);
+
+  @$pb.TagNumber(6)
+  $core.String get stdin => $_getSZ(5);
+  @$pb.TagNumber(6)
+  set stdin($core.String v) { $_setString(5, v); }
+  @$pb.TagNumber(6)
+  $core.bool hasStdin() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearStdin() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.Map<$core.String, $core.String> get envVars => $_getMap(6);
+
+  @$pb.TagNumber(8)
+  $core.String get runner => $_getSZ(7);
+  @$pb.TagNumber(8)
+  set runner($core.String v) { $_setString(7, v); }
+  @$pb.TagNumber(8)
+  $core.bool hasRunner() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearRunner() => clearField(8);
+
+  @$pb.TagNumber(9)
+  $1.Duration get deadline => $_getN(8);
+  @$pb.TagNumber(9)
+  set deadline($1.Duration v) { setField(9, v); }
+  @$pb.TagNumber(9)
+  $core.bool hasDeadline() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearDeadline() => clearField(9);
+  @$pb.TagNumber(9)
+  $1.Duration ensureDeadline() => $_ensure(8);
+
+  @$pb.TagNumber(10)
+  $core.String get exampleCloudPath => $_getSZ(9);
+  @$pb.TagNumber(10)
+  set exampleCloudPath($core.String v) { $_setString(9, v); }
+  @$pb.TagNumber(10)
+  $core.bool hasExampleCloudPath() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearExampleCloudPath() => clearField(10);
+
+  @$pb.TagNumber(11)
+  $core.bool get keepWorkingDir => $_getBF(10);
+  @$pb.TagNumber(11)
+  set keepWorkingDir($core.bool v) { $_setBool(10, v); }
+  @$pb.TagNumber(11)
+  $core.bool hasKeepWorkingDir() => $_has(10);
+  @$pb.TagNumber(11)
+  void clearKeepWorkingDir() => clearField(11);
+
+  @$pb.TagNumber(12)
+  $core.String get snippetId => $_getSZ(11);
+  @$pb.TagNumber(12)
+  set snippetId($core.String v) { $_setString(11, v); }
+  @$pb.TagNumber(12)
+  $core.bool hasSnippetId() => $_has(11);
+  @$pb.TagNumber(12)
+  void clearSnippetId() => clearField(12);
 }
 
 class RunCodeResponse extends $pb.GeneratedMessage {
@@ -147,42 +314,47 @@ class RunCodeResponse extends $pb.GeneratedMessage {
   void clearPipelineUuid() => clearField(1);
 }
 
-class CheckStatusRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CheckStatusRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+class RunProgramResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'RunProgramResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..e<Status>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'status', $pb.PbFieldType.OE, defaultOrMaker: Status.STATUS_UNSPECIFIED, valueOf: Status.valueOf, enumValues: Status.values)
     ..hasRequiredFields = false
   ;
 
-  CheckStatusRequest._() : super();
-  factory CheckStatusRequest({
+  RunProgramResponse._() : super();
+  factory RunProgramResponse({
     $core.String? pipelineUuid,
+    Status? status,
   }) {
     final _result = create();
     if (pipelineUuid != null) {
       _result.pipelineUuid = pipelineUuid;
     }
+    if (status != null) {
+      _result.status = status;
+    }
     return _result;
   }
-  factory CheckStatusRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory CheckStatusRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory RunProgramResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory RunProgramResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  CheckStatusRequest clone() => CheckStatusRequest()..mergeFromMessage(this);
+  RunProgramResponse clone() => RunProgramResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  CheckStatusRequest copyWith(void Function(CheckStatusRequest) updates) => super.copyWith((message) => updates(message as CheckStatusRequest)) as CheckStatusRequest; // ignore: deprecated_member_use
+  RunProgramResponse copyWith(void Function(RunProgramResponse) updates) => super.copyWith((message) => updates(message as RunProgramResponse)) as RunProgramResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static CheckStatusRequest create() => CheckStatusRequest._();
-  CheckStatusRequest createEmptyInstance() => create();
-  static $pb.PbList<CheckStatusRequest> createRepeated() => $pb.PbList<CheckStatusRequest>();
+  static RunProgramResponse create() => RunProgramResponse._();
+  RunProgramResponse createEmptyInstance() => create();
+  static $pb.PbList<RunProgramResponse> createRepeated() => $pb.PbList<RunProgramResponse>();
   @$core.pragma('dart2js:noInline')
-  static CheckStatusRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CheckStatusRequest>(create);
-  static CheckStatusRequest? _defaultInstance;
+  static RunProgramResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<RunProgramResponse>(create);
+  static RunProgramResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
   $core.String get pipelineUuid => $_getSZ(0);
@@ -192,91 +364,124 @@ class CheckStatusRequest extends $pb.GeneratedMessage {
   $core.bool hasPipelineUuid() => $_has(0);
   @$pb.TagNumber(1)
   void clearPipelineUuid() => clearField(1);
+
+  @$pb.TagNumber(2)
+  Status get status => $_getN(1);
+  @$pb.TagNumber(2)
+  set status(Status v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasStatus() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearStatus() => clearField(2);
 }
 
-class CheckStatusResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CheckStatusResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..e<Status>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'status', $pb.PbFieldType.OE, defaultOrMaker: Status.STATUS_UNSPECIFIED, valueOf: Status.valueOf, enumValues: Status.values)
+class ValidateCodeRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ValidateCodeRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'code')
+    ..e<Sdk>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
     ..hasRequiredFields = false
   ;
 
-  CheckStatusResponse._() : super();
-  factory CheckStatusResponse({
-    Status? status,
+  ValidateCodeRequest._() : super();
+  factory ValidateCodeRequest({
+    $core.String? code,
+    Sdk? sdk,
   }) {
     final _result = create();
-    if (status != null) {
-      _result.status = status;
+    if (code != null) {
+      _result.code = code;
+    }
+    if (sdk != null) {
+      _result.sdk = sdk;
     }
     return _result;
   }
-  factory CheckStatusResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory CheckStatusResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory ValidateCodeRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ValidateCodeRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  CheckStatusResponse clone() => CheckStatusResponse()..mergeFromMessage(this);
+  ValidateCodeRequest clone() => ValidateCodeRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  CheckStatusResponse copyWith(void Function(CheckStatusResponse) updates) => super.copyWith((message) => updates(message as CheckStatusResponse)) as CheckStatusResponse; // ignore: deprecated_member_use
+  ValidateCodeRequest copyWith(void Function(ValidateCodeRequest) updates) => super.copyWith((message) => updates(message as ValidateCodeRequest)) as ValidateCodeRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static CheckStatusResponse create() => CheckStatusResponse._();
-  CheckStatusResponse createEmptyInstance() => create();
-  static $pb.PbList<CheckStatusResponse> createRepeated() => $pb.PbList<CheckStatusResponse>();
+  static ValidateCodeRequest create() => ValidateCodeRequest._();
+  ValidateCodeRequest createEmptyInstance() => create();
+  static $pb.PbList<ValidateCodeRequest> createRepeated() => $pb.PbList<ValidateCodeRequest>();
   @$core.pragma('dart2js:noInline')
-  static CheckStatusResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CheckStatusResponse>(create);
-  static CheckStatusResponse? _defaultInstance;
+  static ValidateCodeRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ValidateCodeRequest>(create);
+  static ValidateCodeRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  Status get status => $_getN(0);
+  $core.String get code => $_getSZ(0);
   @$pb.TagNumber(1)
-  set status(Status v) { setField(1, v); }
+  set code($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasStatus() => $_has(0);
+  $core.bool hasCode() => $_has(0);
   @$pb.TagNumber(1)
-  void clearStatus() => clearField(1);
+  void clearCode() => clearField(1);
+
+  @$pb.TagNumber(2)
+  Sdk get sdk => $_getN(1);
+  @$pb.TagNumber(2)
+  set sdk(Sdk v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasSdk() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearSdk() => clearField(2);
 }
 
-class GetValidationOutputRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetValidationOutputRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+class ValidateCodeResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ValidateCodeResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..e<Status>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'status', $pb.PbFieldType.OE, defaultOrMaker: Status.STATUS_UNSPECIFIED, valueOf: Status.valueOf, enumValues: Status.values)
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
     ..hasRequiredFields = false
   ;
 
-  GetValidationOutputRequest._() : super();
-  factory GetValidationOutputRequest({
+  ValidateCodeResponse._() : super();
+  factory ValidateCodeResponse({
     $core.String? pipelineUuid,
+    Status? status,
+    $core.String? output,
   }) {
     final _result = create();
     if (pipelineUuid != null) {
       _result.pipelineUuid = pipelineUuid;
     }
+    if (status != null) {
+      _result.status = status;
+    }
+    if (output != null) {
+      _result.output = output;
+    }
     return _result;
   }
-  factory GetValidationOutputRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetValidationOutputRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory ValidateCodeResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ValidateCodeResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetValidationOutputRequest clone() => GetValidationOutputRequest()..mergeFromMessage(this);
+  ValidateCodeResponse clone() => ValidateCodeResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetValidationOutputRequest copyWith(void Function(GetValidationOutputRequest) updates) => super.copyWith((message) => updates(message as GetValidationOutputRequest)) as GetValidationOutputRequest; // ignore: deprecated_member_use
+  ValidateCodeResponse copyWith(void Function(ValidateCodeResponse) updates) => super.copyWith((message) => updates(message as ValidateCodeResponse)) as ValidateCodeResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetValidationOutputRequest create() => GetValidationOutputRequest._();
-  GetValidationOutputRequest createEmptyInstance() => create();
-  static $pb.PbList<GetValidationOutputRequest> createRepeated() => $pb.PbList<GetValidationOutputRequest>();
+  static ValidateCodeResponse create() => ValidateCodeResponse._();
+  ValidateCodeResponse createEmptyInstance() => create();
+  static $pb.PbList<ValidateCodeResponse> createRepeated() => $pb.PbList<ValidateCodeResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetValidationOutputRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetValidationOutputRequest>(create);
-  static GetValidationOutputRequest? _defaultInstance;
+  static ValidateCodeResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ValidateCodeResponse>(create);
+  static ValidateCodeResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
   $core.String get pipelineUuid => $_getSZ(0);
@@ -286,157 +491,239 @@ class GetValidationOutputRequest extends $pb.GeneratedMessage {
   $core.bool hasPipelineUuid() => $_has(0);
   @$pb.TagNumber(1)
   void clearPipelineUuid() => clearField(1);
+
+  @$pb.TagNumber(2)
+  Status get status => $_getN(1);
+  @$pb.TagNumber(2)
+  set status(Status v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasStatus() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearStatus() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get output => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set output($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasOutput() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearOutput() => clearField(3);
 }
 
-class GetValidationOutputResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetValidationOutputResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
+class ValidateOptionsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ValidateOptionsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..e<Sdk>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineOptions')
     ..hasRequiredFields = false
   ;
 
-  GetValidationOutputResponse._() : super();
-  factory GetValidationOutputResponse({
-    $core.String? output,
+  ValidateOptionsRequest._() : super();
+  factory ValidateOptionsRequest({
+    Sdk? sdk,
+    $core.String? pipelineOptions,
   }) {
     final _result = create();
-    if (output != null) {
-      _result.output = output;
+    if (sdk != null) {
+      _result.sdk = sdk;
+    }
+    if (pipelineOptions != null) {
+      _result.pipelineOptions = pipelineOptions;
     }
     return _result;
   }
-  factory GetValidationOutputResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetValidationOutputResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory ValidateOptionsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ValidateOptionsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetValidationOutputResponse clone() => GetValidationOutputResponse()..mergeFromMessage(this);
+  ValidateOptionsRequest clone() => ValidateOptionsRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetValidationOutputResponse copyWith(void Function(GetValidationOutputResponse) updates) => super.copyWith((message) => updates(message as GetValidationOutputResponse)) as GetValidationOutputResponse; // ignore: deprecated_member_use
+  ValidateOptionsRequest copyWith(void Function(ValidateOptionsRequest) updates) => super.copyWith((message) => updates(message as ValidateOptionsRequest)) as ValidateOptionsRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetValidationOutputResponse create() => GetValidationOutputResponse._();
-  GetValidationOutputResponse createEmptyInstance() => create();
-  static $pb.PbList<GetValidationOutputResponse> createRepeated() => $pb.PbList<GetValidationOutputResponse>();
+  static ValidateOptionsRequest create() => ValidateOptionsRequest._();
+  ValidateOptionsRequest createEmptyInstance() => create();
+  static $pb.PbList<ValidateOptionsRequest> createRepeated() => $pb.PbList<ValidateOptionsRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetValidationOutputResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetValidationOutputResponse>(create);
-  static GetValidationOutputResponse? _defaultInstance;
+  static ValidateOptionsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ValidateOptionsRequest>(create);
+  static ValidateOptionsRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get output => $_getSZ(0);
+  Sdk get sdk => $_getN(0);
   @$pb.TagNumber(1)
-  set output($core.String v) { $_setString(0, v); }
+  set sdk(Sdk v) { setField(1, v); }
   @$pb.TagNumber(1)
-  $core.bool hasOutput() => $_has(0);
+  $core.bool hasSdk() => $_has(0);
   @$pb.TagNumber(1)
-  void clearOutput() => clearField(1);
+  void clearSdk() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get pipelineOptions => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set pipelineOptions($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasPipelineOptions() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearPipelineOptions() => clearField(2);
 }
 
-class GetPreparationOutputRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPreparationOutputRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+class OptionDiagnostic extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'OptionDiagnostic', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'option')
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'name')
+    ..e<LogSeverity>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'severity', $pb.PbFieldType.OE, defaultOrMaker: LogSeverity.LOG_SEVERITY_UNSPECIFIED, valueOf: LogSeverity.valueOf, enumValues: LogSeverity.values)
+    ..aOS(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'message')
     ..hasRequiredFields = false
   ;
 
-  GetPreparationOutputRequest._() : super();
-  factory GetPreparationOutputRequest({
-    $core.String? pipelineUuid,
+  OptionDiagnostic._() : super();
+  factory OptionDiagnostic({
+    $core.String? option,
+    $core.String? name,
+    LogSeverity? severity,
+    $core.String? message,
   }) {
     final _result = create();
-    if (pipelineUuid != null) {
-      _result.pipelineUuid = pipelineUuid;
+    if (option != null) {
+      _result.option = option;
+    }
+    if (name != null) {
+      _result.name = name;
+    }
+    if (severity != null) {
+      _result.severity = severity;
+    }
+    if (message != null) {
+      _result.message = message;
     }
     return _result;
   }
-  factory GetPreparationOutputRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPreparationOutputRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory OptionDiagnostic.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory OptionDiagnostic.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPreparationOutputRequest clone() => GetPreparationOutputRequest()..mergeFromMessage(this);
+  OptionDiagnostic clone() => OptionDiagnostic()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPreparationOutputRequest copyWith(void Function(GetPreparationOutputRequest) updates) => super.copyWith((message) => updates(message as GetPreparationOutputRequest)) as GetPreparationOutputRequest; // ignore: deprecated_member_use
+  OptionDiagnostic copyWith(void Function(OptionDiagnostic) updates) => super.copyWith((message) => updates(message as OptionDiagnostic)) as OptionDiagnostic; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPreparationOutputRequest create() => GetPreparationOutputRequest._();
-  GetPreparationOutputRequest createEmptyInstance() => create();
-  static $pb.PbList<GetPreparationOutputRequest> createRepeated() => $pb.PbList<GetPreparationOutputRequest>();
+  static OptionDiagnostic create() => OptionDiagnostic._();
+  OptionDiagnostic createEmptyInstance() => create();
+  static $pb.PbList<OptionDiagnostic> createRepeated() => $pb.PbList<OptionDiagnostic>();
   @$core.pragma('dart2js:noInline')
-  static GetPreparationOutputRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPreparationOutputRequest>(create);
-  static GetPreparationOutputRequest? _defaultInstance;
+  static OptionDiagnostic getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<OptionDiagnostic>(create);
+  static OptionDiagnostic? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get pipelineUuid => $_getSZ(0);
+  $core.String get option => $_getSZ(0);
   @$pb.TagNumber(1)
-  set pipelineUuid($core.String v) { $_setString(0, v); }
+  set option($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasPipelineUuid() => $_has(0);
+  $core.bool hasOption() => $_has(0);
   @$pb.TagNumber(1)
-  void clearPipelineUuid() => clearField(1);
+  void clearOption() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get name => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set name($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasName() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearName() => clearField(2);
+
+  @$pb.TagNumber(3)
+  LogSeverity get severity => $_getN(2);
+  @$pb.TagNumber(3)
+  set severity(LogSeverity v) { setField(3, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasSeverity() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearSeverity() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.String get message => $_getSZ(3);
+  @$pb.TagNumber(4)
+  set message($core.String v) { $_setString(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasMessage() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearMessage() => clearField(4);
 }
 
-class GetPreparationOutputResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPreparationOutputResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
+class ValidateOptionsResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ValidateOptionsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOB(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'valid')
+    ..pc<OptionDiagnostic>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'diagnostics', $pb.PbFieldType.PM, subBuilder: OptionDiagnostic.create)
     ..hasRequiredFields = false
   ;
 
-  GetPreparationOutputResponse._() : super();
-  factory GetPreparationOutputResponse({
-    $core.String? output,
+  ValidateOptionsResponse._() : super();
+  factory ValidateOptionsResponse({
+    $core.bool? valid,
+    $core.Iterable<OptionDiagnostic>? diagnostics,
   }) {
     final _result = create();
-    if (output != null) {
-      _result.output = output;
+    if (valid != null) {
+      _result.valid = valid;
+    }
+    if (diagnostics != null) {
+      _result.diagnostics.addAll(diagnostics);
     }
     return _result;
   }
-  factory GetPreparationOutputResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPreparationOutputResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory ValidateOptionsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ValidateOptionsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPreparationOutputResponse clone() => GetPreparationOutputResponse()..mergeFromMessage(this);
+  ValidateOptionsResponse clone() => ValidateOptionsResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPreparationOutputResponse copyWith(void Function(GetPreparationOutputResponse) updates) => super.copyWith((message) => updates(message as GetPreparationOutputResponse)) as GetPreparationOutputResponse; // ignore: deprecated_member_use
+  ValidateOptionsResponse copyWith(void Function(ValidateOptionsResponse) updates) => super.copyWith((message) => updates(message as ValidateOptionsResponse)) as ValidateOptionsResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPreparationOutputResponse create() => GetPreparationOutputResponse._();
-  GetPreparationOutputResponse createEmptyInstance() => create();
-  static $pb.PbList<GetPreparationOutputResponse> createRepeated() => $pb.PbList<GetPreparationOutputResponse>();
+  static ValidateOptionsResponse create() => ValidateOptionsResponse._();
+  ValidateOptionsResponse createEmptyInstance() => create();
+  static $pb.PbList<ValidateOptionsResponse> createRepeated() => $pb.PbList<ValidateOptionsResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetPreparationOutputResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPreparationOutputResponse>(create);
-  static GetPreparationOutputResponse? _defaultInstance;
+  static ValidateOptionsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ValidateOptionsResponse>(create);
+  static ValidateOptionsResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get output => $_getSZ(0);
+  $core.bool get valid => $_getBF(0);
   @$pb.TagNumber(1)
-  set output($core.String v) { $_setString(0, v); }
+  set valid($core.bool v) { $_setBool(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasOutput() => $_has(0);
+  $core.bool hasValid() => $_has(0);
   @$pb.TagNumber(1)
-  void clearOutput() => clearField(1);
+  void clearValid() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<OptionDiagnostic> get diagnostics => $_getList(1);
 }
 
-class GetCompileOutputRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetCompileOutputRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+class CheckStatusRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CheckStatusRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
     ..hasRequiredFields = false
   ;
 
-  GetCompileOutputRequest._() : super();
-  factory GetCompileOutputRequest({
+  CheckStatusRequest._() : super();
+  factory CheckStatusRequest({
     $core.String? pipelineUuid,
   }) {
     final _result = create();
@@ -445,26 +732,26 @@ class GetCompileOutputRequest extends $pb.GeneratedMessage {
     }
     return _result;
   }
-  factory GetCompileOutputRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetCompileOutputRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory CheckStatusRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory CheckStatusRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetCompileOutputRequest clone() => GetCompileOutputRequest()..mergeFromMessage(this);
+  CheckStatusRequest clone() => CheckStatusRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetCompileOutputRequest copyWith(void Function(GetCompileOutputRequest) updates) => super.copyWith((message) => updates(message as GetCompileOutputRequest)) as GetCompileOutputRequest; // ignore: deprecated_member_use
+  CheckStatusRequest copyWith(void Function(CheckStatusRequest) updates) => super.copyWith((message) => updates(message as CheckStatusRequest)) as CheckStatusRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetCompileOutputRequest create() => GetCompileOutputRequest._();
-  GetCompileOutputRequest createEmptyInstance() => create();
-  static $pb.PbList<GetCompileOutputRequest> createRepeated() => $pb.PbList<GetCompileOutputRequest>();
+  static CheckStatusRequest create() => CheckStatusRequest._();
+  CheckStatusRequest createEmptyInstance() => create();
+  static $pb.PbList<CheckStatusRequest> createRepeated() => $pb.PbList<CheckStatusRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetCompileOutputRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetCompileOutputRequest>(create);
-  static GetCompileOutputRequest? _defaultInstance;
+  static CheckStatusRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CheckStatusRequest>(create);
+  static CheckStatusRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
   $core.String get pipelineUuid => $_getSZ(0);
@@ -476,61 +763,61 @@ class GetCompileOutputRequest extends $pb.GeneratedMessage {
   void clearPipelineUuid() => clearField(1);
 }
 
-class GetCompileOutputResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetCompileOutputResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
+class CheckStatusResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CheckStatusResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..e<Status>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'status', $pb.PbFieldType.OE, defaultOrMaker: Status.STATUS_UNSPECIFIED, valueOf: Status.valueOf, enumValues: Status.values)
     ..hasRequiredFields = false
   ;
 
-  GetCompileOutputResponse._() : super();
-  factory GetCompileOutputResponse({
-    $core.String? output,
+  CheckStatusResponse._() : super();
+  factory CheckStatusResponse({
+    Status? status,
   }) {
     final _result = create();
-    if (output != null) {
-      _result.output = output;
+    if (status != null) {
+      _result.status = status;
     }
     return _result;
   }
-  factory GetCompileOutputResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetCompileOutputResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory CheckStatusResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory CheckStatusResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetCompileOutputResponse clone() => GetCompileOutputResponse()..mergeFromMessage(this);
+  CheckStatusResponse clone() => CheckStatusResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetCompileOutputResponse copyWith(void Function(GetCompileOutputResponse) updates) => super.copyWith((message) => updates(message as GetCompileOutputResponse)) as GetCompileOutputResponse; // ignore: deprecated_member_use
+  CheckStatusResponse copyWith(void Function(CheckStatusResponse) updates) => super.copyWith((message) => updates(message as CheckStatusResponse)) as CheckStatusResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetCompileOutputResponse create() => GetCompileOutputResponse._();
-  GetCompileOutputResponse createEmptyInstance() => create();
-  static $pb.PbList<GetCompileOutputResponse> createRepeated() => $pb.PbList<GetCompileOutputResponse>();
+  static CheckStatusResponse create() => CheckStatusResponse._();
+  CheckStatusResponse createEmptyInstance() => create();
+  static $pb.PbList<CheckStatusResponse> createRepeated() => $pb.PbList<CheckStatusResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetCompileOutputResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetCompileOutputResponse>(create);
-  static GetCompileOutputResponse? _defaultInstance;
+  static CheckStatusResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CheckStatusResponse>(create);
+  static CheckStatusResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get output => $_getSZ(0);
+  Status get status => $_getN(0);
   @$pb.TagNumber(1)
-  set output($core.String v) { $_setString(0, v); }
+  set status(Status v) { setField(1, v); }
   @$pb.TagNumber(1)
-  $core.bool hasOutput() => $_has(0);
+  $core.bool hasStatus() => $_has(0);
   @$pb.TagNumber(1)
-  void clearOutput() => clearField(1);
+  void clearStatus() => clearField(1);
 }
 
-class GetRunOutputRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRunOutputRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+class GetValidationOutputRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetValidationOutputRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
     ..hasRequiredFields = false
   ;
 
-  GetRunOutputRequest._() : super();
-  factory GetRunOutputRequest({
+  GetValidationOutputRequest._() : super();
+  factory GetValidationOutputRequest({
     $core.String? pipelineUuid,
   }) {
     final _result = create();
@@ -539,26 +826,26 @@ class GetRunOutputRequest extends $pb.GeneratedMessage {
     }
     return _result;
   }
-  factory GetRunOutputRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetRunOutputRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetValidationOutputRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetValidationOutputRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetRunOutputRequest clone() => GetRunOutputRequest()..mergeFromMessage(this);
+  GetValidationOutputRequest clone() => GetValidationOutputRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetRunOutputRequest copyWith(void Function(GetRunOutputRequest) updates) => super.copyWith((message) => updates(message as GetRunOutputRequest)) as GetRunOutputRequest; // ignore: deprecated_member_use
+  GetValidationOutputRequest copyWith(void Function(GetValidationOutputRequest) updates) => super.copyWith((message) => updates(message as GetValidationOutputRequest)) as GetValidationOutputRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetRunOutputRequest create() => GetRunOutputRequest._();
-  GetRunOutputRequest createEmptyInstance() => create();
-  static $pb.PbList<GetRunOutputRequest> createRepeated() => $pb.PbList<GetRunOutputRequest>();
+  static GetValidationOutputRequest create() => GetValidationOutputRequest._();
+  GetValidationOutputRequest createEmptyInstance() => create();
+  static $pb.PbList<GetValidationOutputRequest> createRepeated() => $pb.PbList<GetValidationOutputRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetRunOutputRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRunOutputRequest>(create);
-  static GetRunOutputRequest? _defaultInstance;
+  static GetValidationOutputRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetValidationOutputRequest>(create);
+  static GetValidationOutputRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
   $core.String get pipelineUuid => $_getSZ(0);
@@ -570,14 +857,14 @@ class GetRunOutputRequest extends $pb.GeneratedMessage {
   void clearPipelineUuid() => clearField(1);
 }
 
-class GetRunOutputResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRunOutputResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+class GetValidationOutputResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetValidationOutputResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
     ..hasRequiredFields = false
   ;
 
-  GetRunOutputResponse._() : super();
-  factory GetRunOutputResponse({
+  GetValidationOutputResponse._() : super();
+  factory GetValidationOutputResponse({
     $core.String? output,
   }) {
     final _result = create();
@@ -586,26 +873,26 @@ class GetRunOutputResponse extends $pb.GeneratedMessage {
     }
     return _result;
   }
-  factory GetRunOutputResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetRunOutputResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetValidationOutputResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetValidationOutputResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetRunOutputResponse clone() => GetRunOutputResponse()..mergeFromMessage(this);
+  GetValidationOutputResponse clone() => GetValidationOutputResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetRunOutputResponse copyWith(void Function(GetRunOutputResponse) updates) => super.copyWith((message) => updates(message as GetRunOutputResponse)) as GetRunOutputResponse; // ignore: deprecated_member_use
+  GetValidationOutputResponse copyWith(void Function(GetValidationOutputResponse) updates) => super.copyWith((message) => updates(message as GetValidationOutputResponse)) as GetValidationOutputResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetRunOutputResponse create() => GetRunOutputResponse._();
-  GetRunOutputResponse createEmptyInstance() => create();
-  static $pb.PbList<GetRunOutputResponse> createRepeated() => $pb.PbList<GetRunOutputResponse>();
+  static GetValidationOutputResponse create() => GetValidationOutputResponse._();
+  GetValidationOutputResponse createEmptyInstance() => create();
+  static $pb.PbList<GetValidationOutputResponse> createRepeated() => $pb.PbList<GetValidationOutputResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetRunOutputResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRunOutputResponse>(create);
-  static GetRunOutputResponse? _defaultInstance;
+  static GetValidationOutputResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetValidationOutputResponse>(create);
+  static GetValidationOutputResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
   $core.String get output => $_getSZ(0);
@@ -617,14 +904,14 @@ class GetRunOutputResponse extends $pb.GeneratedMessage {
   void clearOutput() => clearField(1);
 }
 
-class GetRunErrorRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRunErrorRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+class GetPreparationOutputRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPreparationOutputRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
     ..hasRequiredFields = false
   ;
 
-  GetRunErrorRequest._() : super();
-  factory GetRunErrorRequest({
+  GetPreparationOutputRequest._() : super();
+  factory GetPreparationOutputRequest({
     $core.String? pipelineUuid,
   }) {
     final _result = create();
@@ -633,26 +920,26 @@ class GetRunErrorRequest extends $pb.GeneratedMessage {
     }
     return _result;
   }
-  factory GetRunErrorRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetRunErrorRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetPreparationOutputRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPreparationOutputRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetRunErrorRequest clone() => GetRunErrorRequest()..mergeFromMessage(this);
+  GetPreparationOutputRequest clone() => GetPreparationOutputRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetRunErrorRequest copyWith(void Function(GetRunErrorRequest) updates) => super.copyWith((message) => updates(message as GetRunErrorRequest)) as GetRunErrorRequest; // ignore: deprecated_member_use
+  GetPreparationOutputRequest copyWith(void Function(GetPreparationOutputRequest) updates) => super.copyWith((message) => updates(message as GetPreparationOutputRequest)) as GetPreparationOutputRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetRunErrorRequest create() => GetRunErrorRequest._();
-  GetRunErrorRequest createEmptyInstance() => create();
-  static $pb.PbList<GetRunErrorRequest> createRepeated() => $pb.PbList<GetRunErrorRequest>();
+  static GetPreparationOutputRequest create() => GetPreparationOutputRequest._();
+  GetPreparationOutputRequest createEmptyInstance() => create();
+  static $pb.PbList<GetPreparationOutputRequest> createRepeated() => $pb.PbList<GetPreparationOutputRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetRunErrorRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRunErrorRequest>(create);
-  static GetRunErrorRequest? _defaultInstance;
+  static GetPreparationOutputRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPreparationOutputRequest>(create);
+  static GetPreparationOutputRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
   $core.String get pipelineUuid => $_getSZ(0);
@@ -664,14 +951,14 @@ class GetRunErrorRequest extends $pb.GeneratedMessage {
   void clearPipelineUuid() => clearField(1);
 }
 
-class GetRunErrorResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRunErrorResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+class GetPreparationOutputResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPreparationOutputResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
     ..hasRequiredFields = false
   ;
 
-  GetRunErrorResponse._() : super();
-  factory GetRunErrorResponse({
+  GetPreparationOutputResponse._() : super();
+  factory GetPreparationOutputResponse({
     $core.String? output,
   }) {
     final _result = create();
@@ -680,26 +967,26 @@ class GetRunErrorResponse extends $pb.GeneratedMessage {
     }
     return _result;
   }
-  factory GetRunErrorResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetRunErrorResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetPreparationOutputResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPreparationOutputResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetRunErrorResponse clone() => GetRunErrorResponse()..mergeFromMessage(this);
+  GetPreparationOutputResponse clone() => GetPreparationOutputResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetRunErrorResponse copyWith(void Function(GetRunErrorResponse) updates) => super.copyWith((message) => updates(message as GetRunErrorResponse)) as GetRunErrorResponse; // ignore: deprecated_member_use
+  GetPreparationOutputResponse copyWith(void Function(GetPreparationOutputResponse) updates) => super.copyWith((message) => updates(message as GetPreparationOutputResponse)) as GetPreparationOutputResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetRunErrorResponse create() => GetRunErrorResponse._();
-  GetRunErrorResponse createEmptyInstance() => create();
-  static $pb.PbList<GetRunErrorResponse> createRepeated() => $pb.PbList<GetRunErrorResponse>();
+  static GetPreparationOutputResponse create() => GetPreparationOutputResponse._();
+  GetPreparationOutputResponse createEmptyInstance() => create();
+  static $pb.PbList<GetPreparationOutputResponse> createRepeated() => $pb.PbList<GetPreparationOutputResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetRunErrorResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRunErrorResponse>(create);
-  static GetRunErrorResponse? _defaultInstance;
+  static GetPreparationOutputResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPreparationOutputResponse>(create);
+  static GetPreparationOutputResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
   $core.String get output => $_getSZ(0);
@@ -711,14 +998,14 @@ class GetRunErrorResponse extends $pb.GeneratedMessage {
   void clearOutput() => clearField(1);
 }
 
-class GetLogsRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetLogsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+class GetCompileOutputRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetCompileOutputRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
     ..hasRequiredFields = false
   ;
 
-  GetLogsRequest._() : super();
-  factory GetLogsRequest({
+  GetCompileOutputRequest._() : super();
+  factory GetCompileOutputRequest({
     $core.String? pipelineUuid,
   }) {
     final _result = create();
@@ -727,26 +1014,26 @@ class GetLogsRequest extends $pb.GeneratedMessage {
     }
     return _result;
   }
-  factory GetLogsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetLogsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetCompileOutputRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetCompileOutputRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetLogsRequest clone() => GetLogsRequest()..mergeFromMessage(this);
+  GetCompileOutputRequest clone() => GetCompileOutputRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetLogsRequest copyWith(void Function(GetLogsRequest) updates) => super.copyWith((message) => updates(message as GetLogsRequest)) as GetLogsRequest; // ignore: deprecated_member_use
+  GetCompileOutputRequest copyWith(void Function(GetCompileOutputRequest) updates) => super.copyWith((message) => updates(message as GetCompileOutputRequest)) as GetCompileOutputRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetLogsRequest create() => GetLogsRequest._();
-  GetLogsRequest createEmptyInstance() => create();
-  static $pb.PbList<GetLogsRequest> createRepeated() => $pb.PbList<GetLogsRequest>();
+  static GetCompileOutputRequest create() => GetCompileOutputRequest._();
+  GetCompileOutputRequest createEmptyInstance() => create();
+  static $pb.PbList<GetCompileOutputRequest> createRepeated() => $pb.PbList<GetCompileOutputRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetLogsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetLogsRequest>(create);
-  static GetLogsRequest? _defaultInstance;
+  static GetCompileOutputRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetCompileOutputRequest>(create);
+  static GetCompileOutputRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
   $core.String get pipelineUuid => $_getSZ(0);
@@ -758,491 +1045,2464 @@ class GetLogsRequest extends $pb.GeneratedMessage {
   void clearPipelineUuid() => clearField(1);
 }
 
-class GetLogsResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetLogsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
+class CompileDiagnostic extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CompileDiagnostic', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'file')
+    ..a<$core.int>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'line', $pb.PbFieldType.O3)
+    ..a<$core.int>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'column', $pb.PbFieldType.O3)
+    ..e<LogSeverity>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'severity', $pb.PbFieldType.OE, defaultOrMaker: LogSeverity.LOG_SEVERITY_UNSPECIFIED, valueOf: LogSeverity.valueOf, enumValues: LogSeverity.values)
+    ..aOS(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'message')
+    ..aOS(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'raw')
     ..hasRequiredFields = false
   ;
 
-  GetLogsResponse._() : super();
-  factory GetLogsResponse({
-    $core.String? output,
+  CompileDiagnostic._() : super();
+  factory CompileDiagnostic({
+    $core.String? file,
+    $core.int? line,
+    $core.int? column,
+    LogSeverity? severity,
+    $core.String? message,
+    $core.String? raw,
   }) {
     final _result = create();
-    if (output != null) {
-      _result.output = output;
+    if (file != null) {
+      _result.file = file;
+    }
+    if (line != null) {
+      _result.line = line;
+    }
+    if (column != null) {
+      _result.column = column;
+    }
+    if (severity != null) {
+      _result.severity = severity;
+    }
+    if (message != null) {
+      _result.message = message;
+    }
+    if (raw != null) {
+      _result.raw = raw;
     }
     return _result;
   }
-  factory GetLogsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetLogsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory CompileDiagnostic.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory CompileDiagnostic.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetLogsResponse clone() => GetLogsResponse()..mergeFromMessage(this);
+  CompileDiagnostic clone() => CompileDiagnostic()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetLogsResponse copyWith(void Function(GetLogsResponse) updates) => super.copyWith((message) => updates(message as GetLogsResponse)) as GetLogsResponse; // ignore: deprecated_member_use
+  CompileDiagnostic copyWith(void Function(CompileDiagnostic) updates) => super.copyWith((message) => updates(message as CompileDiagnostic)) as CompileDiagnostic; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetLogsResponse create() => GetLogsResponse._();
-  GetLogsResponse createEmptyInstance() => create();
-  static $pb.PbList<GetLogsResponse> createRepeated() => $pb.PbList<GetLogsResponse>();
+  static CompileDiagnostic create() => CompileDiagnostic._();
+  CompileDiagnostic createEmptyInstance() => create();
+  static $pb.PbList<CompileDiagnostic> createRepeated() => $pb.PbList<CompileDiagnostic>();
   @$core.pragma('dart2js:noInline')
-  static GetLogsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetLogsResponse>(create);
-  static GetLogsResponse? _defaultInstance;
+  static CompileDiagnostic getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CompileDiagnostic>(create);
+  static CompileDiagnostic? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get output => $_getSZ(0);
+  $core.String get file => $_getSZ(0);
   @$pb.TagNumber(1)
-  set output($core.String v) { $_setString(0, v); }
+  set file($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasOutput() => $_has(0);
+  $core.bool hasFile() => $_has(0);
   @$pb.TagNumber(1)
-  void clearOutput() => clearField(1);
-}
+  void clearFile() => clearField(1);
 
-class GetGraphRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetGraphRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
-    ..hasRequiredFields = false
-  ;
+  @$pb.TagNumber(2)
+  $core.int get line => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set line($core.int v) { $_setSignedInt32(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasLine() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearLine() => clearField(2);
 
-  GetGraphRequest._() : super();
-  factory GetGraphRequest({
-    $core.String? pipelineUuid,
-  }) {
-    final _result = create();
-    if (pipelineUuid != null) {
-      _result.pipelineUuid = pipelineUuid;
-    }
-    return _result;
-  }
-  factory GetGraphRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetGraphRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
-  @$core.Deprecated(
-  'Using this can add significant overhead to your binary. '
-  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  @$pb.TagNumber(3)
+  $core.int get column => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set column($core.int v) { $_setSignedInt32(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasColumn() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearColumn() => clearField(3);
+
+  @$pb.TagNumber(4)
+  LogSeverity get severity => $_getN(3);
+  @$pb.TagNumber(4)
+  set severity(LogSeverity v) { setField(4, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasSeverity() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearSeverity() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.String get message => $_getSZ(4);
+  @$pb.TagNumber(5)
+  set message($core.String v) { $_setString(4, v); }
+  @$pb.TagNumber(5)
+  $core.bool hasMessage() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearMessage() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.String get raw => $_getSZ(5);
+  @$pb.TagNumber(6)
+  set raw($core.String v) { $_setString(5, v); }
+  @$pb.TagNumber(6)
+  $core.bool hasRaw() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearRaw() => clearField(6);
+}
+
+class GetCompileOutputResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetCompileOutputResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
+    ..pc<CompileDiagnostic>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'diagnostics', $pb.PbFieldType.PM, subBuilder: CompileDiagnostic.create)
+    ..hasRequiredFields = false
+  ;
+
+  GetCompileOutputResponse._() : super();
+  factory GetCompileOutputResponse({
+    $core.String? output,
+    $core.Iterable<CompileDiagnostic>? diagnostics,
+  }) {
+    final _result = create();
+    if (output != null) {
+      _result.output = output;
+    }
+    if (diagnostics != null) {
+      _result.diagnostics.addAll(diagnostics);
+    }
+    return _result;
+  }
+  factory GetCompileOutputResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetCompileOutputResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetCompileOutputResponse clone() => GetCompileOutputResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetCompileOutputResponse copyWith(void Function(GetCompileOutputResponse) updates) => super.copyWith((message) => updates(message as GetCompileOutputResponse)) as GetCompileOutputResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetCompileOutputResponse create() => GetCompileOutputResponse._();
+  GetCompileOutputResponse createEmptyInstance() => create();
+  static $pb.PbList<GetCompileOutputResponse> createRepeated() => $pb.PbList<GetCompileOutputResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetCompileOutputResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetCompileOutputResponse>(create);
+  static GetCompileOutputResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get output => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set output($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasOutput() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearOutput() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<CompileDiagnostic> get diagnostics => $_getList(1);
+}
+
+class GetExecutionCommandRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetExecutionCommandRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..hasRequiredFields = false
+  ;
+
+  GetExecutionCommandRequest._() : super();
+  factory GetExecutionCommandRequest({
+    $core.String? pipelineUuid,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    return _result;
+  }
+  factory GetExecutionCommandRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetExecutionCommandRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetExecutionCommandRequest clone() => GetExecutionCommandRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetExecutionCommandRequest copyWith(void Function(GetExecutionCommandRequest) updates) => super.copyWith((message) => updates(message as GetExecutionCommandRequest)) as GetExecutionCommandRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetExecutionCommandRequest create() => GetExecutionCommandRequest._();
+  GetExecutionCommandRequest createEmptyInstance() => create();
+  static $pb.PbList<GetExecutionCommandRequest> createRepeated() => $pb.PbList<GetExecutionCommandRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetExecutionCommandRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetExecutionCommandRequest>(create);
+  static GetExecutionCommandRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+}
+
+class GetExecutionCommandResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetExecutionCommandResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'command')
+    ..hasRequiredFields = false
+  ;
+
+  GetExecutionCommandResponse._() : super();
+  factory GetExecutionCommandResponse({
+    $core.String? command,
+  }) {
+    final _result = create();
+    if (command != null) {
+      _result.command = command;
+    }
+    return _result;
+  }
+  factory GetExecutionCommandResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetExecutionCommandResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetExecutionCommandResponse clone() => GetExecutionCommandResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetExecutionCommandResponse copyWith(void Function(GetExecutionCommandResponse) updates) => super.copyWith((message) => updates(message as GetExecutionCommandResponse)) as GetExecutionCommandResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetExecutionCommandResponse create() => GetExecutionCommandResponse._();
+  GetExecutionCommandResponse createEmptyInstance() => create();
+  static $pb.PbList<GetExecutionCommandResponse> createRepeated() => $pb.PbList<GetExecutionCommandResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetExecutionCommandResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetExecutionCommandResponse>(create);
+  static GetExecutionCommandResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get command => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set command($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasCommand() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCommand() => clearField(1);
+}
+
+class GetRunOutputRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRunOutputRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..hasRequiredFields = false
+  ;
+
+  GetRunOutputRequest._() : super();
+  factory GetRunOutputRequest({
+    $core.String? pipelineUuid,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    return _result;
+  }
+  factory GetRunOutputRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetRunOutputRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetRunOutputRequest clone() => GetRunOutputRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetRunOutputRequest copyWith(void Function(GetRunOutputRequest) updates) => super.copyWith((message) => updates(message as GetRunOutputRequest)) as GetRunOutputRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetRunOutputRequest create() => GetRunOutputRequest._();
+  GetRunOutputRequest createEmptyInstance() => create();
+  static $pb.PbList<GetRunOutputRequest> createRepeated() => $pb.PbList<GetRunOutputRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetRunOutputRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRunOutputRequest>(create);
+  static GetRunOutputRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+}
+
+class GetRunOutputResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRunOutputResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
+    ..hasRequiredFields = false
+  ;
+
+  GetRunOutputResponse._() : super();
+  factory GetRunOutputResponse({
+    $core.String? output,
+  }) {
+    final _result = create();
+    if (output != null) {
+      _result.output = output;
+    }
+    return _result;
+  }
+  factory GetRunOutputResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetRunOutputResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetRunOutputResponse clone() => GetRunOutputResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetRunOutputResponse copyWith(void Function(GetRunOutputResponse) updates) => super.copyWith((message) => updates(message as GetRunOutputResponse)) as GetRunOutputResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetRunOutputResponse create() => GetRunOutputResponse._();
+  GetRunOutputResponse createEmptyInstance() => create();
+  static $pb.PbList<GetRunOutputResponse> createRepeated() => $pb.PbList<GetRunOutputResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetRunOutputResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRunOutputResponse>(create);
+  static GetRunOutputResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get output => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set output($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasOutput() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearOutput() => clearField(1);
+}
+
+class GetRunErrorRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRunErrorRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..hasRequiredFields = false
+  ;
+
+  GetRunErrorRequest._() : super();
+  factory GetRunErrorRequest({
+    $core.String? pipelineUuid,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    return _result;
+  }
+  factory GetRunErrorRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetRunErrorRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetRunErrorRequest clone() => GetRunErrorRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetRunErrorRequest copyWith(void Function(GetRunErrorRequest) updates) => super.copyWith((message) => updates(message as GetRunErrorRequest)) as GetRunErrorRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetRunErrorRequest create() => GetRunErrorRequest._();
+  GetRunErrorRequest createEmptyInstance() => create();
+  static $pb.PbList<GetRunErrorRequest> createRepeated() => $pb.PbList<GetRunErrorRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetRunErrorRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRunErrorRequest>(create);
+  static GetRunErrorRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+}
+
+class GetRunErrorResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRunErrorResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
+    ..hasRequiredFields = false
+  ;
+
+  GetRunErrorResponse._() : super();
+  factory GetRunErrorResponse({
+    $core.String? output,
+  }) {
+    final _result = create();
+    if (output != null) {
+      _result.output = output;
+    }
+    return _result;
+  }
+  factory GetRunErrorResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetRunErrorResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetRunErrorResponse clone() => GetRunErrorResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetRunErrorResponse copyWith(void Function(GetRunErrorResponse) updates) => super.copyWith((message) => updates(message as GetRunErrorResponse)) as GetRunErrorResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetRunErrorResponse create() => GetRunErrorResponse._();
+  GetRunErrorResponse createEmptyInstance() => create();
+  static $pb.PbList<GetRunErrorResponse> createRepeated() => $pb.PbList<GetRunErrorResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetRunErrorResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRunErrorResponse>(create);
+  static GetRunErrorResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get output => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set output($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasOutput() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearOutput() => clearField(1);
+}
+
+class GetLogsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetLogsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..hasRequiredFields = false
+  ;
+
+  GetLogsRequest._() : super();
+  factory GetLogsRequest({
+    $core.String? pipelineUuid,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    return _result;
+  }
+  factory GetLogsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetLogsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetLogsRequest clone() => GetLogsRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetLogsRequest copyWith(void Function(GetLogsRequest) updates) => super.copyWith((message) => updates(message as GetLogsRequest)) as GetLogsRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetLogsRequest create() => GetLogsRequest._();
+  GetLogsRequest createEmptyInstance() => create();
+  static $pb.PbList<GetLogsRequest> createRepeated() => $pb.PbList<GetLogsRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetLogsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetLogsRequest>(create);
+  static GetLogsRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+}
+
+class GetLogsResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetLogsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
+    ..hasRequiredFields = false
+  ;
+
+  GetLogsResponse._() : super();
+  factory GetLogsResponse({
+    $core.String? output,
+  }) {
+    final _result = create();
+    if (output != null) {
+      _result.output = output;
+    }
+    return _result;
+  }
+  factory GetLogsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetLogsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetLogsResponse clone() => GetLogsResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetLogsResponse copyWith(void Function(GetLogsResponse) updates) => super.copyWith((message) => updates(message as GetLogsResponse)) as GetLogsResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetLogsResponse create() => GetLogsResponse._();
+  GetLogsResponse createEmptyInstance() => create();
+  static $pb.PbList<GetLogsResponse> createRepeated() => $pb.PbList<GetLogsResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetLogsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetLogsResponse>(create);
+  static GetLogsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get output => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set output($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasOutput() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearOutput() => clearField(1);
+}
+
+class GetLogEntriesRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetLogEntriesRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..e<LogSeverity>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'minSeverity', $pb.PbFieldType.OE, defaultOrMaker: LogSeverity.LOG_SEVERITY_UNSPECIFIED, valueOf: LogSeverity.valueOf, enumValues: LogSeverity.values)
+    ..aOM<$2.Timestamp>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'startTime', subBuilder: $2.Timestamp.create)
+    ..aOM<$2.Timestamp>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'endTime', subBuilder: $2.Timestamp.create)
+    ..hasRequiredFields = false
+  ;
+
+  GetLogEntriesRequest._() : super();
+  factory GetLogEntriesRequest({
+    $core.String? pipelineUuid,
+    LogSeverity? minSeverity,
+    $2.Timestamp? startTime,
+    $2.Timestamp? endTime,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    if (minSeverity != null) {
+      _result.minSeverity = minSeverity;
+    }
+    if (startTime != null) {
+      _result.startTime = startTime;
+    }
+    if (endTime != null) {
+      _result.endTime = endTime;
+    }
+    return _result;
+  }
+  factory GetLogEntriesRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetLogEntriesRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetLogEntriesRequest clone() => GetLogEntriesRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetLogEntriesRequest copyWith(void Function(GetLogEntriesRequest) updates) => super.copyWith((message) => updates(message as GetLogEntriesRequest)) as GetLogEntriesRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetLogEntriesRequest create() => GetLogEntriesRequest._();
+  GetLogEntriesRequest createEmptyInstance() => create();
+  static $pb.PbList<GetLogEntriesRequest> createRepeated() => $pb.PbList<GetLogEntriesRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetLogEntriesRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetLogEntriesRequest>(create);
+  static GetLogEntriesRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+
+  @$pb.TagNumber(2)
+  LogSeverity get minSeverity => $_getN(1);
+  @$pb.TagNumber(2)
+  set minSeverity(LogSeverity v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasMinSeverity() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearMinSeverity() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $2.Timestamp get startTime => $_getN(2);
+  @$pb.TagNumber(3)
+  set startTime($2.Timestamp v) { setField(3, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasStartTime() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearStartTime() => clearField(3);
+  @$pb.TagNumber(3)
+  $2.Timestamp ensureStartTime() => $_ensure(2);
+
+  @$pb.TagNumber(4)
+  $2.Timestamp get endTime => $_getN(3);
+  @$pb.TagNumber(4)
+  set endTime($2.Timestamp v) { setField(4, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasEndTime() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearEndTime() => clearField(4);
+  @$pb.TagNumber(4)
+  $2.Timestamp ensureEndTime() => $_ensure(3);
+}
+
+class LogEntry extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'LogEntry', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..e<LogSeverity>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'severity', $pb.PbFieldType.OE, defaultOrMaker: LogSeverity.LOG_SEVERITY_UNSPECIFIED, valueOf: LogSeverity.valueOf, enumValues: LogSeverity.values)
+    ..aOM<$2.Timestamp>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'timestamp', subBuilder: $2.Timestamp.create)
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'message')
+    ..hasRequiredFields = false
+  ;
+
+  LogEntry._() : super();
+  factory LogEntry({
+    LogSeverity? severity,
+    $2.Timestamp? timestamp,
+    $core.String? message,
+  }) {
+    final _result = create();
+    if (severity != null) {
+      _result.severity = severity;
+    }
+    if (timestamp != null) {
+      _result.timestamp = timestamp;
+    }
+    if (message != null) {
+      _result.message = message;
+    }
+    return _result;
+  }
+  factory LogEntry.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory LogEntry.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  LogEntry clone() => LogEntry()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  LogEntry copyWith(void Function(LogEntry) updates) => super.copyWith((message) => updates(message as LogEntry)) as LogEntry; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static LogEntry create() => LogEntry._();
+  LogEntry createEmptyInstance() => create();
+  static $pb.PbList<LogEntry> createRepeated() => $pb.PbList<LogEntry>();
+  @$core.pragma('dart2js:noInline')
+  static LogEntry getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<LogEntry>(create);
+  static LogEntry? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  LogSeverity get severity => $_getN(0);
+  @$pb.TagNumber(1)
+  set severity(LogSeverity v) { setField(1, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasSeverity() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearSeverity() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $2.Timestamp get timestamp => $_getN(1);
+  @$pb.TagNumber(2)
+  set timestamp($2.Timestamp v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasTimestamp() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearTimestamp() => clearField(2);
+  @$pb.TagNumber(2)
+  $2.Timestamp ensureTimestamp() => $_ensure(1);
+
+  @$pb.TagNumber(3)
+  $core.String get message => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set message($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasMessage() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearMessage() => clearField(3);
+}
+
+class GetLogEntriesResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetLogEntriesResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..pc<LogEntry>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'entries', $pb.PbFieldType.PM, subBuilder: LogEntry.create)
+    ..hasRequiredFields = false
+  ;
+
+  GetLogEntriesResponse._() : super();
+  factory GetLogEntriesResponse({
+    $core.Iterable<LogEntry>? entries,
+  }) {
+    final _result = create();
+    if (entries != null) {
+      _result.entries.addAll(entries);
+    }
+    return _result;
+  }
+  factory GetLogEntriesResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetLogEntriesResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetLogEntriesResponse clone() => GetLogEntriesResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetLogEntriesResponse copyWith(void Function(GetLogEntriesResponse) updates) => super.copyWith((message) => updates(message as GetLogEntriesResponse)) as GetLogEntriesResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetLogEntriesResponse create() => GetLogEntriesResponse._();
+  GetLogEntriesResponse createEmptyInstance() => create();
+  static $pb.PbList<GetLogEntriesResponse> createRepeated() => $pb.PbList<GetLogEntriesResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetLogEntriesResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetLogEntriesResponse>(create);
+  static GetLogEntriesResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<LogEntry> get entries => $_getList(0);
+}
+
+class GetGraphRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetGraphRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..hasRequiredFields = false
+  ;
+
+  GetGraphRequest._() : super();
+  factory GetGraphRequest({
+    $core.String? pipelineUuid,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    return _result;
+  }
+  factory GetGraphRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetGraphRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetGraphRequest clone() => GetGraphRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetGraphRequest copyWith(void Function(GetGraphRequest) updates) => super.copyWith((message) => updates(message as GetGraphRequest)) as GetGraphRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetGraphRequest create() => GetGraphRequest._();
+  GetGraphRequest createEmptyInstance() => create();
+  static $pb.PbList<GetGraphRequest> createRepeated() => $pb.PbList<GetGraphRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetGraphRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetGraphRequest>(create);
+  static GetGraphRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+}
+
+class GetGraphResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetGraphResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'graph')
+    ..aOB(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'ready')
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'dot')
+    ..aOS(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'json')
+    ..hasRequiredFields = false
+  ;
+
+  GetGraphResponse._() : super();
+  factory GetGraphResponse({
+    $core.String? graph,
+    $core.bool? ready,
+    $core.String? dot,
+    $core.String? json,
+  }) {
+    final _result = create();
+    if (graph != null) {
+      _result.graph = graph;
+    }
+    if (ready != null) {
+      _result.ready = ready;
+    }
+    if (dot != null) {
+      _result.dot = dot;
+    }
+    if (json != null) {
+      _result.json = json;
+    }
+    return _result;
+  }
+  factory GetGraphResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetGraphResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetGraphResponse clone() => GetGraphResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetGraphResponse copyWith(void Function(GetGraphResponse) updates) => super.copyWith((message) => updates(message as GetGraphResponse)) as GetGraphResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetGraphResponse create() => GetGraphResponse._();
+  GetGraphResponse createEmptyInstance() => create();
+  static $pb.PbList<GetGraphResponse> createRepeated() => $pb.PbList<GetGraphResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetGraphResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetGraphResponse>(create);
+  static GetGraphResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get graph => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set graph($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasGraph() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearGraph() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.bool get ready => $_getBF(1);
+  @$pb.TagNumber(2)
+  set ready($core.bool v) { $_setBool(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasReady() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearReady() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get dot => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set dot($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasDot() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearDot() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.String get json => $_getSZ(3);
+  @$pb.TagNumber(4)
+  set json($core.String v) { $_setString(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasJson() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearJson() => clearField(4);
+}
+
+class CancelRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CancelRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..hasRequiredFields = false
+  ;
+
+  CancelRequest._() : super();
+  factory CancelRequest({
+    $core.String? pipelineUuid,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    return _result;
+  }
+  factory CancelRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory CancelRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  CancelRequest clone() => CancelRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  CancelRequest copyWith(void Function(CancelRequest) updates) => super.copyWith((message) => updates(message as CancelRequest)) as CancelRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static CancelRequest create() => CancelRequest._();
+  CancelRequest createEmptyInstance() => create();
+  static $pb.PbList<CancelRequest> createRepeated() => $pb.PbList<CancelRequest>();
+  @$core.pragma('dart2js:noInline')
+  static CancelRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CancelRequest>(create);
+  static CancelRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+}
+
+class CancelResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CancelResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  CancelResponse._() : super();
+  factory CancelResponse() => create();
+  factory CancelResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory CancelResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  CancelResponse clone() => CancelResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  CancelResponse copyWith(void Function(CancelResponse) updates) => super.copyWith((message) => updates(message as CancelResponse)) as CancelResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static CancelResponse create() => CancelResponse._();
+  CancelResponse createEmptyInstance() => create();
+  static $pb.PbList<CancelResponse> createRepeated() => $pb.PbList<CancelResponse>();
+  @$core.pragma('dart2js:noInline')
+  static CancelResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CancelResponse>(create);
+  static CancelResponse? _defaultInstance;
+}
+
+class GetRunOutputPageRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRunOutputPageRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..aInt64(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'offset')
+    ..aInt64(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'limit')
+    ..hasRequiredFields = false
+  ;
+
+  GetRunOutputPageRequest._() : super();
+  factory GetRunOutputPageRequest({
+    $core.String? pipelineUuid,
+    $fixnum.Int64? offset,
+    $fixnum.Int64? limit,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    if (offset != null) {
+      _result.offset = offset;
+    }
+    if (limit != null) {
+      _result.limit = limit;
+    }
+    return _result;
+  }
+  factory GetRunOutputPageRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetRunOutputPageRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetRunOutputPageRequest clone() => GetRunOutputPageRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetRunOutputPageRequest copyWith(void Function(GetRunOutputPageRequest) updates) => super.copyWith((message) => updates(message as GetRunOutputPageRequest)) as GetRunOutputPageRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetRunOutputPageRequest create() => GetRunOutputPageRequest._();
+  GetRunOutputPageRequest createEmptyInstance() => create();
+  static $pb.PbList<GetRunOutputPageRequest> createRepeated() => $pb.PbList<GetRunOutputPageRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetRunOutputPageRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRunOutputPageRequest>(create);
+  static GetRunOutputPageRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get offset => $_getI64(1);
+  @$pb.TagNumber(2)
+  set offset($fixnum.Int64 v) { $_setInt64(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasOffset() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearOffset() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get limit => $_getI64(2);
+  @$pb.TagNumber(3)
+  set limit($fixnum.Int64 v) { $_setInt64(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasLimit() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearLimit() => clearField(3);
+}
+
+class GetRunOutputPageResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRunOutputPageResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
+    ..aInt64(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'nextOffset')
+    ..aInt64(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'totalSize')
+    ..hasRequiredFields = false
+  ;
+
+  GetRunOutputPageResponse._() : super();
+  factory GetRunOutputPageResponse({
+    $core.String? output,
+    $fixnum.Int64? nextOffset,
+    $fixnum.Int64? totalSize,
+  }) {
+    final _result = create();
+    if (output != null) {
+      _result.output = output;
+    }
+    if (nextOffset != null) {
+      _result.nextOffset = nextOffset;
+    }
+    if (totalSize != null) {
+      _result.totalSize = totalSize;
+    }
+    return _result;
+  }
+  factory GetRunOutputPageResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetRunOutputPageResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetRunOutputPageResponse clone() => GetRunOutputPageResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetRunOutputPageResponse copyWith(void Function(GetRunOutputPageResponse) updates) => super.copyWith((message) => updates(message as GetRunOutputPageResponse)) as GetRunOutputPageResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetRunOutputPageResponse create() => GetRunOutputPageResponse._();
+  GetRunOutputPageResponse createEmptyInstance() => create();
+  static $pb.PbList<GetRunOutputPageResponse> createRepeated() => $pb.PbList<GetRunOutputPageResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetRunOutputPageResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRunOutputPageResponse>(create);
+  static GetRunOutputPageResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get output => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set output($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasOutput() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearOutput() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get nextOffset => $_getI64(1);
+  @$pb.TagNumber(2)
+  set nextOffset($fixnum.Int64 v) { $_setInt64(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasNextOffset() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearNextOffset() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get totalSize => $_getI64(2);
+  @$pb.TagNumber(3)
+  set totalSize($fixnum.Int64 v) { $_setInt64(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasTotalSize() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearTotalSize() => clearField(3);
+}
+
+class StreamOutputRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'StreamOutputRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..hasRequiredFields = false
+  ;
+
+  StreamOutputRequest._() : super();
+  factory StreamOutputRequest({
+    $core.String? pipelineUuid,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    return _result;
+  }
+  factory StreamOutputRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory StreamOutputRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  StreamOutputRequest clone() => StreamOutputRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  StreamOutputRequest copyWith(void Function(StreamOutputRequest) updates) => super.copyWith((message) => updates(message as StreamOutputRequest)) as StreamOutputRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static StreamOutputRequest create() => StreamOutputRequest._();
+  StreamOutputRequest createEmptyInstance() => create();
+  static $pb.PbList<StreamOutputRequest> createRepeated() => $pb.PbList<StreamOutputRequest>();
+  @$core.pragma('dart2js:noInline')
+  static StreamOutputRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<StreamOutputRequest>(create);
+  static StreamOutputRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+}
+
+class StreamOutputResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'StreamOutputResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..e<Status>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'status', $pb.PbFieldType.OE, defaultOrMaker: Status.STATUS_UNSPECIFIED, valueOf: Status.valueOf, enumValues: Status.values)
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'runOutput')
+    ..hasRequiredFields = false
+  ;
+
+  StreamOutputResponse._() : super();
+  factory StreamOutputResponse({
+    Status? status,
+    $core.String? runOutput,
+  }) {
+    final _result = create();
+    if (status != null) {
+      _result.status = status;
+    }
+    if (runOutput != null) {
+      _result.runOutput = runOutput;
+    }
+    return _result;
+  }
+  factory StreamOutputResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory StreamOutputResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  StreamOutputResponse clone() => StreamOutputResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  StreamOutputResponse copyWith(void Function(StreamOutputResponse) updates) => super.copyWith((message) => updates(message as StreamOutputResponse)) as StreamOutputResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static StreamOutputResponse create() => StreamOutputResponse._();
+  StreamOutputResponse createEmptyInstance() => create();
+  static $pb.PbList<StreamOutputResponse> createRepeated() => $pb.PbList<StreamOutputResponse>();
+  @$core.pragma('dart2js:noInline')
+  static StreamOutputResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<StreamOutputResponse>(create);
+  static StreamOutputResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  Status get status => $_getN(0);
+  @$pb.TagNumber(1)
+  set status(Status v) { setField(1, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasStatus() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearStatus() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get runOutput => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set runOutput($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasRunOutput() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearRunOutput() => clearField(2);
+}
+
+class GetWorkingDirRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetWorkingDirRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..hasRequiredFields = false
+  ;
+
+  GetWorkingDirRequest._() : super();
+  factory GetWorkingDirRequest({
+    $core.String? pipelineUuid,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    return _result;
+  }
+  factory GetWorkingDirRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetWorkingDirRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetWorkingDirRequest clone() => GetWorkingDirRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetWorkingDirRequest copyWith(void Function(GetWorkingDirRequest) updates) => super.copyWith((message) => updates(message as GetWorkingDirRequest)) as GetWorkingDirRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetWorkingDirRequest create() => GetWorkingDirRequest._();
+  GetWorkingDirRequest createEmptyInstance() => create();
+  static $pb.PbList<GetWorkingDirRequest> createRepeated() => $pb.PbList<GetWorkingDirRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetWorkingDirRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetWorkingDirRequest>(create);
+  static GetWorkingDirRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+}
+
+class GetWorkingDirResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetWorkingDirResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..a<$core.List<$core.int>>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'chunk', $pb.PbFieldType.OY)
+    ..hasRequiredFields = false
+  ;
+
+  GetWorkingDirResponse._() : super();
+  factory GetWorkingDirResponse({
+    $core.List<$core.int>? chunk,
+  }) {
+    final _result = create();
+    if (chunk != null) {
+      _result.chunk = chunk;
+    }
+    return _result;
+  }
+  factory GetWorkingDirResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetWorkingDirResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetWorkingDirResponse clone() => GetWorkingDirResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetWorkingDirResponse copyWith(void Function(GetWorkingDirResponse) updates) => super.copyWith((message) => updates(message as GetWorkingDirResponse)) as GetWorkingDirResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetWorkingDirResponse create() => GetWorkingDirResponse._();
+  GetWorkingDirResponse createEmptyInstance() => create();
+  static $pb.PbList<GetWorkingDirResponse> createRepeated() => $pb.PbList<GetWorkingDirResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetWorkingDirResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetWorkingDirResponse>(create);
+  static GetWorkingDirResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<$core.int> get chunk => $_getN(0);
+  @$pb.TagNumber(1)
+  set chunk($core.List<$core.int> v) { $_setBytes(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasChunk() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearChunk() => clearField(1);
+}
+
+class GetLogsPageRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetLogsPageRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..aInt64(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'offset')
+    ..aInt64(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'limit')
+    ..hasRequiredFields = false
+  ;
+
+  GetLogsPageRequest._() : super();
+  factory GetLogsPageRequest({
+    $core.String? pipelineUuid,
+    $fixnum.Int64? offset,
+    $fixnum.Int64? limit,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    if (offset != null) {
+      _result.offset = offset;
+    }
+    if (limit != null) {
+      _result.limit = limit;
+    }
+    return _result;
+  }
+  factory GetLogsPageRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetLogsPageRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetLogsPageRequest clone() => GetLogsPageRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetLogsPageRequest copyWith(void Function(GetLogsPageRequest) updates) => super.copyWith((message) => updates(message as GetLogsPageRequest)) as GetLogsPageRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetLogsPageRequest create() => GetLogsPageRequest._();
+  GetLogsPageRequest createEmptyInstance() => create();
+  static $pb.PbList<GetLogsPageRequest> createRepeated() => $pb.PbList<GetLogsPageRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetLogsPageRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetLogsPageRequest>(create);
+  static GetLogsPageRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get offset => $_getI64(1);
+  @$pb.TagNumber(2)
+  set offset($fixnum.Int64 v) { $_setInt64(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasOffset() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearOffset() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get limit => $_getI64(2);
+  @$pb.TagNumber(3)
+  set limit($fixnum.Int64 v) { $_setInt64(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasLimit() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearLimit() => clearField(3);
+}
+
+class GetLogsPageResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetLogsPageResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
+    ..aInt64(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'nextOffset')
+    ..aInt64(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'totalSize')
+    ..hasRequiredFields = false
+  ;
+
+  GetLogsPageResponse._() : super();
+  factory GetLogsPageResponse({
+    $core.String? output,
+    $fixnum.Int64? nextOffset,
+    $fixnum.Int64? totalSize,
+  }) {
+    final _result = create();
+    if (output != null) {
+      _result.output = output;
+    }
+    if (nextOffset != null) {
+      _result.nextOffset = nextOffset;
+    }
+    if (totalSize != null) {
+      _result.totalSize = totalSize;
+    }
+    return _result;
+  }
+  factory GetLogsPageResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetLogsPageResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetLogsPageResponse clone() => GetLogsPageResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetLogsPageResponse copyWith(void Function(GetLogsPageResponse) updates) => super.copyWith((message) => updates(message as GetLogsPageResponse)) as GetLogsPageResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetLogsPageResponse create() => GetLogsPageResponse._();
+  GetLogsPageResponse createEmptyInstance() => create();
+  static $pb.PbList<GetLogsPageResponse> createRepeated() => $pb.PbList<GetLogsPageResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetLogsPageResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetLogsPageResponse>(create);
+  static GetLogsPageResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get output => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set output($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasOutput() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearOutput() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get nextOffset => $_getI64(1);
+  @$pb.TagNumber(2)
+  set nextOffset($fixnum.Int64 v) { $_setInt64(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasNextOffset() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearNextOffset() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get totalSize => $_getI64(2);
+  @$pb.TagNumber(3)
+  set totalSize($fixnum.Int64 v) { $_setInt64(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasTotalSize() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearTotalSize() => clearField(3);
+}
+
+class GetMetadataRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetMetadataRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..hasRequiredFields = false
+  ;
+
+  GetMetadataRequest._() : super();
+  factory GetMetadataRequest({
+    $core.String? pipelineUuid,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    return _result;
+  }
+  factory GetMetadataRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetMetadataRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetMetadataRequest clone() => GetMetadataRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetMetadataRequest copyWith(void Function(GetMetadataRequest) updates) => super.copyWith((message) => updates(message as GetMetadataRequest)) as GetMetadataRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetMetadataRequest create() => GetMetadataRequest._();
+  GetMetadataRequest createEmptyInstance() => create();
+  static $pb.PbList<GetMetadataRequest> createRepeated() => $pb.PbList<GetMetadataRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetMetadataRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetMetadataRequest>(create);
+  static GetMetadataRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+}
+
+class SubKeyMetadata extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SubKeyMetadata', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'subKey')
+    ..aInt64(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'size')
+    ..aInt64(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'ttlSeconds')
+    ..hasRequiredFields = false
+  ;
+
+  SubKeyMetadata._() : super();
+  factory SubKeyMetadata({
+    $core.String? subKey,
+    $fixnum.Int64? size,
+    $fixnum.Int64? ttlSeconds,
+  }) {
+    final _result = create();
+    if (subKey != null) {
+      _result.subKey = subKey;
+    }
+    if (size != null) {
+      _result.size = size;
+    }
+    if (ttlSeconds != null) {
+      _result.ttlSeconds = ttlSeconds;
+    }
+    return _result;
+  }
+  factory SubKeyMetadata.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SubKeyMetadata.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  SubKeyMetadata clone() => SubKeyMetadata()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  SubKeyMetadata copyWith(void Function(SubKeyMetadata) updates) => super.copyWith((message) => updates(message as SubKeyMetadata)) as SubKeyMetadata; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static SubKeyMetadata create() => SubKeyMetadata._();
+  SubKeyMetadata createEmptyInstance() => create();
+  static $pb.PbList<SubKeyMetadata> createRepeated() => $pb.PbList<SubKeyMetadata>();
+  @$core.pragma('dart2js:noInline')
+  static SubKeyMetadata getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SubKeyMetadata>(create);
+  static SubKeyMetadata? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get subKey => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set subKey($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasSubKey() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearSubKey() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get size => $_getI64(1);
+  @$pb.TagNumber(2)
+  set size($fixnum.Int64 v) { $_setInt64(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasSize() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearSize() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get ttlSeconds => $_getI64(2);
+  @$pb.TagNumber(3)
+  set ttlSeconds($fixnum.Int64 v) { $_setInt64(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasTtlSeconds() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearTtlSeconds() => clearField(3);
+}
+
+class GetMetadataResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetMetadataResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..pc<SubKeyMetadata>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'subKeys', $pb.PbFieldType.PM, subBuilder: SubKeyMetadata.create)
+    ..hasRequiredFields = false
+  ;
+
+  GetMetadataResponse._() : super();
+  factory GetMetadataResponse({
+    $core.Iterable<SubKeyMetadata>? subKeys,
+  }) {
+    final _result = create();
+    if (subKeys != null) {
+      _result.subKeys.addAll(subKeys);
+    }
+    return _result;
+  }
+  factory GetMetadataResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetMetadataResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetMetadataResponse clone() => GetMetadataResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetMetadataResponse copyWith(void Function(GetMetadataResponse) updates) => super.copyWith((message) => updates(message as GetMetadataResponse)) as GetMetadataResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetMetadataResponse create() => GetMetadataResponse._();
+  GetMetadataResponse createEmptyInstance() => create();
+  static $pb.PbList<GetMetadataResponse> createRepeated() => $pb.PbList<GetMetadataResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetMetadataResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetMetadataResponse>(create);
+  static GetMetadataResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<SubKeyMetadata> get subKeys => $_getList(0);
+}
+
+class PrecompiledObject extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'PrecompiledObject', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'name')
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'description')
+    ..e<PrecompiledObjectType>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'type', $pb.PbFieldType.OE, defaultOrMaker: PrecompiledObjectType.PRECOMPILED_OBJECT_TYPE_UNSPECIFIED, valueOf: PrecompiledObjectType.valueOf, enumValues: PrecompiledObjectType.values)
+    ..aOS(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineOptions')
+    ..aOS(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'link')
+    ..hasRequiredFields = false
+  ;
+
+  PrecompiledObject._() : super();
+  factory PrecompiledObject({
+    $core.String? cloudPath,
+    $core.String? name,
+    $core.String? description,
+    PrecompiledObjectType? type,
+    $core.String? pipelineOptions,
+    $core.String? link,
+  }) {
+    final _result = create();
+    if (cloudPath != null) {
+      _result.cloudPath = cloudPath;
+    }
+    if (name != null) {
+      _result.name = name;
+    }
+    if (description != null) {
+      _result.description = description;
+    }
+    if (type != null) {
+      _result.type = type;
+    }
+    if (pipelineOptions != null) {
+      _result.pipelineOptions = pipelineOptions;
+    }
+    if (link != null) {
+      _result.link = link;
+    }
+    return _result;
+  }
+  factory PrecompiledObject.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory PrecompiledObject.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  PrecompiledObject clone() => PrecompiledObject()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  PrecompiledObject copyWith(void Function(PrecompiledObject) updates) => super.copyWith((message) => updates(message as PrecompiledObject)) as PrecompiledObject; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static PrecompiledObject create() => PrecompiledObject._();
+  PrecompiledObject createEmptyInstance() => create();
+  static $pb.PbList<PrecompiledObject> createRepeated() => $pb.PbList<PrecompiledObject>();
+  @$core.pragma('dart2js:noInline')
+  static PrecompiledObject getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<PrecompiledObject>(create);
+  static PrecompiledObject? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get cloudPath => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set cloudPath($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasCloudPath() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCloudPath() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get name => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set name($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasName() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearName() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get description => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set description($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasDescription() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearDescription() => clearField(3);
+
+  @$pb.TagNumber(4)
+  PrecompiledObjectType get type => $_getN(3);
+  @$pb.TagNumber(4)
+  set type(PrecompiledObjectType v) { setField(4, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasType() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearType() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.String get pipelineOptions => $_getSZ(4);
+  @$pb.TagNumber(5)
+  set pipelineOptions($core.String v) { $_setString(4, v); }
+  @$pb.TagNumber(5)
+  $core.bool hasPipelineOptions() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearPipelineOptions() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.String get link => $_getSZ(5);
+  @$pb.TagNumber(6)
+  set link($core.String v) { $_setString(5, v); }
+  @$pb.TagNumber(6)
+  $core.bool hasLink() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearLink() => clearField(6);
+}
+
+class Categories_Category extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'Categories.Category', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'categoryName')
+    ..pc<PrecompiledObject>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'precompiledObjects', $pb.PbFieldType.PM, subBuilder: PrecompiledObject.create)
+    ..hasRequiredFields = false
+  ;
+
+  Categories_Category._() : super();
+  factory Categories_Category({
+    $core.String? categoryName,
+    $core.Iterable<PrecompiledObject>? precompiledObjects,
+  }) {
+    final _result = create();
+    if (categoryName != null) {
+      _result.categoryName = categoryName;
+    }
+    if (precompiledObjects != null) {
+      _result.precompiledObjects.addAll(precompiledObjects);
+    }
+    return _result;
+  }
+  factory Categories_Category.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory Categories_Category.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  Categories_Category clone() => Categories_Category()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  Categories_Category copyWith(void Function(Categories_Category) updates) => super.copyWith((message) => updates(message as Categories_Category)) as Categories_Category; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static Categories_Category create() => Categories_Category._();
+  Categories_Category createEmptyInstance() => create();
+  static $pb.PbList<Categories_Category> createRepeated() => $pb.PbList<Categories_Category>();
+  @$core.pragma('dart2js:noInline')
+  static Categories_Category getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<Categories_Category>(create);
+  static Categories_Category? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get categoryName => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set categoryName($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasCategoryName() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCategoryName() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<PrecompiledObject> get precompiledObjects => $_getList(1);
+}
+
+class Categories extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'Categories', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..e<Sdk>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+    ..pc<Categories_Category>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'categories', $pb.PbFieldType.PM, subBuilder: Categories_Category.create)
+    ..hasRequiredFields = false
+  ;
+
+  Categories._() : super();
+  factory Categories({
+    Sdk? sdk,
+    $core.Iterable<Categories_Category>? categories,
+  }) {
+    final _result = create();
+    if (sdk != null) {
+      _result.sdk = sdk;
+    }
+    if (categories != null) {
+      _result.categories.addAll(categories);
+    }
+    return _result;
+  }
+  factory Categories.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory Categories.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  Categories clone() => Categories()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  Categories copyWith(void Function(Categories) updates) => super.copyWith((message) => updates(message as Categories)) as Categories; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static Categories create() => Categories._();
+  Categories createEmptyInstance() => create();
+  static $pb.PbList<Categories> createRepeated() => $pb.PbList<Categories>();
+  @$core.pragma('dart2js:noInline')
+  static Categories getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<Categories>(create);
+  static Categories? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  Sdk get sdk => $_getN(0);
+  @$pb.TagNumber(1)
+  set sdk(Sdk v) { setField(1, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasSdk() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearSdk() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<Categories_Category> get categories => $_getList(1);
+}
+
+class GetPrecompiledObjectsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..e<Sdk>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'category')
+    ..hasRequiredFields = false
+  ;
+
+  GetPrecompiledObjectsRequest._() : super();
+  factory GetPrecompiledObjectsRequest({
+    Sdk? sdk,
+    $core.String? category,
+  }) {
+    final _result = create();
+    if (sdk != null) {
+      _result.sdk = sdk;
+    }
+    if (category != null) {
+      _result.category = category;
+    }
+    return _result;
+  }
+  factory GetPrecompiledObjectsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectsRequest clone() => GetPrecompiledObjectsRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectsRequest copyWith(void Function(GetPrecompiledObjectsRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectsRequest)) as GetPrecompiledObjectsRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectsRequest create() => GetPrecompiledObjectsRequest._();
+  GetPrecompiledObjectsRequest createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectsRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectsRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectsRequest>(create);
+  static GetPrecompiledObjectsRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  Sdk get sdk => $_getN(0);
+  @$pb.TagNumber(1)
+  set sdk(Sdk v) { setField(1, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasSdk() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearSdk() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get category => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set category($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasCategory() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearCategory() => clearField(2);
+}
+
+class GetPrecompiledObjectCodeRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectCodeRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+    ..hasRequiredFields = false
+  ;
+
+  GetPrecompiledObjectCodeRequest._() : super();
+  factory GetPrecompiledObjectCodeRequest({
+    $core.String? cloudPath,
+  }) {
+    final _result = create();
+    if (cloudPath != null) {
+      _result.cloudPath = cloudPath;
+    }
+    return _result;
+  }
+  factory GetPrecompiledObjectCodeRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectCodeRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetGraphRequest clone() => GetGraphRequest()..mergeFromMessage(this);
+  GetPrecompiledObjectCodeRequest clone() => GetPrecompiledObjectCodeRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetGraphRequest copyWith(void Function(GetGraphRequest) updates) => super.copyWith((message) => updates(message as GetGraphRequest)) as GetGraphRequest; // ignore: deprecated_member_use
+  GetPrecompiledObjectCodeRequest copyWith(void Function(GetPrecompiledObjectCodeRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectCodeRequest)) as GetPrecompiledObjectCodeRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetGraphRequest create() => GetGraphRequest._();
-  GetGraphRequest createEmptyInstance() => create();
-  static $pb.PbList<GetGraphRequest> createRepeated() => $pb.PbList<GetGraphRequest>();
+  static GetPrecompiledObjectCodeRequest create() => GetPrecompiledObjectCodeRequest._();
+  GetPrecompiledObjectCodeRequest createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectCodeRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectCodeRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetGraphRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetGraphRequest>(create);
-  static GetGraphRequest? _defaultInstance;
+  static GetPrecompiledObjectCodeRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectCodeRequest>(create);
+  static GetPrecompiledObjectCodeRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get pipelineUuid => $_getSZ(0);
+  $core.String get cloudPath => $_getSZ(0);
   @$pb.TagNumber(1)
-  set pipelineUuid($core.String v) { $_setString(0, v); }
+  set cloudPath($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasPipelineUuid() => $_has(0);
+  $core.bool hasCloudPath() => $_has(0);
   @$pb.TagNumber(1)
-  void clearPipelineUuid() => clearField(1);
+  void clearCloudPath() => clearField(1);
 }
 
-class GetGraphResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetGraphResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'graph')
+class GetPrecompiledObjectOutputRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectOutputRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
     ..hasRequiredFields = false
   ;
 
-  GetGraphResponse._() : super();
-  factory GetGraphResponse({
-    $core.String? graph,
+  GetPrecompiledObjectOutputRequest._() : super();
+  factory GetPrecompiledObjectOutputRequest({
+    $core.String? cloudPath,
   }) {
     final _result = create();
-    if (graph != null) {
-      _result.graph = graph;
+    if (cloudPath != null) {
+      _result.cloudPath = cloudPath;
     }
     return _result;
   }
-  factory GetGraphResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetGraphResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetPrecompiledObjectOutputRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectOutputRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetGraphResponse clone() => GetGraphResponse()..mergeFromMessage(this);
+  GetPrecompiledObjectOutputRequest clone() => GetPrecompiledObjectOutputRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetGraphResponse copyWith(void Function(GetGraphResponse) updates) => super.copyWith((message) => updates(message as GetGraphResponse)) as GetGraphResponse; // ignore: deprecated_member_use
+  GetPrecompiledObjectOutputRequest copyWith(void Function(GetPrecompiledObjectOutputRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectOutputRequest)) as GetPrecompiledObjectOutputRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetGraphResponse create() => GetGraphResponse._();
-  GetGraphResponse createEmptyInstance() => create();
-  static $pb.PbList<GetGraphResponse> createRepeated() => $pb.PbList<GetGraphResponse>();
+  static GetPrecompiledObjectOutputRequest create() => GetPrecompiledObjectOutputRequest._();
+  GetPrecompiledObjectOutputRequest createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectOutputRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectOutputRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetGraphResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetGraphResponse>(create);
-  static GetGraphResponse? _defaultInstance;
+  static GetPrecompiledObjectOutputRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectOutputRequest>(create);
+  static GetPrecompiledObjectOutputRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get graph => $_getSZ(0);
+  $core.String get cloudPath => $_getSZ(0);
   @$pb.TagNumber(1)
-  set graph($core.String v) { $_setString(0, v); }
+  set cloudPath($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasGraph() => $_has(0);
+  $core.bool hasCloudPath() => $_has(0);
   @$pb.TagNumber(1)
-  void clearGraph() => clearField(1);
+  void clearCloudPath() => clearField(1);
 }
 
-class CancelRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CancelRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+class GetPrecompiledObjectLogsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectLogsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
     ..hasRequiredFields = false
   ;
 
-  CancelRequest._() : super();
-  factory CancelRequest({
-    $core.String? pipelineUuid,
+  GetPrecompiledObjectLogsRequest._() : super();
+  factory GetPrecompiledObjectLogsRequest({
+    $core.String? cloudPath,
   }) {
     final _result = create();
-    if (pipelineUuid != null) {
-      _result.pipelineUuid = pipelineUuid;
+    if (cloudPath != null) {
+      _result.cloudPath = cloudPath;
     }
     return _result;
   }
-  factory CancelRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory CancelRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetPrecompiledObjectLogsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectLogsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  CancelRequest clone() => CancelRequest()..mergeFromMessage(this);
+  GetPrecompiledObjectLogsRequest clone() => GetPrecompiledObjectLogsRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  CancelRequest copyWith(void Function(CancelRequest) updates) => super.copyWith((message) => updates(message as CancelRequest)) as CancelRequest; // ignore: deprecated_member_use
+  GetPrecompiledObjectLogsRequest copyWith(void Function(GetPrecompiledObjectLogsRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectLogsRequest)) as GetPrecompiledObjectLogsRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static CancelRequest create() => CancelRequest._();
-  CancelRequest createEmptyInstance() => create();
-  static $pb.PbList<CancelRequest> createRepeated() => $pb.PbList<CancelRequest>();
+  static GetPrecompiledObjectLogsRequest create() => GetPrecompiledObjectLogsRequest._();
+  GetPrecompiledObjectLogsRequest createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectLogsRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectLogsRequest>();
   @$core.pragma('dart2js:noInline')
-  static CancelRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CancelRequest>(create);
-  static CancelRequest? _defaultInstance;
+  static GetPrecompiledObjectLogsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectLogsRequest>(create);
+  static GetPrecompiledObjectLogsRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get pipelineUuid => $_getSZ(0);
+  $core.String get cloudPath => $_getSZ(0);
   @$pb.TagNumber(1)
-  set pipelineUuid($core.String v) { $_setString(0, v); }
+  set cloudPath($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasPipelineUuid() => $_has(0);
+  $core.bool hasCloudPath() => $_has(0);
   @$pb.TagNumber(1)
-  void clearPipelineUuid() => clearField(1);
+  void clearCloudPath() => clearField(1);
 }
 
-class CancelResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CancelResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+class GetPrecompiledObjectRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
     ..hasRequiredFields = false
   ;
 
-  CancelResponse._() : super();
-  factory CancelResponse() => create();
-  factory CancelResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory CancelResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  GetPrecompiledObjectRequest._() : super();
+  factory GetPrecompiledObjectRequest({
+    $core.String? cloudPath,
+  }) {
+    final _result = create();
+    if (cloudPath != null) {
+      _result.cloudPath = cloudPath;
+    }
+    return _result;
+  }
+  factory GetPrecompiledObjectRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  CancelResponse clone() => CancelResponse()..mergeFromMessage(this);
+  GetPrecompiledObjectRequest clone() => GetPrecompiledObjectRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  CancelResponse copyWith(void Function(CancelResponse) updates) => super.copyWith((message) => updates(message as CancelResponse)) as CancelResponse; // ignore: deprecated_member_use
+  GetPrecompiledObjectRequest copyWith(void Function(GetPrecompiledObjectRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectRequest)) as GetPrecompiledObjectRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static CancelResponse create() => CancelResponse._();
-  CancelResponse createEmptyInstance() => create();
-  static $pb.PbList<CancelResponse> createRepeated() => $pb.PbList<CancelResponse>();
+  static GetPrecompiledObjectRequest create() => GetPrecompiledObjectRequest._();
+  GetPrecompiledObjectRequest createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectRequest>();
   @$core.pragma('dart2js:noInline')
-  static CancelResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CancelResponse>(create);
-  static CancelResponse? _defaultInstance;
+  static GetPrecompiledObjectRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectRequest>(create);
+  static GetPrecompiledObjectRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get cloudPath => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set cloudPath($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasCloudPath() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCloudPath() => clearField(1);
 }
 
-class PrecompiledObject extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'PrecompiledObject', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
-    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'name')
-    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'description')
-    ..e<PrecompiledObjectType>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'type', $pb.PbFieldType.OE, defaultOrMaker: PrecompiledObjectType.PRECOMPILED_OBJECT_TYPE_UNSPECIFIED, valueOf: PrecompiledObjectType.valueOf, enumValues: PrecompiledObjectType.values)
-    ..aOS(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineOptions')
-    ..aOS(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'link')
+class GetDefaultPrecompiledObjectRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetDefaultPrecompiledObjectRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..e<Sdk>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
     ..hasRequiredFields = false
   ;
 
-  PrecompiledObject._() : super();
-  factory PrecompiledObject({
-    $core.String? cloudPath,
-    $core.String? name,
-    $core.String? description,
-    PrecompiledObjectType? type,
-    $core.String? pipelineOptions,
-    $core.String? link,
+  GetDefaultPrecompiledObjectRequest._() : super();
+  factory GetDefaultPrecompiledObjectRequest({
+    Sdk? sdk,
   }) {
     final _result = create();
-    if (cloudPath != null) {
-      _result.cloudPath = cloudPath;
+    if (sdk != null) {
+      _result.sdk = sdk;
     }
-    if (name != null) {
-      _result.name = name;
+    return _result;
+  }
+  factory GetDefaultPrecompiledObjectRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetDefaultPrecompiledObjectRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetDefaultPrecompiledObjectRequest clone() => GetDefaultPrecompiledObjectRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetDefaultPrecompiledObjectRequest copyWith(void Function(GetDefaultPrecompiledObjectRequest) updates) => super.copyWith((message) => updates(message as GetDefaultPrecompiledObjectRequest)) as GetDefaultPrecompiledObjectRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetDefaultPrecompiledObjectRequest create() => GetDefaultPrecompiledObjectRequest._();
+  GetDefaultPrecompiledObjectRequest createEmptyInstance() => create();
+  static $pb.PbList<GetDefaultPrecompiledObjectRequest> createRepeated() => $pb.PbList<GetDefaultPrecompiledObjectRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetDefaultPrecompiledObjectRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetDefaultPrecompiledObjectRequest>(create);
+  static GetDefaultPrecompiledObjectRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  Sdk get sdk => $_getN(0);
+  @$pb.TagNumber(1)
+  set sdk(Sdk v) { setField(1, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasSdk() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearSdk() => clearField(1);
+}
+
+class SearchExamplesRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SearchExamplesRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..pc<Sdk>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdks', $pb.PbFieldType.PE, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'category')
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'term')
+    ..a<$core.int>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'offset', $pb.PbFieldType.O3)
+    ..a<$core.int>(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'limit', $pb.PbFieldType.O3)
+    ..hasRequiredFields = false
+  ;
+
+  SearchExamplesRequest._() : super();
+  factory SearchExamplesRequest({
+    $core.Iterable<Sdk>? sdks,
+    $core.String? category,
+    $core.String? term,
+    $core.int? offset,
+    $core.int? limit,
+  }) {
+    final _result = create();
+    if (sdks != null) {
+      _result.sdks.addAll(sdks);
     }
-    if (description != null) {
-      _result.description = description;
+    if (category != null) {
+      _result.category = category;
     }
-    if (type != null) {
-      _result.type = type;
+    if (term != null) {
+      _result.term = term;
     }
-    if (pipelineOptions != null) {
-      _result.pipelineOptions = pipelineOptions;
+    if (offset != null) {
+      _result.offset = offset;
     }
-    if (link != null) {
-      _result.link = link;
+    if (limit != null) {
+      _result.limit = limit;
     }
     return _result;
   }
-  factory PrecompiledObject.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory PrecompiledObject.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory SearchExamplesRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SearchExamplesRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  PrecompiledObject clone() => PrecompiledObject()..mergeFromMessage(this);
+  SearchExamplesRequest clone() => SearchExamplesRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  PrecompiledObject copyWith(void Function(PrecompiledObject) updates) => super.copyWith((message) => updates(message as PrecompiledObject)) as PrecompiledObject; // ignore: deprecated_member_use
+  SearchExamplesRequest copyWith(void Function(SearchExamplesRequest) updates) => super.copyWith((message) => updates(message as SearchExamplesRequest)) as SearchExamplesRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static PrecompiledObject create() => PrecompiledObject._();
-  PrecompiledObject createEmptyInstance() => create();
-  static $pb.PbList<PrecompiledObject> createRepeated() => $pb.PbList<PrecompiledObject>();
+  static SearchExamplesRequest create() => SearchExamplesRequest._();
+  SearchExamplesRequest createEmptyInstance() => create();
+  static $pb.PbList<SearchExamplesRequest> createRepeated() => $pb.PbList<SearchExamplesRequest>();
   @$core.pragma('dart2js:noInline')
-  static PrecompiledObject getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<PrecompiledObject>(create);
-  static PrecompiledObject? _defaultInstance;
+  static SearchExamplesRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SearchExamplesRequest>(create);
+  static SearchExamplesRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get cloudPath => $_getSZ(0);
-  @$pb.TagNumber(1)
-  set cloudPath($core.String v) { $_setString(0, v); }
-  @$pb.TagNumber(1)
-  $core.bool hasCloudPath() => $_has(0);
-  @$pb.TagNumber(1)
-  void clearCloudPath() => clearField(1);
+  $core.List<Sdk> get sdks => $_getList(0);
 
   @$pb.TagNumber(2)
-  $core.String get name => $_getSZ(1);
+  $core.String get category => $_getSZ(1);
   @$pb.TagNumber(2)
-  set name($core.String v) { $_setString(1, v); }
+  set category($core.String v) { $_setString(1, v); }
   @$pb.TagNumber(2)
-  $core.bool hasName() => $_has(1);
+  $core.bool hasCategory() => $_has(1);
   @$pb.TagNumber(2)
-  void clearName() => clearField(2);
+  void clearCategory() => clearField(2);
 
   @$pb.TagNumber(3)
-  $core.String get description => $_getSZ(2);
+  $core.String get term => $_getSZ(2);
   @$pb.TagNumber(3)
-  set description($core.String v) { $_setString(2, v); }
+  set term($core.String v) { $_setString(2, v); }
   @$pb.TagNumber(3)
-  $core.bool hasDescription() => $_has(2);
+  $core.bool hasTerm() => $_has(2);
   @$pb.TagNumber(3)
-  void clearDescription() => clearField(3);
+  void clearTerm() => clearField(3);
 
   @$pb.TagNumber(4)
-  PrecompiledObjectType get type => $_getN(3);
+  $core.int get offset => $_getIZ(3);
   @$pb.TagNumber(4)
-  set type(PrecompiledObjectType v) { setField(4, v); }
+  set offset($core.int v) { $_setSignedInt32(3, v); }
   @$pb.TagNumber(4)
-  $core.bool hasType() => $_has(3);
+  $core.bool hasOffset() => $_has(3);
   @$pb.TagNumber(4)
-  void clearType() => clearField(4);
+  void clearOffset() => clearField(4);
 
   @$pb.TagNumber(5)
-  $core.String get pipelineOptions => $_getSZ(4);
+  $core.int get limit => $_getIZ(4);
   @$pb.TagNumber(5)
-  set pipelineOptions($core.String v) { $_setString(4, v); }
+  set limit($core.int v) { $_setSignedInt32(4, v); }
   @$pb.TagNumber(5)
-  $core.bool hasPipelineOptions() => $_has(4);
+  $core.bool hasLimit() => $_has(4);
   @$pb.TagNumber(5)
-  void clearPipelineOptions() => clearField(5);
-
-  @$pb.TagNumber(6)
-  $core.String get link => $_getSZ(5);
-  @$pb.TagNumber(6)
-  set link($core.String v) { $_setString(5, v); }
-  @$pb.TagNumber(6)
-  $core.bool hasLink() => $_has(5);
-  @$pb.TagNumber(6)
-  void clearLink() => clearField(6);
+  void clearLimit() => clearField(5);
 }
 
-class Categories_Category extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'Categories.Category', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'categoryName')
-    ..pc<PrecompiledObject>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'precompiledObjects', $pb.PbFieldType.PM, subBuilder: PrecompiledObject.create)
+class SaveSnippetRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SaveSnippetRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'code')
+    ..e<Sdk>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineOptions')
     ..hasRequiredFields = false
   ;
 
-  Categories_Category._() : super();
-  factory Categories_Category({
-    $core.String? categoryName,
-    $core.Iterable<PrecompiledObject>? precompiledObjects,
+  SaveSnippetRequest._() : super();
+  factory SaveSnippetRequest({
+    $core.String? code,
+    Sdk? sdk,
+    $core.String? pipelineOptions,
   }) {
     final _result = create();
-    if (categoryName != null) {
-      _result.categoryName = categoryName;
+    if (code != null) {
+      _result.code = code;
     }
-    if (precompiledObjects != null) {
-      _result.precompiledObjects.addAll(precompiledObjects);
+    if (sdk != null) {
+      _result.sdk = sdk;
+    }
+    if (pipelineOptions != null) {
+      _result.pipelineOptions = pipelineOptions;
     }
     return _result;
   }
-  factory Categories_Category.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory Categories_Category.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory SaveSnippetRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SaveSnippetRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  Categories_Category clone() => Categories_Category()..mergeFromMessage(this);
+  SaveSnippetRequest clone() => SaveSnippetRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  Categories_Category copyWith(void Function(Categories_Category) updates) => super.copyWith((message) => updates(message as Categories_Category)) as Categories_Category; // ignore: deprecated_member_use
+  SaveSnippetRequest copyWith(void Function(SaveSnippetRequest) updates) => super.copyWith((message) => updates(message as SaveSnippetRequest)) as SaveSnippetRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static Categories_Category create() => Categories_Category._();
-  Categories_Category createEmptyInstance() => create();
-  static $pb.PbList<Categories_Category> createRepeated() => $pb.PbList<Categories_Category>();
+  static SaveSnippetRequest create() => SaveSnippetRequest._();
+  SaveSnippetRequest createEmptyInstance() => create();
+  static $pb.PbList<SaveSnippetRequest> createRepeated() => $pb.PbList<SaveSnippetRequest>();
   @$core.pragma('dart2js:noInline')
-  static Categories_Category getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<Categories_Category>(create);
-  static Categories_Category? _defaultInstance;
+  static SaveSnippetRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SaveSnippetRequest>(create);
+  static SaveSnippetRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get categoryName => $_getSZ(0);
+  $core.String get code => $_getSZ(0);
   @$pb.TagNumber(1)
-  set categoryName($core.String v) { $_setString(0, v); }
+  set code($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasCategoryName() => $_has(0);
+  $core.bool hasCode() => $_has(0);
   @$pb.TagNumber(1)
-  void clearCategoryName() => clearField(1);
+  void clearCode() => clearField(1);
 
   @$pb.TagNumber(2)
-  $core.List<PrecompiledObject> get precompiledObjects => $_getList(1);
+  Sdk get sdk => $_getN(1);
+  @$pb.TagNumber(2)
+  set sdk(Sdk v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasSdk() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearSdk() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get pipelineOptions => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set pipelineOptions($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasPipelineOptions() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearPipelineOptions() => clearField(3);
 }
 
-class Categories extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'Categories', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..e<Sdk>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
-    ..pc<Categories_Category>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'categories', $pb.PbFieldType.PM, subBuilder: Categories_Category.create)
+class GetSnippetRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetSnippetRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'id')
     ..hasRequiredFields = false
   ;
 
-  Categories._() : super();
-  factory Categories({
-    Sdk? sdk,
-    $core.Iterable<Categories_Category>? categories,
+  GetSnippetRequest._() : super();
+  factory GetSnippetRequest({
+    $core.String? id,
   }) {
     final _result = create();
-    if (sdk != null) {
-      _result.sdk = sdk;
-    }
-    if (categories != null) {
-      _result.categories.addAll(categories);
+    if (id != null) {
+      _result.id = id;
     }
     return _result;
   }
-  factory Categories.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory Categories.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetSnippetRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetSnippetRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  Categories clone() => Categories()..mergeFromMessage(this);
+  GetSnippetRequest clone() => GetSnippetRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  Categories copyWith(void Function(Categories) updates) => super.copyWith((message) => updates(message as Categories)) as Categories; // ignore: deprecated_member_use
+  GetSnippetRequest copyWith(void Function(GetSnippetRequest) updates) => super.copyWith((message) => updates(message as GetSnippetRequest)) as GetSnippetRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static Categories create() => Categories._();
-  Categories createEmptyInstance() => create();
-  static $pb.PbList<Categories> createRepeated() => $pb.PbList<Categories>();
+  static GetSnippetRequest create() => GetSnippetRequest._();
+  GetSnippetRequest createEmptyInstance() => create();
+  static $pb.PbList<GetSnippetRequest> createRepeated() => $pb.PbList<GetSnippetRequest>();
   @$core.pragma('dart2js:noInline')
-  static Categories getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<Categories>(create);
-  static Categories? _defaultInstance;
+  static GetSnippetRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetSnippetRequest>(create);
+  static GetSnippetRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  Sdk get sdk => $_getN(0);
+  $core.String get id => $_getSZ(0);
   @$pb.TagNumber(1)
-  set sdk(Sdk v) { setField(1, v); }
+  set id($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasSdk() => $_has(0);
+  $core.bool hasId() => $_has(0);
   @$pb.TagNumber(1)
-  void clearSdk() => clearField(1);
+  void clearId() => clearField(1);
+}
 
-  @$pb.TagNumber(2)
-  $core.List<Categories_Category> get categories => $_getList(1);
+class ListSdksRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ListSdksRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  ListSdksRequest._() : super();
+  factory ListSdksRequest() => create();
+  factory ListSdksRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ListSdksRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  ListSdksRequest clone() => ListSdksRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  ListSdksRequest copyWith(void Function(ListSdksRequest) updates) => super.copyWith((message) => updates(message as ListSdksRequest)) as ListSdksRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static ListSdksRequest create() => ListSdksRequest._();
+  ListSdksRequest createEmptyInstance() => create();
+  static $pb.PbList<ListSdksRequest> createRepeated() => $pb.PbList<ListSdksRequest>();
+  @$core.pragma('dart2js:noInline')
+  static ListSdksRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ListSdksRequest>(create);
+  static ListSdksRequest? _defaultInstance;
 }
 
-class GetPrecompiledObjectsRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+class SdkInfo extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SdkInfo', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
     ..e<Sdk>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
-    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'category')
+    ..aOB(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'enabled')
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'version')
+    ..aOS(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'disabledReason')
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectsRequest._() : super();
-  factory GetPrecompiledObjectsRequest({
+  SdkInfo._() : super();
+  factory SdkInfo({
     Sdk? sdk,
-    $core.String? category,
+    $core.bool? enabled,
+    $core.String? version,
+    $core.String? disabledReason,
   }) {
     final _result = create();
     if (sdk != null) {
       _result.sdk = sdk;
     }
-    if (category != null) {
-      _result.category = category;
+    if (enabled != null) {
+      _result.enabled = enabled;
+    }
+    if (version != null) {
+      _result.version = version;
+    }
+    if (disabledReason != null) {
+      _result.disabledReason = disabledReason;
     }
     return _result;
   }
-  factory GetPrecompiledObjectsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory SdkInfo.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SdkInfo.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectsRequest clone() => GetPrecompiledObjectsRequest()..mergeFromMessage(this);
+  SdkInfo clone() => SdkInfo()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectsRequest copyWith(void Function(GetPrecompiledObjectsRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectsRequest)) as GetPrecompiledObjectsRequest; // ignore: deprecated_member_use
+  SdkInfo copyWith(void Function(SdkInfo) updates) => super.copyWith((message) => updates(message as SdkInfo)) as SdkInfo; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectsRequest create() => GetPrecompiledObjectsRequest._();
-  GetPrecompiledObjectsRequest createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectsRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectsRequest>();
+  static SdkInfo create() => SdkInfo._();
+  SdkInfo createEmptyInstance() => create();
+  static $pb.PbList<SdkInfo> createRepeated() => $pb.PbList<SdkInfo>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectsRequest>(create);
-  static GetPrecompiledObjectsRequest? _defaultInstance;
+  static SdkInfo getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SdkInfo>(create);
+  static SdkInfo? _defaultInstance;
 
   @$pb.TagNumber(1)
   Sdk get sdk => $_getN(0);
@@ -1254,154 +3514,258 @@ class GetPrecompiledObjectsRequest extends $pb.GeneratedMessage {
   void clearSdk() => clearField(1);
 
   @$pb.TagNumber(2)
-  $core.String get category => $_getSZ(1);
+  $core.bool get enabled => $_getBF(1);
   @$pb.TagNumber(2)
-  set category($core.String v) { $_setString(1, v); }
+  set enabled($core.bool v) { $_setBool(1, v); }
   @$pb.TagNumber(2)
-  $core.bool hasCategory() => $_has(1);
+  $core.bool hasEnabled() => $_has(1);
   @$pb.TagNumber(2)
-  void clearCategory() => clearField(2);
+  void clearEnabled() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get version => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set version($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasVersion() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearVersion() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.String get disabledReason => $_getSZ(3);
+  @$pb.TagNumber(4)
+  set disabledReason($core.String v) { $_setString(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasDisabledReason() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearDisabledReason() => clearField(4);
 }
 
-class GetPrecompiledObjectCodeRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectCodeRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+class GetUsageMetricsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetUsageMetricsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'fromDate')
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'toDate')
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectCodeRequest._() : super();
-  factory GetPrecompiledObjectCodeRequest({
-    $core.String? cloudPath,
+  GetUsageMetricsRequest._() : super();
+  factory GetUsageMetricsRequest({
+    $core.String? fromDate,
+    $core.String? toDate,
   }) {
     final _result = create();
-    if (cloudPath != null) {
-      _result.cloudPath = cloudPath;
+    if (fromDate != null) {
+      _result.fromDate = fromDate;
+    }
+    if (toDate != null) {
+      _result.toDate = toDate;
     }
     return _result;
   }
-  factory GetPrecompiledObjectCodeRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectCodeRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetUsageMetricsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetUsageMetricsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectCodeRequest clone() => GetPrecompiledObjectCodeRequest()..mergeFromMessage(this);
+  GetUsageMetricsRequest clone() => GetUsageMetricsRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectCodeRequest copyWith(void Function(GetPrecompiledObjectCodeRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectCodeRequest)) as GetPrecompiledObjectCodeRequest; // ignore: deprecated_member_use
+  GetUsageMetricsRequest copyWith(void Function(GetUsageMetricsRequest) updates) => super.copyWith((message) => updates(message as GetUsageMetricsRequest)) as GetUsageMetricsRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectCodeRequest create() => GetPrecompiledObjectCodeRequest._();
-  GetPrecompiledObjectCodeRequest createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectCodeRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectCodeRequest>();
+  static GetUsageMetricsRequest create() => GetUsageMetricsRequest._();
+  GetUsageMetricsRequest createEmptyInstance() => create();
+  static $pb.PbList<GetUsageMetricsRequest> createRepeated() => $pb.PbList<GetUsageMetricsRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectCodeRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectCodeRequest>(create);
-  static GetPrecompiledObjectCodeRequest? _defaultInstance;
+  static GetUsageMetricsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetUsageMetricsRequest>(create);
+  static GetUsageMetricsRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get cloudPath => $_getSZ(0);
+  $core.String get fromDate => $_getSZ(0);
   @$pb.TagNumber(1)
-  set cloudPath($core.String v) { $_setString(0, v); }
+  set fromDate($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasCloudPath() => $_has(0);
+  $core.bool hasFromDate() => $_has(0);
   @$pb.TagNumber(1)
-  void clearCloudPath() => clearField(1);
+  void clearFromDate() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get toDate => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set toDate($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasToDate() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearToDate() => clearField(2);
 }
 
-class GetPrecompiledObjectOutputRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectOutputRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+class UsageMetric extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'UsageMetric', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..e<Sdk>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'example')
+    ..aInt64(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'runs')
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectOutputRequest._() : super();
-  factory GetPrecompiledObjectOutputRequest({
-    $core.String? cloudPath,
+  UsageMetric._() : super();
+  factory UsageMetric({
+    Sdk? sdk,
+    $core.String? example,
+    $fixnum.Int64? runs,
   }) {
     final _result = create();
-    if (cloudPath != null) {
-      _result.cloudPath = cloudPath;
+    if (sdk != null) {
+      _result.sdk = sdk;
+    }
+    if (example != null) {
+      _result.example = example;
+    }
+    if (runs != null) {
+      _result.runs = runs;
     }
     return _result;
   }
-  factory GetPrecompiledObjectOutputRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectOutputRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory UsageMetric.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory UsageMetric.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectOutputRequest clone() => GetPrecompiledObjectOutputRequest()..mergeFromMessage(this);
+  UsageMetric clone() => UsageMetric()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectOutputRequest copyWith(void Function(GetPrecompiledObjectOutputRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectOutputRequest)) as GetPrecompiledObjectOutputRequest; // ignore: deprecated_member_use
+  UsageMetric copyWith(void Function(UsageMetric) updates) => super.copyWith((message) => updates(message as UsageMetric)) as UsageMetric; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectOutputRequest create() => GetPrecompiledObjectOutputRequest._();
-  GetPrecompiledObjectOutputRequest createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectOutputRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectOutputRequest>();
+  static UsageMetric create() => UsageMetric._();
+  UsageMetric createEmptyInstance() => create();
+  static $pb.PbList<UsageMetric> createRepeated() => $pb.PbList<UsageMetric>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectOutputRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectOutputRequest>(create);
-  static GetPrecompiledObjectOutputRequest? _defaultInstance;
+  static UsageMetric getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<UsageMetric>(create);
+  static UsageMetric? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get cloudPath => $_getSZ(0);
+  Sdk get sdk => $_getN(0);
   @$pb.TagNumber(1)
-  set cloudPath($core.String v) { $_setString(0, v); }
+  set sdk(Sdk v) { setField(1, v); }
   @$pb.TagNumber(1)
-  $core.bool hasCloudPath() => $_has(0);
+  $core.bool hasSdk() => $_has(0);
   @$pb.TagNumber(1)
-  void clearCloudPath() => clearField(1);
+  void clearSdk() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get example => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set example($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasExample() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearExample() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get runs => $_getI64(2);
+  @$pb.TagNumber(3)
+  set runs($fixnum.Int64 v) { $_setInt64(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasRuns() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearRuns() => clearField(3);
 }
 
-class GetPrecompiledObjectLogsRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectLogsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+class GetUsageMetricsResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetUsageMetricsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'fromDate')
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'toDate')
+    ..aInt64(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'totalRuns')
+    ..pc<UsageMetric>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdks', $pb.PbFieldType.PM, subBuilder: UsageMetric.create)
+    ..pc<UsageMetric>(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'examples', $pb.PbFieldType.PM, subBuilder: UsageMetric.create)
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectLogsRequest._() : super();
-  factory GetPrecompiledObjectLogsRequest({
-    $core.String? cloudPath,
+  GetUsageMetricsResponse._() : super();
+  factory GetUsageMetricsResponse({
+    $core.String? fromDate,
+    $core.String? toDate,
+    $fixnum.Int64? totalRuns,
+    $core.Iterable<UsageMetric>? sdks,
+    $core.Iterable<UsageMetric>? examples,
   }) {
     final _result = create();
-    if (cloudPath != null) {
-      _result.cloudPath = cloudPath;
+    if (fromDate != null) {
+      _result.fromDate = fromDate;
+    }
+    if (toDate != null) {
+      _result.toDate = toDate;
+    }
+    if (totalRuns != null) {
+      _result.totalRuns = totalRuns;
+    }
+    if (sdks != null) {
+      _result.sdks.addAll(sdks);
+    }
+    if (examples != null) {
+      _result.examples.addAll(examples);
     }
     return _result;
   }
-  factory GetPrecompiledObjectLogsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectLogsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetUsageMetricsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetUsageMetricsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectLogsRequest clone() => GetPrecompiledObjectLogsRequest()..mergeFromMessage(this);
+  GetUsageMetricsResponse clone() => GetUsageMetricsResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectLogsRequest copyWith(void Function(GetPrecompiledObjectLogsRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectLogsRequest)) as GetPrecompiledObjectLogsRequest; // ignore: deprecated_member_use
+  GetUsageMetricsResponse copyWith(void Function(GetUsageMetricsResponse) updates) => super.copyWith((message) => updates(message as GetUsageMetricsResponse)) as GetUsageMetricsResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectLogsRequest create() => GetPrecompiledObjectLogsRequest._();
-  GetPrecompiledObjectLogsRequest createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectLogsRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectLogsRequest>();
+  static GetUsageMetricsResponse create() => GetUsageMetricsResponse._();
+  GetUsageMetricsResponse createEmptyInstance() => create();
+  static $pb.PbList<GetUsageMetricsResponse> createRepeated() => $pb.PbList<GetUsageMetricsResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectLogsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectLogsRequest>(create);
-  static GetPrecompiledObjectLogsRequest? _defaultInstance;
+  static GetUsageMetricsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetUsageMetricsResponse>(create);
+  static GetUsageMetricsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get fromDate => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set fromDate($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasFromDate() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearFromDate() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get toDate => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set toDate($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasToDate() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearToDate() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get totalRuns => $_getI64(2);
+  @$pb.TagNumber(3)
+  set totalRuns($fixnum.Int64 v) { $_setInt64(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasTotalRuns() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearTotalRuns() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.List<UsageMetric> get sdks => $_getList(3);
 
-  @$pb.TagNumber(1)
-  $core.String get cloudPath => $_getSZ(0);
-  @$pb.TagNumber(1)
-  set cloudPath($core.String v) { $_setString(0, v); }
-  @$pb.TagNumber(1)
-  $core.bool hasCloudPath() => $_has(0);
-  @$pb.TagNumber(1)
-  void clearCloudPath() => clearField(1);
+  @$pb.TagNumber(5)
+  $core.List<UsageMetric> get examples => $_getList(4);
 }
 
 class GetPrecompiledObjectsResponse extends $pb.GeneratedMessage {
@@ -1586,3 +3950,417 @@ class GetPrecompiledObjectLogsResponse extends $pb.GeneratedMessage {
   void clearOutput() => clearField(1);
 }
 
+class GetPrecompiledObjectResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOM<PrecompiledObject>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'precompiledObject', subBuilder: PrecompiledObject.create)
+    ..e<Sdk>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'code')
+    ..aOS(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'compileOutput')
+    ..aOS(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'runOutput')
+    ..aOB(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'hasCompileOutput')
+    ..aOB(7, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'hasRunOutput')
+    ..hasRequiredFields = false
+  ;
+
+  GetPrecompiledObjectResponse._() : super();
+  factory GetPrecompiledObjectResponse({
+    PrecompiledObject? precompiledObject,
+    Sdk? sdk,
+    $core.String? code,
+    $core.String? compileOutput,
+    $core.String? runOutput,
+    $core.bool? hasCompileOutput,
+    $core.bool? hasRunOutput,
+  }) {
+    final _result = create();
+    if (precompiledObject != null) {
+      _result.precompiledObject = precompiledObject;
+    }
+    if (sdk != null) {
+      _result.sdk = sdk;
+    }
+    if (code != null) {
+      _result.code = code;
+    }
+    if (compileOutput != null) {
+      _result.compileOutput = compileOutput;
+    }
+    if (runOutput != null) {
+      _result.runOutput = runOutput;
+    }
+    if (hasCompileOutput != null) {
+      _result.hasCompileOutput = hasCompileOutput;
+    }
+    if (hasRunOutput != null) {
+      _result.hasRunOutput = hasRunOutput;
+    }
+    return _result;
+  }
+  factory GetPrecompiledObjectResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectResponse clone() => GetPrecompiledObjectResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectResponse copyWith(void Function(GetPrecompiledObjectResponse) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectResponse)) as GetPrecompiledObjectResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectResponse create() => GetPrecompiledObjectResponse._();
+  GetPrecompiledObjectResponse createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectResponse> createRepeated() => $pb.PbList<GetPrecompiledObjectResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectResponse>(create);
+  static GetPrecompiledObjectResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  PrecompiledObject get precompiledObject => $_getN(0);
+  @$pb.TagNumber(1)
+  set precompiledObject(PrecompiledObject v) { setField(1, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPrecompiledObject() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPrecompiledObject() => clearField(1);
+  @$pb.TagNumber(1)
+  PrecompiledObject ensurePrecompiledObject() => $_ensure(0);
+
+  @$pb.TagNumber(2)
+  Sdk get sdk => $_getN(1);
+  @$pb.TagNumber(2)
+  set sdk(Sdk v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasSdk() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearSdk() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get code => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set code($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasCode() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearCode() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.String get compileOutput => $_getSZ(3);
+  @$pb.TagNumber(4)
+  set compileOutput($core.String v) { $_setString(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasCompileOutput() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearCompileOutput() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.String get runOutput => $_getSZ(4);
+  @$pb.TagNumber(5)
+  set runOutput($core.String v) { $_setString(4, v); }
+  @$pb.TagNumber(5)
+  $core.bool hasRunOutput() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearRunOutput() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.bool get hasCompileOutput => $_getBF(5);
+  @$pb.TagNumber(6)
+  set hasCompileOutput($core.bool v) { $_setBool(5, v); }
+  @$pb.TagNumber(6)
+  $core.bool hasHasCompileOutput() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearHasCompileOutput() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.bool get hasRunOutput => $_getBF(6);
+  @$pb.TagNumber(7)
+  set hasRunOutput($core.bool v) { $_setBool(6, v); }
+  @$pb.TagNumber(7)
+  $core.bool hasHasRunOutput() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearHasRunOutput() => clearField(7);
+}
+
+class GetDefaultPrecompiledObjectResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetDefaultPrecompiledObjectResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOM<PrecompiledObject>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'precompiledObject', subBuilder: PrecompiledObject.create)
+    ..hasRequiredFields = false
+  ;
+
+  GetDefaultPrecompiledObjectResponse._() : super();
+  factory GetDefaultPrecompiledObjectResponse({
+    PrecompiledObject? precompiledObject,
+  }) {
+    final _result = create();
+    if (precompiledObject != null) {
+      _result.precompiledObject = precompiledObject;
+    }
+    return _result;
+  }
+  factory GetDefaultPrecompiledObjectResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetDefaultPrecompiledObjectResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetDefaultPrecompiledObjectResponse clone() => GetDefaultPrecompiledObjectResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetDefaultPrecompiledObjectResponse copyWith(void Function(GetDefaultPrecompiledObjectResponse) updates) => super.copyWith((message) => updates(message as GetDefaultPrecompiledObjectResponse)) as GetDefaultPrecompiledObjectResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetDefaultPrecompiledObjectResponse create() => GetDefaultPrecompiledObjectResponse._();
+  GetDefaultPrecompiledObjectResponse createEmptyInstance() => create();
+  static $pb.PbList<GetDefaultPrecompiledObjectResponse> createRepeated() => $pb.PbList<GetDefaultPrecompiledObjectResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetDefaultPrecompiledObjectResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetDefaultPrecompiledObjectResponse>(create);
+  static GetDefaultPrecompiledObjectResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  PrecompiledObject get precompiledObject => $_getN(0);
+  @$pb.TagNumber(1)
+  set precompiledObject(PrecompiledObject v) { setField(1, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPrecompiledObject() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPrecompiledObject() => clearField(1);
+  @$pb.TagNumber(1)
+  PrecompiledObject ensurePrecompiledObject() => $_ensure(0);
+}
+
+class SearchExamplesResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SearchExamplesResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..pc<PrecompiledObject>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'precompiledObjects', $pb.PbFieldType.PM, subBuilder: PrecompiledObject.create)
+    ..a<$core.int>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'nextOffset', $pb.PbFieldType.O3)
+    ..a<$core.int>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'totalCount', $pb.PbFieldType.O3)
+    ..hasRequiredFields = false
+  ;
+
+  SearchExamplesResponse._() : super();
+  factory SearchExamplesResponse({
+    $core.Iterable<PrecompiledObject>? precompiledObjects,
+    $core.int? nextOffset,
+    $core.int? totalCount,
+  }) {
+    final _result = create();
+    if (precompiledObjects != null) {
+      _result.precompiledObjects.addAll(precompiledObjects);
+    }
+    if (nextOffset != null) {
+      _result.nextOffset = nextOffset;
+    }
+    if (totalCount != null) {
+      _result.totalCount = totalCount;
+    }
+    return _result;
+  }
+  factory SearchExamplesResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SearchExamplesResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  SearchExamplesResponse clone() => SearchExamplesResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  SearchExamplesResponse copyWith(void Function(SearchExamplesResponse) updates) => super.copyWith((message) => updates(message as SearchExamplesResponse)) as SearchExamplesResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static SearchExamplesResponse create() => SearchExamplesResponse._();
+  SearchExamplesResponse createEmptyInstance() => create();
+  static $pb.PbList<SearchExamplesResponse> createRepeated() => $pb.PbList<SearchExamplesResponse>();
+  @$core.pragma('dart2js:noInline')
+  static SearchExamplesResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SearchExamplesResponse>(create);
+  static SearchExamplesResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<PrecompiledObject> get precompiledObjects => $_getList(0);
+
+  @$pb.TagNumber(2)
+  $core.int get nextOffset => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set nextOffset($core.int v) { $_setSignedInt32(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasNextOffset() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearNextOffset() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get totalCount => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set totalCount($core.int v) { $_setSignedInt32(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasTotalCount() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearTotalCount() => clearField(3);
+}
+
+class SaveSnippetResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SaveSnippetResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'id')
+    ..hasRequiredFields = false
+  ;
+
+  SaveSnippetResponse._() : super();
+  factory SaveSnippetResponse({
+    $core.String? id,
+  }) {
+    final _result = create();
+    if (id != null) {
+      _result.id = id;
+    }
+    return _result;
+  }
+  factory SaveSnippetResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SaveSnippetResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  SaveSnippetResponse clone() => SaveSnippetResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  SaveSnippetResponse copyWith(void Function(SaveSnippetResponse) updates) => super.copyWith((message) => updates(message as SaveSnippetResponse)) as SaveSnippetResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static SaveSnippetResponse create() => SaveSnippetResponse._();
+  SaveSnippetResponse createEmptyInstance() => create();
+  static $pb.PbList<SaveSnippetResponse> createRepeated() => $pb.PbList<SaveSnippetResponse>();
+  @$core.pragma('dart2js:noInline')
+  static SaveSnippetResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SaveSnippetResponse>(create);
+  static SaveSnippetResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get id => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set id($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearId() => clearField(1);
+}
+
+class GetSnippetResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetSnippetResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'code')
+    ..e<Sdk>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineOptions')
+    ..hasRequiredFields = false
+  ;
+
+  GetSnippetResponse._() : super();
+  factory GetSnippetResponse({
+    $core.String? code,
+    Sdk? sdk,
+    $core.String? pipelineOptions,
+  }) {
+    final _result = create();
+    if (code != null) {
+      _result.code = code;
+    }
+    if (sdk != null) {
+      _result.sdk = sdk;
+    }
+    if (pipelineOptions != null) {
+      _result.pipelineOptions = pipelineOptions;
+    }
+    return _result;
+  }
+  factory GetSnippetResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetSnippetResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetSnippetResponse clone() => GetSnippetResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetSnippetResponse copyWith(void Function(GetSnippetResponse) updates) => super.copyWith((message) => updates(message as GetSnippetResponse)) as GetSnippetResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetSnippetResponse create() => GetSnippetResponse._();
+  GetSnippetResponse createEmptyInstance() => create();
+  static $pb.PbList<GetSnippetResponse> createRepeated() => $pb.PbList<GetSnippetResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetSnippetResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetSnippetResponse>(create);
+  static GetSnippetResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get code => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set code($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasCode() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCode() => clearField(1);
+
+  @$pb.TagNumber(2)
+  Sdk get sdk => $_getN(1);
+  @$pb.TagNumber(2)
+  set sdk(Sdk v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasSdk() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearSdk() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get pipelineOptions => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set pipelineOptions($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasPipelineOptions() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearPipelineOptions() => clearField(3);
+}
+
+class ListSdksResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ListSdksResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..pc<SdkInfo>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdks', $pb.PbFieldType.PM, subBuilder: SdkInfo.create)
+    ..hasRequiredFields = false
+  ;
+
+  ListSdksResponse._() : super();
+  factory ListSdksResponse({
+    $core.Iterable<SdkInfo>? sdks,
+  }) {
+    final _result = create();
+    if (sdks != null) {
+      _result.sdks.addAll(sdks);
+    }
+    return _result;
+  }
+  factory ListSdksResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ListSdksResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  ListSdksResponse clone() => ListSdksResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  ListSdksResponse copyWith(void Function(ListSdksResponse) updates) => super.copyWith((message) => updates(message as ListSdksResponse)) as ListSdksResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static ListSdksResponse create() => ListSdksResponse._();
+  ListSdksResponse createEmptyInstance() => create();
+  static $pb.PbList<ListSdksResponse> createRepeated() => $pb.PbList<ListSdksResponse>();
+  @$core.pragma('dart2js:noInline')
+  static ListSdksResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ListSdksResponse>(create);
+  static ListSdksResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<SdkInfo> get sdks => $_getList(0);
+}
+
@@ -62,6 +62,7 @@ class Status extends $pb.ProtobufEnum {
   static const Status STATUS_ERROR = Status._(10, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'STATUS_ERROR');
   static const Status STATUS_RUN_TIMEOUT = Status._(11, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'STATUS_RUN_TIMEOUT');
   static const Status STATUS_CANCELED = Status._(12, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'STATUS_CANCELED');
+  static const Status STATUS_WAITING = Status._(13, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'STATUS_WAITING');
 
   static const $core.List<Status> values = <Status> [
     STATUS_UNSPECIFIED,
@@ -77,6 +78,7 @@ class Status extends $pb.ProtobufEnum {
     STATUS_ERROR,
     STATUS_RUN_TIMEOUT,
     STATUS_CANCELED,
+    STATUS_WAITING,
   ];
 
   static final $core.Map<$core.int, Status> _byValue = $pb.ProtobufEnum.initByValue(values);
@@ -85,6 +87,27 @@ class Status extends $pb.ProtobufEnum {
   const Status._($core.int v, $core.String n) : super(v, n);
 }
 
+class LogSeverity extends $pb.ProtobufEnum {
+  static const LogSeverity LOG_SEVERITY_UNSPECIFIED = LogSeverity._(0, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'LOG_SEVERITY_UNSPECIFIED');
+  static const LogSeverity LOG_SEVERITY_DEBUG = LogSeverity._(1, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'LOG_SEVERITY_DEBUG');
+  static const LogSeverity LOG_SEVERITY_INFO = LogSeverity._(2, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'LOG_SEVERITY_INFO');
+  static const LogSeverity LOG_SEVERITY_WARNING = LogSeverity._(3, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'LOG_SEVERITY_WARNING');
+  static const LogSeverity LOG_SEVERITY_ERROR = LogSeverity._(4, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'LOG_SEVERITY_ERROR');
+
+  static const $core.List<LogSeverity> values = <LogSeverity> [
+    LOG_SEVERITY_UNSPECIFIED,
+    LOG_SEVERITY_DEBUG,
+    LOG_SEVERITY_INFO,
+    LOG_SEVERITY_WARNING,
+    LOG_SEVERITY_ERROR,
+  ];
+
+  static final $core.Map<$core.int, LogSeverity> _byValue = $pb.ProtobufEnum.initByValue(values);
+  static LogSeverity? valueOf($core.int value) => _byValue[value];
+
+  const LogSeverity._($core.int v, $core.String n) : super(v, n);
+}
+
 class PrecompiledObjectType extends $pb.ProtobufEnum {
   static const PrecompiledObjectType PRECOMPILED_OBJECT_TYPE_UNSPECIFIED = PrecompiledObjectType._(0, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'PRECOMPILED_OBJECT_TYPE_UNSPECIFIED');
   static const PrecompiledObjectType PRECOMPILED_OBJECT_TYPE_EXAMPLE = PrecompiledObjectType._(1, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'PRECOMPILED_OBJECT_TYPE_EXAMPLE');
@@ -104,3 +127,28 @@ class PrecompiledObjectType extends $pb.ProtobufEnum {
   const PrecompiledObjectType._($core.int v, $core.String n) : super(v, n);
 }
 
+class ErrorCode extends $pb.ProtobufEnum {
+  static const ErrorCode ERROR_CODE_UNSPECIFIED = ErrorCode._(0, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'ERROR_CODE_UNSPECIFIED');
+  static const ErrorCode ERROR_CODE_COMPILE_ERROR = ErrorCode._(1, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'ERROR_CODE_COMPILE_ERROR');
+  static const ErrorCode ERROR_CODE_RUN_ERROR = ErrorCode._(2, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'ERROR_CODE_RUN_ERROR');
+  static const ErrorCode ERROR_CODE_TIMEOUT = ErrorCode._(3, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'ERROR_CODE_TIMEOUT');
+  static const ErrorCode ERROR_CODE_CANCELED = ErrorCode._(4, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'ERROR_CODE_CANCELED');
+  static const ErrorCode ERROR_CODE_RESOURCE_LIMIT = ErrorCode._(5, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'ERROR_CODE_RESOURCE_LIMIT');
+  static const ErrorCode ERROR_CODE_INTERNAL = ErrorCode._(6, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'ERROR_CODE_INTERNAL');
+
+  static const $core.List<ErrorCode> values = <ErrorCode> [
+    ERROR_CODE_UNSPECIFIED,
+    ERROR_CODE_COMPILE_ERROR,
+    ERROR_CODE_RUN_ERROR,
+    ERROR_CODE_TIMEOUT,
+    ERROR_CODE_CANCELED,
+    ERROR_CODE_RESOURCE_LIMIT,
+    ERROR_CODE_INTERNAL,
+  ];
+
+  static final $core.Map<$core.int, ErrorCode> _byValue = $pb.ProtobufEnum.initByValue(values);
+  static ErrorCode? valueOf($core.int value) => _byValue[value];
+
+  const ErrorCode._($core.int v, $core.String n) : super(v, n);
+}
+
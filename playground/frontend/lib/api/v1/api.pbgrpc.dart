@@ -32,6 +32,24 @@ class PlaygroundServiceClient extends $grpc.Client {
           ($0.RunCodeRequest value) => value.writeToBuffer(),
           ($core.List<$core.int> value) =>
               $0.RunCodeResponse.fromBuffer(value));
+  static final _$runProgram =
+      $grpc.ClientMethod<$0.RunCodeRequest, $0.RunProgramResponse>(
+          '/api.v1.PlaygroundService/RunProgram',
+          ($0.RunCodeRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.RunProgramResponse.fromBuffer(value));
+  static final _$validateCode =
+      $grpc.ClientMethod<$0.ValidateCodeRequest, $0.ValidateCodeResponse>(
+          '/api.v1.PlaygroundService/ValidateCode',
+          ($0.ValidateCodeRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.ValidateCodeResponse.fromBuffer(value));
+  static final _$validateOptions =
+      $grpc.ClientMethod<$0.ValidateOptionsRequest, $0.ValidateOptionsResponse>(
+          '/api.v1.PlaygroundService/ValidateOptions',
+          ($0.ValidateOptionsRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.ValidateOptionsResponse.fromBuffer(value));
   static final _$checkStatus =
       $grpc.ClientMethod<$0.CheckStatusRequest, $0.CheckStatusResponse>(
           '/api.v1.PlaygroundService/CheckStatus',
@@ -50,6 +68,36 @@ class PlaygroundServiceClient extends $grpc.Client {
           ($0.GetLogsRequest value) => value.writeToBuffer(),
           ($core.List<$core.int> value) =>
               $0.GetLogsResponse.fromBuffer(value));
+  static final _$getLogEntries =
+      $grpc.ClientMethod<$0.GetLogEntriesRequest, $0.GetLogEntriesResponse>(
+          '/api.v1.PlaygroundService/GetLogEntries',
+          ($0.GetLogEntriesRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.GetLogEntriesResponse.fromBuffer(value));
+  static final _$getRunOutputPage = $grpc.ClientMethod<
+          $0.GetRunOutputPageRequest, $0.GetRunOutputPageResponse>(
+      '/api.v1.PlaygroundService/GetRunOutputPage',
+      ($0.GetRunOutputPageRequest value) => value.writeToBuffer(),
+      ($core.List<$core.int> value) =>
+          $0.GetRunOutputPageResponse.fromBuffer(value));
+  static final _$getLogsPage =
+      $grpc.ClientMethod<$0.GetLogsPageRequest, $0.GetLogsPageResponse>(
+          '/api.v1.PlaygroundService/GetLogsPage',
+          ($0.GetLogsPageRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.GetLogsPageResponse.fromBuffer(value));
+  static final _$streamOutput =
+      $grpc.ClientMethod<$0.StreamOutputRequest, $0.StreamOutputResponse>(
+          '/api.v1.PlaygroundService/StreamOutput',
+          ($0.StreamOutputRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.StreamOutputResponse.fromBuffer(value));
+  static final _$getWorkingDir =
+      $grpc.ClientMethod<$0.GetWorkingDirRequest, $0.GetWorkingDirResponse>(
+          '/api.v1.PlaygroundService/GetWorkingDir',
+          ($0.GetWorkingDirRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.GetWorkingDirResponse.fromBuffer(value));
   static final _$getGraph =
       $grpc.ClientMethod<$0.GetGraphRequest, $0.GetGraphResponse>(
           '/api.v1.PlaygroundService/GetGraph',
@@ -80,17 +128,35 @@ class PlaygroundServiceClient extends $grpc.Client {
       ($0.GetCompileOutputRequest value) => value.writeToBuffer(),
       ($core.List<$core.int> value) =>
           $0.GetCompileOutputResponse.fromBuffer(value));
+  static final _$getExecutionCommand = $grpc.ClientMethod<
+          $0.GetExecutionCommandRequest, $0.GetExecutionCommandResponse>(
+      '/api.v1.PlaygroundService/GetExecutionCommand',
+      ($0.GetExecutionCommandRequest value) => value.writeToBuffer(),
+      ($core.List<$core.int> value) =>
+          $0.GetExecutionCommandResponse.fromBuffer(value));
   static final _$cancel =
       $grpc.ClientMethod<$0.CancelRequest, $0.CancelResponse>(
           '/api.v1.PlaygroundService/Cancel',
           ($0.CancelRequest value) => value.writeToBuffer(),
           ($core.List<$core.int> value) => $0.CancelResponse.fromBuffer(value));
+  static final _$getMetadata =
+      $grpc.ClientMethod<$0.GetMetadataRequest, $0.GetMetadataResponse>(
+          '/api.v1.PlaygroundService/GetMetadata',
+          ($0.GetMetadataRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.GetMetadataResponse.fromBuffer(value));
   static final _$getPrecompiledObjects = $grpc.ClientMethod<
           $0.GetPrecompiledObjectsRequest, $0.GetPrecompiledObjectsResponse>(
       '/api.v1.PlaygroundService/GetPrecompiledObjects',
       ($0.GetPrecompiledObjectsRequest value) => value.writeToBuffer(),
       ($core.List<$core.int> value) =>
           $0.GetPrecompiledObjectsResponse.fromBuffer(value));
+  static final _$getPrecompiledObject = $grpc.ClientMethod<
+          $0.GetPrecompiledObjectRequest, $0.GetPrecompiledObjectResponse>(
+      '/api.v1.PlaygroundService/GetPrecompiledObject',
+      ($0.GetPrecompiledObjectRequest value) => value.writeToBuffer(),
+      ($core.List<$core.int> value) =>
+          $0.GetPrecompiledObjectResponse.fromBuffer(value));
   static final _$getPrecompiledObjectCode = $grpc.ClientMethod<
           $0.GetPrecompiledObjectCodeRequest,
           $0.GetPrecompiledObjectCodeResponse>(
@@ -112,6 +178,43 @@ class PlaygroundServiceClient extends $grpc.Client {
       ($0.GetPrecompiledObjectLogsRequest value) => value.writeToBuffer(),
       ($core.List<$core.int> value) =>
           $0.GetPrecompiledObjectLogsResponse.fromBuffer(value));
+  static final _$getDefaultPrecompiledObject = $grpc.ClientMethod<
+          $0.GetDefaultPrecompiledObjectRequest,
+          $0.GetDefaultPrecompiledObjectResponse>(
+      '/api.v1.PlaygroundService/GetDefaultPrecompiledObject',
+      ($0.GetDefaultPrecompiledObjectRequest value) => value.writeToBuffer(),
+      ($core.List<$core.int> value) =>
+          $0.GetDefaultPrecompiledObjectResponse.fromBuffer(value));
+  static final _$searchExamples =
+      $grpc.ClientMethod<$0.SearchExamplesRequest, $0.SearchExamplesResponse>(
+          '/api.v1.PlaygroundService/SearchExamples',
+          ($0.SearchExamplesRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.SearchExamplesResponse.fromBuffer(value));
+  static final _$saveSnippet =
+      $grpc.ClientMethod<$0.SaveSnippetRequest, $0.SaveSnippetResponse>(
+          '/api.v1.PlaygroundService/SaveSnippet',
+          ($0.SaveSnippetRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.SaveSnippetResponse.fromBuffer(value));
+  static final _$getSnippet =
+      $grpc.ClientMethod<$0.GetSnippetRequest, $0.GetSnippetResponse>(
+          '/api.v1.PlaygroundService/GetSnippet',
+          ($0.GetSnippetRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.GetSnippetResponse.fromBuffer(value));
+  static final _$listSDKs =
+      $grpc.ClientMethod<$0.ListSdksRequest, $0.ListSdksResponse>(
+          '/api.v1.PlaygroundService/ListSDKs',
+          ($0.ListSdksRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.ListSdksResponse.fromBuffer(value));
+  static final _$getUsageMetrics =
+      $grpc.ClientMethod<$0.GetUsageMetricsRequest, $0.GetUsageMetricsResponse>(
+          '/api.v1.PlaygroundService/GetUsageMetrics',
+          ($0.GetUsageMetricsRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.GetUsageMetricsResponse.fromBuffer(value));
 
   PlaygroundServiceClient($grpc.ClientChannel channel,
       {$grpc.CallOptions? options,
@@ -123,6 +226,26 @@ class PlaygroundServiceClient extends $grpc.Client {
     return $createUnaryCall(_$runCode, request, options: options);
   }
 
+  $grpc.ResponseStream<$0.RunProgramResponse> runProgram(
+      $0.RunCodeRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createStreamingCall(
+        _$runProgram, $async.Stream.fromIterable([request]),
+        options: options);
+  }
+
+  $grpc.ResponseFuture<$0.ValidateCodeResponse> validateCode(
+      $0.ValidateCodeRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$validateCode, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.ValidateOptionsResponse> validateOptions(
+      $0.ValidateOptionsRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$validateOptions, request, options: options);
+  }
+
   $grpc.ResponseFuture<$0.CheckStatusResponse> checkStatus(
       $0.CheckStatusRequest request,
       {$grpc.CallOptions? options}) {
@@ -140,6 +263,40 @@ class PlaygroundServiceClient extends $grpc.Client {
     return $createUnaryCall(_$getLogs, request, options: options);
   }
 
+  $grpc.ResponseFuture<$0.GetLogEntriesResponse> getLogEntries(
+      $0.GetLogEntriesRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$getLogEntries, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.GetRunOutputPageResponse> getRunOutputPage(
+      $0.GetRunOutputPageRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$getRunOutputPage, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.GetLogsPageResponse> getLogsPage(
+      $0.GetLogsPageRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$getLogsPage, request, options: options);
+  }
+
+  $grpc.ResponseStream<$0.StreamOutputResponse> streamOutput(
+      $0.StreamOutputRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createStreamingCall(
+        _$streamOutput, $async.Stream.fromIterable([request]),
+        options: options);
+  }
+
+  $grpc.ResponseStream<$0.GetWorkingDirResponse> getWorkingDir(
+      $0.GetWorkingDirRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createStreamingCall(
+        _$getWorkingDir, $async.Stream.fromIterable([request]),
+        options: options);
+  }
+
   $grpc.ResponseFuture<$0.GetGraphResponse> getGraph($0.GetGraphRequest request,
       {$grpc.CallOptions? options}) {
     return $createUnaryCall(_$getGraph, request, options: options);
@@ -169,17 +326,35 @@ class PlaygroundServiceClient extends $grpc.Client {
     return $createUnaryCall(_$getCompileOutput, request, options: options);
   }
 
+  $grpc.ResponseFuture<$0.GetExecutionCommandResponse> getExecutionCommand(
+      $0.GetExecutionCommandRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$getExecutionCommand, request, options: options);
+  }
+
   $grpc.ResponseFuture<$0.CancelResponse> cancel($0.CancelRequest request,
       {$grpc.CallOptions? options}) {
     return $createUnaryCall(_$cancel, request, options: options);
   }
 
+  $grpc.ResponseFuture<$0.GetMetadataResponse> getMetadata(
+      $0.GetMetadataRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$getMetadata, request, options: options);
+  }
+
   $grpc.ResponseFuture<$0.GetPrecompiledObjectsResponse> getPrecompiledObjects(
       $0.GetPrecompiledObjectsRequest request,
       {$grpc.CallOptions? options}) {
     return $createUnaryCall(_$getPrecompiledObjects, request, options: options);
   }
 
+  $grpc.ResponseFuture<$0.GetPrecompiledObjectResponse> getPrecompiledObject(
+      $0.GetPrecompiledObjectRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$getPrecompiledObject, request, options: options);
+  }
+
   $grpc.ResponseFuture<$0.GetPrecompiledObjectCodeResponse>
       getPrecompiledObjectCode($0.GetPrecompiledObjectCodeRequest request,
           {$grpc.CallOptions? options}) {
@@ -200,6 +375,42 @@ class PlaygroundServiceClient extends $grpc.Client {
     return $createUnaryCall(_$getPrecompiledObjectLogs, request,
         options: options);
   }
+
+  $grpc.ResponseFuture<$0.GetDefaultPrecompiledObjectResponse>
+      getDefaultPrecompiledObject($0.GetDefaultPrecompiledObjectRequest request,
+          {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$getDefaultPrecompiledObject, request,
+        options: options);
+  }
+
+  $grpc.ResponseFuture<$0.SearchExamplesResponse> searchExamples(
+      $0.SearchExamplesRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$searchExamples, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.SaveSnippetResponse> saveSnippet(
+      $0.SaveSnippetRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$saveSnippet, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.GetSnippetResponse> getSnippet(
+      $0.GetSnippetRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$getSnippet, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.ListSdksResponse> listSDKs($0.ListSdksRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$listSDKs, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.GetUsageMetricsResponse> getUsageMetrics(
+      $0.GetUsageMetricsRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$getUsageMetrics, request, options: options);
+  }
 }
 
 abstract class PlaygroundServiceBase extends $grpc.Service {
@@ -213,6 +424,31 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
         false,
         ($core.List<$core.int> value) => $0.RunCodeRequest.fromBuffer(value),
         ($0.RunCodeResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.RunCodeRequest, $0.RunProgramResponse>(
+        'RunProgram',
+        runProgram_Pre,
+        false,
+        true,
+        ($core.List<$core.int> value) => $0.RunCodeRequest.fromBuffer(value),
+        ($0.RunProgramResponse value) => value.writeToBuffer()));
+    $addMethod(
+        $grpc.ServiceMethod<$0.ValidateCodeRequest, $0.ValidateCodeResponse>(
+            'ValidateCode',
+            validateCode_Pre,
+            false,
+            false,
+            ($core.List<$core.int> value) =>
+                $0.ValidateCodeRequest.fromBuffer(value),
+            ($0.ValidateCodeResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.ValidateOptionsRequest,
+            $0.ValidateOptionsResponse>(
+        'ValidateOptions',
+        validateOptions_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) =>
+            $0.ValidateOptionsRequest.fromBuffer(value),
+        ($0.ValidateOptionsResponse value) => value.writeToBuffer()));
     $addMethod(
         $grpc.ServiceMethod<$0.CheckStatusRequest, $0.CheckStatusResponse>(
             'CheckStatus',
@@ -238,6 +474,51 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
         false,
         ($core.List<$core.int> value) => $0.GetLogsRequest.fromBuffer(value),
         ($0.GetLogsResponse value) => value.writeToBuffer()));
+    $addMethod(
+        $grpc.ServiceMethod<$0.GetLogEntriesRequest, $0.GetLogEntriesResponse>(
+            'GetLogEntries',
+            getLogEntries_Pre,
+            false,
+            false,
+            ($core.List<$core.int> value) =>
+                $0.GetLogEntriesRequest.fromBuffer(value),
+            ($0.GetLogEntriesResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.GetRunOutputPageRequest,
+            $0.GetRunOutputPageResponse>(
+        'GetRunOutputPage',
+        getRunOutputPage_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) =>
+            $0.GetRunOutputPageRequest.fromBuffer(value),
+        ($0.GetRunOutputPageResponse value) => value.writeToBuffer()));
+    $addMethod(
+        $grpc.ServiceMethod<$0.GetLogsPageRequest, $0.GetLogsPageResponse>(
+            'GetLogsPage',
+            getLogsPage_Pre,
+            false,
+            false,
+            ($core.List<$core.int> value) =>
+                $0.GetLogsPageRequest.fromBuffer(value),
+            ($0.GetLogsPageResponse value) => value.writeToBuffer()));
+    $addMethod(
+        $grpc.ServiceMethod<$0.StreamOutputRequest, $0.StreamOutputResponse>(
+            'StreamOutput',
+            streamOutput_Pre,
+            false,
+            true,
+            ($core.List<$core.int> value) =>
+                $0.StreamOutputRequest.fromBuffer(value),
+            ($0.StreamOutputResponse value) => value.writeToBuffer()));
+    $addMethod(
+        $grpc.ServiceMethod<$0.GetWorkingDirRequest, $0.GetWorkingDirResponse>(
+            'GetWorkingDir',
+            getWorkingDir_Pre,
+            false,
+            true,
+            ($core.List<$core.int> value) =>
+                $0.GetWorkingDirRequest.fromBuffer(value),
+            ($0.GetWorkingDirResponse value) => value.writeToBuffer()));
     $addMethod($grpc.ServiceMethod<$0.GetGraphRequest, $0.GetGraphResponse>(
         'GetGraph',
         getGraph_Pre,
@@ -281,6 +562,15 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
         ($core.List<$core.int> value) =>
             $0.GetCompileOutputRequest.fromBuffer(value),
         ($0.GetCompileOutputResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.GetExecutionCommandRequest,
+            $0.GetExecutionCommandResponse>(
+        'GetExecutionCommand',
+        getExecutionCommand_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) =>
+            $0.GetExecutionCommandRequest.fromBuffer(value),
+        ($0.GetExecutionCommandResponse value) => value.writeToBuffer()));
     $addMethod($grpc.ServiceMethod<$0.CancelRequest, $0.CancelResponse>(
         'Cancel',
         cancel_Pre,
@@ -288,6 +578,15 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
         false,
         ($core.List<$core.int> value) => $0.CancelRequest.fromBuffer(value),
         ($0.CancelResponse value) => value.writeToBuffer()));
+    $addMethod(
+        $grpc.ServiceMethod<$0.GetMetadataRequest, $0.GetMetadataResponse>(
+            'GetMetadata',
+            getMetadata_Pre,
+            false,
+            false,
+            ($core.List<$core.int> value) =>
+                $0.GetMetadataRequest.fromBuffer(value),
+            ($0.GetMetadataResponse value) => value.writeToBuffer()));
     $addMethod($grpc.ServiceMethod<$0.GetPrecompiledObjectsRequest,
             $0.GetPrecompiledObjectsResponse>(
         'GetPrecompiledObjects',
@@ -297,6 +596,15 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
         ($core.List<$core.int> value) =>
             $0.GetPrecompiledObjectsRequest.fromBuffer(value),
         ($0.GetPrecompiledObjectsResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.GetPrecompiledObjectRequest,
+            $0.GetPrecompiledObjectResponse>(
+        'GetPrecompiledObject',
+        getPrecompiledObject_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) =>
+            $0.GetPrecompiledObjectRequest.fromBuffer(value),
+        ($0.GetPrecompiledObjectResponse value) => value.writeToBuffer()));
     $addMethod($grpc.ServiceMethod<$0.GetPrecompiledObjectCodeRequest,
             $0.GetPrecompiledObjectCodeResponse>(
         'GetPrecompiledObjectCode',
@@ -325,6 +633,57 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
         ($core.List<$core.int> value) =>
             $0.GetPrecompiledObjectLogsRequest.fromBuffer(value),
         ($0.GetPrecompiledObjectLogsResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.GetDefaultPrecompiledObjectRequest,
+            $0.GetDefaultPrecompiledObjectResponse>(
+        'GetDefaultPrecompiledObject',
+        getDefaultPrecompiledObject_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) =>
+            $0.GetDefaultPrecompiledObjectRequest.fromBuffer(value),
+        ($0.GetDefaultPrecompiledObjectResponse value) =>
+            value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.SearchExamplesRequest,
+            $0.SearchExamplesResponse>(
+        'SearchExamples',
+        searchExamples_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) =>
+            $0.SearchExamplesRequest.fromBuffer(value),
+        ($0.SearchExamplesResponse value) => value.writeToBuffer()));
+    $addMethod(
+        $grpc.ServiceMethod<$0.SaveSnippetRequest, $0.SaveSnippetResponse>(
+            'SaveSnippet',
+            saveSnippet_Pre,
+            false,
+            false,
+            ($core.List<$core.int> value) =>
+                $0.SaveSnippetRequest.fromBuffer(value),
+            ($0.SaveSnippetResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.GetSnippetRequest, $0.GetSnippetResponse>(
+        'GetSnippet',
+        getSnippet_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) => $0.GetSnippetRequest.fromBuffer(value),
+        ($0.GetSnippetResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.ListSdksRequest, $0.ListSdksResponse>(
+        'ListSDKs',
+        listSDKs_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) => $0.ListSdksRequest.fromBuffer(value),
+        ($0.ListSdksResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.GetUsageMetricsRequest,
+            $0.GetUsageMetricsResponse>(
+        'GetUsageMetrics',
+        getUsageMetrics_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) =>
+            $0.GetUsageMetricsRequest.fromBuffer(value),
+        ($0.GetUsageMetricsResponse value) => value.writeToBuffer()));
   }
 
   $async.Future<$0.RunCodeResponse> runCode_Pre(
@@ -332,6 +691,23 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
     return runCode(call, await request);
   }
 
+  $async.Stream<$0.RunProgramResponse> runProgram_Pre(
+      $grpc.ServiceCall call, $async.Future<$0.RunCodeRequest> request) async* {
+    yield* runProgram(call, await request);
+  }
+
+  $async.Future<$0.ValidateCodeResponse> validateCode_Pre(
+      $grpc.ServiceCall call,
+      $async.Future<$0.ValidateCodeRequest> request) async {
+    return validateCode(call, await request);
+  }
+
+  $async.Future<$0.ValidateOptionsResponse> validateOptions_Pre(
+      $grpc.ServiceCall call,
+      $async.Future<$0.ValidateOptionsRequest> request) async {
+    return validateOptions(call, await request);
+  }
+
   $async.Future<$0.CheckStatusResponse> checkStatus_Pre($grpc.ServiceCall call,
       $async.Future<$0.CheckStatusRequest> request) async {
     return checkStatus(call, await request);
@@ -348,6 +724,35 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
     return getLogs(call, await request);
   }
 
+  $async.Future<$0.GetLogEntriesResponse> getLogEntries_Pre(
+      $grpc.ServiceCall call,
+      $async.Future<$0.GetLogEntriesRequest> request) async {
+    return getLogEntries(call, await request);
+  }
+
+  $async.Future<$0.GetRunOutputPageResponse> getRunOutputPage_Pre(
+      $grpc.ServiceCall call,
+      $async.Future<$0.GetRunOutputPageRequest> request) async {
+    return getRunOutputPage(call, await request);
+  }
+
+  $async.Future<$0.GetLogsPageResponse> getLogsPage_Pre($grpc.ServiceCall call,
+      $async.Future<$0.GetLogsPageRequest> request) async {
+    return getLogsPage(call, await request);
+  }
+
+  $async.Stream<$0.StreamOutputResponse> streamOutput_Pre(
+      $grpc.ServiceCall call,
+      $async.Future<$0.StreamOutputRequest> request) async* {
+    yield* streamOutput(call, await request);
+  }
+
+  $async.Stream<$0.GetWorkingDirResponse> getWorkingDir_Pre(
+      $grpc.ServiceCall call,
+      $async.Future<$0.GetWorkingDirRequest> request) async* {
+    yield* getWorkingDir(call, await request);
+  }
+
   $async.Future<$0.GetGraphResponse> getGraph_Pre(
       $grpc.ServiceCall call, $async.Future<$0.GetGraphRequest> request) async {
     return getGraph(call, await request);
@@ -376,17 +781,34 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
     return getCompileOutput(call, await request);
   }
 
+  $async.Future<$0.GetExecutionCommandResponse> getExecutionCommand_Pre(
+      $grpc.ServiceCall call,
+      $async.Future<$0.GetExecutionCommandRequest> request) async {
+    return getExecutionCommand(call, await request);
+  }
+
   $async.Future<$0.CancelResponse> cancel_Pre(
       $grpc.ServiceCall call, $async.Future<$0.CancelRequest> request) async {
     return cancel(call, await request);
   }
 
+  $async.Future<$0.GetMetadataResponse> getMetadata_Pre($grpc.ServiceCall call,
+      $async.Future<$0.GetMetadataRequest> request) async {
+    return getMetadata(call, await request);
+  }
+
   $async.Future<$0.GetPrecompiledObjectsResponse> getPrecompiledObjects_Pre(
       $grpc.ServiceCall call,
       $async.Future<$0.GetPrecompiledObjectsRequest> request) async {
     return getPrecompiledObjects(call, await request);
   }
 
+  $async.Future<$0.GetPrecompiledObjectResponse> getPrecompiledObject_Pre(
+      $grpc.ServiceCall call,
+      $async.Future<$0.GetPrecompiledObjectRequest> request) async {
+    return getPrecompiledObject(call, await request);
+  }
+
   $async.Future<$0.GetPrecompiledObjectCodeResponse>
       getPrecompiledObjectCode_Pre($grpc.ServiceCall call,
           $async.Future<$0.GetPrecompiledObjectCodeRequest> request) async {
@@ -405,14 +827,63 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
     return getPrecompiledObjectLogs(call, await request);
   }
 
+  $async.Future<$0.GetDefaultPrecompiledObjectResponse>
+      getDefaultPrecompiledObject_Pre($grpc.ServiceCall call,
+          $async.Future<$0.GetDefaultPrecompiledObjectRequest> request) async {
+    return getDefaultPrecompiledObject(call, await request);
+  }
+
+  $async.Future<$0.SearchExamplesResponse> searchExamples_Pre(
+      $grpc.ServiceCall call,
+      $async.Future<$0.SearchExamplesRequest> request) async {
+    return searchExamples(call, await request);
+  }
+
+  $async.Future<$0.SaveSnippetResponse> saveSnippet_Pre($grpc.ServiceCall call,
+      $async.Future<$0.SaveSnippetRequest> request) async {
+    return saveSnippet(call, await request);
+  }
+
+  $async.Future<$0.GetSnippetResponse> getSnippet_Pre($grpc.ServiceCall call,
+      $async.Future<$0.GetSnippetRequest> request) async {
+    return getSnippet(call, await request);
+  }
+
+  $async.Future<$0.ListSdksResponse> listSDKs_Pre(
+      $grpc.ServiceCall call, $async.Future<$0.ListSdksRequest> request) async {
+    return listSDKs(call, await request);
+  }
+
+  $async.Future<$0.GetUsageMetricsResponse> getUsageMetrics_Pre(
+      $grpc.ServiceCall call,
+      $async.Future<$0.GetUsageMetricsRequest> request) async {
+    return getUsageMetrics(call, await request);
+  }
+
   $async.Future<$0.RunCodeResponse> runCode(
       $grpc.ServiceCall call, $0.RunCodeRequest request);
+  $async.Stream<$0.RunProgramResponse> runProgram(
+      $grpc.ServiceCall call, $0.RunCodeRequest request);
+  $async.Future<$0.ValidateCodeResponse> validateCode(
+      $grpc.ServiceCall call, $0.ValidateCodeRequest request);
+  $async.Future<$0.ValidateOptionsResponse> validateOptions(
+      $grpc.ServiceCall call, $0.ValidateOptionsRequest request);
   $async.Future<$0.CheckStatusResponse> checkStatus(
       $grpc.ServiceCall call, $0.CheckStatusRequest request);
   $async.Future<$0.GetRunOutputResponse> getRunOutput(
       $grpc.ServiceCall call, $0.GetRunOutputRequest request);
   $async.Future<$0.GetLogsResponse> getLogs(
       $grpc.ServiceCall call, $0.GetLogsRequest request);
+  $async.Future<$0.GetLogEntriesResponse> getLogEntries(
+      $grpc.ServiceCall call, $0.GetLogEntriesRequest request);
+  $async.Future<$0.GetRunOutputPageResponse> getRunOutputPage(
+      $grpc.ServiceCall call, $0.GetRunOutputPageRequest request);
+  $async.Future<$0.GetLogsPageResponse> getLogsPage(
+      $grpc.ServiceCall call, $0.GetLogsPageRequest request);
+  $async.Stream<$0.StreamOutputResponse> streamOutput(
+      $grpc.ServiceCall call, $0.StreamOutputRequest request);
+  $async.Stream<$0.GetWorkingDirResponse> getWorkingDir(
+      $grpc.ServiceCall call, $0.GetWorkingDirRequest request);
   $async.Future<$0.GetGraphResponse> getGraph(
       $grpc.ServiceCall call, $0.GetGraphRequest request);
   $async.Future<$0.GetRunErrorResponse> getRunError(
@@ -423,10 +894,16 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
       $grpc.ServiceCall call, $0.GetPreparationOutputRequest request);
   $async.Future<$0.GetCompileOutputResponse> getCompileOutput(
       $grpc.ServiceCall call, $0.GetCompileOutputRequest request);
+  $async.Future<$0.GetExecutionCommandResponse> getExecutionCommand(
+      $grpc.ServiceCall call, $0.GetExecutionCommandRequest request);
   $async.Future<$0.CancelResponse> cancel(
       $grpc.ServiceCall call, $0.CancelRequest request);
+  $async.Future<$0.GetMetadataResponse> getMetadata(
+      $grpc.ServiceCall call, $0.GetMetadataRequest request);
   $async.Future<$0.GetPrecompiledObjectsResponse> getPrecompiledObjects(
       $grpc.ServiceCall call, $0.GetPrecompiledObjectsRequest request);
+  $async.Future<$0.GetPrecompiledObjectResponse> getPrecompiledObject(
+      $grpc.ServiceCall call, $0.GetPrecompiledObjectRequest request);
   $async.Future<$0.GetPrecompiledObjectCodeResponse> getPrecompiledObjectCode(
       $grpc.ServiceCall call, $0.GetPrecompiledObjectCodeRequest request);
   $async.Future<$0.GetPrecompiledObjectOutputResponse>
@@ -434,4 +911,17 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
           $grpc.ServiceCall call, $0.GetPrecompiledObjectOutputRequest request);
   $async.Future<$0.GetPrecompiledObjectLogsResponse> getPrecompiledObjectLogs(
       $grpc.ServiceCall call, $0.GetPrecompiledObjectLogsRequest request);
+  $async.Future<$0.GetDefaultPrecompiledObjectResponse>
+      getDefaultPrecompiledObject($grpc.ServiceCall call,
+          $0.GetDefaultPrecompiledObjectRequest request);
+  $async.Future<$0.SearchExamplesResponse> searchExamples(
+      $grpc.ServiceCall call, $0.SearchExamplesRequest request);
+  $async.Future<$0.SaveSnippetResponse> saveSnippet(
+      $grpc.ServiceCall call, $0.SaveSnippetRequest request);
+  $async.Future<$0.GetSnippetResponse> getSnippet(
+      $grpc.ServiceCall call, $0.GetSnippetRequest request);
+  $async.Future<$0.ListSdksResponse> listSDKs(
+      $grpc.ServiceCall call, $0.ListSdksRequest request);
+  $async.Future<$0.GetUsageMetricsResponse> getUsageMetrics(
+      $grpc.ServiceCall call, $0.GetUsageMetricsRequest request);
 }
@@ -51,11 +51,26 @@ const Status$json = const {
     const {'1': 'STATUS_ERROR', '2': 10},
     const {'1': 'STATUS_RUN_TIMEOUT', '2': 11},
     const {'1': 'STATUS_CANCELED', '2': 12},
+    const {'1': 'STATUS_WAITING', '2': 13},
   ],
 };
 
 /// Descriptor for `Status`. Decode as a `google.protobuf.EnumDescriptorProto`.
-final $typed_data.Uint8List statusDescriptor = $convert.base64Decode('CgZTdGF0dXMSFgoSU1RBVFVTX1VOU1BFQ0lGSUVEEAASFQoRU1RBVFVTX1ZBTElEQVRJTkcQARIbChdTVEFUVVNfVkFMSURBVElPTl9FUlJPUhACEhQKEFNUQVRVU19QUkVQQVJJTkcQAxIcChhTVEFUVVNfUFJFUEFSQVRJT05fRVJST1IQBBIUChBTVEFUVVNfQ09NUElMSU5HEAUSGAoUU1RBVFVTX0NPTVBJTEVfRVJST1IQBhIUChBTVEFUVVNfRVhFQ1VUSU5HEAcSEwoPU1RBVFVTX0ZJTklTSEVEEAgSFAoQU1RBVFVTX1JVTl9FUlJPUhAJEhAKDFNUQVRVU19FUlJPUhAKEhYKElNUQVRVU19SVU5fVElNRU9VVBALEhMKD1NUQVRVU19DQU5DRUxFRBAM');
+final $typed_data.Uint8List statusDescriptor = $convert.base64Decode('CgZTdGF0dXMSFgoSU1RBVFVTX1VOU1BFQ0lGSUVEEAASFQoRU1RBVFVTX1ZBTElEQVRJTkcQARIbChdTVEFUVVNfVkFMSURBVElPTl9FUlJPUhACEhQKEFNUQVRVU19QUkVQQVJJTkcQAxIcChhTVEFUVVNfUFJFUEFSQVRJT05fRVJST1IQBBIUChBTVEFUVVNfQ09NUElMSU5HEAUSGAoUU1RBVFVTX0NPTVBJTEVfRVJST1IQBhIUChBTVEFUVVNfRVhFQ1VUSU5HEAcSEwoPU1RBVFVTX0ZJTklTSEVEEAgSFAoQU1RBVFVTX1JVTl9FUlJPUhAJEhAKDFNUQVRVU19FUlJPUhAKEhYKElNUQVRVU19SVU5fVElNRU9VVBALEhMKD1NUQVRVU19DQU5DRUxFRBAMEhIKDlNUQVRVU19XQUlUSU5HEA0=');
+@$core.Deprecated('Use logSeverityDescriptor instead')
+const LogSeverity$json = const {
+  '1': 'LogSeverity',
+  '2': const [
+    const {'1': 'LOG_SEVERITY_UNSPECIFIED', '2': 0},
+    const {'1': 'LOG_SEVERITY_DEBUG', '2': 1},
+    const {'1': 'LOG_SEVERITY_INFO', '2': 2},
+    const {'1': 'LOG_SEVERITY_WARNING', '2': 3},
+    const {'1': 'LOG_SEVERITY_ERROR', '2': 4},
+  ],
+};
+
+/// Descriptor for `LogSeverity`. Decode as a `google.protobuf.EnumDescriptorProto`.
+final $typed_data.Uint8List logSeverityDescriptor = $convert.base64Decode('CgtMb2dTZXZlcml0eRIcChhMT0dfU0VWRVJJVFlfVU5TUEVDSUZJRUQQABIWChJMT0dfU0VWRVJJVFlfREVCVUcQARIVChFMT0dfU0VWRVJJVFlfSU5GTxACEhgKFExPR19TRVZFUklUWV9XQVJOSU5HEAMSFgoSTE9HX1NFVkVSSVRZX0VSUk9SEAQ=');
 @$core.Deprecated('Use precompiledObjectTypeDescriptor instead')
 const PrecompiledObjectType$json = const {
   '1': 'PrecompiledObjectType',
@@ -69,6 +84,32 @@ const PrecompiledObjectType$json = const {
 
 /// Descriptor for `PrecompiledObjectType`. Decode as a `google.protobuf.EnumDescriptorProto`.
 final $typed_data.Uint8List precompiledObjectTypeDescriptor = $convert.base64Decode('ChVQcmVjb21waWxlZE9iamVjdFR5cGUSJwojUFJFQ09NUElMRURfT0JKRUNUX1RZUEVfVU5TUEVDSUZJRUQQABIjCh9QUkVDT01QSUxFRF9PQkpFQ1RfVFlQRV9FWEFNUExFEAESIAocUFJFQ09NUElMRURfT0JKRUNUX1RZUEVfS0FUQRACEiUKIVBSRUNPTVBJTEVEX09CSkVDVF9UWVBFX1VOSVRfVEVTVBAD');
+@$core.Deprecated('Use errorCodeDescriptor instead')
+const ErrorCode$json = const {
+  '1': 'ErrorCode',
+  '2': const [
+    const {'1': 'ERROR_CODE_UNSPECIFIED', '2': 0},
+    const {'1': 'ERROR_CODE_COMPILE_ERROR', '2': 1},
+    const {'1': 'ERROR_CODE_RUN_ERROR', '2': 2},
+    const {'1': 'ERROR_CODE_TIMEOUT', '2': 3},
+    const {'1': 'ERROR_CODE_CANCELED', '2': 4},
+    const {'1': 'ERROR_CODE_RESOURCE_LIMIT', '2': 5},
+    const {'1': 'ERROR_CODE_INTERNAL', '2': 6},
+  ],
+};
+
+/// Descriptor for `ErrorCode`. Decode as a `google.protobuf.EnumDescriptorProto`.
+final $typed_data.Uint8List errorCodeDescriptor = $convert.base64Decode('CglFcnJvckNvZGUSGgoWRVJST1JfQ09ERV9VTlNQRUNJRklFRBAAEhwKGEVSUk9SX0NPREVfQ09NUElMRV9FUlJPUhABEhgKFEVSUk9SX0NPREVfUlVOX0VSUk9SEAISFgoSRVJST1JfQ09ERV9USU1FT1VUEAMSFwoTRVJST1JfQ09ERV9DQU5DRUxFRBAEEh0KGUVSUk9SX0NPREVfUkVTT1VSQ0VfTElNSVQQBRIXChNFUlJPUl9DT0RFX0lOVEVSTkFMEAY=');
+@$core.Deprecated('Use errorDetailsDescriptor instead')
+const ErrorDetails$json = const {
+  '1': 'ErrorDetails',
+  '2': const [
+    const {'1': 'code', '3': 1, '4': 1, '5': 14, '6': '.api.v1.ErrorCode', '10': 'code'},
+  ],
+};
+
+/// Descriptor for `ErrorDetails`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List errorDetailsDescriptor = $convert.base64Decode('CgxFcnJvckRldGFpbHMSJQoEY29kZRgBIAEoDjIRLmFwaS52MS5FcnJvckNvZGVSBGNvZGU=');
 @$core.Deprecated('Use runCodeRequestDescriptor instead')
 const RunCodeRequest$json = const {
   '1': 'RunCodeRequest',
@@ -76,11 +117,31 @@ const RunCodeRequest$json = const {
     const {'1': 'code', '3': 1, '4': 1, '5': 9, '10': 'code'},
     const {'1': 'sdk', '3': 2, '4': 1, '5': 14, '6': '.api.v1.Sdk', '10': 'sdk'},
     const {'1': 'pipeline_options', '3': 3, '4': 1, '5': 9, '10': 'pipelineOptions'},
+    const {'1': 'nondeterministic', '3': 4, '4': 1, '5': 8, '10': 'nondeterministic'},
+    const {'1': 'requirements', '3': 5, '4': 3, '5': 9, '10': 'requirements'},
+    const {'1': 'stdin', '3': 6, '4': 1, '5': 9, '10': 'stdin'},
+    const {'1': 'env_vars', '3': 7, '4': 3, '5': 11, '6': '.api.v1.RunCodeRequest.EnvVarsEntry', '10': 'envVars'},
+    const {'1': 'runner', '3': 8, '4': 1, '5': 9, '10': 'runner'},
+    const {'1': 'deadline', '3': 9, '4': 1, '5': 11, '6': '.google.protobuf.Duration', '10': 'deadline'},
+    const {'1': 'example_cloud_path', '3': 10, '4': 1, '5': 9, '10': 'exampleCloudPath'},
+    const {'1': 'keep_working_dir', '3': 11, '4': 1, '5': 8, '10': 'keepWorkingDir'},
+    const {'1': 'snippet_id', '3': 12, '4': 1, '5': 9, '10': 'snippetId'},
   ],
+  '3': const [RunCodeRequest_EnvVarsEntry$json],
+};
+
+@$core.Deprecated('Use runCodeRequestDescriptor instead')
+const RunCodeRequest_EnvVarsEntry$json = const {
+  '1': 'EnvVarsEntry',
+  '2': const [
+    const {'1': 'key', '3': 1, '4': 1, '5': 9, '10': 'key'},
+    const {'1': 'value', '3': 2, '4': 1, '5': 9, '10': 'value'},
+  ],
+  '7': const {'7': true},
 };
 
 /// Descriptor for `RunCodeRequest`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List runCodeRequestDescriptor = $convert.base64Decode('Cg5SdW5Db2RlUmVxdWVzdBISCgRjb2RlGAEgASgJUgRjb2RlEh0KA3NkaxgCIAEoDjILLmFwaS52MS5TZGtSA3NkaxIpChBwaXBlbGluZV9vcHRpb25zGAMgASgJUg9waXBlbGluZU9wdGlvbnM=');
+final $typed_data.Uint8List runCodeRequestDescriptor = $convert.base64Decode('Cg5SdW5Db2RlUmVxdWVzdBISCgRjb2RlGAEgASgJUgRjb2RlEh0KA3NkaxgCIAEoDjILLmFwaS52MS5TZGtSA3NkaxIpChBwaXBlbGluZV9vcHRpb25zGAMgASgJUg9waXBlbGluZU9wdGlvbnMSKgoQbm9uZGV0ZXJtaW5pc3RpYxgEIAEoCFIQbm9uZGV0ZXJtaW5pc3RpYxIiCgxyZXF1aXJlbWVudHMYBSADKAlSDHJlcXVpcmVtZW50cxIUCgVzdGRpbhgGIAEoCVIFc3RkaW4SPgoIZW52X3ZhcnMYByADKAsyIy5hcGkudjEuUnVuQ29kZVJlcXVlc3QuRW52VmFyc0VudHJ5UgdlbnZWYXJzEhYKBnJ1bm5lchgIIAEoCVIGcnVubmVyEjUKCGRlYWRsaW5lGAkgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uUghkZWFkbGluZRIsChJleGFtcGxlX2Nsb3VkX3BhdGgYCiABKAlSEGV4YW1wbGVDbG91ZFBhdGgSKAoQa2VlcF93b3JraW5nX2RpchgLIAEoCFIOa2VlcFdvcmtpbmdEaXISHQoKc25pcHBldF9pZBgMIAEoCVIJc25pcHBldElkGjoKDEVudlZhcnNFbnRyeRIQCgNrZXkYASABKAlSA2tleRIUCgV2YWx1ZRgCIAEoCVIFdmFsdWU6AjgB');
 @$core.Deprecated('Use runCodeResponseDescriptor instead')
 const RunCodeResponse$json = const {
   '1': 'RunCodeResponse',
@@ -91,6 +152,75 @@ const RunCodeResponse$json = const {
 
 /// Descriptor for `RunCodeResponse`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List runCodeResponseDescriptor = $convert.base64Decode('Cg9SdW5Db2RlUmVzcG9uc2USIwoNcGlwZWxpbmVfdXVpZBgBIAEoCVIMcGlwZWxpbmVVdWlk');
+@$core.Deprecated('Use runProgramResponseDescriptor instead')
+const RunProgramResponse$json = const {
+  '1': 'RunProgramResponse',
+  '2': const [
+    const {'1': 'pipeline_uuid', '3': 1, '4': 1, '5': 9, '10': 'pipelineUuid'},
+    const {'1': 'status', '3': 2, '4': 1, '5': 14, '6': '.api.v1.Status', '10': 'status'},
+  ],
+};
+
+/// Descriptor for `RunProgramResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List runProgramResponseDescriptor = $convert.base64Decode('ChJSdW5Qcm9ncmFtUmVzcG9uc2USIwoNcGlwZWxpbmVfdXVpZBgBIAEoCVIMcGlwZWxpbmVVdWlkEiYKBnN0YXR1cxgCIAEoDjIOLmFwaS52MS5TdGF0dXNSBnN0YXR1cw==');
+@$core.Deprecated('Use validateCodeRequestDescriptor instead')
+const ValidateCodeRequest$json = const {
+  '1': 'ValidateCodeRequest',
+  '2': const [
+    const {'1': 'code', '3': 1, '4': 1, '5': 9, '10': 'code'},
+    const {'1': 'sdk', '3': 2, '4': 1, '5': 14, '6': '.api.v1.Sdk', '10': 'sdk'},
+  ],
+};
+
+/// Descriptor for `ValidateCodeRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List validateCodeRequestDescriptor = $convert.base64Decode('ChNWYWxpZGF0ZUNvZGVSZXF1ZXN0EhIKBGNvZGUYASABKAlSBGNvZGUSHQoDc2RrGAIgASgOMgsuYXBpLnYxLlNka1IDc2Rr');
+@$core.Deprecated('Use validateCodeResponseDescriptor instead')
+const ValidateCodeResponse$json = const {
+  '1': 'ValidateCodeResponse',
+  '2': const [
+    const {'1': 'pipeline_uuid', '3': 1, '4': 1, '5': 9, '10': 'pipelineUuid'},
+    const {'1': 'status', '3': 2, '4': 1, '5': 14, '6': '.api.v1.Status', '10': 'status'},
+    const {'1': 'output', '3': 3, '4': 1, '5': 9, '10': 'output'},
+  ],
+};
+
+/// Descriptor for `ValidateCodeResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List validateCodeResponseDescriptor = $convert.base64Decode('ChRWYWxpZGF0ZUNvZGVSZXNwb25zZRIjCg1waXBlbGluZV91dWlkGAEgASgJUgxwaXBlbGluZVV1aWQSJgoGc3RhdHVzGAIgASgOMg4uYXBpLnYxLlN0YXR1c1IGc3RhdHVzEhYKBm91dHB1dBgDIAEoCVIGb3V0cHV0');
+@$core.Deprecated('Use validateOptionsRequestDescriptor instead')
+const ValidateOptionsRequest$json = const {
+  '1': 'ValidateOptionsRequest',
+  '2': const [
+    const {'1': 'sdk', '3': 1, '4': 1, '5': 14, '6': '.api.v1.Sdk', '10': 'sdk'},
+    const {'1': 'pipeline_options', '3': 2, '4': 1, '5': 9, '10': 'pipelineOptions'},
+  ],
+};
+
+/// Descriptor for `ValidateOptionsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List validateOptionsRequestDescriptor = $convert.base64Decode('ChZWYWxpZGF0ZU9wdGlvbnNSZXF1ZXN0Eh0KA3NkaxgBIAEoDjILLmFwaS52MS5TZGtSA3NkaxIpChBwaXBlbGluZV9vcHRpb25zGAIgASgJUg9waXBlbGluZU9wdGlvbnM=');
+@$core.Deprecated('Use optionDiagnosticDescriptor instead')
+const OptionDiagnostic$json = const {
+  '1': 'OptionDiagnostic',
+  '2': const [
+    const {'1': 'option', '3': 1, '4': 1, '5': 9, '10': 'option'},
+    const {'1': 'name', '3': 2, '4': 1, '5': 9, '10': 'name'},
+    const {'1': 'severity', '3': 3, '4': 1, '5': 14, '6': '.api.v1.LogSeverity', '10': 'severity'},
+    const {'1': 'message', '3': 4, '4': 1, '5': 9, '10': 'message'},
+  ],
+};
+
+/// Descriptor for `OptionDiagnostic`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List optionDiagnosticDescriptor = $convert.base64Decode('ChBPcHRpb25EaWFnbm9zdGljEhYKBm9wdGlvbhgBIAEoCVIGb3B0aW9uEhIKBG5hbWUYAiABKAlSBG5hbWUSLwoIc2V2ZXJpdHkYAyABKA4yEy5hcGkudjEuTG9nU2V2ZXJpdHlSCHNldmVyaXR5EhgKB21lc3NhZ2UYBCABKAlSB21lc3NhZ2U=');
+@$core.Deprecated('Use validateOptionsResponseDescriptor instead')
+const ValidateOptionsResponse$json = const {
+  '1': 'ValidateOptionsResponse',
+  '2': const [
+    const {'1': 'valid', '3': 1, '4': 1, '5': 8, '10': 'valid'},
+    const {'1': 'diagnostics', '3': 2, '4': 3, '5': 11, '6': '.api.v1.OptionDiagnostic', '10': 'diagnostics'},
+  ],
+};
+
+/// Descriptor for `ValidateOptionsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List validateOptionsResponseDescriptor = $convert.base64Decode('ChdWYWxpZGF0ZU9wdGlvbnNSZXNwb25zZRIUCgV2YWxpZBgBIAEoCFIFdmFsaWQSOgoLZGlhZ25vc3RpY3MYAiADKAsyGC5hcGkudjEuT3B0aW9uRGlhZ25vc3RpY1ILZGlhZ25vc3RpY3M=');
 @$core.Deprecated('Use checkStatusRequestDescriptor instead')
 const CheckStatusRequest$json = const {
   '1': 'CheckStatusRequest',
@@ -161,16 +291,52 @@ const GetCompileOutputRequest$json = const {
 
 /// Descriptor for `GetCompileOutputRequest`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List getCompileOutputRequestDescriptor = $convert.base64Decode('ChdHZXRDb21waWxlT3V0cHV0UmVxdWVzdBIjCg1waXBlbGluZV91dWlkGAEgASgJUgxwaXBlbGluZVV1aWQ=');
+@$core.Deprecated('Use compileDiagnosticDescriptor instead')
+const CompileDiagnostic$json = const {
+  '1': 'CompileDiagnostic',
+  '2': const [
+    const {'1': 'file', '3': 1, '4': 1, '5': 9, '10': 'file'},
+    const {'1': 'line', '3': 2, '4': 1, '5': 5, '10': 'line'},
+    const {'1': 'column', '3': 3, '4': 1, '5': 5, '10': 'column'},
+    const {'1': 'severity', '3': 4, '4': 1, '5': 14, '6': '.api.v1.LogSeverity', '10': 'severity'},
+    const {'1': 'message', '3': 5, '4': 1, '5': 9, '10': 'message'},
+    const {'1': 'raw', '3': 6, '4': 1, '5': 9, '10': 'raw'},
+  ],
+};
+
+/// Descriptor for `CompileDiagnostic`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List compileDiagnosticDescriptor = $convert.base64Decode('ChFDb21waWxlRGlhZ25vc3RpYxISCgRmaWxlGAEgASgJUgRmaWxlEhIKBGxpbmUYAiABKAVSBGxpbmUSFgoGY29sdW1uGAMgASgFUgZjb2x1bW4SLwoIc2V2ZXJpdHkYBCABKA4yEy5hcGkudjEuTG9nU2V2ZXJpdHlSCHNldmVyaXR5EhgKB21lc3NhZ2UYBSABKAlSB21lc3NhZ2USEAoDcmF3GAYgASgJUgNyYXc=');
 @$core.Deprecated('Use getCompileOutputResponseDescriptor instead')
 const GetCompileOutputResponse$json = const {
   '1': 'GetCompileOutputResponse',
   '2': const [
     const {'1': 'output', '3': 1, '4': 1, '5': 9, '10': 'output'},
+    const {'1': 'diagnostics', '3': 2, '4': 3, '5': 11, '6': '.api.v1.CompileDiagnostic', '10': 'diagnostics'},
   ],
 };
 
 /// Descriptor for `GetCompileOutputResponse`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List getCompileOutputResponseDescriptor = $convert.base64Decode('ChhHZXRDb21waWxlT3V0cHV0UmVzcG9uc2USFgoGb3V0cHV0GAEgASgJUgZvdXRwdXQ=');
+final $typed_data.Uint8List getCompileOutputResponseDescriptor = $convert.base64Decode('ChhHZXRDb21waWxlT3V0cHV0UmVzcG9uc2USFgoGb3V0cHV0GAEgASgJUgZvdXRwdXQSOwoLZGlhZ25vc3RpY3MYAiADKAsyGS5hcGkudjEuQ29tcGlsZURpYWdub3N0aWNSC2RpYWdub3N0aWNz');
+@$core.Deprecated('Use getExecutionCommandRequestDescriptor instead')
+const GetExecutionCommandRequest$json = const {
+  '1': 'GetExecutionCommandRequest',
+  '2': const [
+    const {'1': 'pipeline_uuid', '3': 1, '4': 1, '5': 9, '10': 'pipelineUuid'},
+  ],
+};
+
+/// Descriptor for `GetExecutionCommandRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getExecutionCommandRequestDescriptor = $convert.base64Decode('ChpHZXRFeGVjdXRpb25Db21tYW5kUmVxdWVzdBIjCg1waXBlbGluZV91dWlkGAEgASgJUgxwaXBlbGluZVV1aWQ=');
+@$core.Deprecated('Use getExecutionCommandResponseDescriptor instead')
+const GetExecutionCommandResponse$json = const {
+  '1': 'GetExecutionCommandResponse',
+  '2': const [
+    const {'1': 'command', '3': 1, '4': 1, '5': 9, '10': 'command'},
+  ],
+};
+
+/// Descriptor for `GetExecutionCommandResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getExecutionCommandResponseDescriptor = $convert.base64Decode('ChtHZXRFeGVjdXRpb25Db21tYW5kUmVzcG9uc2USGAoHY29tbWFuZBgBIAEoCVIHY29tbWFuZA==');
 @$core.Deprecated('Use getRunOutputRequestDescriptor instead')
 const GetRunOutputRequest$json = const {
   '1': 'GetRunOutputRequest',
@@ -231,6 +397,41 @@ const GetLogsResponse$json = const {
 
 /// Descriptor for `GetLogsResponse`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List getLogsResponseDescriptor = $convert.base64Decode('Cg9HZXRMb2dzUmVzcG9uc2USFgoGb3V0cHV0GAEgASgJUgZvdXRwdXQ=');
+@$core.Deprecated('Use getLogEntriesRequestDescriptor instead')
+const GetLogEntriesRequest$json = const {
+  '1': 'GetLogEntriesRequest',
+  '2': const [
+    const {'1': 'pipeline_uuid', '3': 1, '4': 1, '5': 9, '10': 'pipelineUuid'},
+    const {'1': 'min_severity', '3': 2, '4': 1, '5': 14, '6': '.api.v1.LogSeverity', '10': 'minSeverity'},
+    const {'1': 'start_time', '3': 3, '4': 1, '5': 11, '6': '.google.protobuf.Timestamp', '10': 'startTime'},
+    const {'1': 'end_time', '3': 4, '4': 1, '5': 11, '6': '.google.protobuf.Timestamp', '10': 'endTime'},
+  ],
+};
+
+/// Descriptor for `GetLogEntriesRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getLogEntriesRequestDescriptor = $convert.base64Decode('ChRHZXRMb2dFbnRyaWVzUmVxdWVzdBIjCg1waXBlbGluZV91dWlkGAEgASgJUgxwaXBlbGluZVV1aWQSNgoMbWluX3NldmVyaXR5GAIgASgOMhMuYXBpLnYxLkxvZ1NldmVyaXR5UgttaW5TZXZlcml0eRI5CgpzdGFydF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcFIJc3RhcnRUaW1lEjUKCGVuZF90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcFIHZW5kVGltZQ==');
+@$core.Deprecated('Use logEntryDescriptor instead')
+const LogEntry$json = const {
+  '1': 'LogEntry',
+  '2': const [
+    const {'1': 'severity', '3': 1, '4': 1, '5': 14, '6': '.api.v1.LogSeverity', '10': 'severity'},
+    const {'1': 'timestamp', '3': 2, '4': 1, '5': 11, '6': '.google.protobuf.Timestamp', '10': 'timestamp'},
+    const {'1': 'message', '3': 3, '4': 1, '5': 9, '10': 'message'},
+  ],
+};
+
+/// Descriptor for `LogEntry`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List logEntryDescriptor = $convert.base64Decode('CghMb2dFbnRyeRIvCghzZXZlcml0eRgBIAEoDjITLmFwaS52MS5Mb2dTZXZlcml0eVIIc2V2ZXJpdHkSOAoJdGltZXN0YW1wGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcFIJdGltZXN0YW1wEhgKB21lc3NhZ2UYAyABKAlSB21lc3NhZ2U=');
+@$core.Deprecated('Use getLogEntriesResponseDescriptor instead')
+const GetLogEntriesResponse$json = const {
+  '1': 'GetLogEntriesResponse',
+  '2': const [
+    const {'1': 'entries', '3': 1, '4': 3, '5': 11, '6': '.api.v1.LogEntry', '10': 'entries'},
+  ],
+};
+
+/// Descriptor for `GetLogEntriesResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getLogEntriesResponseDescriptor = $convert.base64Decode('ChVHZXRMb2dFbnRyaWVzUmVzcG9uc2USKgoHZW50cmllcxgBIAMoCzIQLmFwaS52MS5Mb2dFbnRyeVIHZW50cmllcw==');
 @$core.Deprecated('Use getGraphRequestDescriptor instead')
 const GetGraphRequest$json = const {
   '1': 'GetGraphRequest',
@@ -246,11 +447,14 @@ const GetGraphResponse$json = const {
   '1': 'GetGraphResponse',
   '2': const [
     const {'1': 'graph', '3': 1, '4': 1, '5': 9, '10': 'graph'},
+    const {'1': 'ready', '3': 2, '4': 1, '5': 8, '10': 'ready'},
+    const {'1': 'dot', '3': 3, '4': 1, '5': 9, '10': 'dot'},
+    const {'1': 'json', '3': 4, '4': 1, '5': 9, '10': 'json'},
   ],
 };
 
 /// Descriptor for `GetGraphResponse`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List getGraphResponseDescriptor = $convert.base64Decode('ChBHZXRHcmFwaFJlc3BvbnNlEhQKBWdyYXBoGAEgASgJUgVncmFwaA==');
+final $typed_data.Uint8List getGraphResponseDescriptor = $convert.base64Decode('ChBHZXRHcmFwaFJlc3BvbnNlEhQKBWdyYXBoGAEgASgJUgVncmFwaBIUCgVyZWFkeRgCIAEoCFIFcmVhZHkSEAoDZG90GAMgASgJUgNkb3QSEgoEanNvbhgEIAEoCVIEanNvbg==');
 @$core.Deprecated('Use cancelRequestDescriptor instead')
 const CancelRequest$json = const {
   '1': 'CancelRequest',
@@ -268,6 +472,127 @@ const CancelResponse$json = const {
 
 /// Descriptor for `CancelResponse`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List cancelResponseDescriptor = $convert.base64Decode('Cg5DYW5jZWxSZXNwb25zZQ==');
+@$core.Deprecated('Use getRunOutputPageRequestDescriptor instead')
+const GetRunOutputPageRequest$json = const {
+  '1': 'GetRunOutputPageRequest',
+  '2': const [
+    const {'1': 'pipeline_uuid', '3': 1, '4': 1, '5': 9, '10': 'pipelineUuid'},
+    const {'1': 'offset', '3': 2, '4': 1, '5': 3, '10': 'offset'},
+    const {'1': 'limit', '3': 3, '4': 1, '5': 3, '10': 'limit'},
+  ],
+};
+
+/// Descriptor for `GetRunOutputPageRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getRunOutputPageRequestDescriptor = $convert.base64Decode('ChdHZXRSdW5PdXRwdXRQYWdlUmVxdWVzdBIjCg1waXBlbGluZV91dWlkGAEgASgJUgxwaXBlbGluZVV1aWQSFgoGb2Zmc2V0GAIgASgDUgZvZmZzZXQSFAoFbGltaXQYAyABKANSBWxpbWl0');
+@$core.Deprecated('Use getRunOutputPageResponseDescriptor instead')
+const GetRunOutputPageResponse$json = const {
+  '1': 'GetRunOutputPageResponse',
+  '2': const [
+    const {'1': 'output', '3': 1, '4': 1, '5': 9, '10': 'output'},
+    const {'1': 'next_offset', '3': 2, '4': 1, '5': 3, '10': 'nextOffset'},
+    const {'1': 'total_size', '3': 3, '4': 1, '5': 3, '10': 'totalSize'},
+  ],
+};
+
+/// Descriptor for `GetRunOutputPageResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getRunOutputPageResponseDescriptor = $convert.base64Decode('ChhHZXRSdW5PdXRwdXRQYWdlUmVzcG9uc2USFgoGb3V0cHV0GAEgASgJUgZvdXRwdXQSHwoLbmV4dF9vZmZzZXQYAiABKANSCm5leHRPZmZzZXQSHQoKdG90YWxfc2l6ZRgDIAEoA1IJdG90YWxTaXpl');
+@$core.Deprecated('Use streamOutputRequestDescriptor instead')
+const StreamOutputRequest$json = const {
+  '1': 'StreamOutputRequest',
+  '2': const [
+    const {'1': 'pipeline_uuid', '3': 1, '4': 1, '5': 9, '10': 'pipelineUuid'},
+  ],
+};
+
+/// Descriptor for `StreamOutputRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List streamOutputRequestDescriptor = $convert.base64Decode('ChNTdHJlYW1PdXRwdXRSZXF1ZXN0EiMKDXBpcGVsaW5lX3V1aWQYASABKAlSDHBpcGVsaW5lVXVpZA==');
+@$core.Deprecated('Use streamOutputResponseDescriptor instead')
+const StreamOutputResponse$json = const {
+  '1': 'StreamOutputResponse',
+  '2': const [
+    const {'1': 'status', '3': 1, '4': 1, '5': 14, '6': '.api.v1.Status', '10': 'status'},
+    const {'1': 'run_output', '3': 2, '4': 1, '5': 9, '10': 'runOutput'},
+  ],
+};
+
+/// Descriptor for `StreamOutputResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List streamOutputResponseDescriptor = $convert.base64Decode('ChRTdHJlYW1PdXRwdXRSZXNwb25zZRImCgZzdGF0dXMYASABKA4yDi5hcGkudjEuU3RhdHVzUgZzdGF0dXMSHQoKcnVuX291dHB1dBgCIAEoCVIJcnVuT3V0cHV0');
+@$core.Deprecated('Use getWorkingDirRequestDescriptor instead')
+const GetWorkingDirRequest$json = const {
+  '1': 'GetWorkingDirRequest',
+  '2': const [
+    const {'1': 'pipeline_uuid', '3': 1, '4': 1, '5': 9, '10': 'pipelineUuid'},
+  ],
+};
+
+/// Descriptor for `GetWorkingDirRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getWorkingDirRequestDescriptor = $convert.base64Decode('ChRHZXRXb3JraW5nRGlyUmVxdWVzdBIjCg1waXBlbGluZV91dWlkGAEgASgJUgxwaXBlbGluZVV1aWQ=');
+@$core.Deprecated('Use getWorkingDirResponseDescriptor instead')
+const GetWorkingDirResponse$json = const {
+  '1': 'GetWorkingDirResponse',
+  '2': const [
+    const {'1': 'chunk', '3': 1, '4': 1, '5': 12, '10': 'chunk'},
+  ],
+};
+
+/// Descriptor for `GetWorkingDirResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getWorkingDirResponseDescriptor = $convert.base64Decode('ChVHZXRXb3JraW5nRGlyUmVzcG9uc2USFAoFY2h1bmsYASABKAxSBWNodW5r');
+@$core.Deprecated('Use getLogsPageRequestDescriptor instead')
+const GetLogsPageRequest$json = const {
+  '1': 'GetLogsPageRequest',
+  '2': const [
+    const {'1': 'pipeline_uuid', '3': 1, '4': 1, '5': 9, '10': 'pipelineUuid'},
+    const {'1': 'offset', '3': 2, '4': 1, '5': 3, '10': 'offset'},
+    const {'1': 'limit', '3': 3, '4': 1, '5': 3, '10': 'limit'},
+  ],
+};
+
+/// Descriptor for `GetLogsPageRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getLogsPageRequestDescriptor = $convert.base64Decode('ChJHZXRMb2dzUGFnZVJlcXVlc3QSIwoNcGlwZWxpbmVfdXVpZBgBIAEoCVIMcGlwZWxpbmVVdWlkEhYKBm9mZnNldBgCIAEoA1IGb2Zmc2V0EhQKBWxpbWl0GAMgASgDUgVsaW1pdA==');
+@$core.Deprecated('Use getLogsPageResponseDescriptor instead')
+const GetLogsPageResponse$json = const {
+  '1': 'GetLogsPageResponse',
+  '2': const [
+    const {'1': 'output', '3': 1, '4': 1, '5': 9, '10': 'output'},
+    const {'1': 'next_offset', '3': 2, '4': 1, '5': 3, '10': 'nextOffset'},
+    const {'1': 'total_size', '3': 3, '4': 1, '5': 3, '10': 'totalSize'},
+  ],
+};
+
+/// Descriptor for `GetLogsPageResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getLogsPageResponseDescriptor = $convert.base64Decode('ChNHZXRMb2dzUGFnZVJlc3BvbnNlEhYKBm91dHB1dBgBIAEoCVIGb3V0cHV0Eh8KC25leHRfb2Zmc2V0GAIgASgDUgpuZXh0T2Zmc2V0Eh0KCnRvdGFsX3NpemUYAyABKANSCXRvdGFsU2l6ZQ==');
+@$core.Deprecated('Use getMetadataRequestDescriptor instead')
+const GetMetadataRequest$json = const {
+  '1': 'GetMetadataRequest',
+  '2': const [
+    const {'1': 'pipeline_uuid', '3': 1, '4': 1, '5': 9, '10': 'pipelineUuid'},
+  ],
+};
+
+/// Descriptor for `GetMetadataRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getMetadataRequestDescriptor = $convert.base64Decode('ChJHZXRNZXRhZGF0YVJlcXVlc3QSIwoNcGlwZWxpbmVfdXVpZBgBIAEoCVIMcGlwZWxpbmVVdWlk');
+@$core.Deprecated('Use subKeyMetadataDescriptor instead')
+const SubKeyMetadata$json = const {
+  '1': 'SubKeyMetadata',
+  '2': const [
+    const {'1': 'sub_key', '3': 1, '4': 1, '5': 9, '10': 'subKey'},
+    const {'1': 'size', '3': 2, '4': 1, '5': 3, '10': 'size'},
+    const {'1': 'ttl_seconds', '3': 3, '4': 1, '5': 3, '10': 'ttlSeconds'},
+  ],
+};
+
+/// Descriptor for `SubKeyMetadata`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List subKeyMetadataDescriptor = $convert.base64Decode('Cg5TdWJLZXlNZXRhZGF0YRIXCgdzdWJfa2V5GAEgASgJUgZzdWJLZXkSEgoEc2l6ZRgCIAEoA1IEc2l6ZRIfCgt0dGxfc2Vjb25kcxgDIAEoA1IKdHRsU2Vjb25kcw==');
+@$core.Deprecated('Use getMetadataResponseDescriptor instead')
+const GetMetadataResponse$json = const {
+  '1': 'GetMetadataResponse',
+  '2': const [
+    const {'1': 'sub_keys', '3': 1, '4': 3, '5': 11, '6': '.api.v1.SubKeyMetadata', '10': 'subKeys'},
+  ],
+};
+
+/// Descriptor for `GetMetadataResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getMetadataResponseDescriptor = $convert.base64Decode('ChNHZXRNZXRhZGF0YVJlc3BvbnNlEjEKCHN1Yl9rZXlzGAEgAygLMhYuYXBpLnYxLlN1YktleU1ldGFkYXRhUgdzdWJLZXlz');
 @$core.Deprecated('Use precompiledObjectDescriptor instead')
 const PrecompiledObject$json = const {
   '1': 'PrecompiledObject',
@@ -345,6 +670,119 @@ const GetPrecompiledObjectLogsRequest$json = const {
 
 /// Descriptor for `GetPrecompiledObjectLogsRequest`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List getPrecompiledObjectLogsRequestDescriptor = $convert.base64Decode('Ch9HZXRQcmVjb21waWxlZE9iamVjdExvZ3NSZXF1ZXN0Eh0KCmNsb3VkX3BhdGgYASABKAlSCWNsb3VkUGF0aA==');
+@$core.Deprecated('Use getPrecompiledObjectRequestDescriptor instead')
+const GetPrecompiledObjectRequest$json = const {
+  '1': 'GetPrecompiledObjectRequest',
+  '2': const [
+    const {'1': 'cloud_path', '3': 1, '4': 1, '5': 9, '10': 'cloudPath'},
+  ],
+};
+
+/// Descriptor for `GetPrecompiledObjectRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getPrecompiledObjectRequestDescriptor = $convert.base64Decode('ChtHZXRQcmVjb21waWxlZE9iamVjdFJlcXVlc3QSHQoKY2xvdWRfcGF0aBgBIAEoCVIJY2xvdWRQYXRo');
+@$core.Deprecated('Use getDefaultPrecompiledObjectRequestDescriptor instead')
+const GetDefaultPrecompiledObjectRequest$json = const {
+  '1': 'GetDefaultPrecompiledObjectRequest',
+  '2': const [
+    const {'1': 'sdk', '3': 1, '4': 1, '5': 14, '6': '.api.v1.Sdk', '10': 'sdk'},
+  ],
+};
+
+/// Descriptor for `GetDefaultPrecompiledObjectRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getDefaultPrecompiledObjectRequestDescriptor = $convert.base64Decode('CiJHZXREZWZhdWx0UHJlY29tcGlsZWRPYmplY3RSZXF1ZXN0Eh0KA3NkaxgBIAEoDjILLmFwaS52MS5TZGtSA3Nkaw==');
+@$core.Deprecated('Use searchExamplesRequestDescriptor instead')
+const SearchExamplesRequest$json = const {
+  '1': 'SearchExamplesRequest',
+  '2': const [
+    const {'1': 'sdks', '3': 1, '4': 3, '5': 14, '6': '.api.v1.Sdk', '10': 'sdks'},
+    const {'1': 'category', '3': 2, '4': 1, '5': 9, '10': 'category'},
+    const {'1': 'term', '3': 3, '4': 1, '5': 9, '10': 'term'},
+    const {'1': 'offset', '3': 4, '4': 1, '5': 5, '10': 'offset'},
+    const {'1': 'limit', '3': 5, '4': 1, '5': 5, '10': 'limit'},
+  ],
+};
+
+/// Descriptor for `SearchExamplesRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List searchExamplesRequestDescriptor = $convert.base64Decode('ChVTZWFyY2hFeGFtcGxlc1JlcXVlc3QSHwoEc2RrcxgBIAMoDjILLmFwaS52MS5TZGtSBHNka3MSGgoIY2F0ZWdvcnkYAiABKAlSCGNhdGVnb3J5EhIKBHRlcm0YAyABKAlSBHRlcm0SFgoGb2Zmc2V0GAQgASgFUgZvZmZzZXQSFAoFbGltaXQYBSABKAVSBWxpbWl0');
+@$core.Deprecated('Use saveSnippetRequestDescriptor instead')
+const SaveSnippetRequest$json = const {
+  '1': 'SaveSnippetRequest',
+  '2': const [
+    const {'1': 'code', '3': 1, '4': 1, '5': 9, '10': 'code'},
+    const {'1': 'sdk', '3': 2, '4': 1, '5': 14, '6': '.api.v1.Sdk', '10': 'sdk'},
+    const {'1': 'pipeline_options', '3': 3, '4': 1, '5': 9, '10': 'pipelineOptions'},
+  ],
+};
+
+/// Descriptor for `SaveSnippetRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List saveSnippetRequestDescriptor = $convert.base64Decode('ChJTYXZlU25pcHBldFJlcXVlc3QSEgoEY29kZRgBIAEoCVIEY29kZRIdCgNzZGsYAiABKA4yCy5hcGkudjEuU2RrUgNzZGsSKQoQcGlwZWxpbmVfb3B0aW9ucxgDIAEoCVIPcGlwZWxpbmVPcHRpb25z');
+@$core.Deprecated('Use getSnippetRequestDescriptor instead')
+const GetSnippetRequest$json = const {
+  '1': 'GetSnippetRequest',
+  '2': const [
+    const {'1': 'id', '3': 1, '4': 1, '5': 9, '10': 'id'},
+  ],
+};
+
+/// Descriptor for `GetSnippetRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getSnippetRequestDescriptor = $convert.base64Decode('ChFHZXRTbmlwcGV0UmVxdWVzdBIOCgJpZBgBIAEoCVICaWQ=');
+@$core.Deprecated('Use listSdksRequestDescriptor instead')
+const ListSdksRequest$json = const {
+  '1': 'ListSdksRequest',
+};
+
+/// Descriptor for `ListSdksRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List listSdksRequestDescriptor = $convert.base64Decode('Cg9MaXN0U2Rrc1JlcXVlc3Q=');
+@$core.Deprecated('Use sdkInfoDescriptor instead')
+const SdkInfo$json = const {
+  '1': 'SdkInfo',
+  '2': const [
+    const {'1': 'sdk', '3': 1, '4': 1, '5': 14, '6': '.api.v1.Sdk', '10': 'sdk'},
+    const {'1': 'enabled', '3': 2, '4': 1, '5': 8, '10': 'enabled'},
+    const {'1': 'version', '3': 3, '4': 1, '5': 9, '10': 'version'},
+    const {'1': 'disabled_reason', '3': 4, '4': 1, '5': 9, '10': 'disabledReason'},
+  ],
+};
+
+/// Descriptor for `SdkInfo`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List sdkInfoDescriptor = $convert.base64Decode('CgdTZGtJbmZvEh0KA3NkaxgBIAEoDjILLmFwaS52MS5TZGtSA3NkaxIYCgdlbmFibGVkGAIgASgIUgdlbmFibGVkEhgKB3ZlcnNpb24YAyABKAlSB3ZlcnNpb24SJwoPZGlzYWJsZWRfcmVhc29uGAQgASgJUg5kaXNhYmxlZFJlYXNvbg==');
+@$core.Deprecated('Use getUsageMetricsRequestDescriptor instead')
+const GetUsageMetricsRequest$json = const {
+  '1': 'GetUsageMetricsRequest',
+  '2': const [
+    const {'1': 'from_date', '3': 1, '4': 1, '5': 9, '10': 'fromDate'},
+    const {'1': 'to_date', '3': 2, '4': 1, '5': 9, '10': 'toDate'},
+  ],
+};
+
+/// Descriptor for `GetUsageMetricsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getUsageMetricsRequestDescriptor = $convert.base64Decode('ChZHZXRVc2FnZU1ldHJpY3NSZXF1ZXN0EhsKCWZyb21fZGF0ZRgBIAEoCVIIZnJvbURhdGUSFwoHdG9fZGF0ZRgCIAEoCVIGdG9EYXRl');
+@$core.Deprecated('Use usageMetricDescriptor instead')
+const UsageMetric$json = const {
+  '1': 'UsageMetric',
+  '2': const [
+    const {'1': 'sdk', '3': 1, '4': 1, '5': 14, '6': '.api.v1.Sdk', '10': 'sdk'},
+    const {'1': 'example', '3': 2, '4': 1, '5': 9, '10': 'example'},
+    const {'1': 'runs', '3': 3, '4': 1, '5': 3, '10': 'runs'},
+  ],
+};
+
+/// Descriptor for `UsageMetric`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List usageMetricDescriptor = $convert.base64Decode('CgtVc2FnZU1ldHJpYxIdCgNzZGsYASABKA4yCy5hcGkudjEuU2RrUgNzZGsSGAoHZXhhbXBsZRgCIAEoCVIHZXhhbXBsZRISCgRydW5zGAMgASgDUgRydW5z');
+@$core.Deprecated('Use getUsageMetricsResponseDescriptor instead')
+const GetUsageMetricsResponse$json = const {
+  '1': 'GetUsageMetricsResponse',
+  '2': const [
+    const {'1': 'from_date', '3': 1, '4': 1, '5': 9, '10': 'fromDate'},
+    const {'1': 'to_date', '3': 2, '4': 1, '5': 9, '10': 'toDate'},
+    const {'1': 'total_runs', '3': 3, '4': 1, '5': 3, '10': 'totalRuns'},
+    const {'1': 'sdks', '3': 4, '4': 3, '5': 11, '6': '.api.v1.UsageMetric', '10': 'sdks'},
+    const {'1': 'examples', '3': 5, '4': 3, '5': 11, '6': '.api.v1.UsageMetric', '10': 'examples'},
+  ],
+};
+
+/// Descriptor for `GetUsageMetricsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getUsageMetricsResponseDescriptor = $convert.base64Decode('ChdHZXRVc2FnZU1ldHJpY3NSZXNwb25zZRIbCglmcm9tX2RhdGUYASABKAlSCGZyb21EYXRlEhcKB3RvX2RhdGUYAiABKAlSBnRvRGF0ZRIdCgp0b3RhbF9ydW5zGAMgASgDUgl0b3RhbFJ1bnMSJwoEc2RrcxgEIAMoCzITLmFwaS52MS5Vc2FnZU1ldHJpY1IEc2RrcxIvCghleGFtcGxlcxgFIAMoCzITLmFwaS52MS5Vc2FnZU1ldHJpY1IIZXhhbXBsZXM=');
 @$core.Deprecated('Use getPrecompiledObjectsResponseDescriptor instead')
 const GetPrecompiledObjectsResponse$json = const {
   '1': 'GetPrecompiledObjectsResponse',
@@ -385,3 +823,73 @@ const GetPrecompiledObjectLogsResponse$json = const {
 
 /// Descriptor for `GetPrecompiledObjectLogsResponse`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List getPrecompiledObjectLogsResponseDescriptor = $convert.base64Decode('CiBHZXRQcmVjb21waWxlZE9iamVjdExvZ3NSZXNwb25zZRIWCgZvdXRwdXQYASABKAlSBm91dHB1dA==');
+@$core.Deprecated('Use getPrecompiledObjectResponseDescriptor instead')
+const GetPrecompiledObjectResponse$json = const {
+  '1': 'GetPrecompiledObjectResponse',
+  '2': const [
+    const {'1': 'precompiled_object', '3': 1, '4': 1, '5': 11, '6': '.api.v1.PrecompiledObject', '10': 'precompiledObject'},
+    const {'1': 'sdk', '3': 2, '4': 1, '5': 14, '6': '.api.v1.Sdk', '10': 'sdk'},
+    const {'1': 'code', '3': 3, '4': 1, '5': 9, '10': 'code'},
+    const {'1': 'compile_output', '3': 4, '4': 1, '5': 9, '10': 'compileOutput'},
+    const {'1': 'run_output', '3': 5, '4': 1, '5': 9, '10': 'runOutput'},
+    const {'1': 'has_compile_output', '3': 6, '4': 1, '5': 8, '10': 'hasCompileOutput'},
+    const {'1': 'has_run_output', '3': 7, '4': 1, '5': 8, '10': 'hasRunOutput'},
+  ],
+};
+
+/// Descriptor for `GetPrecompiledObjectResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getPrecompiledObjectResponseDescriptor = $convert.base64Decode('ChxHZXRQcmVjb21waWxlZE9iamVjdFJlc3BvbnNlEkgKEnByZWNvbXBpbGVkX29iamVjdBgBIAEoCzIZLmFwaS52MS5QcmVjb21waWxlZE9iamVjdFIRcHJlY29tcGlsZWRPYmplY3QSHQoDc2RrGAIgASgOMgsuYXBpLnYxLlNka1IDc2RrEhIKBGNvZGUYAyABKAlSBGNvZGUSJQoOY29tcGlsZV9vdXRwdXQYBCABKAlSDWNvbXBpbGVPdXRwdXQSHQoKcnVuX291dHB1dBgFIAEoCVIJcnVuT3V0cHV0EiwKEmhhc19jb21waWxlX291dHB1dBgGIAEoCFIQaGFzQ29tcGlsZU91dHB1dBIkCg5oYXNfcnVuX291dHB1dBgHIAEoCFIMaGFzUnVuT3V0cHV0');
+@$core.Deprecated('Use getDefaultPrecompiledObjectResponseDescriptor instead')
+const GetDefaultPrecompiledObjectResponse$json = const {
+  '1': 'GetDefaultPrecompiledObjectResponse',
+  '2': const [
+    const {'1': 'precompiled_object', '3': 1, '4': 1, '5': 11, '6': '.api.v1.PrecompiledObject', '10': 'precompiledObject'},
+  ],
+};
+
+/// Descriptor for `GetDefaultPrecompiledObjectResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getDefaultPrecompiledObjectResponseDescriptor = $convert.base64Decode('CiNHZXREZWZhdWx0UHJlY29tcGlsZWRPYmplY3RSZXNwb25zZRJIChJwcmVjb21waWxlZF9vYmplY3QYASABKAsyGS5hcGkudjEuUHJlY29tcGlsZWRPYmplY3RSEXByZWNvbXBpbGVkT2JqZWN0');
+@$core.Deprecated('Use searchExamplesResponseDescriptor instead')
+const SearchExamplesResponse$json = const {
+  '1': 'SearchExamplesResponse',
+  '2': const [
+    const {'1': 'precompiled_objects', '3': 1, '4': 3, '5': 11, '6': '.api.v1.PrecompiledObject', '10': 'precompiledObjects'},
+    const {'1': 'next_offset', '3': 2, '4': 1, '5': 5, '10': 'nextOffset'},
+    const {'1': 'total_count', '3': 3, '4': 1, '5': 5, '10': 'totalCount'},
+  ],
+};
+
+/// Descriptor for `SearchExamplesResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List searchExamplesResponseDescriptor = $convert.base64Decode('ChZTZWFyY2hFeGFtcGxlc1Jlc3BvbnNlEkoKE3ByZWNvbXBpbGVkX29iamVjdHMYASADKAsyGS5hcGkudjEuUHJlY29tcGlsZWRPYmplY3RSEnByZWNvbXBpbGVkT2JqZWN0cxIfCgtuZXh0X29mZnNldBgCIAEoBVIKbmV4dE9mZnNldBIfCgt0b3RhbF9jb3VudBgDIAEoBVIKdG90YWxDb3VudA==');
+@$core.Deprecated('Use saveSnippetResponseDescriptor instead')
+const SaveSnippetResponse$json = const {
+  '1': 'SaveSnippetResponse',
+  '2': const [
+    const {'1': 'id', '3': 1, '4': 1, '5': 9, '10': 'id'},
+  ],
+};
+
+/// Descriptor for `SaveSnippetResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List saveSnippetResponseDescriptor = $convert.base64Decode('ChNTYXZlU25pcHBldFJlc3BvbnNlEg4KAmlkGAEgASgJUgJpZA==');
+@$core.Deprecated('Use getSnippetResponseDescriptor instead')
+const GetSnippetResponse$json = const {
+  '1': 'GetSnippetResponse',
+  '2': const [
+    const {'1': 'code', '3': 1, '4': 1, '5': 9, '10': 'code'},
+    const {'1': 'sdk', '3': 2, '4': 1, '5': 14, '6': '.api.v1.Sdk', '10': 'sdk'},
+    const {'1': 'pipeline_options', '3': 3, '4': 1, '5': 9, '10': 'pipelineOptions'},
+  ],
+};
+
+/// Descriptor for `GetSnippetResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getSnippetResponseDescriptor = $convert.base64Decode('ChJHZXRTbmlwcGV0UmVzcG9uc2USEgoEY29kZRgBIAEoCVIEY29kZRIdCgNzZGsYAiABKA4yCy5hcGkudjEuU2RrUgNzZGsSKQoQcGlwZWxpbmVfb3B0aW9ucxgDIAEoCVIPcGlwZWxpbmVPcHRpb25z');
+@$core.Deprecated('Use listSdksResponseDescriptor instead')
+const ListSdksResponse$json = const {
+  '1': 'ListSdksResponse',
+  '2': const [
+    const {'1': 'sdks', '3': 1, '4': 3, '5': 11, '6': '.api.v1.SdkInfo', '10': 'sdks'},
+  ],
+};
+
+/// Descriptor for `ListSdksResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List listSdksResponseDescriptor = $convert.base64Decode('ChBMaXN0U2Rrc1Jlc3BvbnNlEiMKBHNka3MYASADKAsyDy5hcGkudjEuU2RrSW5mb1IEc2Rrcw==');
@@ -188,6 +188,8 @@ class GrpcCodeClient implements CodeClient {
     switch (status) {
       case grpc.Status.STATUS_UNSPECIFIED:
         return RunCodeStatus.unspecified;
+      case grpc.Status.STATUS_WAITING:
+        return RunCodeStatus.waiting;
       case grpc.Status.STATUS_VALIDATING:
       case grpc.Status.STATUS_PREPARING:
         return RunCodeStatus.preparation;
@@ -20,6 +20,7 @@ import 'package:flutter/material.dart';
 
 enum RunCodeStatus {
   unspecified,
+  waiting,
   preparation,
   preparationError,
   validationError,
@@ -27,6 +27,8 @@ from google.protobuf import symbol_database as _symbol_database
 _sym_db = _symbol_database.Default()
 
 
+from google.protobuf import duration_pb2 as google_dot_protobuf_dot_duration__pb2
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
 DESCRIPTOR = _descriptor.FileDescriptor(
@@ -35,8 +37,9 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'Z6beam.apache.org/playground/backend/internal;playground',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\tapi.proto\x12\x06\x61pi.v1\"R\n\x0eRunCodeRequest\x12\x0c\n\x04\x63ode\x18\x01 \x01(\t\x12\x18\n\x03sdk\x18\x02 \x01(\x0e\x32\x0b.api.v1.Sdk\x12\x18\n\x10pipeline_options\x18\x03 \x01(\t\"(\n\x0fRunCodeResponse\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"+\n\x12\x43heckStatusRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"5\n\x13\x43heckStatusResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0e\x32\x0e.api.v1.Status\"3\n\x1aGetValidationOutputRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"-\n\x1bGetValidationOutputResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\"4\n\x1bGetPreparationOutputRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\".\n\x1cGetPreparationOutputResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\"0\n\x17GetCompileOutputRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"*\n\x18GetCompileOutputResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\",\n\x13GetRunOutputRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"&\n\x14GetRunOutputResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\"+\n\x12GetRunErrorRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"%\n\x13GetRunErrorResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\"\'\n\x0eGetLogsRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"!\n\x0fGetLogsResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\"&\n\rCancelRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"\x10\n\x0e\x43\x61ncelResponse\"\x9f\x01\n\x11PrecompiledObject\x12\x12\n\ncloud_path\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12+\n\x04type\x18\x04 \x01(\x0e\x32\x1d.api.v1.PrecompiledObjectType\x12\x18\n\x10pipeline_options\x18\x05 \x01(\t\x12\x0c\n\x04link\x18\x06 \x01(\t\"\xb2\x01\n\nCategories\x12\x18\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.Sdk\x12/\n\ncategories\x18\x02 \x03(\x0b\x32\x1b.api.v1.Categories.Category\x1aY\n\x08\x43\x61tegory\x12\x15\n\rcategory_name\x18\x01 \x01(\t\x12\x36\n\x13precompiled_objects\x18\x02 \x03(\x0b\x32\x19.api.v1.PrecompiledObject\"J\n\x1cGetPrecompiledObjectsRequest\x12\x18\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.Sdk\x12\x10\n\x08\x63\x61tegory\x18\x02 \x01(\t\"5\n\x1fGetPrecompiledObjectCodeRequest\x12\x12\n\ncloud_path\x18\x01 \x01(\t\"7\n!GetPrecompiledObjectOutputRequest\x12\x12\n\ncloud_path\x18\x01 \x01(\t\"5\n\x1fGetPrecompiledObjectLogsRequest\x12\x12\n\ncloud_path\x18\x01 \x01(\t\">\n\"GetDefaultPrecompiledObjectRequest\x12\x18\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.Sdk\"K\n\x1dGetPrecompiledObjectsResponse\x12*\n\x0esdk_categories\x18\x01 \x03(\x0b\x32\x12.api.v1.Categories\"0\n GetPrecompiledObjectCodeResponse\x12\x0c\n\x04\x63ode\x18\x01 \x01(\t\"4\n\"GetPrecompiledObjectOutputResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\"2\n GetPrecompiledObjectLogsResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\"\\\n#GetDefaultPrecompiledObjectResponse\x12\x35\n\x12precompiled_object\x18\x01 \x01(\x0b\x32\x19.api.v1.PrecompiledObject*R\n\x03Sdk\x12\x13\n\x0fSDK_UNSPECIFIED\x10\x00\x12\x0c\n\x08SDK_JAVA\x10\x01\x12\n\n\x06SDK_GO\x10\x02\x12\x0e\n\nSDK_PYTHON\x10\x03\x12\x0c\n\x08SDK_SCIO\x10\x04*\xb8\x02\n\x06Status\x12\x16\n\x12STATUS_UNSPECIFIED\x10\x00\x12\x15\n\x11STATUS_VALIDATING\x10\x01\x12\x1b\n\x17STATUS_VALIDATION_ERROR\x10\x02\x12\x14\n\x10STATUS_PREPARING\x10\x03\x12\x1c\n\x18STATUS_PREPARATION_ERROR\x10\x04\x12\x14\n\x10STATUS_COMPILING\x10\x05\x12\x18\n\x14STATUS_COMPILE_ERROR\x10\x06\x12\x14\n\x10STATUS_EXECUTING\x10\x07\x12\x13\n\x0fSTATUS_FINISHED\x10\x08\x12\x14\n\x10STATUS_RUN_ERROR\x10\t\x12\x10\n\x0cSTATUS_ERROR\x10\n\x12\x16\n\x12STATUS_RUN_TIMEOUT\x10\x0b\x12\x13\n\x0fSTATUS_CANCELED\x10\x0c*\xae\x01\n\x15PrecompiledObjectType\x12\'\n#PRECOMPILED_OBJECT_TYPE_UNSPECIFIED\x10\x00\x12#\n\x1fPRECOMPILED_OBJECT_TYPE_EXAMPLE\x10\x01\x12 \n\x1cPRECOMPILED_OBJECT_TYPE_KATA\x10\x02\x12%\n!PRECOMPILED_OBJECT_TYPE_UNIT_TEST\x10\x03\x32\xea\t\n\x11PlaygroundService\x12:\n\x07RunCode\x12\x16.api.v1.RunCodeRequest\x1a\x17.api.v1.RunCodeResponse\x12\x46\n\x0b\x43heckStatus\x12\x1a.api.v1.CheckStatusRequest\x1a\x1b.api.v1.CheckStatusResponse\x12I\n\x0cGetRunOutput\x12\x1b.api.v1.GetRunOutputRequest\x1a\x1c.api.v1.GetRunOutputResponse\x12:\n\x07GetLogs\x12\x16.api.v1.GetLogsRequest\x1a\x17.api.v1.GetLogsResponse\x12\x46\n\x0bGetRunError\x12\x1a.api.v1.GetRunErrorRequest\x1a\x1b.api.v1.GetRunErrorResponse\x12^\n\x13GetValidationOutput\x12\".api.v1.GetValidationOutputRequest\x1a#.api.v1.GetValidationOutputResponse\x12\x61\n\x14GetPreparationOutput\x12#.api.v1.GetPreparationOutputRequest\x1a$.api.v1.GetPreparationOutputResponse\x12U\n\x10GetCompileOutput\x12\x1f.api.v1.GetCompileOutputRequest\x1a .api.v1.GetCompileOutputResponse\x12\x37\n\x06\x43\x61ncel\x12\x15.api.v1.CancelRequest\x1a\x16.api.v1.CancelResponse\x12\x64\n\x15GetPrecompiledObjects\x12$.api.v1.GetPrecompiledObjectsRequest\x1a%.api.v1.GetPrecompiledObjectsResponse\x12m\n\x18GetPrecompiledObjectCode\x12\'.api.v1.GetPrecompiledObjectCodeRequest\x1a(.api.v1.GetPrecompiledObjectCodeResponse\x12s\n\x1aGetPrecompiledObjectOutput\x12).api.v1.GetPrecompiledObjectOutputRequest\x1a*.api.v1.GetPrecompiledObjectOutputResponse\x12m\n\x18GetPrecompiledObjectLogs\x12\'.api.v1.GetPrecompiledObjectLogsRequest\x1a(.api.v1.GetPrecompiledObjectLogsResponse\x12v\n\x1bGetDefaultPrecompiledObject\x12*.api.v1.GetDefaultPrecompiledObjectRequest\x1a+.api.v1.GetDefaultPrecompiledObjectResponseB8Z6beam.apache.org/playground/backend/internal;playgroundb\x06proto3'
-)
+  serialized_pb=b'\n\tapi.proto\x12\x06\x61pi.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"/\n\x0c\x45rrorDetails\x12\x1f\n\x04\x63ode\x18\x01 \x01(\x0e\x32\x11.api.v1.ErrorCode\"\xff\x02\n\x0eRunCodeRequest\x12\x0c\n\x04\x63ode\x18\x01 \x01(\t\x12\x18\n\x03sdk\x18\x02 \x01(\x0e\x32\x0b.api.v1.Sdk\x12\x18\n\x10pipeline_options\x18\x03 \x01(\t\x12\x18\n\x10nondeterministic\x18\x04 \x01(\x08\x12\x14\n\x0crequirements\x18\x05 \x03(\t\x12\r\n\x05stdin\x18\x06 \x01(\t\x12\x35\n\x08\x65nv_vars\x18\x07 \x03(\x0b\x32#.api.v1.RunCodeRequest.EnvVarsEntry\x12\x0e\n\x06runner\x18\x08 \x01(\t\x12+\n\x08\x64\x65\x61\x64line\x18\t \x01(\x0b\x32\x19.google.protobuf.Duration\x12\x1a\n\x12\x65xample_cloud_path\x18\n \x01(\t\x12\x18\n\x10keep_working_dir\x18\x0b \x01(\x08\x12\x12\n\nsnippet_id\x18\x0c \x01(\t\x1a.\n\x0c\x45nvVarsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"(\n\x0fRunCodeResponse\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"K\n\x12RunProgramResponse\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\x12\x1e\n\x06status\x18\x02 \x01(\x0e\x32\x0e.api.v1.Status\"=\n\x13ValidateCodeRequest\x12\x0c\n\x04\x63ode\x18\x01 \x01(\t\x12\x18\n\x03sdk\x18\x02 \x01(\x0e\x32\x0b.api.v1.Sdk\"]\n\x14ValidateCodeResponse\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\x12\x1e\n\x06status\x18\x02 \x01(\x0e\x32\x0e.api.v1.Status\x12\x0e\n\x06output\x18\x03 \x01(\t\"L\n\x16ValidateOptionsRequest\x12\x18\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.Sdk\x12\x18\n\x10pipeline_options\x18\x02 \x01(\t\"h\n\x10OptionDiagnostic\x12\x0e\n\x06option\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12%\n\x08severity\x18\x03 \x01(\x0e\x32\x13.api.v1.LogSeverity\x12\x0f\n\x07message\x18\x04 \x01(\t\"W\n\x17ValidateOptionsResponse\x12\r\n\x05valid\x18\x01 \x01(\x08\x12-\n\x0b\x64iagnostics\x18\x02 \x03(\x0b\x32\x18.api.v1.OptionDiagnostic\"+\n\x12\x43heckStatusRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"5\n\x13\x43heckStatusResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0e\x32\x0e.api.v1.Status\"3\n\x1aGetValidationOutputRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"-\n\x1bGetValidationOutputResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\"4\n\x1bGetPreparationOutputRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\".\n\x1cGetPreparationOutputResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\"0\n\x17GetCompileOutputRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"\x84\x01\n\x11\x43ompileDiagnostic\x12\x0c\n\x04\x66ile\x18\x01 \x01(\t\x12\x0c\n\x04line\x18\x02 \x01(\x05\x12\x0e\n\x06\x63olumn\x18\x03 \x01(\x05\x12%\n\x08severity\x18\x04 \x01(\x0e\x32\x13.api.v1.LogSeverity\x12\x0f\n\x07message\x18\x05 \x01(\t\x12\x0b\n\x03raw\x18\x06 \x01(\t\"Z\n\x18GetCompileOutputResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\x12.\n\x0b\x64iagnostics\x18\x02 \x03(\x0b\x32\x19.api.v1.CompileDiagnostic\"3\n\x1aGetExecutionCommandRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\".\n\x1bGetExecutionCommandResponse\x12\x0f\n\x07\x63ommand\x18\x01 \x01(\t\",\n\x13GetRunOutputRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"&\n\x14GetRunOutputResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\"+\n\x12GetRunErrorRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"%\n\x13GetRunErrorResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\"\'\n\x0eGetLogsRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"!\n\x0fGetLogsResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\"\xb6\x01\n\x14GetLogEntriesRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\x12)\n\x0cmin_severity\x18\x02 \x01(\x0e\x32\x13.api.v1.LogSeverity\x12.\n\nstart_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08\x65nd_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"q\n\x08LogEntry\x12%\n\x08severity\x18\x01 \x01(\x0e\x32\x13.api.v1.LogSeverity\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07message\x18\x03 \x01(\t\":\n\x15GetLogEntriesResponse\x12!\n\x07\x65ntries\x18\x01 \x03(\x0b\x32\x10.api.v1.LogEntry\"(\n\x0fGetGraphRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"K\n\x10GetGraphResponse\x12\r\n\x05graph\x18\x01 \x01(\t\x12\r\n\x05ready\x18\x02 \x01(\x08\x12\x0b\n\x03\x64ot\x18\x03 \x01(\t\x12\x0c\n\x04json\x18\x04 \x01(\t\"&\n\rCancelRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"\x10\n\x0e\x43\x61ncelResponse\"O\n\x17GetRunOutputPageRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\x12\x0e\n\x06offset\x18\x02 \x01(\x03\x12\r\n\x05limit\x18\x03 \x01(\x03\"S\n\x18GetRunOutputPageResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\x12\x13\n\x0bnext_offset\x18\x02 \x01(\x03\x12\x12\n\ntotal_size\x18\x03 \x01(\x03\",\n\x13StreamOutputRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"J\n\x14StreamOutputResponse\x12\x1e\n\x06status\x18\x01 \x01(\x0e\x32\x0e.api.v1.Status\x12\x12\n\nrun_output\x18\x02 \x01(\t\"-\n\x14GetWorkingDirRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"&\n\x15GetWorkingDirResponse\x12\r\n\x05\x63hunk\x18\x01 \x01(\x0c\"J\n\x12GetLogsPageRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\x12\x0e\n\x06offset\x18\x02 \x01(\x03\x12\r\n\x05limit\x18\x03 \x01(\x03\"N\n\x13GetLogsPageResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\x12\x13\n\x0bnext_offset\x18\x02 \x01(\x03\x12\x12\n\ntotal_size\x18\x03 \x01(\x03\"+\n\x12GetMetadataRequest\x12\x15\n\rpipeline_uuid\x18\x01 \x01(\t\"D\n\x0eSubKeyMetadata\x12\x0f\n\x07sub_key\x18\x01 \x01(\t\x12\x0c\n\x04size\x18\x02 \x01(\x03\x12\x13\n\x0bttl_seconds\x18\x03 \x01(\x03\"?\n\x13GetMetadataResponse\x12(\n\x08sub_keys\x18\x01 \x03(\x0b\x32\x16.api.v1.SubKeyMetadata\"\x9f\x01\n\x11PrecompiledObject\x12\x12\n\ncloud_path\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12+\n\x04type\x18\x04 \x01(\x0e\x32\x1d.api.v1.PrecompiledObjectType\x12\x18\n\x10pipeline_options\x18\x05 \x01(\t\x12\x0c\n\x04link\x18\x06 \x01(\t\"\xb2\x01\n\nCategories\x12\x18\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.Sdk\x12/\n\ncategories\x18\x02 \x03(\x0b\x32\x1b.api.v1.Categories.Category\x1aY\n\x08\x43\x61tegory\x12\x15\n\rcategory_name\x18\x01 \x01(\t\x12\x36\n\x13precompiled_objects\x18\x02 \x03(\x0b\x32\x19.api.v1.PrecompiledObject\"J\n\x1cGetPrecompiledObjectsRequest\x12\x18\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.Sdk\x12\x10\n\x08\x63\x61tegory\x18\x02 \x01(\t\"5\n\x1fGetPrecompiledObjectCodeRequest\x12\x12\n\ncloud_path\x18\x01 \x01(\t\"7\n!GetPrecompiledObjectOutputRequest\x12\x12\n\ncloud_path\x18\x01 \x01(\t\"5\n\x1fGetPrecompiledObjectLogsRequest\x12\x12\n\ncloud_path\x18\x01 \x01(\t\"1\n\x1bGetPrecompiledObjectRequest\x12\x12\n\ncloud_path\x18\x01 \x01(\t\">\n\"GetDefaultPrecompiledObjectRequest\x12\x18\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.Sdk\"q\n\x15SearchExamplesRequest\x12\x19\n\x04sdks\x18\x01 \x03(\x0e\x32\x0b.api.v1.Sdk\x12\x10\n\x08\x63\x61tegory\x18\x02 \x01(\t\x12\x0c\n\x04term\x18\x03 \x01(\t\x12\x0e\n\x06offset\x18\x04 \x01(\x05\x12\r\n\x05limit\x18\x05 \x01(\x05\"V\n\x12SaveSnippetRequest\x12\x0c\n\x04\x63ode\x18\x01 \x01(\t\x12\x18\n\x03sdk\x18\x02 \x01(\x0e\x32\x0b.api.v1.Sdk\x12\x18\n\x10pipeline_options\x18\x03 \x01(\t\"\x1f\n\x11GetSnippetRequest\x12\n\n\x02id\x18\x01 \x01(\t\"\x11\n\x0fListSdksRequest\"^\n\x07SdkInfo\x12\x18\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.Sdk\x12\x0f\n\x07\x65nabled\x18\x02 \x01(\x08\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x17\n\x0f\x64isabled_reason\x18\x04 \x01(\t\"<\n\x16GetUsageMetricsRequest\x12\x11\n\tfrom_date\x18\x01 \x01(\t\x12\x0f\n\x07to_date\x18\x02 \x01(\t\"F\n\x0bUsageMetric\x12\x18\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.Sdk\x12\x0f\n\x07\x65xample\x18\x02 \x01(\t\x12\x0c\n\x04runs\x18\x03 \x01(\x03\"\x9b\x01\n\x17GetUsageMetricsResponse\x12\x11\n\tfrom_date\x18\x01 \x01(\t\x12\x0f\n\x07to_date\x18\x02 \x01(\t\x12\x12\n\ntotal_runs\x18\x03 \x01(\x03\x12!\n\x04sdks\x18\x04 \x03(\x0b\x32\x13.api.v1.UsageMetric\x12%\n\x08\x65xamples\x18\x05 \x03(\x0b\x32\x13.api.v1.UsageMetric\"K\n\x1dGetPrecompiledObjectsResponse\x12*\n\x0esdk_categories\x18\x01 \x03(\x0b\x32\x12.api.v1.Categories\"0\n GetPrecompiledObjectCodeResponse\x12\x0c\n\x04\x63ode\x18\x01 \x01(\t\"4\n\"GetPrecompiledObjectOutputResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\"2\n GetPrecompiledObjectLogsResponse\x12\x0e\n\x06output\x18\x01 \x01(\t\"\xdd\x01\n\x1cGetPrecompiledObjectResponse\x12\x35\n\x12precompiled_object\x18\x01 \x01(\x0b\x32\x19.api.v1.PrecompiledObject\x12\x18\n\x03sdk\x18\x02 \x01(\x0e\x32\x0b.api.v1.Sdk\x12\x0c\n\x04\x63ode\x18\x03 \x01(\t\x12\x16\n\x0e\x63ompile_output\x18\x04 \x01(\t\x12\x12\n\nrun_output\x18\x05 \x01(\t\x12\x1a\n\x12has_compile_output\x18\x06 \x01(\x08\x12\x16\n\x0ehas_run_output\x18\x07 \x01(\x08\"\\\n#GetDefaultPrecompiledObjectResponse\x12\x35\n\x12precompiled_object\x18\x01 \x01(\x0b\x32\x19.api.v1.PrecompiledObject\"z\n\x16SearchExamplesResponse\x12\x36\n\x13precompiled_objects\x18\x01 \x03(\x0b\x32\x19.api.v1.PrecompiledObject\x12\x13\n\x0bnext_offset\x18\x02 \x01(\x05\x12\x13\n\x0btotal_count\x18\x03 \x01(\x05\"!\n\x13SaveSnippetResponse\x12\n\n\x02id\x18\x01 \x01(\t\"V\n\x12GetSnippetResponse\x12\x0c\n\x04\x63ode\x18\x01 \x01(\t\x12\x18\n\x03sdk\x18\x02 \x01(\x0e\x32\x0b.api.v1.Sdk\x12\x18\n\x10pipeline_options\x18\x03 \x01(\t\"1\n\x10ListSdksResponse\x12\x1d\n\x04sdks\x18\x01 \x03(\x0b\x32\x0f.api.v1.SdkInfo*R\n\x03Sdk\x12\x13\n\x0fSDK_UNSPECIFIED\x10\x00\x12\x0c\n\x08SDK_JAVA\x10\x01\x12\n\n\x06SDK_GO\x10\x02\x12\x0e\n\nSDK_PYTHON\x10\x03\x12\x0c\n\x08SDK_SCIO\x10\x04*\xcc\x02\n\x06Status\x12\x16\n\x12STATUS_UNSPECIFIED\x10\x00\x12\x15\n\x11STATUS_VALIDATING\x10\x01\x12\x1b\n\x17STATUS_VALIDATION_ERROR\x10\x02\x12\x14\n\x10STATUS_PREPARING\x10\x03\x12\x1c\n\x18STATUS_PREPARATION_ERROR\x10\x04\x12\x14\n\x10STATUS_COMPILING\x10\x05\x12\x18\n\x14STATUS_COMPILE_ERROR\x10\x06\x12\x14\n\x10STATUS_EXECUTING\x10\x07\x12\x13\n\x0fSTATUS_FINISHED\x10\x08\x12\x14\n\x10STATUS_RUN_ERROR\x10\t\x12\x10\n\x0cSTATUS_ERROR\x10\n\x12\x16\n\x12STATUS_RUN_TIMEOUT\x10\x0b\x12\x13\n\x0fSTATUS_CANCELED\x10\x0c\x12\x12\n\x0eSTATUS_WAITING\x10\r*\x8c\x01\n\x0bLogSeverity\x12\x1c\n\x18LOG_SEVERITY_UNSPECIFIED\x10\x00\x12\x16\n\x12LOG_SEVERITY_DEBUG\x10\x01\x12\x15\n\x11LOG_SEVERITY_INFO\x10\x02\x12\x18\n\x14LOG_SEVERITY_WARNING\x10\x03\x12\x16\n\x12LOG_SEVERITY_ERROR\x10\x04*\xae\x01\n\x15PrecompiledObjectType\x12\'\n#PRECOMPILED_OBJECT_TYPE_UNSPECIFIED\x10\x00\x12#\n\x1fPRECOMPILED_OBJECT_TYPE_EXAMPLE\x10\x01\x12 \n\x1cPRECOMPILED_OBJECT_TYPE_KATA\x10\x02\x12%\n!PRECOMPILED_OBJECT_TYPE_UNIT_TEST\x10\x03*\xc8\x01\n\tErrorCode\x12\x1a\n\x16\x45RROR_CODE_UNSPECIFIED\x10\x00\x12\x1c\n\x18\x45RROR_CODE_COMPILE_ERROR\x10\x01\x12\x18\n\x14\x45RROR_CODE_RUN_ERROR\x10\x02\x12\x16\n\x12\x45RROR_CODE_TIMEOUT\x10\x03\x12\x17\n\x13\x45RROR_CODE_CANCELED\x10\x04\x12\x1d\n\x19\x45RROR_CODE_RESOURCE_LIMIT\x10\x05\x12\x17\n\x13\x45RROR_CODE_INTERNAL\x10\x06\x32\x92\x14\n\x11PlaygroundService\x12:\n\x07RunCode\x12\x16.api.v1.RunCodeRequest\x1a\x17.api.v1.RunCodeResponse\x12\x42\n\nRunProgram\x12\x16.api.v1.RunCodeRequest\x1a\x1a.api.v1.RunProgramResponse0\x01\x12I\n\x0cValidateCode\x12\x1b.api.v1.ValidateCodeRequest\x1a\x1c.api.v1.ValidateCodeResponse\x12R\n\x0fValidateOptions\x12\x1e.api.v1.ValidateOptionsRequest\x1a\x1f.api.v1.ValidateOptionsResponse\x12\x46\n\x0b\x43heckStatus\x12\x1a.api.v1.CheckStatusRequest\x1a\x1b.api.v1.CheckStatusResponse\x12I\n\x0cGetRunOutput\x12\x1b.api.v1.GetRunOutputRequest\x1a\x1c.api.v1.GetRunOutputResponse\x12:\n\x07GetLogs\x12\x16.api.v1.GetLogsRequest\x1a\x17.api.v1.GetLogsResponse\x12L\n\rGetLogEntries\x12\x1c.api.v1.GetLogEntriesRequest\x1a\x1d.api.v1.GetLogEntriesResponse\x12U\n\x10GetRunOutputPage\x12\x1f.api.v1.GetRunOutputPageRequest\x1a .api.v1.GetRunOutputPageResponse\x12\x46\n\x0bGetLogsPage\x12\x1a.api.v1.GetLogsPageRequest\x1a\x1b.api.v1.GetLogsPageResponse\x12K\n\x0cStreamOutput\x12\x1b.api.v1.StreamOutputRequest\x1a\x1c.api.v1.StreamOutputResponse0\x01\x12N\n\rGetWorkingDir\x12\x1c.api.v1.GetWorkingDirRequest\x1a\x1d.api.v1.GetWorkingDirResponse0\x01\x12=\n\x08GetGraph\x12\x17.api.v1.GetGraphRequest\x1a\x18.api.v1.GetGraphResponse\x12\x46\n\x0bGetRunError\x12\x1a.api.v1.GetRunErrorRequest\x1a\x1b.api.v1.GetRunErrorResponse\x12^\n\x13GetValidationOutput\x12\".api.v1.GetValidationOutputRequest\x1a#.api.v1.GetValidationOutputResponse\x12\x61\n\x14GetPreparationOutput\x12#.api.v1.GetPreparationOutputRequest\x1a$.api.v1.GetPreparationOutputResponse\x12U\n\x10GetCompileOutput\x12\x1f.api.v1.GetCompileOutputRequest\x1a .api.v1.GetCompileOutputResponse\x12^\n\x13GetExecutionCommand\x12\".api.v1.GetExecutionCommandRequest\x1a#.api.v1.GetExecutionCommandResponse\x12\x37\n\x06\x43\x61ncel\x12\x15.api.v1.CancelRequest\x1a\x16.api.v1.CancelResponse\x12\x46\n\x0bGetMetadata\x12\x1a.api.v1.GetMetadataRequest\x1a\x1b.api.v1.GetMetadataResponse\x12\x64\n\x15GetPrecompiledObjects\x12$.api.v1.GetPrecompiledObjectsRequest\x1a%.api.v1.GetPrecompiledObjectsResponse\x12\x61\n\x14GetPrecompiledObject\x12#.api.v1.GetPrecompiledObjectRequest\x1a$.api.v1.GetPrecompiledObjectResponse\x12m\n\x18GetPrecompiledObjectCode\x12\'.api.v1.GetPrecompiledObjectCodeRequest\x1a(.api.v1.GetPrecompiledObjectCodeResponse\x12s\n\x1aGetPrecompiledObjectOutput\x12).api.v1.GetPrecompiledObjectOutputRequest\x1a*.api.v1.GetPrecompiledObjectOutputResponse\x12m\n\x18GetPrecompiledObjectLogs\x12\'.api.v1.GetPrecompiledObjectLogsRequest\x1a(.api.v1.GetPrecompiledObjectLogsResponse\x12v\n\x1bGetDefaultPrecompiledObject\x12*.api.v1.GetDefaultPrecompiledObjectRequest\x1a+.api.v1.GetDefaultPrecompiledObjectResponse\x12O\n\x0eSearchExamples\x12\x1d.api.v1.SearchExamplesRequest\x1a\x1e.api.v1.SearchExamplesResponse\x12\x46\n\x0bSaveSnippet\x12\x1a.api.v1.SaveSnippetRequest\x1a\x1b.api.v1.SaveSnippetResponse\x12\x43\n\nGetSnippet\x12\x19.api.v1.GetSnippetRequest\x1a\x1a.api.v1.GetSnippetResponse\x12=\n\x08ListSDKs\x12\x17.api.v1.ListSdksRequest\x1a\x18.api.v1.ListSdksResponse\x12R\n\x0fGetUsageMetrics\x12\x1e.api.v1.GetUsageMetricsRequest\x1a\x1f.api.v1.GetUsageMetricsResponseB8Z6beam.apache.org/playground/backend/internal;playgroundb\x06proto3'
+  ,
+  dependencies=[google_dot_protobuf_dot_duration__pb2.DESCRIPTOR,google_dot_protobuf_dot_timestamp__pb2.DESCRIPTOR,])
 
 _SDK = _descriptor.EnumDescriptor(
   name='Sdk',
@@ -73,8 +76,8 @@ _SDK = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1824,
-  serialized_end=1906,
+  serialized_start=5438,
+  serialized_end=5520,
 )
 _sym_db.RegisterEnumDescriptor(_SDK)
 
@@ -151,15 +154,61 @@ _STATUS = _descriptor.EnumDescriptor(
       serialized_options=None,
       type=None,
       create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='STATUS_WAITING', index=13, number=13,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1909,
-  serialized_end=2221,
+  serialized_start=5523,
+  serialized_end=5855,
 )
 _sym_db.RegisterEnumDescriptor(_STATUS)
 
 Status = enum_type_wrapper.EnumTypeWrapper(_STATUS)
+_LOGSEVERITY = _descriptor.EnumDescriptor(
+  name='LogSeverity',
+  full_name='api.v1.LogSeverity',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='LOG_SEVERITY_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='LOG_SEVERITY_DEBUG', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='LOG_SEVERITY_INFO', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='LOG_SEVERITY_WARNING', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='LOG_SEVERITY_ERROR', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=5858,
+  serialized_end=5998,
+)
+_sym_db.RegisterEnumDescriptor(_LOGSEVERITY)
+
+LogSeverity = enum_type_wrapper.EnumTypeWrapper(_LOGSEVERITY)
 _PRECOMPILEDOBJECTTYPE = _descriptor.EnumDescriptor(
   name='PrecompiledObjectType',
   full_name='api.v1.PrecompiledObjectType',
@@ -190,12 +239,63 @@ _PRECOMPILEDOBJECTTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2224,
-  serialized_end=2398,
+  serialized_start=6001,
+  serialized_end=6175,
 )
 _sym_db.RegisterEnumDescriptor(_PRECOMPILEDOBJECTTYPE)
 
 PrecompiledObjectType = enum_type_wrapper.EnumTypeWrapper(_PRECOMPILEDOBJECTTYPE)
+_ERRORCODE = _descriptor.EnumDescriptor(
+  name='ErrorCode',
+  full_name='api.v1.ErrorCode',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='ERROR_CODE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='ERROR_CODE_COMPILE_ERROR', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='ERROR_CODE_RUN_ERROR', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='ERROR_CODE_TIMEOUT', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='ERROR_CODE_CANCELED', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='ERROR_CODE_RESOURCE_LIMIT', index=5, number=5,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='ERROR_CODE_INTERNAL', index=6, number=6,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=6178,
+  serialized_end=6378,
+)
+_sym_db.RegisterEnumDescriptor(_ERRORCODE)
+
+ErrorCode = enum_type_wrapper.EnumTypeWrapper(_ERRORCODE)
 SDK_UNSPECIFIED = 0
 SDK_JAVA = 1
 SDK_GO = 2
@@ -214,42 +314,41 @@ STATUS_RUN_ERROR = 9
 STATUS_ERROR = 10
 STATUS_RUN_TIMEOUT = 11
 STATUS_CANCELED = 12
+STATUS_WAITING = 13
+LOG_SEVERITY_UNSPECIFIED = 0
+LOG_SEVERITY_DEBUG = 1
+LOG_SEVERITY_INFO = 2
+LOG_SEVERITY_WARNING = 3
+LOG_SEVERITY_ERROR = 4
 PRECOMPILED_OBJECT_TYPE_UNSPECIFIED = 0
 PRECOMPILED_OBJECT_TYPE_EXAMPLE = 1
 PRECOMPILED_OBJECT_TYPE_KATA = 2
 PRECOMPILED_OBJECT_TYPE_UNIT_TEST = 3
+ERROR_CODE_UNSPECIFIED = 0
+ERROR_CODE_COMPILE_ERROR = 1
+ERROR_CODE_RUN_ERROR = 2
+ERROR_CODE_TIMEOUT = 3
+ERROR_CODE_CANCELED = 4
+ERROR_CODE_RESOURCE_LIMIT = 5
+ERROR_CODE_INTERNAL = 6
 
 
 
-_RUNCODEREQUEST = _descriptor.Descriptor(
-  name='RunCodeRequest',
-  full_name='api.v1.RunCodeRequest',
+_ERRORDETAILS = _descriptor.Descriptor(
+  name='ErrorDetails',
+  full_name='api.v1.ErrorDetails',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='code', full_name='api.v1.RunCodeRequest.code', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='sdk', full_name='api.v1.RunCodeRequest.sdk', index=1,
-      number=2, type=14, cpp_type=8, label=1,
+      name='code', full_name='api.v1.ErrorDetails.code', index=0,
+      number=1, type=14, cpp_type=8, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='pipeline_options', full_name='api.v1.RunCodeRequest.pipeline_options', index=2,
-      number=3, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -262,62 +361,145 @@ _RUNCODEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=21,
-  serialized_end=103,
+  serialized_start=86,
+  serialized_end=133,
 )
 
 
-_RUNCODERESPONSE = _descriptor.Descriptor(
-  name='RunCodeResponse',
-  full_name='api.v1.RunCodeResponse',
+_RUNCODEREQUEST_ENVVARSENTRY = _descriptor.Descriptor(
+  name='EnvVarsEntry',
+  full_name='api.v1.RunCodeRequest.EnvVarsEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='pipeline_uuid', full_name='api.v1.RunCodeResponse.pipeline_uuid', index=0,
+      name='key', full_name='api.v1.RunCodeRequest.EnvVarsEntry.key', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='api.v1.RunCodeRequest.EnvVarsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  serialized_options=None,
+  serialized_options=b'8\001',
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=105,
-  serialized_end=145,
+  serialized_start=473,
+  serialized_end=519,
 )
 
-
-_CHECKSTATUSREQUEST = _descriptor.Descriptor(
-  name='CheckStatusRequest',
-  full_name='api.v1.CheckStatusRequest',
+_RUNCODEREQUEST = _descriptor.Descriptor(
+  name='RunCodeRequest',
+  full_name='api.v1.RunCodeRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='pipeline_uuid', full_name='api.v1.CheckStatusRequest.pipeline_uuid', index=0,
+      name='code', full_name='api.v1.RunCodeRequest.code', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sdk', full_name='api.v1.RunCodeRequest.sdk', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pipeline_options', full_name='api.v1.RunCodeRequest.pipeline_options', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='nondeterministic', full_name='api.v1.RunCodeRequest.nondeterministic', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='requirements', full_name='api.v1.RunCodeRequest.requirements', index=4,
+      number=5, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='stdin', full_name='api.v1.RunCodeRequest.stdin', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='env_vars', full_name='api.v1.RunCodeRequest.env_vars', index=6,
+      number=7, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='runner', full_name='api.v1.RunCodeRequest.runner', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='deadline', full_name='api.v1.RunCodeRequest.deadline', index=8,
+      number=9, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='example_cloud_path', full_name='api.v1.RunCodeRequest.example_cloud_path', index=9,
+      number=10, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='keep_working_dir', full_name='api.v1.RunCodeRequest.keep_working_dir', index=10,
+      number=11, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='snippet_id', full_name='api.v1.RunCodeRequest.snippet_id', index=11,
+      number=12, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_RUNCODEREQUEST_ENVVARSENTRY, ],
   enum_types=[
   ],
   serialized_options=None,
@@ -326,23 +508,23 @@ _CHECKSTATUSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=147,
-  serialized_end=190,
+  serialized_start=136,
+  serialized_end=519,
 )
 
 
-_CHECKSTATUSRESPONSE = _descriptor.Descriptor(
-  name='CheckStatusResponse',
-  full_name='api.v1.CheckStatusResponse',
+_RUNCODERESPONSE = _descriptor.Descriptor(
+  name='RunCodeResponse',
+  full_name='api.v1.RunCodeResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='status', full_name='api.v1.CheckStatusResponse.status', index=0,
-      number=1, type=14, cpp_type=8, label=1,
-      has_default_value=False, default_value=0,
+      name='pipeline_uuid', full_name='api.v1.RunCodeResponse.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -358,26 +540,33 @@ _CHECKSTATUSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=192,
-  serialized_end=245,
+  serialized_start=521,
+  serialized_end=561,
 )
 
 
-_GETVALIDATIONOUTPUTREQUEST = _descriptor.Descriptor(
-  name='GetValidationOutputRequest',
-  full_name='api.v1.GetValidationOutputRequest',
+_RUNPROGRAMRESPONSE = _descriptor.Descriptor(
+  name='RunProgramResponse',
+  full_name='api.v1.RunProgramResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='pipeline_uuid', full_name='api.v1.GetValidationOutputRequest.pipeline_uuid', index=0,
+      name='pipeline_uuid', full_name='api.v1.RunProgramResponse.pipeline_uuid', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='api.v1.RunProgramResponse.status', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -390,26 +579,33 @@ _GETVALIDATIONOUTPUTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=247,
-  serialized_end=298,
+  serialized_start=563,
+  serialized_end=638,
 )
 
 
-_GETVALIDATIONOUTPUTRESPONSE = _descriptor.Descriptor(
-  name='GetValidationOutputResponse',
-  full_name='api.v1.GetValidationOutputResponse',
+_VALIDATECODEREQUEST = _descriptor.Descriptor(
+  name='ValidateCodeRequest',
+  full_name='api.v1.ValidateCodeRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='output', full_name='api.v1.GetValidationOutputResponse.output', index=0,
+      name='code', full_name='api.v1.ValidateCodeRequest.code', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sdk', full_name='api.v1.ValidateCodeRequest.sdk', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -422,26 +618,40 @@ _GETVALIDATIONOUTPUTRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=300,
-  serialized_end=345,
+  serialized_start=640,
+  serialized_end=701,
 )
 
 
-_GETPREPARATIONOUTPUTREQUEST = _descriptor.Descriptor(
-  name='GetPreparationOutputRequest',
-  full_name='api.v1.GetPreparationOutputRequest',
+_VALIDATECODERESPONSE = _descriptor.Descriptor(
+  name='ValidateCodeResponse',
+  full_name='api.v1.ValidateCodeResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='pipeline_uuid', full_name='api.v1.GetPreparationOutputRequest.pipeline_uuid', index=0,
+      name='pipeline_uuid', full_name='api.v1.ValidateCodeResponse.pipeline_uuid', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='api.v1.ValidateCodeResponse.status', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='output', full_name='api.v1.ValidateCodeResponse.output', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -454,22 +664,29 @@ _GETPREPARATIONOUTPUTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=347,
-  serialized_end=399,
+  serialized_start=703,
+  serialized_end=796,
 )
 
 
-_GETPREPARATIONOUTPUTRESPONSE = _descriptor.Descriptor(
-  name='GetPreparationOutputResponse',
-  full_name='api.v1.GetPreparationOutputResponse',
+_VALIDATEOPTIONSREQUEST = _descriptor.Descriptor(
+  name='ValidateOptionsRequest',
+  full_name='api.v1.ValidateOptionsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='output', full_name='api.v1.GetPreparationOutputResponse.output', index=0,
-      number=1, type=9, cpp_type=9, label=1,
+      name='sdk', full_name='api.v1.ValidateOptionsRequest.sdk', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pipeline_options', full_name='api.v1.ValidateOptionsRequest.pipeline_options', index=1,
+      number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -486,26 +703,47 @@ _GETPREPARATIONOUTPUTRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=401,
-  serialized_end=447,
+  serialized_start=798,
+  serialized_end=874,
 )
 
 
-_GETCOMPILEOUTPUTREQUEST = _descriptor.Descriptor(
-  name='GetCompileOutputRequest',
-  full_name='api.v1.GetCompileOutputRequest',
+_OPTIONDIAGNOSTIC = _descriptor.Descriptor(
+  name='OptionDiagnostic',
+  full_name='api.v1.OptionDiagnostic',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='pipeline_uuid', full_name='api.v1.GetCompileOutputRequest.pipeline_uuid', index=0,
+      name='option', full_name='api.v1.OptionDiagnostic.option', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='api.v1.OptionDiagnostic.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='severity', full_name='api.v1.OptionDiagnostic.severity', index=2,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='message', full_name='api.v1.OptionDiagnostic.message', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -518,22 +756,1384 @@ _GETCOMPILEOUTPUTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=449,
-  serialized_end=497,
+  serialized_start=876,
+  serialized_end=980,
 )
 
 
-_GETCOMPILEOUTPUTRESPONSE = _descriptor.Descriptor(
-  name='GetCompileOutputResponse',
-  full_name='api.v1.GetCompileOutputResponse',
+_VALIDATEOPTIONSRESPONSE = _descriptor.Descriptor(
+  name='ValidateOptionsResponse',
+  full_name='api.v1.ValidateOptionsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='output', full_name='api.v1.GetCompileOutputResponse.output', index=0,
-      number=1, type=9, cpp_type=9, label=1,
+      name='valid', full_name='api.v1.ValidateOptionsResponse.valid', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='diagnostics', full_name='api.v1.ValidateOptionsResponse.diagnostics', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=982,
+  serialized_end=1069,
+)
+
+
+_CHECKSTATUSREQUEST = _descriptor.Descriptor(
+  name='CheckStatusRequest',
+  full_name='api.v1.CheckStatusRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.CheckStatusRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1071,
+  serialized_end=1114,
+)
+
+
+_CHECKSTATUSRESPONSE = _descriptor.Descriptor(
+  name='CheckStatusResponse',
+  full_name='api.v1.CheckStatusResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='status', full_name='api.v1.CheckStatusResponse.status', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1116,
+  serialized_end=1169,
+)
+
+
+_GETVALIDATIONOUTPUTREQUEST = _descriptor.Descriptor(
+  name='GetValidationOutputRequest',
+  full_name='api.v1.GetValidationOutputRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.GetValidationOutputRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1171,
+  serialized_end=1222,
+)
+
+
+_GETVALIDATIONOUTPUTRESPONSE = _descriptor.Descriptor(
+  name='GetValidationOutputResponse',
+  full_name='api.v1.GetValidationOutputResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='output', full_name='api.v1.GetValidationOutputResponse.output', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1224,
+  serialized_end=1269,
+)
+
+
+_GETPREPARATIONOUTPUTREQUEST = _descriptor.Descriptor(
+  name='GetPreparationOutputRequest',
+  full_name='api.v1.GetPreparationOutputRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.GetPreparationOutputRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1271,
+  serialized_end=1323,
+)
+
+
+_GETPREPARATIONOUTPUTRESPONSE = _descriptor.Descriptor(
+  name='GetPreparationOutputResponse',
+  full_name='api.v1.GetPreparationOutputResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='output', full_name='api.v1.GetPreparationOutputResponse.output', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1325,
+  serialized_end=1371,
+)
+
+
+_GETCOMPILEOUTPUTREQUEST = _descriptor.Descriptor(
+  name='GetCompileOutputRequest',
+  full_name='api.v1.GetCompileOutputRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.GetCompileOutputRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1373,
+  serialized_end=1421,
+)
+
+
+_COMPILEDIAGNOSTIC = _descriptor.Descriptor(
+  name='CompileDiagnostic',
+  full_name='api.v1.CompileDiagnostic',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='file', full_name='api.v1.CompileDiagnostic.file', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='line', full_name='api.v1.CompileDiagnostic.line', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='column', full_name='api.v1.CompileDiagnostic.column', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='severity', full_name='api.v1.CompileDiagnostic.severity', index=3,
+      number=4, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='message', full_name='api.v1.CompileDiagnostic.message', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='raw', full_name='api.v1.CompileDiagnostic.raw', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1424,
+  serialized_end=1556,
+)
+
+
+_GETCOMPILEOUTPUTRESPONSE = _descriptor.Descriptor(
+  name='GetCompileOutputResponse',
+  full_name='api.v1.GetCompileOutputResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='output', full_name='api.v1.GetCompileOutputResponse.output', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='diagnostics', full_name='api.v1.GetCompileOutputResponse.diagnostics', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1558,
+  serialized_end=1648,
+)
+
+
+_GETEXECUTIONCOMMANDREQUEST = _descriptor.Descriptor(
+  name='GetExecutionCommandRequest',
+  full_name='api.v1.GetExecutionCommandRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.GetExecutionCommandRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1650,
+  serialized_end=1701,
+)
+
+
+_GETEXECUTIONCOMMANDRESPONSE = _descriptor.Descriptor(
+  name='GetExecutionCommandResponse',
+  full_name='api.v1.GetExecutionCommandResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='command', full_name='api.v1.GetExecutionCommandResponse.command', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1703,
+  serialized_end=1749,
+)
+
+
+_GETRUNOUTPUTREQUEST = _descriptor.Descriptor(
+  name='GetRunOutputRequest',
+  full_name='api.v1.GetRunOutputRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.GetRunOutputRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1751,
+  serialized_end=1795,
+)
+
+
+_GETRUNOUTPUTRESPONSE = _descriptor.Descriptor(
+  name='GetRunOutputResponse',
+  full_name='api.v1.GetRunOutputResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='output', full_name='api.v1.GetRunOutputResponse.output', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1797,
+  serialized_end=1835,
+)
+
+
+_GETRUNERRORREQUEST = _descriptor.Descriptor(
+  name='GetRunErrorRequest',
+  full_name='api.v1.GetRunErrorRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.GetRunErrorRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1837,
+  serialized_end=1880,
+)
+
+
+_GETRUNERRORRESPONSE = _descriptor.Descriptor(
+  name='GetRunErrorResponse',
+  full_name='api.v1.GetRunErrorResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='output', full_name='api.v1.GetRunErrorResponse.output', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1882,
+  serialized_end=1919,
+)
+
+
+_GETLOGSREQUEST = _descriptor.Descriptor(
+  name='GetLogsRequest',
+  full_name='api.v1.GetLogsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.GetLogsRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1921,
+  serialized_end=1960,
+)
+
+
+_GETLOGSRESPONSE = _descriptor.Descriptor(
+  name='GetLogsResponse',
+  full_name='api.v1.GetLogsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='output', full_name='api.v1.GetLogsResponse.output', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1962,
+  serialized_end=1995,
+)
+
+
+_GETLOGENTRIESREQUEST = _descriptor.Descriptor(
+  name='GetLogEntriesRequest',
+  full_name='api.v1.GetLogEntriesRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.GetLogEntriesRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='min_severity', full_name='api.v1.GetLogEntriesRequest.min_severity', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='start_time', full_name='api.v1.GetLogEntriesRequest.start_time', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='end_time', full_name='api.v1.GetLogEntriesRequest.end_time', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1998,
+  serialized_end=2180,
+)
+
+
+_LOGENTRY = _descriptor.Descriptor(
+  name='LogEntry',
+  full_name='api.v1.LogEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='severity', full_name='api.v1.LogEntry.severity', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='api.v1.LogEntry.timestamp', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='message', full_name='api.v1.LogEntry.message', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2182,
+  serialized_end=2295,
+)
+
+
+_GETLOGENTRIESRESPONSE = _descriptor.Descriptor(
+  name='GetLogEntriesResponse',
+  full_name='api.v1.GetLogEntriesResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='entries', full_name='api.v1.GetLogEntriesResponse.entries', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2297,
+  serialized_end=2355,
+)
+
+
+_GETGRAPHREQUEST = _descriptor.Descriptor(
+  name='GetGraphRequest',
+  full_name='api.v1.GetGraphRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.GetGraphRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2357,
+  serialized_end=2397,
+)
+
+
+_GETGRAPHRESPONSE = _descriptor.Descriptor(
+  name='GetGraphResponse',
+  full_name='api.v1.GetGraphResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='graph', full_name='api.v1.GetGraphResponse.graph', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ready', full_name='api.v1.GetGraphResponse.ready', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='dot', full_name='api.v1.GetGraphResponse.dot', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='json', full_name='api.v1.GetGraphResponse.json', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2399,
+  serialized_end=2474,
+)
+
+
+_CANCELREQUEST = _descriptor.Descriptor(
+  name='CancelRequest',
+  full_name='api.v1.CancelRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.CancelRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2476,
+  serialized_end=2514,
+)
+
+
+_CANCELRESPONSE = _descriptor.Descriptor(
+  name='CancelResponse',
+  full_name='api.v1.CancelResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2516,
+  serialized_end=2532,
+)
+
+
+_GETRUNOUTPUTPAGEREQUEST = _descriptor.Descriptor(
+  name='GetRunOutputPageRequest',
+  full_name='api.v1.GetRunOutputPageRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.GetRunOutputPageRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='offset', full_name='api.v1.GetRunOutputPageRequest.offset', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='limit', full_name='api.v1.GetRunOutputPageRequest.limit', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2534,
+  serialized_end=2613,
+)
+
+
+_GETRUNOUTPUTPAGERESPONSE = _descriptor.Descriptor(
+  name='GetRunOutputPageResponse',
+  full_name='api.v1.GetRunOutputPageResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='output', full_name='api.v1.GetRunOutputPageResponse.output', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='next_offset', full_name='api.v1.GetRunOutputPageResponse.next_offset', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='total_size', full_name='api.v1.GetRunOutputPageResponse.total_size', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2615,
+  serialized_end=2698,
+)
+
+
+_STREAMOUTPUTREQUEST = _descriptor.Descriptor(
+  name='StreamOutputRequest',
+  full_name='api.v1.StreamOutputRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.StreamOutputRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2700,
+  serialized_end=2744,
+)
+
+
+_STREAMOUTPUTRESPONSE = _descriptor.Descriptor(
+  name='StreamOutputResponse',
+  full_name='api.v1.StreamOutputResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='status', full_name='api.v1.StreamOutputResponse.status', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='run_output', full_name='api.v1.StreamOutputResponse.run_output', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2746,
+  serialized_end=2820,
+)
+
+
+_GETWORKINGDIRREQUEST = _descriptor.Descriptor(
+  name='GetWorkingDirRequest',
+  full_name='api.v1.GetWorkingDirRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.GetWorkingDirRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2822,
+  serialized_end=2867,
+)
+
+
+_GETWORKINGDIRRESPONSE = _descriptor.Descriptor(
+  name='GetWorkingDirResponse',
+  full_name='api.v1.GetWorkingDirResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='chunk', full_name='api.v1.GetWorkingDirResponse.chunk', index=0,
+      number=1, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2869,
+  serialized_end=2907,
+)
+
+
+_GETLOGSPAGEREQUEST = _descriptor.Descriptor(
+  name='GetLogsPageRequest',
+  full_name='api.v1.GetLogsPageRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.GetLogsPageRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='offset', full_name='api.v1.GetLogsPageRequest.offset', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='limit', full_name='api.v1.GetLogsPageRequest.limit', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2909,
+  serialized_end=2983,
+)
+
+
+_GETLOGSPAGERESPONSE = _descriptor.Descriptor(
+  name='GetLogsPageResponse',
+  full_name='api.v1.GetLogsPageResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='output', full_name='api.v1.GetLogsPageResponse.output', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='next_offset', full_name='api.v1.GetLogsPageResponse.next_offset', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='total_size', full_name='api.v1.GetLogsPageResponse.total_size', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2985,
+  serialized_end=3063,
+)
+
+
+_GETMETADATAREQUEST = _descriptor.Descriptor(
+  name='GetMetadataRequest',
+  full_name='api.v1.GetMetadataRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pipeline_uuid', full_name='api.v1.GetMetadataRequest.pipeline_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3065,
+  serialized_end=3108,
+)
+
+
+_SUBKEYMETADATA = _descriptor.Descriptor(
+  name='SubKeyMetadata',
+  full_name='api.v1.SubKeyMetadata',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='sub_key', full_name='api.v1.SubKeyMetadata.sub_key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='size', full_name='api.v1.SubKeyMetadata.size', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ttl_seconds', full_name='api.v1.SubKeyMetadata.ttl_seconds', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3110,
+  serialized_end=3178,
+)
+
+
+_GETMETADATARESPONSE = _descriptor.Descriptor(
+  name='GetMetadataResponse',
+  full_name='api.v1.GetMetadataResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='sub_keys', full_name='api.v1.GetMetadataResponse.sub_keys', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3180,
+  serialized_end=3243,
+)
+
+
+_PRECOMPILEDOBJECT = _descriptor.Descriptor(
+  name='PrecompiledObject',
+  full_name='api.v1.PrecompiledObject',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cloud_path', full_name='api.v1.PrecompiledObject.cloud_path', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='api.v1.PrecompiledObject.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='description', full_name='api.v1.PrecompiledObject.description', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='type', full_name='api.v1.PrecompiledObject.type', index=3,
+      number=4, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pipeline_options', full_name='api.v1.PrecompiledObject.pipeline_options', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='link', full_name='api.v1.PrecompiledObject.link', index=5,
+      number=6, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -550,21 +2150,137 @@ _GETCOMPILEOUTPUTRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=499,
-  serialized_end=541,
+  serialized_start=3246,
+  serialized_end=3405,
 )
 
 
-_GETRUNOUTPUTREQUEST = _descriptor.Descriptor(
-  name='GetRunOutputRequest',
-  full_name='api.v1.GetRunOutputRequest',
+_CATEGORIES_CATEGORY = _descriptor.Descriptor(
+  name='Category',
+  full_name='api.v1.Categories.Category',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='pipeline_uuid', full_name='api.v1.GetRunOutputRequest.pipeline_uuid', index=0,
+      name='category_name', full_name='api.v1.Categories.Category.category_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='precompiled_objects', full_name='api.v1.Categories.Category.precompiled_objects', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3497,
+  serialized_end=3586,
+)
+
+_CATEGORIES = _descriptor.Descriptor(
+  name='Categories',
+  full_name='api.v1.Categories',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='sdk', full_name='api.v1.Categories.sdk', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='categories', full_name='api.v1.Categories.categories', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_CATEGORIES_CATEGORY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3408,
+  serialized_end=3586,
+)
+
+
+_GETPRECOMPILEDOBJECTSREQUEST = _descriptor.Descriptor(
+  name='GetPrecompiledObjectsRequest',
+  full_name='api.v1.GetPrecompiledObjectsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='sdk', full_name='api.v1.GetPrecompiledObjectsRequest.sdk', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='category', full_name='api.v1.GetPrecompiledObjectsRequest.category', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3588,
+  serialized_end=3662,
+)
+
+
+_GETPRECOMPILEDOBJECTCODEREQUEST = _descriptor.Descriptor(
+  name='GetPrecompiledObjectCodeRequest',
+  full_name='api.v1.GetPrecompiledObjectCodeRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cloud_path', full_name='api.v1.GetPrecompiledObjectCodeRequest.cloud_path', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -582,21 +2298,21 @@ _GETRUNOUTPUTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=543,
-  serialized_end=587,
+  serialized_start=3664,
+  serialized_end=3717,
 )
 
 
-_GETRUNOUTPUTRESPONSE = _descriptor.Descriptor(
-  name='GetRunOutputResponse',
-  full_name='api.v1.GetRunOutputResponse',
+_GETPRECOMPILEDOBJECTOUTPUTREQUEST = _descriptor.Descriptor(
+  name='GetPrecompiledObjectOutputRequest',
+  full_name='api.v1.GetPrecompiledObjectOutputRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='output', full_name='api.v1.GetRunOutputResponse.output', index=0,
+      name='cloud_path', full_name='api.v1.GetPrecompiledObjectOutputRequest.cloud_path', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -614,21 +2330,21 @@ _GETRUNOUTPUTRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=589,
-  serialized_end=627,
+  serialized_start=3719,
+  serialized_end=3774,
 )
 
 
-_GETRUNERRORREQUEST = _descriptor.Descriptor(
-  name='GetRunErrorRequest',
-  full_name='api.v1.GetRunErrorRequest',
+_GETPRECOMPILEDOBJECTLOGSREQUEST = _descriptor.Descriptor(
+  name='GetPrecompiledObjectLogsRequest',
+  full_name='api.v1.GetPrecompiledObjectLogsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='pipeline_uuid', full_name='api.v1.GetRunErrorRequest.pipeline_uuid', index=0,
+      name='cloud_path', full_name='api.v1.GetPrecompiledObjectLogsRequest.cloud_path', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -646,21 +2362,21 @@ _GETRUNERRORREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=629,
-  serialized_end=672,
+  serialized_start=3776,
+  serialized_end=3829,
 )
 
 
-_GETRUNERRORRESPONSE = _descriptor.Descriptor(
-  name='GetRunErrorResponse',
-  full_name='api.v1.GetRunErrorResponse',
+_GETPRECOMPILEDOBJECTREQUEST = _descriptor.Descriptor(
+  name='GetPrecompiledObjectRequest',
+  full_name='api.v1.GetPrecompiledObjectRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='output', full_name='api.v1.GetRunErrorResponse.output', index=0,
+      name='cloud_path', full_name='api.v1.GetPrecompiledObjectRequest.cloud_path', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -678,26 +2394,86 @@ _GETRUNERRORRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=674,
-  serialized_end=711,
+  serialized_start=3831,
+  serialized_end=3880,
+)
+
+
+_GETDEFAULTPRECOMPILEDOBJECTREQUEST = _descriptor.Descriptor(
+  name='GetDefaultPrecompiledObjectRequest',
+  full_name='api.v1.GetDefaultPrecompiledObjectRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='sdk', full_name='api.v1.GetDefaultPrecompiledObjectRequest.sdk', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3882,
+  serialized_end=3944,
 )
 
 
-_GETLOGSREQUEST = _descriptor.Descriptor(
-  name='GetLogsRequest',
-  full_name='api.v1.GetLogsRequest',
+_SEARCHEXAMPLESREQUEST = _descriptor.Descriptor(
+  name='SearchExamplesRequest',
+  full_name='api.v1.SearchExamplesRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='pipeline_uuid', full_name='api.v1.GetLogsRequest.pipeline_uuid', index=0,
-      number=1, type=9, cpp_type=9, label=1,
+      name='sdks', full_name='api.v1.SearchExamplesRequest.sdks', index=0,
+      number=1, type=14, cpp_type=8, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='category', full_name='api.v1.SearchExamplesRequest.category', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='term', full_name='api.v1.SearchExamplesRequest.term', index=2,
+      number=3, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='offset', full_name='api.v1.SearchExamplesRequest.offset', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='limit', full_name='api.v1.SearchExamplesRequest.limit', index=4,
+      number=5, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -710,26 +2486,40 @@ _GETLOGSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=713,
-  serialized_end=752,
+  serialized_start=3946,
+  serialized_end=4059,
 )
 
 
-_GETLOGSRESPONSE = _descriptor.Descriptor(
-  name='GetLogsResponse',
-  full_name='api.v1.GetLogsResponse',
+_SAVESNIPPETREQUEST = _descriptor.Descriptor(
+  name='SaveSnippetRequest',
+  full_name='api.v1.SaveSnippetRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='output', full_name='api.v1.GetLogsResponse.output', index=0,
+      name='code', full_name='api.v1.SaveSnippetRequest.code', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sdk', full_name='api.v1.SaveSnippetRequest.sdk', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pipeline_options', full_name='api.v1.SaveSnippetRequest.pipeline_options', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -742,21 +2532,21 @@ _GETLOGSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=754,
-  serialized_end=787,
+  serialized_start=4061,
+  serialized_end=4147,
 )
 
 
-_CANCELREQUEST = _descriptor.Descriptor(
-  name='CancelRequest',
-  full_name='api.v1.CancelRequest',
+_GETSNIPPETREQUEST = _descriptor.Descriptor(
+  name='GetSnippetRequest',
+  full_name='api.v1.GetSnippetRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='pipeline_uuid', full_name='api.v1.CancelRequest.pipeline_uuid', index=0,
+      name='id', full_name='api.v1.GetSnippetRequest.id', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -774,14 +2564,14 @@ _CANCELREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=789,
-  serialized_end=827,
+  serialized_start=4149,
+  serialized_end=4180,
 )
 
 
-_CANCELRESPONSE = _descriptor.Descriptor(
-  name='CancelResponse',
-  full_name='api.v1.CancelResponse',
+_LISTSDKSREQUEST = _descriptor.Descriptor(
+  name='ListSdksRequest',
+  full_name='api.v1.ListSdksRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -799,57 +2589,82 @@ _CANCELRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=829,
-  serialized_end=845,
+  serialized_start=4182,
+  serialized_end=4199,
 )
 
 
-_PRECOMPILEDOBJECT = _descriptor.Descriptor(
-  name='PrecompiledObject',
-  full_name='api.v1.PrecompiledObject',
+_SDKINFO = _descriptor.Descriptor(
+  name='SdkInfo',
+  full_name='api.v1.SdkInfo',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='cloud_path', full_name='api.v1.PrecompiledObject.cloud_path', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='sdk', full_name='api.v1.SdkInfo.sdk', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='name', full_name='api.v1.PrecompiledObject.name', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='enabled', full_name='api.v1.SdkInfo.enabled', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='description', full_name='api.v1.PrecompiledObject.description', index=2,
+      name='version', full_name='api.v1.SdkInfo.version', index=2,
       number=3, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='type', full_name='api.v1.PrecompiledObject.type', index=3,
-      number=4, type=14, cpp_type=8, label=1,
-      has_default_value=False, default_value=0,
+      name='disabled_reason', full_name='api.v1.SdkInfo.disabled_reason', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4201,
+  serialized_end=4295,
+)
+
+
+_GETUSAGEMETRICSREQUEST = _descriptor.Descriptor(
+  name='GetUsageMetricsRequest',
+  full_name='api.v1.GetUsageMetricsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
     _descriptor.FieldDescriptor(
-      name='pipeline_options', full_name='api.v1.PrecompiledObject.pipeline_options', index=4,
-      number=5, type=9, cpp_type=9, label=1,
+      name='from_date', full_name='api.v1.GetUsageMetricsRequest.from_date', index=0,
+      number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='link', full_name='api.v1.PrecompiledObject.link', index=5,
-      number=6, type=9, cpp_type=9, label=1,
+      name='to_date', full_name='api.v1.GetUsageMetricsRequest.to_date', index=1,
+      number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -866,30 +2681,37 @@ _PRECOMPILEDOBJECT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=848,
-  serialized_end=1007,
+  serialized_start=4297,
+  serialized_end=4357,
 )
 
 
-_CATEGORIES_CATEGORY = _descriptor.Descriptor(
-  name='Category',
-  full_name='api.v1.Categories.Category',
+_USAGEMETRIC = _descriptor.Descriptor(
+  name='UsageMetric',
+  full_name='api.v1.UsageMetric',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='category_name', full_name='api.v1.Categories.Category.category_name', index=0,
-      number=1, type=9, cpp_type=9, label=1,
+      name='sdk', full_name='api.v1.UsageMetric.sdk', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='example', full_name='api.v1.UsageMetric.example', index=1,
+      number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='precompiled_objects', full_name='api.v1.Categories.Category.precompiled_objects', index=1,
-      number=2, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
+      name='runs', full_name='api.v1.UsageMetric.runs', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -905,28 +2727,50 @@ _CATEGORIES_CATEGORY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1099,
-  serialized_end=1188,
+  serialized_start=4359,
+  serialized_end=4429,
 )
 
-_CATEGORIES = _descriptor.Descriptor(
-  name='Categories',
-  full_name='api.v1.Categories',
+
+_GETUSAGEMETRICSRESPONSE = _descriptor.Descriptor(
+  name='GetUsageMetricsResponse',
+  full_name='api.v1.GetUsageMetricsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='sdk', full_name='api.v1.Categories.sdk', index=0,
-      number=1, type=14, cpp_type=8, label=1,
+      name='from_date', full_name='api.v1.GetUsageMetricsResponse.from_date', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='to_date', full_name='api.v1.GetUsageMetricsResponse.to_date', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='total_runs', full_name='api.v1.GetUsageMetricsResponse.total_runs', index=2,
+      number=3, type=3, cpp_type=2, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='categories', full_name='api.v1.Categories.categories', index=1,
-      number=2, type=11, cpp_type=10, label=3,
+      name='sdks', full_name='api.v1.GetUsageMetricsResponse.sdks', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='examples', full_name='api.v1.GetUsageMetricsResponse.examples', index=4,
+      number=5, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -934,7 +2778,7 @@ _CATEGORIES = _descriptor.Descriptor(
   ],
   extensions=[
   ],
-  nested_types=[_CATEGORIES_CATEGORY, ],
+  nested_types=[],
   enum_types=[
   ],
   serialized_options=None,
@@ -943,30 +2787,23 @@ _CATEGORIES = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1010,
-  serialized_end=1188,
+  serialized_start=4432,
+  serialized_end=4587,
 )
 
 
-_GETPRECOMPILEDOBJECTSREQUEST = _descriptor.Descriptor(
-  name='GetPrecompiledObjectsRequest',
-  full_name='api.v1.GetPrecompiledObjectsRequest',
+_GETPRECOMPILEDOBJECTSRESPONSE = _descriptor.Descriptor(
+  name='GetPrecompiledObjectsResponse',
+  full_name='api.v1.GetPrecompiledObjectsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='sdk', full_name='api.v1.GetPrecompiledObjectsRequest.sdk', index=0,
-      number=1, type=14, cpp_type=8, label=1,
-      has_default_value=False, default_value=0,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='category', full_name='api.v1.GetPrecompiledObjectsRequest.category', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='sdk_categories', full_name='api.v1.GetPrecompiledObjectsResponse.sdk_categories', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -982,21 +2819,21 @@ _GETPRECOMPILEDOBJECTSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1190,
-  serialized_end=1264,
+  serialized_start=4589,
+  serialized_end=4664,
 )
 
 
-_GETPRECOMPILEDOBJECTCODEREQUEST = _descriptor.Descriptor(
-  name='GetPrecompiledObjectCodeRequest',
-  full_name='api.v1.GetPrecompiledObjectCodeRequest',
+_GETPRECOMPILEDOBJECTCODERESPONSE = _descriptor.Descriptor(
+  name='GetPrecompiledObjectCodeResponse',
+  full_name='api.v1.GetPrecompiledObjectCodeResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='cloud_path', full_name='api.v1.GetPrecompiledObjectCodeRequest.cloud_path', index=0,
+      name='code', full_name='api.v1.GetPrecompiledObjectCodeResponse.code', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -1014,21 +2851,21 @@ _GETPRECOMPILEDOBJECTCODEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1266,
-  serialized_end=1319,
+  serialized_start=4666,
+  serialized_end=4714,
 )
 
 
-_GETPRECOMPILEDOBJECTOUTPUTREQUEST = _descriptor.Descriptor(
-  name='GetPrecompiledObjectOutputRequest',
-  full_name='api.v1.GetPrecompiledObjectOutputRequest',
+_GETPRECOMPILEDOBJECTOUTPUTRESPONSE = _descriptor.Descriptor(
+  name='GetPrecompiledObjectOutputResponse',
+  full_name='api.v1.GetPrecompiledObjectOutputResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='cloud_path', full_name='api.v1.GetPrecompiledObjectOutputRequest.cloud_path', index=0,
+      name='output', full_name='api.v1.GetPrecompiledObjectOutputResponse.output', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -1046,21 +2883,21 @@ _GETPRECOMPILEDOBJECTOUTPUTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1321,
-  serialized_end=1376,
+  serialized_start=4716,
+  serialized_end=4768,
 )
 
 
-_GETPRECOMPILEDOBJECTLOGSREQUEST = _descriptor.Descriptor(
-  name='GetPrecompiledObjectLogsRequest',
-  full_name='api.v1.GetPrecompiledObjectLogsRequest',
+_GETPRECOMPILEDOBJECTLOGSRESPONSE = _descriptor.Descriptor(
+  name='GetPrecompiledObjectLogsResponse',
+  full_name='api.v1.GetPrecompiledObjectLogsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='cloud_path', full_name='api.v1.GetPrecompiledObjectLogsRequest.cloud_path', index=0,
+      name='output', full_name='api.v1.GetPrecompiledObjectLogsResponse.output', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -1078,26 +2915,68 @@ _GETPRECOMPILEDOBJECTLOGSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1378,
-  serialized_end=1431,
+  serialized_start=4770,
+  serialized_end=4820,
 )
 
 
-_GETDEFAULTPRECOMPILEDOBJECTREQUEST = _descriptor.Descriptor(
-  name='GetDefaultPrecompiledObjectRequest',
-  full_name='api.v1.GetDefaultPrecompiledObjectRequest',
+_GETPRECOMPILEDOBJECTRESPONSE = _descriptor.Descriptor(
+  name='GetPrecompiledObjectResponse',
+  full_name='api.v1.GetPrecompiledObjectResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='sdk', full_name='api.v1.GetDefaultPrecompiledObjectRequest.sdk', index=0,
-      number=1, type=14, cpp_type=8, label=1,
+      name='precompiled_object', full_name='api.v1.GetPrecompiledObjectResponse.precompiled_object', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sdk', full_name='api.v1.GetPrecompiledObjectResponse.sdk', index=1,
+      number=2, type=14, cpp_type=8, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='code', full_name='api.v1.GetPrecompiledObjectResponse.code', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='compile_output', full_name='api.v1.GetPrecompiledObjectResponse.compile_output', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='run_output', full_name='api.v1.GetPrecompiledObjectResponse.run_output', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='has_compile_output', full_name='api.v1.GetPrecompiledObjectResponse.has_compile_output', index=5,
+      number=6, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='has_run_output', full_name='api.v1.GetPrecompiledObjectResponse.has_run_output', index=6,
+      number=7, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -1110,23 +2989,23 @@ _GETDEFAULTPRECOMPILEDOBJECTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1433,
-  serialized_end=1495,
+  serialized_start=4823,
+  serialized_end=5044,
 )
 
 
-_GETPRECOMPILEDOBJECTSRESPONSE = _descriptor.Descriptor(
-  name='GetPrecompiledObjectsResponse',
-  full_name='api.v1.GetPrecompiledObjectsResponse',
+_GETDEFAULTPRECOMPILEDOBJECTRESPONSE = _descriptor.Descriptor(
+  name='GetDefaultPrecompiledObjectResponse',
+  full_name='api.v1.GetDefaultPrecompiledObjectResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='sdk_categories', full_name='api.v1.GetPrecompiledObjectsResponse.sdk_categories', index=0,
-      number=1, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
+      name='precompiled_object', full_name='api.v1.GetDefaultPrecompiledObjectResponse.precompiled_object', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -1142,23 +3021,37 @@ _GETPRECOMPILEDOBJECTSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1497,
-  serialized_end=1572,
+  serialized_start=5046,
+  serialized_end=5138,
 )
 
 
-_GETPRECOMPILEDOBJECTCODERESPONSE = _descriptor.Descriptor(
-  name='GetPrecompiledObjectCodeResponse',
-  full_name='api.v1.GetPrecompiledObjectCodeResponse',
+_SEARCHEXAMPLESRESPONSE = _descriptor.Descriptor(
+  name='SearchExamplesResponse',
+  full_name='api.v1.SearchExamplesResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='code', full_name='api.v1.GetPrecompiledObjectCodeResponse.code', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='precompiled_objects', full_name='api.v1.SearchExamplesResponse.precompiled_objects', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='next_offset', full_name='api.v1.SearchExamplesResponse.next_offset', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='total_count', full_name='api.v1.SearchExamplesResponse.total_count', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -1174,21 +3067,21 @@ _GETPRECOMPILEDOBJECTCODERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1574,
-  serialized_end=1622,
+  serialized_start=5140,
+  serialized_end=5262,
 )
 
 
-_GETPRECOMPILEDOBJECTOUTPUTRESPONSE = _descriptor.Descriptor(
-  name='GetPrecompiledObjectOutputResponse',
-  full_name='api.v1.GetPrecompiledObjectOutputResponse',
+_SAVESNIPPETRESPONSE = _descriptor.Descriptor(
+  name='SaveSnippetResponse',
+  full_name='api.v1.SaveSnippetResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='output', full_name='api.v1.GetPrecompiledObjectOutputResponse.output', index=0,
+      name='id', full_name='api.v1.SaveSnippetResponse.id', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -1206,26 +3099,40 @@ _GETPRECOMPILEDOBJECTOUTPUTRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1624,
-  serialized_end=1676,
+  serialized_start=5264,
+  serialized_end=5297,
 )
 
 
-_GETPRECOMPILEDOBJECTLOGSRESPONSE = _descriptor.Descriptor(
-  name='GetPrecompiledObjectLogsResponse',
-  full_name='api.v1.GetPrecompiledObjectLogsResponse',
+_GETSNIPPETRESPONSE = _descriptor.Descriptor(
+  name='GetSnippetResponse',
+  full_name='api.v1.GetSnippetResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='output', full_name='api.v1.GetPrecompiledObjectLogsResponse.output', index=0,
+      name='code', full_name='api.v1.GetSnippetResponse.code', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sdk', full_name='api.v1.GetSnippetResponse.sdk', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pipeline_options', full_name='api.v1.GetSnippetResponse.pipeline_options', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -1238,23 +3145,23 @@ _GETPRECOMPILEDOBJECTLOGSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1678,
-  serialized_end=1728,
+  serialized_start=5299,
+  serialized_end=5385,
 )
 
 
-_GETDEFAULTPRECOMPILEDOBJECTRESPONSE = _descriptor.Descriptor(
-  name='GetDefaultPrecompiledObjectResponse',
-  full_name='api.v1.GetDefaultPrecompiledObjectResponse',
+_LISTSDKSRESPONSE = _descriptor.Descriptor(
+  name='ListSdksResponse',
+  full_name='api.v1.ListSdksResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='precompiled_object', full_name='api.v1.GetDefaultPrecompiledObjectResponse.precompiled_object', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='sdks', full_name='api.v1.ListSdksResponse.sdks', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -1270,12 +3177,32 @@ _GETDEFAULTPRECOMPILEDOBJECTRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1730,
-  serialized_end=1822,
+  serialized_start=5387,
+  serialized_end=5436,
 )
 
+_ERRORDETAILS.fields_by_name['code'].enum_type = _ERRORCODE
+_RUNCODEREQUEST_ENVVARSENTRY.containing_type = _RUNCODEREQUEST
 _RUNCODEREQUEST.fields_by_name['sdk'].enum_type = _SDK
+_RUNCODEREQUEST.fields_by_name['env_vars'].message_type = _RUNCODEREQUEST_ENVVARSENTRY
+_RUNCODEREQUEST.fields_by_name['deadline'].message_type = google_dot_protobuf_dot_duration__pb2._DURATION
+_RUNPROGRAMRESPONSE.fields_by_name['status'].enum_type = _STATUS
+_VALIDATECODEREQUEST.fields_by_name['sdk'].enum_type = _SDK
+_VALIDATECODERESPONSE.fields_by_name['status'].enum_type = _STATUS
+_VALIDATEOPTIONSREQUEST.fields_by_name['sdk'].enum_type = _SDK
+_OPTIONDIAGNOSTIC.fields_by_name['severity'].enum_type = _LOGSEVERITY
+_VALIDATEOPTIONSRESPONSE.fields_by_name['diagnostics'].message_type = _OPTIONDIAGNOSTIC
 _CHECKSTATUSRESPONSE.fields_by_name['status'].enum_type = _STATUS
+_COMPILEDIAGNOSTIC.fields_by_name['severity'].enum_type = _LOGSEVERITY
+_GETCOMPILEOUTPUTRESPONSE.fields_by_name['diagnostics'].message_type = _COMPILEDIAGNOSTIC
+_GETLOGENTRIESREQUEST.fields_by_name['min_severity'].enum_type = _LOGSEVERITY
+_GETLOGENTRIESREQUEST.fields_by_name['start_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_GETLOGENTRIESREQUEST.fields_by_name['end_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_LOGENTRY.fields_by_name['severity'].enum_type = _LOGSEVERITY
+_LOGENTRY.fields_by_name['timestamp'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_GETLOGENTRIESRESPONSE.fields_by_name['entries'].message_type = _LOGENTRY
+_STREAMOUTPUTRESPONSE.fields_by_name['status'].enum_type = _STATUS
+_GETMETADATARESPONSE.fields_by_name['sub_keys'].message_type = _SUBKEYMETADATA
 _PRECOMPILEDOBJECT.fields_by_name['type'].enum_type = _PRECOMPILEDOBJECTTYPE
 _CATEGORIES_CATEGORY.fields_by_name['precompiled_objects'].message_type = _PRECOMPILEDOBJECT
 _CATEGORIES_CATEGORY.containing_type = _CATEGORIES
@@ -1283,10 +3210,28 @@ _CATEGORIES.fields_by_name['sdk'].enum_type = _SDK
 _CATEGORIES.fields_by_name['categories'].message_type = _CATEGORIES_CATEGORY
 _GETPRECOMPILEDOBJECTSREQUEST.fields_by_name['sdk'].enum_type = _SDK
 _GETDEFAULTPRECOMPILEDOBJECTREQUEST.fields_by_name['sdk'].enum_type = _SDK
+_SEARCHEXAMPLESREQUEST.fields_by_name['sdks'].enum_type = _SDK
+_SAVESNIPPETREQUEST.fields_by_name['sdk'].enum_type = _SDK
+_SDKINFO.fields_by_name['sdk'].enum_type = _SDK
+_USAGEMETRIC.fields_by_name['sdk'].enum_type = _SDK
+_GETUSAGEMETRICSRESPONSE.fields_by_name['sdks'].message_type = _USAGEMETRIC
+_GETUSAGEMETRICSRESPONSE.fields_by_name['examples'].message_type = _USAGEMETRIC
 _GETPRECOMPILEDOBJECTSRESPONSE.fields_by_name['sdk_categories'].message_type = _CATEGORIES
+_GETPRECOMPILEDOBJECTRESPONSE.fields_by_name['precompiled_object'].message_type = _PRECOMPILEDOBJECT
+_GETPRECOMPILEDOBJECTRESPONSE.fields_by_name['sdk'].enum_type = _SDK
 _GETDEFAULTPRECOMPILEDOBJECTRESPONSE.fields_by_name['precompiled_object'].message_type = _PRECOMPILEDOBJECT
+_SEARCHEXAMPLESRESPONSE.fields_by_name['precompiled_objects'].message_type = _PRECOMPILEDOBJECT
+_GETSNIPPETRESPONSE.fields_by_name['sdk'].enum_type = _SDK
+_LISTSDKSRESPONSE.fields_by_name['sdks'].message_type = _SDKINFO
+DESCRIPTOR.message_types_by_name['ErrorDetails'] = _ERRORDETAILS
 DESCRIPTOR.message_types_by_name['RunCodeRequest'] = _RUNCODEREQUEST
 DESCRIPTOR.message_types_by_name['RunCodeResponse'] = _RUNCODERESPONSE
+DESCRIPTOR.message_types_by_name['RunProgramResponse'] = _RUNPROGRAMRESPONSE
+DESCRIPTOR.message_types_by_name['ValidateCodeRequest'] = _VALIDATECODEREQUEST
+DESCRIPTOR.message_types_by_name['ValidateCodeResponse'] = _VALIDATECODERESPONSE
+DESCRIPTOR.message_types_by_name['ValidateOptionsRequest'] = _VALIDATEOPTIONSREQUEST
+DESCRIPTOR.message_types_by_name['OptionDiagnostic'] = _OPTIONDIAGNOSTIC
+DESCRIPTOR.message_types_by_name['ValidateOptionsResponse'] = _VALIDATEOPTIONSRESPONSE
 DESCRIPTOR.message_types_by_name['CheckStatusRequest'] = _CHECKSTATUSREQUEST
 DESCRIPTOR.message_types_by_name['CheckStatusResponse'] = _CHECKSTATUSRESPONSE
 DESCRIPTOR.message_types_by_name['GetValidationOutputRequest'] = _GETVALIDATIONOUTPUTREQUEST
@@ -1294,38 +3239,88 @@ DESCRIPTOR.message_types_by_name['GetValidationOutputResponse'] = _GETVALIDATION
 DESCRIPTOR.message_types_by_name['GetPreparationOutputRequest'] = _GETPREPARATIONOUTPUTREQUEST
 DESCRIPTOR.message_types_by_name['GetPreparationOutputResponse'] = _GETPREPARATIONOUTPUTRESPONSE
 DESCRIPTOR.message_types_by_name['GetCompileOutputRequest'] = _GETCOMPILEOUTPUTREQUEST
+DESCRIPTOR.message_types_by_name['CompileDiagnostic'] = _COMPILEDIAGNOSTIC
 DESCRIPTOR.message_types_by_name['GetCompileOutputResponse'] = _GETCOMPILEOUTPUTRESPONSE
+DESCRIPTOR.message_types_by_name['GetExecutionCommandRequest'] = _GETEXECUTIONCOMMANDREQUEST
+DESCRIPTOR.message_types_by_name['GetExecutionCommandResponse'] = _GETEXECUTIONCOMMANDRESPONSE
 DESCRIPTOR.message_types_by_name['GetRunOutputRequest'] = _GETRUNOUTPUTREQUEST
 DESCRIPTOR.message_types_by_name['GetRunOutputResponse'] = _GETRUNOUTPUTRESPONSE
 DESCRIPTOR.message_types_by_name['GetRunErrorRequest'] = _GETRUNERRORREQUEST
 DESCRIPTOR.message_types_by_name['GetRunErrorResponse'] = _GETRUNERRORRESPONSE
 DESCRIPTOR.message_types_by_name['GetLogsRequest'] = _GETLOGSREQUEST
 DESCRIPTOR.message_types_by_name['GetLogsResponse'] = _GETLOGSRESPONSE
+DESCRIPTOR.message_types_by_name['GetLogEntriesRequest'] = _GETLOGENTRIESREQUEST
+DESCRIPTOR.message_types_by_name['LogEntry'] = _LOGENTRY
+DESCRIPTOR.message_types_by_name['GetLogEntriesResponse'] = _GETLOGENTRIESRESPONSE
+DESCRIPTOR.message_types_by_name['GetGraphRequest'] = _GETGRAPHREQUEST
+DESCRIPTOR.message_types_by_name['GetGraphResponse'] = _GETGRAPHRESPONSE
 DESCRIPTOR.message_types_by_name['CancelRequest'] = _CANCELREQUEST
 DESCRIPTOR.message_types_by_name['CancelResponse'] = _CANCELRESPONSE
+DESCRIPTOR.message_types_by_name['GetRunOutputPageRequest'] = _GETRUNOUTPUTPAGEREQUEST
+DESCRIPTOR.message_types_by_name['GetRunOutputPageResponse'] = _GETRUNOUTPUTPAGERESPONSE
+DESCRIPTOR.message_types_by_name['StreamOutputRequest'] = _STREAMOUTPUTREQUEST
+DESCRIPTOR.message_types_by_name['StreamOutputResponse'] = _STREAMOUTPUTRESPONSE
+DESCRIPTOR.message_types_by_name['GetWorkingDirRequest'] = _GETWORKINGDIRREQUEST
+DESCRIPTOR.message_types_by_name['GetWorkingDirResponse'] = _GETWORKINGDIRRESPONSE
+DESCRIPTOR.message_types_by_name['GetLogsPageRequest'] = _GETLOGSPAGEREQUEST
+DESCRIPTOR.message_types_by_name['GetLogsPageResponse'] = _GETLOGSPAGERESPONSE
+DESCRIPTOR.message_types_by_name['GetMetadataRequest'] = _GETMETADATAREQUEST
+DESCRIPTOR.message_types_by_name['SubKeyMetadata'] = _SUBKEYMETADATA
+DESCRIPTOR.message_types_by_name['GetMetadataResponse'] = _GETMETADATARESPONSE
 DESCRIPTOR.message_types_by_name['PrecompiledObject'] = _PRECOMPILEDOBJECT
 DESCRIPTOR.message_types_by_name['Categories'] = _CATEGORIES
 DESCRIPTOR.message_types_by_name['GetPrecompiledObjectsRequest'] = _GETPRECOMPILEDOBJECTSREQUEST
 DESCRIPTOR.message_types_by_name['GetPrecompiledObjectCodeRequest'] = _GETPRECOMPILEDOBJECTCODEREQUEST
 DESCRIPTOR.message_types_by_name['GetPrecompiledObjectOutputRequest'] = _GETPRECOMPILEDOBJECTOUTPUTREQUEST
 DESCRIPTOR.message_types_by_name['GetPrecompiledObjectLogsRequest'] = _GETPRECOMPILEDOBJECTLOGSREQUEST
+DESCRIPTOR.message_types_by_name['GetPrecompiledObjectRequest'] = _GETPRECOMPILEDOBJECTREQUEST
 DESCRIPTOR.message_types_by_name['GetDefaultPrecompiledObjectRequest'] = _GETDEFAULTPRECOMPILEDOBJECTREQUEST
+DESCRIPTOR.message_types_by_name['SearchExamplesRequest'] = _SEARCHEXAMPLESREQUEST
+DESCRIPTOR.message_types_by_name['SaveSnippetRequest'] = _SAVESNIPPETREQUEST
+DESCRIPTOR.message_types_by_name['GetSnippetRequest'] = _GETSNIPPETREQUEST
+DESCRIPTOR.message_types_by_name['ListSdksRequest'] = _LISTSDKSREQUEST
+DESCRIPTOR.message_types_by_name['SdkInfo'] = _SDKINFO
+DESCRIPTOR.message_types_by_name['GetUsageMetricsRequest'] = _GETUSAGEMETRICSREQUEST
+DESCRIPTOR.message_types_by_name['UsageMetric'] = _USAGEMETRIC
+DESCRIPTOR.message_types_by_name['GetUsageMetricsResponse'] = _GETUSAGEMETRICSRESPONSE
 DESCRIPTOR.message_types_by_name['GetPrecompiledObjectsResponse'] = _GETPRECOMPILEDOBJECTSRESPONSE
 DESCRIPTOR.message_types_by_name['GetPrecompiledObjectCodeResponse'] = _GETPRECOMPILEDOBJECTCODERESPONSE
 DESCRIPTOR.message_types_by_name['GetPrecompiledObjectOutputResponse'] = _GETPRECOMPILEDOBJECTOUTPUTRESPONSE
 DESCRIPTOR.message_types_by_name['GetPrecompiledObjectLogsResponse'] = _GETPRECOMPILEDOBJECTLOGSRESPONSE
+DESCRIPTOR.message_types_by_name['GetPrecompiledObjectResponse'] = _GETPRECOMPILEDOBJECTRESPONSE
 DESCRIPTOR.message_types_by_name['GetDefaultPrecompiledObjectResponse'] = _GETDEFAULTPRECOMPILEDOBJECTRESPONSE
+DESCRIPTOR.message_types_by_name['SearchExamplesResponse'] = _SEARCHEXAMPLESRESPONSE
+DESCRIPTOR.message_types_by_name['SaveSnippetResponse'] = _SAVESNIPPETRESPONSE
+DESCRIPTOR.message_types_by_name['GetSnippetResponse'] = _GETSNIPPETRESPONSE
+DESCRIPTOR.message_types_by_name['ListSdksResponse'] = _LISTSDKSRESPONSE
 DESCRIPTOR.enum_types_by_name['Sdk'] = _SDK
 DESCRIPTOR.enum_types_by_name['Status'] = _STATUS
+DESCRIPTOR.enum_types_by_name['LogSeverity'] = _LOGSEVERITY
 DESCRIPTOR.enum_types_by_name['PrecompiledObjectType'] = _PRECOMPILEDOBJECTTYPE
+DESCRIPTOR.enum_types_by_name['ErrorCode'] = _ERRORCODE
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
+ErrorDetails = _reflection.GeneratedProtocolMessageType('ErrorDetails', (_message.Message,), {
+  'DESCRIPTOR' : _ERRORDETAILS,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.ErrorDetails)
+  })
+_sym_db.RegisterMessage(ErrorDetails)
+
 RunCodeRequest = _reflection.GeneratedProtocolMessageType('RunCodeRequest', (_message.Message,), {
+
+  'EnvVarsEntry' : _reflection.GeneratedProtocolMessageType('EnvVarsEntry', (_message.Message,), {
+    'DESCRIPTOR' : _RUNCODEREQUEST_ENVVARSENTRY,
+    '__module__' : 'api_pb2'
+    # @@protoc_insertion_point(class_scope:api.v1.RunCodeRequest.EnvVarsEntry)
+    })
+  ,
   'DESCRIPTOR' : _RUNCODEREQUEST,
   '__module__' : 'api_pb2'
   # @@protoc_insertion_point(class_scope:api.v1.RunCodeRequest)
   })
 _sym_db.RegisterMessage(RunCodeRequest)
+_sym_db.RegisterMessage(RunCodeRequest.EnvVarsEntry)
 
 RunCodeResponse = _reflection.GeneratedProtocolMessageType('RunCodeResponse', (_message.Message,), {
   'DESCRIPTOR' : _RUNCODERESPONSE,
@@ -1334,6 +3329,48 @@ RunCodeResponse = _reflection.GeneratedProtocolMessageType('RunCodeResponse', (_
   })
 _sym_db.RegisterMessage(RunCodeResponse)
 
+RunProgramResponse = _reflection.GeneratedProtocolMessageType('RunProgramResponse', (_message.Message,), {
+  'DESCRIPTOR' : _RUNPROGRAMRESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.RunProgramResponse)
+  })
+_sym_db.RegisterMessage(RunProgramResponse)
+
+ValidateCodeRequest = _reflection.GeneratedProtocolMessageType('ValidateCodeRequest', (_message.Message,), {
+  'DESCRIPTOR' : _VALIDATECODEREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.ValidateCodeRequest)
+  })
+_sym_db.RegisterMessage(ValidateCodeRequest)
+
+ValidateCodeResponse = _reflection.GeneratedProtocolMessageType('ValidateCodeResponse', (_message.Message,), {
+  'DESCRIPTOR' : _VALIDATECODERESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.ValidateCodeResponse)
+  })
+_sym_db.RegisterMessage(ValidateCodeResponse)
+
+ValidateOptionsRequest = _reflection.GeneratedProtocolMessageType('ValidateOptionsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _VALIDATEOPTIONSREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.ValidateOptionsRequest)
+  })
+_sym_db.RegisterMessage(ValidateOptionsRequest)
+
+OptionDiagnostic = _reflection.GeneratedProtocolMessageType('OptionDiagnostic', (_message.Message,), {
+  'DESCRIPTOR' : _OPTIONDIAGNOSTIC,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.OptionDiagnostic)
+  })
+_sym_db.RegisterMessage(OptionDiagnostic)
+
+ValidateOptionsResponse = _reflection.GeneratedProtocolMessageType('ValidateOptionsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _VALIDATEOPTIONSRESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.ValidateOptionsResponse)
+  })
+_sym_db.RegisterMessage(ValidateOptionsResponse)
+
 CheckStatusRequest = _reflection.GeneratedProtocolMessageType('CheckStatusRequest', (_message.Message,), {
   'DESCRIPTOR' : _CHECKSTATUSREQUEST,
   '__module__' : 'api_pb2'
@@ -1383,6 +3420,13 @@ GetCompileOutputRequest = _reflection.GeneratedProtocolMessageType('GetCompileOu
   })
 _sym_db.RegisterMessage(GetCompileOutputRequest)
 
+CompileDiagnostic = _reflection.GeneratedProtocolMessageType('CompileDiagnostic', (_message.Message,), {
+  'DESCRIPTOR' : _COMPILEDIAGNOSTIC,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.CompileDiagnostic)
+  })
+_sym_db.RegisterMessage(CompileDiagnostic)
+
 GetCompileOutputResponse = _reflection.GeneratedProtocolMessageType('GetCompileOutputResponse', (_message.Message,), {
   'DESCRIPTOR' : _GETCOMPILEOUTPUTRESPONSE,
   '__module__' : 'api_pb2'
@@ -1390,6 +3434,20 @@ GetCompileOutputResponse = _reflection.GeneratedProtocolMessageType('GetCompileO
   })
 _sym_db.RegisterMessage(GetCompileOutputResponse)
 
+GetExecutionCommandRequest = _reflection.GeneratedProtocolMessageType('GetExecutionCommandRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETEXECUTIONCOMMANDREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetExecutionCommandRequest)
+  })
+_sym_db.RegisterMessage(GetExecutionCommandRequest)
+
+GetExecutionCommandResponse = _reflection.GeneratedProtocolMessageType('GetExecutionCommandResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETEXECUTIONCOMMANDRESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetExecutionCommandResponse)
+  })
+_sym_db.RegisterMessage(GetExecutionCommandResponse)
+
 GetRunOutputRequest = _reflection.GeneratedProtocolMessageType('GetRunOutputRequest', (_message.Message,), {
   'DESCRIPTOR' : _GETRUNOUTPUTREQUEST,
   '__module__' : 'api_pb2'
@@ -1432,6 +3490,41 @@ GetLogsResponse = _reflection.GeneratedProtocolMessageType('GetLogsResponse', (_
   })
 _sym_db.RegisterMessage(GetLogsResponse)
 
+GetLogEntriesRequest = _reflection.GeneratedProtocolMessageType('GetLogEntriesRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETLOGENTRIESREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetLogEntriesRequest)
+  })
+_sym_db.RegisterMessage(GetLogEntriesRequest)
+
+LogEntry = _reflection.GeneratedProtocolMessageType('LogEntry', (_message.Message,), {
+  'DESCRIPTOR' : _LOGENTRY,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.LogEntry)
+  })
+_sym_db.RegisterMessage(LogEntry)
+
+GetLogEntriesResponse = _reflection.GeneratedProtocolMessageType('GetLogEntriesResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETLOGENTRIESRESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetLogEntriesResponse)
+  })
+_sym_db.RegisterMessage(GetLogEntriesResponse)
+
+GetGraphRequest = _reflection.GeneratedProtocolMessageType('GetGraphRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETGRAPHREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetGraphRequest)
+  })
+_sym_db.RegisterMessage(GetGraphRequest)
+
+GetGraphResponse = _reflection.GeneratedProtocolMessageType('GetGraphResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETGRAPHRESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetGraphResponse)
+  })
+_sym_db.RegisterMessage(GetGraphResponse)
+
 CancelRequest = _reflection.GeneratedProtocolMessageType('CancelRequest', (_message.Message,), {
   'DESCRIPTOR' : _CANCELREQUEST,
   '__module__' : 'api_pb2'
@@ -1446,6 +3539,83 @@ CancelResponse = _reflection.GeneratedProtocolMessageType('CancelResponse', (_me
   })
 _sym_db.RegisterMessage(CancelResponse)
 
+GetRunOutputPageRequest = _reflection.GeneratedProtocolMessageType('GetRunOutputPageRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETRUNOUTPUTPAGEREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetRunOutputPageRequest)
+  })
+_sym_db.RegisterMessage(GetRunOutputPageRequest)
+
+GetRunOutputPageResponse = _reflection.GeneratedProtocolMessageType('GetRunOutputPageResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETRUNOUTPUTPAGERESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetRunOutputPageResponse)
+  })
+_sym_db.RegisterMessage(GetRunOutputPageResponse)
+
+StreamOutputRequest = _reflection.GeneratedProtocolMessageType('StreamOutputRequest', (_message.Message,), {
+  'DESCRIPTOR' : _STREAMOUTPUTREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.StreamOutputRequest)
+  })
+_sym_db.RegisterMessage(StreamOutputRequest)
+
+StreamOutputResponse = _reflection.GeneratedProtocolMessageType('StreamOutputResponse', (_message.Message,), {
+  'DESCRIPTOR' : _STREAMOUTPUTRESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.StreamOutputResponse)
+  })
+_sym_db.RegisterMessage(StreamOutputResponse)
+
+GetWorkingDirRequest = _reflection.GeneratedProtocolMessageType('GetWorkingDirRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETWORKINGDIRREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetWorkingDirRequest)
+  })
+_sym_db.RegisterMessage(GetWorkingDirRequest)
+
+GetWorkingDirResponse = _reflection.GeneratedProtocolMessageType('GetWorkingDirResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETWORKINGDIRRESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetWorkingDirResponse)
+  })
+_sym_db.RegisterMessage(GetWorkingDirResponse)
+
+GetLogsPageRequest = _reflection.GeneratedProtocolMessageType('GetLogsPageRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETLOGSPAGEREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetLogsPageRequest)
+  })
+_sym_db.RegisterMessage(GetLogsPageRequest)
+
+GetLogsPageResponse = _reflection.GeneratedProtocolMessageType('GetLogsPageResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETLOGSPAGERESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetLogsPageResponse)
+  })
+_sym_db.RegisterMessage(GetLogsPageResponse)
+
+GetMetadataRequest = _reflection.GeneratedProtocolMessageType('GetMetadataRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETMETADATAREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetMetadataRequest)
+  })
+_sym_db.RegisterMessage(GetMetadataRequest)
+
+SubKeyMetadata = _reflection.GeneratedProtocolMessageType('SubKeyMetadata', (_message.Message,), {
+  'DESCRIPTOR' : _SUBKEYMETADATA,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.SubKeyMetadata)
+  })
+_sym_db.RegisterMessage(SubKeyMetadata)
+
+GetMetadataResponse = _reflection.GeneratedProtocolMessageType('GetMetadataResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETMETADATARESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetMetadataResponse)
+  })
+_sym_db.RegisterMessage(GetMetadataResponse)
+
 PrecompiledObject = _reflection.GeneratedProtocolMessageType('PrecompiledObject', (_message.Message,), {
   'DESCRIPTOR' : _PRECOMPILEDOBJECT,
   '__module__' : 'api_pb2'
@@ -1496,6 +3666,13 @@ GetPrecompiledObjectLogsRequest = _reflection.GeneratedProtocolMessageType('GetP
   })
 _sym_db.RegisterMessage(GetPrecompiledObjectLogsRequest)
 
+GetPrecompiledObjectRequest = _reflection.GeneratedProtocolMessageType('GetPrecompiledObjectRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETPRECOMPILEDOBJECTREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetPrecompiledObjectRequest)
+  })
+_sym_db.RegisterMessage(GetPrecompiledObjectRequest)
+
 GetDefaultPrecompiledObjectRequest = _reflection.GeneratedProtocolMessageType('GetDefaultPrecompiledObjectRequest', (_message.Message,), {
   'DESCRIPTOR' : _GETDEFAULTPRECOMPILEDOBJECTREQUEST,
   '__module__' : 'api_pb2'
@@ -1503,6 +3680,62 @@ GetDefaultPrecompiledObjectRequest = _reflection.GeneratedProtocolMessageType('G
   })
 _sym_db.RegisterMessage(GetDefaultPrecompiledObjectRequest)
 
+SearchExamplesRequest = _reflection.GeneratedProtocolMessageType('SearchExamplesRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SEARCHEXAMPLESREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.SearchExamplesRequest)
+  })
+_sym_db.RegisterMessage(SearchExamplesRequest)
+
+SaveSnippetRequest = _reflection.GeneratedProtocolMessageType('SaveSnippetRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SAVESNIPPETREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.SaveSnippetRequest)
+  })
+_sym_db.RegisterMessage(SaveSnippetRequest)
+
+GetSnippetRequest = _reflection.GeneratedProtocolMessageType('GetSnippetRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETSNIPPETREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetSnippetRequest)
+  })
+_sym_db.RegisterMessage(GetSnippetRequest)
+
+ListSdksRequest = _reflection.GeneratedProtocolMessageType('ListSdksRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTSDKSREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.ListSdksRequest)
+  })
+_sym_db.RegisterMessage(ListSdksRequest)
+
+SdkInfo = _reflection.GeneratedProtocolMessageType('SdkInfo', (_message.Message,), {
+  'DESCRIPTOR' : _SDKINFO,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.SdkInfo)
+  })
+_sym_db.RegisterMessage(SdkInfo)
+
+GetUsageMetricsRequest = _reflection.GeneratedProtocolMessageType('GetUsageMetricsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETUSAGEMETRICSREQUEST,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetUsageMetricsRequest)
+  })
+_sym_db.RegisterMessage(GetUsageMetricsRequest)
+
+UsageMetric = _reflection.GeneratedProtocolMessageType('UsageMetric', (_message.Message,), {
+  'DESCRIPTOR' : _USAGEMETRIC,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.UsageMetric)
+  })
+_sym_db.RegisterMessage(UsageMetric)
+
+GetUsageMetricsResponse = _reflection.GeneratedProtocolMessageType('GetUsageMetricsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETUSAGEMETRICSRESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetUsageMetricsResponse)
+  })
+_sym_db.RegisterMessage(GetUsageMetricsResponse)
+
 GetPrecompiledObjectsResponse = _reflection.GeneratedProtocolMessageType('GetPrecompiledObjectsResponse', (_message.Message,), {
   'DESCRIPTOR' : _GETPRECOMPILEDOBJECTSRESPONSE,
   '__module__' : 'api_pb2'
@@ -1531,6 +3764,13 @@ GetPrecompiledObjectLogsResponse = _reflection.GeneratedProtocolMessageType('Get
   })
 _sym_db.RegisterMessage(GetPrecompiledObjectLogsResponse)
 
+GetPrecompiledObjectResponse = _reflection.GeneratedProtocolMessageType('GetPrecompiledObjectResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETPRECOMPILEDOBJECTRESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetPrecompiledObjectResponse)
+  })
+_sym_db.RegisterMessage(GetPrecompiledObjectResponse)
+
 GetDefaultPrecompiledObjectResponse = _reflection.GeneratedProtocolMessageType('GetDefaultPrecompiledObjectResponse', (_message.Message,), {
   'DESCRIPTOR' : _GETDEFAULTPRECOMPILEDOBJECTRESPONSE,
   '__module__' : 'api_pb2'
@@ -1538,8 +3778,37 @@ GetDefaultPrecompiledObjectResponse = _reflection.GeneratedProtocolMessageType('
   })
 _sym_db.RegisterMessage(GetDefaultPrecompiledObjectResponse)
 
+SearchExamplesResponse = _reflection.GeneratedProtocolMessageType('SearchExamplesResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SEARCHEXAMPLESRESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.SearchExamplesResponse)
+  })
+_sym_db.RegisterMessage(SearchExamplesResponse)
+
+SaveSnippetResponse = _reflection.GeneratedProtocolMessageType('SaveSnippetResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SAVESNIPPETRESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.SaveSnippetResponse)
+  })
+_sym_db.RegisterMessage(SaveSnippetResponse)
+
+GetSnippetResponse = _reflection.GeneratedProtocolMessageType('GetSnippetResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETSNIPPETRESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.GetSnippetResponse)
+  })
+_sym_db.RegisterMessage(GetSnippetResponse)
+
+ListSdksResponse = _reflection.GeneratedProtocolMessageType('ListSdksResponse', (_message.Message,), {
+  'DESCRIPTOR' : _LISTSDKSRESPONSE,
+  '__module__' : 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.ListSdksResponse)
+  })
+_sym_db.RegisterMessage(ListSdksResponse)
+
 
 DESCRIPTOR._options = None
+_RUNCODEREQUEST_ENVVARSENTRY._options = None
 
 _PLAYGROUNDSERVICE = _descriptor.ServiceDescriptor(
   name='PlaygroundService',
@@ -1548,8 +3817,8 @@ _PLAYGROUNDSERVICE = _descriptor.ServiceDescriptor(
   index=0,
   serialized_options=None,
   create_key=_descriptor._internal_create_key,
-  serialized_start=2401,
-  serialized_end=3659,
+  serialized_start=6381,
+  serialized_end=8959,
   methods=[
   _descriptor.MethodDescriptor(
     name='RunCode',
@@ -1561,10 +3830,40 @@ _PLAYGROUNDSERVICE = _descriptor.ServiceDescriptor(
     serialized_options=None,
     create_key=_descriptor._internal_create_key,
   ),
+  _descriptor.MethodDescriptor(
+    name='RunProgram',
+    full_name='api.v1.PlaygroundService.RunProgram',
+    index=1,
+    containing_service=None,
+    input_type=_RUNCODEREQUEST,
+    output_type=_RUNPROGRAMRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ValidateCode',
+    full_name='api.v1.PlaygroundService.ValidateCode',
+    index=2,
+    containing_service=None,
+    input_type=_VALIDATECODEREQUEST,
+    output_type=_VALIDATECODERESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ValidateOptions',
+    full_name='api.v1.PlaygroundService.ValidateOptions',
+    index=3,
+    containing_service=None,
+    input_type=_VALIDATEOPTIONSREQUEST,
+    output_type=_VALIDATEOPTIONSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
   _descriptor.MethodDescriptor(
     name='CheckStatus',
     full_name='api.v1.PlaygroundService.CheckStatus',
-    index=1,
+    index=4,
     containing_service=None,
     input_type=_CHECKSTATUSREQUEST,
     output_type=_CHECKSTATUSRESPONSE,
@@ -1574,7 +3873,7 @@ _PLAYGROUNDSERVICE = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetRunOutput',
     full_name='api.v1.PlaygroundService.GetRunOutput',
-    index=2,
+    index=5,
     containing_service=None,
     input_type=_GETRUNOUTPUTREQUEST,
     output_type=_GETRUNOUTPUTRESPONSE,
@@ -1584,17 +3883,77 @@ _PLAYGROUNDSERVICE = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetLogs',
     full_name='api.v1.PlaygroundService.GetLogs',
-    index=3,
+    index=6,
     containing_service=None,
     input_type=_GETLOGSREQUEST,
     output_type=_GETLOGSRESPONSE,
     serialized_options=None,
     create_key=_descriptor._internal_create_key,
   ),
+  _descriptor.MethodDescriptor(
+    name='GetLogEntries',
+    full_name='api.v1.PlaygroundService.GetLogEntries',
+    index=7,
+    containing_service=None,
+    input_type=_GETLOGENTRIESREQUEST,
+    output_type=_GETLOGENTRIESRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetRunOutputPage',
+    full_name='api.v1.PlaygroundService.GetRunOutputPage',
+    index=8,
+    containing_service=None,
+    input_type=_GETRUNOUTPUTPAGEREQUEST,
+    output_type=_GETRUNOUTPUTPAGERESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetLogsPage',
+    full_name='api.v1.PlaygroundService.GetLogsPage',
+    index=9,
+    containing_service=None,
+    input_type=_GETLOGSPAGEREQUEST,
+    output_type=_GETLOGSPAGERESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='StreamOutput',
+    full_name='api.v1.PlaygroundService.StreamOutput',
+    index=10,
+    containing_service=None,
+    input_type=_STREAMOUTPUTREQUEST,
+    output_type=_STREAMOUTPUTRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetWorkingDir',
+    full_name='api.v1.PlaygroundService.GetWorkingDir',
+    index=11,
+    containing_service=None,
+    input_type=_GETWORKINGDIRREQUEST,
+    output_type=_GETWORKINGDIRRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetGraph',
+    full_name='api.v1.PlaygroundService.GetGraph',
+    index=12,
+    containing_service=None,
+    input_type=_GETGRAPHREQUEST,
+    output_type=_GETGRAPHRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
   _descriptor.MethodDescriptor(
     name='GetRunError',
     full_name='api.v1.PlaygroundService.GetRunError',
-    index=4,
+    index=13,
     containing_service=None,
     input_type=_GETRUNERRORREQUEST,
     output_type=_GETRUNERRORRESPONSE,
@@ -1604,7 +3963,7 @@ _PLAYGROUNDSERVICE = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetValidationOutput',
     full_name='api.v1.PlaygroundService.GetValidationOutput',
-    index=5,
+    index=14,
     containing_service=None,
     input_type=_GETVALIDATIONOUTPUTREQUEST,
     output_type=_GETVALIDATIONOUTPUTRESPONSE,
@@ -1614,7 +3973,7 @@ _PLAYGROUNDSERVICE = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetPreparationOutput',
     full_name='api.v1.PlaygroundService.GetPreparationOutput',
-    index=6,
+    index=15,
     containing_service=None,
     input_type=_GETPREPARATIONOUTPUTREQUEST,
     output_type=_GETPREPARATIONOUTPUTRESPONSE,
@@ -1624,37 +3983,67 @@ _PLAYGROUNDSERVICE = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetCompileOutput',
     full_name='api.v1.PlaygroundService.GetCompileOutput',
-    index=7,
+    index=16,
     containing_service=None,
     input_type=_GETCOMPILEOUTPUTREQUEST,
     output_type=_GETCOMPILEOUTPUTRESPONSE,
     serialized_options=None,
     create_key=_descriptor._internal_create_key,
   ),
+  _descriptor.MethodDescriptor(
+    name='GetExecutionCommand',
+    full_name='api.v1.PlaygroundService.GetExecutionCommand',
+    index=17,
+    containing_service=None,
+    input_type=_GETEXECUTIONCOMMANDREQUEST,
+    output_type=_GETEXECUTIONCOMMANDRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
   _descriptor.MethodDescriptor(
     name='Cancel',
     full_name='api.v1.PlaygroundService.Cancel',
-    index=8,
+    index=18,
     containing_service=None,
     input_type=_CANCELREQUEST,
     output_type=_CANCELRESPONSE,
     serialized_options=None,
     create_key=_descriptor._internal_create_key,
   ),
+  _descriptor.MethodDescriptor(
+    name='GetMetadata',
+    full_name='api.v1.PlaygroundService.GetMetadata',
+    index=19,
+    containing_service=None,
+    input_type=_GETMETADATAREQUEST,
+    output_type=_GETMETADATARESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
   _descriptor.MethodDescriptor(
     name='GetPrecompiledObjects',
     full_name='api.v1.PlaygroundService.GetPrecompiledObjects',
-    index=9,
+    index=20,
     containing_service=None,
     input_type=_GETPRECOMPILEDOBJECTSREQUEST,
     output_type=_GETPRECOMPILEDOBJECTSRESPONSE,
     serialized_options=None,
     create_key=_descriptor._internal_create_key,
   ),
+  _descriptor.MethodDescriptor(
+    name='GetPrecompiledObject',
+    full_name='api.v1.PlaygroundService.GetPrecompiledObject',
+    index=21,
+    containing_service=None,
+    input_type=_GETPRECOMPILEDOBJECTREQUEST,
+    output_type=_GETPRECOMPILEDOBJECTRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
   _descriptor.MethodDescriptor(
     name='GetPrecompiledObjectCode',
     full_name='api.v1.PlaygroundService.GetPrecompiledObjectCode',
-    index=10,
+    index=22,
     containing_service=None,
     input_type=_GETPRECOMPILEDOBJECTCODEREQUEST,
     output_type=_GETPRECOMPILEDOBJECTCODERESPONSE,
@@ -1664,7 +4053,7 @@ _PLAYGROUNDSERVICE = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetPrecompiledObjectOutput',
     full_name='api.v1.PlaygroundService.GetPrecompiledObjectOutput',
-    index=11,
+    index=23,
     containing_service=None,
     input_type=_GETPRECOMPILEDOBJECTOUTPUTREQUEST,
     output_type=_GETPRECOMPILEDOBJECTOUTPUTRESPONSE,
@@ -1674,7 +4063,7 @@ _PLAYGROUNDSERVICE = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetPrecompiledObjectLogs',
     full_name='api.v1.PlaygroundService.GetPrecompiledObjectLogs',
-    index=12,
+    index=24,
     containing_service=None,
     input_type=_GETPRECOMPILEDOBJECTLOGSREQUEST,
     output_type=_GETPRECOMPILEDOBJECTLOGSRESPONSE,
@@ -1684,13 +4073,63 @@ _PLAYGROUNDSERVICE = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetDefaultPrecompiledObject',
     full_name='api.v1.PlaygroundService.GetDefaultPrecompiledObject',
-    index=13,
+    index=25,
     containing_service=None,
     input_type=_GETDEFAULTPRECOMPILEDOBJECTREQUEST,
     output_type=_GETDEFAULTPRECOMPILEDOBJECTRESPONSE,
     serialized_options=None,
     create_key=_descriptor._internal_create_key,
   ),
+  _descriptor.MethodDescriptor(
+    name='SearchExamples',
+    full_name='api.v1.PlaygroundService.SearchExamples',
+    index=26,
+    containing_service=None,
+    input_type=_SEARCHEXAMPLESREQUEST,
+    output_type=_SEARCHEXAMPLESRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SaveSnippet',
+    full_name='api.v1.PlaygroundService.SaveSnippet',
+    index=27,
+    containing_service=None,
+    input_type=_SAVESNIPPETREQUEST,
+    output_type=_SAVESNIPPETRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetSnippet',
+    full_name='api.v1.PlaygroundService.GetSnippet',
+    index=28,
+    containing_service=None,
+    input_type=_GETSNIPPETREQUEST,
+    output_type=_GETSNIPPETRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListSDKs',
+    full_name='api.v1.PlaygroundService.ListSDKs',
+    index=29,
+    containing_service=None,
+    input_type=_LISTSDKSREQUEST,
+    output_type=_LISTSDKSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetUsageMetrics',
+    full_name='api.v1.PlaygroundService.GetUsageMetrics',
+    index=30,
+    containing_service=None,
+    input_type=_GETUSAGEMETRICSREQUEST,
+    output_type=_GETUSAGEMETRICSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_PLAYGROUNDSERVICE)
 
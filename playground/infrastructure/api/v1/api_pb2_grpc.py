@@ -34,6 +34,21 @@ class PlaygroundServiceStub(object):
                 request_serializer=api__pb2.RunCodeRequest.SerializeToString,
                 response_deserializer=api__pb2.RunCodeResponse.FromString,
                 )
+        self.RunProgram = channel.unary_stream(
+                '/api.v1.PlaygroundService/RunProgram',
+                request_serializer=api__pb2.RunCodeRequest.SerializeToString,
+                response_deserializer=api__pb2.RunProgramResponse.FromString,
+                )
+        self.ValidateCode = channel.unary_unary(
+                '/api.v1.PlaygroundService/ValidateCode',
+                request_serializer=api__pb2.ValidateCodeRequest.SerializeToString,
+                response_deserializer=api__pb2.ValidateCodeResponse.FromString,
+                )
+        self.ValidateOptions = channel.unary_unary(
+                '/api.v1.PlaygroundService/ValidateOptions',
+                request_serializer=api__pb2.ValidateOptionsRequest.SerializeToString,
+                response_deserializer=api__pb2.ValidateOptionsResponse.FromString,
+                )
         self.CheckStatus = channel.unary_unary(
                 '/api.v1.PlaygroundService/CheckStatus',
                 request_serializer=api__pb2.CheckStatusRequest.SerializeToString,
@@ -49,6 +64,36 @@ class PlaygroundServiceStub(object):
                 request_serializer=api__pb2.GetLogsRequest.SerializeToString,
                 response_deserializer=api__pb2.GetLogsResponse.FromString,
                 )
+        self.GetLogEntries = channel.unary_unary(
+                '/api.v1.PlaygroundService/GetLogEntries',
+                request_serializer=api__pb2.GetLogEntriesRequest.SerializeToString,
+                response_deserializer=api__pb2.GetLogEntriesResponse.FromString,
+                )
+        self.GetRunOutputPage = channel.unary_unary(
+                '/api.v1.PlaygroundService/GetRunOutputPage',
+                request_serializer=api__pb2.GetRunOutputPageRequest.SerializeToString,
+                response_deserializer=api__pb2.GetRunOutputPageResponse.FromString,
+                )
+        self.GetLogsPage = channel.unary_unary(
+                '/api.v1.PlaygroundService/GetLogsPage',
+                request_serializer=api__pb2.GetLogsPageRequest.SerializeToString,
+                response_deserializer=api__pb2.GetLogsPageResponse.FromString,
+                )
+        self.StreamOutput = channel.unary_stream(
+                '/api.v1.PlaygroundService/StreamOutput',
+                request_serializer=api__pb2.StreamOutputRequest.SerializeToString,
+                response_deserializer=api__pb2.StreamOutputResponse.FromString,
+                )
+        self.GetWorkingDir = channel.unary_stream(
+                '/api.v1.PlaygroundService/GetWorkingDir',
+                request_serializer=api__pb2.GetWorkingDirRequest.SerializeToString,
+                response_deserializer=api__pb2.GetWorkingDirResponse.FromString,
+                )
+        self.GetGraph = channel.unary_unary(
+                '/api.v1.PlaygroundService/GetGraph',
+                request_serializer=api__pb2.GetGraphRequest.SerializeToString,
+                response_deserializer=api__pb2.GetGraphResponse.FromString,
+                )
         self.GetRunError = channel.unary_unary(
                 '/api.v1.PlaygroundService/GetRunError',
                 request_serializer=api__pb2.GetRunErrorRequest.SerializeToString,
@@ -69,16 +114,31 @@ class PlaygroundServiceStub(object):
                 request_serializer=api__pb2.GetCompileOutputRequest.SerializeToString,
                 response_deserializer=api__pb2.GetCompileOutputResponse.FromString,
                 )
+        self.GetExecutionCommand = channel.unary_unary(
+                '/api.v1.PlaygroundService/GetExecutionCommand',
+                request_serializer=api__pb2.GetExecutionCommandRequest.SerializeToString,
+                response_deserializer=api__pb2.GetExecutionCommandResponse.FromString,
+                )
         self.Cancel = channel.unary_unary(
                 '/api.v1.PlaygroundService/Cancel',
                 request_serializer=api__pb2.CancelRequest.SerializeToString,
                 response_deserializer=api__pb2.CancelResponse.FromString,
                 )
+        self.GetMetadata = channel.unary_unary(
+                '/api.v1.PlaygroundService/GetMetadata',
+                request_serializer=api__pb2.GetMetadataRequest.SerializeToString,
+                response_deserializer=api__pb2.GetMetadataResponse.FromString,
+                )
         self.GetPrecompiledObjects = channel.unary_unary(
                 '/api.v1.PlaygroundService/GetPrecompiledObjects',
                 request_serializer=api__pb2.GetPrecompiledObjectsRequest.SerializeToString,
                 response_deserializer=api__pb2.GetPrecompiledObjectsResponse.FromString,
                 )
+        self.GetPrecompiledObject = channel.unary_unary(
+                '/api.v1.PlaygroundService/GetPrecompiledObject',
+                request_serializer=api__pb2.GetPrecompiledObjectRequest.SerializeToString,
+                response_deserializer=api__pb2.GetPrecompiledObjectResponse.FromString,
+                )
         self.GetPrecompiledObjectCode = channel.unary_unary(
                 '/api.v1.PlaygroundService/GetPrecompiledObjectCode',
                 request_serializer=api__pb2.GetPrecompiledObjectCodeRequest.SerializeToString,
@@ -99,6 +159,31 @@ class PlaygroundServiceStub(object):
                 request_serializer=api__pb2.GetDefaultPrecompiledObjectRequest.SerializeToString,
                 response_deserializer=api__pb2.GetDefaultPrecompiledObjectResponse.FromString,
                 )
+        self.SearchExamples = channel.unary_unary(
+                '/api.v1.PlaygroundService/SearchExamples',
+                request_serializer=api__pb2.SearchExamplesRequest.SerializeToString,
+                response_deserializer=api__pb2.SearchExamplesResponse.FromString,
+                )
+        self.SaveSnippet = channel.unary_unary(
+                '/api.v1.PlaygroundService/SaveSnippet',
+                request_serializer=api__pb2.SaveSnippetRequest.SerializeToString,
+                response_deserializer=api__pb2.SaveSnippetResponse.FromString,
+                )
+        self.GetSnippet = channel.unary_unary(
+                '/api.v1.PlaygroundService/GetSnippet',
+                request_serializer=api__pb2.GetSnippetRequest.SerializeToString,
+                response_deserializer=api__pb2.GetSnippetResponse.FromString,
+                )
+        self.ListSDKs = channel.unary_unary(
+                '/api.v1.PlaygroundService/ListSDKs',
+                request_serializer=api__pb2.ListSdksRequest.SerializeToString,
+                response_deserializer=api__pb2.ListSdksResponse.FromString,
+                )
+        self.GetUsageMetrics = channel.unary_unary(
+                '/api.v1.PlaygroundService/GetUsageMetrics',
+                request_serializer=api__pb2.GetUsageMetricsRequest.SerializeToString,
+                response_deserializer=api__pb2.GetUsageMetricsResponse.FromString,
+                )
 
 
 class PlaygroundServiceServicer(object):
@@ -111,6 +196,30 @@ class PlaygroundServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def RunProgram(self, request, context):
+        """Submit the job for an execution and receive statuses of the pipeline (e.g. STATUS_COMPILING -> STATUS_EXECUTING ->
+        STATUS_FINISHED) until it is finished. The code isn't run if it isn't compiled.
+        If the code processing is failed, the call ends with the error which contains ErrorDetails after the last status.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ValidateCode(self, request, context):
+        """Check the syntax of the code without compiling and running it.
+        Diagnostics are also available as the validation output of the returned pipeline uuid.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ValidateOptions(self, request, context):
+        """Check pipeline options against options known for the SDK without running the code.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def CheckStatus(self, request, context):
         """Get the status of pipeline execution.
         """
@@ -132,6 +241,52 @@ class PlaygroundServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetLogEntries(self, request, context):
+        """Get structured entries of the logs of pipeline execution filtered by the min severity and the time range.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetRunOutputPage(self, request, context):
+        """Get the page of the result of pipeline execution by the offset and the limit in bytes.
+        Pages of the finished pipeline execution are stable, so the whole result could be read page by page.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetLogsPage(self, request, context):
+        """Get the page of the logs of pipeline execution by the offset and the limit in bytes.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def StreamOutput(self, request, context):
+        """Stream changes of the status and chunks of the run output as they are produced until the code processing is finished.
+        The run output which is produced before the status is changed is sent before the status.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetWorkingDir(self, request, context):
+        """Download the zip archive of the working directory of the code which is kept by keep_working_dir of RunCodeRequest.
+        The archive is streamed in chunks which should be concatenated.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetGraph(self, request, context):
+        """Get the pipeline execution graph in DOT format and as JSON of nodes and edges.
+        If the graph isn't generated yet, the response isn't ready instead of an error.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetRunError(self, request, context):
         """Get the error of pipeline execution.
         """
@@ -154,7 +309,14 @@ class PlaygroundServiceServicer(object):
         raise NotImplementedError('Method not implemented!')
 
     def GetCompileOutput(self, request, context):
-        """Get the result of pipeline compilation.
+        """Get the result of pipeline compilation with diagnostics which are parsed from it by the SDK.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetExecutionCommand(self, request, context):
+        """Get command lines which are run to compile and run the code
         """
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
@@ -167,6 +329,13 @@ class PlaygroundServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetMetadata(self, request, context):
+        """Get the metadata of all currently available values of the pipeline execution.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetPrecompiledObjects(self, request, context):
         """Get all precompiled objects from the cloud storage.
         """
@@ -174,6 +343,13 @@ class PlaygroundServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetPrecompiledObject(self, request, context):
+        """Get the PrecompiledObject with its code and precompiled outputs without running the code.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetPrecompiledObjectCode(self, request, context):
         """Get the code of an PrecompiledObject.
         """
@@ -202,6 +378,44 @@ class PlaygroundServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def SearchExamples(self, request, context):
+        """Search examples by SDKs, the category and the term which is found in names or descriptions of examples.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SaveSnippet(self, request, context):
+        """Save the code which is shared by the user and get the id of the snippet.
+        Snippets are kept during the retention of the server.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetSnippet(self, request, context):
+        """Get the code of the shared snippet by its id.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListSDKs(self, request, context):
+        """Get all known SDKs, their versions and whether they are available on the server.
+        Toolchains of SDKs are detected when the server is started.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetUsageMetrics(self, request, context):
+        """Get numbers of runs per SDK and example during the range of dates.
+        Runs are counted in batches, so the latest runs could be missed until they are saved.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_PlaygroundServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -210,6 +424,21 @@ def add_PlaygroundServiceServicer_to_server(servicer, server):
                     request_deserializer=api__pb2.RunCodeRequest.FromString,
                     response_serializer=api__pb2.RunCodeResponse.SerializeToString,
             ),
+            'RunProgram': grpc.unary_stream_rpc_method_handler(
+                    servicer.RunProgram,
+                    request_deserializer=api__pb2.RunCodeRequest.FromString,
+                    response_serializer=api__pb2.RunProgramResponse.SerializeToString,
+            ),
+            'ValidateCode': grpc.unary_unary_rpc_method_handler(
+                    servicer.ValidateCode,
+                    request_deserializer=api__pb2.ValidateCodeRequest.FromString,
+                    response_serializer=api__pb2.ValidateCodeResponse.SerializeToString,
+            ),
+            'ValidateOptions': grpc.unary_unary_rpc_method_handler(
+                    servicer.ValidateOptions,
+                    request_deserializer=api__pb2.ValidateOptionsRequest.FromString,
+                    response_serializer=api__pb2.ValidateOptionsResponse.SerializeToString,
+            ),
             'CheckStatus': grpc.unary_unary_rpc_method_handler(
                     servicer.CheckStatus,
                     request_deserializer=api__pb2.CheckStatusRequest.FromString,
@@ -225,6 +454,36 @@ def add_PlaygroundServiceServicer_to_server(servicer, server):
                     request_deserializer=api__pb2.GetLogsRequest.FromString,
                     response_serializer=api__pb2.GetLogsResponse.SerializeToString,
             ),
+            'GetLogEntries': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetLogEntries,
+                    request_deserializer=api__pb2.GetLogEntriesRequest.FromString,
+                    response_serializer=api__pb2.GetLogEntriesResponse.SerializeToString,
+            ),
+            'GetRunOutputPage': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetRunOutputPage,
+                    request_deserializer=api__pb2.GetRunOutputPageRequest.FromString,
+                    response_serializer=api__pb2.GetRunOutputPageResponse.SerializeToString,
+            ),
+            'GetLogsPage': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetLogsPage,
+                    request_deserializer=api__pb2.GetLogsPageRequest.FromString,
+                    response_serializer=api__pb2.GetLogsPageResponse.SerializeToString,
+            ),
+            'StreamOutput': grpc.unary_stream_rpc_method_handler(
+                    servicer.StreamOutput,
+                    request_deserializer=api__pb2.StreamOutputRequest.FromString,
+                    response_serializer=api__pb2.StreamOutputResponse.SerializeToString,
+            ),
+            'GetWorkingDir': grpc.unary_stream_rpc_method_handler(
+                    servicer.GetWorkingDir,
+                    request_deserializer=api__pb2.GetWorkingDirRequest.FromString,
+                    response_serializer=api__pb2.GetWorkingDirResponse.SerializeToString,
+            ),
+            'GetGraph': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetGraph,
+                    request_deserializer=api__pb2.GetGraphRequest.FromString,
+                    response_serializer=api__pb2.GetGraphResponse.SerializeToString,
+            ),
             'GetRunError': grpc.unary_unary_rpc_method_handler(
                     servicer.GetRunError,
                     request_deserializer=api__pb2.GetRunErrorRequest.FromString,
@@ -245,16 +504,31 @@ def add_PlaygroundServiceServicer_to_server(servicer, server):
                     request_deserializer=api__pb2.GetCompileOutputRequest.FromString,
                     response_serializer=api__pb2.GetCompileOutputResponse.SerializeToString,
             ),
+            'GetExecutionCommand': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetExecutionCommand,
+                    request_deserializer=api__pb2.GetExecutionCommandRequest.FromString,
+                    response_serializer=api__pb2.GetExecutionCommandResponse.SerializeToString,
+            ),
             'Cancel': grpc.unary_unary_rpc_method_handler(
                     servicer.Cancel,
                     request_deserializer=api__pb2.CancelRequest.FromString,
                     response_serializer=api__pb2.CancelResponse.SerializeToString,
             ),
+            'GetMetadata': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetMetadata,
+                    request_deserializer=api__pb2.GetMetadataRequest.FromString,
+                    response_serializer=api__pb2.GetMetadataResponse.SerializeToString,
+            ),
             'GetPrecompiledObjects': grpc.unary_unary_rpc_method_handler(
                     servicer.GetPrecompiledObjects,
                     request_deserializer=api__pb2.GetPrecompiledObjectsRequest.FromString,
                     response_serializer=api__pb2.GetPrecompiledObjectsResponse.SerializeToString,
             ),
+            'GetPrecompiledObject': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetPrecompiledObject,
+                    request_deserializer=api__pb2.GetPrecompiledObjectRequest.FromString,
+                    response_serializer=api__pb2.GetPrecompiledObjectResponse.SerializeToString,
+            ),
             'GetPrecompiledObjectCode': grpc.unary_unary_rpc_method_handler(
                     servicer.GetPrecompiledObjectCode,
                     request_deserializer=api__pb2.GetPrecompiledObjectCodeRequest.FromString,
@@ -275,6 +549,31 @@ def add_PlaygroundServiceServicer_to_server(servicer, server):
                     request_deserializer=api__pb2.GetDefaultPrecompiledObjectRequest.FromString,
                     response_serializer=api__pb2.GetDefaultPrecompiledObjectResponse.SerializeToString,
             ),
+            'SearchExamples': grpc.unary_unary_rpc_method_handler(
+                    servicer.SearchExamples,
+                    request_deserializer=api__pb2.SearchExamplesRequest.FromString,
+                    response_serializer=api__pb2.SearchExamplesResponse.SerializeToString,
+            ),
+            'SaveSnippet': grpc.unary_unary_rpc_method_handler(
+                    servicer.SaveSnippet,
+                    request_deserializer=api__pb2.SaveSnippetRequest.FromString,
+                    response_serializer=api__pb2.SaveSnippetResponse.SerializeToString,
+            ),
+            'GetSnippet': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetSnippet,
+                    request_deserializer=api__pb2.GetSnippetRequest.FromString,
+                    response_serializer=api__pb2.GetSnippetResponse.SerializeToString,
+            ),
+            'ListSDKs': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListSDKs,
+                    request_deserializer=api__pb2.ListSdksRequest.FromString,
+                    response_serializer=api__pb2.ListSdksResponse.SerializeToString,
+            ),
+            'GetUsageMetrics': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetUsageMetrics,
+                    request_deserializer=api__pb2.GetUsageMetricsRequest.FromString,
+                    response_serializer=api__pb2.GetUsageMetricsResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'api.v1.PlaygroundService', rpc_method_handlers)
@@ -302,6 +601,57 @@ class PlaygroundService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def RunProgram(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/api.v1.PlaygroundService/RunProgram',
+            api__pb2.RunCodeRequest.SerializeToString,
+            api__pb2.RunProgramResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ValidateCode(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/ValidateCode',
+            api__pb2.ValidateCodeRequest.SerializeToString,
+            api__pb2.ValidateCodeResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ValidateOptions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/ValidateOptions',
+            api__pb2.ValidateOptionsRequest.SerializeToString,
+            api__pb2.ValidateOptionsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def CheckStatus(request,
             target,
@@ -353,6 +703,108 @@ class PlaygroundService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def GetLogEntries(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/GetLogEntries',
+            api__pb2.GetLogEntriesRequest.SerializeToString,
+            api__pb2.GetLogEntriesResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetRunOutputPage(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/GetRunOutputPage',
+            api__pb2.GetRunOutputPageRequest.SerializeToString,
+            api__pb2.GetRunOutputPageResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetLogsPage(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/GetLogsPage',
+            api__pb2.GetLogsPageRequest.SerializeToString,
+            api__pb2.GetLogsPageResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def StreamOutput(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/api.v1.PlaygroundService/StreamOutput',
+            api__pb2.StreamOutputRequest.SerializeToString,
+            api__pb2.StreamOutputResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetWorkingDir(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/api.v1.PlaygroundService/GetWorkingDir',
+            api__pb2.GetWorkingDirRequest.SerializeToString,
+            api__pb2.GetWorkingDirResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetGraph(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/GetGraph',
+            api__pb2.GetGraphRequest.SerializeToString,
+            api__pb2.GetGraphResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def GetRunError(request,
             target,
@@ -421,6 +873,23 @@ class PlaygroundService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def GetExecutionCommand(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/GetExecutionCommand',
+            api__pb2.GetExecutionCommandRequest.SerializeToString,
+            api__pb2.GetExecutionCommandResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def Cancel(request,
             target,
@@ -438,6 +907,23 @@ class PlaygroundService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def GetMetadata(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/GetMetadata',
+            api__pb2.GetMetadataRequest.SerializeToString,
+            api__pb2.GetMetadataResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def GetPrecompiledObjects(request,
             target,
@@ -455,6 +941,23 @@ class PlaygroundService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def GetPrecompiledObject(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/GetPrecompiledObject',
+            api__pb2.GetPrecompiledObjectRequest.SerializeToString,
+            api__pb2.GetPrecompiledObjectResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def GetPrecompiledObjectCode(request,
             target,
@@ -522,3 +1025,88 @@ class PlaygroundService(object):
             api__pb2.GetDefaultPrecompiledObjectResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SearchExamples(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/SearchExamples',
+            api__pb2.SearchExamplesRequest.SerializeToString,
+            api__pb2.SearchExamplesResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SaveSnippet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/SaveSnippet',
+            api__pb2.SaveSnippetRequest.SerializeToString,
+            api__pb2.SaveSnippetResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetSnippet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/GetSnippet',
+            api__pb2.GetSnippetRequest.SerializeToString,
+            api__pb2.GetSnippetResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListSDKs(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/ListSDKs',
+            api__pb2.ListSdksRequest.SerializeToString,
+            api__pb2.ListSdksResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetUsageMetrics(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/GetUsageMetrics',
+            api__pb2.GetUsageMetricsRequest.SerializeToString,
+            api__pb2.GetUsageMetricsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -30,7 +30,8 @@ from yaml import YAMLError
 
 from api.v1.api_pb2 import SDK_UNSPECIFIED, STATUS_UNSPECIFIED, Sdk, \
   STATUS_VALIDATING, STATUS_PREPARING, \
-  STATUS_COMPILING, STATUS_EXECUTING, PRECOMPILED_OBJECT_TYPE_UNIT_TEST, \
+  STATUS_COMPILING, STATUS_EXECUTING, STATUS_WAITING, \
+  PRECOMPILED_OBJECT_TYPE_UNIT_TEST, \
   PRECOMPILED_OBJECT_TYPE_KATA, PRECOMPILED_OBJECT_TYPE_UNSPECIFIED, \
   PRECOMPILED_OBJECT_TYPE_EXAMPLE, PrecompiledObjectType
 from config import Config, TagFields, PrecompiledExampleType, OptionalTagFields
@@ -356,7 +357,7 @@ async def _update_example_status(example: Example, client: GRPCClient):
   Use client to send requests to the backend:
   1. Start code processing.
   2. Ping the backend while status is STATUS_VALIDATING/
-    STATUS_PREPARING/STATUS_COMPILING/STATUS_EXECUTING
+    STATUS_PREPARING/STATUS_COMPILING/STATUS_EXECUTING/STATUS_WAITING
   Update example.status with resulting status.
 
   Args:
@@ -370,7 +371,8 @@ async def _update_example_status(example: Example, client: GRPCClient):
   while status in [STATUS_VALIDATING,
                    STATUS_PREPARING,
                    STATUS_COMPILING,
-                   STATUS_EXECUTING]:
+                   STATUS_EXECUTING,
+                   STATUS_WAITING]:
     await asyncio.sleep(Config.PAUSE_DELAY)
     status = await client.check_status(pipeline_id)
   example.status = status
@@ -46,6 +46,7 @@ resource "google_app_engine_flexible_app_version" "backend_app" {
   env_variables = {
      CACHE_TYPE="${var.cache_type}"
      CACHE_ADDRESS="${var.cache_address}:6379"
+     SNIPPETS_STORE="datastore"
      NUM_PARALLEL_JOBS=10
      LAUNCH_SITE = "app_engine"
   }
@@ -49,6 +49,7 @@ resource "google_app_engine_flexible_app_version" "backend_app_go" {
   env_variables = {
      CACHE_TYPE="${var.cache_type}"
      CACHE_ADDRESS="${var.cache_address}:6379"
+     SNIPPETS_STORE="datastore"
      NUM_PARALLEL_JOBS=30
   }
 
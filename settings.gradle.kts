@@ -63,6 +63,7 @@ include(":playground:frontend")
 include(":playground:backend:containers:java")
 include(":playground:backend:containers:go")
 include(":playground:backend:containers:python")
+include(":playground:backend:containers:scio")
 include(":runners:core-construction-java")
 include(":runners:core-java")
 include(":runners:direct-java")